@@ -54,47 +54,68 @@ func init() {
 }
 
 var fileDescriptor_cf5ca5e0c737570d = []byte{
-	// 636 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xbf, 0x6f, 0x13, 0x31,
-	0x14, 0xc7, 0xcf, 0x0b, 0x83, 0xc5, 0x2f, 0xb9, 0x08, 0x89, 0x0e, 0x06, 0xc1, 0x7e, 0x51, 0x8b,
-	0x54, 0x44, 0x0b, 0xb4, 0xe9, 0x0f, 0x52, 0x89, 0x06, 0xc1, 0x05, 0x81, 0xc4, 0x82, 0x9c, 0xcb,
-	0x6b, 0x7b, 0xea, 0x25, 0x3e, 0x6c, 0x5f, 0x4a, 0x27, 0x18, 0x91, 0x90, 0x10, 0x4c, 0x48, 0x48,
-	0x4c, 0x2c, 0x0c, 0xfc, 0x0d, 0x48, 0x6c, 0x8c, 0x1d, 0xcb, 0x46, 0x2f, 0x0b, 0x63, 0xff, 0x04,
-	0x14, 0x2e, 0x76, 0xae, 0xe9, 0x51, 0x7c, 0x97, 0x6e, 0xb1, 0xe4, 0xcf, 0xf7, 0x7d, 0x9c, 0x38,
-	0xcf, 0x0f, 0x4f, 0x29, 0x68, 0x47, 0x5c, 0xb0, 0xb0, 0x22, 0x41, 0x74, 0x41, 0x54, 0x58, 0x14,
-	0x54, 0x58, 0xab, 0x1d, 0x74, 0xfa, 0xeb, 0xc0, 0x87, 0x4a, 0x77, 0xaa, 0x32, 0xf8, 0xe8, 0x46,
-	0x82, 0x2b, 0x4e, 0xae, 0x69, 0xc4, 0x4d, 0x11, 0x97, 0x45, 0x81, 0x9b, 0x45, 0xdc, 0xee, 0xd4,
-	0xe4, 0xac, 0x4d, 0xae, 0x80, 0xe7, 0x31, 0x48, 0xf5, 0x4c, 0x80, 0x8c, 0x78, 0x47, 0x0e, 0x0a,
-	0x4c, 0xff, 0x9c, 0xc0, 0xa7, 0xab, 0xfd, 0xad, 0x8d, 0x74, 0x2b, 0xf9, 0x84, 0xf0, 0x85, 0x65,
-	0x90, 0xbe, 0x08, 0x9a, 0x50, 0x8f, 0x15, 0x6b, 0x86, 0xd0, 0x50, 0x4c, 0x01, 0x59, 0x70, 0x2d,
-	0x5c, 0xdc, 0x3c, 0xd4, 0x4b, 0x4b, 0x4f, 0x56, 0xc7, 0x48, 0x48, 0xa5, 0xaf, 0x3a, 0xe4, 0x23,
-	0xc2, 0x13, 0x7a, 0xcb, 0x6a, 0x20, 0x15, 0x17, 0x3b, 0xab, 0x5c, 0x2a, 0x32, 0x5f, 0x28, 0x3c,
-	0x43, 0x6a, 0xbb, 0x85, 0xf2, 0x01, 0x46, 0xee, 0x25, 0xc6, 0x4b, 0x21, 0x97, 0xd0, 0xd8, 0x64,
-	0xa2, 0x45, 0x66, 0xac, 0x12, 0x87, 0x80, 0x36, 0xb9, 0x51, 0x98, 0xcb, 0x0a, 0x78, 0xd0, 0xe6,
-	0x5d, 0x78, 0xc4, 0xe4, 0x96, 0xa5, 0xc0, 0x10, 0x28, 0x26, 0x90, 0xe5, 0x8c, 0xc0, 0x77, 0x84,
-	0xaf, 0xd4, 0x40, 0x3d, 0xe1, 0x62, 0x6b, 0x3d, 0xe4, 0xdb, 0x2b, 0x2f, 0xc0, 0x8f, 0x55, 0xc0,
-	0x3b, 0x1e, 0xdb, 0x1e, 0x7c, 0x65, 0x8f, 0xa7, 0xc9, 0x9a, 0x55, 0xfe, 0xff, 0x62, 0xb4, 0x6d,
-	0xfd, 0x84, 0xd2, 0xcc, 0x19, 0x3e, 0x23, 0x7c, 0xb1, 0x06, 0xca, 0x83, 0x28, 0x0c, 0x7c, 0xd6,
-	0xdf, 0x58, 0x07, 0x29, 0xd9, 0x06, 0x48, 0xb2, 0x68, 0x5b, 0x2b, 0x07, 0xd6, 0xbe, 0x4b, 0x63,
-	0x65, 0x18, 0xcb, 0x6f, 0x08, 0x5f, 0xae, 0x81, 0xba, 0xcf, 0xda, 0x20, 0x23, 0xe6, 0x43, 0x9e,
-	0xee, 0x3d, 0xdb, 0x52, 0xc7, 0xa5, 0x68, 0xef, 0xb5, 0x93, 0x09, 0x33, 0x07, 0xf8, 0x8a, 0xf0,
-	0xa5, 0x1a, 0xa8, 0xe5, 0xb5, 0x87, 0x79, 0xea, 0x2b, 0xb6, 0xd5, 0xf2, 0x79, 0x2d, 0x7d, 0x77,
-	0xdc, 0x18, 0xa3, 0xfb, 0x1a, 0xe1, 0x33, 0x1e, 0xb0, 0x28, 0x0a, 0x77, 0x56, 0xba, 0xd0, 0x51,
-	0x92, 0xdc, 0xb4, 0xfc, 0x9b, 0x64, 0x18, 0xad, 0x35, 0x5b, 0x06, 0x35, 0x2a, 0x1f, 0x10, 0x26,
-	0xd5, 0x56, 0xab, 0x01, 0x4c, 0xf8, 0x9b, 0x55, 0xa5, 0x44, 0xd0, 0x8c, 0x15, 0x90, 0x3b, 0x56,
-	0xa1, 0x47, 0x41, 0x2d, 0x35, 0x5f, 0x9a, 0x37, 0x66, 0x6f, 0x11, 0x3e, 0xa7, 0x5b, 0xe4, 0x52,
-	0x18, 0x4b, 0x05, 0x82, 0xcc, 0x15, 0x6a, 0xac, 0x03, 0x4a, 0x3b, 0xdd, 0x2a, 0x07, 0x1b, 0xa1,
-	0x37, 0x08, 0x9f, 0x4d, 0x7f, 0x5d, 0x73, 0xb3, 0x66, 0x0b, 0x5c, 0x89, 0xd1, 0xeb, 0x34, 0x57,
-	0x8a, 0x35, 0x36, 0xef, 0x11, 0x3e, 0xff, 0x20, 0x16, 0x1b, 0x90, 0xf5, 0xb1, 0x3b, 0xe2, 0x28,
-	0xa6, 0x8d, 0x6e, 0x97, 0xa4, 0x0f, 0x39, 0xd5, 0xa1, 0x94, 0xd3, 0x28, 0x56, 0xcc, 0xe9, 0x28,
-	0x6d, 0x9c, 0xfa, 0x43, 0x88, 0x07, 0xeb, 0x02, 0xe4, 0xa6, 0x6e, 0xda, 0xfd, 0x77, 0x46, 0x5a,
-	0x0e, 0x21, 0x79, 0x68, 0xb1, 0x21, 0x24, 0x3f, 0xe1, 0xd0, 0x0b, 0xe1, 0x81, 0x84, 0x4e, 0x2b,
-	0xd3, 0x33, 0x52, 0xc3, 0x45, 0xcb, 0xfc, 0x3c, 0xb8, 0xd8, 0x0b, 0xf1, 0xaf, 0x0c, 0x6d, 0xb9,
-	0x18, 0xee, 0xee, 0x53, 0x67, 0x6f, 0x9f, 0x3a, 0x07, 0xfb, 0x14, 0xbd, 0x4a, 0x28, 0xfa, 0x92,
-	0x50, 0xf4, 0x23, 0xa1, 0x68, 0x37, 0xa1, 0xe8, 0x57, 0x42, 0xd1, 0xef, 0x84, 0x3a, 0x07, 0x09,
-	0x45, 0xef, 0x7a, 0xd4, 0xd9, 0xed, 0x51, 0x67, 0xaf, 0x47, 0x9d, 0xa7, 0x33, 0x1b, 0x7c, 0x58,
-	0x3e, 0xe0, 0xc7, 0xcc, 0x94, 0x73, 0xd9, 0x75, 0xf3, 0xd4, 0xdf, 0x81, 0xf2, 0xfa, 0x9f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xf6, 0x9b, 0x25, 0x12, 0xe6, 0x0a, 0x00, 0x00,
+	// 970 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x98, 0xcb, 0x8b, 0x2b, 0x45,
+	0x14, 0xc6, 0x53, 0x1b, 0x17, 0x85, 0xcf, 0xf2, 0x3d, 0x42, 0x2b, 0x0a, 0x2e, 0x13, 0xe6, 0x0a,
+	0x57, 0x9c, 0xf1, 0x3e, 0xf2, 0x9a, 0x8c, 0x4e, 0x22, 0xf7, 0x26, 0xf7, 0x2a, 0xb8, 0x91, 0x9a,
+	0xce, 0x99, 0xa4, 0x98, 0x4e, 0x57, 0x5b, 0x55, 0x9d, 0x31, 0x2b, 0x5d, 0x0a, 0x82, 0x28, 0x08,
+	0x82, 0x20, 0x5c, 0x10, 0x44, 0x41, 0x10, 0xdc, 0x0a, 0x82, 0x3b, 0x97, 0xb3, 0xbc, 0x4b, 0x27,
+	0xb3, 0x71, 0x39, 0x7f, 0x82, 0xf4, 0x24, 0x55, 0x93, 0x9e, 0x74, 0x72, 0xab, 0xba, 0x67, 0x37,
+	0x61, 0xce, 0xf7, 0x9d, 0x5f, 0xd7, 0xe3, 0x9c, 0xd3, 0x8d, 0x37, 0x15, 0x8c, 0x22, 0x2e, 0x68,
+	0x50, 0x91, 0x20, 0xc6, 0x20, 0x2a, 0x34, 0x62, 0x15, 0xda, 0x1f, 0xb1, 0x30, 0xf9, 0xcd, 0x7c,
+	0xa8, 0x8c, 0x37, 0x2b, 0xf3, 0x3f, 0xcb, 0x91, 0xe0, 0x8a, 0x93, 0x37, 0xb4, 0xa4, 0x3c, 0x93,
+	0x94, 0x69, 0xc4, 0xca, 0x8b, 0x92, 0xf2, 0x78, 0x73, 0x63, 0xcb, 0xc6, 0x57, 0xc0, 0xa7, 0x31,
+	0x48, 0xf5, 0x89, 0x00, 0x19, 0xf1, 0x50, 0xce, 0x13, 0x5c, 0x7b, 0xf0, 0x26, 0x7e, 0xbc, 0x9a,
+	0x84, 0xf6, 0x66, 0xa1, 0xe4, 0x47, 0x84, 0x9f, 0x6b, 0x80, 0xf4, 0x05, 0xdb, 0x87, 0x4e, 0xac,
+	0xe8, 0x7e, 0x00, 0x3d, 0x45, 0x15, 0x90, 0xdb, 0x65, 0x0b, 0x96, 0x72, 0x96, 0xb4, 0x3b, 0x4b,
+	0xbd, 0x51, 0x2d, 0xe0, 0x30, 0x83, 0x7e, 0xbd, 0x44, 0x7e, 0x43, 0xf8, 0xe5, 0x1a, 0x55, 0xfe,
+	0x30, 0x13, 0xb2, 0x69, 0x95, 0x62, 0xa5, 0x5e, 0x93, 0xee, 0x14, 0xb5, 0x31, 0xb8, 0x3f, 0x20,
+	0xfc, 0xac, 0x0e, 0xd9, 0x65, 0x52, 0x71, 0x31, 0xd9, 0xe5, 0x52, 0x91, 0x5b, 0x4e, 0x6b, 0xb1,
+	0xa0, 0xd4, 0x88, 0xb7, 0xf3, 0x1b, 0x18, 0xb8, 0xcf, 0x31, 0xae, 0x07, 0x5c, 0x42, 0x6f, 0x48,
+	0x45, 0x9f, 0x5c, 0xb7, 0x72, 0xbc, 0x10, 0x68, 0x92, 0xb7, 0x9d, 0x75, 0x8b, 0x00, 0x5d, 0x18,
+	0xf1, 0x31, 0xdc, 0xa3, 0xf2, 0xd0, 0x12, 0xe0, 0x42, 0xe0, 0x06, 0xb0, 0xa8, 0x33, 0x00, 0x7f,
+	0x23, 0xfc, 0x5a, 0x0b, 0xd4, 0x47, 0x5c, 0x1c, 0x1e, 0x04, 0xfc, 0xa8, 0xf9, 0x19, 0xf8, 0xb1,
+	0x62, 0x3c, 0xec, 0xd2, 0xa3, 0xf9, 0x92, 0x7d, 0x78, 0x8d, 0xb4, 0xad, 0xfc, 0x1f, 0x65, 0xa3,
+	0x69, 0x3b, 0x57, 0xe4, 0x66, 0x9e, 0xe1, 0x27, 0x84, 0x5f, 0x68, 0x81, 0xea, 0x42, 0x14, 0x30,
+	0x9f, 0x26, 0x81, 0x1d, 0x90, 0x92, 0x0e, 0x40, 0x92, 0x9a, 0x6d, 0xae, 0x0c, 0xb1, 0xe6, 0xad,
+	0x17, 0xf2, 0x30, 0x94, 0x7f, 0x21, 0xfc, 0x6a, 0x0b, 0xd4, 0x07, 0x74, 0x04, 0x32, 0xa2, 0x3e,
+	0x64, 0xe1, 0xee, 0xd9, 0xa6, 0x5a, 0xe7, 0xa2, 0xb9, 0xdb, 0x57, 0x63, 0x96, 0x2a, 0x3c, 0x2d,
+	0x50, 0x8d, 0xf6, 0xdd, 0x2c, 0xf4, 0xa6, 0x6d, 0xb6, 0x6c, 0xbd, 0x5b, 0xe1, 0x59, 0x63, 0x63,
+	0x70, 0xbf, 0x44, 0xf8, 0x89, 0x2e, 0xd0, 0x28, 0x0a, 0x26, 0xcd, 0x31, 0x84, 0x4a, 0x92, 0x77,
+	0x2c, 0xaf, 0xc9, 0x82, 0x46, 0x63, 0x6d, 0xe5, 0x91, 0x1a, 0x94, 0xef, 0x11, 0x26, 0xd5, 0x7e,
+	0xbf, 0x07, 0x54, 0xf8, 0xc3, 0xaa, 0x52, 0x82, 0xed, 0xc7, 0x0a, 0xc8, 0x4d, 0x2b, 0xd3, 0x65,
+	0xa1, 0x86, 0xba, 0x95, 0x5b, 0x6f, 0xc8, 0xbe, 0x46, 0xf8, 0x29, 0x5d, 0x22, 0xeb, 0x41, 0x2c,
+	0x15, 0x08, 0xb2, 0xed, 0x54, 0x58, 0xe7, 0x2a, 0xcd, 0xf4, 0x6e, 0x3e, 0xb1, 0x01, 0xfa, 0x0a,
+	0xe1, 0x27, 0x67, 0xbb, 0x6b, 0x4e, 0xd6, 0x96, 0xc3, 0x91, 0xb8, 0x7c, 0x9c, 0xb6, 0x73, 0x69,
+	0x0d, 0xcd, 0xb7, 0x08, 0x3f, 0x7d, 0x27, 0x16, 0x03, 0x58, 0xe4, 0xb1, 0x7b, 0xc4, 0xcb, 0x32,
+	0x4d, 0x74, 0x23, 0xa7, 0x3a, 0xc5, 0xd4, 0x81, 0x5c, 0x4c, 0x1d, 0x28, 0xc2, 0xd4, 0x81, 0x95,
+	0x4c, 0xc9, 0xcc, 0xd4, 0x85, 0x03, 0x01, 0x72, 0xa8, 0x8b, 0x76, 0xd2, 0x67, 0xa4, 0xe5, 0xcc,
+	0x94, 0x25, 0x75, 0x9b, 0x99, 0xb2, 0x1d, 0x0c, 0xdf, 0x1f, 0x08, 0xbf, 0x72, 0x3f, 0xea, 0x53,
+	0x05, 0x4b, 0x3d, 0xe5, 0x1e, 0x1d, 0x48, 0xd2, 0xb2, 0x4a, 0xb2, 0xc6, 0x41, 0xd3, 0xee, 0x16,
+	0x37, 0x4a, 0x5d, 0x85, 0xde, 0x30, 0x56, 0x7d, 0x7e, 0x14, 0x26, 0xb1, 0x20, 0x2c, 0xaf, 0x42,
+	0x5a, 0xe4, 0x76, 0x15, 0x2e, 0x6b, 0x53, 0x4d, 0xf6, 0x0e, 0x8d, 0xe5, 0x32, 0xb6, 0x65, 0x93,
+	0xcd, 0x16, 0xbb, 0x35, 0xd9, 0x55, 0x1e, 0x86, 0xf2, 0x57, 0x84, 0x5f, 0xba, 0x1f, 0x46, 0xd9,
+	0x9c, 0x0d, 0xbb, 0xcd, 0x09, 0xa3, 0xb5, 0xa4, 0xcd, 0x82, 0x2e, 0x86, 0xf5, 0x67, 0x84, 0x5f,
+	0xd4, 0x85, 0xd0, 0xb4, 0xe0, 0x3a, 0x0f, 0x0f, 0xd8, 0x80, 0xd4, 0x9d, 0xca, 0xe8, 0x25, 0xb5,
+	0x26, 0x6d, 0x14, 0x33, 0x31, 0xa0, 0x0f, 0x10, 0x7e, 0xbe, 0xcd, 0xa4, 0x6a, 0x4c, 0x42, 0x3a,
+	0x62, 0xfe, 0xec, 0xff, 0x7b, 0x30, 0x91, 0xc4, 0xee, 0x72, 0x66, 0x6a, 0x35, 0x64, 0xad, 0x88,
+	0x45, 0x0a, 0x51, 0x3f, 0x88, 0x5e, 0xf3, 0x36, 0xf7, 0x0f, 0x6d, 0x11, 0x33, 0xb5, 0x6e, 0x88,
+	0x2b, 0x2c, 0x0c, 0xe2, 0xef, 0x08, 0x6f, 0x24, 0x8f, 0xb1, 0x74, 0x24, 0xea, 0x43, 0xca, 0x42,
+	0xb2, 0x63, 0xbd, 0x0e, 0xd9, 0x06, 0x1a, 0xb6, 0x55, 0xd8, 0x27, 0x45, 0x9c, 0x35, 0x86, 0xcf,
+	0xc7, 0xa9, 0x9d, 0xdc, 0x73, 0x7c, 0x7a, 0xb6, 0x6a, 0x15, 0xf6, 0x31, 0xc4, 0xdf, 0x21, 0xfc,
+	0x8c, 0xde, 0x87, 0xa4, 0x07, 0xdc, 0x8d, 0x21, 0x06, 0x72, 0xc3, 0x69, 0xff, 0x8c, 0x4e, 0xf3,
+	0xdd, 0xcc, 0x2b, 0x37, 0x58, 0x7f, 0x22, 0xec, 0x9d, 0xbf, 0x2b, 0xd7, 0xf9, 0x28, 0x0a, 0x40,
+	0x41, 0xd5, 0x57, 0x6c, 0xcc, 0xd4, 0x24, 0x09, 0x96, 0xb5, 0xc9, 0x7b, 0x7d, 0xf2, 0xbe, 0xfd,
+	0x0b, 0xf7, 0x4a, 0x13, 0x0d, 0xbc, 0x77, 0x25, 0x5e, 0xa9, 0x63, 0x70, 0x1e, 0xbc, 0x43, 0x59,
+	0xb0, 0x4c, 0xee, 0xf0, 0xa9, 0x20, 0xd3, 0xc0, 0xed, 0x18, 0xac, 0xf3, 0x49, 0x55, 0xd6, 0xd9,
+	0x21, 0x81, 0xba, 0xe0, 0x52, 0xce, 0xc7, 0xcc, 0x24, 0xd4, 0xb2, 0xb2, 0xae, 0x50, 0xbb, 0x55,
+	0xd6, 0x95, 0x26, 0xa9, 0xa6, 0xda, 0x05, 0x09, 0x61, 0x7f, 0xe1, 0x5d, 0x66, 0x36, 0x39, 0xd5,
+	0x2c, 0xe7, 0x9e, 0x2c, 0xb1, 0x5b, 0x53, 0x5d, 0xe5, 0xa1, 0x29, 0x6b, 0xc1, 0xf1, 0x89, 0x57,
+	0x7a, 0x78, 0xe2, 0x95, 0xce, 0x4e, 0x3c, 0xf4, 0xc5, 0xd4, 0x43, 0xbf, 0x4c, 0x3d, 0xf4, 0xcf,
+	0xd4, 0x43, 0xc7, 0x53, 0x0f, 0xfd, 0x3b, 0xf5, 0xd0, 0x7f, 0x53, 0xaf, 0x74, 0x36, 0xf5, 0xd0,
+	0x37, 0xa7, 0x5e, 0xe9, 0xf8, 0xd4, 0x2b, 0x3d, 0x3c, 0xf5, 0x4a, 0x1f, 0x5f, 0x1f, 0xf0, 0x8b,
+	0xf4, 0x8c, 0xaf, 0xf9, 0x34, 0xb7, 0xbd, 0xf8, 0x7b, 0xff, 0xb1, 0xf3, 0xef, 0x72, 0x6f, 0xfd,
+	0x3f, 0x00, 0xd4, 0x3e, 0xf6, 0x58, 0x2d, 0x14, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -111,6 +132,9 @@ const _ = grpc.SupportPackageIsVersion4
 type AdminServiceClient interface {
 	// DescribeWorkflowExecution returns information about the internal states of workflow execution.
 	DescribeMutableState(ctx context.Context, in *DescribeMutableStateRequest, opts ...grpc.CallOption) (*DescribeMutableStateResponse, error)
+	// BatchDescribeMutableState returns summarized mutable state of a list of workflow executions.
+	// Executions are fanned out to the owning history shards internally.
+	BatchDescribeMutableState(ctx context.Context, in *BatchDescribeMutableStateRequest, opts ...grpc.CallOption) (*BatchDescribeMutableStateResponse, error)
 	// DescribeHistoryHost returns information about the internal states of a history host
 	DescribeHistoryHost(ctx context.Context, in *DescribeHistoryHostRequest, opts ...grpc.CallOption) (*DescribeHistoryHostResponse, error)
 	CloseShard(ctx context.Context, in *CloseShardRequest, opts ...grpc.CallOption) (*CloseShardResponse, error)
@@ -142,6 +166,37 @@ type AdminServiceClient interface {
 	MergeDLQMessages(ctx context.Context, in *MergeDLQMessagesRequest, opts ...grpc.CallOption) (*MergeDLQMessagesResponse, error)
 	// RefreshWorkflowTasks refreshes all tasks of a workflow.
 	RefreshWorkflowTasks(ctx context.Context, in *RefreshWorkflowTasksRequest, opts ...grpc.CallOption) (*RefreshWorkflowTasksResponse, error)
+	// UpdateWorkflowExecutionTags upserts and removes non-indexed tags of a running workflow.
+	UpdateWorkflowExecutionTags(ctx context.Context, in *UpdateWorkflowExecutionTagsRequest, opts ...grpc.CallOption) (*UpdateWorkflowExecutionTagsResponse, error)
+	// ShutdownWorker is called by a worker on graceful shutdown to remove its pollers from task queue bookkeeping.
+	ShutdownWorker(ctx context.Context, in *ShutdownWorkerRequest, opts ...grpc.CallOption) (*ShutdownWorkerResponse, error)
+	// PauseWorkflowExecution stops dispatching workflow tasks of a running workflow to workers, e.g. during incidents.
+	PauseWorkflowExecution(ctx context.Context, in *PauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*PauseWorkflowExecutionResponse, error)
+	// UnpauseWorkflowExecution resumes dispatching workflow tasks of a paused workflow.
+	UnpauseWorkflowExecution(ctx context.Context, in *UnpauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*UnpauseWorkflowExecutionResponse, error)
+	// DescribeNamespaceConfig returns the effective configuration applied to a namespace, including dynamic config overrides.
+	DescribeNamespaceConfig(ctx context.Context, in *DescribeNamespaceConfigRequest, opts ...grpc.CallOption) (*DescribeNamespaceConfigResponse, error)
+	// ListDynamicConfigKeys returns the dynamic config keys known to the server, with their value types, defaults,
+	// filters and descriptions.
+	ListDynamicConfigKeys(ctx context.Context, in *ListDynamicConfigKeysRequest, opts ...grpc.CallOption) (*ListDynamicConfigKeysResponse, error)
+	// DescribeWorkflowLocks returns hold times and queue lengths of contended workflow execution locks in the history cache of a shard.
+	DescribeWorkflowLocks(ctx context.Context, in *DescribeWorkflowLocksRequest, opts ...grpc.CallOption) (*DescribeWorkflowLocksResponse, error)
+	// ListWorkflowExecutionChain returns the runs of the continue-as-new, retry and cron chain of a workflow, latest run first.
+	ListWorkflowExecutionChain(ctx context.Context, in *ListWorkflowExecutionChainRequest, opts ...grpc.CallOption) (*ListWorkflowExecutionChainResponse, error)
+	// GetWorkflowExecutionEvents returns a single event or a contiguous event ID range from the current branch of a workflow run,
+	// without paging through the history from the first event.
+	GetWorkflowExecutionEvents(ctx context.Context, in *GetWorkflowExecutionEventsRequest, opts ...grpc.CallOption) (*GetWorkflowExecutionEventsResponse, error)
+	// DescribeTaskQueue returns the pollers, status and sync match stats of the root partition of a task queue.
+	DescribeTaskQueue(ctx context.Context, in *DescribeTaskQueueRequest, opts ...grpc.CallOption) (*DescribeTaskQueueResponse, error)
+	// BatchCompleteActivityTasksById completes multiple activity tasks of a namespace by workflow and activity ID in a single call.
+	// Each completion is applied independently, failed completions are reported in the response without failing the call.
+	BatchCompleteActivityTasksById(ctx context.Context, in *BatchCompleteActivityTasksByIdRequest, opts ...grpc.CallOption) (*BatchCompleteActivityTasksByIdResponse, error)
+	// BatchFailActivityTasksById fails multiple activity tasks of a namespace by workflow and activity ID in a single call.
+	// Each failure is applied independently, failed requests are reported in the response without failing the call.
+	BatchFailActivityTasksById(ctx context.Context, in *BatchFailActivityTasksByIdRequest, opts ...grpc.CallOption) (*BatchFailActivityTasksByIdResponse, error)
+	// ExecuteCrossClusterTask applies a task sent by a remote cluster to a workflow of a namespace active in the current cluster,
+	// such as starting a child workflow or signaling a workflow on behalf of a workflow of a namespace active in the remote cluster.
+	ExecuteCrossClusterTask(ctx context.Context, in *ExecuteCrossClusterTaskRequest, opts ...grpc.CallOption) (*ExecuteCrossClusterTaskResponse, error)
 	// ResendReplicationTasks requests replication tasks from remote cluster and apply tasks to current cluster.
 	ResendReplicationTasks(ctx context.Context, in *ResendReplicationTasksRequest, opts ...grpc.CallOption) (*ResendReplicationTasksResponse, error)
 }
@@ -163,6 +218,15 @@ func (c *adminServiceClient) DescribeMutableState(ctx context.Context, in *Descr
 	return out, nil
 }
 
+func (c *adminServiceClient) BatchDescribeMutableState(ctx context.Context, in *BatchDescribeMutableStateRequest, opts ...grpc.CallOption) (*BatchDescribeMutableStateResponse, error) {
+	out := new(BatchDescribeMutableStateResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/BatchDescribeMutableState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) DescribeHistoryHost(ctx context.Context, in *DescribeHistoryHostRequest, opts ...grpc.CallOption) (*DescribeHistoryHostResponse, error) {
 	out := new(DescribeHistoryHostResponse)
 	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DescribeHistoryHost", in, out, opts...)
@@ -289,6 +353,123 @@ func (c *adminServiceClient) RefreshWorkflowTasks(ctx context.Context, in *Refre
 	return out, nil
 }
 
+func (c *adminServiceClient) UpdateWorkflowExecutionTags(ctx context.Context, in *UpdateWorkflowExecutionTagsRequest, opts ...grpc.CallOption) (*UpdateWorkflowExecutionTagsResponse, error) {
+	out := new(UpdateWorkflowExecutionTagsResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/UpdateWorkflowExecutionTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ShutdownWorker(ctx context.Context, in *ShutdownWorkerRequest, opts ...grpc.CallOption) (*ShutdownWorkerResponse, error) {
+	out := new(ShutdownWorkerResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ShutdownWorker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) PauseWorkflowExecution(ctx context.Context, in *PauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*PauseWorkflowExecutionResponse, error) {
+	out := new(PauseWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/PauseWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UnpauseWorkflowExecution(ctx context.Context, in *UnpauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*UnpauseWorkflowExecutionResponse, error) {
+	out := new(UnpauseWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/UnpauseWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeNamespaceConfig(ctx context.Context, in *DescribeNamespaceConfigRequest, opts ...grpc.CallOption) (*DescribeNamespaceConfigResponse, error) {
+	out := new(DescribeNamespaceConfigResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DescribeNamespaceConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListDynamicConfigKeys(ctx context.Context, in *ListDynamicConfigKeysRequest, opts ...grpc.CallOption) (*ListDynamicConfigKeysResponse, error) {
+	out := new(ListDynamicConfigKeysResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ListDynamicConfigKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeWorkflowLocks(ctx context.Context, in *DescribeWorkflowLocksRequest, opts ...grpc.CallOption) (*DescribeWorkflowLocksResponse, error) {
+	out := new(DescribeWorkflowLocksResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DescribeWorkflowLocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListWorkflowExecutionChain(ctx context.Context, in *ListWorkflowExecutionChainRequest, opts ...grpc.CallOption) (*ListWorkflowExecutionChainResponse, error) {
+	out := new(ListWorkflowExecutionChainResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ListWorkflowExecutionChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetWorkflowExecutionEvents(ctx context.Context, in *GetWorkflowExecutionEventsRequest, opts ...grpc.CallOption) (*GetWorkflowExecutionEventsResponse, error) {
+	out := new(GetWorkflowExecutionEventsResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/GetWorkflowExecutionEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeTaskQueue(ctx context.Context, in *DescribeTaskQueueRequest, opts ...grpc.CallOption) (*DescribeTaskQueueResponse, error) {
+	out := new(DescribeTaskQueueResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DescribeTaskQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) BatchCompleteActivityTasksById(ctx context.Context, in *BatchCompleteActivityTasksByIdRequest, opts ...grpc.CallOption) (*BatchCompleteActivityTasksByIdResponse, error) {
+	out := new(BatchCompleteActivityTasksByIdResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/BatchCompleteActivityTasksById", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) BatchFailActivityTasksById(ctx context.Context, in *BatchFailActivityTasksByIdRequest, opts ...grpc.CallOption) (*BatchFailActivityTasksByIdResponse, error) {
+	out := new(BatchFailActivityTasksByIdResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/BatchFailActivityTasksById", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ExecuteCrossClusterTask(ctx context.Context, in *ExecuteCrossClusterTaskRequest, opts ...grpc.CallOption) (*ExecuteCrossClusterTaskResponse, error) {
+	out := new(ExecuteCrossClusterTaskResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ExecuteCrossClusterTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) ResendReplicationTasks(ctx context.Context, in *ResendReplicationTasksRequest, opts ...grpc.CallOption) (*ResendReplicationTasksResponse, error) {
 	out := new(ResendReplicationTasksResponse)
 	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ResendReplicationTasks", in, out, opts...)
@@ -302,6 +483,9 @@ func (c *adminServiceClient) ResendReplicationTasks(ctx context.Context, in *Res
 type AdminServiceServer interface {
 	// DescribeWorkflowExecution returns information about the internal states of workflow execution.
 	DescribeMutableState(context.Context, *DescribeMutableStateRequest) (*DescribeMutableStateResponse, error)
+	// BatchDescribeMutableState returns summarized mutable state of a list of workflow executions.
+	// Executions are fanned out to the owning history shards internally.
+	BatchDescribeMutableState(context.Context, *BatchDescribeMutableStateRequest) (*BatchDescribeMutableStateResponse, error)
 	// DescribeHistoryHost returns information about the internal states of a history host
 	DescribeHistoryHost(context.Context, *DescribeHistoryHostRequest) (*DescribeHistoryHostResponse, error)
 	CloseShard(context.Context, *CloseShardRequest) (*CloseShardResponse, error)
@@ -333,6 +517,37 @@ type AdminServiceServer interface {
 	MergeDLQMessages(context.Context, *MergeDLQMessagesRequest) (*MergeDLQMessagesResponse, error)
 	// RefreshWorkflowTasks refreshes all tasks of a workflow.
 	RefreshWorkflowTasks(context.Context, *RefreshWorkflowTasksRequest) (*RefreshWorkflowTasksResponse, error)
+	// UpdateWorkflowExecutionTags upserts and removes non-indexed tags of a running workflow.
+	UpdateWorkflowExecutionTags(context.Context, *UpdateWorkflowExecutionTagsRequest) (*UpdateWorkflowExecutionTagsResponse, error)
+	// ShutdownWorker is called by a worker on graceful shutdown to remove its pollers from task queue bookkeeping.
+	ShutdownWorker(context.Context, *ShutdownWorkerRequest) (*ShutdownWorkerResponse, error)
+	// PauseWorkflowExecution stops dispatching workflow tasks of a running workflow to workers, e.g. during incidents.
+	PauseWorkflowExecution(context.Context, *PauseWorkflowExecutionRequest) (*PauseWorkflowExecutionResponse, error)
+	// UnpauseWorkflowExecution resumes dispatching workflow tasks of a paused workflow.
+	UnpauseWorkflowExecution(context.Context, *UnpauseWorkflowExecutionRequest) (*UnpauseWorkflowExecutionResponse, error)
+	// DescribeNamespaceConfig returns the effective configuration applied to a namespace, including dynamic config overrides.
+	DescribeNamespaceConfig(context.Context, *DescribeNamespaceConfigRequest) (*DescribeNamespaceConfigResponse, error)
+	// ListDynamicConfigKeys returns the dynamic config keys known to the server, with their value types, defaults,
+	// filters and descriptions.
+	ListDynamicConfigKeys(context.Context, *ListDynamicConfigKeysRequest) (*ListDynamicConfigKeysResponse, error)
+	// DescribeWorkflowLocks returns hold times and queue lengths of contended workflow execution locks in the history cache of a shard.
+	DescribeWorkflowLocks(context.Context, *DescribeWorkflowLocksRequest) (*DescribeWorkflowLocksResponse, error)
+	// ListWorkflowExecutionChain returns the runs of the continue-as-new, retry and cron chain of a workflow, latest run first.
+	ListWorkflowExecutionChain(context.Context, *ListWorkflowExecutionChainRequest) (*ListWorkflowExecutionChainResponse, error)
+	// GetWorkflowExecutionEvents returns a single event or a contiguous event ID range from the current branch of a workflow run,
+	// without paging through the history from the first event.
+	GetWorkflowExecutionEvents(context.Context, *GetWorkflowExecutionEventsRequest) (*GetWorkflowExecutionEventsResponse, error)
+	// DescribeTaskQueue returns the pollers, status and sync match stats of the root partition of a task queue.
+	DescribeTaskQueue(context.Context, *DescribeTaskQueueRequest) (*DescribeTaskQueueResponse, error)
+	// BatchCompleteActivityTasksById completes multiple activity tasks of a namespace by workflow and activity ID in a single call.
+	// Each completion is applied independently, failed completions are reported in the response without failing the call.
+	BatchCompleteActivityTasksById(context.Context, *BatchCompleteActivityTasksByIdRequest) (*BatchCompleteActivityTasksByIdResponse, error)
+	// BatchFailActivityTasksById fails multiple activity tasks of a namespace by workflow and activity ID in a single call.
+	// Each failure is applied independently, failed requests are reported in the response without failing the call.
+	BatchFailActivityTasksById(context.Context, *BatchFailActivityTasksByIdRequest) (*BatchFailActivityTasksByIdResponse, error)
+	// ExecuteCrossClusterTask applies a task sent by a remote cluster to a workflow of a namespace active in the current cluster,
+	// such as starting a child workflow or signaling a workflow on behalf of a workflow of a namespace active in the remote cluster.
+	ExecuteCrossClusterTask(context.Context, *ExecuteCrossClusterTaskRequest) (*ExecuteCrossClusterTaskResponse, error)
 	// ResendReplicationTasks requests replication tasks from remote cluster and apply tasks to current cluster.
 	ResendReplicationTasks(context.Context, *ResendReplicationTasksRequest) (*ResendReplicationTasksResponse, error)
 }
@@ -344,6 +559,9 @@ type UnimplementedAdminServiceServer struct {
 func (*UnimplementedAdminServiceServer) DescribeMutableState(ctx context.Context, req *DescribeMutableStateRequest) (*DescribeMutableStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeMutableState not implemented")
 }
+func (*UnimplementedAdminServiceServer) BatchDescribeMutableState(ctx context.Context, req *BatchDescribeMutableStateRequest) (*BatchDescribeMutableStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDescribeMutableState not implemented")
+}
 func (*UnimplementedAdminServiceServer) DescribeHistoryHost(ctx context.Context, req *DescribeHistoryHostRequest) (*DescribeHistoryHostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeHistoryHost not implemented")
 }
@@ -386,6 +604,45 @@ func (*UnimplementedAdminServiceServer) MergeDLQMessages(ctx context.Context, re
 func (*UnimplementedAdminServiceServer) RefreshWorkflowTasks(ctx context.Context, req *RefreshWorkflowTasksRequest) (*RefreshWorkflowTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RefreshWorkflowTasks not implemented")
 }
+func (*UnimplementedAdminServiceServer) UpdateWorkflowExecutionTags(ctx context.Context, req *UpdateWorkflowExecutionTagsRequest) (*UpdateWorkflowExecutionTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkflowExecutionTags not implemented")
+}
+func (*UnimplementedAdminServiceServer) ShutdownWorker(ctx context.Context, req *ShutdownWorkerRequest) (*ShutdownWorkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShutdownWorker not implemented")
+}
+func (*UnimplementedAdminServiceServer) PauseWorkflowExecution(ctx context.Context, req *PauseWorkflowExecutionRequest) (*PauseWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseWorkflowExecution not implemented")
+}
+func (*UnimplementedAdminServiceServer) UnpauseWorkflowExecution(ctx context.Context, req *UnpauseWorkflowExecutionRequest) (*UnpauseWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseWorkflowExecution not implemented")
+}
+func (*UnimplementedAdminServiceServer) DescribeNamespaceConfig(ctx context.Context, req *DescribeNamespaceConfigRequest) (*DescribeNamespaceConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeNamespaceConfig not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListDynamicConfigKeys(ctx context.Context, req *ListDynamicConfigKeysRequest) (*ListDynamicConfigKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDynamicConfigKeys not implemented")
+}
+func (*UnimplementedAdminServiceServer) DescribeWorkflowLocks(ctx context.Context, req *DescribeWorkflowLocksRequest) (*DescribeWorkflowLocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeWorkflowLocks not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListWorkflowExecutionChain(ctx context.Context, req *ListWorkflowExecutionChainRequest) (*ListWorkflowExecutionChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkflowExecutionChain not implemented")
+}
+func (*UnimplementedAdminServiceServer) GetWorkflowExecutionEvents(ctx context.Context, req *GetWorkflowExecutionEventsRequest) (*GetWorkflowExecutionEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowExecutionEvents not implemented")
+}
+func (*UnimplementedAdminServiceServer) DescribeTaskQueue(ctx context.Context, req *DescribeTaskQueueRequest) (*DescribeTaskQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeTaskQueue not implemented")
+}
+func (*UnimplementedAdminServiceServer) BatchCompleteActivityTasksById(ctx context.Context, req *BatchCompleteActivityTasksByIdRequest) (*BatchCompleteActivityTasksByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCompleteActivityTasksById not implemented")
+}
+func (*UnimplementedAdminServiceServer) BatchFailActivityTasksById(ctx context.Context, req *BatchFailActivityTasksByIdRequest) (*BatchFailActivityTasksByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchFailActivityTasksById not implemented")
+}
+func (*UnimplementedAdminServiceServer) ExecuteCrossClusterTask(ctx context.Context, req *ExecuteCrossClusterTaskRequest) (*ExecuteCrossClusterTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteCrossClusterTask not implemented")
+}
 func (*UnimplementedAdminServiceServer) ResendReplicationTasks(ctx context.Context, req *ResendReplicationTasksRequest) (*ResendReplicationTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResendReplicationTasks not implemented")
 }
@@ -412,6 +669,24 @@ func _AdminService_DescribeMutableState_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_BatchDescribeMutableState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDescribeMutableStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).BatchDescribeMutableState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/BatchDescribeMutableState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).BatchDescribeMutableState(ctx, req.(*BatchDescribeMutableStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_DescribeHistoryHost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DescribeHistoryHostRequest)
 	if err := dec(in); err != nil {
@@ -664,6 +939,240 @@ func _AdminService_RefreshWorkflowTasks_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_UpdateWorkflowExecutionTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWorkflowExecutionTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateWorkflowExecutionTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/UpdateWorkflowExecutionTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateWorkflowExecutionTags(ctx, req.(*UpdateWorkflowExecutionTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ShutdownWorker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShutdownWorkerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ShutdownWorker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ShutdownWorker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ShutdownWorker(ctx, req.(*ShutdownWorkerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_PauseWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PauseWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/PauseWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PauseWorkflowExecution(ctx, req.(*PauseWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UnpauseWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpauseWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UnpauseWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/UnpauseWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UnpauseWorkflowExecution(ctx, req.(*UnpauseWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeNamespaceConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeNamespaceConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeNamespaceConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribeNamespaceConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeNamespaceConfig(ctx, req.(*DescribeNamespaceConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListDynamicConfigKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDynamicConfigKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListDynamicConfigKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ListDynamicConfigKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListDynamicConfigKeys(ctx, req.(*ListDynamicConfigKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeWorkflowLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeWorkflowLocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeWorkflowLocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribeWorkflowLocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeWorkflowLocks(ctx, req.(*DescribeWorkflowLocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListWorkflowExecutionChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkflowExecutionChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListWorkflowExecutionChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ListWorkflowExecutionChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListWorkflowExecutionChain(ctx, req.(*ListWorkflowExecutionChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetWorkflowExecutionEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkflowExecutionEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetWorkflowExecutionEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetWorkflowExecutionEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetWorkflowExecutionEvents(ctx, req.(*GetWorkflowExecutionEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeTaskQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeTaskQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeTaskQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribeTaskQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeTaskQueue(ctx, req.(*DescribeTaskQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_BatchCompleteActivityTasksById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCompleteActivityTasksByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).BatchCompleteActivityTasksById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/BatchCompleteActivityTasksById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).BatchCompleteActivityTasksById(ctx, req.(*BatchCompleteActivityTasksByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_BatchFailActivityTasksById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchFailActivityTasksByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).BatchFailActivityTasksById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/BatchFailActivityTasksById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).BatchFailActivityTasksById(ctx, req.(*BatchFailActivityTasksByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ExecuteCrossClusterTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteCrossClusterTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ExecuteCrossClusterTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ExecuteCrossClusterTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ExecuteCrossClusterTask(ctx, req.(*ExecuteCrossClusterTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_ResendReplicationTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ResendReplicationTasksRequest)
 	if err := dec(in); err != nil {
@@ -690,6 +1199,10 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DescribeMutableState",
 			Handler:    _AdminService_DescribeMutableState_Handler,
 		},
+		{
+			MethodName: "BatchDescribeMutableState",
+			Handler:    _AdminService_BatchDescribeMutableState_Handler,
+		},
 		{
 			MethodName: "DescribeHistoryHost",
 			Handler:    _AdminService_DescribeHistoryHost_Handler,
@@ -746,6 +1259,58 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RefreshWorkflowTasks",
 			Handler:    _AdminService_RefreshWorkflowTasks_Handler,
 		},
+		{
+			MethodName: "UpdateWorkflowExecutionTags",
+			Handler:    _AdminService_UpdateWorkflowExecutionTags_Handler,
+		},
+		{
+			MethodName: "ShutdownWorker",
+			Handler:    _AdminService_ShutdownWorker_Handler,
+		},
+		{
+			MethodName: "PauseWorkflowExecution",
+			Handler:    _AdminService_PauseWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "UnpauseWorkflowExecution",
+			Handler:    _AdminService_UnpauseWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "DescribeNamespaceConfig",
+			Handler:    _AdminService_DescribeNamespaceConfig_Handler,
+		},
+		{
+			MethodName: "ListDynamicConfigKeys",
+			Handler:    _AdminService_ListDynamicConfigKeys_Handler,
+		},
+		{
+			MethodName: "DescribeWorkflowLocks",
+			Handler:    _AdminService_DescribeWorkflowLocks_Handler,
+		},
+		{
+			MethodName: "ListWorkflowExecutionChain",
+			Handler:    _AdminService_ListWorkflowExecutionChain_Handler,
+		},
+		{
+			MethodName: "GetWorkflowExecutionEvents",
+			Handler:    _AdminService_GetWorkflowExecutionEvents_Handler,
+		},
+		{
+			MethodName: "DescribeTaskQueue",
+			Handler:    _AdminService_DescribeTaskQueue_Handler,
+		},
+		{
+			MethodName: "BatchCompleteActivityTasksById",
+			Handler:    _AdminService_BatchCompleteActivityTasksById_Handler,
+		},
+		{
+			MethodName: "BatchFailActivityTasksById",
+			Handler:    _AdminService_BatchFailActivityTasksById_Handler,
+		},
+		{
+			MethodName: "ExecuteCrossClusterTask",
+			Handler:    _AdminService_ExecuteCrossClusterTask_Handler,
+		},
 		{
 			MethodName: "ResendReplicationTasks",
 			Handler:    _AdminService_ResendReplicationTasks_Handler,
@@ -80,6 +80,66 @@ func (mr *MockAdminServiceClientMockRecorder) AddSearchAttribute(ctx, in interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSearchAttribute", reflect.TypeOf((*MockAdminServiceClient)(nil).AddSearchAttribute), varargs...)
 }
 
+// BatchCompleteActivityTasksById mocks base method.
+func (m *MockAdminServiceClient) BatchCompleteActivityTasksById(ctx context.Context, in *adminservice.BatchCompleteActivityTasksByIdRequest, opts ...grpc.CallOption) (*adminservice.BatchCompleteActivityTasksByIdResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BatchCompleteActivityTasksById", varargs...)
+	ret0, _ := ret[0].(*adminservice.BatchCompleteActivityTasksByIdResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchCompleteActivityTasksById indicates an expected call of BatchCompleteActivityTasksById.
+func (mr *MockAdminServiceClientMockRecorder) BatchCompleteActivityTasksById(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchCompleteActivityTasksById", reflect.TypeOf((*MockAdminServiceClient)(nil).BatchCompleteActivityTasksById), varargs...)
+}
+
+// BatchDescribeMutableState mocks base method.
+func (m *MockAdminServiceClient) BatchDescribeMutableState(ctx context.Context, in *adminservice.BatchDescribeMutableStateRequest, opts ...grpc.CallOption) (*adminservice.BatchDescribeMutableStateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BatchDescribeMutableState", varargs...)
+	ret0, _ := ret[0].(*adminservice.BatchDescribeMutableStateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchDescribeMutableState indicates an expected call of BatchDescribeMutableState.
+func (mr *MockAdminServiceClientMockRecorder) BatchDescribeMutableState(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchDescribeMutableState", reflect.TypeOf((*MockAdminServiceClient)(nil).BatchDescribeMutableState), varargs...)
+}
+
+// BatchFailActivityTasksById mocks base method.
+func (m *MockAdminServiceClient) BatchFailActivityTasksById(ctx context.Context, in *adminservice.BatchFailActivityTasksByIdRequest, opts ...grpc.CallOption) (*adminservice.BatchFailActivityTasksByIdResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BatchFailActivityTasksById", varargs...)
+	ret0, _ := ret[0].(*adminservice.BatchFailActivityTasksByIdResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchFailActivityTasksById indicates an expected call of BatchFailActivityTasksById.
+func (mr *MockAdminServiceClientMockRecorder) BatchFailActivityTasksById(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchFailActivityTasksById", reflect.TypeOf((*MockAdminServiceClient)(nil).BatchFailActivityTasksById), varargs...)
+}
+
 // CloseShard mocks base method.
 func (m *MockAdminServiceClient) CloseShard(ctx context.Context, in *adminservice.CloseShardRequest, opts ...grpc.CallOption) (*adminservice.CloseShardResponse, error) {
 	m.ctrl.T.Helper()
@@ -160,6 +220,86 @@ func (mr *MockAdminServiceClientMockRecorder) DescribeMutableState(ctx, in inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeMutableState), varargs...)
 }
 
+// DescribeNamespaceConfig mocks base method.
+func (m *MockAdminServiceClient) DescribeNamespaceConfig(ctx context.Context, in *adminservice.DescribeNamespaceConfigRequest, opts ...grpc.CallOption) (*adminservice.DescribeNamespaceConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeNamespaceConfig", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeNamespaceConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeNamespaceConfig indicates an expected call of DescribeNamespaceConfig.
+func (mr *MockAdminServiceClientMockRecorder) DescribeNamespaceConfig(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeNamespaceConfig", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeNamespaceConfig), varargs...)
+}
+
+// DescribeTaskQueue mocks base method.
+func (m *MockAdminServiceClient) DescribeTaskQueue(ctx context.Context, in *adminservice.DescribeTaskQueueRequest, opts ...grpc.CallOption) (*adminservice.DescribeTaskQueueResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeTaskQueue", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeTaskQueueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTaskQueue indicates an expected call of DescribeTaskQueue.
+func (mr *MockAdminServiceClientMockRecorder) DescribeTaskQueue(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueue", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeTaskQueue), varargs...)
+}
+
+// DescribeWorkflowLocks mocks base method.
+func (m *MockAdminServiceClient) DescribeWorkflowLocks(ctx context.Context, in *adminservice.DescribeWorkflowLocksRequest, opts ...grpc.CallOption) (*adminservice.DescribeWorkflowLocksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeWorkflowLocks", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeWorkflowLocksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeWorkflowLocks indicates an expected call of DescribeWorkflowLocks.
+func (mr *MockAdminServiceClientMockRecorder) DescribeWorkflowLocks(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkflowLocks", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeWorkflowLocks), varargs...)
+}
+
+// ExecuteCrossClusterTask mocks base method.
+func (m *MockAdminServiceClient) ExecuteCrossClusterTask(ctx context.Context, in *adminservice.ExecuteCrossClusterTaskRequest, opts ...grpc.CallOption) (*adminservice.ExecuteCrossClusterTaskResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExecuteCrossClusterTask", varargs...)
+	ret0, _ := ret[0].(*adminservice.ExecuteCrossClusterTaskResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteCrossClusterTask indicates an expected call of ExecuteCrossClusterTask.
+func (mr *MockAdminServiceClientMockRecorder) ExecuteCrossClusterTask(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteCrossClusterTask", reflect.TypeOf((*MockAdminServiceClient)(nil).ExecuteCrossClusterTask), varargs...)
+}
+
 // GetDLQMessages mocks base method.
 func (m *MockAdminServiceClient) GetDLQMessages(ctx context.Context, in *adminservice.GetDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.GetDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -240,6 +380,26 @@ func (mr *MockAdminServiceClientMockRecorder) GetReplicationMessages(ctx, in int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).GetReplicationMessages), varargs...)
 }
 
+// GetWorkflowExecutionEvents mocks base method.
+func (m *MockAdminServiceClient) GetWorkflowExecutionEvents(ctx context.Context, in *adminservice.GetWorkflowExecutionEventsRequest, opts ...grpc.CallOption) (*adminservice.GetWorkflowExecutionEventsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetWorkflowExecutionEvents", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetWorkflowExecutionEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowExecutionEvents indicates an expected call of GetWorkflowExecutionEvents.
+func (mr *MockAdminServiceClientMockRecorder) GetWorkflowExecutionEvents(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionEvents", reflect.TypeOf((*MockAdminServiceClient)(nil).GetWorkflowExecutionEvents), varargs...)
+}
+
 // GetWorkflowExecutionRawHistoryV2 mocks base method.
 func (m *MockAdminServiceClient) GetWorkflowExecutionRawHistoryV2(ctx context.Context, in *adminservice.GetWorkflowExecutionRawHistoryV2Request, opts ...grpc.CallOption) (*adminservice.GetWorkflowExecutionRawHistoryV2Response, error) {
 	m.ctrl.T.Helper()
@@ -260,6 +420,46 @@ func (mr *MockAdminServiceClientMockRecorder) GetWorkflowExecutionRawHistoryV2(c
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionRawHistoryV2", reflect.TypeOf((*MockAdminServiceClient)(nil).GetWorkflowExecutionRawHistoryV2), varargs...)
 }
 
+// ListDynamicConfigKeys mocks base method.
+func (m *MockAdminServiceClient) ListDynamicConfigKeys(ctx context.Context, in *adminservice.ListDynamicConfigKeysRequest, opts ...grpc.CallOption) (*adminservice.ListDynamicConfigKeysResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDynamicConfigKeys", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListDynamicConfigKeysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDynamicConfigKeys indicates an expected call of ListDynamicConfigKeys.
+func (mr *MockAdminServiceClientMockRecorder) ListDynamicConfigKeys(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfigKeys", reflect.TypeOf((*MockAdminServiceClient)(nil).ListDynamicConfigKeys), varargs...)
+}
+
+// ListWorkflowExecutionChain mocks base method.
+func (m *MockAdminServiceClient) ListWorkflowExecutionChain(ctx context.Context, in *adminservice.ListWorkflowExecutionChainRequest, opts ...grpc.CallOption) (*adminservice.ListWorkflowExecutionChainResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListWorkflowExecutionChain", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListWorkflowExecutionChainResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkflowExecutionChain indicates an expected call of ListWorkflowExecutionChain.
+func (mr *MockAdminServiceClientMockRecorder) ListWorkflowExecutionChain(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkflowExecutionChain", reflect.TypeOf((*MockAdminServiceClient)(nil).ListWorkflowExecutionChain), varargs...)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockAdminServiceClient) MergeDLQMessages(ctx context.Context, in *adminservice.MergeDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -280,6 +480,26 @@ func (mr *MockAdminServiceClientMockRecorder) MergeDLQMessages(ctx, in interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).MergeDLQMessages), varargs...)
 }
 
+// PauseWorkflowExecution mocks base method.
+func (m *MockAdminServiceClient) PauseWorkflowExecution(ctx context.Context, in *adminservice.PauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.PauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*adminservice.PauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseWorkflowExecution indicates an expected call of PauseWorkflowExecution.
+func (mr *MockAdminServiceClientMockRecorder) PauseWorkflowExecution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).PauseWorkflowExecution), varargs...)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockAdminServiceClient) PurgeDLQMessages(ctx context.Context, in *adminservice.PurgeDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.PurgeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -380,6 +600,66 @@ func (mr *MockAdminServiceClientMockRecorder) ResendReplicationTasks(ctx, in int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ResendReplicationTasks), varargs...)
 }
 
+// ShutdownWorker mocks base method.
+func (m *MockAdminServiceClient) ShutdownWorker(ctx context.Context, in *adminservice.ShutdownWorkerRequest, opts ...grpc.CallOption) (*adminservice.ShutdownWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ShutdownWorker", varargs...)
+	ret0, _ := ret[0].(*adminservice.ShutdownWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShutdownWorker indicates an expected call of ShutdownWorker.
+func (mr *MockAdminServiceClientMockRecorder) ShutdownWorker(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShutdownWorker", reflect.TypeOf((*MockAdminServiceClient)(nil).ShutdownWorker), varargs...)
+}
+
+// UnpauseWorkflowExecution mocks base method.
+func (m *MockAdminServiceClient) UnpauseWorkflowExecution(ctx context.Context, in *adminservice.UnpauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.UnpauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnpauseWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*adminservice.UnpauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseWorkflowExecution indicates an expected call of UnpauseWorkflowExecution.
+func (mr *MockAdminServiceClientMockRecorder) UnpauseWorkflowExecution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).UnpauseWorkflowExecution), varargs...)
+}
+
+// UpdateWorkflowExecutionTags mocks base method.
+func (m *MockAdminServiceClient) UpdateWorkflowExecutionTags(ctx context.Context, in *adminservice.UpdateWorkflowExecutionTagsRequest, opts ...grpc.CallOption) (*adminservice.UpdateWorkflowExecutionTagsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateWorkflowExecutionTags", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateWorkflowExecutionTagsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkflowExecutionTags indicates an expected call of UpdateWorkflowExecutionTags.
+func (mr *MockAdminServiceClientMockRecorder) UpdateWorkflowExecutionTags(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowExecutionTags", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateWorkflowExecutionTags), varargs...)
+}
+
 // MockAdminServiceServer is a mock of AdminServiceServer interface.
 type MockAdminServiceServer struct {
 	ctrl     *gomock.Controller
@@ -418,6 +698,51 @@ func (mr *MockAdminServiceServerMockRecorder) AddSearchAttribute(arg0, arg1 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSearchAttribute", reflect.TypeOf((*MockAdminServiceServer)(nil).AddSearchAttribute), arg0, arg1)
 }
 
+// BatchCompleteActivityTasksById mocks base method.
+func (m *MockAdminServiceServer) BatchCompleteActivityTasksById(arg0 context.Context, arg1 *adminservice.BatchCompleteActivityTasksByIdRequest) (*adminservice.BatchCompleteActivityTasksByIdResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchCompleteActivityTasksById", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.BatchCompleteActivityTasksByIdResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchCompleteActivityTasksById indicates an expected call of BatchCompleteActivityTasksById.
+func (mr *MockAdminServiceServerMockRecorder) BatchCompleteActivityTasksById(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchCompleteActivityTasksById", reflect.TypeOf((*MockAdminServiceServer)(nil).BatchCompleteActivityTasksById), arg0, arg1)
+}
+
+// BatchDescribeMutableState mocks base method.
+func (m *MockAdminServiceServer) BatchDescribeMutableState(arg0 context.Context, arg1 *adminservice.BatchDescribeMutableStateRequest) (*adminservice.BatchDescribeMutableStateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchDescribeMutableState", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.BatchDescribeMutableStateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchDescribeMutableState indicates an expected call of BatchDescribeMutableState.
+func (mr *MockAdminServiceServerMockRecorder) BatchDescribeMutableState(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchDescribeMutableState", reflect.TypeOf((*MockAdminServiceServer)(nil).BatchDescribeMutableState), arg0, arg1)
+}
+
+// BatchFailActivityTasksById mocks base method.
+func (m *MockAdminServiceServer) BatchFailActivityTasksById(arg0 context.Context, arg1 *adminservice.BatchFailActivityTasksByIdRequest) (*adminservice.BatchFailActivityTasksByIdResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchFailActivityTasksById", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.BatchFailActivityTasksByIdResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchFailActivityTasksById indicates an expected call of BatchFailActivityTasksById.
+func (mr *MockAdminServiceServerMockRecorder) BatchFailActivityTasksById(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchFailActivityTasksById", reflect.TypeOf((*MockAdminServiceServer)(nil).BatchFailActivityTasksById), arg0, arg1)
+}
+
 // CloseShard mocks base method.
 func (m *MockAdminServiceServer) CloseShard(arg0 context.Context, arg1 *adminservice.CloseShardRequest) (*adminservice.CloseShardResponse, error) {
 	m.ctrl.T.Helper()
@@ -478,6 +803,66 @@ func (mr *MockAdminServiceServerMockRecorder) DescribeMutableState(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeMutableState), arg0, arg1)
 }
 
+// DescribeNamespaceConfig mocks base method.
+func (m *MockAdminServiceServer) DescribeNamespaceConfig(arg0 context.Context, arg1 *adminservice.DescribeNamespaceConfigRequest) (*adminservice.DescribeNamespaceConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeNamespaceConfig", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeNamespaceConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeNamespaceConfig indicates an expected call of DescribeNamespaceConfig.
+func (mr *MockAdminServiceServerMockRecorder) DescribeNamespaceConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeNamespaceConfig", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeNamespaceConfig), arg0, arg1)
+}
+
+// DescribeTaskQueue mocks base method.
+func (m *MockAdminServiceServer) DescribeTaskQueue(arg0 context.Context, arg1 *adminservice.DescribeTaskQueueRequest) (*adminservice.DescribeTaskQueueResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeTaskQueue", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeTaskQueueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTaskQueue indicates an expected call of DescribeTaskQueue.
+func (mr *MockAdminServiceServerMockRecorder) DescribeTaskQueue(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueue", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeTaskQueue), arg0, arg1)
+}
+
+// DescribeWorkflowLocks mocks base method.
+func (m *MockAdminServiceServer) DescribeWorkflowLocks(arg0 context.Context, arg1 *adminservice.DescribeWorkflowLocksRequest) (*adminservice.DescribeWorkflowLocksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeWorkflowLocks", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeWorkflowLocksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeWorkflowLocks indicates an expected call of DescribeWorkflowLocks.
+func (mr *MockAdminServiceServerMockRecorder) DescribeWorkflowLocks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkflowLocks", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeWorkflowLocks), arg0, arg1)
+}
+
+// ExecuteCrossClusterTask mocks base method.
+func (m *MockAdminServiceServer) ExecuteCrossClusterTask(arg0 context.Context, arg1 *adminservice.ExecuteCrossClusterTaskRequest) (*adminservice.ExecuteCrossClusterTaskResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteCrossClusterTask", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ExecuteCrossClusterTaskResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteCrossClusterTask indicates an expected call of ExecuteCrossClusterTask.
+func (mr *MockAdminServiceServerMockRecorder) ExecuteCrossClusterTask(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteCrossClusterTask", reflect.TypeOf((*MockAdminServiceServer)(nil).ExecuteCrossClusterTask), arg0, arg1)
+}
+
 // GetDLQMessages mocks base method.
 func (m *MockAdminServiceServer) GetDLQMessages(arg0 context.Context, arg1 *adminservice.GetDLQMessagesRequest) (*adminservice.GetDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -538,6 +923,21 @@ func (mr *MockAdminServiceServerMockRecorder) GetReplicationMessages(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).GetReplicationMessages), arg0, arg1)
 }
 
+// GetWorkflowExecutionEvents mocks base method.
+func (m *MockAdminServiceServer) GetWorkflowExecutionEvents(arg0 context.Context, arg1 *adminservice.GetWorkflowExecutionEventsRequest) (*adminservice.GetWorkflowExecutionEventsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkflowExecutionEvents", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetWorkflowExecutionEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowExecutionEvents indicates an expected call of GetWorkflowExecutionEvents.
+func (mr *MockAdminServiceServerMockRecorder) GetWorkflowExecutionEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionEvents", reflect.TypeOf((*MockAdminServiceServer)(nil).GetWorkflowExecutionEvents), arg0, arg1)
+}
+
 // GetWorkflowExecutionRawHistoryV2 mocks base method.
 func (m *MockAdminServiceServer) GetWorkflowExecutionRawHistoryV2(arg0 context.Context, arg1 *adminservice.GetWorkflowExecutionRawHistoryV2Request) (*adminservice.GetWorkflowExecutionRawHistoryV2Response, error) {
 	m.ctrl.T.Helper()
@@ -553,6 +953,36 @@ func (mr *MockAdminServiceServerMockRecorder) GetWorkflowExecutionRawHistoryV2(a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionRawHistoryV2", reflect.TypeOf((*MockAdminServiceServer)(nil).GetWorkflowExecutionRawHistoryV2), arg0, arg1)
 }
 
+// ListDynamicConfigKeys mocks base method.
+func (m *MockAdminServiceServer) ListDynamicConfigKeys(arg0 context.Context, arg1 *adminservice.ListDynamicConfigKeysRequest) (*adminservice.ListDynamicConfigKeysResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDynamicConfigKeys", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListDynamicConfigKeysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDynamicConfigKeys indicates an expected call of ListDynamicConfigKeys.
+func (mr *MockAdminServiceServerMockRecorder) ListDynamicConfigKeys(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfigKeys", reflect.TypeOf((*MockAdminServiceServer)(nil).ListDynamicConfigKeys), arg0, arg1)
+}
+
+// ListWorkflowExecutionChain mocks base method.
+func (m *MockAdminServiceServer) ListWorkflowExecutionChain(arg0 context.Context, arg1 *adminservice.ListWorkflowExecutionChainRequest) (*adminservice.ListWorkflowExecutionChainResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkflowExecutionChain", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListWorkflowExecutionChainResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkflowExecutionChain indicates an expected call of ListWorkflowExecutionChain.
+func (mr *MockAdminServiceServerMockRecorder) ListWorkflowExecutionChain(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkflowExecutionChain", reflect.TypeOf((*MockAdminServiceServer)(nil).ListWorkflowExecutionChain), arg0, arg1)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockAdminServiceServer) MergeDLQMessages(arg0 context.Context, arg1 *adminservice.MergeDLQMessagesRequest) (*adminservice.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -568,6 +998,21 @@ func (mr *MockAdminServiceServerMockRecorder) MergeDLQMessages(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).MergeDLQMessages), arg0, arg1)
 }
 
+// PauseWorkflowExecution mocks base method.
+func (m *MockAdminServiceServer) PauseWorkflowExecution(arg0 context.Context, arg1 *adminservice.PauseWorkflowExecutionRequest) (*adminservice.PauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.PauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseWorkflowExecution indicates an expected call of PauseWorkflowExecution.
+func (mr *MockAdminServiceServerMockRecorder) PauseWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).PauseWorkflowExecution), arg0, arg1)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockAdminServiceServer) PurgeDLQMessages(arg0 context.Context, arg1 *adminservice.PurgeDLQMessagesRequest) (*adminservice.PurgeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -642,3 +1087,48 @@ func (mr *MockAdminServiceServerMockRecorder) ResendReplicationTasks(arg0, arg1
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ResendReplicationTasks), arg0, arg1)
 }
+
+// ShutdownWorker mocks base method.
+func (m *MockAdminServiceServer) ShutdownWorker(arg0 context.Context, arg1 *adminservice.ShutdownWorkerRequest) (*adminservice.ShutdownWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShutdownWorker", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ShutdownWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShutdownWorker indicates an expected call of ShutdownWorker.
+func (mr *MockAdminServiceServerMockRecorder) ShutdownWorker(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShutdownWorker", reflect.TypeOf((*MockAdminServiceServer)(nil).ShutdownWorker), arg0, arg1)
+}
+
+// UnpauseWorkflowExecution mocks base method.
+func (m *MockAdminServiceServer) UnpauseWorkflowExecution(arg0 context.Context, arg1 *adminservice.UnpauseWorkflowExecutionRequest) (*adminservice.UnpauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UnpauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseWorkflowExecution indicates an expected call of UnpauseWorkflowExecution.
+func (mr *MockAdminServiceServerMockRecorder) UnpauseWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).UnpauseWorkflowExecution), arg0, arg1)
+}
+
+// UpdateWorkflowExecutionTags mocks base method.
+func (m *MockAdminServiceServer) UpdateWorkflowExecutionTags(arg0 context.Context, arg1 *adminservice.UpdateWorkflowExecutionTagsRequest) (*adminservice.UpdateWorkflowExecutionTagsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkflowExecutionTags", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateWorkflowExecutionTagsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkflowExecutionTags indicates an expected call of UpdateWorkflowExecutionTags.
+func (mr *MockAdminServiceServerMockRecorder) UpdateWorkflowExecutionTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowExecutionTags", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateWorkflowExecutionTags), arg0, arg1)
+}
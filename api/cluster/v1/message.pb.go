@@ -51,6 +51,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type HostInfo struct {
 	Identity string `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	// Server version advertised by the host, empty for hosts running releases which do not advertise it.
+	ServerVersion string `protobuf:"bytes,2,opt,name=server_version,json=serverVersion,proto3" json:"server_version,omitempty"`
 }
 
 func (m *HostInfo) Reset()      { *m = HostInfo{} }
@@ -92,6 +94,13 @@ func (m *HostInfo) GetIdentity() string {
 	return ""
 }
 
+func (m *HostInfo) GetServerVersion() string {
+	if m != nil {
+		return m.ServerVersion
+	}
+	return ""
+}
+
 type RingInfo struct {
 	Role        string      `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
 	MemberCount int32       `protobuf:"varint,2,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
@@ -221,29 +230,30 @@ func init() {
 }
 
 var fileDescriptor_fcc65697c8eece3a = []byte{
-	// 345 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xbf, 0x4e, 0xf3, 0x30,
-	0x14, 0xc5, 0xe3, 0xf6, 0xeb, 0x47, 0xeb, 0x56, 0x08, 0x3c, 0x55, 0x0c, 0x57, 0xa1, 0x03, 0x8a,
-	0x44, 0xe5, 0xa8, 0x30, 0x22, 0x31, 0x94, 0x05, 0x84, 0x58, 0x32, 0xb2, 0x54, 0x49, 0x30, 0xa9,
-	0xa5, 0x24, 0x8e, 0x6c, 0xb7, 0x12, 0x1b, 0x0b, 0x3b, 0x8f, 0xc1, 0xa3, 0x20, 0xa6, 0x8e, 0x1d,
-	0xa9, 0xb3, 0x30, 0xf6, 0x11, 0x50, 0xf3, 0xa7, 0x2c, 0x08, 0xc1, 0x76, 0xee, 0xc9, 0x39, 0xf1,
-	0xcf, 0xd6, 0xc5, 0x43, 0xcd, 0x92, 0x4c, 0x48, 0x3f, 0x76, 0x15, 0x93, 0x73, 0x26, 0x5d, 0x3f,
-	0xe3, 0x6e, 0x18, 0xcf, 0x94, 0x66, 0xd2, 0x9d, 0x8f, 0xdc, 0x84, 0x29, 0xe5, 0x47, 0x8c, 0x66,
-	0x52, 0x68, 0x41, 0xa0, 0x4e, 0xd3, 0x32, 0x4d, 0xfd, 0x8c, 0xd3, 0x2a, 0x4d, 0xe7, 0xa3, 0xc1,
-	0x11, 0x6e, 0x5f, 0x0a, 0xa5, 0xaf, 0xd2, 0x7b, 0x41, 0x0e, 0x70, 0x9b, 0xdf, 0xb1, 0x54, 0x73,
-	0xfd, 0xd0, 0x47, 0x36, 0x72, 0x3a, 0xde, 0x76, 0x1e, 0x3c, 0x21, 0xdc, 0xf6, 0x78, 0x1a, 0x15,
-	0x41, 0x82, 0xff, 0x49, 0x11, 0xb3, 0x2a, 0x54, 0x68, 0x72, 0x88, 0x7b, 0x09, 0x4b, 0x02, 0x26,
-	0x27, 0xa1, 0x98, 0xa5, 0xba, 0xdf, 0xb0, 0x91, 0xd3, 0xf2, 0xba, 0xa5, 0x77, 0xb1, 0xb1, 0xc8,
-	0x18, 0xef, 0x94, 0xa3, 0xea, 0x37, 0xed, 0xa6, 0xd3, 0x3d, 0x71, 0xe8, 0xcf, 0x74, 0xb4, 0x46,
-	0xf3, 0xea, 0xe2, 0xe0, 0x0d, 0xe1, 0xdd, 0x9b, 0x52, 0x4f, 0x79, 0x56, 0xd0, 0x5c, 0xe3, 0x5e,
-	0x38, 0x93, 0x92, 0xa5, 0x7a, 0x32, 0x15, 0x4a, 0x17, 0x54, 0x7f, 0xf9, 0x77, 0xb7, 0x6a, 0x6f,
-	0x0c, 0x72, 0x8c, 0xf7, 0x25, 0xf3, 0xc3, 0xa9, 0x1f, 0xc4, 0x6c, 0x52, 0xd3, 0x36, 0xec, 0xa6,
-	0xd3, 0xf1, 0xf6, 0xb6, 0x1f, 0x2a, 0x00, 0x72, 0x8e, 0x5b, 0x92, 0xa7, 0xd1, 0xaf, 0xaf, 0x53,
-	0x3f, 0xa0, 0x57, 0xd6, 0xc6, 0xc1, 0x62, 0x05, 0xd6, 0x72, 0x05, 0xd6, 0x7a, 0x05, 0xe8, 0xd1,
-	0x00, 0x7a, 0x31, 0x80, 0x5e, 0x0d, 0xa0, 0x85, 0x01, 0xf4, 0x6e, 0x00, 0x7d, 0x18, 0xb0, 0xd6,
-	0x06, 0xd0, 0x73, 0x0e, 0xd6, 0x22, 0x07, 0x6b, 0x99, 0x83, 0x75, 0x3b, 0x8c, 0xc4, 0xd7, 0x41,
-	0x5c, 0x7c, 0xbf, 0x06, 0x67, 0x95, 0x0c, 0xfe, 0x17, 0x7b, 0x70, 0xfa, 0x19, 0x00, 0x00, 0xff,
-	0xff, 0x4e, 0xa0, 0x30, 0xe6, 0x37, 0x02, 0x00, 0x00,
+	// 361 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xb1, 0x4b, 0xfb, 0x40,
+	0x18, 0xcd, 0xb5, 0xbf, 0xfe, 0x6c, 0xaf, 0xb5, 0xe8, 0x4d, 0xc5, 0xe1, 0xa8, 0x05, 0x21, 0x60,
+	0x49, 0xa8, 0x8e, 0x82, 0x43, 0x5d, 0x14, 0xe9, 0x92, 0xc1, 0xc1, 0x25, 0x24, 0xf1, 0x33, 0x3d,
+	0x48, 0x72, 0xe1, 0xee, 0x1a, 0x70, 0x73, 0x71, 0xf7, 0xcf, 0xf0, 0x4f, 0x11, 0xa7, 0x8e, 0x1d,
+	0x6d, 0xba, 0x38, 0xf6, 0x4f, 0x90, 0xe6, 0x92, 0xba, 0x88, 0xe8, 0xf6, 0x7d, 0x2f, 0xef, 0xbd,
+	0xbc, 0x77, 0x7c, 0x78, 0xa8, 0x20, 0x4e, 0xb9, 0xf0, 0x22, 0x5b, 0x82, 0xc8, 0x40, 0xd8, 0x5e,
+	0xca, 0xec, 0x20, 0x9a, 0x49, 0x05, 0xc2, 0xce, 0x46, 0x76, 0x0c, 0x52, 0x7a, 0x21, 0x58, 0xa9,
+	0xe0, 0x8a, 0x13, 0x5a, 0xb1, 0x2d, 0xcd, 0xb6, 0xbc, 0x94, 0x59, 0x25, 0xdb, 0xca, 0x46, 0x83,
+	0x09, 0x6e, 0x5e, 0x72, 0xa9, 0xae, 0x92, 0x7b, 0x4e, 0x0e, 0x70, 0x93, 0xdd, 0x41, 0xa2, 0x98,
+	0x7a, 0xe8, 0xa1, 0x3e, 0x32, 0x5b, 0xce, 0x76, 0x27, 0x47, 0xb8, 0xab, 0x0d, 0xdc, 0x0c, 0x84,
+	0x64, 0x3c, 0xe9, 0xd5, 0x0a, 0xc6, 0xae, 0x46, 0x6f, 0x34, 0x38, 0x78, 0x42, 0xb8, 0xe9, 0xb0,
+	0x24, 0x2c, 0xfc, 0x08, 0xfe, 0x27, 0x78, 0x04, 0xa5, 0x57, 0x31, 0x93, 0x43, 0xdc, 0x89, 0x21,
+	0xf6, 0x41, 0xb8, 0x01, 0x9f, 0x25, 0xaa, 0x70, 0x69, 0x38, 0x6d, 0x8d, 0x5d, 0x6c, 0x20, 0x32,
+	0xc6, 0x3b, 0x7a, 0x95, 0xbd, 0x7a, 0xbf, 0x6e, 0xb6, 0x4f, 0x4c, 0xeb, 0xe7, 0x12, 0x56, 0xd5,
+	0xc0, 0xa9, 0x84, 0x83, 0x37, 0x84, 0xbb, 0x13, 0x3d, 0x4f, 0x59, 0x5a, 0xa4, 0xb9, 0xc6, 0x9d,
+	0x60, 0x26, 0x04, 0x24, 0xca, 0x9d, 0x72, 0xa9, 0x8a, 0x54, 0x7f, 0xf1, 0x6e, 0x97, 0xea, 0x0d,
+	0x40, 0x8e, 0xf1, 0xbe, 0x00, 0x2f, 0x98, 0x7a, 0x7e, 0x04, 0x6e, 0x95, 0xb6, 0xd6, 0xaf, 0x9b,
+	0x2d, 0x67, 0x6f, 0xfb, 0xa1, 0x0c, 0x40, 0xce, 0x71, 0x43, 0xb0, 0x24, 0xfc, 0x75, 0x9d, 0xea,
+	0x01, 0x1d, 0x2d, 0x1b, 0xfb, 0xf3, 0x25, 0x35, 0x16, 0x4b, 0x6a, 0xac, 0x97, 0x14, 0x3d, 0xe6,
+	0x14, 0xbd, 0xe4, 0x14, 0xbd, 0xe6, 0x14, 0xcd, 0x73, 0x8a, 0xde, 0x73, 0x8a, 0x3e, 0x72, 0x6a,
+	0xac, 0x73, 0x8a, 0x9e, 0x57, 0xd4, 0x98, 0xaf, 0xa8, 0xb1, 0x58, 0x51, 0xe3, 0x76, 0x18, 0xf2,
+	0xaf, 0x1f, 0x31, 0xfe, 0xfd, 0xb5, 0x9c, 0x95, 0xa3, 0xff, 0xbf, 0x38, 0x97, 0xd3, 0xcf, 0x01,
+	0x00, 0xbd, 0xdb, 0x42, 0x1d, 0x5e, 0x02, 0x00, 0x00,
 }
 
 func (this *HostInfo) Equal(that interface{}) bool {
@@ -268,6 +278,9 @@ func (this *HostInfo) Equal(that interface{}) bool {
 	if this.Identity != that1.Identity {
 		return false
 	}
+	if this.ServerVersion != that1.ServerVersion {
+		return false
+	}
 	return true
 }
 func (this *RingInfo) Equal(that interface{}) bool {
@@ -349,9 +362,10 @@ func (this *HostInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 5)
+	s := make([]string, 0, 6)
 	s = append(s, "&cluster.HostInfo{")
 	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
+	s = append(s, "ServerVersion: "+fmt.Sprintf("%#v", this.ServerVersion)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -413,6 +427,13 @@ func (m *HostInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ServerVersion) > 0 {
+		i -= len(m.ServerVersion)
+		copy(dAtA[i:], m.ServerVersion)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.ServerVersion)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Identity) > 0 {
 		i -= len(m.Identity)
 		copy(dAtA[i:], m.Identity)
@@ -551,6 +572,10 @@ func (m *HostInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMessage(uint64(l))
 	}
+	l = len(m.ServerVersion)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
 	return n
 }
 
@@ -613,6 +638,7 @@ func (this *HostInfo) String() string {
 	}
 	s := strings.Join([]string{`&HostInfo{`,
 		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`ServerVersion:` + fmt.Sprintf("%v", this.ServerVersion) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -720,6 +746,38 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.Identity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -49,13 +49,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type ReplicationTaskType int32
 
 const (
-	REPLICATION_TASK_TYPE_UNSPECIFIED            ReplicationTaskType = 0
-	REPLICATION_TASK_TYPE_NAMESPACE_TASK         ReplicationTaskType = 1
-	REPLICATION_TASK_TYPE_HISTORY_TASK           ReplicationTaskType = 2
-	REPLICATION_TASK_TYPE_SYNC_SHARD_STATUS_TASK ReplicationTaskType = 3
-	REPLICATION_TASK_TYPE_SYNC_ACTIVITY_TASK     ReplicationTaskType = 4
-	REPLICATION_TASK_TYPE_HISTORY_METADATA_TASK  ReplicationTaskType = 5
-	REPLICATION_TASK_TYPE_HISTORY_V2_TASK        ReplicationTaskType = 6
+	REPLICATION_TASK_TYPE_UNSPECIFIED              ReplicationTaskType = 0
+	REPLICATION_TASK_TYPE_NAMESPACE_TASK           ReplicationTaskType = 1
+	REPLICATION_TASK_TYPE_HISTORY_TASK             ReplicationTaskType = 2
+	REPLICATION_TASK_TYPE_SYNC_SHARD_STATUS_TASK   ReplicationTaskType = 3
+	REPLICATION_TASK_TYPE_SYNC_ACTIVITY_TASK       ReplicationTaskType = 4
+	REPLICATION_TASK_TYPE_HISTORY_METADATA_TASK    ReplicationTaskType = 5
+	REPLICATION_TASK_TYPE_HISTORY_V2_TASK          ReplicationTaskType = 6
+	REPLICATION_TASK_TYPE_SYNC_WORKFLOW_STATE_TASK ReplicationTaskType = 7
 )
 
 var ReplicationTaskType_name = map[int32]string{
@@ -66,16 +67,18 @@ var ReplicationTaskType_name = map[int32]string{
 	4: "SyncActivityTask",
 	5: "HistoryMetadataTask",
 	6: "HistoryV2Task",
+	7: "SyncWorkflowStateTask",
 }
 
 var ReplicationTaskType_value = map[string]int32{
-	"Unspecified":         0,
-	"NamespaceTask":       1,
-	"HistoryTask":         2,
-	"SyncShardStatusTask": 3,
-	"SyncActivityTask":    4,
-	"HistoryMetadataTask": 5,
-	"HistoryV2Task":       6,
+	"Unspecified":           0,
+	"NamespaceTask":         1,
+	"HistoryTask":           2,
+	"SyncShardStatusTask":   3,
+	"SyncActivityTask":      4,
+	"HistoryMetadataTask":   5,
+	"HistoryV2Task":         6,
+	"SyncWorkflowStateTask": 7,
 }
 
 func (ReplicationTaskType) EnumDescriptor() ([]byte, []int) {
@@ -116,31 +119,31 @@ func init() {
 }
 
 var fileDescriptor_3f4df3039790445d = []byte{
-	// 372 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x3f, 0x4b, 0xc3, 0x40,
-	0x18, 0xc6, 0x73, 0x55, 0x3b, 0xdc, 0x54, 0xce, 0x4d, 0xe4, 0xc4, 0x3f, 0x95, 0x5a, 0x4b, 0x62,
-	0x75, 0x74, 0x3a, 0x93, 0x93, 0x06, 0x6d, 0x12, 0x72, 0xd7, 0x42, 0x1d, 0x0c, 0xb1, 0x1c, 0x12,
-	0x6c, 0x9b, 0x23, 0xa9, 0x85, 0x6e, 0x7e, 0x04, 0x3f, 0x86, 0x1f, 0x45, 0x9c, 0x3a, 0x76, 0xb4,
-	0xe9, 0xe2, 0xd8, 0x8f, 0x20, 0x26, 0x6a, 0x11, 0x62, 0xb7, 0xe3, 0xde, 0xdf, 0xef, 0xe5, 0xe5,
-	0xe1, 0x81, 0xea, 0x50, 0xf4, 0x65, 0x18, 0xf9, 0x3d, 0x2d, 0x16, 0xd1, 0x48, 0x44, 0x9a, 0x2f,
-	0x03, 0x4d, 0x0c, 0x1e, 0xfb, 0xb1, 0x36, 0xaa, 0x6b, 0x91, 0x90, 0xbd, 0xa0, 0xeb, 0x0f, 0x83,
-	0x70, 0xa0, 0xca, 0x28, 0x1c, 0x86, 0x68, 0xfb, 0x87, 0x57, 0x33, 0x5e, 0xf5, 0x65, 0xa0, 0xa6,
-	0xbc, 0x3a, 0xaa, 0x57, 0xdf, 0x0a, 0x70, 0xd3, 0x5d, 0x3a, 0xdc, 0x8f, 0x1f, 0xf8, 0x58, 0x0a,
-	0x54, 0x86, 0xbb, 0x2e, 0x75, 0xae, 0x4d, 0x9d, 0x70, 0xd3, 0xb6, 0x3c, 0x4e, 0xd8, 0x95, 0xc7,
-	0x3b, 0x0e, 0xf5, 0x5a, 0x16, 0x73, 0xa8, 0x6e, 0x5e, 0x9a, 0xd4, 0x28, 0x29, 0xa8, 0x02, 0x0f,
-	0xf2, 0x31, 0x8b, 0x34, 0x29, 0x73, 0x88, 0x4e, 0xd3, 0xbf, 0x12, 0x40, 0x87, 0x70, 0x2f, 0x9f,
-	0x6c, 0x98, 0x8c, 0xdb, 0x6e, 0x27, 0xe3, 0x0a, 0xe8, 0x04, 0xd6, 0xf2, 0x39, 0xd6, 0xb1, 0x74,
-	0x8f, 0x35, 0x88, 0x6b, 0x78, 0x8c, 0x13, 0xde, 0x62, 0x99, 0xb1, 0x86, 0x6a, 0xb0, 0xb2, 0xc2,
-	0x20, 0x3a, 0x37, 0xdb, 0x26, 0xff, 0xde, 0xbf, 0x8e, 0x34, 0x78, 0xbc, 0xfa, 0x8e, 0x26, 0xe5,
-	0xc4, 0x20, 0x9c, 0x64, 0xc2, 0x06, 0x3a, 0x82, 0xe5, 0xd5, 0x42, 0xfb, 0x34, 0x43, 0x8b, 0xd5,
-	0x31, 0x44, 0x96, 0xdf, 0x17, 0xb1, 0xf4, 0xbb, 0xc2, 0x96, 0x22, 0x4a, 0x23, 0x45, 0xfb, 0x70,
-	0x67, 0x99, 0x86, 0xed, 0x50, 0x37, 0x5b, 0xf4, 0x37, 0x48, 0x0c, 0xb7, 0xf2, 0x20, 0xdd, 0xa5,
-	0x84, 0xd3, 0x12, 0xf8, 0x6f, 0xde, 0x72, 0x8c, 0xaf, 0x79, 0xe1, 0xe2, 0x76, 0x32, 0xc3, 0xca,
-	0x74, 0x86, 0x95, 0xc5, 0x0c, 0x83, 0xa7, 0x04, 0x83, 0x97, 0x04, 0x83, 0xd7, 0x04, 0x83, 0x49,
-	0x82, 0xc1, 0x7b, 0x82, 0xc1, 0x47, 0x82, 0x95, 0x45, 0x82, 0xc1, 0xf3, 0x1c, 0x2b, 0x93, 0x39,
-	0x56, 0xa6, 0x73, 0xac, 0xdc, 0x54, 0xee, 0xc3, 0xdf, 0x3a, 0xa9, 0x41, 0x98, 0xd7, 0xa8, 0xf3,
-	0xf4, 0x71, 0x57, 0x4c, 0xcb, 0x74, 0xf6, 0x19, 0x00, 0x00, 0xff, 0xff, 0xab, 0x9f, 0x42, 0xee,
-	0x7e, 0x02, 0x00, 0x00,
+	// 379 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7d, 0x92, 0xcd, 0x4e, 0xc2, 0x40,
+	0x14, 0x85, 0x01, 0x15, 0x93, 0x59, 0x91, 0x71, 0x67, 0x4c, 0x8d, 0x3f, 0x18, 0x44, 0x32, 0x15,
+	0x5c, 0xba, 0x1a, 0xdb, 0x21, 0x34, 0x40, 0xdb, 0x74, 0x06, 0x08, 0x2e, 0x6c, 0x2a, 0x99, 0x98,
+	0x46, 0xa0, 0x4d, 0x8b, 0x24, 0xec, 0x7c, 0x04, 0x1f, 0xc3, 0x47, 0x71, 0xc9, 0x92, 0xa5, 0xe0,
+	0xc6, 0xa5, 0x4f, 0x60, 0x1c, 0x5b, 0x94, 0x98, 0x54, 0x16, 0x27, 0x33, 0x99, 0xfb, 0x9d, 0x9b,
+	0x9b, 0x33, 0x17, 0xa0, 0x11, 0x1f, 0xf8, 0x5e, 0xe0, 0xf4, 0xe5, 0x90, 0x07, 0x63, 0x1e, 0xc8,
+	0x8e, 0xef, 0xca, 0x7c, 0xf8, 0x30, 0x08, 0xe5, 0x71, 0x59, 0x0e, 0xb8, 0xdf, 0x77, 0x7b, 0xce,
+	0xc8, 0xf5, 0x86, 0xc8, 0x0f, 0xbc, 0x91, 0x07, 0xf7, 0x7e, 0x78, 0x14, 0xf3, 0x48, 0xf0, 0x28,
+	0xe2, 0xd1, 0xb8, 0x5c, 0xfc, 0xcc, 0x80, 0x1d, 0x6b, 0xe5, 0x61, 0x4e, 0x78, 0xcf, 0x26, 0x3e,
+	0x87, 0x79, 0x70, 0x60, 0x11, 0xb3, 0xa1, 0x29, 0x98, 0x69, 0x86, 0x6e, 0x33, 0x4c, 0xeb, 0x36,
+	0xeb, 0x9a, 0xc4, 0x6e, 0xe9, 0xd4, 0x24, 0x8a, 0x56, 0xd5, 0x88, 0x9a, 0x4b, 0xc1, 0x02, 0x38,
+	0x4e, 0xc6, 0x74, 0xdc, 0x24, 0xd4, 0xc4, 0x0a, 0x89, 0xde, 0x72, 0x69, 0x78, 0x02, 0x0e, 0x93,
+	0xc9, 0x9a, 0x46, 0x99, 0x61, 0x75, 0x63, 0x2e, 0x03, 0xcf, 0x41, 0x29, 0x99, 0xa3, 0x5d, 0x5d,
+	0xb1, 0x69, 0x0d, 0x5b, 0xaa, 0x4d, 0x19, 0x66, 0x2d, 0x1a, 0x3b, 0x36, 0x60, 0x09, 0x14, 0xd6,
+	0x38, 0xb0, 0xc2, 0xb4, 0xb6, 0xc6, 0x96, 0xfd, 0x37, 0xa1, 0x0c, 0xce, 0xd6, 0xcf, 0xd1, 0x24,
+	0x0c, 0xab, 0x98, 0xe1, 0xd8, 0xb0, 0x05, 0x4f, 0x41, 0x7e, 0xbd, 0xa1, 0x5d, 0x89, 0xd1, 0x2c,
+	0xac, 0x00, 0xb4, 0x66, 0x92, 0x8e, 0x61, 0xd5, 0xab, 0x0d, 0xa3, 0x13, 0x8d, 0xbf, 0xcc, 0x65,
+	0xbb, 0x38, 0x01, 0x50, 0x77, 0x06, 0x3c, 0xf4, 0x9d, 0x1e, 0x37, 0x7c, 0x1e, 0x44, 0xdf, 0x00,
+	0x8f, 0xc0, 0xfe, 0x2a, 0x41, 0xc3, 0x24, 0x56, 0xdc, 0xf1, 0x6f, 0xf8, 0x12, 0xd8, 0x4d, 0x82,
+	0x14, 0x8b, 0x88, 0xfe, 0x22, 0xf2, 0x7f, 0xea, 0x2d, 0x53, 0xfd, 0xae, 0x67, 0xae, 0x6e, 0xa6,
+	0x73, 0x29, 0x35, 0x13, 0xfa, 0x98, 0x4b, 0xe9, 0xc7, 0x85, 0x94, 0x7e, 0x16, 0x7a, 0x11, 0x9a,
+	0x0a, 0xbd, 0x0a, 0xbd, 0x2f, 0x44, 0x4d, 0x9c, 0x4f, 0x6f, 0x52, 0x6a, 0x2a, 0x34, 0x13, 0xba,
+	0x2e, 0xdc, 0x79, 0xbf, 0x2b, 0x88, 0x5c, 0x2f, 0x69, 0x0b, 0x2f, 0xa3, 0xcb, 0x6d, 0x36, 0x5a,
+	0xc0, 0x8b, 0x2f, 0x87, 0x6b, 0x7d, 0xf7, 0xb2, 0x02, 0x00, 0x00,
 }
 
 func (x ReplicationTaskType) String() string {
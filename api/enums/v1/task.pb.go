@@ -133,6 +133,7 @@ const (
 	TASK_TYPE_VISIBILITY_UPSERT_EXECUTION                TaskType = 20
 	TASK_TYPE_VISIBILITY_CLOSE_EXECUTION                 TaskType = 21
 	TASK_TYPE_VISIBILITY_DELETE_EXECUTION                TaskType = 22
+	TASK_TYPE_REPLICATION_SYNC_WORKFLOW_STATE            TaskType = 23
 )
 
 var TaskType_name = map[int32]string{
@@ -159,6 +160,7 @@ var TaskType_name = map[int32]string{
 	20: "VisibilityUpsertExecution",
 	21: "VisibilityCloseExecution",
 	22: "VisibilityDeleteExecution",
+	23: "ReplicationSyncWorkflowState",
 }
 
 var TaskType_value = map[string]int32{
@@ -185,6 +187,7 @@ var TaskType_value = map[string]int32{
 	"VisibilityUpsertExecution":              20,
 	"VisibilityCloseExecution":               21,
 	"VisibilityDeleteExecution":              22,
+	"ReplicationSyncWorkflowState":           23,
 }
 
 func (TaskType) EnumDescriptor() ([]byte, []int) {
@@ -202,47 +205,47 @@ func init() {
 }
 
 var fileDescriptor_36a3d3674ca3cfa6 = []byte{
-	// 630 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0x4d, 0x53, 0x13, 0x4d,
-	0x10, 0xc7, 0x77, 0x03, 0x0f, 0xf0, 0xb4, 0xa8, 0xe3, 0xf0, 0x8e, 0x30, 0xca, 0x9b, 0x60, 0xca,
-	0x4a, 0x0a, 0xf5, 0xa6, 0x97, 0xcd, 0x64, 0x02, 0x53, 0xac, 0xbb, 0xa9, 0x99, 0x59, 0x30, 0x1e,
-	0xd8, 0x8a, 0xd6, 0x16, 0x45, 0x21, 0x6e, 0x2a, 0x09, 0x54, 0x71, 0xf3, 0x23, 0x78, 0xf5, 0xe4,
-	0xd5, 0x8f, 0xe2, 0x91, 0x23, 0x47, 0x59, 0x2e, 0x1e, 0xf9, 0x08, 0x56, 0x96, 0x64, 0x67, 0x13,
-	0x37, 0xb7, 0xad, 0xfa, 0xff, 0xfa, 0xdf, 0xdd, 0xdb, 0xdd, 0x03, 0x9b, 0xed, 0xe0, 0xb4, 0x11,
-	0x36, 0xeb, 0x9f, 0x8b, 0xad, 0xa0, 0x79, 0x1e, 0x34, 0x8b, 0xf5, 0xc6, 0x71, 0x31, 0xf8, 0x72,
-	0x76, 0xda, 0x2a, 0x9e, 0x6f, 0x17, 0xdb, 0xf5, 0xd6, 0x49, 0xa1, 0xd1, 0x0c, 0xdb, 0x21, 0x5e,
-	0xea, 0x81, 0x85, 0x3b, 0xb0, 0x50, 0x6f, 0x1c, 0x17, 0x62, 0xb0, 0x70, 0xbe, 0x9d, 0x3f, 0x04,
-	0x50, 0xf5, 0xd6, 0x89, 0x0c, 0xcf, 0x9a, 0x9f, 0x02, 0xfc, 0x18, 0xe6, 0x94, 0x25, 0xf7, 0x7c,
-	0xe9, 0x7a, 0x82, 0x32, 0xdf, 0x73, 0x64, 0x95, 0x51, 0x5e, 0xe1, 0xac, 0x8c, 0x0c, 0x3c, 0x07,
-	0x53, 0x69, 0x71, 0x97, 0x4b, 0xe5, 0x8a, 0x1a, 0x32, 0xf1, 0x22, 0xcc, 0xa6, 0x85, 0x72, 0xc9,
-	0x2f, 0x59, 0x74, 0xcf, 0x76, 0x77, 0x50, 0x2e, 0xff, 0xc3, 0x84, 0xc9, 0x4e, 0x02, 0x5a, 0x6f,
-	0x07, 0x47, 0x61, 0xf3, 0x02, 0x2f, 0xc3, 0x42, 0x0c, 0x53, 0x4b, 0xb1, 0x1d, 0x57, 0xd4, 0x06,
-	0x92, 0xf4, 0xbc, 0x12, 0x59, 0x09, 0xcb, 0x91, 0x15, 0x26, 0x90, 0x99, 0x14, 0xa0, 0x35, 0xfe,
-	0x8e, 0x09, 0x94, 0xfb, 0xd7, 0x53, 0xb0, 0xaa, 0xcd, 0xa9, 0xa5, 0xb8, 0xeb, 0xa0, 0x11, 0xbc,
-	0x04, 0xf3, 0xfd, 0xf2, 0x3e, 0x97, 0xbc, 0xc4, 0x6d, 0xae, 0x6a, 0x68, 0x34, 0xff, 0x7d, 0x1c,
-	0x26, 0x3a, 0x15, 0xaa, 0x8b, 0x46, 0x80, 0x17, 0x60, 0x26, 0x46, 0x55, 0xad, 0x3a, 0xd8, 0xfe,
-	0x0a, 0x2c, 0x6b, 0x29, 0x95, 0x20, 0xf5, 0x23, 0x36, 0x61, 0x2d, 0x1b, 0x91, 0x35, 0x87, 0xfa,
-	0x16, 0x55, 0x7c, 0xbf, 0x93, 0x33, 0x87, 0xd7, 0xe1, 0xa9, 0x06, 0x7b, 0x1d, 0xfa, 0x07, 0xae,
-	0xd8, 0xab, 0xd8, 0xee, 0x81, 0xdf, 0xd1, 0xd0, 0xc8, 0x10, 0xaa, 0x67, 0x73, 0x47, 0x8d, 0xe2,
-	0x67, 0xb0, 0x9a, 0x41, 0x51, 0xdb, 0x95, 0xcc, 0x67, 0xef, 0x19, 0xf5, 0xe2, 0xbf, 0xf0, 0x5f,
-	0x7f, 0x71, 0x9a, 0xb3, 0x1c, 0xca, 0xec, 0x14, 0x38, 0x86, 0x5f, 0xc0, 0x56, 0x06, 0x28, 0x95,
-	0x25, 0x94, 0x4f, 0x77, 0xb9, 0x5d, 0x4e, 0xd1, 0xe3, 0x43, 0x6c, 0x25, 0xdf, 0x71, 0xac, 0xb4,
-	0xed, 0x04, 0x7e, 0x09, 0xf9, 0x0c, 0x50, 0x30, 0xea, 0x8a, 0xb2, 0x6e, 0x3d, 0x4e, 0xc3, 0xca,
-	0xe8, 0xff, 0xc5, 0xdc, 0x84, 0x89, 0x37, 0x60, 0x25, 0x33, 0x46, 0x32, 0x95, 0x84, 0x20, 0xc0,
-	0x6f, 0xe1, 0x75, 0x06, 0xe6, 0x55, 0x25, 0x13, 0x2a, 0x65, 0xcd, 0x2c, 0x41, 0x77, 0x7d, 0x4b,
-	0x29, 0xc1, 0x4b, 0x9e, 0x62, 0x12, 0xdd, 0x8b, 0x93, 0xac, 0xc1, 0x13, 0x1d, 0xdd, 0x37, 0x83,
-	0x78, 0xc1, 0x5c, 0x4f, 0xa1, 0x49, 0x4c, 0x60, 0x51, 0x43, 0x7a, 0x04, 0x5d, 0xfd, 0x3e, 0x9e,
-	0x87, 0xe9, 0xd4, 0xe2, 0x48, 0x26, 0xba, 0xcb, 0xf9, 0x00, 0xaf, 0x02, 0xc9, 0xb0, 0x17, 0x9e,
-	0x93, 0x44, 0x3f, 0xec, 0x67, 0xca, 0xcc, 0x66, 0x2a, 0xb9, 0x2f, 0x9f, 0xed, 0x33, 0x47, 0x21,
-	0xd4, 0xcf, 0x24, 0x15, 0x08, 0xa6, 0x92, 0x43, 0x78, 0xd4, 0xbf, 0x31, 0x49, 0xae, 0xce, 0x35,
-	0xba, 0x95, 0x4a, 0x97, 0xc2, 0x78, 0x0b, 0xd6, 0x35, 0xa5, 0x6f, 0xa1, 0x3b, 0x62, 0x3d, 0xb3,
-	0x29, 0xfc, 0x1c, 0x36, 0x32, 0xc9, 0xee, 0xaf, 0xd5, 0xe8, 0xf4, 0x50, 0xd3, 0xc1, 0x45, 0x9c,
-	0x19, 0x6a, 0xda, 0xed, 0x5b, 0xa3, 0xb3, 0xa5, 0xc3, 0xcb, 0x6b, 0x62, 0x5c, 0x5d, 0x13, 0xe3,
-	0xf6, 0x9a, 0x98, 0x5f, 0x23, 0x62, 0xfe, 0x8c, 0x88, 0xf9, 0x2b, 0x22, 0xe6, 0x65, 0x44, 0xcc,
-	0xdf, 0x11, 0x31, 0xff, 0x44, 0xc4, 0xb8, 0x8d, 0x88, 0xf9, 0xed, 0x86, 0x18, 0x97, 0x37, 0xc4,
-	0xb8, 0xba, 0x21, 0xc6, 0x87, 0xad, 0xa3, 0xb0, 0x90, 0x3c, 0x7a, 0xc7, 0x61, 0xd6, 0x03, 0xf9,
-	0x26, 0xfe, 0xf8, 0x38, 0x16, 0x3f, 0x91, 0xaf, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0x4f, 0x31,
-	0x49, 0x69, 0x4d, 0x05, 0x00, 0x00,
+	// 625 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7d, 0x94, 0xcb, 0x72, 0x12, 0x41,
+	0x14, 0x86, 0x03, 0xc6, 0x04, 0x8f, 0x51, 0xc7, 0xce, 0x85, 0x04, 0x13, 0x34, 0x37, 0x43, 0x28,
+	0x85, 0x8a, 0xba, 0xd3, 0xcd, 0xd0, 0x34, 0xd0, 0xc5, 0x38, 0x43, 0x75, 0xf7, 0x10, 0x71, 0x91,
+	0x29, 0xb4, 0xa6, 0x52, 0x29, 0x8d, 0x43, 0x01, 0xa1, 0x2a, 0x3b, 0x1f, 0xc1, 0x37, 0x70, 0xeb,
+	0xa3, 0x64, 0xc9, 0x32, 0x4b, 0x13, 0x37, 0x2e, 0x7d, 0x04, 0x9b, 0x09, 0x4c, 0x0f, 0x38, 0xb8,
+	0x38, 0x05, 0x53, 0xff, 0x77, 0x6e, 0xdd, 0xe7, 0x34, 0xec, 0x75, 0xdd, 0xd3, 0x96, 0xd7, 0x6e,
+	0x7e, 0xce, 0x77, 0xdc, 0x76, 0xcf, 0x6d, 0xe7, 0x9b, 0xad, 0x93, 0xbc, 0xfb, 0xe5, 0xec, 0xb4,
+	0x93, 0xef, 0x1d, 0xe4, 0xbb, 0xcd, 0xce, 0xa7, 0x5c, 0xab, 0xed, 0x75, 0x3d, 0xb4, 0x3e, 0x02,
+	0x73, 0x37, 0x60, 0x4e, 0x82, 0x39, 0x1f, 0xcc, 0xf5, 0x0e, 0xb2, 0x47, 0x00, 0x42, 0xb2, 0xdc,
+	0x3b, 0x6b, 0x7f, 0x74, 0xd1, 0x23, 0x48, 0x0a, 0x9d, 0x57, 0x1d, 0x6e, 0xd9, 0x0c, 0x13, 0xc7,
+	0x36, 0x79, 0x8d, 0x60, 0x5a, 0xa2, 0xa4, 0xa8, 0xcd, 0xa0, 0x24, 0x2c, 0x86, 0xc5, 0x0a, 0xe5,
+	0xc2, 0x62, 0x0d, 0x2d, 0x86, 0x52, 0xb0, 0x12, 0x16, 0x8a, 0x05, 0xa7, 0xa0, 0xe3, 0xaa, 0x61,
+	0x95, 0xb5, 0x78, 0xf6, 0x7b, 0x0c, 0x16, 0x06, 0x09, 0x70, 0xb3, 0xeb, 0x1e, 0x7b, 0xed, 0x73,
+	0xb4, 0x01, 0x6b, 0x3e, 0x8c, 0x75, 0x41, 0xca, 0xd2, 0x7f, 0x22, 0xc9, 0x28, 0x56, 0x20, 0x0b,
+	0xa6, 0x9b, 0xbc, 0x44, 0x98, 0xcc, 0x33, 0x2a, 0x40, 0x69, 0xf4, 0xad, 0x14, 0xe2, 0xff, 0xc6,
+	0x64, 0xa4, 0x66, 0x50, 0xf9, 0x45, 0x2d, 0x53, 0xbb, 0x85, 0xd6, 0x61, 0x75, 0x5c, 0xae, 0x53,
+	0x4e, 0x0b, 0xd4, 0xa0, 0xa2, 0xa1, 0xcd, 0x66, 0x2f, 0xe6, 0x21, 0x31, 0xa8, 0x50, 0x9c, 0xb7,
+	0x5c, 0xb4, 0x06, 0xcb, 0x3e, 0x2a, 0x1a, 0xb5, 0xc9, 0xf6, 0x37, 0x61, 0x43, 0x49, 0xa1, 0x04,
+	0xa1, 0x83, 0xd8, 0x83, 0xed, 0x68, 0x84, 0x37, 0x4c, 0xec, 0xe8, 0x58, 0xd0, 0xfa, 0x20, 0x67,
+	0x1c, 0xed, 0xc0, 0x13, 0x05, 0x8e, 0x3a, 0x74, 0x0e, 0x2d, 0x56, 0x2d, 0x19, 0xd6, 0xa1, 0x33,
+	0xd0, 0x64, 0xdd, 0xd1, 0xd4, 0x28, 0xcc, 0x0d, 0x35, 0x8b, 0x9e, 0xc2, 0x56, 0x04, 0x85, 0x0d,
+	0x8b, 0x13, 0x87, 0xbc, 0x23, 0xd8, 0xf6, 0x4f, 0xe1, 0xf6, 0x78, 0x71, 0x8a, 0xd3, 0x4d, 0x4c,
+	0x8c, 0x10, 0x38, 0x87, 0x9e, 0x41, 0x26, 0x02, 0xe4, 0x42, 0x67, 0xc2, 0xc1, 0x15, 0x6a, 0x14,
+	0x43, 0xf4, 0xfc, 0x94, 0xb0, 0x9c, 0x96, 0x4d, 0x3d, 0x1c, 0x36, 0x81, 0x5e, 0x40, 0x36, 0x02,
+	0x64, 0x04, 0x5b, 0xac, 0xa8, 0x5a, 0xf7, 0xd3, 0xc8, 0xf3, 0xbe, 0x93, 0x8a, 0x27, 0x62, 0x68,
+	0x17, 0x36, 0x23, 0x7d, 0x38, 0x11, 0x81, 0x8b, 0x06, 0xe8, 0x0d, 0xbc, 0x8a, 0xc0, 0xec, 0x1a,
+	0x27, 0x4c, 0x84, 0x42, 0x13, 0x9d, 0xe1, 0x8a, 0xa3, 0x0b, 0xc1, 0x68, 0xc1, 0x16, 0x84, 0x6b,
+	0x77, 0xfd, 0x24, 0xdb, 0xf0, 0x58, 0x79, 0x8f, 0xdd, 0x81, 0x3f, 0x60, 0x96, 0x2d, 0xb4, 0x05,
+	0x94, 0x86, 0x94, 0x82, 0xd4, 0x15, 0x0c, 0xf5, 0x7b, 0x68, 0x15, 0x96, 0x42, 0x83, 0x23, 0x13,
+	0x0f, 0x87, 0xf3, 0x3e, 0xda, 0x82, 0x74, 0x44, 0x78, 0x66, 0x9b, 0x81, 0xf7, 0x83, 0x71, 0xa6,
+	0x48, 0x0c, 0x22, 0x82, 0xfd, 0x72, 0x48, 0x9d, 0x98, 0x42, 0xd3, 0xc6, 0x99, 0xa0, 0x02, 0x46,
+	0x44, 0xb0, 0x08, 0x0f, 0xc7, 0x27, 0x26, 0xc8, 0x35, 0xd8, 0x46, 0xab, 0x54, 0x1a, 0x52, 0x08,
+	0x65, 0x60, 0x47, 0x51, 0x6a, 0x17, 0x86, 0x57, 0xac, 0xee, 0x6c, 0x11, 0xed, 0xc3, 0x6e, 0x24,
+	0x39, 0x3c, 0x5a, 0x85, 0x2e, 0x4d, 0x0d, 0x3a, 0x39, 0x88, 0xcb, 0x53, 0x83, 0x0e, 0xfb, 0x56,
+	0xe8, 0x0a, 0x7a, 0x0e, 0xfb, 0xff, 0x59, 0xa8, 0xf0, 0xd0, 0x08, 0xa2, 0x25, 0x0b, 0x47, 0xfd,
+	0xab, 0xf4, 0xcc, 0xa5, 0xb4, 0x3f, 0x57, 0xe9, 0xd8, 0xd7, 0xeb, 0x74, 0xec, 0x87, 0xb4, 0x0b,
+	0x69, 0x7d, 0x69, 0x3f, 0xa5, 0xfd, 0xbe, 0x96, 0x9a, 0xfc, 0xfd, 0xf6, 0x2b, 0x3d, 0xd3, 0x97,
+	0x76, 0x29, 0xed, 0x7d, 0xe6, 0xd8, 0xcb, 0x05, 0x6f, 0xe4, 0x89, 0x17, 0xf5, 0x9e, 0xbe, 0xf6,
+	0xff, 0x7c, 0x98, 0xf3, 0x5f, 0xd4, 0x97, 0x7f, 0x01, 0x95, 0xe0, 0xac, 0x9f, 0x7c, 0x05, 0x00,
+	0x00,
 }
 
 func (x TaskSource) String() string {
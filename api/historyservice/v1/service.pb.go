@@ -54,72 +54,77 @@ func init() {
 }
 
 var fileDescriptor_655983da427ae822 = []byte{
-	// 1034 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x99, 0xcd, 0x8b, 0x23, 0x45,
-	0x18, 0x87, 0x53, 0x17, 0x0f, 0x85, 0xae, 0xda, 0x8a, 0x1f, 0xa3, 0x36, 0x22, 0x78, 0x4d, 0xd8,
-	0xdd, 0xcb, 0x7e, 0xcc, 0xba, 0xee, 0x64, 0x66, 0x32, 0xb3, 0x3b, 0xa3, 0x4e, 0xb2, 0x28, 0x78,
-	0x91, 0x9a, 0xce, 0xbb, 0x93, 0x62, 0x7a, 0x52, 0x6d, 0x55, 0x25, 0x9a, 0x9b, 0xe0, 0x49, 0x58,
-	0x50, 0x04, 0xc1, 0x93, 0xe0, 0x49, 0x11, 0x04, 0x41, 0x10, 0x04, 0xc1, 0x93, 0xe0, 0x71, 0x8e,
-	0x7b, 0x74, 0x32, 0x17, 0x8f, 0xfb, 0x27, 0x2c, 0x49, 0xa7, 0x6a, 0x52, 0xdd, 0xd5, 0xa1, 0xaa,
-	0x92, 0xdb, 0x6e, 0xa6, 0x7e, 0x4f, 0x3f, 0x5d, 0x5f, 0x6f, 0xa5, 0x82, 0xaf, 0x4a, 0x38, 0xc9,
-	0x18, 0x27, 0x69, 0x43, 0x00, 0x1f, 0x02, 0x6f, 0x90, 0x8c, 0x36, 0x7a, 0x54, 0x48, 0xc6, 0x47,
-	0x93, 0x4f, 0x68, 0x02, 0x8d, 0xe1, 0xe5, 0xc6, 0xec, 0x9f, 0xf5, 0x8c, 0x33, 0xc9, 0xa2, 0xb7,
-	0x55, 0xa8, 0x9e, 0x87, 0xea, 0x24, 0xa3, 0x75, 0x33, 0x54, 0x1f, 0x5e, 0x5e, 0x5b, 0x77, 0x63,
-	0x73, 0xf8, 0x74, 0x00, 0x42, 0x7e, 0xc2, 0x41, 0x64, 0xac, 0x2f, 0x66, 0x0f, 0xb9, 0xf2, 0xb0,
-	0x81, 0x2f, 0xed, 0xe4, 0x8d, 0x3b, 0x79, 0xe3, 0xe8, 0x27, 0x84, 0x5f, 0xea, 0x48, 0xc2, 0xe5,
-	0x47, 0x8c, 0x1f, 0x3f, 0x48, 0xd9, 0x67, 0x5b, 0x9f, 0x43, 0x32, 0x90, 0x94, 0xf5, 0xa3, 0xcd,
-	0xba, 0x93, 0x53, 0xdd, 0x1e, 0x6f, 0xe7, 0x0a, 0x6b, 0x5b, 0x4b, 0x52, 0xf2, 0x17, 0x78, 0xab,
-	0x16, 0x7d, 0x8b, 0xf0, 0xb3, 0x2d, 0x90, 0xfb, 0x03, 0x49, 0x0e, 0x53, 0xe8, 0x48, 0x22, 0x21,
-	0xba, 0xe5, 0x08, 0x2f, 0xe4, 0x94, 0xdb, 0x3b, 0xa1, 0x71, 0x2d, 0xf5, 0x1d, 0xc2, 0xcf, 0x7d,
-	0xc0, 0xd2, 0xd4, 0xb0, 0x72, 0xc5, 0x16, 0x83, 0x4a, 0xeb, 0x76, 0x70, 0x5e, 0x7b, 0xfd, 0x88,
-	0xf0, 0x8b, 0x6d, 0x10, 0x20, 0x3b, 0x92, 0x26, 0xc7, 0xa3, 0xfb, 0x44, 0x1c, 0x1f, 0x0c, 0x60,
-	0x00, 0xd1, 0x86, 0x23, 0xdb, 0x16, 0x56, 0x7e, 0xcd, 0xa5, 0x18, 0xda, 0xf1, 0x37, 0x84, 0x5f,
-	0x6d, 0x43, 0xc2, 0x78, 0x57, 0x0d, 0xfb, 0xa4, 0xd5, 0x74, 0x1e, 0x40, 0x37, 0x6a, 0x39, 0x3f,
-	0xa4, 0x82, 0xa0, 0x6c, 0x77, 0x96, 0x07, 0x59, 0x94, 0xef, 0x24, 0x92, 0x0e, 0xa9, 0x1c, 0x85,
-	0x2b, 0x5b, 0x08, 0x61, 0xca, 0x56, 0x90, 0x56, 0xfe, 0x13, 0xe1, 0xd7, 0xf3, 0xff, 0x1a, 0xef,
-	0xd6, 0x64, 0x27, 0x59, 0x0a, 0x13, 0xeb, 0xbb, 0xee, 0xa3, 0x59, 0x09, 0x51, 0xe2, 0xf7, 0x56,
-	0xc2, 0x2a, 0x74, 0x77, 0xa9, 0xe9, 0x36, 0xa1, 0xa9, 0x57, 0x77, 0x57, 0x10, 0xfc, 0xbb, 0xbb,
-	0x12, 0xa4, 0x95, 0xff, 0x40, 0xf8, 0xb5, 0xf2, 0xb0, 0xec, 0x00, 0xe1, 0xf2, 0x10, 0x88, 0x8c,
-	0x76, 0x83, 0x87, 0x56, 0x33, 0x94, 0xf6, 0xdd, 0x55, 0xa0, 0x6c, 0xf3, 0x64, 0xbe, 0x69, 0xf0,
-	0x3c, 0xb1, 0x42, 0x02, 0xe7, 0x49, 0x05, 0xcb, 0x36, 0x4f, 0xe6, 0x9b, 0x86, 0xcd, 0x93, 0x32,
-	0x21, 0x70, 0x9e, 0xd8, 0x40, 0x85, 0x79, 0x52, 0x7e, 0x3b, 0xd2, 0x4f, 0x60, 0x22, 0xbd, 0xbb,
-	0x44, 0x0f, 0xcd, 0x18, 0xfe, 0xf3, 0x64, 0x01, 0x4a, 0x8b, 0xff, 0x82, 0xf0, 0xcb, 0x1d, 0x7a,
-	0xd4, 0x27, 0x69, 0xf9, 0xc4, 0xe0, 0x5c, 0xeb, 0xed, 0x79, 0x25, 0xbc, 0xbd, 0x2c, 0x46, 0xcb,
-	0xfe, 0x83, 0xf0, 0x9b, 0xb3, 0x56, 0x54, 0xf6, 0x2a, 0xce, 0x39, 0xef, 0xf9, 0x3d, 0xae, 0x12,
-	0xa4, 0xf4, 0xdf, 0x5f, 0x19, 0x4f, 0xbf, 0xc7, 0xaf, 0x08, 0xbf, 0xd2, 0x86, 0x13, 0x36, 0x84,
-	0x3c, 0x64, 0x1c, 0x37, 0xb6, 0x9d, 0xc7, 0xd7, 0x0e, 0x50, 0xde, 0xad, 0xa5, 0x39, 0xda, 0xf7,
-	0x77, 0x84, 0xd7, 0xee, 0x03, 0x3f, 0xa1, 0x7d, 0x22, 0xa1, 0xdc, 0xe3, 0xae, 0x0b, 0xa9, 0x1a,
-	0xa1, 0x9c, 0x77, 0x57, 0x40, 0xd2, 0xd6, 0x93, 0xb3, 0xf0, 0xf4, 0xcc, 0x12, 0x7e, 0x16, 0xb6,
-	0xc7, 0x7d, 0xcf, 0xc2, 0x55, 0x14, 0x6d, 0xfa, 0x37, 0xc2, 0xf1, 0x0c, 0x9a, 0x2f, 0xd1, 0xb2,
-	0xf1, 0x9e, 0xf3, 0xb3, 0x16, 0x61, 0x94, 0xf9, 0xfe, 0x8a, 0x68, 0xc6, 0x01, 0xb5, 0x93, 0xf4,
-	0xa0, 0x3b, 0x48, 0x61, 0xbe, 0xa0, 0x3a, 0x1f, 0x50, 0x6d, 0x61, 0xdf, 0x03, 0xaa, 0x9d, 0xa1,
-	0x1d, 0xff, 0x42, 0xf8, 0x8d, 0xbc, 0x78, 0x36, 0x7b, 0x34, 0xed, 0xea, 0xd7, 0xb8, 0xa8, 0x89,
-	0xf7, 0xbc, 0x4a, 0x70, 0x05, 0x45, 0x59, 0xef, 0xad, 0x06, 0x66, 0x54, 0xc5, 0x4d, 0x10, 0x09,
-	0xa7, 0x87, 0x96, 0x35, 0xe8, 0xba, 0xda, 0x2b, 0x09, 0xbe, 0x55, 0x71, 0x01, 0x48, 0x2b, 0x7f,
-	0x8f, 0xf0, 0xf3, 0x6d, 0xc8, 0x52, 0x9a, 0x10, 0x09, 0x5b, 0x43, 0xe8, 0x4b, 0xf1, 0xe1, 0x95,
-	0xe8, 0xb6, 0x73, 0xc7, 0x14, 0x92, 0x4a, 0xf1, 0xdd, 0x70, 0x80, 0xf1, 0xf5, 0xb3, 0x33, 0xea,
-	0x27, 0x9d, 0x1e, 0xe1, 0xdd, 0xc9, 0x7e, 0x37, 0x10, 0xce, 0x5f, 0x3f, 0x0b, 0x39, 0xdf, 0xaf,
-	0x9f, 0xa5, 0xb8, 0x96, 0xfa, 0x0a, 0xe1, 0xa7, 0x27, 0x7f, 0x55, 0x35, 0x3b, 0xba, 0xe1, 0x81,
-	0x54, 0x21, 0xa5, 0x73, 0x33, 0x28, 0x6b, 0xac, 0x68, 0x35, 0xc6, 0x46, 0x7d, 0xda, 0xf0, 0x9c,
-	0x20, 0xb6, 0xda, 0xd4, 0x5c, 0x8a, 0xa1, 0x1d, 0x7f, 0x40, 0xf8, 0x05, 0xd5, 0x64, 0x76, 0x11,
-	0xb2, 0xc3, 0x84, 0x8c, 0xee, 0x78, 0xe2, 0xe7, 0xb2, 0xca, 0x70, 0x63, 0x19, 0x84, 0x16, 0xfc,
-	0x12, 0x61, 0xdc, 0x4c, 0x99, 0x80, 0xe9, 0x78, 0x47, 0xd7, 0x1c, 0xa1, 0x17, 0x11, 0xa5, 0x73,
-	0x3d, 0x20, 0x69, 0x58, 0xe4, 0x55, 0x7e, 0xba, 0x25, 0x5f, 0xf3, 0x3a, 0x18, 0xcc, 0x6f, 0xc4,
-	0xd7, 0x03, 0x92, 0x46, 0x39, 0x6e, 0x81, 0x54, 0x8b, 0x92, 0xb2, 0xfe, 0x3e, 0x08, 0x41, 0x8e,
-	0x40, 0x38, 0x97, 0x63, 0x7b, 0xdc, 0xb7, 0x1c, 0x57, 0x51, 0x8c, 0x9d, 0xb6, 0x05, 0x72, 0x73,
-	0xef, 0xc0, 0x26, 0xdb, 0x72, 0x7f, 0x8c, 0x9d, 0xe0, 0xbb, 0xd3, 0x2e, 0x00, 0x69, 0xe5, 0x87,
-	0x08, 0x3f, 0x73, 0x30, 0x00, 0x3e, 0x52, 0xdb, 0x71, 0xe4, 0xba, 0xfc, 0x8d, 0x94, 0x52, 0x5b,
-	0x0f, 0x0b, 0x1b, 0x3a, 0x6d, 0x20, 0x59, 0x96, 0x8e, 0xf2, 0xbd, 0xd7, 0x59, 0xc7, 0x48, 0xf9,
-	0xea, 0x14, 0xc2, 0x5a, 0xe7, 0x6b, 0x84, 0x2f, 0xe5, 0xbd, 0xa8, 0x47, 0x71, 0xdd, 0xab, 0xf3,
-	0x8b, 0x43, 0x77, 0x2b, 0x30, 0x6d, 0x5e, 0x34, 0x0e, 0xf8, 0x11, 0xcc, 0x3b, 0x39, 0x5f, 0x34,
-	0x16, 0x82, 0xde, 0x17, 0x8d, 0xa5, 0xbc, 0xe1, 0xb5, 0x0f, 0x81, 0x5e, 0xc5, 0xa0, 0xaf, 0x57,
-	0x39, 0x5f, 0xb8, 0x00, 0x7d, 0xc0, 0x41, 0xf4, 0xe6, 0x4f, 0x77, 0xc2, 0xe3, 0x02, 0xb4, 0x1c,
-	0xf6, 0xbf, 0x00, 0xb5, 0x31, 0x94, 0xe3, 0x46, 0x76, 0x7a, 0x16, 0xd7, 0x1e, 0x9d, 0xc5, 0xb5,
-	0xc7, 0x67, 0x31, 0xfa, 0x62, 0x1c, 0xa3, 0x9f, 0xc7, 0x31, 0xfa, 0x77, 0x1c, 0xa3, 0xd3, 0x71,
-	0x8c, 0xfe, 0x1b, 0xc7, 0xe8, 0xff, 0x71, 0x5c, 0x7b, 0x3c, 0x8e, 0xd1, 0x37, 0xe7, 0x71, 0xed,
-	0xf4, 0x3c, 0xae, 0x3d, 0x3a, 0x8f, 0x6b, 0x1f, 0xdf, 0x38, 0x62, 0x17, 0x8f, 0xa7, 0x6c, 0xe1,
-	0x0f, 0x01, 0x37, 0xcd, 0x4f, 0x0e, 0x9f, 0x9a, 0xfe, 0x0e, 0x70, 0xf5, 0x49, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x3e, 0xd6, 0x58, 0xf3, 0xa3, 0x18, 0x00, 0x00,
+	// 1112 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x99, 0xcd, 0x6b, 0x24, 0x45,
+	0x18, 0x87, 0xa7, 0x2e, 0x1e, 0x0a, 0x5d, 0xb5, 0xfd, 0x8e, 0xda, 0x88, 0xe0, 0x75, 0x86, 0xdd,
+	0xf5, 0xb0, 0x1f, 0x59, 0xd7, 0xcd, 0x24, 0x99, 0x64, 0x37, 0xa3, 0x9b, 0x99, 0xac, 0x82, 0x17,
+	0xa9, 0xf4, 0xbc, 0x3b, 0xd3, 0xa4, 0x33, 0xd5, 0x76, 0x55, 0x8f, 0xce, 0x4d, 0xf0, 0x24, 0x08,
+	0x8a, 0x20, 0x78, 0x12, 0x04, 0x41, 0x11, 0x04, 0x41, 0x10, 0x04, 0xc1, 0x93, 0xe2, 0x31, 0xc7,
+	0x3d, 0x9a, 0xc9, 0xc5, 0xe3, 0xfe, 0x09, 0xcb, 0x4c, 0x4f, 0x55, 0xa6, 0xba, 0xab, 0x87, 0xaa,
+	0xea, 0xb9, 0xed, 0x26, 0xf5, 0x7b, 0xfa, 0xe9, 0xaa, 0xea, 0xaa, 0xb7, 0x2a, 0xf8, 0x32, 0x87,
+	0xe3, 0x98, 0x26, 0x24, 0x6a, 0x30, 0x48, 0x46, 0x90, 0x34, 0x48, 0x1c, 0x36, 0x06, 0x21, 0xe3,
+	0x34, 0x19, 0x4f, 0x7f, 0x12, 0x06, 0xd0, 0x18, 0x5d, 0x6c, 0xcc, 0xff, 0x59, 0x8f, 0x13, 0xca,
+	0xa9, 0xf7, 0x86, 0x08, 0xd5, 0xb3, 0x50, 0x9d, 0xc4, 0x61, 0x5d, 0x0d, 0xd5, 0x47, 0x17, 0xd7,
+	0xd6, 0xcd, 0xd8, 0x09, 0x7c, 0x94, 0x02, 0xe3, 0x1f, 0x26, 0xc0, 0x62, 0x3a, 0x64, 0xf3, 0x87,
+	0x5c, 0xfa, 0xe7, 0x4d, 0x7c, 0x61, 0x27, 0x6b, 0xdc, 0xcd, 0x1a, 0x7b, 0x3f, 0x22, 0xfc, 0x7c,
+	0x97, 0x93, 0x84, 0xbf, 0x4f, 0x93, 0xa3, 0xfb, 0x11, 0xfd, 0x78, 0xeb, 0x13, 0x08, 0x52, 0x1e,
+	0xd2, 0xa1, 0xb7, 0x59, 0x37, 0x72, 0xaa, 0xeb, 0xe3, 0x9d, 0x4c, 0x61, 0x6d, 0xab, 0x22, 0x25,
+	0x7b, 0x81, 0xd7, 0x6b, 0xde, 0xd7, 0x08, 0x3f, 0xd9, 0x02, 0xde, 0x4e, 0x39, 0x39, 0x8c, 0xa0,
+	0xcb, 0x09, 0x07, 0xef, 0x86, 0x21, 0x3c, 0x97, 0x13, 0x6e, 0x6f, 0xb9, 0xc6, 0xa5, 0xd4, 0x37,
+	0x08, 0x3f, 0x75, 0x97, 0x46, 0x91, 0x62, 0x65, 0x8a, 0xcd, 0x07, 0x85, 0xd6, 0x4d, 0xe7, 0xbc,
+	0xf4, 0xfa, 0x1e, 0xe1, 0x67, 0x3b, 0xc0, 0x80, 0x77, 0x79, 0x18, 0x1c, 0x8d, 0x0f, 0x08, 0x3b,
+	0xda, 0x4f, 0x21, 0x05, 0x6f, 0xc3, 0x90, 0xad, 0x0b, 0x0b, 0xbf, 0x66, 0x25, 0x86, 0x74, 0xfc,
+	0x15, 0xe1, 0x97, 0x3a, 0x10, 0xd0, 0xa4, 0x27, 0x86, 0x7d, 0xda, 0x6a, 0x36, 0x0f, 0xa0, 0xe7,
+	0xb5, 0x8c, 0x1f, 0x52, 0x42, 0x10, 0xb6, 0x3b, 0xd5, 0x41, 0x1a, 0xe5, 0x5b, 0x01, 0x0f, 0x47,
+	0x21, 0x1f, 0xbb, 0x2b, 0x6b, 0x08, 0x6e, 0xca, 0x5a, 0x90, 0x54, 0xfe, 0x03, 0xe1, 0x57, 0xb2,
+	0xff, 0x2a, 0xef, 0xd6, 0xa4, 0xc7, 0x71, 0x04, 0x53, 0xeb, 0xdb, 0xe6, 0xa3, 0x59, 0x0a, 0x11,
+	0xe2, 0x77, 0x56, 0xc2, 0xca, 0x75, 0x77, 0xa1, 0xe9, 0x36, 0x09, 0x23, 0xab, 0xee, 0x2e, 0x21,
+	0xd8, 0x77, 0x77, 0x29, 0x48, 0x2a, 0xff, 0x8e, 0xf0, 0xcb, 0xc5, 0x61, 0xd9, 0x01, 0x92, 0xf0,
+	0x43, 0x20, 0xdc, 0xdb, 0x75, 0x1e, 0x5a, 0xc9, 0x10, 0xda, 0xb7, 0x57, 0x81, 0xd2, 0xcd, 0x93,
+	0xc5, 0xa6, 0xce, 0xf3, 0x44, 0x0b, 0x71, 0x9c, 0x27, 0x25, 0x2c, 0xdd, 0x3c, 0x59, 0x6c, 0xea,
+	0x36, 0x4f, 0x8a, 0x04, 0xc7, 0x79, 0xa2, 0x03, 0xe5, 0xe6, 0x49, 0xf1, 0xed, 0xc8, 0x30, 0x80,
+	0xa9, 0xf4, 0x6e, 0x85, 0x1e, 0x9a, 0x33, 0xec, 0xe7, 0xc9, 0x12, 0x94, 0x14, 0xff, 0x19, 0xe1,
+	0x17, 0xba, 0x61, 0x7f, 0x48, 0xa2, 0x62, 0xc5, 0x60, 0xbc, 0xd7, 0xeb, 0xf3, 0x42, 0x78, 0xbb,
+	0x2a, 0x46, 0xca, 0xfe, 0x8d, 0xf0, 0x6b, 0xf3, 0x56, 0x21, 0x1f, 0x94, 0xd4, 0x39, 0xef, 0xd8,
+	0x3d, 0xae, 0x14, 0x24, 0xf4, 0xdf, 0x5d, 0x19, 0x4f, 0xbe, 0xc7, 0x2f, 0x08, 0xbf, 0xd8, 0x81,
+	0x63, 0x3a, 0x82, 0x2c, 0xa4, 0x94, 0x1b, 0xdb, 0xc6, 0xe3, 0xab, 0x07, 0x08, 0xef, 0x56, 0x65,
+	0x8e, 0xf4, 0xfd, 0x0d, 0xe1, 0xb5, 0x03, 0x48, 0x8e, 0xc3, 0x21, 0xe1, 0x50, 0xec, 0x71, 0xd3,
+	0x0f, 0xa9, 0x1c, 0x21, 0x9c, 0x77, 0x57, 0x40, 0x92, 0xd6, 0xd3, 0x5a, 0x78, 0x56, 0xb3, 0xb8,
+	0xd7, 0xc2, 0xfa, 0xb8, 0x6d, 0x2d, 0x5c, 0x46, 0x91, 0xa6, 0x7f, 0x21, 0xec, 0xcf, 0xa1, 0xd9,
+	0x27, 0x5a, 0x34, 0xde, 0x33, 0x7e, 0xd6, 0x32, 0x8c, 0x30, 0x6f, 0xaf, 0x88, 0xa6, 0x14, 0xa8,
+	0xdd, 0x60, 0x00, 0xbd, 0x34, 0x82, 0xc5, 0x0d, 0xd5, 0xb8, 0x40, 0xd5, 0x85, 0x6d, 0x0b, 0x54,
+	0x3d, 0x43, 0x3a, 0xfe, 0x89, 0xf0, 0xab, 0xd9, 0xe6, 0xd9, 0x1c, 0x84, 0x51, 0x4f, 0xbe, 0xc6,
+	0xf9, 0x9e, 0x78, 0xc7, 0x6a, 0x0b, 0x2e, 0xa1, 0x08, 0xeb, 0xbd, 0xd5, 0xc0, 0x94, 0x5d, 0x71,
+	0x13, 0x58, 0x90, 0x84, 0x87, 0x9a, 0x6f, 0xd0, 0xf4, 0x6b, 0x2f, 0x25, 0xd8, 0xee, 0x8a, 0x4b,
+	0x40, 0x52, 0xf9, 0x5b, 0x84, 0x9f, 0xee, 0x40, 0x1c, 0x85, 0x01, 0xe1, 0xb0, 0x35, 0x82, 0x21,
+	0x67, 0xef, 0x5d, 0xf2, 0x6e, 0x1a, 0x77, 0x4c, 0x2e, 0x29, 0x14, 0xdf, 0x76, 0x07, 0x28, 0xc7,
+	0xcf, 0xee, 0x78, 0x18, 0x74, 0x07, 0x24, 0xe9, 0x4d, 0xd7, 0xbb, 0x94, 0x19, 0x1f, 0x3f, 0x73,
+	0x39, 0xdb, 0xe3, 0x67, 0x21, 0x2e, 0xa5, 0x3e, 0x47, 0xf8, 0xf1, 0xe9, 0x6f, 0xc5, 0x9e, 0xed,
+	0x5d, 0xb3, 0x40, 0x8a, 0x90, 0xd0, 0xb9, 0xee, 0x94, 0x55, 0xbe, 0x68, 0x31, 0xc6, 0xca, 0xfe,
+	0xb4, 0x61, 0x39, 0x41, 0x74, 0x7b, 0x53, 0xb3, 0x12, 0x43, 0x3a, 0x7e, 0x87, 0xf0, 0x33, 0xa2,
+	0xc9, 0xfc, 0x22, 0x64, 0x87, 0x32, 0xee, 0xdd, 0xb2, 0xc4, 0x2f, 0x64, 0x85, 0xe1, 0x46, 0x15,
+	0x84, 0x14, 0xfc, 0x0c, 0x61, 0xdc, 0x8c, 0x28, 0x83, 0xd9, 0x78, 0x7b, 0x57, 0x0c, 0xa1, 0xe7,
+	0x11, 0xa1, 0x73, 0xd5, 0x21, 0xa9, 0x58, 0x64, 0xbb, 0xfc, 0x6c, 0x49, 0xbe, 0x62, 0x55, 0x18,
+	0x2c, 0x2e, 0xc4, 0x57, 0x1d, 0x92, 0xca, 0x76, 0xdc, 0x02, 0x2e, 0x3e, 0xca, 0x90, 0x0e, 0xdb,
+	0xc0, 0x18, 0xe9, 0x03, 0x33, 0xde, 0x8e, 0xf5, 0x71, 0xdb, 0xed, 0xb8, 0x8c, 0xa2, 0xac, 0xb4,
+	0x2d, 0xe0, 0x9b, 0x7b, 0xfb, 0x3a, 0xd9, 0x96, 0xf9, 0x63, 0xf4, 0x04, 0xdb, 0x95, 0x76, 0x09,
+	0x48, 0x2a, 0x7f, 0x81, 0xf0, 0x13, 0xfb, 0x29, 0x24, 0x63, 0xb1, 0x1c, 0x7b, 0xa6, 0x9f, 0xbf,
+	0x92, 0x12, 0x6a, 0xeb, 0x6e, 0x61, 0x45, 0xa7, 0x03, 0x24, 0x8e, 0xa3, 0x71, 0xb6, 0xf6, 0x1a,
+	0xeb, 0x28, 0x29, 0x5b, 0x9d, 0x5c, 0x58, 0xea, 0x7c, 0x89, 0xf0, 0x85, 0xac, 0x17, 0xe5, 0x28,
+	0xae, 0x5b, 0x75, 0x7e, 0x7e, 0xe8, 0x6e, 0x38, 0xa6, 0xd5, 0x8b, 0xc6, 0x34, 0xe9, 0xc3, 0xa2,
+	0x93, 0xf1, 0x45, 0x63, 0x2e, 0x68, 0x7d, 0xd1, 0x58, 0xc8, 0x2b, 0x5e, 0x6d, 0x70, 0xf4, 0x6a,
+	0x43, 0x35, 0xaf, 0x36, 0x94, 0x7a, 0x65, 0x17, 0xa0, 0xf7, 0x13, 0x60, 0x83, 0xc5, 0xea, 0x8e,
+	0x59, 0x5c, 0x80, 0x16, 0xc3, 0xf6, 0x17, 0xa0, 0x3a, 0x86, 0x72, 0x07, 0x70, 0x2f, 0xee, 0xe9,
+	0x4e, 0x25, 0x07, 0xa4, 0xcf, 0x8c, 0xef, 0x00, 0x96, 0x30, 0x6c, 0xef, 0x00, 0x96, 0xa2, 0x94,
+	0x95, 0xf9, 0x2e, 0x49, 0x19, 0xb8, 0x1f, 0x94, 0xf4, 0x71, 0xdb, 0x95, 0xb9, 0x8c, 0xa2, 0x1c,
+	0x9c, 0xef, 0x0d, 0x63, 0xbd, 0xab, 0xe9, 0xc1, 0xb9, 0x0c, 0x60, 0x7b, 0x70, 0x2e, 0xe7, 0x48,
+	0xdf, 0x1f, 0x10, 0x7e, 0x2e, 0x5f, 0x28, 0xef, 0xd1, 0xe0, 0x88, 0x79, 0x4d, 0xc7, 0x32, 0x7b,
+	0x96, 0x16, 0xa6, 0x9b, 0xd5, 0x20, 0x42, 0x73, 0x23, 0x3e, 0x39, 0xf5, 0x6b, 0x0f, 0x4e, 0xfd,
+	0xda, 0xc3, 0x53, 0x1f, 0x7d, 0x3a, 0xf1, 0xd1, 0x4f, 0x13, 0x1f, 0xfd, 0x3b, 0xf1, 0xd1, 0xc9,
+	0xc4, 0x47, 0xff, 0x4d, 0x7c, 0xf4, 0xff, 0xc4, 0xaf, 0x3d, 0x9c, 0xf8, 0xe8, 0xab, 0x33, 0xbf,
+	0x76, 0x72, 0xe6, 0xd7, 0x1e, 0x9c, 0xf9, 0xb5, 0x0f, 0xae, 0xf5, 0xe9, 0xf9, 0xf3, 0x43, 0xba,
+	0xf4, 0x4f, 0x58, 0xd7, 0xd5, 0x9f, 0x1c, 0x3e, 0x36, 0xfb, 0x0b, 0xd6, 0xe5, 0x47, 0x03, 0x00,
+	0x91, 0x4d, 0x14, 0x63, 0x5d, 0x1b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -262,6 +267,14 @@ type HistoryServiceClient interface {
 	MergeDLQMessages(ctx context.Context, in *MergeDLQMessagesRequest, opts ...grpc.CallOption) (*MergeDLQMessagesResponse, error)
 	// RefreshWorkflowTasks refreshes all tasks of a workflow.
 	RefreshWorkflowTasks(ctx context.Context, in *RefreshWorkflowTasksRequest, opts ...grpc.CallOption) (*RefreshWorkflowTasksResponse, error)
+	// UpdateWorkflowExecutionTags upserts and removes non-indexed tags of a running workflow.
+	UpdateWorkflowExecutionTags(ctx context.Context, in *UpdateWorkflowExecutionTagsRequest, opts ...grpc.CallOption) (*UpdateWorkflowExecutionTagsResponse, error)
+	// PauseWorkflowExecution stops dispatching workflow tasks of a running workflow to workers.
+	PauseWorkflowExecution(ctx context.Context, in *PauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*PauseWorkflowExecutionResponse, error)
+	// UnpauseWorkflowExecution resumes dispatching workflow tasks of a paused workflow.
+	UnpauseWorkflowExecution(ctx context.Context, in *UnpauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*UnpauseWorkflowExecutionResponse, error)
+	// DescribeWorkflowLocks returns hold times and queue lengths of contended workflow execution locks in the history cache of a shard.
+	DescribeWorkflowLocks(ctx context.Context, in *DescribeWorkflowLocksRequest, opts ...grpc.CallOption) (*DescribeWorkflowLocksResponse, error)
 }
 
 type historyServiceClient struct {
@@ -596,6 +609,42 @@ func (c *historyServiceClient) RefreshWorkflowTasks(ctx context.Context, in *Ref
 	return out, nil
 }
 
+func (c *historyServiceClient) UpdateWorkflowExecutionTags(ctx context.Context, in *UpdateWorkflowExecutionTagsRequest, opts ...grpc.CallOption) (*UpdateWorkflowExecutionTagsResponse, error) {
+	out := new(UpdateWorkflowExecutionTagsResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/UpdateWorkflowExecutionTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) PauseWorkflowExecution(ctx context.Context, in *PauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*PauseWorkflowExecutionResponse, error) {
+	out := new(PauseWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/PauseWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) UnpauseWorkflowExecution(ctx context.Context, in *UnpauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*UnpauseWorkflowExecutionResponse, error) {
+	out := new(UnpauseWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/UnpauseWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) DescribeWorkflowLocks(ctx context.Context, in *DescribeWorkflowLocksRequest, opts ...grpc.CallOption) (*DescribeWorkflowLocksResponse, error) {
+	out := new(DescribeWorkflowLocksResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/DescribeWorkflowLocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HistoryServiceServer is the server API for HistoryService service.
 type HistoryServiceServer interface {
 	// StartWorkflowExecution starts a new long running workflow instance.  It will create the instance with
@@ -726,6 +775,14 @@ type HistoryServiceServer interface {
 	MergeDLQMessages(context.Context, *MergeDLQMessagesRequest) (*MergeDLQMessagesResponse, error)
 	// RefreshWorkflowTasks refreshes all tasks of a workflow.
 	RefreshWorkflowTasks(context.Context, *RefreshWorkflowTasksRequest) (*RefreshWorkflowTasksResponse, error)
+	// UpdateWorkflowExecutionTags upserts and removes non-indexed tags of a running workflow.
+	UpdateWorkflowExecutionTags(context.Context, *UpdateWorkflowExecutionTagsRequest) (*UpdateWorkflowExecutionTagsResponse, error)
+	// PauseWorkflowExecution stops dispatching workflow tasks of a running workflow to workers.
+	PauseWorkflowExecution(context.Context, *PauseWorkflowExecutionRequest) (*PauseWorkflowExecutionResponse, error)
+	// UnpauseWorkflowExecution resumes dispatching workflow tasks of a paused workflow.
+	UnpauseWorkflowExecution(context.Context, *UnpauseWorkflowExecutionRequest) (*UnpauseWorkflowExecutionResponse, error)
+	// DescribeWorkflowLocks returns hold times and queue lengths of contended workflow execution locks in the history cache of a shard.
+	DescribeWorkflowLocks(context.Context, *DescribeWorkflowLocksRequest) (*DescribeWorkflowLocksResponse, error)
 }
 
 // UnimplementedHistoryServiceServer can be embedded to have forward compatible implementations.
@@ -840,6 +897,18 @@ func (*UnimplementedHistoryServiceServer) MergeDLQMessages(ctx context.Context,
 func (*UnimplementedHistoryServiceServer) RefreshWorkflowTasks(ctx context.Context, req *RefreshWorkflowTasksRequest) (*RefreshWorkflowTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RefreshWorkflowTasks not implemented")
 }
+func (*UnimplementedHistoryServiceServer) UpdateWorkflowExecutionTags(ctx context.Context, req *UpdateWorkflowExecutionTagsRequest) (*UpdateWorkflowExecutionTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkflowExecutionTags not implemented")
+}
+func (*UnimplementedHistoryServiceServer) PauseWorkflowExecution(ctx context.Context, req *PauseWorkflowExecutionRequest) (*PauseWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseWorkflowExecution not implemented")
+}
+func (*UnimplementedHistoryServiceServer) UnpauseWorkflowExecution(ctx context.Context, req *UnpauseWorkflowExecutionRequest) (*UnpauseWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseWorkflowExecution not implemented")
+}
+func (*UnimplementedHistoryServiceServer) DescribeWorkflowLocks(ctx context.Context, req *DescribeWorkflowLocksRequest) (*DescribeWorkflowLocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeWorkflowLocks not implemented")
+}
 
 func RegisterHistoryServiceServer(s *grpc.Server, srv HistoryServiceServer) {
 	s.RegisterService(&_HistoryService_serviceDesc, srv)
@@ -1493,6 +1562,78 @@ func _HistoryService_RefreshWorkflowTasks_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_UpdateWorkflowExecutionTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWorkflowExecutionTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).UpdateWorkflowExecutionTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/UpdateWorkflowExecutionTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).UpdateWorkflowExecutionTags(ctx, req.(*UpdateWorkflowExecutionTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_PauseWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).PauseWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/PauseWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).PauseWorkflowExecution(ctx, req.(*PauseWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_UnpauseWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpauseWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).UnpauseWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/UnpauseWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).UnpauseWorkflowExecution(ctx, req.(*UnpauseWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_DescribeWorkflowLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeWorkflowLocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).DescribeWorkflowLocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/DescribeWorkflowLocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).DescribeWorkflowLocks(ctx, req.(*DescribeWorkflowLocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _HistoryService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "temporal.server.api.historyservice.v1.HistoryService",
 	HandlerType: (*HistoryServiceServer)(nil),
@@ -1641,6 +1782,22 @@ var _HistoryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RefreshWorkflowTasks",
 			Handler:    _HistoryService_RefreshWorkflowTasks_Handler,
 		},
+		{
+			MethodName: "UpdateWorkflowExecutionTags",
+			Handler:    _HistoryService_UpdateWorkflowExecutionTags_Handler,
+		},
+		{
+			MethodName: "PauseWorkflowExecution",
+			Handler:    _HistoryService_PauseWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "UnpauseWorkflowExecution",
+			Handler:    _HistoryService_UnpauseWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "DescribeWorkflowLocks",
+			Handler:    _HistoryService_DescribeWorkflowLocks_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "temporal/server/api/historyservice/v1/service.proto",
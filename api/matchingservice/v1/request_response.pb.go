@@ -49,6 +49,7 @@ import (
 	v1 "go.temporal.io/api/workflowservice/v1"
 	v15 "go.temporal.io/server/api/enums/v1"
 	v13 "go.temporal.io/server/api/history/v1"
+	v17 "go.temporal.io/server/api/taskqueue/v1"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1113,6 +1114,108 @@ func (m *CancelOutstandingPollResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CancelOutstandingPollResponse proto.InternalMessageInfo
 
+type RemovePollerRequest struct {
+	NamespaceId   string            `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	TaskQueueType v16.TaskQueueType `protobuf:"varint,2,opt,name=task_queue_type,json=taskQueueType,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_queue_type,omitempty"`
+	TaskQueue     *v14.TaskQueue    `protobuf:"bytes,3,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	Identity      string            `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
+}
+
+func (m *RemovePollerRequest) Reset()      { *m = RemovePollerRequest{} }
+func (*RemovePollerRequest) ProtoMessage() {}
+func (*RemovePollerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{14}
+}
+func (m *RemovePollerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemovePollerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemovePollerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemovePollerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemovePollerRequest.Merge(m, src)
+}
+func (m *RemovePollerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemovePollerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemovePollerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemovePollerRequest proto.InternalMessageInfo
+
+func (m *RemovePollerRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *RemovePollerRequest) GetTaskQueueType() v16.TaskQueueType {
+	if m != nil {
+		return m.TaskQueueType
+	}
+	return v16.TASK_QUEUE_TYPE_UNSPECIFIED
+}
+
+func (m *RemovePollerRequest) GetTaskQueue() *v14.TaskQueue {
+	if m != nil {
+		return m.TaskQueue
+	}
+	return nil
+}
+
+func (m *RemovePollerRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+type RemovePollerResponse struct {
+}
+
+func (m *RemovePollerResponse) Reset()      { *m = RemovePollerResponse{} }
+func (*RemovePollerResponse) ProtoMessage() {}
+func (*RemovePollerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{15}
+}
+func (m *RemovePollerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemovePollerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemovePollerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemovePollerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemovePollerResponse.Merge(m, src)
+}
+func (m *RemovePollerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemovePollerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemovePollerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemovePollerResponse proto.InternalMessageInfo
+
 type DescribeTaskQueueRequest struct {
 	NamespaceId string                       `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	DescRequest *v1.DescribeTaskQueueRequest `protobuf:"bytes,2,opt,name=desc_request,json=descRequest,proto3" json:"desc_request,omitempty"`
@@ -1121,7 +1224,7 @@ type DescribeTaskQueueRequest struct {
 func (m *DescribeTaskQueueRequest) Reset()      { *m = DescribeTaskQueueRequest{} }
 func (*DescribeTaskQueueRequest) ProtoMessage() {}
 func (*DescribeTaskQueueRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a429a3813476c583, []int{14}
+	return fileDescriptor_a429a3813476c583, []int{16}
 }
 func (m *DescribeTaskQueueRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1167,12 +1270,16 @@ func (m *DescribeTaskQueueRequest) GetDescRequest() *v1.DescribeTaskQueueRequest
 type DescribeTaskQueueResponse struct {
 	Pollers         []*v14.PollerInfo    `protobuf:"bytes,1,rep,name=pollers,proto3" json:"pollers,omitempty"`
 	TaskQueueStatus *v14.TaskQueueStatus `protobuf:"bytes,2,opt,name=task_queue_status,json=taskQueueStatus,proto3" json:"task_queue_status,omitempty"`
+	// Only set when sync match stats are enabled for the task queue.
+	SyncMatchStats *v17.SyncMatchStats `protobuf:"bytes,3,opt,name=sync_match_stats,json=syncMatchStats,proto3" json:"sync_match_stats,omitempty"`
+	// Tasks dispatched to workers which may still be holding them.
+	TaskHolders []*v17.TaskHolderInfo `protobuf:"bytes,4,rep,name=task_holders,json=taskHolders,proto3" json:"task_holders,omitempty"`
 }
 
 func (m *DescribeTaskQueueResponse) Reset()      { *m = DescribeTaskQueueResponse{} }
 func (*DescribeTaskQueueResponse) ProtoMessage() {}
 func (*DescribeTaskQueueResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a429a3813476c583, []int{15}
+	return fileDescriptor_a429a3813476c583, []int{17}
 }
 func (m *DescribeTaskQueueResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1215,6 +1322,20 @@ func (m *DescribeTaskQueueResponse) GetTaskQueueStatus() *v14.TaskQueueStatus {
 	return nil
 }
 
+func (m *DescribeTaskQueueResponse) GetSyncMatchStats() *v17.SyncMatchStats {
+	if m != nil {
+		return m.SyncMatchStats
+	}
+	return nil
+}
+
+func (m *DescribeTaskQueueResponse) GetTaskHolders() []*v17.TaskHolderInfo {
+	if m != nil {
+		return m.TaskHolders
+	}
+	return nil
+}
+
 type ListTaskQueuePartitionsRequest struct {
 	Namespace string         `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	TaskQueue *v14.TaskQueue `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
@@ -1223,7 +1344,7 @@ type ListTaskQueuePartitionsRequest struct {
 func (m *ListTaskQueuePartitionsRequest) Reset()      { *m = ListTaskQueuePartitionsRequest{} }
 func (*ListTaskQueuePartitionsRequest) ProtoMessage() {}
 func (*ListTaskQueuePartitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a429a3813476c583, []int{16}
+	return fileDescriptor_a429a3813476c583, []int{18}
 }
 func (m *ListTaskQueuePartitionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1274,7 +1395,7 @@ type ListTaskQueuePartitionsResponse struct {
 func (m *ListTaskQueuePartitionsResponse) Reset()      { *m = ListTaskQueuePartitionsResponse{} }
 func (*ListTaskQueuePartitionsResponse) ProtoMessage() {}
 func (*ListTaskQueuePartitionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a429a3813476c583, []int{17}
+	return fileDescriptor_a429a3813476c583, []int{19}
 }
 func (m *ListTaskQueuePartitionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1333,6 +1454,8 @@ func init() {
 	proto.RegisterType((*RespondQueryTaskCompletedResponse)(nil), "temporal.server.api.matchingservice.v1.RespondQueryTaskCompletedResponse")
 	proto.RegisterType((*CancelOutstandingPollRequest)(nil), "temporal.server.api.matchingservice.v1.CancelOutstandingPollRequest")
 	proto.RegisterType((*CancelOutstandingPollResponse)(nil), "temporal.server.api.matchingservice.v1.CancelOutstandingPollResponse")
+	proto.RegisterType((*RemovePollerRequest)(nil), "temporal.server.api.matchingservice.v1.RemovePollerRequest")
+	proto.RegisterType((*RemovePollerResponse)(nil), "temporal.server.api.matchingservice.v1.RemovePollerResponse")
 	proto.RegisterType((*DescribeTaskQueueRequest)(nil), "temporal.server.api.matchingservice.v1.DescribeTaskQueueRequest")
 	proto.RegisterType((*DescribeTaskQueueResponse)(nil), "temporal.server.api.matchingservice.v1.DescribeTaskQueueResponse")
 	proto.RegisterType((*ListTaskQueuePartitionsRequest)(nil), "temporal.server.api.matchingservice.v1.ListTaskQueuePartitionsRequest")
@@ -1344,114 +1467,119 @@ func init() {
 }
 
 var fileDescriptor_a429a3813476c583 = []byte{
-	// 1712 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x18, 0x4f, 0x73, 0xdb, 0x58,
-	0x3d, 0x72, 0x12, 0x27, 0x7e, 0xb2, 0x13, 0x47, 0x0b, 0x59, 0x25, 0x6d, 0x94, 0xd4, 0xbb, 0xec,
-	0x66, 0x99, 0x45, 0x9e, 0x86, 0xd9, 0xce, 0xb6, 0xd0, 0x81, 0x34, 0xcd, 0xb4, 0x86, 0xb6, 0xa4,
-	0x8a, 0x07, 0x98, 0x0e, 0x33, 0xea, 0x8b, 0xf4, 0xe2, 0x88, 0xc8, 0x7a, 0x8e, 0xde, 0x93, 0x53,
-	0x73, 0x62, 0xa6, 0xc3, 0xbd, 0x33, 0x5c, 0x60, 0xf8, 0x02, 0x70, 0x86, 0x0f, 0xc1, 0x81, 0x43,
-	0x8f, 0xbd, 0x41, 0x93, 0x0b, 0x33, 0x5c, 0xca, 0x37, 0x60, 0xde, 0x1f, 0xc9, 0x92, 0x6c, 0x27,
-	0x4e, 0x9a, 0xa1, 0x7b, 0xb3, 0x7e, 0xff, 0xff, 0xff, 0x7e, 0xcf, 0xe0, 0x2e, 0x45, 0xed, 0x0e,
-	0x0e, 0xa1, 0x5f, 0x27, 0x28, 0xec, 0xa2, 0xb0, 0x0e, 0x3b, 0x5e, 0xbd, 0x0d, 0xa9, 0x73, 0xe0,
-	0x05, 0x2d, 0x06, 0xf2, 0x1c, 0x54, 0xef, 0xde, 0xac, 0x87, 0xe8, 0x28, 0x42, 0x84, 0xda, 0x21,
-	0x22, 0x1d, 0x1c, 0x10, 0x64, 0x76, 0x42, 0x4c, 0xb1, 0xf6, 0x59, 0xcc, 0x6e, 0x0a, 0x76, 0x13,
-	0x76, 0x3c, 0x33, 0xc7, 0x6e, 0x76, 0x6f, 0x2e, 0x1b, 0x2d, 0x8c, 0x5b, 0x3e, 0xaa, 0x73, 0xae,
-	0xbd, 0x68, 0xbf, 0xee, 0x46, 0x21, 0xa4, 0x1e, 0x0e, 0x84, 0x9c, 0xe5, 0xd5, 0x3c, 0x9e, 0x7a,
-	0x6d, 0x44, 0x28, 0x6c, 0x77, 0x24, 0xc1, 0x0d, 0x17, 0x75, 0x50, 0xe0, 0xa2, 0xc0, 0xf1, 0x10,
-	0xa9, 0xb7, 0x70, 0x0b, 0x73, 0x38, 0xff, 0x25, 0x49, 0x3e, 0x4d, 0x5c, 0x61, 0x3e, 0x38, 0xb8,
-	0xdd, 0xc6, 0x01, 0x33, 0xbd, 0x8d, 0x08, 0x81, 0x2d, 0x69, 0xf1, 0xf2, 0x67, 0x19, 0x2a, 0x14,
-	0x44, 0x6d, 0xc2, 0x88, 0x28, 0x24, 0x87, 0xf6, 0x51, 0x84, 0xa2, 0x98, 0xee, 0xf3, 0x0c, 0x1d,
-	0x43, 0x73, 0xec, 0xa0, 0xc0, 0x4f, 0x32, 0x84, 0x47, 0x11, 0x0a, 0x7b, 0x83, 0x44, 0x9f, 0x0f,
-	0x0b, 0x73, 0x46, 0xb9, 0x24, 0xfc, 0x72, 0x18, 0xe1, 0x81, 0x47, 0x28, 0x1e, 0x26, 0xf6, 0x56,
-	0x46, 0xf7, 0x31, 0x0e, 0x0f, 0xf7, 0x7d, 0x7c, 0x7c, 0x6e, 0xda, 0x6a, 0xff, 0x51, 0xc0, 0xf5,
-	0x1d, 0xec, 0xfb, 0xbf, 0x90, 0x1c, 0x4d, 0x48, 0x0e, 0x9f, 0x32, 0xf7, 0x2c, 0x41, 0xaf, 0xdd,
-	0x00, 0xe5, 0x00, 0xb6, 0x11, 0xe9, 0x40, 0x07, 0xd9, 0x9e, 0xab, 0x2b, 0x6b, 0xca, 0x7a, 0xc9,
-	0x52, 0x13, 0x58, 0xc3, 0xd5, 0xae, 0x81, 0x52, 0x07, 0xfb, 0x3e, 0x0a, 0x19, 0xbe, 0xc0, 0xf1,
-	0xb3, 0x02, 0xd0, 0x70, 0xb5, 0xe7, 0xa0, 0xcc, 0x7e, 0xdb, 0x52, 0xbf, 0x3e, 0xb9, 0xa6, 0xac,
-	0xab, 0x1b, 0x77, 0xcd, 0xa4, 0x5c, 0x58, 0x9d, 0xe4, 0xec, 0x35, 0xbb, 0x37, 0xcd, 0xb3, 0x8c,
-	0xb2, 0x54, 0x26, 0x32, 0xb6, 0xf0, 0x0b, 0x50, 0xdd, 0xc7, 0xe1, 0x31, 0x0c, 0x5d, 0xe4, 0xda,
-	0x04, 0x47, 0xa1, 0x83, 0xf4, 0x29, 0x6e, 0xc5, 0x7c, 0x02, 0xdf, 0xe5, 0xe0, 0xda, 0xcb, 0x12,
-	0x58, 0x19, 0x21, 0x58, 0x44, 0x45, 0x5b, 0x01, 0x80, 0x17, 0x00, 0xc5, 0x87, 0x28, 0xe0, 0xce,
-	0x96, 0xad, 0x12, 0x83, 0x34, 0x19, 0x40, 0xfb, 0x25, 0xd0, 0x62, 0x5b, 0x6d, 0xf4, 0x02, 0x39,
-	0x11, 0xab, 0x5c, 0xee, 0xb3, 0xba, 0xf1, 0x45, 0xd6, 0x27, 0x51, 0x76, 0xcc, 0x95, 0x58, 0xdb,
-	0x76, 0xcc, 0x60, 0x2d, 0x1c, 0xe7, 0x41, 0x5a, 0x03, 0x54, 0x12, 0xc9, 0xb4, 0xd7, 0x41, 0x32,
-	0x50, 0x9f, 0x9e, 0x27, 0xb4, 0xd9, 0xeb, 0x20, 0xab, 0x7c, 0x9c, 0xfa, 0xd2, 0x6e, 0x83, 0xa5,
-	0x4e, 0x88, 0xba, 0x1e, 0x8e, 0x88, 0x4d, 0x28, 0x0c, 0x29, 0x72, 0x6d, 0xd4, 0x45, 0x01, 0x65,
-	0xf9, 0x61, 0x91, 0x99, 0xb4, 0x16, 0x63, 0x82, 0x5d, 0x81, 0xdf, 0x66, 0xe8, 0x86, 0xab, 0xad,
-	0x83, 0xea, 0x00, 0xc7, 0x34, 0xe7, 0x98, 0x23, 0x59, 0x4a, 0x1d, 0xcc, 0x40, 0xca, 0x6c, 0xa3,
-	0x7a, 0x71, 0x4d, 0x59, 0x9f, 0xb6, 0xe2, 0x4f, 0xad, 0x06, 0x2a, 0x01, 0x7a, 0x41, 0xfb, 0x02,
-	0x66, 0xb8, 0x00, 0x95, 0x01, 0x63, 0xee, 0x2f, 0x81, 0xb6, 0x07, 0x9d, 0x43, 0x1f, 0xb7, 0x6c,
-	0x07, 0x47, 0x01, 0xb5, 0x0f, 0xbc, 0x80, 0xea, 0xb3, 0x9c, 0xb0, 0x2a, 0x31, 0x5b, 0x0c, 0xf1,
-	0xd0, 0x0b, 0xa8, 0xf6, 0x35, 0xd0, 0x09, 0xf5, 0x9c, 0xc3, 0x5e, 0x3f, 0xe6, 0x36, 0x0a, 0xe0,
-	0x9e, 0x8f, 0x5c, 0xbd, 0xb4, 0xa6, 0xac, 0xcf, 0x5a, 0x8b, 0x02, 0x9f, 0x84, 0x73, 0x5b, 0x60,
-	0xb5, 0x3b, 0x60, 0x9a, 0xf7, 0xa1, 0x0e, 0x86, 0x45, 0x93, 0xa3, 0xd2, 0xc1, 0x7c, 0xca, 0x00,
-	0x96, 0x60, 0xd1, 0x5a, 0xa9, 0x5c, 0xf3, 0x9a, 0xf0, 0x82, 0x7d, 0xac, 0xab, 0x5c, 0xd0, 0x6d,
-	0x73, 0xd8, 0xb8, 0x93, 0xdd, 0xc9, 0x24, 0x36, 0x43, 0x18, 0x10, 0x0f, 0x05, 0x34, 0x5d, 0x6a,
-	0x8d, 0x60, 0x1f, 0x5b, 0xd5, 0xe3, 0x1c, 0x44, 0x6b, 0x81, 0x95, 0xc1, 0xa2, 0xb2, 0xfb, 0x73,
-	0x48, 0x2f, 0x0f, 0x33, 0x3e, 0x19, 0x44, 0x5c, 0x5d, 0x52, 0xc8, 0xcb, 0x03, 0xa5, 0x95, 0xe0,
-	0x58, 0x2f, 0xef, 0x85, 0x30, 0x70, 0x0e, 0x64, 0x79, 0xcf, 0xf1, 0xf2, 0x56, 0x05, 0x4c, 0x14,
-	0xf8, 0x03, 0x30, 0x47, 0x9c, 0x03, 0xe4, 0x46, 0x3e, 0x72, 0x6d, 0x36, 0x7a, 0xf5, 0x79, 0xae,
-	0x7c, 0xd9, 0x14, 0x73, 0xd9, 0x8c, 0xe7, 0xb2, 0xd9, 0x8c, 0xe7, 0xf2, 0xbd, 0xa9, 0x57, 0xff,
-	0x5c, 0x55, 0xac, 0x4a, 0xc2, 0xc7, 0x30, 0xda, 0x16, 0x28, 0xc7, 0x95, 0xc4, 0xc5, 0x54, 0xc7,
-	0x14, 0xa3, 0x4a, 0x2e, 0x2e, 0xc4, 0x07, 0x33, 0x2c, 0x17, 0x1e, 0x22, 0xfa, 0xc2, 0xda, 0xe4,
-	0xba, 0xba, 0x61, 0x99, 0xe3, 0xad, 0x19, 0xf3, 0xcc, 0x2e, 0x37, 0x9f, 0x0a, 0xa1, 0xdb, 0x01,
-	0x0d, 0x7b, 0x56, 0xac, 0x62, 0xf9, 0x39, 0x28, 0xa7, 0x11, 0x5a, 0x15, 0x4c, 0x1e, 0xa2, 0x9e,
-	0x9c, 0x78, 0xec, 0x27, 0x2b, 0xa7, 0x2e, 0xf4, 0x23, 0x24, 0x3b, 0x7e, 0xcc, 0x72, 0xe2, 0x2c,
-	0x77, 0x0a, 0x5f, 0x2b, 0x3f, 0x99, 0x9a, 0xad, 0x54, 0xe7, 0x92, 0x99, 0xbb, 0xe9, 0x50, 0xaf,
-	0xeb, 0xd1, 0xde, 0x37, 0x6a, 0xe6, 0x8e, 0x32, 0xea, 0xd2, 0x33, 0xf7, 0x1f, 0xb3, 0x62, 0xe6,
-	0x0e, 0x11, 0xfc, 0xa1, 0x67, 0xee, 0x2a, 0x50, 0xa1, 0xb4, 0x8a, 0x85, 0x71, 0x92, 0x3b, 0x00,
-	0x62, 0x50, 0xc3, 0x65, 0x43, 0x39, 0x21, 0xe0, 0x43, 0x79, 0xea, 0xec, 0xa1, 0x9c, 0xf8, 0xc8,
-	0x87, 0x32, 0x4c, 0x7d, 0x69, 0xb7, 0xc0, 0xb4, 0x17, 0x74, 0x22, 0xca, 0xc7, 0xa9, 0xba, 0xb1,
-	0x36, 0x4a, 0xc4, 0x0e, 0xec, 0xf9, 0x18, 0xba, 0xc4, 0x12, 0xe4, 0x43, 0x1a, 0xb2, 0x78, 0xb9,
-	0x86, 0x7c, 0x06, 0x96, 0x62, 0x80, 0x4d, 0xb1, 0xed, 0xf8, 0x98, 0x20, 0x2e, 0x10, 0x47, 0x94,
-	0x8f, 0x68, 0x75, 0x63, 0x69, 0x40, 0xe6, 0x7d, 0x79, 0x9c, 0xdd, 0x9b, 0xfa, 0x03, 0x13, 0xb9,
-	0x18, 0x4b, 0x68, 0xe2, 0x2d, 0xc6, 0xdf, 0x14, 0xec, 0x03, 0xcd, 0x3e, 0x7b, 0x99, 0x66, 0x6f,
-	0x82, 0x45, 0xfe, 0x39, 0x68, 0x5d, 0x69, 0x3c, 0xeb, 0x3e, 0xe2, 0xec, 0x39, 0xd3, 0x1e, 0x81,
-	0x85, 0x03, 0x04, 0x43, 0xba, 0x87, 0x20, 0x4d, 0x04, 0x82, 0xf1, 0x04, 0x56, 0x13, 0xce, 0x58,
-	0x5a, 0x6a, 0xeb, 0xa9, 0xd9, 0xad, 0x87, 0x80, 0xe1, 0x44, 0x61, 0xc8, 0x56, 0x9e, 0x04, 0xd9,
-	0xb9, 0xbc, 0x95, 0xc7, 0x0c, 0xca, 0x35, 0x29, 0x67, 0x53, 0x88, 0xd9, 0xcd, 0x64, 0xf1, 0x71,
-	0xda, 0x1d, 0x17, 0x51, 0xe8, 0xf9, 0x44, 0xaf, 0x8c, 0x59, 0x52, 0x7d, 0x7f, 0xee, 0x0b, 0xce,
-	0xc1, 0xab, 0x63, 0xee, 0xd2, 0x57, 0xc7, 0xf7, 0x52, 0x6d, 0x9a, 0x4c, 0x2a, 0xbe, 0x3d, 0x4a,
-	0xfd, 0xde, 0x7b, 0x12, 0x23, 0xb4, 0x5b, 0xa0, 0x78, 0x80, 0xa0, 0x8b, 0x42, 0xb9, 0x19, 0x8c,
-	0x51, 0x2a, 0x1f, 0x72, 0x2a, 0x4b, 0x52, 0xd7, 0xfe, 0x3a, 0x09, 0x16, 0x37, 0x5d, 0x37, 0x3d,
-	0xdb, 0x2f, 0x30, 0x36, 0x1f, 0x80, 0xd2, 0x7b, 0x8c, 0x90, 0x3e, 0xaf, 0xb6, 0x25, 0x67, 0x96,
-	0x58, 0xd0, 0x93, 0x17, 0x58, 0xd0, 0x7c, 0xb2, 0x89, 0x7d, 0xbc, 0x0a, 0xd4, 0xa4, 0x25, 0x93,
-	0xd3, 0x0c, 0xc4, 0xa0, 0x86, 0x9b, 0xef, 0x59, 0xd9, 0x1e, 0xb2, 0x88, 0xa7, 0x2f, 0xdc, 0xb3,
-	0xfc, 0xd8, 0x8b, 0x4b, 0x79, 0xd8, 0x08, 0x2f, 0x0e, 0x1d, 0xe1, 0xda, 0x8f, 0x41, 0x51, 0x12,
-	0xb0, 0x39, 0x31, 0xb7, 0xb1, 0x3e, 0x74, 0x0b, 0xf3, 0x47, 0x4c, 0xec, 0xab, 0xe0, 0xb4, 0x24,
-	0x5f, 0x6d, 0x09, 0x7c, 0x3c, 0x90, 0x34, 0x31, 0xfd, 0x6b, 0xa7, 0x22, 0xa1, 0xe9, 0xf5, 0xf0,
-	0x21, 0x12, 0x6a, 0x82, 0x8f, 0x84, 0xad, 0x76, 0x46, 0xa5, 0xd8, 0x09, 0x0b, 0x02, 0xf5, 0x24,
-	0xa5, 0x38, 0x5b, 0x00, 0x53, 0x57, 0x52, 0x00, 0xd3, 0x17, 0x2b, 0x80, 0xe2, 0xd5, 0x17, 0xc0,
-	0xcc, 0x79, 0x05, 0x30, 0xfb, 0x5e, 0x05, 0x90, 0x4d, 0xb2, 0x2c, 0x80, 0xdf, 0x15, 0xc0, 0xb7,
-	0xf8, 0xa5, 0x14, 0xe7, 0xe7, 0x02, 0xe9, 0xcf, 0x66, 0xa1, 0x70, 0xb9, 0x2c, 0x3c, 0x03, 0x15,
-	0x7e, 0xba, 0xe5, 0xee, 0xa5, 0xaf, 0xce, 0xbd, 0x97, 0x86, 0x59, 0x6d, 0x95, 0xb9, 0xac, 0x4b,
-	0x1c, 0x4a, 0x7f, 0x51, 0xc0, 0xb7, 0x73, 0x12, 0xe5, 0x81, 0xb4, 0x05, 0xca, 0xb1, 0x81, 0x24,
-	0xf2, 0x29, 0x0f, 0xc4, 0x38, 0xf3, 0x5e, 0x95, 0xa6, 0x30, 0x26, 0xed, 0xa7, 0x60, 0x2e, 0x16,
-	0xf2, 0x6b, 0xe4, 0x50, 0xe4, 0x9e, 0x73, 0xc4, 0x8a, 0xe3, 0x55, 0xd2, 0x5a, 0x95, 0xa3, 0xf4,
-	0x67, 0xed, 0xf7, 0x05, 0xb0, 0x26, 0xcc, 0x73, 0x39, 0x1d, 0x8b, 0xeb, 0x16, 0x6e, 0x77, 0x7c,
-	0xc4, 0x88, 0xff, 0xcf, 0xf9, 0xfb, 0x18, 0xcc, 0x88, 0xf7, 0x59, 0xdc, 0xae, 0x45, 0xf6, 0xd9,
-	0x70, 0xb5, 0x00, 0x2c, 0x38, 0xb1, 0x51, 0x49, 0x72, 0x45, 0xab, 0x6e, 0x9e, 0x9b, 0xdc, 0xf3,
-	0xdc, 0xb3, 0xaa, 0x4e, 0x0e, 0x52, 0xfb, 0x04, 0xdc, 0x38, 0x83, 0x4b, 0x96, 0xfb, 0x7f, 0x15,
-	0x70, 0x7d, 0x0b, 0x06, 0x0e, 0xf2, 0x7f, 0x16, 0x51, 0x42, 0x61, 0xe0, 0x7a, 0x41, 0x6b, 0x27,
-	0x75, 0x5b, 0x8f, 0x11, 0xb6, 0x47, 0x60, 0xbe, 0x1f, 0x36, 0xb1, 0xb8, 0x0b, 0xbc, 0x31, 0x73,
-	0xb1, 0xcb, 0x74, 0x24, 0x0f, 0x16, 0x5f, 0xdc, 0x15, 0x9a, 0xfe, 0xbc, 0x9a, 0x5d, 0x96, 0x79,
-	0x90, 0x4c, 0x65, 0x1f, 0x24, 0xb5, 0x55, 0xb0, 0x32, 0xc2, 0x65, 0x19, 0x94, 0x3f, 0x29, 0x40,
-	0xbf, 0x8f, 0x88, 0x13, 0x7a, 0x7b, 0xe8, 0x32, 0xcf, 0xa1, 0x5f, 0x81, 0xb2, 0x8b, 0x88, 0x93,
-	0x24, 0xb9, 0x90, 0x7f, 0xa5, 0x8f, 0x48, 0xf2, 0x28, 0x9d, 0x96, 0xca, 0xc4, 0xc5, 0x79, 0xfd,
-	0x9b, 0x02, 0x96, 0x86, 0x50, 0xca, 0xee, 0xfc, 0x11, 0x98, 0x11, 0x8e, 0x12, 0x5d, 0xe1, 0x8f,
-	0xd4, 0xef, 0x9c, 0x11, 0xbb, 0x1d, 0x11, 0x92, 0x60, 0x1f, 0x5b, 0x31, 0x97, 0xf6, 0x73, 0xb0,
-	0x90, 0xca, 0x26, 0xa1, 0x90, 0x46, 0x44, 0x7a, 0xf0, 0xdd, 0x71, 0xd2, 0xb0, 0xcb, 0x39, 0xac,
-	0x79, 0x9a, 0x05, 0xd4, 0x5e, 0x2a, 0xc0, 0x78, 0xe4, 0x11, 0x9a, 0x10, 0xee, 0xc0, 0x90, 0x7a,
-	0x6c, 0x33, 0x90, 0x38, 0xb4, 0xd7, 0x41, 0xa9, 0x7f, 0xab, 0x89, 0xb8, 0xf6, 0x01, 0x57, 0xd2,
-	0x9d, 0xb5, 0x3f, 0x16, 0xc0, 0xea, 0x48, 0x2b, 0x64, 0x08, 0x7f, 0x03, 0x8c, 0xfe, 0x3b, 0xab,
-	0x1f, 0x8a, 0x4e, 0x42, 0x29, 0x23, 0xfb, 0xd5, 0x38, 0xca, 0x13, 0xf9, 0x8f, 0x11, 0x85, 0x2e,
-	0xa4, 0xd0, 0xba, 0x06, 0xf3, 0x6f, 0xcf, 0xbe, 0x0d, 0x4c, 0x77, 0xf6, 0x6f, 0x9e, 0x01, 0xdd,
-	0x85, 0xf7, 0xd2, 0x7d, 0x9c, 0xff, 0x17, 0xa2, 0xaf, 0xfb, 0x5e, 0xf8, 0xfa, 0xad, 0x31, 0xf1,
-	0xe6, 0xad, 0x31, 0xf1, 0xee, 0xad, 0xa1, 0xfc, 0xf6, 0xc4, 0x50, 0xfe, 0x7c, 0x62, 0x28, 0x7f,
-	0x3f, 0x31, 0x94, 0xd7, 0x27, 0x86, 0xf2, 0xaf, 0x13, 0x43, 0xf9, 0xf7, 0x89, 0x31, 0xf1, 0xee,
-	0xc4, 0x50, 0x5e, 0x9d, 0x1a, 0x13, 0xaf, 0x4f, 0x8d, 0x89, 0x37, 0xa7, 0xc6, 0xc4, 0xb3, 0x1f,
-	0xb6, 0x70, 0xdf, 0x16, 0x0f, 0x9f, 0xfd, 0x7f, 0xfd, 0x0f, 0x72, 0xa0, 0xbd, 0x22, 0xbf, 0x13,
-	0xbe, 0xff, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf8, 0x11, 0x60, 0x20, 0xf0, 0x17, 0x00, 0x00,
+	// 1779 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd5, 0x19, 0x5b, 0x6f, 0xdb, 0x54,
+	0x78, 0x49, 0xd3, 0x34, 0x39, 0xb9, 0x34, 0xf5, 0x46, 0x97, 0x76, 0x6b, 0xda, 0x65, 0x63, 0x17,
+	0x34, 0x12, 0x56, 0xb4, 0x69, 0x1b, 0x4c, 0xd0, 0x75, 0xd5, 0x36, 0xd8, 0x46, 0xe7, 0x46, 0x80,
+	0x26, 0xa4, 0xe0, 0xda, 0xa7, 0xa9, 0xa9, 0xe3, 0x93, 0xd9, 0x4e, 0xba, 0xf2, 0x84, 0x34, 0xf1,
+	0x3e, 0x89, 0x17, 0x10, 0x7f, 0x00, 0x9e, 0xf9, 0x13, 0x3c, 0xf0, 0xb0, 0xc7, 0xbd, 0x8d, 0x6d,
+	0x42, 0x20, 0xf1, 0x02, 0xff, 0x80, 0xef, 0xdc, 0x1c, 0xdb, 0x49, 0xda, 0xb4, 0xab, 0x18, 0x3c,
+	0x58, 0xcd, 0xf9, 0xee, 0xf7, 0xef, 0xd8, 0x45, 0x57, 0x3c, 0xdc, 0x6c, 0x11, 0x47, 0xb3, 0xaa,
+	0x2e, 0x76, 0x3a, 0xd8, 0xa9, 0x6a, 0x2d, 0xb3, 0xda, 0xd4, 0x3c, 0x7d, 0xdd, 0xb4, 0x1b, 0x14,
+	0x64, 0xea, 0xb8, 0xda, 0x39, 0x57, 0x75, 0xf0, 0xfd, 0x36, 0x76, 0xbd, 0xba, 0x83, 0xdd, 0x16,
+	0xb1, 0x5d, 0x5c, 0x69, 0x39, 0xc4, 0x23, 0xca, 0x49, 0xc9, 0x5e, 0xe1, 0xec, 0x15, 0x60, 0xaf,
+	0x44, 0xd8, 0x2b, 0x9d, 0x73, 0xd3, 0xa5, 0x06, 0x21, 0x0d, 0x0b, 0x57, 0x19, 0xd7, 0x6a, 0x7b,
+	0xad, 0x6a, 0xb4, 0x1d, 0xcd, 0x33, 0x89, 0xcd, 0xe5, 0x4c, 0xcf, 0x46, 0xf1, 0x9e, 0xd9, 0x04,
+	0x75, 0x5a, 0xb3, 0x25, 0x08, 0x8e, 0x19, 0xb8, 0x85, 0x6d, 0x03, 0xdb, 0xba, 0x89, 0xdd, 0x6a,
+	0x83, 0x34, 0x08, 0x83, 0xb3, 0x5f, 0x82, 0xe4, 0x84, 0xef, 0x0a, 0xf5, 0x41, 0x27, 0xcd, 0x26,
+	0xb1, 0xa9, 0xe9, 0x20, 0xc8, 0xd5, 0x1a, 0xc2, 0xe2, 0xe9, 0x93, 0x21, 0x2a, 0x6c, 0xb7, 0x9b,
+	0x2e, 0x25, 0xf2, 0x34, 0x77, 0xa3, 0x0e, 0x2e, 0xb6, 0x25, 0xdd, 0xa9, 0x10, 0x1d, 0x45, 0x33,
+	0x6c, 0xaf, 0xc0, 0xe3, 0x21, 0x42, 0x20, 0x72, 0xb6, 0x7a, 0x89, 0x4e, 0xf5, 0x0b, 0x73, 0x48,
+	0xb9, 0x20, 0x3c, 0xdb, 0x8f, 0x70, 0xdd, 0x74, 0x3d, 0xd2, 0x4f, 0x6c, 0xa5, 0x1f, 0xf5, 0x36,
+	0xb6, 0x5e, 0x08, 0xd9, 0xba, 0x49, 0x9c, 0x8d, 0x35, 0x8b, 0x6c, 0xee, 0x98, 0xe6, 0xf2, 0x9f,
+	0x31, 0x74, 0x74, 0x99, 0x58, 0xd6, 0x27, 0x82, 0xa3, 0x06, 0x2a, 0xee, 0x52, 0x15, 0x2a, 0xa7,
+	0x57, 0x8e, 0xa1, 0xac, 0xad, 0x81, 0xae, 0x96, 0xa6, 0xe3, 0xba, 0x69, 0x14, 0x63, 0x73, 0xb1,
+	0xd3, 0x69, 0x35, 0xe3, 0xc3, 0x6e, 0x1a, 0xca, 0x11, 0x94, 0x6e, 0x81, 0x08, 0xec, 0x50, 0x7c,
+	0x9c, 0xe1, 0x53, 0x1c, 0x00, 0xc8, 0xcf, 0x51, 0x96, 0xfe, 0xae, 0x0b, 0xfd, 0xc5, 0x11, 0xc0,
+	0x67, 0xe6, 0xaf, 0xf8, 0xfe, 0xb1, 0xba, 0x8a, 0xd8, 0x0b, 0x75, 0x55, 0xd9, 0xce, 0x28, 0x35,
+	0x43, 0x45, 0x4a, 0x0b, 0xcf, 0xa0, 0xc2, 0x1a, 0x71, 0x36, 0x35, 0xc7, 0xc0, 0x46, 0xdd, 0x25,
+	0x6d, 0x47, 0xc7, 0xc5, 0x04, 0xb3, 0x62, 0xdc, 0x87, 0xaf, 0x30, 0x70, 0xf9, 0x61, 0x1a, 0xcd,
+	0x0c, 0x10, 0xcc, 0xa3, 0xa2, 0xcc, 0x20, 0xc4, 0x0a, 0xc6, 0x23, 0x1b, 0xd8, 0x66, 0xce, 0x66,
+	0xd5, 0x34, 0x85, 0xd4, 0x28, 0x40, 0xf9, 0x14, 0x29, 0xd2, 0xd6, 0x3a, 0x7e, 0x80, 0xf5, 0x36,
+	0xad, 0x74, 0xe6, 0x73, 0x66, 0xfe, 0x4c, 0xd8, 0x27, 0x5e, 0xa6, 0xd4, 0x15, 0xa9, 0x6d, 0x49,
+	0x32, 0xa8, 0x13, 0x9b, 0x51, 0x90, 0x72, 0x13, 0xe5, 0x7c, 0xc9, 0xde, 0x56, 0x0b, 0x8b, 0x40,
+	0x9d, 0xd8, 0x49, 0x68, 0x0d, 0x68, 0xd5, 0xec, 0x66, 0xe0, 0xa4, 0x5c, 0x42, 0x53, 0x2d, 0x07,
+	0x77, 0x4c, 0xd2, 0x76, 0xeb, 0xd0, 0x69, 0x8e, 0x07, 0x71, 0xc1, 0x1d, 0x6c, 0x7b, 0x34, 0x3f,
+	0x34, 0x32, 0x23, 0xea, 0xa4, 0x24, 0x58, 0xe1, 0xf8, 0x25, 0x8a, 0x86, 0x6c, 0x9d, 0x46, 0x85,
+	0x1e, 0x8e, 0x51, 0xc6, 0x91, 0x77, 0xc3, 0x94, 0x45, 0x34, 0xa6, 0x79, 0xd4, 0x36, 0xaf, 0x98,
+	0x04, 0x82, 0x51, 0x55, 0x1e, 0x95, 0x32, 0xca, 0xd9, 0xf8, 0x81, 0xd7, 0x15, 0x30, 0xc6, 0x04,
+	0x64, 0x28, 0x50, 0x72, 0x9f, 0x45, 0xca, 0xaa, 0xa6, 0x6f, 0x58, 0xa4, 0x51, 0xd7, 0x49, 0x1b,
+	0xc8, 0x60, 0xaa, 0x78, 0xc5, 0x14, 0x23, 0x2c, 0x08, 0xcc, 0x22, 0x45, 0xdc, 0x00, 0xb8, 0x72,
+	0x11, 0x15, 0x5d, 0xcf, 0xd4, 0x37, 0xb6, 0xba, 0x31, 0xaf, 0x63, 0x5b, 0x5b, 0xb5, 0xb0, 0x51,
+	0x4c, 0x03, 0x4f, 0x4a, 0x9d, 0xe4, 0x78, 0x3f, 0x9c, 0x4b, 0x1c, 0xab, 0x5c, 0x46, 0xa3, 0xac,
+	0x6f, 0x8b, 0xa8, 0x5f, 0x34, 0x19, 0x2a, 0x18, 0xcc, 0xbb, 0x14, 0xa0, 0x72, 0x16, 0xa5, 0x11,
+	0xc8, 0x35, 0xab, 0x09, 0xd3, 0x5e, 0x23, 0xc5, 0x0c, 0x13, 0x74, 0xa9, 0xd2, 0x6f, 0x3c, 0x8a,
+	0x6e, 0xa6, 0x12, 0x6b, 0x8e, 0x66, 0xbb, 0x26, 0x38, 0x1b, 0x2c, 0xb5, 0x9b, 0x20, 0x40, 0x2d,
+	0x6c, 0x46, 0x20, 0xa0, 0x68, 0xa6, 0xb7, 0xa8, 0xea, 0xdd, 0xb9, 0x55, 0xcc, 0xf6, 0x33, 0xde,
+	0x1f, 0x06, 0x4c, 0x9d, 0x5f, 0xc8, 0xd3, 0x3d, 0xa5, 0xe5, 0xe3, 0x68, 0x2f, 0xaf, 0x82, 0x5d,
+	0xfa, 0xba, 0x28, 0xef, 0x3c, 0x2b, 0xef, 0x0c, 0x87, 0xf1, 0x02, 0xbf, 0x8e, 0xf2, 0xae, 0xbe,
+	0x8e, 0x8d, 0x36, 0x44, 0xaf, 0x4e, 0x47, 0x75, 0x71, 0x9c, 0x29, 0x9f, 0xae, 0xf0, 0x39, 0x5e,
+	0x91, 0x73, 0xbc, 0x52, 0x93, 0x73, 0xfc, 0x6a, 0xe2, 0xd1, 0xd3, 0xd9, 0x98, 0x9a, 0xf3, 0xf9,
+	0x28, 0x46, 0x59, 0x44, 0x59, 0x59, 0x49, 0x4c, 0x4c, 0x61, 0x48, 0x31, 0x19, 0xc1, 0xc5, 0x84,
+	0x58, 0x68, 0x8c, 0xe6, 0x02, 0x16, 0x43, 0x71, 0x62, 0x6e, 0x04, 0xf8, 0xd5, 0xca, 0x70, 0x6b,
+	0xa9, 0xb2, 0x6d, 0x97, 0x57, 0xee, 0x72, 0xa1, 0x4b, 0xb6, 0x07, 0xe9, 0x96, 0x2a, 0xa6, 0x61,
+	0x54, 0x05, 0x11, 0x4a, 0x01, 0x8d, 0x6c, 0xe0, 0x2d, 0x31, 0xf1, 0xe8, 0x4f, 0x5a, 0x4e, 0x1d,
+	0xcd, 0x82, 0x8c, 0xc4, 0x77, 0x53, 0x4e, 0x8c, 0xe5, 0x72, 0xfc, 0x62, 0xec, 0x83, 0x44, 0x2a,
+	0x57, 0xc8, 0xfb, 0x33, 0x77, 0x41, 0xf7, 0xcc, 0x8e, 0xe9, 0x6d, 0xfd, 0xa7, 0x66, 0xee, 0x20,
+	0xa3, 0xf6, 0x3c, 0x73, 0x7f, 0x49, 0xf1, 0x99, 0xdb, 0x47, 0xf0, 0xab, 0x9e, 0xb9, 0xb3, 0x28,
+	0xa3, 0x09, 0xab, 0x68, 0x18, 0x47, 0x98, 0x03, 0x48, 0x82, 0x20, 0x90, 0x30, 0x94, 0x7d, 0x02,
+	0x36, 0x94, 0x13, 0xdb, 0x0f, 0x65, 0xdf, 0x47, 0x36, 0x94, 0xb5, 0xc0, 0x49, 0xb9, 0x80, 0x46,
+	0x4d, 0xbb, 0xd5, 0xf6, 0xd8, 0x38, 0xcd, 0xcc, 0xcf, 0x0d, 0x12, 0xb1, 0xac, 0x6d, 0x59, 0x44,
+	0x33, 0x5c, 0x95, 0x93, 0xf7, 0x69, 0xc8, 0xe4, 0xde, 0x1a, 0xf2, 0x1e, 0x9a, 0x92, 0x00, 0x88,
+	0x74, 0x5d, 0xb7, 0x88, 0x8b, 0x99, 0x40, 0x02, 0x46, 0x8d, 0x31, 0x99, 0x53, 0x3d, 0x32, 0xaf,
+	0x89, 0xcb, 0xdc, 0xd5, 0xc4, 0xb7, 0x54, 0xe4, 0xa4, 0x94, 0x50, 0x23, 0x8b, 0x94, 0xbf, 0xc6,
+	0xd9, 0x7b, 0x9a, 0x3d, 0xb5, 0x97, 0x66, 0xaf, 0xa1, 0x49, 0x76, 0xec, 0xb5, 0x2e, 0x3d, 0x9c,
+	0x75, 0x07, 0x19, 0x7b, 0xc4, 0xb4, 0x5b, 0x68, 0x62, 0x1d, 0x03, 0x78, 0x15, 0x6b, 0x9e, 0x2f,
+	0x10, 0x0d, 0x27, 0xb0, 0xe0, 0x73, 0x4a, 0x69, 0x81, 0xad, 0x97, 0x09, 0x6f, 0x3d, 0x8c, 0x4a,
+	0x7a, 0xdb, 0x71, 0xe8, 0xca, 0x13, 0xa0, 0x7a, 0x24, 0x6f, 0xd9, 0x21, 0x83, 0x72, 0x44, 0xc8,
+	0x59, 0xe0, 0x62, 0x56, 0x42, 0x59, 0xbc, 0x1d, 0x74, 0xc7, 0xc0, 0x9e, 0x66, 0x5a, 0x6e, 0x31,
+	0x37, 0x64, 0x49, 0x75, 0xfd, 0xb9, 0xc6, 0x39, 0x7b, 0x6f, 0x1d, 0xf9, 0x3d, 0xdf, 0x3a, 0xde,
+	0x0c, 0xb4, 0xa9, 0x3f, 0xa9, 0xd8, 0xf6, 0x48, 0x77, 0x7b, 0xef, 0x8e, 0x44, 0x40, 0x3f, 0x24,
+	0xc1, 0x1a, 0x03, 0x3b, 0x62, 0x33, 0x94, 0x06, 0xa9, 0xbc, 0xc1, 0xa8, 0x54, 0x41, 0x5d, 0xfe,
+	0x69, 0x04, 0x4d, 0x2e, 0x18, 0x46, 0x70, 0xb6, 0xef, 0x62, 0x6c, 0x5e, 0x47, 0xe9, 0x97, 0x18,
+	0x21, 0x5d, 0x5e, 0xa8, 0x78, 0x14, 0x58, 0xd0, 0x23, 0xbb, 0x58, 0xd0, 0x6c, 0xb2, 0xf1, 0x7d,
+	0x0c, 0xf3, 0xc7, 0x6f, 0x49, 0xff, 0x6a, 0x86, 0x24, 0x08, 0xcc, 0x8d, 0xf4, 0xac, 0x68, 0x0f,
+	0x51, 0xc4, 0xa3, 0xbb, 0xee, 0x59, 0x76, 0xd9, 0x93, 0xa5, 0xdc, 0x6f, 0x84, 0x27, 0xfb, 0x8e,
+	0x70, 0xe5, 0x7d, 0x94, 0x14, 0x04, 0x74, 0x4e, 0xe4, 0xe7, 0x4f, 0xf7, 0xdd, 0xc2, 0xec, 0xa5,
+	0x47, 0xfa, 0xca, 0x39, 0x55, 0xc1, 0x57, 0x9e, 0x42, 0x87, 0x7b, 0x92, 0xc6, 0xa7, 0x7f, 0xf9,
+	0x05, 0x4f, 0x68, 0x70, 0x3d, 0xbc, 0x8a, 0x84, 0x56, 0xd0, 0x41, 0x6e, 0x6b, 0x3d, 0xa4, 0x92,
+	0xef, 0x84, 0x09, 0x8e, 0xba, 0x13, 0x50, 0x1c, 0x2e, 0x80, 0xc4, 0xbe, 0x14, 0xc0, 0xe8, 0xee,
+	0x0a, 0x20, 0xb9, 0xff, 0x05, 0x30, 0xb6, 0x53, 0x01, 0xa4, 0x5e, 0xaa, 0x00, 0xc2, 0x49, 0x16,
+	0x05, 0xf0, 0x75, 0x1c, 0x1d, 0x62, 0x37, 0x25, 0x99, 0x9f, 0x5d, 0xa4, 0x3f, 0x9c, 0x85, 0xf8,
+	0xde, 0xb2, 0x70, 0x0f, 0xe5, 0xd8, 0xd5, 0x2d, 0x72, 0x5f, 0x3a, 0xbf, 0xe3, 0x7d, 0xa9, 0x9f,
+	0xd5, 0x6a, 0x96, 0xc9, 0xda, 0xc3, 0x45, 0xe9, 0xc7, 0x18, 0x7a, 0x2d, 0x22, 0x51, 0x5c, 0x90,
+	0x60, 0xbd, 0x4a, 0x03, 0xdd, 0xb6, 0xe5, 0xb1, 0x40, 0x0c, 0x33, 0xef, 0x33, 0xc2, 0x14, 0xca,
+	0xa4, 0x7c, 0x88, 0xf2, 0x52, 0xc8, 0x17, 0x58, 0x87, 0xa5, 0xbb, 0xc3, 0x25, 0x96, 0x5f, 0x5e,
+	0x05, 0xad, 0x9a, 0xbb, 0x1f, 0x3c, 0x96, 0xbf, 0x89, 0xa3, 0x39, 0x6e, 0x9e, 0xc1, 0xe8, 0x68,
+	0x5c, 0x17, 0x49, 0xb3, 0x65, 0x61, 0x4a, 0xfc, 0x2f, 0xe7, 0xef, 0x30, 0x1a, 0xe3, 0xef, 0x67,
+	0xb2, 0x5d, 0x93, 0xf4, 0x08, 0xd2, 0x6d, 0x34, 0xa1, 0x4b, 0xa3, 0xfc, 0xe4, 0xf2, 0x56, 0x5d,
+	0xd8, 0x31, 0xb9, 0x3b, 0xb9, 0xa7, 0x16, 0xf4, 0x08, 0xa4, 0x7c, 0x1c, 0x1d, 0xdb, 0x86, 0x4b,
+	0x94, 0xfb, 0xdf, 0x70, 0xfb, 0x5f, 0x84, 0x17, 0x2e, 0x6c, 0x7d, 0xd4, 0xf6, 0xa0, 0xa1, 0x6d,
+	0x03, 0xde, 0x5c, 0x96, 0x03, 0x77, 0xeb, 0x21, 0xc2, 0x76, 0x0b, 0x8d, 0x77, 0xc3, 0xc6, 0x17,
+	0x77, 0x9c, 0x35, 0x66, 0x24, 0x76, 0xa1, 0x8e, 0x64, 0xc1, 0x62, 0x8b, 0x3b, 0xe7, 0x05, 0x8f,
+	0xfb, 0xb3, 0xcb, 0x42, 0x2f, 0x24, 0x89, 0xf0, 0x0b, 0x49, 0x79, 0x16, 0xcd, 0x0c, 0x70, 0x59,
+	0x04, 0xe5, 0xf7, 0x18, 0x3a, 0xa8, 0xe2, 0x26, 0xe9, 0xe0, 0x65, 0xc6, 0xf3, 0xff, 0x8e, 0xc5,
+	0x34, 0x4a, 0x99, 0x06, 0xdc, 0xe0, 0x60, 0xd2, 0xc9, 0x50, 0xc8, 0x73, 0x79, 0x12, 0x1d, 0x0a,
+	0x3b, 0x2a, 0x22, 0xf0, 0x7d, 0x0c, 0x15, 0xaf, 0x61, 0x57, 0x77, 0xcc, 0x55, 0xbc, 0x97, 0x17,
+	0xc2, 0xcf, 0x50, 0xd6, 0x00, 0x76, 0xbf, 0xcc, 0xe3, 0xd1, 0xef, 0x14, 0x03, 0xca, 0x7c, 0x90,
+	0x4e, 0x35, 0x43, 0xc5, 0xc9, 0xca, 0xfe, 0x2d, 0x8e, 0xa6, 0xfa, 0x50, 0x8a, 0xf9, 0xf4, 0x1e,
+	0x1a, 0xe3, 0xa9, 0x76, 0xc1, 0x32, 0xfa, 0x9a, 0xfe, 0xfa, 0x36, 0x11, 0xe3, 0x7e, 0xb3, 0x4f,
+	0x21, 0x92, 0x4b, 0xf9, 0x18, 0x4d, 0x04, 0x72, 0x08, 0x15, 0xe2, 0xb5, 0x5d, 0xe1, 0xc1, 0x1b,
+	0xc3, 0x04, 0x7f, 0x85, 0x71, 0xa8, 0xe3, 0x5e, 0x18, 0x00, 0x93, 0xbd, 0xe0, 0x6e, 0xd9, 0x7a,
+	0x9d, 0x7d, 0x20, 0x60, 0x72, 0x5d, 0x91, 0xd3, 0xb7, 0xfa, 0x6e, 0xb0, 0x90, 0xf4, 0x15, 0xe0,
+	0xbc, 0x4d, 0x19, 0xa9, 0x30, 0x57, 0xcd, 0xbb, 0xa1, 0xb3, 0xb2, 0x82, 0xb2, 0xcc, 0xe6, 0x75,
+	0x62, 0x19, 0xd4, 0xf3, 0x04, 0xf3, 0x7c, 0x08, 0xb9, 0xd4, 0xea, 0x1b, 0x8c, 0x89, 0x05, 0x21,
+	0xe3, 0xf9, 0x67, 0xb7, 0xfc, 0x30, 0x86, 0x4a, 0xb7, 0x4c, 0xd7, 0xf3, 0x3d, 0x5b, 0x86, 0x85,
+	0x6d, 0xd2, 0x65, 0xee, 0xca, 0x5a, 0x38, 0x8a, 0xd2, 0xdd, 0xeb, 0x35, 0x2f, 0x84, 0x2e, 0x60,
+	0x5f, 0x06, 0x6a, 0xf9, 0xbb, 0x38, 0x9a, 0x1d, 0x68, 0x85, 0xc8, 0xf9, 0x97, 0xa8, 0xd4, 0x7d,
+	0x35, 0xee, 0xe6, 0xae, 0xe5, 0x53, 0x8a, 0x52, 0x38, 0x3f, 0x8c, 0x72, 0x5f, 0xfe, 0x6d, 0x78,
+	0x2f, 0x31, 0x34, 0x4f, 0x53, 0x8f, 0x68, 0xd1, 0xcf, 0x05, 0x5d, 0x1b, 0xa8, 0xee, 0xf0, 0x97,
+	0xb9, 0x1e, 0xdd, 0xf1, 0x97, 0xd2, 0xbd, 0x19, 0xfd, 0x70, 0xd4, 0xd5, 0x7d, 0xd5, 0x79, 0xfc,
+	0xac, 0x74, 0xe0, 0x09, 0x3c, 0x7f, 0x3d, 0x2b, 0xc5, 0xbe, 0x7a, 0x5e, 0x8a, 0xfd, 0x00, 0xcf,
+	0xcf, 0xf0, 0x3c, 0x86, 0xe7, 0x57, 0x78, 0xfe, 0x78, 0x0e, 0x38, 0xf8, 0xfb, 0xe8, 0x45, 0xe9,
+	0xc0, 0x63, 0x78, 0x9e, 0xc0, 0x73, 0xef, 0xdd, 0x06, 0xe9, 0xda, 0x62, 0x92, 0xed, 0xff, 0x25,
+	0xf3, 0x4e, 0x04, 0xb4, 0x9a, 0x64, 0x57, 0xbb, 0xb7, 0xff, 0x01, 0xf8, 0xce, 0x14, 0xd6, 0xd3,
+	0x19, 0x00, 0x00,
 }
 
 func (this *PollWorkflowTaskQueueRequest) Equal(that interface{}) bool {
@@ -2010,6 +2138,60 @@ func (this *CancelOutstandingPollResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *RemovePollerRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RemovePollerRequest)
+	if !ok {
+		that2, ok := that.(RemovePollerRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if this.TaskQueueType != that1.TaskQueueType {
+		return false
+	}
+	if !this.TaskQueue.Equal(that1.TaskQueue) {
+		return false
+	}
+	if this.Identity != that1.Identity {
+		return false
+	}
+	return true
+}
+func (this *RemovePollerResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RemovePollerResponse)
+	if !ok {
+		that2, ok := that.(RemovePollerResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 func (this *DescribeTaskQueueRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -2067,6 +2249,17 @@ func (this *DescribeTaskQueueResponse) Equal(that interface{}) bool {
 	if !this.TaskQueueStatus.Equal(that1.TaskQueueStatus) {
 		return false
 	}
+	if !this.SyncMatchStats.Equal(that1.SyncMatchStats) {
+		return false
+	}
+	if len(this.TaskHolders) != len(that1.TaskHolders) {
+		return false
+	}
+	for i := range this.TaskHolders {
+		if !this.TaskHolders[i].Equal(that1.TaskHolders[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *ListTaskQueuePartitionsRequest) Equal(that interface{}) bool {
@@ -2388,6 +2581,30 @@ func (this *CancelOutstandingPollResponse) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *RemovePollerRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&matchingservice.RemovePollerRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "TaskQueueType: "+fmt.Sprintf("%#v", this.TaskQueueType)+",\n")
+	if this.TaskQueue != nil {
+		s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	}
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RemovePollerResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&matchingservice.RemovePollerResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *DescribeTaskQueueRequest) GoString() string {
 	if this == nil {
 		return "nil"
@@ -2405,7 +2622,7 @@ func (this *DescribeTaskQueueResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 8)
 	s = append(s, "&matchingservice.DescribeTaskQueueResponse{")
 	if this.Pollers != nil {
 		s = append(s, "Pollers: "+fmt.Sprintf("%#v", this.Pollers)+",\n")
@@ -2413,6 +2630,12 @@ func (this *DescribeTaskQueueResponse) GoString() string {
 	if this.TaskQueueStatus != nil {
 		s = append(s, "TaskQueueStatus: "+fmt.Sprintf("%#v", this.TaskQueueStatus)+",\n")
 	}
+	if this.SyncMatchStats != nil {
+		s = append(s, "SyncMatchStats: "+fmt.Sprintf("%#v", this.SyncMatchStats)+",\n")
+	}
+	if this.TaskHolders != nil {
+		s = append(s, "TaskHolders: "+fmt.Sprintf("%#v", this.TaskHolders)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -3413,6 +3636,83 @@ func (m *CancelOutstandingPollResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *RemovePollerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemovePollerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemovePollerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TaskQueue != nil {
+		{
+			size, err := m.TaskQueue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TaskQueueType != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.TaskQueueType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemovePollerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemovePollerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemovePollerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *DescribeTaskQueueRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3475,6 +3775,32 @@ func (m *DescribeTaskQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if len(m.TaskHolders) > 0 {
+		for iNdEx := len(m.TaskHolders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaskHolders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.SyncMatchStats != nil {
+		{
+			size, err := m.SyncMatchStats.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.TaskQueueStatus != nil {
 		{
 			size, err := m.TaskQueueStatus.MarshalToSizedBuffer(dAtA[:i])
@@ -4007,7 +4333,7 @@ func (m *CancelOutstandingPollResponse) Size() (n int) {
 	return n
 }
 
-func (m *DescribeTaskQueueRequest) Size() (n int) {
+func (m *RemovePollerRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -4017,9 +4343,42 @@ func (m *DescribeTaskQueueRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.DescRequest != nil {
-		l = m.DescRequest.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.TaskQueueType != 0 {
+		n += 1 + sovRequestResponse(uint64(m.TaskQueueType))
+	}
+	if m.TaskQueue != nil {
+		l = m.TaskQueue.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *RemovePollerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DescribeTaskQueueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.DescRequest != nil {
+		l = m.DescRequest.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
 	return n
 }
@@ -4040,6 +4399,16 @@ func (m *DescribeTaskQueueResponse) Size() (n int) {
 		l = m.TaskQueueStatus.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if m.SyncMatchStats != nil {
+		l = m.SyncMatchStats.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if len(m.TaskHolders) > 0 {
+		for _, e := range m.TaskHolders {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -4292,6 +4661,28 @@ func (this *CancelOutstandingPollResponse) String() string {
 	}, "")
 	return s
 }
+func (this *RemovePollerRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RemovePollerRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`TaskQueueType:` + fmt.Sprintf("%v", this.TaskQueueType) + `,`,
+		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v14.TaskQueue", 1) + `,`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RemovePollerResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RemovePollerResponse{`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *DescribeTaskQueueRequest) String() string {
 	if this == nil {
 		return "nil"
@@ -4312,9 +4703,16 @@ func (this *DescribeTaskQueueResponse) String() string {
 		repeatedStringForPollers += strings.Replace(fmt.Sprintf("%v", f), "PollerInfo", "v14.PollerInfo", 1) + ","
 	}
 	repeatedStringForPollers += "}"
+	repeatedStringForTaskHolders := "[]*TaskHolderInfo{"
+	for _, f := range this.TaskHolders {
+		repeatedStringForTaskHolders += strings.Replace(fmt.Sprintf("%v", f), "TaskHolderInfo", "v17.TaskHolderInfo", 1) + ","
+	}
+	repeatedStringForTaskHolders += "}"
 	s := strings.Join([]string{`&DescribeTaskQueueResponse{`,
 		`Pollers:` + repeatedStringForPollers + `,`,
 		`TaskQueueStatus:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueueStatus), "TaskQueueStatus", "v14.TaskQueueStatus", 1) + `,`,
+		`SyncMatchStats:` + strings.Replace(fmt.Sprintf("%v", this.SyncMatchStats), "SyncMatchStats", "v17.SyncMatchStats", 1) + `,`,
+		`TaskHolders:` + repeatedStringForTaskHolders + `,`,
 		`}`,
 	}, "")
 	return s
@@ -7393,6 +7791,231 @@ func (m *CancelOutstandingPollResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RemovePollerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemovePollerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemovePollerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueueType", wireType)
+			}
+			m.TaskQueueType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskQueueType |= v16.TaskQueueType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskQueue == nil {
+				m.TaskQueue = &v14.TaskQueue{}
+			}
+			if err := m.TaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemovePollerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemovePollerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemovePollerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *DescribeTaskQueueRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -7613,6 +8236,76 @@ func (m *DescribeTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncMatchStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SyncMatchStats == nil {
+				m.SyncMatchStats = &v17.SyncMatchStats{}
+			}
+			if err := m.SyncMatchStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskHolders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskHolders = append(m.TaskHolders, &v17.TaskHolderInfo{})
+			if err := m.TaskHolders[len(m.TaskHolders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
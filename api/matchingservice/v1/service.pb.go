@@ -54,36 +54,37 @@ func init() {
 }
 
 var fileDescriptor_1a5c83076e651916 = []byte{
-	// 456 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xbf, 0x6e, 0x1a, 0x31,
-	0x1c, 0x80, 0xcf, 0x4b, 0x07, 0x57, 0x15, 0xea, 0x49, 0x55, 0x55, 0x06, 0x0f, 0x1d, 0x3a, 0xde,
-	0x89, 0xb6, 0x5b, 0xa1, 0x2d, 0x85, 0xfe, 0x93, 0x5a, 0x15, 0xda, 0x4a, 0x95, 0xba, 0x54, 0xe6,
-	0xce, 0xa5, 0x16, 0xc7, 0xf9, 0x6a, 0xfb, 0x2e, 0x62, 0xcb, 0x13, 0x44, 0x19, 0x32, 0xe5, 0x01,
-	0xa2, 0x0c, 0x99, 0xf2, 0x14, 0x19, 0x32, 0x30, 0x32, 0x86, 0x63, 0xc9, 0xc8, 0x23, 0x44, 0x70,
-	0xf8, 0x80, 0x03, 0x22, 0x73, 0x64, 0x03, 0xe3, 0xef, 0xf3, 0x67, 0x89, 0x9f, 0x0c, 0x5f, 0x4a,
-	0xd2, 0x0d, 0x18, 0xc7, 0x9e, 0x2d, 0x08, 0x8f, 0x08, 0xb7, 0x71, 0x40, 0xed, 0x2e, 0x96, 0xce,
-	0x3f, 0xea, 0xb7, 0x27, 0x4b, 0xd4, 0x21, 0x76, 0x54, 0xb2, 0x67, 0x1f, 0xad, 0x80, 0x33, 0xc9,
-	0xcc, 0x67, 0x8a, 0xb2, 0x12, 0xca, 0xc2, 0x01, 0xb5, 0x32, 0x94, 0x15, 0x95, 0x8a, 0x15, 0x4d,
-	0x3b, 0x27, 0xff, 0x43, 0x22, 0xe4, 0x1f, 0x4e, 0x44, 0xc0, 0x7c, 0x31, 0x3b, 0xe6, 0xf9, 0xe5,
-	0x7d, 0x58, 0xf8, 0x3a, 0xdb, 0xfd, 0x23, 0xd9, 0x6d, 0x9e, 0x00, 0xf8, 0xa8, 0xc1, 0x3c, 0xef,
-	0x17, 0xe3, 0x9d, 0xbf, 0x1e, 0xdb, 0xfb, 0x89, 0x45, 0xa7, 0x19, 0x92, 0x90, 0x98, 0x75, 0x4b,
-	0xaf, 0xca, 0x5a, 0x8b, 0x7f, 0x4f, 0x12, 0x8a, 0xef, 0x77, 0xb4, 0x24, 0x17, 0x78, 0x6a, 0xa4,
-	0xa1, 0x55, 0x47, 0xd2, 0x88, 0xca, 0x5e, 0xce, 0xd0, 0x15, 0x3c, 0x57, 0xe8, 0x1a, 0x4b, 0x1a,
-	0x7a, 0x04, 0x60, 0xa1, 0xea, 0xba, 0x8b, 0x77, 0x31, 0x5f, 0xeb, 0xca, 0x33, 0xa0, 0x8a, 0x7b,
-	0x93, 0x9b, 0xcf, 0x66, 0x2d, 0x96, 0x6f, 0x95, 0xb5, 0x08, 0xe6, 0xc9, 0x5a, 0xe6, 0xd3, 0xac,
-	0x03, 0x00, 0x1f, 0x34, 0x43, 0xc2, 0x7b, 0x2a, 0xdb, 0x2c, 0xeb, 0x4a, 0x97, 0x30, 0x95, 0x54,
-	0xc9, 0x49, 0xa7, 0x41, 0xe7, 0x00, 0x3e, 0x49, 0xbe, 0xba, 0xd3, 0x2d, 0x93, 0xde, 0x1a, 0xeb,
-	0x06, 0x1e, 0x91, 0xc4, 0x35, 0x3f, 0xe9, 0xea, 0x37, 0x2a, 0x54, 0xe8, 0xe7, 0x3b, 0x30, 0x2d,
-	0x0d, 0x47, 0x0d, 0xfb, 0x0e, 0xf1, 0xbe, 0x85, 0x52, 0x48, 0xec, 0xbb, 0xd4, 0x6f, 0x4f, 0xfe,
-	0xa8, 0xfa, 0xc3, 0xb1, 0x16, 0xdf, 0x7a, 0x38, 0x36, 0x58, 0xd2, 0xd0, 0x63, 0x00, 0x1f, 0xd6,
-	0x89, 0x70, 0x38, 0x6d, 0x91, 0xf9, 0x04, 0xbf, 0xd5, 0xd5, 0xaf, 0xa0, 0x2a, 0xb0, 0xba, 0x83,
-	0x21, 0x8d, 0x3b, 0x03, 0xf0, 0xf1, 0x17, 0x2a, 0x64, 0xfa, 0x5b, 0x03, 0x73, 0x49, 0x25, 0x65,
-	0xbe, 0x30, 0x3f, 0xe8, 0x1e, 0xb0, 0x41, 0xa0, 0x42, 0x3f, 0xee, 0xec, 0x51, 0xb9, 0xef, 0x78,
-	0x7f, 0x88, 0x8c, 0xc1, 0x10, 0x19, 0xe3, 0x21, 0x02, 0xfb, 0x31, 0x02, 0xa7, 0x31, 0x02, 0x17,
-	0x31, 0x02, 0xfd, 0x18, 0x81, 0xab, 0x18, 0x81, 0xeb, 0x18, 0x19, 0xe3, 0x18, 0x81, 0xc3, 0x11,
-	0x32, 0xfa, 0x23, 0x64, 0x0c, 0x46, 0xc8, 0xf8, 0x5d, 0x6e, 0xb3, 0x79, 0x02, 0x65, 0xb7, 0xbf,
-	0x24, 0xaf, 0x32, 0x4b, 0xad, 0x7b, 0xd3, 0x97, 0xe4, 0xc5, 0x4d, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x04, 0x9e, 0xce, 0x0a, 0xe8, 0x06, 0x00, 0x00,
+	// 475 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xbf, 0x6e, 0x13, 0x31,
+	0x1c, 0x80, 0xcf, 0x0b, 0x83, 0x55, 0x54, 0x61, 0x09, 0x21, 0x3a, 0x78, 0x60, 0x60, 0xbc, 0x53,
+	0x81, 0xad, 0x29, 0x10, 0x5a, 0xfe, 0x49, 0x20, 0xda, 0x82, 0x84, 0xc4, 0x82, 0xdc, 0xbb, 0x1f,
+	0xc1, 0xea, 0xdd, 0xf9, 0xb0, 0x7d, 0x87, 0xba, 0xb1, 0x23, 0x21, 0x06, 0x26, 0x1e, 0x00, 0x31,
+	0x30, 0xf1, 0x14, 0x8c, 0x19, 0x2b, 0x26, 0x72, 0x59, 0x18, 0xfb, 0x08, 0xe8, 0x7a, 0xb1, 0x93,
+	0x5c, 0x12, 0xe4, 0x5c, 0xba, 0x25, 0x67, 0x7f, 0x9f, 0x3f, 0x47, 0xf1, 0x19, 0xdf, 0xd2, 0x90,
+	0x64, 0x42, 0xb2, 0x38, 0x50, 0x20, 0x0b, 0x90, 0x01, 0xcb, 0x78, 0x90, 0x30, 0x1d, 0xbe, 0xe5,
+	0x69, 0xaf, 0x7a, 0xc4, 0x43, 0x08, 0x8a, 0xcd, 0x60, 0xf4, 0xd1, 0xcf, 0xa4, 0xd0, 0x82, 0x5c,
+	0x37, 0x94, 0x5f, 0x53, 0x3e, 0xcb, 0xb8, 0xdf, 0xa0, 0xfc, 0x62, 0x73, 0x63, 0xdb, 0xd1, 0x2e,
+	0xe1, 0x5d, 0x0e, 0x4a, 0xbf, 0x96, 0xa0, 0x32, 0x91, 0xaa, 0xd1, 0x32, 0x37, 0x7e, 0xaf, 0xe1,
+	0xf5, 0xa7, 0xa3, 0xd9, 0xcf, 0xeb, 0xd9, 0xe4, 0x1b, 0xc2, 0x97, 0xf7, 0x44, 0x1c, 0xbf, 0x14,
+	0xf2, 0xe8, 0x4d, 0x2c, 0xde, 0xbf, 0x60, 0xea, 0x68, 0x3f, 0x87, 0x1c, 0xc8, 0xae, 0xef, 0x56,
+	0xe5, 0xcf, 0xc5, 0x0f, 0xea, 0x84, 0x8d, 0xfb, 0x2b, 0x5a, 0xea, 0x0d, 0x5c, 0xf3, 0x6c, 0x68,
+	0x37, 0xd4, 0xbc, 0xe0, 0xfa, 0xb8, 0x65, 0xe8, 0x0c, 0xde, 0x2a, 0x74, 0x8e, 0xc5, 0x86, 0x7e,
+	0x41, 0x78, 0xbd, 0x1b, 0x45, 0x93, 0x7b, 0x21, 0xb7, 0x5d, 0xe5, 0x0d, 0xd0, 0xc4, 0xdd, 0x69,
+	0xcd, 0x37, 0xb3, 0x26, 0xcb, 0x97, 0xca, 0x9a, 0x04, 0xdb, 0x64, 0x4d, 0xf3, 0x36, 0xeb, 0x13,
+	0xc2, 0x17, 0xf7, 0x73, 0x90, 0xc7, 0x26, 0x9b, 0x74, 0x5c, 0xa5, 0x53, 0x98, 0x49, 0xda, 0x6e,
+	0x49, 0xdb, 0xa0, 0x9f, 0x08, 0x5f, 0xad, 0xbf, 0x46, 0x67, 0x53, 0xaa, 0xde, 0x1d, 0x91, 0x64,
+	0x31, 0x68, 0x88, 0xc8, 0x23, 0x57, 0xfd, 0x42, 0x85, 0x09, 0x7d, 0x7c, 0x0e, 0xa6, 0xa9, 0xc3,
+	0xb1, 0xc3, 0xd2, 0x10, 0xe2, 0x67, 0xb9, 0x56, 0x9a, 0xa5, 0x11, 0x4f, 0x7b, 0xd5, 0x1f, 0xd5,
+	0xfd, 0x70, 0xcc, 0xc5, 0x97, 0x3e, 0x1c, 0x0b, 0x2c, 0x36, 0xf4, 0x23, 0xc2, 0x6b, 0x07, 0x90,
+	0x88, 0x02, 0xaa, 0x01, 0x90, 0x64, 0xcb, 0xfd, 0x67, 0x18, 0x53, 0x26, 0xab, 0xd3, 0x0e, 0xb6,
+	0x35, 0x5f, 0x11, 0xbe, 0xb4, 0x0b, 0x2a, 0x94, 0xfc, 0x10, 0xc6, 0xef, 0x93, 0xbb, 0xae, 0xd6,
+	0x19, 0xd4, 0x74, 0x75, 0x57, 0x30, 0xd8, 0xb8, 0x1f, 0x08, 0x5f, 0x79, 0xc2, 0x95, 0xb6, 0x63,
+	0x7b, 0x4c, 0x6a, 0xae, 0xb9, 0x48, 0x15, 0x79, 0xe0, 0xba, 0xc0, 0x02, 0x81, 0x09, 0x7d, 0xb8,
+	0xb2, 0xc7, 0xe4, 0xde, 0x93, 0xfd, 0x01, 0xf5, 0x4e, 0x06, 0xd4, 0x3b, 0x1d, 0x50, 0xf4, 0xa1,
+	0xa4, 0xe8, 0x7b, 0x49, 0xd1, 0xaf, 0x92, 0xa2, 0x7e, 0x49, 0xd1, 0x9f, 0x92, 0xa2, 0xbf, 0x25,
+	0xf5, 0x4e, 0x4b, 0x8a, 0x3e, 0x0f, 0xa9, 0xd7, 0x1f, 0x52, 0xef, 0x64, 0x48, 0xbd, 0x57, 0x9d,
+	0x9e, 0x18, 0x27, 0x70, 0xf1, 0xff, 0x7b, 0x6d, 0xab, 0xf1, 0xe8, 0xf0, 0xc2, 0xd9, 0xbd, 0x76,
+	0xf3, 0xdf, 0x00, 0x35, 0x15, 0xa6, 0xc2, 0x76, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -122,6 +123,9 @@ type MatchingServiceClient interface {
 	// api call to matching it passes in a pollerId and then calls this API when it detects client connection is closed
 	// to unblock long polls for this poller and prevent tasks being sent to these zombie pollers.
 	CancelOutstandingPoll(ctx context.Context, in *CancelOutstandingPollRequest, opts ...grpc.CallOption) (*CancelOutstandingPollResponse, error)
+	// RemovePoller is called by frontend when a worker shuts down gracefully, to remove the worker from poller
+	// bookkeeping of the task queue immediately instead of waiting for the poller history to expire.
+	RemovePoller(ctx context.Context, in *RemovePollerRequest, opts ...grpc.CallOption) (*RemovePollerResponse, error)
 	// DescribeTaskQueue returns information about the target task queue, right now this API returns the
 	// pollers which polled this task queue in last few minutes.
 	DescribeTaskQueue(ctx context.Context, in *DescribeTaskQueueRequest, opts ...grpc.CallOption) (*DescribeTaskQueueResponse, error)
@@ -200,6 +204,15 @@ func (c *matchingServiceClient) CancelOutstandingPoll(ctx context.Context, in *C
 	return out, nil
 }
 
+func (c *matchingServiceClient) RemovePoller(ctx context.Context, in *RemovePollerRequest, opts ...grpc.CallOption) (*RemovePollerResponse, error) {
+	out := new(RemovePollerResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.matchingservice.v1.MatchingService/RemovePoller", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *matchingServiceClient) DescribeTaskQueue(ctx context.Context, in *DescribeTaskQueueRequest, opts ...grpc.CallOption) (*DescribeTaskQueueResponse, error) {
 	out := new(DescribeTaskQueueResponse)
 	err := c.cc.Invoke(ctx, "/temporal.server.api.matchingservice.v1.MatchingService/DescribeTaskQueue", in, out, opts...)
@@ -244,6 +257,9 @@ type MatchingServiceServer interface {
 	// api call to matching it passes in a pollerId and then calls this API when it detects client connection is closed
 	// to unblock long polls for this poller and prevent tasks being sent to these zombie pollers.
 	CancelOutstandingPoll(context.Context, *CancelOutstandingPollRequest) (*CancelOutstandingPollResponse, error)
+	// RemovePoller is called by frontend when a worker shuts down gracefully, to remove the worker from poller
+	// bookkeeping of the task queue immediately instead of waiting for the poller history to expire.
+	RemovePoller(context.Context, *RemovePollerRequest) (*RemovePollerResponse, error)
 	// DescribeTaskQueue returns information about the target task queue, right now this API returns the
 	// pollers which polled this task queue in last few minutes.
 	DescribeTaskQueue(context.Context, *DescribeTaskQueueRequest) (*DescribeTaskQueueResponse, error)
@@ -276,6 +292,9 @@ func (*UnimplementedMatchingServiceServer) RespondQueryTaskCompleted(ctx context
 func (*UnimplementedMatchingServiceServer) CancelOutstandingPoll(ctx context.Context, req *CancelOutstandingPollRequest) (*CancelOutstandingPollResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelOutstandingPoll not implemented")
 }
+func (*UnimplementedMatchingServiceServer) RemovePoller(ctx context.Context, req *RemovePollerRequest) (*RemovePollerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePoller not implemented")
+}
 func (*UnimplementedMatchingServiceServer) DescribeTaskQueue(ctx context.Context, req *DescribeTaskQueueRequest) (*DescribeTaskQueueResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeTaskQueue not implemented")
 }
@@ -413,6 +432,24 @@ func _MatchingService_CancelOutstandingPoll_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MatchingService_RemovePoller_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePollerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).RemovePoller(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.matchingservice.v1.MatchingService/RemovePoller",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).RemovePoller(ctx, req.(*RemovePollerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MatchingService_DescribeTaskQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DescribeTaskQueueRequest)
 	if err := dec(in); err != nil {
@@ -481,6 +518,10 @@ var _MatchingService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelOutstandingPoll",
 			Handler:    _MatchingService_CancelOutstandingPoll_Handler,
 		},
+		{
+			MethodName: "RemovePoller",
+			Handler:    _MatchingService_RemovePoller_Handler,
+		},
 		{
 			MethodName: "DescribeTaskQueue",
 			Handler:    _MatchingService_DescribeTaskQueue_Handler,
@@ -220,6 +220,26 @@ func (mr *MockMatchingServiceClientMockRecorder) QueryWorkflow(ctx, in interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryWorkflow", reflect.TypeOf((*MockMatchingServiceClient)(nil).QueryWorkflow), varargs...)
 }
 
+// RemovePoller mocks base method.
+func (m *MockMatchingServiceClient) RemovePoller(ctx context.Context, in *matchingservice.RemovePollerRequest, opts ...grpc.CallOption) (*matchingservice.RemovePollerResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemovePoller", varargs...)
+	ret0, _ := ret[0].(*matchingservice.RemovePollerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemovePoller indicates an expected call of RemovePoller.
+func (mr *MockMatchingServiceClientMockRecorder) RemovePoller(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePoller", reflect.TypeOf((*MockMatchingServiceClient)(nil).RemovePoller), varargs...)
+}
+
 // RespondQueryTaskCompleted mocks base method.
 func (m *MockMatchingServiceClient) RespondQueryTaskCompleted(ctx context.Context, in *matchingservice.RespondQueryTaskCompletedRequest, opts ...grpc.CallOption) (*matchingservice.RespondQueryTaskCompletedResponse, error) {
 	m.ctrl.T.Helper()
@@ -383,6 +403,21 @@ func (mr *MockMatchingServiceServerMockRecorder) QueryWorkflow(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryWorkflow", reflect.TypeOf((*MockMatchingServiceServer)(nil).QueryWorkflow), arg0, arg1)
 }
 
+// RemovePoller mocks base method.
+func (m *MockMatchingServiceServer) RemovePoller(arg0 context.Context, arg1 *matchingservice.RemovePollerRequest) (*matchingservice.RemovePollerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePoller", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.RemovePollerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemovePoller indicates an expected call of RemovePoller.
+func (mr *MockMatchingServiceServerMockRecorder) RemovePoller(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePoller", reflect.TypeOf((*MockMatchingServiceServer)(nil).RemovePoller), arg0, arg1)
+}
+
 // RespondQueryTaskCompleted mocks base method.
 func (m *MockMatchingServiceServer) RespondQueryTaskCompleted(arg0 context.Context, arg1 *matchingservice.RespondQueryTaskCompletedRequest) (*matchingservice.RespondQueryTaskCompletedResponse, error) {
 	m.ctrl.T.Helper()
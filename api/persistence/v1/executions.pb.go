@@ -44,12 +44,13 @@ import (
 	_ "github.com/gogo/protobuf/types"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	v12 "go.temporal.io/api/common/v1"
-	v15 "go.temporal.io/api/enums/v1"
-	v16 "go.temporal.io/api/failure/v1"
+	v16 "go.temporal.io/api/enums/v1"
+	v17 "go.temporal.io/api/failure/v1"
 	v1 "go.temporal.io/api/history/v1"
 	v11 "go.temporal.io/api/workflow/v1"
-	v14 "go.temporal.io/server/api/enums/v1"
+	v15 "go.temporal.io/server/api/enums/v1"
 	v13 "go.temporal.io/server/api/history/v1"
+	v14 "go.temporal.io/server/api/workflow/v1"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -268,6 +269,16 @@ type WorkflowExecutionInfo struct {
 	FirstExecutionRunId             string                  `protobuf:"bytes,55,opt,name=first_execution_run_id,json=firstExecutionRunId,proto3" json:"first_execution_run_id,omitempty"`
 	ExecutionStats                  *ExecutionStats         `protobuf:"bytes,56,opt,name=execution_stats,json=executionStats,proto3" json:"execution_stats,omitempty"`
 	WorkflowRunExpirationTime       *time.Time              `protobuf:"bytes,57,opt,name=workflow_run_expiration_time,json=workflowRunExpirationTime,proto3,stdtime" json:"workflow_run_expiration_time,omitempty"`
+	// Non-indexed key/value tags attached to the execution, not visible to visibility store.
+	Tags                        map[string]string `protobuf:"bytes,58,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	WorkflowTaskStartedIdentity string            `protobuf:"bytes,59,opt,name=workflow_task_started_identity,json=workflowTaskStartedIdentity,proto3" json:"workflow_task_started_identity,omitempty"`
+	// Set while the execution is paused, workflow tasks are not dispatched to workers until it is unpaused.
+	PauseInfo *v14.WorkflowPauseInfo `protobuf:"bytes,60,opt,name=pause_info,json=pauseInfo,proto3" json:"pause_info,omitempty"`
+	// Failover version and time of the last pause or unpause, used to resolve the replicated pause state.
+	PauseStateVersion    int64      `protobuf:"varint,61,opt,name=pause_state_version,json=pauseStateVersion,proto3" json:"pause_state_version,omitempty"`
+	PauseStateUpdateTime *time.Time `protobuf:"bytes,62,opt,name=pause_state_update_time,json=pauseStateUpdateTime,proto3,stdtime" json:"pause_state_update_time,omitempty"`
+	// Failover version of the last tags update, used to resolve the replicated tags.
+	TagsVersion int64 `protobuf:"varint,63,opt,name=tags_version,json=tagsVersion,proto3" json:"tags_version,omitempty"`
 }
 
 func (m *WorkflowExecutionInfo) Reset()      { *m = WorkflowExecutionInfo{} }
@@ -653,6 +664,48 @@ func (m *WorkflowExecutionInfo) GetWorkflowRunExpirationTime() *time.Time {
 	return nil
 }
 
+func (m *WorkflowExecutionInfo) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionInfo) GetWorkflowTaskStartedIdentity() string {
+	if m != nil {
+		return m.WorkflowTaskStartedIdentity
+	}
+	return ""
+}
+
+func (m *WorkflowExecutionInfo) GetPauseInfo() *v14.WorkflowPauseInfo {
+	if m != nil {
+		return m.PauseInfo
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionInfo) GetPauseStateVersion() int64 {
+	if m != nil {
+		return m.PauseStateVersion
+	}
+	return 0
+}
+
+func (m *WorkflowExecutionInfo) GetPauseStateUpdateTime() *time.Time {
+	if m != nil {
+		return m.PauseStateUpdateTime
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionInfo) GetTagsVersion() int64 {
+	if m != nil {
+		return m.TagsVersion
+	}
+	return 0
+}
+
 type ExecutionStats struct {
 	HistorySize int64 `protobuf:"varint,1,opt,name=history_size,json=historySize,proto3" json:"history_size,omitempty"`
 }
@@ -700,8 +753,8 @@ func (m *ExecutionStats) GetHistorySize() int64 {
 type WorkflowExecutionState struct {
 	CreateRequestId string                      `protobuf:"bytes,1,opt,name=create_request_id,json=createRequestId,proto3" json:"create_request_id,omitempty"`
 	RunId           string                      `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
-	State           v14.WorkflowExecutionState  `protobuf:"varint,3,opt,name=state,proto3,enum=temporal.server.api.enums.v1.WorkflowExecutionState" json:"state,omitempty"`
-	Status          v15.WorkflowExecutionStatus `protobuf:"varint,4,opt,name=status,proto3,enum=temporal.api.enums.v1.WorkflowExecutionStatus" json:"status,omitempty"`
+	State           v15.WorkflowExecutionState  `protobuf:"varint,3,opt,name=state,proto3,enum=temporal.server.api.enums.v1.WorkflowExecutionState" json:"state,omitempty"`
+	Status          v16.WorkflowExecutionStatus `protobuf:"varint,4,opt,name=status,proto3,enum=temporal.api.enums.v1.WorkflowExecutionStatus" json:"status,omitempty"`
 }
 
 func (m *WorkflowExecutionState) Reset()      { *m = WorkflowExecutionState{} }
@@ -750,18 +803,18 @@ func (m *WorkflowExecutionState) GetRunId() string {
 	return ""
 }
 
-func (m *WorkflowExecutionState) GetState() v14.WorkflowExecutionState {
+func (m *WorkflowExecutionState) GetState() v15.WorkflowExecutionState {
 	if m != nil {
 		return m.State
 	}
-	return v14.WORKFLOW_EXECUTION_STATE_UNSPECIFIED
+	return v15.WORKFLOW_EXECUTION_STATE_UNSPECIFIED
 }
 
-func (m *WorkflowExecutionState) GetStatus() v15.WorkflowExecutionStatus {
+func (m *WorkflowExecutionState) GetStatus() v16.WorkflowExecutionStatus {
 	if m != nil {
 		return m.Status
 	}
-	return v15.WORKFLOW_EXECUTION_STATUS_UNSPECIFIED
+	return v16.WORKFLOW_EXECUTION_STATUS_UNSPECIFIED
 }
 
 // transfer column
@@ -769,7 +822,7 @@ type TransferTaskInfo struct {
 	NamespaceId             string       `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	WorkflowId              string       `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
 	RunId                   string       `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
-	TaskType                v14.TaskType `protobuf:"varint,4,opt,name=task_type,json=taskType,proto3,enum=temporal.server.api.enums.v1.TaskType" json:"task_type,omitempty"`
+	TaskType                v15.TaskType `protobuf:"varint,4,opt,name=task_type,json=taskType,proto3,enum=temporal.server.api.enums.v1.TaskType" json:"task_type,omitempty"`
 	TargetNamespaceId       string       `protobuf:"bytes,5,opt,name=target_namespace_id,json=targetNamespaceId,proto3" json:"target_namespace_id,omitempty"`
 	TargetWorkflowId        string       `protobuf:"bytes,6,opt,name=target_workflow_id,json=targetWorkflowId,proto3" json:"target_workflow_id,omitempty"`
 	TargetRunId             string       `protobuf:"bytes,7,opt,name=target_run_id,json=targetRunId,proto3" json:"target_run_id,omitempty"`
@@ -835,11 +888,11 @@ func (m *TransferTaskInfo) GetRunId() string {
 	return ""
 }
 
-func (m *TransferTaskInfo) GetTaskType() v14.TaskType {
+func (m *TransferTaskInfo) GetTaskType() v15.TaskType {
 	if m != nil {
 		return m.TaskType
 	}
-	return v14.TASK_TYPE_UNSPECIFIED
+	return v15.TASK_TYPE_UNSPECIFIED
 }
 
 func (m *TransferTaskInfo) GetTargetNamespaceId() string {
@@ -917,7 +970,7 @@ type ReplicationTaskInfo struct {
 	NamespaceId       string       `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	WorkflowId        string       `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
 	RunId             string       `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
-	TaskType          v14.TaskType `protobuf:"varint,4,opt,name=task_type,json=taskType,proto3,enum=temporal.server.api.enums.v1.TaskType" json:"task_type,omitempty"`
+	TaskType          v15.TaskType `protobuf:"varint,4,opt,name=task_type,json=taskType,proto3,enum=temporal.server.api.enums.v1.TaskType" json:"task_type,omitempty"`
 	Version           int64        `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
 	FirstEventId      int64        `protobuf:"varint,6,opt,name=first_event_id,json=firstEventId,proto3" json:"first_event_id,omitempty"`
 	NextEventId       int64        `protobuf:"varint,7,opt,name=next_event_id,json=nextEventId,proto3" json:"next_event_id,omitempty"`
@@ -980,11 +1033,11 @@ func (m *ReplicationTaskInfo) GetRunId() string {
 	return ""
 }
 
-func (m *ReplicationTaskInfo) GetTaskType() v14.TaskType {
+func (m *ReplicationTaskInfo) GetTaskType() v15.TaskType {
 	if m != nil {
 		return m.TaskType
 	}
-	return v14.TASK_TYPE_UNSPECIFIED
+	return v15.TASK_TYPE_UNSPECIFIED
 }
 
 func (m *ReplicationTaskInfo) GetVersion() int64 {
@@ -1041,7 +1094,7 @@ type VisibilityTaskInfo struct {
 	NamespaceId    string       `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	WorkflowId     string       `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
 	RunId          string       `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
-	TaskType       v14.TaskType `protobuf:"varint,4,opt,name=task_type,json=taskType,proto3,enum=temporal.server.api.enums.v1.TaskType" json:"task_type,omitempty"`
+	TaskType       v15.TaskType `protobuf:"varint,4,opt,name=task_type,json=taskType,proto3,enum=temporal.server.api.enums.v1.TaskType" json:"task_type,omitempty"`
 	Version        int64        `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
 	TaskId         int64        `protobuf:"varint,6,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
 	VisibilityTime *time.Time   `protobuf:"bytes,7,opt,name=visibility_time,json=visibilityTime,proto3,stdtime" json:"visibility_time,omitempty"`
@@ -1100,11 +1153,11 @@ func (m *VisibilityTaskInfo) GetRunId() string {
 	return ""
 }
 
-func (m *VisibilityTaskInfo) GetTaskType() v14.TaskType {
+func (m *VisibilityTaskInfo) GetTaskType() v15.TaskType {
 	if m != nil {
 		return m.TaskType
 	}
-	return v14.TASK_TYPE_UNSPECIFIED
+	return v15.TASK_TYPE_UNSPECIFIED
 }
 
 func (m *VisibilityTaskInfo) GetVersion() int64 {
@@ -1133,9 +1186,9 @@ type TimerTaskInfo struct {
 	NamespaceId         string                  `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	WorkflowId          string                  `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
 	RunId               string                  `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
-	TaskType            v14.TaskType            `protobuf:"varint,4,opt,name=task_type,json=taskType,proto3,enum=temporal.server.api.enums.v1.TaskType" json:"task_type,omitempty"`
-	TimeoutType         v15.TimeoutType         `protobuf:"varint,5,opt,name=timeout_type,json=timeoutType,proto3,enum=temporal.api.enums.v1.TimeoutType" json:"timeout_type,omitempty"`
-	WorkflowBackoffType v14.WorkflowBackoffType `protobuf:"varint,6,opt,name=workflow_backoff_type,json=workflowBackoffType,proto3,enum=temporal.server.api.enums.v1.WorkflowBackoffType" json:"workflow_backoff_type,omitempty"`
+	TaskType            v15.TaskType            `protobuf:"varint,4,opt,name=task_type,json=taskType,proto3,enum=temporal.server.api.enums.v1.TaskType" json:"task_type,omitempty"`
+	TimeoutType         v16.TimeoutType         `protobuf:"varint,5,opt,name=timeout_type,json=timeoutType,proto3,enum=temporal.api.enums.v1.TimeoutType" json:"timeout_type,omitempty"`
+	WorkflowBackoffType v15.WorkflowBackoffType `protobuf:"varint,6,opt,name=workflow_backoff_type,json=workflowBackoffType,proto3,enum=temporal.server.api.enums.v1.WorkflowBackoffType" json:"workflow_backoff_type,omitempty"`
 	Version             int64                   `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
 	ScheduleAttempt     int32                   `protobuf:"varint,8,opt,name=schedule_attempt,json=scheduleAttempt,proto3" json:"schedule_attempt,omitempty"`
 	EventId             int64                   `protobuf:"varint,9,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
@@ -1196,25 +1249,25 @@ func (m *TimerTaskInfo) GetRunId() string {
 	return ""
 }
 
-func (m *TimerTaskInfo) GetTaskType() v14.TaskType {
+func (m *TimerTaskInfo) GetTaskType() v15.TaskType {
 	if m != nil {
 		return m.TaskType
 	}
-	return v14.TASK_TYPE_UNSPECIFIED
+	return v15.TASK_TYPE_UNSPECIFIED
 }
 
-func (m *TimerTaskInfo) GetTimeoutType() v15.TimeoutType {
+func (m *TimerTaskInfo) GetTimeoutType() v16.TimeoutType {
 	if m != nil {
 		return m.TimeoutType
 	}
-	return v15.TIMEOUT_TYPE_UNSPECIFIED
+	return v16.TIMEOUT_TYPE_UNSPECIFIED
 }
 
-func (m *TimerTaskInfo) GetWorkflowBackoffType() v14.WorkflowBackoffType {
+func (m *TimerTaskInfo) GetWorkflowBackoffType() v15.WorkflowBackoffType {
 	if m != nil {
 		return m.WorkflowBackoffType
 	}
-	return v14.WORKFLOW_BACKOFF_TYPE_UNSPECIFIED
+	return v15.WORKFLOW_BACKOFF_TYPE_UNSPECIFIED
 }
 
 func (m *TimerTaskInfo) GetVersion() int64 {
@@ -1286,7 +1339,7 @@ type ActivityInfo struct {
 	RetryExpirationTime         *time.Time     `protobuf:"bytes,24,opt,name=retry_expiration_time,json=retryExpirationTime,proto3,stdtime" json:"retry_expiration_time,omitempty"`
 	RetryBackoffCoefficient     float64        `protobuf:"fixed64,25,opt,name=retry_backoff_coefficient,json=retryBackoffCoefficient,proto3" json:"retry_backoff_coefficient,omitempty"`
 	RetryNonRetryableErrorTypes []string       `protobuf:"bytes,26,rep,name=retry_non_retryable_error_types,json=retryNonRetryableErrorTypes,proto3" json:"retry_non_retryable_error_types,omitempty"`
-	RetryLastFailure            *v16.Failure   `protobuf:"bytes,27,opt,name=retry_last_failure,json=retryLastFailure,proto3" json:"retry_last_failure,omitempty"`
+	RetryLastFailure            *v17.Failure   `protobuf:"bytes,27,opt,name=retry_last_failure,json=retryLastFailure,proto3" json:"retry_last_failure,omitempty"`
 	RetryLastWorkerIdentity     string         `protobuf:"bytes,28,opt,name=retry_last_worker_identity,json=retryLastWorkerIdentity,proto3" json:"retry_last_worker_identity,omitempty"`
 	NamespaceId                 string         `protobuf:"bytes,29,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	ScheduleId                  int64          `protobuf:"varint,30,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
@@ -1508,7 +1561,7 @@ func (m *ActivityInfo) GetRetryNonRetryableErrorTypes() []string {
 	return nil
 }
 
-func (m *ActivityInfo) GetRetryLastFailure() *v16.Failure {
+func (m *ActivityInfo) GetRetryLastFailure() *v17.Failure {
 	if m != nil {
 		return m.RetryLastFailure
 	}
@@ -1639,7 +1692,7 @@ type ChildExecutionInfo struct {
 	CreateRequestId       string                `protobuf:"bytes,8,opt,name=create_request_id,json=createRequestId,proto3" json:"create_request_id,omitempty"`
 	Namespace             string                `protobuf:"bytes,9,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	WorkflowTypeName      string                `protobuf:"bytes,10,opt,name=workflow_type_name,json=workflowTypeName,proto3" json:"workflow_type_name,omitempty"`
-	ParentClosePolicy     v15.ParentClosePolicy `protobuf:"varint,11,opt,name=parent_close_policy,json=parentClosePolicy,proto3,enum=temporal.api.enums.v1.ParentClosePolicy" json:"parent_close_policy,omitempty"`
+	ParentClosePolicy     v16.ParentClosePolicy `protobuf:"varint,11,opt,name=parent_close_policy,json=parentClosePolicy,proto3,enum=temporal.api.enums.v1.ParentClosePolicy" json:"parent_close_policy,omitempty"`
 	InitiatedId           int64                 `protobuf:"varint,12,opt,name=initiated_id,json=initiatedId,proto3" json:"initiated_id,omitempty"`
 }
 
@@ -1745,11 +1798,11 @@ func (m *ChildExecutionInfo) GetWorkflowTypeName() string {
 	return ""
 }
 
-func (m *ChildExecutionInfo) GetParentClosePolicy() v15.ParentClosePolicy {
+func (m *ChildExecutionInfo) GetParentClosePolicy() v16.ParentClosePolicy {
 	if m != nil {
 		return m.ParentClosePolicy
 	}
-	return v15.PARENT_CLOSE_POLICY_UNSPECIFIED
+	return v16.PARENT_CLOSE_POLICY_UNSPECIFIED
 }
 
 func (m *ChildExecutionInfo) GetInitiatedId() int64 {
@@ -1922,7 +1975,7 @@ func (m *SignalInfo) GetInitiatedId() int64 {
 // checksum column
 type Checksum struct {
 	Version int32              `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
-	Flavor  v14.ChecksumFlavor `protobuf:"varint,2,opt,name=flavor,proto3,enum=temporal.server.api.enums.v1.ChecksumFlavor" json:"flavor,omitempty"`
+	Flavor  v15.ChecksumFlavor `protobuf:"varint,2,opt,name=flavor,proto3,enum=temporal.server.api.enums.v1.ChecksumFlavor" json:"flavor,omitempty"`
 	Value   []byte             `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 }
 
@@ -1965,11 +2018,11 @@ func (m *Checksum) GetVersion() int32 {
 	return 0
 }
 
-func (m *Checksum) GetFlavor() v14.ChecksumFlavor {
+func (m *Checksum) GetFlavor() v15.ChecksumFlavor {
 	if m != nil {
 		return m.Flavor
 	}
-	return v14.CHECKSUM_FLAVOR_UNSPECIFIED
+	return v15.CHECKSUM_FLAVOR_UNSPECIFIED
 }
 
 func (m *Checksum) GetValue() []byte {
@@ -1988,6 +2041,7 @@ func init() {
 	proto.RegisterType((*WorkflowExecutionInfo)(nil), "temporal.server.api.persistence.v1.WorkflowExecutionInfo")
 	proto.RegisterMapType((map[string]*v12.Payload)(nil), "temporal.server.api.persistence.v1.WorkflowExecutionInfo.MemoEntry")
 	proto.RegisterMapType((map[string]*v12.Payload)(nil), "temporal.server.api.persistence.v1.WorkflowExecutionInfo.SearchAttributesEntry")
+	proto.RegisterMapType((map[string]string)(nil), "temporal.server.api.persistence.v1.WorkflowExecutionInfo.TagsEntry")
 	proto.RegisterType((*ExecutionStats)(nil), "temporal.server.api.persistence.v1.ExecutionStats")
 	proto.RegisterType((*WorkflowExecutionState)(nil), "temporal.server.api.persistence.v1.WorkflowExecutionState")
 	proto.RegisterType((*TransferTaskInfo)(nil), "temporal.server.api.persistence.v1.TransferTaskInfo")
@@ -2007,207 +2061,212 @@ func init() {
 }
 
 var fileDescriptor_67a714d0e7ba9f37 = []byte{
-	// 3191 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3a, 0xcd, 0x73, 0xdb, 0xc6,
-	0xf5, 0x86, 0x45, 0x89, 0xe4, 0x23, 0x45, 0x91, 0xd0, 0x17, 0x24, 0xdb, 0x94, 0xcc, 0xd8, 0x89,
-	0x1c, 0x3b, 0x94, 0x2d, 0x3b, 0xdf, 0x87, 0xdf, 0xd8, 0xb2, 0x9d, 0x90, 0x93, 0x38, 0x0e, 0xa4,
-	0xc4, 0x99, 0xfc, 0x26, 0xc3, 0x81, 0x80, 0xa5, 0x84, 0x9f, 0x40, 0x80, 0x06, 0x40, 0xda, 0xcc,
-	0xfc, 0x0e, 0x39, 0x74, 0x9a, 0x6b, 0x8e, 0x9d, 0xe9, 0xa9, 0xb7, 0x1e, 0x7a, 0xea, 0x4c, 0xff,
-	0x80, 0x4e, 0x2f, 0x3d, 0xe6, 0x98, 0x43, 0xa7, 0x6d, 0x94, 0x4b, 0x2f, 0x9d, 0xe6, 0x4f, 0xe8,
-	0xec, 0xdb, 0x5d, 0x60, 0x01, 0x42, 0x32, 0xe5, 0xc6, 0x87, 0xf4, 0x06, 0xec, 0xfb, 0xd8, 0xb7,
-	0x6f, 0xdf, 0x37, 0x00, 0x37, 0x43, 0xd2, 0xeb, 0x7b, 0xbe, 0xe1, 0x6c, 0x06, 0xc4, 0x1f, 0x12,
-	0x7f, 0xd3, 0xe8, 0xdb, 0x9b, 0x7d, 0xe2, 0x07, 0x76, 0x10, 0x12, 0xd7, 0x24, 0x9b, 0xc3, 0x1b,
-	0x9b, 0xe4, 0x29, 0x31, 0x07, 0xa1, 0xed, 0xb9, 0x41, 0xb3, 0xef, 0x7b, 0xa1, 0xa7, 0x36, 0x04,
-	0x51, 0x93, 0x11, 0x35, 0x8d, 0xbe, 0xdd, 0x94, 0x88, 0x9a, 0xc3, 0x1b, 0xab, 0xf5, 0x7d, 0xcf,
-	0xdb, 0x77, 0xc8, 0x26, 0x52, 0xec, 0x0d, 0xba, 0x9b, 0xd6, 0xc0, 0x37, 0x28, 0x13, 0xc6, 0x63,
-	0x75, 0x2d, 0x0d, 0x0f, 0xed, 0x1e, 0x09, 0x42, 0xa3, 0xd7, 0xe7, 0x08, 0x63, 0x0c, 0x9e, 0xf8,
-	0x46, 0x9f, 0x6e, 0xc2, 0xe1, 0x17, 0x2d, 0xd2, 0x27, 0xae, 0x45, 0x5c, 0xd3, 0x26, 0xc1, 0xe6,
-	0xbe, 0xb7, 0xef, 0xe1, 0x3a, 0x3e, 0x71, 0x94, 0x4b, 0xd1, 0xe1, 0xe8, 0xa9, 0x4c, 0xaf, 0xd7,
-	0xf3, 0x5c, 0x7a, 0xa0, 0x1e, 0x09, 0x02, 0x63, 0x9f, 0x64, 0x62, 0x11, 0x77, 0xd0, 0x0b, 0x28,
-	0xd2, 0x13, 0xcf, 0x3f, 0xec, 0x3a, 0xde, 0x13, 0x8e, 0x75, 0x39, 0x81, 0xd5, 0x35, 0x6c, 0x67,
-	0xe0, 0x93, 0x71, 0x66, 0x49, 0xb4, 0x03, 0x3b, 0x08, 0x3d, 0x7f, 0x34, 0x8e, 0xf6, 0x72, 0x02,
-	0x4d, 0x6c, 0x35, 0x8e, 0x77, 0x25, 0xeb, 0x7a, 0x22, 0x11, 0xd9, 0x89, 0x38, 0xea, 0xd5, 0x13,
-	0x51, 0x53, 0xa7, 0x79, 0xe5, 0x44, 0xe4, 0xd0, 0x08, 0x0e, 0x39, 0xe2, 0xb5, 0x2c, 0xc4, 0xe3,
-	0x8e, 0xd5, 0xf8, 0x2b, 0x40, 0x71, 0xe7, 0xc0, 0xf0, 0xad, 0x96, 0xdb, 0xf5, 0xd4, 0x15, 0x28,
-	0x04, 0xf4, 0xa5, 0x63, 0x5b, 0x9a, 0xb2, 0xae, 0x6c, 0x4c, 0xeb, 0x79, 0x7c, 0x6f, 0x59, 0x14,
-	0xe4, 0x1b, 0xee, 0x3e, 0xa1, 0xa0, 0xb3, 0xeb, 0xca, 0xc6, 0x94, 0x9e, 0xc7, 0xf7, 0x96, 0xa5,
-	0x2e, 0xc0, 0xb4, 0xf7, 0xc4, 0x25, 0xbe, 0x36, 0xb5, 0xae, 0x6c, 0x14, 0x75, 0xf6, 0xa2, 0x6e,
-	0xc1, 0xa2, 0x4f, 0xfa, 0x8e, 0x6d, 0xa2, 0x0d, 0x75, 0x0c, 0xf3, 0xb0, 0xe3, 0x90, 0x21, 0x71,
-	0xb4, 0x1c, 0x52, 0xcf, 0x4b, 0xc0, 0xdb, 0xe6, 0xe1, 0x07, 0x14, 0xa4, 0x5e, 0x03, 0x35, 0xf4,
-	0x0d, 0x37, 0xe8, 0x12, 0x5f, 0x22, 0x98, 0x46, 0x82, 0xaa, 0x80, 0xc8, 0xd8, 0x41, 0xe8, 0x39,
-	0xc4, 0xed, 0x04, 0xb6, 0x6b, 0x92, 0x8e, 0x4f, 0x5c, 0xf2, 0x44, 0x9b, 0x41, 0xb9, 0xab, 0x0c,
-	0xb2, 0x43, 0x01, 0x3a, 0x5d, 0x57, 0x6f, 0x43, 0x69, 0xd0, 0xb7, 0x8c, 0x90, 0x74, 0xa8, 0xdd,
-	0x6a, 0xf9, 0x75, 0x65, 0xa3, 0xb4, 0xb5, 0xda, 0x64, 0x36, 0xdb, 0x14, 0x36, 0xdb, 0xdc, 0x15,
-	0x46, 0x7d, 0x27, 0xf7, 0xcd, 0xdf, 0xd6, 0x14, 0x1d, 0x18, 0x11, 0x5d, 0x56, 0x3f, 0x86, 0x05,
-	0x4a, 0x2b, 0xc9, 0xc6, 0x78, 0x15, 0x26, 0xe4, 0x55, 0x43, 0x6a, 0x21, 0x3f, 0xb2, 0xbc, 0x0b,
-	0x75, 0xd7, 0xe8, 0x91, 0xa0, 0x6f, 0x98, 0xa4, 0xe3, 0x7a, 0xa1, 0xdd, 0x15, 0x0a, 0x1b, 0x52,
-	0xef, 0xf4, 0x5c, 0xad, 0x88, 0xa7, 0x3f, 0x1f, 0x61, 0x3d, 0x90, 0x90, 0x3e, 0x65, 0x38, 0xea,
-	0xd7, 0x0a, 0xac, 0x9a, 0xce, 0x20, 0x08, 0x89, 0xdf, 0xc9, 0x50, 0x20, 0xac, 0x4f, 0x6d, 0x94,
-	0xb6, 0xda, 0xcd, 0x67, 0x07, 0x81, 0x66, 0x64, 0x0b, 0xcd, 0x6d, 0xc6, 0x6f, 0x37, 0xa5, 0xf5,
-	0x7b, 0x6e, 0xe8, 0x8f, 0xf4, 0x65, 0x33, 0x1b, 0xaa, 0xfe, 0x42, 0x81, 0xe5, 0x48, 0x92, 0xa4,
-	0xae, 0xb4, 0x12, 0x8a, 0xf1, 0xde, 0xf3, 0x89, 0x21, 0x6b, 0x0e, 0x65, 0xe0, 0x3a, 0x5d, 0x30,
-	0x33, 0x10, 0xd4, 0x5f, 0x2a, 0xb0, 0x22, 0xc4, 0x90, 0xad, 0x90, 0x09, 0x52, 0xfe, 0x0f, 0xf4,
-	0xa1, 0xc7, 0xdc, 0x32, 0xf4, 0x91, 0x86, 0x52, 0x7d, 0xac, 0xc8, 0x02, 0x58, 0xce, 0x63, 0x49,
-	0x23, 0xb3, 0x28, 0x48, 0xeb, 0x74, 0x82, 0x48, 0x7b, 0xdc, 0x75, 0x1e, 0x27, 0xef, 0x65, 0xc9,
-	0xcf, 0x04, 0xaa, 0xd7, 0x61, 0x61, 0x68, 0x07, 0xf6, 0x9e, 0xed, 0xd8, 0xe1, 0x48, 0x12, 0xa0,
-	0x82, 0xc6, 0xa5, 0xc6, 0x30, 0x41, 0xb1, 0xda, 0x86, 0xf3, 0x27, 0x59, 0x80, 0x5a, 0x85, 0xa9,
-	0x43, 0x32, 0xc2, 0x28, 0x51, 0xd4, 0xe9, 0x23, 0x0d, 0x03, 0x43, 0xc3, 0x19, 0x10, 0x1e, 0x1e,
-	0xd8, 0xcb, 0x3b, 0x67, 0xdf, 0x52, 0x56, 0x4d, 0x58, 0x39, 0xf6, 0x1a, 0x33, 0x18, 0x5d, 0x97,
-	0x19, 0x9d, 0xe8, 0x57, 0xf2, 0x26, 0xb1, 0xc0, 0x99, 0x57, 0x74, 0x2a, 0x81, 0x5b, 0x70, 0xee,
-	0x04, 0x2d, 0x9f, 0x86, 0x55, 0xe3, 0x77, 0xe7, 0x60, 0xf1, 0x11, 0x0f, 0xe5, 0xf7, 0x44, 0x5a,
-	0xc6, 0x60, 0x7b, 0x11, 0xca, 0xb1, 0xeb, 0xf3, 0x80, 0x5b, 0xd4, 0x4b, 0xd1, 0x5a, 0xcb, 0x52,
-	0xd7, 0xa0, 0x24, 0xd2, 0x80, 0x88, 0xbb, 0x45, 0x1d, 0xc4, 0x52, 0xcb, 0x52, 0x9b, 0x30, 0xdf,
-	0x37, 0x7c, 0xe2, 0x86, 0x9d, 0x04, 0x2b, 0x16, 0x88, 0x6b, 0x0c, 0xf4, 0x40, 0x62, 0x78, 0x0d,
-	0x54, 0x8e, 0x2f, 0xf3, 0xcd, 0x21, 0x7a, 0x95, 0x41, 0x1e, 0xc5, 0xdc, 0x1b, 0x30, 0xcb, 0xb1,
-	0xfd, 0x81, 0x4b, 0x11, 0xa7, 0x99, 0x88, 0x6c, 0x51, 0x1f, 0xb8, 0x2d, 0x8b, 0x9e, 0xc2, 0x76,
-	0xed, 0xd0, 0x36, 0x42, 0x82, 0x69, 0x63, 0x06, 0x15, 0x50, 0x8a, 0xd6, 0x5a, 0x96, 0xfa, 0x36,
-	0xac, 0x98, 0x5e, 0xaf, 0xef, 0x10, 0xf4, 0x00, 0x32, 0xa4, 0x0c, 0xf7, 0x8c, 0xd0, 0x3c, 0xa0,
-	0xf8, 0x79, 0xc4, 0x5f, 0x8a, 0x11, 0xee, 0x51, 0xf8, 0x1d, 0x0a, 0x6e, 0x59, 0xea, 0x43, 0xa8,
-	0xa6, 0x49, 0x79, 0xb4, 0xbd, 0x1c, 0x3b, 0x0d, 0xf5, 0x16, 0x9e, 0xe0, 0xa8, 0xa7, 0xbc, 0xcf,
-	0x1e, 0x91, 0x8f, 0x3e, 0x97, 0x62, 0xac, 0x5e, 0x00, 0xa0, 0xc9, 0xb2, 0xf3, 0x78, 0x40, 0x06,
-	0x04, 0x83, 0x6b, 0x51, 0x2f, 0xd2, 0x95, 0x8f, 0xe9, 0x02, 0x55, 0x50, 0xa4, 0x99, 0x70, 0xd4,
-	0x27, 0xa8, 0x57, 0x0d, 0x98, 0x82, 0x04, 0x64, 0x77, 0xd4, 0x27, 0x54, 0xab, 0xea, 0x17, 0xb0,
-	0x1a, 0x61, 0x47, 0x35, 0x17, 0xc6, 0x3d, 0x6f, 0x10, 0x6a, 0x25, 0x14, 0x74, 0x65, 0xcc, 0x7c,
-	0xef, 0xf2, 0xba, 0xea, 0x4e, 0xee, 0x57, 0x34, 0x82, 0x69, 0x4f, 0xd2, 0xe6, 0xb1, 0xcb, 0x18,
-	0xd0, 0x7c, 0x13, 0xb1, 0xa7, 0x37, 0x20, 0x18, 0x97, 0x27, 0x63, 0x1c, 0x9d, 0x44, 0x1f, 0x44,
-	0x2c, 0xf7, 0xe0, 0x82, 0x45, 0xba, 0xc6, 0xc0, 0x91, 0x2c, 0x00, 0xf5, 0x21, 0x78, 0xcf, 0x4e,
-	0xc6, 0x7b, 0x95, 0x73, 0x11, 0xd6, 0xb2, 0x6b, 0x04, 0x87, 0x62, 0x8f, 0x97, 0x60, 0x36, 0x08,
-	0x0d, 0x3f, 0x8c, 0x52, 0x18, 0x8b, 0x32, 0x65, 0x5c, 0x14, 0x29, 0xeb, 0x2a, 0xa8, 0x8e, 0x11,
-	0x84, 0xdc, 0x1c, 0x50, 0x04, 0xdb, 0xd2, 0x6a, 0x88, 0x39, 0x47, 0x21, 0x78, 0x5d, 0x94, 0x6d,
-	0xcb, 0x52, 0x5f, 0x83, 0x79, 0x44, 0xee, 0xda, 0x7e, 0x44, 0x62, 0x5b, 0x9a, 0xca, 0x0a, 0x03,
-	0x0a, 0xba, 0x4f, 0x21, 0x48, 0xd2, 0xb2, 0x68, 0xb4, 0x43, 0xf4, 0xbe, 0xef, 0x99, 0x24, 0x08,
-	0x88, 0xc5, 0x2d, 0x67, 0x9e, 0x45, 0x3b, 0x0a, 0x7b, 0x28, 0x40, 0xcc, 0x2a, 0xfe, 0x07, 0x80,
-	0x89, 0x8c, 0xf9, 0x7c, 0x61, 0xc2, 0x7c, 0x5e, 0x44, 0x1a, 0xcc, 0xe3, 0x6d, 0x40, 0x31, 0x3a,
-	0x72, 0x89, 0xb1, 0x38, 0x21, 0x9b, 0x0a, 0xa5, 0xfc, 0x24, 0x2e, 0x33, 0xb6, 0x60, 0x31, 0x79,
-	0x37, 0x42, 0x8f, 0x4b, 0xac, 0x72, 0x7a, 0x22, 0xe9, 0x5c, 0xa8, 0xf3, 0x6d, 0x58, 0x49, 0xd2,
-	0x04, 0xe6, 0x01, 0xb1, 0x06, 0x0e, 0x86, 0x83, 0x65, 0xe6, 0x63, 0x32, 0xdd, 0x0e, 0x07, 0xb7,
-	0x2c, 0xf5, 0x4d, 0xd0, 0x52, 0xa4, 0xf4, 0x54, 0xcc, 0x9b, 0x35, 0xa4, 0x5c, 0x4c, 0x50, 0x32,
-	0x68, 0xcb, 0x52, 0x77, 0xd2, 0x72, 0x0a, 0x1b, 0x5a, 0x99, 0xcc, 0x86, 0x12, 0x07, 0x11, 0xc6,
-	0x33, 0x76, 0x78, 0x23, 0xa4, 0x8e, 0x1e, 0x6a, 0xab, 0x58, 0xd7, 0x25, 0x68, 0x6e, 0x33, 0x50,
-	0xc2, 0x0d, 0x13, 0x27, 0xc0, 0x6b, 0x38, 0x37, 0xe1, 0x35, 0x2c, 0x67, 0x9c, 0x12, 0xef, 0xc3,
-	0x80, 0xf3, 0xd9, 0xba, 0xe5, 0x1b, 0x9c, 0x9f, 0x70, 0x83, 0x95, 0xac, 0x0b, 0x60, 0x5b, 0x5c,
-	0x81, 0xaa, 0x69, 0xb8, 0x26, 0x71, 0x3a, 0x3e, 0x79, 0x3c, 0x20, 0x41, 0x48, 0x2c, 0xed, 0xc2,
-	0xba, 0xb2, 0x51, 0xd0, 0xe7, 0xd8, 0xba, 0x2e, 0x96, 0x55, 0x1f, 0x2e, 0x27, 0xa5, 0xf1, 0x7c,
-	0x7b, 0xdf, 0x76, 0x0d, 0x27, 0x2d, 0x56, 0x7d, 0x42, 0xb1, 0x2e, 0xca, 0x62, 0x7d, 0xc4, 0x99,
-	0x25, 0xc5, 0x1b, 0x33, 0x11, 0x2e, 0x25, 0x35, 0x91, 0x35, 0x8c, 0x8d, 0x09, 0x13, 0xe1, 0xc2,
-	0xb6, 0x2c, 0xf5, 0x55, 0xa8, 0x25, 0xcf, 0x45, 0x29, 0xd6, 0x91, 0x22, 0x79, 0x30, 0x86, 0x1b,
-	0x84, 0xb6, 0x79, 0x38, 0xea, 0x48, 0x01, 0xfa, 0x22, 0xc3, 0x65, 0x80, 0xdd, 0x28, 0x4c, 0xef,
-	0xc3, 0x3a, 0xc7, 0x8d, 0xec, 0x3c, 0xf4, 0x3a, 0xb1, 0x0b, 0x53, 0x2b, 0x6c, 0x4c, 0x66, 0x85,
-	0xe7, 0x19, 0x23, 0x71, 0xe0, 0x5d, 0x6f, 0x47, 0x38, 0x35, 0x35, 0x47, 0x0d, 0xf2, 0xc2, 0x00,
-	0x5f, 0x62, 0x0d, 0x11, 0x7f, 0x55, 0x3f, 0x81, 0x25, 0x9f, 0x84, 0xfe, 0xa8, 0xc3, 0x52, 0x9d,
-	0xd3, 0xb1, 0xdd, 0x90, 0xf8, 0x43, 0xc3, 0xd1, 0x2e, 0x4d, 0xb6, 0xf1, 0x02, 0x92, 0xb7, 0x18,
-	0x75, 0x8b, 0x13, 0xc7, 0x6c, 0x7b, 0xc6, 0x53, 0xbb, 0x37, 0xe8, 0xc5, 0x6c, 0x2f, 0x9f, 0x86,
-	0xed, 0x87, 0x8c, 0x3a, 0x62, 0x7b, 0x2b, 0xcd, 0x96, 0x1f, 0x23, 0xd0, 0x5e, 0xc6, 0x63, 0x25,
-	0xa8, 0xb8, 0x5f, 0x05, 0xea, 0x3b, 0xb4, 0x78, 0xa5, 0x54, 0x7b, 0x86, 0x79, 0xe8, 0x75, 0xbb,
-	0x1d, 0xd3, 0x23, 0xdd, 0xae, 0x6d, 0xda, 0x34, 0x9a, 0xbe, 0xb2, 0xae, 0x6c, 0x28, 0xfa, 0x32,
-	0x22, 0xdc, 0x61, 0xf0, 0xed, 0x18, 0xac, 0xf6, 0xa0, 0x91, 0x91, 0x1b, 0xc9, 0xd3, 0xbe, 0xcd,
-	0xc4, 0x65, 0x46, 0xba, 0x31, 0xa1, 0x91, 0xae, 0x8d, 0x25, 0xc9, 0x7b, 0x11, 0x27, 0xde, 0x48,
-	0xad, 0x31, 0x51, 0x5d, 0xcf, 0xed, 0xe0, 0x93, 0xb1, 0xe7, 0x90, 0x0e, 0xf1, 0x7d, 0xcf, 0xc7,
-	0x4c, 0x1e, 0x68, 0x57, 0xd6, 0xa7, 0x36, 0x8a, 0xfa, 0x39, 0x04, 0x3e, 0xf0, 0x5c, 0x5d, 0x20,
-	0xdd, 0xa3, 0x38, 0x34, 0xa7, 0x07, 0xea, 0x06, 0x54, 0x0f, 0x8c, 0x80, 0xd1, 0x77, 0xfa, 0x9e,
-	0x63, 0x9b, 0x23, 0xed, 0x55, 0xf4, 0xc3, 0xca, 0x81, 0x11, 0x20, 0xc5, 0x43, 0x5c, 0xa5, 0x49,
-	0xce, 0xf4, 0x3d, 0x37, 0xb2, 0x3f, 0xed, 0x2a, 0x5a, 0x6a, 0x99, 0x2e, 0x0a, 0x5b, 0xa2, 0xc5,
-	0x51, 0x60, 0xef, 0x53, 0xdf, 0x34, 0xbd, 0x81, 0x1b, 0x6a, 0x4d, 0x56, 0x1c, 0xb1, 0xb5, 0x6d,
-	0xba, 0xa4, 0x5e, 0x86, 0x32, 0xaf, 0x5d, 0x3a, 0x81, 0xfd, 0x25, 0xd1, 0x36, 0x29, 0xca, 0x9d,
-	0xb3, 0x9a, 0xa2, 0x97, 0xf8, 0xfa, 0x8e, 0xfd, 0x25, 0x6d, 0x3d, 0x6b, 0xc6, 0x20, 0xf4, 0x3a,
-	0x3e, 0x09, 0x48, 0xd8, 0xe9, 0x7b, 0xb6, 0x1b, 0x06, 0xda, 0xcd, 0xac, 0x4a, 0x28, 0x9a, 0x1b,
-	0x0c, 0x6f, 0x34, 0x75, 0x8a, 0xfd, 0x10, 0x91, 0xf5, 0x39, 0x4a, 0x2f, 0x2d, 0xa8, 0xff, 0x0f,
-	0xb5, 0x80, 0x18, 0xbe, 0x79, 0x40, 0x6d, 0xc1, 0xb7, 0xf7, 0x06, 0x21, 0x09, 0xb4, 0x5b, 0xd8,
-	0x91, 0x7c, 0x34, 0x49, 0x47, 0x92, 0x59, 0xd5, 0x36, 0x77, 0x90, 0xe5, 0xed, 0x88, 0x23, 0xeb,
-	0x4b, 0xaa, 0x41, 0x6a, 0x59, 0x7d, 0x04, 0xb9, 0x1e, 0xe9, 0x79, 0xda, 0xeb, 0xb8, 0xe1, 0xf6,
-	0xf3, 0x6f, 0xf8, 0x21, 0xe9, 0x79, 0x6c, 0x13, 0x64, 0xa8, 0x7e, 0x01, 0x35, 0x9e, 0x2f, 0x3b,
-	0x4c, 0x81, 0x36, 0x09, 0xb4, 0x37, 0x50, 0x53, 0xd7, 0x33, 0x77, 0x91, 0x4a, 0x47, 0x9e, 0x4d,
-	0xdf, 0x17, 0x74, 0x7a, 0x75, 0x98, 0x5a, 0x51, 0x6f, 0xc2, 0x12, 0xaf, 0x42, 0x22, 0x9b, 0xe6,
-	0xc5, 0xf1, 0x9b, 0x68, 0x00, 0xf3, 0x08, 0x8d, 0x44, 0x64, 0x45, 0xf2, 0xff, 0xc2, 0x5c, 0x8c,
-	0x1e, 0x84, 0x46, 0x18, 0x68, 0x6f, 0xa1, 0x44, 0x5b, 0x93, 0x9c, 0x3b, 0x62, 0xb6, 0x43, 0x29,
-	0xf5, 0x0a, 0x49, 0xbc, 0x27, 0xd2, 0x13, 0x15, 0x25, 0xed, 0x62, 0x6f, 0x9f, 0x36, 0x3d, 0xe9,
-	0x83, 0x94, 0x73, 0xad, 0x5a, 0xb0, 0x98, 0x79, 0xaf, 0x19, 0x9d, 0xd0, 0xeb, 0xc9, 0xe6, 0x6d,
-	0x2d, 0x69, 0x9c, 0x7c, 0xfe, 0x35, 0xbc, 0xd1, 0x7c, 0x68, 0x8c, 0x1c, 0xcf, 0xb0, 0xe4, 0xae,
-	0xeb, 0x33, 0x28, 0x46, 0x97, 0xf9, 0x93, 0x72, 0x6e, 0xe7, 0x0a, 0x73, 0xd5, 0x6a, 0x3b, 0x57,
-	0xa8, 0x56, 0x6b, 0xed, 0x5c, 0xe1, 0x5a, 0xf5, 0xb5, 0x76, 0xae, 0xf0, 0x5a, 0xb5, 0xd9, 0xce,
-	0x15, 0xae, 0x57, 0x6f, 0xb4, 0x73, 0x85, 0x1b, 0xd5, 0xad, 0x76, 0xae, 0xb0, 0x55, 0xbd, 0xd9,
-	0xb8, 0x09, 0x95, 0xa4, 0xba, 0xa9, 0x0f, 0x27, 0x1c, 0x54, 0x61, 0x3e, 0x2c, 0x39, 0x67, 0xe3,
-	0x5f, 0x0a, 0x2c, 0x8d, 0x19, 0x27, 0xa5, 0x26, 0x98, 0x00, 0x7d, 0x42, 0x4b, 0x42, 0x29, 0x01,
-	0x2a, 0x3c, 0x01, 0x22, 0x20, 0x4e, 0x80, 0x8b, 0x30, 0xc3, 0x4d, 0x89, 0x35, 0x7a, 0xd3, 0x3e,
-	0x1a, 0x4f, 0x1b, 0xa6, 0xa9, 0xc9, 0x10, 0xec, 0xea, 0x2a, 0x5b, 0xb7, 0x32, 0x4d, 0x06, 0x27,
-	0x81, 0x99, 0x4e, 0x82, 0x72, 0xe8, 0x8c, 0x85, 0x7a, 0x1f, 0x66, 0xe8, 0xc3, 0x20, 0xc0, 0x9e,
-	0xaf, 0xb2, 0xd5, 0x4c, 0x2a, 0xf1, 0x64, 0x2e, 0x83, 0x40, 0xe7, 0xd4, 0x8d, 0xbf, 0xe4, 0xa0,
-	0x2a, 0xe6, 0x02, 0x58, 0xa3, 0xff, 0x54, 0x0d, 0x6d, 0xac, 0x83, 0x29, 0x59, 0x07, 0xdb, 0x50,
-	0x64, 0x15, 0xe6, 0xa8, 0x4f, 0xb8, 0xe8, 0x2f, 0x9f, 0xac, 0x07, 0xac, 0x29, 0x47, 0x7d, 0xa2,
-	0x17, 0x42, 0xfe, 0x44, 0x9b, 0xe5, 0xd0, 0xf0, 0xf7, 0x49, 0xaa, 0x59, 0x66, 0x4d, 0x6d, 0x8d,
-	0x81, 0x52, 0xcd, 0x32, 0xc7, 0x97, 0x65, 0x9e, 0x61, 0xbd, 0x20, 0x83, 0x24, 0x9b, 0x65, 0x8e,
-	0xcd, 0x0f, 0x90, 0x67, 0xc7, 0x67, 0x8b, 0x2c, 0x0e, 0x24, 0x9b, 0xcf, 0x42, 0xba, 0xf9, 0x7c,
-	0x17, 0x56, 0x39, 0x0b, 0xf3, 0xc0, 0x76, 0xac, 0x78, 0x5b, 0xcf, 0x75, 0x46, 0xd8, 0xab, 0x16,
-	0xf4, 0x65, 0x86, 0xb1, 0x4d, 0x11, 0xc4, 0xee, 0x1f, 0xb9, 0xce, 0x88, 0xaa, 0x56, 0xae, 0xf9,
-	0x01, 0xcd, 0x14, 0x82, 0xb8, 0xce, 0xd7, 0x20, 0x2f, 0x1a, 0x89, 0x12, 0x1b, 0xe0, 0xf2, 0x57,
-	0x75, 0x19, 0xf2, 0xa2, 0x01, 0x2b, 0x23, 0x64, 0x26, 0x64, 0x7d, 0x57, 0x0b, 0xe6, 0xa4, 0xb1,
-	0x11, 0x46, 0x93, 0xd9, 0x49, 0x9b, 0x9a, 0x98, 0x10, 0xf3, 0xf3, 0x55, 0xa8, 0xf9, 0xc4, 0xf4,
-	0x7c, 0xab, 0x13, 0x03, 0xb0, 0x31, 0x2c, 0xe8, 0x55, 0x06, 0xf8, 0x34, 0x5a, 0x6f, 0xfc, 0x71,
-	0x0a, 0xe6, 0xa5, 0x01, 0xcc, 0xcf, 0xc6, 0xc2, 0x24, 0x15, 0x4f, 0x27, 0x55, 0x7c, 0x09, 0x2a,
-	0xa9, 0xe6, 0x95, 0x0d, 0x4a, 0xca, 0x5d, 0xb9, 0x71, 0x6d, 0xc0, 0xac, 0x4b, 0x9e, 0x4a, 0x48,
-	0x6c, 0x3a, 0x52, 0xa2, 0x8b, 0x02, 0x87, 0xd6, 0x14, 0x51, 0xa1, 0x6f, 0x5b, 0x68, 0x45, 0xb4,
-	0xa6, 0x10, 0x6b, 0x0c, 0x65, 0xcf, 0x37, 0x5c, 0xf3, 0xa0, 0x13, 0x7a, 0x87, 0x84, 0x5d, 0x77,
-	0x59, 0x2f, 0xb1, 0xb5, 0x5d, 0xba, 0xa4, 0x6e, 0xc2, 0x82, 0x4b, 0x58, 0xbe, 0x48, 0xa0, 0xce,
-	0x22, 0x6a, 0xcd, 0x25, 0x34, 0x0b, 0xdc, 0x91, 0x08, 0x24, 0x1b, 0x99, 0x93, 0x6d, 0xa4, 0x9d,
-	0x2b, 0x14, 0xab, 0xd0, 0xce, 0x15, 0xa0, 0x5a, 0x6a, 0xe7, 0x0a, 0xe5, 0xea, 0x6c, 0x3b, 0x57,
-	0xa8, 0x54, 0xe7, 0x1a, 0xbf, 0x3f, 0x0b, 0x6a, 0x7c, 0xa5, 0xff, 0x05, 0x57, 0x28, 0x69, 0x60,
-	0xe6, 0x59, 0x5e, 0x92, 0x7f, 0x3e, 0x2f, 0x69, 0xfc, 0x26, 0x07, 0xb3, 0x38, 0x23, 0xfd, 0xd9,
-	0xe8, 0xeb, 0x1e, 0x94, 0x79, 0xc3, 0xc5, 0xf8, 0x4c, 0x23, 0x9f, 0xc6, 0x31, 0x79, 0x85, 0xb7,
-	0x55, 0xc8, 0xa3, 0x14, 0xc6, 0x2f, 0x2a, 0x91, 0xda, 0x7e, 0xd1, 0x6c, 0x20, 0xbf, 0x19, 0xe4,
-	0x77, 0x63, 0xb2, 0xa4, 0xc7, 0xdb, 0x10, 0x64, 0x1f, 0x4d, 0x0a, 0xa4, 0x45, 0xf9, 0x76, 0xf3,
-	0xc9, 0xdb, 0xbd, 0x02, 0xd5, 0x28, 0x7c, 0x8a, 0x8e, 0xaf, 0x80, 0xad, 0xd1, 0x9c, 0x58, 0x17,
-	0xe3, 0x86, 0x15, 0x28, 0x44, 0x0e, 0xca, 0xbe, 0xce, 0xe4, 0x09, 0x77, 0x4e, 0xc9, 0x46, 0xe0,
-	0x59, 0x36, 0x52, 0x7a, 0x4e, 0x1b, 0xf9, 0xf5, 0x1c, 0x94, 0x6f, 0x9b, 0xa1, 0x3d, 0xb4, 0xc3,
-	0x11, 0x9a, 0x88, 0x74, 0x28, 0x25, 0x79, 0xa8, 0x37, 0x41, 0x8b, 0x63, 0x45, 0x6a, 0xf0, 0xca,
-	0x26, 0xd5, 0x8b, 0x11, 0x3c, 0x31, 0x77, 0x7d, 0x00, 0x73, 0x29, 0x42, 0x34, 0x9d, 0x89, 0xc7,
-	0xae, 0x95, 0x24, 0x5b, 0xf5, 0x3d, 0xa8, 0xa4, 0xa6, 0x13, 0xb9, 0x09, 0x4f, 0x3f, 0x1b, 0x24,
-	0x26, 0x11, 0x17, 0xf8, 0xa0, 0x8e, 0xc5, 0x3e, 0xe6, 0xa1, 0xc5, 0x20, 0x1a, 0x49, 0xb5, 0xf9,
-	0xe8, 0x31, 0x92, 0x7a, 0xe6, 0x34, 0x52, 0x97, 0x39, 0x2d, 0x93, 0x79, 0x1b, 0xca, 0x89, 0x39,
-	0xd2, 0xa4, 0x3e, 0x5d, 0x0a, 0xa4, 0xd9, 0xd1, 0x1a, 0x94, 0x0c, 0x7e, 0x57, 0x22, 0x58, 0x17,
-	0x75, 0x10, 0x4b, 0xac, 0x24, 0x90, 0x2a, 0x43, 0x3e, 0x8f, 0xf6, 0xa3, 0x9a, 0xf0, 0x73, 0x58,
-	0x39, 0x7e, 0xc2, 0x01, 0x93, 0x4d, 0x04, 0x96, 0x82, 0xec, 0xd9, 0x46, 0x8a, 0xb7, 0xe9, 0x78,
-	0x01, 0x39, 0xed, 0xf0, 0x5a, 0xe2, 0xbd, 0x4d, 0xe9, 0x05, 0xef, 0x5d, 0x58, 0xe2, 0xb2, 0xa6,
-	0x19, 0x4f, 0x38, 0xbc, 0x9e, 0x67, 0xb3, 0xd5, 0x24, 0xd7, 0x0f, 0xa0, 0x76, 0x40, 0x0c, 0x3f,
-	0xdc, 0x23, 0x46, 0x78, 0xda, 0x89, 0x75, 0x35, 0xa2, 0x14, 0xdc, 0xb2, 0x86, 0x6e, 0x95, 0xec,
-	0xa1, 0x5b, 0xe6, 0x1c, 0x8b, 0xe5, 0xc1, 0xac, 0x39, 0x16, 0xfb, 0xf2, 0x29, 0x46, 0x91, 0xb4,
-	0xdc, 0xae, 0xb2, 0x50, 0x12, 0x8a, 0xd8, 0xce, 0xea, 0x69, 0x79, 0xbc, 0x54, 0x4b, 0x8e, 0x97,
-	0x92, 0xa5, 0xa2, 0x9a, 0x2e, 0x15, 0x69, 0xb8, 0x8a, 0xfc, 0x80, 0xb8, 0x21, 0xad, 0xa6, 0xe6,
-	0xc5, 0xac, 0x8c, 0x7b, 0x03, 0x5b, 0xce, 0x9c, 0x69, 0x2c, 0x64, 0xce, 0x34, 0x8e, 0x1f, 0x69,
-	0x2d, 0xbe, 0x98, 0x91, 0xd6, 0xd2, 0x8b, 0x19, 0x69, 0x2d, 0x9f, 0x30, 0xd2, 0xda, 0x85, 0x45,
-	0x46, 0x95, 0x6e, 0x93, 0xb5, 0x09, 0xdd, 0x7b, 0x1e, 0xc9, 0x53, 0xd3, 0xa7, 0x13, 0x07, 0x65,
-	0x2b, 0x27, 0x0f, 0xca, 0x26, 0x98, 0x5c, 0xad, 0x3e, 0x7b, 0x72, 0xf5, 0x00, 0x54, 0xc6, 0x85,
-	0x7d, 0x28, 0x61, 0x7f, 0xbb, 0xf0, 0xd9, 0xf7, 0x7a, 0x32, 0xfc, 0x71, 0x20, 0x0d, 0x7f, 0xf7,
-	0xd9, 0x23, 0x2d, 0xc1, 0x43, 0x7f, 0xf4, 0x81, 0x11, 0x84, 0x7c, 0x85, 0xf6, 0x22, 0x12, 0x3f,
-	0x9a, 0x4b, 0x89, 0x1f, 0x9b, 0xda, 0x79, 0x34, 0xb5, 0xe5, 0x88, 0xea, 0x11, 0xc2, 0x23, 0x93,
-	0x4b, 0x17, 0x2d, 0x17, 0x32, 0x8b, 0x16, 0xb9, 0x5d, 0xa9, 0x8f, 0xb5, 0x2b, 0x9f, 0xc2, 0x12,
-	0x6e, 0x1d, 0x3b, 0xbc, 0x45, 0x42, 0xc3, 0x76, 0x02, 0x9c, 0x38, 0x8f, 0x1d, 0x6a, 0xac, 0xff,
-	0x0f, 0x74, 0xfc, 0x08, 0xf4, 0xbe, 0x20, 0xbf, 0xcb, 0xa8, 0xd5, 0x2f, 0x60, 0x35, 0xc5, 0x57,
-	0xfe, 0x66, 0xb3, 0x3e, 0xe9, 0xc7, 0x82, 0x04, 0xef, 0xf8, 0xe3, 0x4d, 0xe3, 0x4f, 0x0a, 0x14,
-	0xb1, 0x82, 0x7b, 0x46, 0x6a, 0x4e, 0x26, 0xb2, 0xb3, 0xe9, 0x44, 0x76, 0x1b, 0x4a, 0x68, 0xa0,
-	0xbc, 0x56, 0x98, 0x9a, 0xf4, 0x6f, 0x15, 0x46, 0x24, 0x52, 0x8f, 0x1c, 0x81, 0xd8, 0x6f, 0x37,
-	0x18, 0x54, 0x78, 0xf0, 0x59, 0x81, 0x02, 0x0b, 0x54, 0x51, 0x13, 0x9c, 0xc7, 0xf7, 0x96, 0xd5,
-	0xf8, 0x67, 0x0e, 0x54, 0x6c, 0x31, 0x93, 0x9f, 0xac, 0x4f, 0xac, 0x34, 0xe2, 0xcf, 0xc0, 0xd9,
-	0x95, 0x46, 0x04, 0x4f, 0x54, 0x1a, 0x49, 0x3d, 0x4c, 0xa5, 0xf5, 0xf0, 0x00, 0xe6, 0x52, 0x7c,
-	0x79, 0xe5, 0x30, 0x69, 0x21, 0x92, 0xdc, 0x55, 0x6d, 0xc2, 0xbc, 0xd8, 0x4e, 0xae, 0x99, 0xf9,
-	0x0c, 0x80, 0x83, 0xa4, 0xae, 0xfe, 0x12, 0x54, 0x04, 0x3e, 0x2f, 0xa1, 0x59, 0xff, 0x2f, 0x4a,
-	0x03, 0x9d, 0x8f, 0x68, 0x52, 0x65, 0x47, 0xfe, 0xf9, 0xcb, 0x8e, 0xcc, 0x89, 0x51, 0x21, 0x7b,
-	0x62, 0x74, 0x1e, 0x8a, 0x91, 0x4f, 0x89, 0xda, 0x21, 0x5a, 0x38, 0xe5, 0xb7, 0xec, 0xcf, 0xa2,
-	0x5f, 0x09, 0x58, 0xbe, 0xe6, 0x99, 0xa2, 0x84, 0xf5, 0xf7, 0xc6, 0x31, 0xf5, 0xfc, 0x43, 0xa4,
-	0xc0, 0x1c, 0xcd, 0x72, 0x88, 0xf8, 0xe9, 0x40, 0x5a, 0x1a, 0xfb, 0x45, 0xa0, 0x3c, 0xf6, 0x8b,
-	0x40, 0xe3, 0x0f, 0x0a, 0xd4, 0xf8, 0xb1, 0xb6, 0x31, 0x9d, 0xbe, 0x28, 0x73, 0xcb, 0x4c, 0xe4,
-	0x53, 0xd9, 0x1f, 0xa4, 0xd2, 0x72, 0xe7, 0xc6, 0xe5, 0xfe, 0xfa, 0x2c, 0xc0, 0x0e, 0x4e, 0xf3,
-	0x5f, 0xa0, 0x7f, 0x8c, 0x49, 0x2a, 0xd5, 0x87, 0x2a, 0xe4, 0xf0, 0x56, 0xd9, 0x2f, 0x1c, 0xf8,
-	0xac, 0xbe, 0x01, 0xd3, 0xb6, 0xdb, 0x1f, 0x84, 0x68, 0xd5, 0x93, 0x04, 0x4a, 0x86, 0x4e, 0xa5,
-	0x37, 0x3d, 0x37, 0xf4, 0x3d, 0x87, 0x1b, 0xb9, 0x78, 0x1d, 0xd3, 0x44, 0x7e, 0x5c, 0x13, 0x5f,
-	0x29, 0x50, 0xd8, 0x3e, 0x20, 0xe6, 0x61, 0x30, 0xe8, 0xa5, 0xf5, 0x30, 0x1d, 0xeb, 0xe1, 0x2e,
-	0xcc, 0x74, 0x1d, 0x63, 0xe8, 0xf9, 0x78, 0xea, 0xca, 0xd6, 0xb5, 0x93, 0x1b, 0x3b, 0xc1, 0xf1,
-	0x3e, 0xd2, 0xe8, 0x9c, 0x36, 0xfe, 0xdd, 0x66, 0x0a, 0xc7, 0x15, 0xec, 0xe5, 0xce, 0xff, 0x7d,
-	0xfb, 0x7d, 0xfd, 0xcc, 0x77, 0xdf, 0xd7, 0xcf, 0xfc, 0xf8, 0x7d, 0x5d, 0xf9, 0xea, 0xa8, 0xae,
-	0xfc, 0xf6, 0xa8, 0xae, 0xfc, 0xf9, 0xa8, 0xae, 0x7c, 0x7b, 0x54, 0x57, 0xfe, 0x7e, 0x54, 0x57,
-	0xfe, 0x71, 0x54, 0x3f, 0xf3, 0xe3, 0x51, 0x5d, 0xf9, 0xe6, 0x87, 0xfa, 0x99, 0x6f, 0x7f, 0xa8,
-	0x9f, 0xf9, 0xee, 0x87, 0xfa, 0x99, 0xcf, 0x6f, 0xed, 0x7b, 0xb1, 0x0c, 0xb6, 0x77, 0xfc, 0x5f,
-	0xb5, 0xef, 0x4a, 0xaf, 0x7b, 0x33, 0x18, 0x82, 0x6f, 0xfe, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xcc,
-	0x5f, 0x50, 0xe1, 0x8e, 0x2b, 0x00, 0x00,
+	// 3272 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdd, 0x1a, 0x4d, 0x73, 0xdb, 0xc6,
+	0x35, 0xb4, 0x3e, 0x48, 0x2e, 0x29, 0x0a, 0x84, 0xbe, 0x20, 0x59, 0x96, 0x6c, 0x26, 0x76, 0xec,
+	0xd8, 0xa6, 0x6c, 0xd9, 0x89, 0x13, 0xbb, 0x6d, 0xc6, 0x92, 0xed, 0x44, 0x9e, 0xc4, 0x56, 0x20,
+	0x25, 0xce, 0xa4, 0xd3, 0xe1, 0x40, 0xe4, 0x52, 0x42, 0x05, 0x12, 0x0c, 0x00, 0x4a, 0x56, 0xa7,
+	0x87, 0x4c, 0xa7, 0xd3, 0x5c, 0x73, 0xec, 0x4c, 0x4f, 0xbd, 0xe5, 0xdc, 0x99, 0xfe, 0x80, 0x4e,
+	0x2f, 0x3d, 0xe6, 0x98, 0x43, 0xa7, 0x6d, 0xd2, 0x4b, 0x2f, 0x9d, 0xf6, 0x27, 0xf4, 0xed, 0xdb,
+	0x5d, 0x60, 0x01, 0x42, 0x34, 0xe4, 0xc4, 0x87, 0xf4, 0x20, 0x8a, 0xd8, 0xf7, 0xb1, 0x6f, 0xdf,
+	0xbe, 0x6f, 0x90, 0xdc, 0x08, 0x68, 0xa7, 0xe7, 0x7a, 0x96, 0xb3, 0xe2, 0x53, 0xef, 0x80, 0x7a,
+	0x2b, 0x56, 0xcf, 0x5e, 0xe9, 0x51, 0xcf, 0xb7, 0xfd, 0x80, 0x76, 0x9b, 0x74, 0xe5, 0xe0, 0xfa,
+	0x0a, 0x7d, 0x4a, 0x9b, 0xfd, 0xc0, 0x76, 0xbb, 0x7e, 0xbd, 0xe7, 0xb9, 0x81, 0xab, 0xd7, 0x24,
+	0x51, 0x9d, 0x13, 0xd5, 0x81, 0xa8, 0xae, 0x10, 0xd5, 0x0f, 0xae, 0x2f, 0x2c, 0xed, 0xba, 0xee,
+	0xae, 0x43, 0x57, 0x90, 0x62, 0xa7, 0xdf, 0x5e, 0x69, 0xf5, 0x3d, 0x8b, 0x31, 0xe1, 0x3c, 0x16,
+	0x96, 0x93, 0xf0, 0xc0, 0xee, 0x50, 0x3f, 0xb0, 0x3a, 0x3d, 0x81, 0x30, 0xc0, 0xe0, 0xd0, 0xb3,
+	0x7a, 0x6c, 0x13, 0x01, 0x3f, 0xd7, 0xa2, 0x3d, 0xda, 0x6d, 0xc1, 0x7e, 0x36, 0xf5, 0x57, 0x76,
+	0xdd, 0x5d, 0x17, 0xd7, 0xf1, 0x9b, 0x40, 0x79, 0x25, 0x3c, 0x1c, 0x3b, 0x55, 0xd3, 0xed, 0x74,
+	0xdc, 0x2e, 0x3b, 0x10, 0x6c, 0xe4, 0x5b, 0xbb, 0x34, 0x15, 0x8b, 0x76, 0xfb, 0x1d, 0x9f, 0x21,
+	0x1d, 0xba, 0xde, 0x7e, 0xdb, 0x71, 0x0f, 0x05, 0xd6, 0xf9, 0x18, 0x56, 0xdb, 0xb2, 0x9d, 0xbe,
+	0x47, 0x07, 0x99, 0xc5, 0xd1, 0xf6, 0x40, 0x21, 0xae, 0x77, 0x34, 0x88, 0x76, 0x21, 0x86, 0x26,
+	0xb7, 0x1a, 0xc4, 0xbb, 0x94, 0x76, 0x3d, 0xa1, 0x88, 0xfc, 0x44, 0x02, 0xf5, 0xf2, 0x50, 0xd4,
+	0xc4, 0x69, 0x5e, 0x1d, 0x8a, 0x1c, 0x58, 0xfe, 0xbe, 0x40, 0xbc, 0x92, 0x86, 0x78, 0xec, 0xb1,
+	0xae, 0xa6, 0x61, 0x1f, 0x7b, 0xba, 0xda, 0xdf, 0x08, 0x29, 0x6e, 0xed, 0x59, 0x5e, 0x6b, 0xa3,
+	0xdb, 0x76, 0xf5, 0x79, 0x52, 0xf0, 0xd9, 0x43, 0xc3, 0x6e, 0x19, 0xb9, 0xb3, 0xb9, 0x8b, 0x63,
+	0x66, 0x1e, 0x9f, 0x37, 0x5a, 0x0c, 0xe4, 0x59, 0xdd, 0x5d, 0xca, 0x40, 0xa7, 0x00, 0x34, 0x62,
+	0xe6, 0xf1, 0x19, 0x40, 0xd3, 0x64, 0xcc, 0x3d, 0xec, 0x52, 0xcf, 0x18, 0x81, 0xf5, 0xa2, 0xc9,
+	0x1f, 0xf4, 0x55, 0x32, 0xe3, 0xd1, 0x9e, 0x63, 0x37, 0xd1, 0xe4, 0x1a, 0x56, 0x73, 0xbf, 0xe1,
+	0xd0, 0x03, 0xea, 0x18, 0xa3, 0x48, 0x3d, 0xa5, 0x00, 0xef, 0x36, 0xf7, 0xdf, 0x63, 0x20, 0xfd,
+	0x0a, 0xd1, 0x03, 0xe0, 0xea, 0xb7, 0xa9, 0xa7, 0x10, 0x8c, 0x21, 0x81, 0x26, 0x21, 0x2a, 0x36,
+	0x28, 0xc1, 0xa1, 0xdd, 0x86, 0x6f, 0x83, 0xc9, 0x37, 0x3c, 0xda, 0xa5, 0x87, 0xc6, 0x38, 0xca,
+	0xad, 0x71, 0xc8, 0x16, 0x03, 0x98, 0x6c, 0x5d, 0xbf, 0x4b, 0x4a, 0xfd, 0x5e, 0xcb, 0x0a, 0x68,
+	0x83, 0x99, 0xb9, 0x91, 0x07, 0xb4, 0xd2, 0xea, 0x42, 0x9d, 0x9b, 0x78, 0x5d, 0x9a, 0x78, 0x7d,
+	0x5b, 0xfa, 0xc0, 0xda, 0xe8, 0x17, 0x7f, 0x5f, 0xce, 0x99, 0x84, 0x13, 0xb1, 0x65, 0xfd, 0x03,
+	0x32, 0xcd, 0x68, 0x15, 0xd9, 0x38, 0xaf, 0x42, 0x46, 0x5e, 0x55, 0xa4, 0x96, 0xf2, 0x23, 0xcb,
+	0x7b, 0x64, 0xa9, 0x6b, 0x01, 0x56, 0xcf, 0x82, 0x03, 0x74, 0xdd, 0xc0, 0x6e, 0x4b, 0x85, 0x1d,
+	0x30, 0x67, 0x76, 0xbb, 0x46, 0x11, 0x4f, 0xbf, 0x18, 0x62, 0x3d, 0x52, 0x90, 0x3e, 0xe2, 0x38,
+	0xfa, 0xe7, 0x39, 0xb2, 0xd0, 0x74, 0xfa, 0xe0, 0xfa, 0x5e, 0x23, 0x45, 0x81, 0xe4, 0xec, 0x08,
+	0xc8, 0xf7, 0xb0, 0xfe, 0xec, 0x98, 0x51, 0x0f, 0x6d, 0xa1, 0xbe, 0xce, 0xf9, 0x6d, 0x27, 0xb4,
+	0x7e, 0xbf, 0x1b, 0x78, 0x47, 0xe6, 0x5c, 0x33, 0x1d, 0xaa, 0xff, 0x3a, 0x47, 0xe6, 0x42, 0x49,
+	0xe2, 0xba, 0x32, 0x4a, 0x28, 0xc6, 0x3b, 0xcf, 0x27, 0x86, 0xaa, 0x39, 0x94, 0x41, 0xe8, 0x74,
+	0xba, 0x99, 0x82, 0xa0, 0xff, 0x26, 0x47, 0xe6, 0xa5, 0x18, 0xaa, 0x15, 0x72, 0x41, 0xca, 0xdf,
+	0x41, 0x1f, 0x66, 0xc4, 0x2d, 0x45, 0x1f, 0x49, 0x28, 0xd3, 0xc7, 0xbc, 0x2a, 0x40, 0xcb, 0xf9,
+	0x54, 0xd1, 0xc8, 0x04, 0x0a, 0xb2, 0x71, 0x32, 0x41, 0x94, 0x3d, 0xee, 0x39, 0x9f, 0xc6, 0xef,
+	0x65, 0xd6, 0x4b, 0x05, 0xea, 0xd7, 0xc8, 0xf4, 0x81, 0xed, 0xdb, 0x3b, 0xb6, 0x63, 0x07, 0x47,
+	0x8a, 0x00, 0x15, 0x34, 0x2e, 0x3d, 0x82, 0x49, 0x8a, 0x85, 0x87, 0x64, 0x71, 0x98, 0x05, 0xe8,
+	0x1a, 0x19, 0xd9, 0xa7, 0x47, 0x18, 0x25, 0x8a, 0x26, 0xfb, 0xca, 0xc2, 0xc0, 0x81, 0xe5, 0xf4,
+	0xa9, 0x08, 0x0f, 0xfc, 0xe1, 0xf6, 0xa9, 0x37, 0x73, 0x0b, 0x4d, 0x32, 0x7f, 0xec, 0x35, 0xa6,
+	0x30, 0xba, 0xa6, 0x32, 0x1a, 0xea, 0x57, 0xea, 0x26, 0x91, 0xc0, 0xa9, 0x57, 0x74, 0x22, 0x81,
+	0x37, 0xc8, 0xe9, 0x21, 0x5a, 0x3e, 0x09, 0xab, 0xda, 0xaf, 0x96, 0xc9, 0xcc, 0x13, 0x11, 0x7e,
+	0xef, 0xcb, 0x2c, 0x8e, 0xc1, 0xf6, 0x1c, 0x29, 0x47, 0xae, 0x2f, 0x02, 0x6e, 0xd1, 0x2c, 0x85,
+	0x6b, 0x10, 0x59, 0x97, 0x49, 0x49, 0x86, 0x6e, 0x19, 0x77, 0x8b, 0x26, 0x91, 0x4b, 0x80, 0x50,
+	0x27, 0x53, 0x3d, 0x0b, 0xe2, 0x5e, 0xd0, 0x88, 0xb1, 0xe2, 0x81, 0xb8, 0xca, 0x41, 0x8f, 0x14,
+	0x86, 0x10, 0x32, 0x05, 0xbe, 0xca, 0x77, 0x14, 0xd1, 0x35, 0x0e, 0x79, 0x12, 0x71, 0xaf, 0x91,
+	0x09, 0x81, 0xed, 0xf5, 0xbb, 0x0c, 0x71, 0x8c, 0x8b, 0xc8, 0x17, 0xcd, 0x7e, 0x17, 0x70, 0xe0,
+	0x14, 0x76, 0xd7, 0x0e, 0x6c, 0x88, 0x91, 0x98, 0x36, 0xc6, 0x51, 0x01, 0xa5, 0x70, 0x0d, 0x50,
+	0xde, 0x02, 0x5f, 0x74, 0x3b, 0x3d, 0x87, 0xa2, 0x07, 0x80, 0x1a, 0x81, 0xe1, 0x8e, 0x15, 0x34,
+	0xf7, 0x18, 0x7e, 0x1e, 0xf1, 0x67, 0x23, 0x84, 0xfb, 0x0c, 0xbe, 0xc6, 0xc0, 0x40, 0xba, 0x49,
+	0xb4, 0x24, 0xa9, 0x88, 0xb6, 0xe7, 0x23, 0xa7, 0x61, 0xde, 0x22, 0xf2, 0x21, 0xf3, 0x94, 0x77,
+	0xf9, 0x57, 0xe4, 0x63, 0x4e, 0x26, 0x18, 0xeb, 0x67, 0x08, 0x61, 0xb9, 0xb5, 0xf1, 0x69, 0x9f,
+	0xc2, 0x75, 0x15, 0xf1, 0x40, 0x45, 0xb6, 0xf2, 0x01, 0x5b, 0x60, 0x0a, 0x0a, 0x35, 0x13, 0x1c,
+	0xf5, 0x28, 0xea, 0x15, 0x02, 0x28, 0x2a, 0x48, 0x42, 0xb6, 0x01, 0xc0, 0xb4, 0xaa, 0xff, 0x8c,
+	0x2c, 0x84, 0xd8, 0x61, 0x89, 0x86, 0x71, 0xcf, 0xed, 0x07, 0x10, 0xef, 0x98, 0xa0, 0xf3, 0x03,
+	0xe6, 0x7b, 0x4f, 0x94, 0x61, 0x6b, 0xa3, 0xbf, 0x65, 0x11, 0xcc, 0x38, 0x4c, 0x9a, 0xc7, 0x36,
+	0x67, 0xc0, 0xf2, 0x4d, 0xc8, 0x9e, 0xdd, 0x80, 0x64, 0x5c, 0xce, 0xc6, 0x38, 0x3c, 0x09, 0xdc,
+	0x94, 0x64, 0xb9, 0x43, 0xce, 0xb4, 0x68, 0xdb, 0xea, 0x3b, 0x8a, 0x05, 0xa0, 0x3e, 0x24, 0xef,
+	0x89, 0x6c, 0xbc, 0x17, 0x04, 0x17, 0x69, 0x2d, 0xdb, 0xc0, 0x43, 0xee, 0xf1, 0x32, 0x99, 0x00,
+	0xef, 0xf4, 0x82, 0x30, 0x85, 0xf1, 0x28, 0x53, 0xc6, 0x45, 0x99, 0xb2, 0x2e, 0x13, 0xdd, 0xb1,
+	0xfc, 0x40, 0x98, 0x03, 0x8a, 0x00, 0xd6, 0x50, 0x45, 0xcc, 0x49, 0x06, 0xc1, 0xeb, 0x62, 0x6c,
+	0xc1, 0x0c, 0xae, 0x92, 0x29, 0x44, 0x6e, 0xdb, 0x5e, 0x48, 0x02, 0xd8, 0x3a, 0x2f, 0x0c, 0x18,
+	0xe8, 0x01, 0x83, 0x20, 0x09, 0xa0, 0x43, 0xb4, 0x43, 0x74, 0x10, 0xbe, 0x09, 0xd5, 0x0e, 0x18,
+	0x26, 0xb7, 0x9c, 0x29, 0x1e, 0xed, 0x18, 0x6c, 0x53, 0x82, 0xb8, 0x55, 0xbc, 0x4d, 0x08, 0x17,
+	0x19, 0xf3, 0xf9, 0x74, 0xc6, 0x7c, 0x5e, 0x44, 0x1a, 0xcc, 0xe3, 0x0f, 0x09, 0x8a, 0xd1, 0x50,
+	0x4b, 0x8c, 0x99, 0x8c, 0x6c, 0x2a, 0x8c, 0xf2, 0xc3, 0xa8, 0xcc, 0x80, 0xca, 0x29, 0x7e, 0x37,
+	0x52, 0x8f, 0xb3, 0xbc, 0x72, 0x3a, 0x54, 0x74, 0x2e, 0xd5, 0x09, 0x3e, 0x16, 0xa7, 0xf1, 0x9b,
+	0x7b, 0xb4, 0xd5, 0x77, 0x30, 0x1c, 0xcc, 0x71, 0x1f, 0x53, 0xe9, 0xb6, 0x04, 0x18, 0xb4, 0x75,
+	0x8b, 0x18, 0x09, 0x52, 0x76, 0x2a, 0xee, 0xcd, 0x06, 0x52, 0xce, 0xc4, 0x28, 0x39, 0x14, 0x08,
+	0xb7, 0x92, 0x72, 0x4a, 0x1b, 0x9a, 0xcf, 0x66, 0x43, 0xb1, 0x83, 0x48, 0xe3, 0x19, 0x38, 0xbc,
+	0x15, 0x30, 0x47, 0x0f, 0x8c, 0x05, 0xac, 0xeb, 0x62, 0x34, 0x77, 0x39, 0x28, 0xe6, 0x86, 0xb1,
+	0x13, 0xe0, 0x35, 0x9c, 0xce, 0x78, 0x0d, 0x73, 0x29, 0xa7, 0xc4, 0xfb, 0xb0, 0xc8, 0x62, 0xba,
+	0x6e, 0xc5, 0x06, 0x8b, 0x19, 0x37, 0x98, 0x4f, 0xbb, 0x00, 0xbe, 0xc5, 0x25, 0x88, 0x73, 0x16,
+	0x64, 0x7a, 0x07, 0xaa, 0x15, 0x88, 0x4c, 0x90, 0xc5, 0x5a, 0xc6, 0x19, 0x60, 0x5b, 0x80, 0x00,
+	0x86, 0xeb, 0xa6, 0x5c, 0xd6, 0x3d, 0x72, 0x3e, 0x2e, 0x8d, 0xeb, 0xd9, 0xbb, 0x76, 0xd7, 0x72,
+	0x92, 0x62, 0x2d, 0x65, 0x14, 0xeb, 0x9c, 0x2a, 0xd6, 0x63, 0xc1, 0x2c, 0x2e, 0xde, 0x80, 0x89,
+	0x08, 0x29, 0x99, 0x89, 0x2c, 0x63, 0x6c, 0x8c, 0x99, 0x88, 0x10, 0x16, 0x4c, 0xe4, 0x35, 0x52,
+	0x8d, 0x9f, 0x8b, 0x51, 0x9c, 0x45, 0x8a, 0xf8, 0xc1, 0x38, 0xae, 0x1f, 0xd8, 0xcd, 0xfd, 0xa3,
+	0x86, 0x12, 0xa0, 0xcf, 0x71, 0x5c, 0x0e, 0xd8, 0x0e, 0xc3, 0xf4, 0x2e, 0x39, 0x2b, 0x70, 0x43,
+	0x3b, 0x0f, 0xdc, 0x46, 0xe4, 0xc2, 0xcc, 0x0a, 0x6b, 0xd9, 0xac, 0x70, 0x91, 0x33, 0x92, 0x07,
+	0xde, 0x76, 0xb7, 0xa4, 0x53, 0x33, 0x73, 0x34, 0x48, 0x5e, 0x1a, 0xe0, 0xcb, 0xbc, 0x21, 0x12,
+	0x8f, 0xfa, 0x87, 0x04, 0x8a, 0x2d, 0xa8, 0x06, 0x1a, 0x3c, 0xd5, 0x39, 0xf0, 0x1f, 0x6a, 0x0f,
+	0x48, 0xfc, 0xc6, 0x2b, 0xd9, 0x36, 0x9e, 0x46, 0xf2, 0x0d, 0x4e, 0xbd, 0x21, 0x88, 0x23, 0xb6,
+	0x1d, 0xeb, 0xa9, 0xdd, 0xe9, 0x77, 0x22, 0xb6, 0xe7, 0x4f, 0xc2, 0xf6, 0x7d, 0x4e, 0x1d, 0xb2,
+	0xbd, 0x99, 0x64, 0x2b, 0x8e, 0xe1, 0x1b, 0x17, 0xf0, 0x58, 0x31, 0x2a, 0xe1, 0x57, 0xbe, 0x7e,
+	0x9b, 0x15, 0xaf, 0x8c, 0x6a, 0x07, 0x4a, 0x46, 0xb7, 0xdd, 0x6e, 0x34, 0x5d, 0xda, 0x86, 0xe6,
+	0xc3, 0x66, 0xd1, 0xf4, 0x55, 0x20, 0x04, 0xaf, 0x41, 0x84, 0x35, 0x0e, 0x5f, 0x8f, 0xc0, 0x7a,
+	0x87, 0xd4, 0x52, 0x72, 0x23, 0x7d, 0xda, 0xb3, 0xb9, 0xb8, 0xdc, 0x48, 0x2f, 0x66, 0x34, 0xd2,
+	0xe5, 0x81, 0x24, 0x79, 0x3f, 0xe4, 0x24, 0x1a, 0xa9, 0x65, 0x2e, 0x6a, 0x17, 0x58, 0xe3, 0x37,
+	0x6b, 0x07, 0xac, 0x82, 0x7a, 0x9e, 0xeb, 0x61, 0x26, 0xf7, 0x8d, 0x4b, 0x50, 0x6d, 0x17, 0xcd,
+	0xd3, 0x08, 0x7c, 0xe4, 0x76, 0x4d, 0x89, 0x74, 0x9f, 0xe1, 0xb0, 0x9c, 0xee, 0xeb, 0x17, 0x89,
+	0xb6, 0x67, 0xf9, 0x9c, 0xbe, 0xd1, 0x73, 0xa1, 0x02, 0x3c, 0x32, 0x5e, 0x43, 0x3f, 0xac, 0xc0,
+	0x3a, 0x52, 0x6c, 0xe2, 0x2a, 0x4b, 0x72, 0x4d, 0x0f, 0xb6, 0x92, 0xf6, 0x67, 0x5c, 0x46, 0x4b,
+	0x2d, 0xb3, 0x45, 0x69, 0x4b, 0xac, 0x38, 0xf2, 0xed, 0x5d, 0xe6, 0x9b, 0x4d, 0xb7, 0x0f, 0x2a,
+	0xab, 0xf3, 0xe2, 0x88, 0xaf, 0xad, 0xb3, 0x25, 0xfd, 0x3c, 0x29, 0x8b, 0xda, 0x05, 0xba, 0xd8,
+	0x5f, 0x50, 0x63, 0x85, 0xa1, 0xac, 0x9d, 0x32, 0x72, 0x66, 0x49, 0xac, 0x6f, 0xc1, 0x32, 0x94,
+	0x02, 0x55, 0xab, 0x0f, 0x26, 0xee, 0x51, 0x9f, 0x42, 0x62, 0x73, 0xc1, 0x2a, 0x7c, 0xe3, 0x46,
+	0x5a, 0x25, 0x14, 0x8e, 0x19, 0xa0, 0x14, 0x32, 0x19, 0xf6, 0x26, 0x22, 0x9b, 0x93, 0x8c, 0x5e,
+	0x59, 0xd0, 0x7f, 0x09, 0xfe, 0x46, 0x2d, 0x0f, 0xca, 0x30, 0xb0, 0x05, 0xcf, 0xde, 0xe9, 0x07,
+	0xa0, 0xa3, 0x9b, 0xd8, 0x91, 0x3c, 0xce, 0xd2, 0x91, 0xa4, 0x56, 0xb5, 0xf5, 0x2d, 0x64, 0x79,
+	0x37, 0xe4, 0xc8, 0xfb, 0x12, 0xcd, 0x4f, 0x2c, 0xeb, 0x4f, 0xc8, 0x68, 0x87, 0x76, 0x5c, 0xe3,
+	0x75, 0xdc, 0x70, 0xfd, 0xf9, 0x37, 0x7c, 0x1f, 0xb8, 0xf0, 0x4d, 0x90, 0x21, 0x24, 0x83, 0xaa,
+	0xc8, 0x97, 0x0d, 0xae, 0x40, 0x1b, 0x8e, 0xf5, 0x06, 0x6a, 0xea, 0x5a, 0xea, 0x2e, 0x4a, 0xe9,
+	0x28, 0xb2, 0xe9, 0xbb, 0x92, 0xce, 0xd4, 0x0e, 0x12, 0x2b, 0xfa, 0x0d, 0x32, 0x2b, 0xaa, 0x90,
+	0xd0, 0xa6, 0x45, 0x71, 0x7c, 0x0b, 0x0d, 0x60, 0x0a, 0xa1, 0xa1, 0x88, 0xbc, 0x48, 0xfe, 0x29,
+	0x99, 0x8c, 0xd0, 0xc1, 0xac, 0xe1, 0xee, 0xde, 0x44, 0x89, 0x56, 0xb3, 0x9c, 0x3b, 0x64, 0xb6,
+	0xc5, 0x28, 0xcd, 0x0a, 0x8d, 0x3d, 0xc7, 0xd2, 0x13, 0x13, 0x25, 0xe9, 0x62, 0x6f, 0x9d, 0x34,
+	0x3d, 0x81, 0xcc, 0x09, 0xe7, 0x82, 0xcb, 0x0a, 0xac, 0x5d, 0xdf, 0xb8, 0xfd, 0x5d, 0x2f, 0x6b,
+	0x1b, 0xb8, 0x88, 0xcb, 0x62, 0x0c, 0xf5, 0x75, 0xb2, 0x74, 0x5c, 0xed, 0x01, 0x31, 0x04, 0xfa,
+	0x51, 0xe3, 0x0e, 0x6a, 0xf5, 0x74, 0x6a, 0x05, 0xc2, 0x51, 0xc0, 0x37, 0x48, 0xcf, 0xea, 0xfb,
+	0x50, 0xea, 0xc0, 0x16, 0xc6, 0x8f, 0x86, 0x28, 0x56, 0xf5, 0x0d, 0x29, 0xe0, 0x26, 0x23, 0x65,
+	0xc2, 0x99, 0xc5, 0x9e, 0xfc, 0xca, 0xfb, 0x2a, 0xc6, 0x92, 0x5d, 0x16, 0x0d, 0x0b, 0xb0, 0x1f,
+	0xa3, 0xff, 0x56, 0x11, 0xc4, 0x94, 0x4f, 0x65, 0xf9, 0xf5, 0x84, 0xcc, 0xa9, 0xf8, 0x6a, 0x15,
+	0xf8, 0x93, 0x8c, 0xea, 0x9f, 0x8e, 0xb8, 0x2a, 0xb5, 0x20, 0x44, 0x10, 0xa6, 0xa8, 0x50, 0x82,
+	0xb7, 0x79, 0x04, 0x61, 0x6b, 0x62, 0xef, 0x85, 0x16, 0x99, 0x49, 0x75, 0xba, 0x94, 0x36, 0xf5,
+	0xf5, 0x78, 0x67, 0xbd, 0x1c, 0x8f, 0x1c, 0x62, 0x96, 0x09, 0xba, 0xd9, 0xb4, 0x8e, 0x1c, 0xd7,
+	0x6a, 0xa9, 0x2d, 0xf1, 0xc7, 0xa4, 0x18, 0x7a, 0xda, 0xf7, 0xcb, 0xf9, 0x16, 0x29, 0x86, 0x66,
+	0xf1, 0xac, 0xd6, 0xba, 0xa8, 0x10, 0x3e, 0x1c, 0x2d, 0x4c, 0x6a, 0x1a, 0x7c, 0x6a, 0x5a, 0x15,
+	0x3e, 0xaf, 0x68, 0x57, 0xe1, 0xf3, 0xaa, 0x56, 0x87, 0xcf, 0x6b, 0xda, 0x75, 0xf8, 0xbc, 0xae,
+	0xad, 0xc2, 0xe7, 0xaa, 0x76, 0xa3, 0x76, 0x83, 0x54, 0xe2, 0x4e, 0xc4, 0xf4, 0x1a, 0x0b, 0xbb,
+	0x39, 0xae, 0x57, 0x25, 0xe4, 0xd6, 0xfe, 0x93, 0x23, 0xb3, 0x03, 0x56, 0x8c, 0xf7, 0x83, 0x65,
+	0x8d, 0x47, 0xd9, 0x15, 0x2b, 0x65, 0x4d, 0x4e, 0x94, 0x35, 0x08, 0x88, 0xca, 0x9a, 0x19, 0x32,
+	0x2e, 0x02, 0x84, 0x38, 0x80, 0x87, 0x21, 0xe1, 0x21, 0x19, 0x43, 0x5b, 0xc1, 0x5e, 0xbd, 0xb2,
+	0x7a, 0x33, 0xd5, 0x5e, 0x71, 0x1c, 0x9c, 0xea, 0x4d, 0x28, 0x87, 0xc9, 0x59, 0xe8, 0x0f, 0xc8,
+	0x38, 0xfb, 0xd2, 0xf7, 0xb1, 0x93, 0xaf, 0xac, 0xd6, 0xe3, 0xda, 0x1f, 0xce, 0xa5, 0xef, 0x9b,
+	0x82, 0xba, 0xf6, 0xd7, 0x51, 0xa2, 0xc9, 0x69, 0x0f, 0x76, 0x5e, 0xdf, 0xd7, 0x98, 0x22, 0xd2,
+	0xc1, 0x88, 0xaa, 0x83, 0x75, 0x52, 0xe4, 0x7d, 0x03, 0xe4, 0x5e, 0x21, 0xfa, 0x85, 0xe1, 0x7a,
+	0xc0, 0x4e, 0x01, 0xb0, 0xcd, 0x42, 0x20, 0xbe, 0x31, 0x57, 0x85, 0x78, 0xb0, 0x4b, 0x13, 0x23,
+	0x10, 0x3e, 0xaa, 0xa8, 0x72, 0x50, 0x62, 0x04, 0x22, 0xf0, 0x55, 0x99, 0xc7, 0x79, 0x87, 0xcf,
+	0x21, 0xf1, 0x11, 0x88, 0xc0, 0x16, 0x07, 0xc8, 0xf3, 0xe3, 0xf3, 0x45, 0x1e, 0xdd, 0xe3, 0x23,
+	0x85, 0x42, 0x72, 0xa4, 0x70, 0x87, 0x2c, 0x08, 0x16, 0xcd, 0x3d, 0xdb, 0x69, 0x45, 0xdb, 0xba,
+	0x5d, 0xe7, 0x08, 0x27, 0x10, 0x05, 0x73, 0x8e, 0x63, 0xac, 0x33, 0x04, 0xb9, 0xfb, 0x63, 0x00,
+	0x33, 0xd5, 0xaa, 0x9d, 0x1c, 0x41, 0x33, 0x25, 0x7e, 0xd4, 0xbd, 0x41, 0x81, 0x2a, 0x63, 0x43,
+	0x89, 0x8f, 0xe5, 0xc5, 0xa3, 0x3e, 0x47, 0xf2, 0xb2, 0xad, 0x2e, 0x23, 0x64, 0x3c, 0xe0, 0xdd,
+	0xf4, 0x06, 0x99, 0x54, 0x86, 0x81, 0x18, 0xa4, 0x26, 0xb2, 0xb6, 0xaa, 0x11, 0x21, 0x86, 0xa7,
+	0xcb, 0xa4, 0xea, 0xd1, 0xa6, 0xeb, 0xb5, 0x1a, 0x11, 0x00, 0xdb, 0xfd, 0x82, 0xa9, 0x71, 0xc0,
+	0x47, 0xe1, 0x7a, 0xed, 0x4f, 0x23, 0x64, 0x4a, 0x19, 0xab, 0xfd, 0x60, 0x2c, 0x4c, 0x51, 0xf1,
+	0x58, 0x5c, 0xc5, 0xaf, 0x90, 0x4a, 0x62, 0x24, 0xc1, 0xc7, 0x5f, 0xe5, 0xb6, 0x3a, 0x8e, 0x00,
+	0x1b, 0xea, 0xd2, 0xa7, 0x0a, 0x12, 0x9f, 0x79, 0x95, 0xd8, 0xa2, 0xc4, 0x61, 0x95, 0x62, 0xd8,
+	0xbe, 0x01, 0x4a, 0x41, 0x54, 0x8a, 0x72, 0x8d, 0xa3, 0xec, 0x80, 0x73, 0x42, 0xbd, 0x16, 0xb8,
+	0xfb, 0x94, 0x5f, 0x77, 0xd9, 0x2c, 0xf1, 0xb5, 0x6d, 0xb6, 0xa4, 0xaf, 0x90, 0xe9, 0x2e, 0xe5,
+	0x55, 0x40, 0x0c, 0x75, 0x02, 0x51, 0xab, 0x00, 0x03, 0x8b, 0x5d, 0x53, 0x08, 0x14, 0x1b, 0x99,
+	0x54, 0x6d, 0x04, 0xe2, 0x66, 0x51, 0x23, 0xf0, 0x49, 0xb4, 0x12, 0x7c, 0x96, 0xb5, 0x09, 0xf8,
+	0xac, 0x68, 0x93, 0xb5, 0x3f, 0x9c, 0x22, 0x7a, 0x74, 0xa5, 0xff, 0x07, 0x57, 0xa8, 0x68, 0x60,
+	0xfc, 0x59, 0x5e, 0x92, 0x7f, 0x3e, 0x2f, 0xa9, 0xfd, 0x7e, 0x94, 0x4c, 0xe0, 0xe4, 0xfb, 0x07,
+	0xa3, 0xaf, 0xfb, 0x50, 0x76, 0xf0, 0x0e, 0x98, 0xf3, 0x19, 0x43, 0x3e, 0xb5, 0x63, 0xf2, 0x8a,
+	0x68, 0x96, 0x91, 0x47, 0x29, 0x88, 0x1e, 0x74, 0xaa, 0x0c, 0x73, 0x64, 0x0b, 0x89, 0xfc, 0xc6,
+	0x91, 0xdf, 0xf5, 0x6c, 0x49, 0x4f, 0x34, 0x97, 0xc8, 0x3e, 0x9c, 0xff, 0x28, 0x8b, 0xea, 0xed,
+	0xe6, 0xe3, 0xb7, 0x7b, 0x89, 0x68, 0x61, 0xf8, 0x94, 0x7d, 0x7c, 0x01, 0x1b, 0xde, 0x49, 0xb9,
+	0x2e, 0x87, 0x48, 0xf3, 0xa4, 0x10, 0x3a, 0x28, 0x7f, 0xe7, 0x96, 0xa7, 0xc2, 0x39, 0x15, 0x1b,
+	0x21, 0xcf, 0xb2, 0x91, 0xd2, 0x73, 0xda, 0xc8, 0xef, 0x26, 0x49, 0xf9, 0x6e, 0x33, 0xb0, 0x0f,
+	0x60, 0x01, 0x4d, 0x44, 0x39, 0x54, 0x2e, 0x7e, 0xa8, 0x5b, 0xc4, 0x88, 0x62, 0x45, 0x62, 0x9c,
+	0xce, 0xdf, 0x3f, 0xcc, 0x84, 0xf0, 0xd8, 0x34, 0xfd, 0x11, 0x99, 0x4c, 0x10, 0xa2, 0xe9, 0x64,
+	0x1e, 0xa6, 0x57, 0xe2, 0x6c, 0xf5, 0x77, 0x48, 0x25, 0x31, 0x73, 0x1a, 0xcd, 0x78, 0xfa, 0x09,
+	0x3f, 0x36, 0x5f, 0x3a, 0x23, 0xc6, 0xaf, 0x3c, 0xf6, 0x71, 0x0f, 0x2d, 0xfa, 0xe1, 0xa0, 0xf1,
+	0xa1, 0x18, 0x28, 0x87, 0x52, 0x8f, 0x9f, 0x44, 0xea, 0xb2, 0xa0, 0xe5, 0x32, 0xaf, 0x93, 0x72,
+	0x6c, 0x3a, 0x98, 0xd5, 0xa7, 0x4b, 0xbe, 0x32, 0x11, 0x04, 0xdf, 0xb4, 0xc4, 0x5d, 0xc9, 0x60,
+	0x0d, 0xbe, 0x29, 0x97, 0x78, 0x49, 0xa0, 0x54, 0x86, 0xe2, 0x2d, 0x83, 0x17, 0xd6, 0x84, 0x9f,
+	0x90, 0xf9, 0xe3, 0xe7, 0x56, 0x24, 0xdb, 0x9c, 0x67, 0xd6, 0x4f, 0x9f, 0x58, 0x25, 0x78, 0x37,
+	0x1d, 0xd7, 0xa7, 0x27, 0x7d, 0x25, 0xa1, 0xf0, 0x5e, 0x67, 0xf4, 0x92, 0xf7, 0x36, 0x99, 0x15,
+	0xb2, 0x26, 0x19, 0x67, 0x7c, 0x25, 0x31, 0xc5, 0x27, 0xe6, 0x71, 0xae, 0xef, 0x91, 0xea, 0x1e,
+	0x34, 0x30, 0xc1, 0x0e, 0x14, 0xce, 0x27, 0x7d, 0x0f, 0xa1, 0x85, 0x94, 0x92, 0x5b, 0xda, 0x28,
+	0xb5, 0x92, 0x3e, 0x4a, 0x4d, 0x9d, 0x4e, 0xf2, 0x3c, 0x98, 0x36, 0x9d, 0xe4, 0xef, 0xb3, 0x65,
+	0x9b, 0xca, 0xca, 0x6d, 0x8d, 0x87, 0x92, 0x40, 0xc6, 0x76, 0x5e, 0x4f, 0xab, 0x43, 0xc3, 0x6a,
+	0x7c, 0x68, 0x18, 0x2f, 0x15, 0xf5, 0x64, 0xa9, 0xc8, 0xc2, 0x55, 0xb2, 0x01, 0x9e, 0x92, 0x13,
+	0xd0, 0x78, 0xd3, 0x9b, 0x36, 0xa9, 0x9a, 0x4e, 0x9d, 0x54, 0x1d, 0x3f, 0xa8, 0x9c, 0x79, 0x31,
+	0x83, 0xca, 0xd9, 0x17, 0x33, 0xa8, 0x9c, 0x1b, 0x32, 0xa8, 0xdc, 0x66, 0x3f, 0x36, 0x61, 0x54,
+	0xc9, 0xe1, 0x87, 0x91, 0xd1, 0xbd, 0xa7, 0x90, 0x3c, 0x31, 0xf6, 0x18, 0x3a, 0xfe, 0x9c, 0x1f,
+	0x3e, 0xfe, 0xcc, 0x30, 0x8f, 0x5c, 0x78, 0xf6, 0x3c, 0xf2, 0x11, 0xd1, 0x39, 0x17, 0xfe, 0xfa,
+	0x8b, 0xff, 0xe4, 0x49, 0xbc, 0xd1, 0x38, 0x1b, 0x0f, 0x7f, 0x02, 0xc8, 0xc2, 0xdf, 0x03, 0xfe,
+	0x95, 0x95, 0xe0, 0x40, 0xfb, 0x1e, 0x7b, 0x3d, 0xc6, 0x57, 0x58, 0x2f, 0xa2, 0xf0, 0x63, 0xb9,
+	0x14, 0x2c, 0x3a, 0x34, 0xb5, 0x45, 0x34, 0xb5, 0xb9, 0x90, 0xea, 0x09, 0xc2, 0x43, 0x93, 0x4b,
+	0x16, 0x2d, 0x67, 0x52, 0x8b, 0x16, 0xb5, 0x5d, 0x59, 0x1a, 0x68, 0x57, 0x3e, 0x22, 0xb3, 0xb8,
+	0x75, 0xe4, 0xf0, 0x2d, 0x1a, 0x80, 0x70, 0x3e, 0xbe, 0x47, 0x18, 0x38, 0xd4, 0xc0, 0xe0, 0xc0,
+	0x37, 0xf1, 0xd5, 0xde, 0xbb, 0x92, 0xfc, 0x1e, 0xa7, 0x66, 0xaf, 0x80, 0x12, 0x7c, 0xd5, 0x19,
+	0xcc, 0xd9, 0xac, 0xaf, 0x80, 0x62, 0xbc, 0xa3, 0x31, 0x4c, 0xed, 0xcf, 0x39, 0x52, 0xc4, 0x0a,
+	0xee, 0x19, 0xa9, 0x39, 0x9e, 0xc8, 0x4e, 0x25, 0x13, 0xd9, 0x5d, 0x52, 0x42, 0x03, 0x15, 0xb5,
+	0xc2, 0x48, 0xd6, 0xdf, 0x20, 0x71, 0x22, 0x99, 0x7a, 0xd4, 0x08, 0xc4, 0x7f, 0x4c, 0x85, 0x41,
+	0x45, 0x04, 0x1f, 0xa8, 0x63, 0x78, 0xa0, 0x0a, 0x9b, 0xe0, 0x3c, 0x3e, 0x6f, 0xb4, 0x6a, 0xff,
+	0x1e, 0x25, 0x3a, 0xb6, 0x98, 0xf1, 0x1f, 0x22, 0x0c, 0xad, 0x34, 0xa2, 0x97, 0xfb, 0xe9, 0x95,
+	0x46, 0x08, 0x8f, 0x55, 0x1a, 0x71, 0x3d, 0x8c, 0x24, 0xf5, 0x00, 0x85, 0x48, 0x82, 0xaf, 0xa8,
+	0x1c, 0xb2, 0x16, 0x22, 0xf1, 0x5d, 0xd9, 0x0c, 0x40, 0x6e, 0xa7, 0xd6, 0xcc, 0x62, 0x06, 0x20,
+	0x40, 0x4a, 0x57, 0x0f, 0x7d, 0x9b, 0xc4, 0x17, 0x25, 0x34, 0xef, 0xff, 0x65, 0x69, 0x60, 0x8a,
+	0x11, 0x4d, 0xa2, 0xec, 0xc8, 0x3f, 0x7f, 0xd9, 0x91, 0x3a, 0x31, 0x2a, 0xa4, 0x4f, 0x8c, 0x16,
+	0x49, 0x31, 0xf4, 0x29, 0x59, 0x3b, 0x84, 0x0b, 0x27, 0xfc, 0x85, 0xc2, 0xc7, 0xe1, 0x0f, 0x44,
+	0x78, 0xbe, 0x16, 0x99, 0xa2, 0x84, 0xf5, 0xf7, 0xc5, 0x63, 0xea, 0xf9, 0x4d, 0xa4, 0xc0, 0x1c,
+	0xcd, 0x73, 0x88, 0xfc, 0x29, 0x89, 0xb2, 0x34, 0xf0, 0xc3, 0x8f, 0xf2, 0xc0, 0x0f, 0x3f, 0x6a,
+	0x7f, 0xcc, 0x91, 0xaa, 0x38, 0xd6, 0x3a, 0xa6, 0xd3, 0x17, 0x65, 0x6e, 0xa9, 0x89, 0x7c, 0x24,
+	0xfd, 0x35, 0x63, 0x52, 0xee, 0xd1, 0x41, 0xb9, 0x3f, 0x3f, 0x45, 0xc8, 0x16, 0xbe, 0xa3, 0x79,
+	0x81, 0xfe, 0x31, 0x20, 0xa9, 0x52, 0x1f, 0xea, 0x64, 0x14, 0x6f, 0x95, 0xff, 0x30, 0x07, 0xbf,
+	0xeb, 0x6f, 0x90, 0x31, 0xbb, 0xdb, 0x83, 0xca, 0x68, 0x2c, 0x63, 0xa0, 0xe4, 0xe8, 0x4c, 0xfa,
+	0xa6, 0xdb, 0x0d, 0x3c, 0xd7, 0x11, 0x46, 0x2e, 0x1f, 0x07, 0x34, 0x91, 0x1f, 0xd4, 0xc4, 0x67,
+	0x39, 0x52, 0x58, 0xdf, 0xa3, 0xcd, 0x7d, 0xbf, 0xdf, 0x49, 0xea, 0x61, 0x2c, 0xd2, 0xc3, 0x3d,
+	0x32, 0xde, 0x76, 0xac, 0x03, 0xd7, 0xc3, 0x53, 0x57, 0x56, 0xaf, 0x0c, 0x6f, 0xec, 0x24, 0xc7,
+	0x07, 0x48, 0x63, 0x0a, 0xda, 0x68, 0xd2, 0x3b, 0x82, 0xe3, 0x0a, 0xfe, 0xb0, 0xf6, 0xf3, 0xaf,
+	0xbe, 0x59, 0x7a, 0xe9, 0x6b, 0xf8, 0xfb, 0xef, 0x37, 0x4b, 0xb9, 0xcf, 0xbe, 0x5d, 0xca, 0x7d,
+	0x09, 0x7f, 0x7f, 0x81, 0xbf, 0xaf, 0xe0, 0xef, 0x1f, 0xf0, 0xf7, 0xaf, 0x6f, 0x01, 0x06, 0xff,
+	0xbf, 0xf8, 0xe7, 0xd2, 0x4b, 0x5f, 0xc1, 0xdf, 0xd7, 0xf0, 0xf7, 0xc9, 0xcd, 0x5d, 0x37, 0x92,
+	0xc1, 0x76, 0x8f, 0xff, 0x69, 0xf5, 0x1d, 0xe5, 0x71, 0x67, 0x1c, 0x43, 0xf0, 0x8d, 0xff, 0x01,
+	0xcf, 0xb4, 0x8a, 0x91, 0x93, 0x2d, 0x00, 0x00,
 }
 
 func (this *ShardInfo) Equal(that interface{}) bool {
@@ -2555,6 +2614,33 @@ func (this *WorkflowExecutionInfo) Equal(that interface{}) bool {
 	} else if !this.WorkflowRunExpirationTime.Equal(*that1.WorkflowRunExpirationTime) {
 		return false
 	}
+	if len(this.Tags) != len(that1.Tags) {
+		return false
+	}
+	for i := range this.Tags {
+		if this.Tags[i] != that1.Tags[i] {
+			return false
+		}
+	}
+	if this.WorkflowTaskStartedIdentity != that1.WorkflowTaskStartedIdentity {
+		return false
+	}
+	if !this.PauseInfo.Equal(that1.PauseInfo) {
+		return false
+	}
+	if this.PauseStateVersion != that1.PauseStateVersion {
+		return false
+	}
+	if that1.PauseStateUpdateTime == nil {
+		if this.PauseStateUpdateTime != nil {
+			return false
+		}
+	} else if !this.PauseStateUpdateTime.Equal(*that1.PauseStateUpdateTime) {
+		return false
+	}
+	if this.TagsVersion != that1.TagsVersion {
+		return false
+	}
 	return true
 }
 func (this *ExecutionStats) Equal(that interface{}) bool {
@@ -3290,7 +3376,7 @@ func (this *WorkflowExecutionInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 54)
+	s := make([]string, 0, 60)
 	s = append(s, "&persistence.WorkflowExecutionInfo{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
@@ -3374,6 +3460,26 @@ func (this *WorkflowExecutionInfo) GoString() string {
 		s = append(s, "ExecutionStats: "+fmt.Sprintf("%#v", this.ExecutionStats)+",\n")
 	}
 	s = append(s, "WorkflowRunExpirationTime: "+fmt.Sprintf("%#v", this.WorkflowRunExpirationTime)+",\n")
+	keysForTags := make([]string, 0, len(this.Tags))
+	for k, _ := range this.Tags {
+		keysForTags = append(keysForTags, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForTags)
+	mapStringForTags := "map[string]string{"
+	for _, k := range keysForTags {
+		mapStringForTags += fmt.Sprintf("%#v: %#v,", k, this.Tags[k])
+	}
+	mapStringForTags += "}"
+	if this.Tags != nil {
+		s = append(s, "Tags: "+mapStringForTags+",\n")
+	}
+	s = append(s, "WorkflowTaskStartedIdentity: "+fmt.Sprintf("%#v", this.WorkflowTaskStartedIdentity)+",\n")
+	if this.PauseInfo != nil {
+		s = append(s, "PauseInfo: "+fmt.Sprintf("%#v", this.PauseInfo)+",\n")
+	}
+	s = append(s, "PauseStateVersion: "+fmt.Sprintf("%#v", this.PauseStateVersion)+",\n")
+	s = append(s, "PauseStateUpdateTime: "+fmt.Sprintf("%#v", this.PauseStateUpdateTime)+",\n")
+	s = append(s, "TagsVersion: "+fmt.Sprintf("%#v", this.TagsVersion)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -3798,8 +3904,15 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.WorkflowRunExpirationTime != nil {
-		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowRunExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowRunExpirationTime):])
+	if m.TagsVersion != 0 {
+		i = encodeVarintExecutions(dAtA, i, uint64(m.TagsVersion))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xf8
+	}
+	if m.PauseStateUpdateTime != nil {
+		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.PauseStateUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.PauseStateUpdateTime):])
 		if err4 != nil {
 			return 0, err4
 		}
@@ -3808,6 +3921,69 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x3
 		i--
+		dAtA[i] = 0xf2
+	}
+	if m.PauseStateVersion != 0 {
+		i = encodeVarintExecutions(dAtA, i, uint64(m.PauseStateVersion))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xe8
+	}
+	if m.PauseInfo != nil {
+		{
+			size, err := m.PauseInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExecutions(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xe2
+	}
+	if len(m.WorkflowTaskStartedIdentity) > 0 {
+		i -= len(m.WorkflowTaskStartedIdentity)
+		copy(dAtA[i:], m.WorkflowTaskStartedIdentity)
+		i = encodeVarintExecutions(dAtA, i, uint64(len(m.WorkflowTaskStartedIdentity)))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xda
+	}
+	if len(m.Tags) > 0 {
+		for k := range m.Tags {
+			v := m.Tags[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintExecutions(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintExecutions(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintExecutions(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if m.WorkflowRunExpirationTime != nil {
+		n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowRunExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowRunExpirationTime):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintExecutions(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x3
+		i--
 		dAtA[i] = 0xca
 	}
 	if m.ExecutionStats != nil {
@@ -3964,12 +4140,12 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if m.WorkflowExecutionExpirationTime != nil {
-		n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowExecutionExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowExecutionExpirationTime):])
-		if err10 != nil {
-			return 0, err10
+		n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowExecutionExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowExecutionExpirationTime):])
+		if err12 != nil {
+			return 0, err12
 		}
-		i -= n10
-		i = encodeVarintExecutions(dAtA, i, uint64(n10))
+		i -= n12
+		i = encodeVarintExecutions(dAtA, i, uint64(n12))
 		i--
 		dAtA[i] = 0x2
 		i--
@@ -3991,24 +4167,24 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xb0
 	}
 	if m.RetryMaximumInterval != nil {
-		n11, err11 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryMaximumInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryMaximumInterval):])
-		if err11 != nil {
-			return 0, err11
+		n13, err13 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryMaximumInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryMaximumInterval):])
+		if err13 != nil {
+			return 0, err13
 		}
-		i -= n11
-		i = encodeVarintExecutions(dAtA, i, uint64(n11))
+		i -= n13
+		i = encodeVarintExecutions(dAtA, i, uint64(n13))
 		i--
 		dAtA[i] = 0x2
 		i--
 		dAtA[i] = 0xaa
 	}
 	if m.RetryInitialInterval != nil {
-		n12, err12 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryInitialInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryInitialInterval):])
-		if err12 != nil {
-			return 0, err12
+		n14, err14 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryInitialInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryInitialInterval):])
+		if err14 != nil {
+			return 0, err14
 		}
-		i -= n12
-		i = encodeVarintExecutions(dAtA, i, uint64(n12))
+		i -= n14
+		i = encodeVarintExecutions(dAtA, i, uint64(n14))
 		i--
 		dAtA[i] = 0x2
 		i--
@@ -4022,12 +4198,12 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x98
 	}
 	if m.StickyScheduleToStartTimeout != nil {
-		n13, err13 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StickyScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StickyScheduleToStartTimeout):])
-		if err13 != nil {
-			return 0, err13
+		n15, err15 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StickyScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StickyScheduleToStartTimeout):])
+		if err15 != nil {
+			return 0, err15
 		}
-		i -= n13
-		i = encodeVarintExecutions(dAtA, i, uint64(n13))
+		i -= n15
+		i = encodeVarintExecutions(dAtA, i, uint64(n15))
 		i--
 		dAtA[i] = 0x2
 		i--
@@ -4061,12 +4237,12 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xfa
 	}
 	if m.WorkflowTaskOriginalScheduledTime != nil {
-		n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskOriginalScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskOriginalScheduledTime):])
-		if err14 != nil {
-			return 0, err14
+		n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskOriginalScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskOriginalScheduledTime):])
+		if err16 != nil {
+			return 0, err16
 		}
-		i -= n14
-		i = encodeVarintExecutions(dAtA, i, uint64(n14))
+		i -= n16
+		i = encodeVarintExecutions(dAtA, i, uint64(n16))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -4085,24 +4261,24 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xe8
 	}
 	if m.WorkflowTaskScheduledTime != nil {
-		n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskScheduledTime):])
-		if err15 != nil {
-			return 0, err15
+		n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskScheduledTime):])
+		if err17 != nil {
+			return 0, err17
 		}
-		i -= n15
-		i = encodeVarintExecutions(dAtA, i, uint64(n15))
+		i -= n17
+		i = encodeVarintExecutions(dAtA, i, uint64(n17))
 		i--
 		dAtA[i] = 0x1
 		i--
 		dAtA[i] = 0xe2
 	}
 	if m.WorkflowTaskStartedTime != nil {
-		n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskStartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskStartedTime):])
-		if err16 != nil {
-			return 0, err16
+		n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskStartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskStartedTime):])
+		if err18 != nil {
+			return 0, err18
 		}
-		i -= n16
-		i = encodeVarintExecutions(dAtA, i, uint64(n16))
+		i -= n18
+		i = encodeVarintExecutions(dAtA, i, uint64(n18))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -4116,12 +4292,12 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xd0
 	}
 	if m.WorkflowTaskTimeout != nil {
-		n17, err17 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowTaskTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowTaskTimeout):])
-		if err17 != nil {
-			return 0, err17
+		n19, err19 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowTaskTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowTaskTimeout):])
+		if err19 != nil {
+			return 0, err19
 		}
-		i -= n17
-		i = encodeVarintExecutions(dAtA, i, uint64(n17))
+		i -= n19
+		i = encodeVarintExecutions(dAtA, i, uint64(n19))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -4149,24 +4325,24 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xb0
 	}
 	if m.LastUpdateTime != nil {
-		n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpdateTime):])
-		if err18 != nil {
-			return 0, err18
+		n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpdateTime):])
+		if err20 != nil {
+			return 0, err20
 		}
-		i -= n18
-		i = encodeVarintExecutions(dAtA, i, uint64(n18))
+		i -= n20
+		i = encodeVarintExecutions(dAtA, i, uint64(n20))
 		i--
 		dAtA[i] = 0x1
 		i--
 		dAtA[i] = 0xaa
 	}
 	if m.StartTime != nil {
-		n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime):])
-		if err19 != nil {
-			return 0, err19
+		n21, err21 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime):])
+		if err21 != nil {
+			return 0, err21
 		}
-		i -= n19
-		i = encodeVarintExecutions(dAtA, i, uint64(n19))
+		i -= n21
+		i = encodeVarintExecutions(dAtA, i, uint64(n21))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -4199,32 +4375,32 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x70
 	}
 	if m.DefaultWorkflowTaskTimeout != nil {
-		n20, err20 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.DefaultWorkflowTaskTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.DefaultWorkflowTaskTimeout):])
-		if err20 != nil {
-			return 0, err20
+		n22, err22 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.DefaultWorkflowTaskTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.DefaultWorkflowTaskTimeout):])
+		if err22 != nil {
+			return 0, err22
 		}
-		i -= n20
-		i = encodeVarintExecutions(dAtA, i, uint64(n20))
+		i -= n22
+		i = encodeVarintExecutions(dAtA, i, uint64(n22))
 		i--
 		dAtA[i] = 0x6a
 	}
 	if m.WorkflowRunTimeout != nil {
-		n21, err21 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowRunTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowRunTimeout):])
-		if err21 != nil {
-			return 0, err21
+		n23, err23 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowRunTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowRunTimeout):])
+		if err23 != nil {
+			return 0, err23
 		}
-		i -= n21
-		i = encodeVarintExecutions(dAtA, i, uint64(n21))
+		i -= n23
+		i = encodeVarintExecutions(dAtA, i, uint64(n23))
 		i--
 		dAtA[i] = 0x62
 	}
 	if m.WorkflowExecutionTimeout != nil {
-		n22, err22 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowExecutionTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowExecutionTimeout):])
-		if err22 != nil {
-			return 0, err22
+		n24, err24 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowExecutionTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowExecutionTimeout):])
+		if err24 != nil {
+			return 0, err24
 		}
-		i -= n22
-		i = encodeVarintExecutions(dAtA, i, uint64(n22))
+		i -= n24
+		i = encodeVarintExecutions(dAtA, i, uint64(n24))
 		i--
 		dAtA[i] = 0x5a
 	}
@@ -4408,12 +4584,12 @@ func (m *TransferTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x70
 	}
 	if m.VisibilityTime != nil {
-		n24, err24 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
-		if err24 != nil {
-			return 0, err24
+		n26, err26 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
+		if err26 != nil {
+			return 0, err26
 		}
-		i -= n24
-		i = encodeVarintExecutions(dAtA, i, uint64(n24))
+		i -= n26
+		i = encodeVarintExecutions(dAtA, i, uint64(n26))
 		i--
 		dAtA[i] = 0x6a
 	}
@@ -4608,12 +4784,12 @@ func (m *VisibilityTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.VisibilityTime != nil {
-		n25, err25 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
-		if err25 != nil {
-			return 0, err25
+		n27, err27 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
+		if err27 != nil {
+			return 0, err27
 		}
-		i -= n25
-		i = encodeVarintExecutions(dAtA, i, uint64(n25))
+		i -= n27
+		i = encodeVarintExecutions(dAtA, i, uint64(n27))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -4677,12 +4853,12 @@ func (m *TimerTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.VisibilityTime != nil {
-		n26, err26 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
-		if err26 != nil {
-			return 0, err26
+		n28, err28 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
+		if err28 != nil {
+			return 0, err28
 		}
-		i -= n26
-		i = encodeVarintExecutions(dAtA, i, uint64(n26))
+		i -= n28
+		i = encodeVarintExecutions(dAtA, i, uint64(n28))
 		i--
 		dAtA[i] = 0x5a
 	}
@@ -4766,12 +4942,12 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.LastHeartbeatUpdateTime != nil {
-		n27, err27 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatUpdateTime):])
-		if err27 != nil {
-			return 0, err27
+		n29, err29 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatUpdateTime):])
+		if err29 != nil {
+			return 0, err29
 		}
-		i -= n27
-		i = encodeVarintExecutions(dAtA, i, uint64(n27))
+		i -= n29
+		i = encodeVarintExecutions(dAtA, i, uint64(n29))
 		i--
 		dAtA[i] = 0x2
 		i--
@@ -4850,12 +5026,12 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xc9
 	}
 	if m.RetryExpirationTime != nil {
-		n30, err30 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.RetryExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.RetryExpirationTime):])
-		if err30 != nil {
-			return 0, err30
+		n32, err32 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.RetryExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.RetryExpirationTime):])
+		if err32 != nil {
+			return 0, err32
 		}
-		i -= n30
-		i = encodeVarintExecutions(dAtA, i, uint64(n30))
+		i -= n32
+		i = encodeVarintExecutions(dAtA, i, uint64(n32))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -4869,24 +5045,24 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xb8
 	}
 	if m.RetryMaximumInterval != nil {
-		n31, err31 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryMaximumInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryMaximumInterval):])
-		if err31 != nil {
-			return 0, err31
+		n33, err33 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryMaximumInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryMaximumInterval):])
+		if err33 != nil {
+			return 0, err33
 		}
-		i -= n31
-		i = encodeVarintExecutions(dAtA, i, uint64(n31))
+		i -= n33
+		i = encodeVarintExecutions(dAtA, i, uint64(n33))
 		i--
 		dAtA[i] = 0x1
 		i--
 		dAtA[i] = 0xb2
 	}
 	if m.RetryInitialInterval != nil {
-		n32, err32 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryInitialInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryInitialInterval):])
-		if err32 != nil {
-			return 0, err32
+		n34, err34 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryInitialInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryInitialInterval):])
+		if err34 != nil {
+			return 0, err34
 		}
-		i -= n32
-		i = encodeVarintExecutions(dAtA, i, uint64(n32))
+		i -= n34
+		i = encodeVarintExecutions(dAtA, i, uint64(n34))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -4952,42 +5128,42 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x70
 	}
 	if m.HeartbeatTimeout != nil {
-		n33, err33 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.HeartbeatTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.HeartbeatTimeout):])
-		if err33 != nil {
-			return 0, err33
+		n35, err35 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.HeartbeatTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.HeartbeatTimeout):])
+		if err35 != nil {
+			return 0, err35
 		}
-		i -= n33
-		i = encodeVarintExecutions(dAtA, i, uint64(n33))
+		i -= n35
+		i = encodeVarintExecutions(dAtA, i, uint64(n35))
 		i--
 		dAtA[i] = 0x6a
 	}
 	if m.StartToCloseTimeout != nil {
-		n34, err34 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StartToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StartToCloseTimeout):])
-		if err34 != nil {
-			return 0, err34
+		n36, err36 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StartToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StartToCloseTimeout):])
+		if err36 != nil {
+			return 0, err36
 		}
-		i -= n34
-		i = encodeVarintExecutions(dAtA, i, uint64(n34))
+		i -= n36
+		i = encodeVarintExecutions(dAtA, i, uint64(n36))
 		i--
 		dAtA[i] = 0x62
 	}
 	if m.ScheduleToCloseTimeout != nil {
-		n35, err35 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToCloseTimeout):])
-		if err35 != nil {
-			return 0, err35
+		n37, err37 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToCloseTimeout):])
+		if err37 != nil {
+			return 0, err37
 		}
-		i -= n35
-		i = encodeVarintExecutions(dAtA, i, uint64(n35))
+		i -= n37
+		i = encodeVarintExecutions(dAtA, i, uint64(n37))
 		i--
 		dAtA[i] = 0x5a
 	}
 	if m.ScheduleToStartTimeout != nil {
-		n36, err36 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout):])
-		if err36 != nil {
-			return 0, err36
+		n38, err38 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout):])
+		if err38 != nil {
+			return 0, err38
 		}
-		i -= n36
-		i = encodeVarintExecutions(dAtA, i, uint64(n36))
+		i -= n38
+		i = encodeVarintExecutions(dAtA, i, uint64(n38))
 		i--
 		dAtA[i] = 0x52
 	}
@@ -5006,12 +5182,12 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x42
 	}
 	if m.StartedTime != nil {
-		n37, err37 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
-		if err37 != nil {
-			return 0, err37
+		n39, err39 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
+		if err39 != nil {
+			return 0, err39
 		}
-		i -= n37
-		i = encodeVarintExecutions(dAtA, i, uint64(n37))
+		i -= n39
+		i = encodeVarintExecutions(dAtA, i, uint64(n39))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -5033,12 +5209,12 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x28
 	}
 	if m.ScheduledTime != nil {
-		n39, err39 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
-		if err39 != nil {
-			return 0, err39
+		n41, err41 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
+		if err41 != nil {
+			return 0, err41
 		}
-		i -= n39
-		i = encodeVarintExecutions(dAtA, i, uint64(n39))
+		i -= n41
+		i = encodeVarintExecutions(dAtA, i, uint64(n41))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -5100,12 +5276,12 @@ func (m *TimerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x20
 	}
 	if m.ExpiryTime != nil {
-		n41, err41 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
-		if err41 != nil {
-			return 0, err41
+		n43, err43 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
+		if err43 != nil {
+			return 0, err43
 		}
-		i -= n41
-		i = encodeVarintExecutions(dAtA, i, uint64(n41))
+		i -= n43
+		i = encodeVarintExecutions(dAtA, i, uint64(n43))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -5684,6 +5860,32 @@ func (m *WorkflowExecutionInfo) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowRunExpirationTime)
 		n += 2 + l + sovExecutions(uint64(l))
 	}
+	if len(m.Tags) > 0 {
+		for k, v := range m.Tags {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovExecutions(uint64(len(k))) + 1 + len(v) + sovExecutions(uint64(len(v)))
+			n += mapEntrySize + 2 + sovExecutions(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.WorkflowTaskStartedIdentity)
+	if l > 0 {
+		n += 2 + l + sovExecutions(uint64(l))
+	}
+	if m.PauseInfo != nil {
+		l = m.PauseInfo.Size()
+		n += 2 + l + sovExecutions(uint64(l))
+	}
+	if m.PauseStateVersion != 0 {
+		n += 2 + sovExecutions(uint64(m.PauseStateVersion))
+	}
+	if m.PauseStateUpdateTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.PauseStateUpdateTime)
+		n += 2 + l + sovExecutions(uint64(l))
+	}
+	if m.TagsVersion != 0 {
+		n += 2 + sovExecutions(uint64(m.TagsVersion))
+	}
 	return n
 }
 
@@ -6282,6 +6484,16 @@ func (this *WorkflowExecutionInfo) String() string {
 		mapStringForMemo += fmt.Sprintf("%v: %v,", k, this.Memo[k])
 	}
 	mapStringForMemo += "}"
+	keysForTags := make([]string, 0, len(this.Tags))
+	for k, _ := range this.Tags {
+		keysForTags = append(keysForTags, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForTags)
+	mapStringForTags := "map[string]string{"
+	for _, k := range keysForTags {
+		mapStringForTags += fmt.Sprintf("%v: %v,", k, this.Tags[k])
+	}
+	mapStringForTags += "}"
 	s := strings.Join([]string{`&WorkflowExecutionInfo{`,
 		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
 		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
@@ -6333,6 +6545,12 @@ func (this *WorkflowExecutionInfo) String() string {
 		`FirstExecutionRunId:` + fmt.Sprintf("%v", this.FirstExecutionRunId) + `,`,
 		`ExecutionStats:` + strings.Replace(this.ExecutionStats.String(), "ExecutionStats", "ExecutionStats", 1) + `,`,
 		`WorkflowRunExpirationTime:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowRunExpirationTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Tags:` + mapStringForTags + `,`,
+		`WorkflowTaskStartedIdentity:` + fmt.Sprintf("%v", this.WorkflowTaskStartedIdentity) + `,`,
+		`PauseInfo:` + strings.Replace(fmt.Sprintf("%v", this.PauseInfo), "WorkflowPauseInfo", "v14.WorkflowPauseInfo", 1) + `,`,
+		`PauseStateVersion:` + fmt.Sprintf("%v", this.PauseStateVersion) + `,`,
+		`PauseStateUpdateTime:` + strings.Replace(fmt.Sprintf("%v", this.PauseStateUpdateTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`TagsVersion:` + fmt.Sprintf("%v", this.TagsVersion) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -6470,7 +6688,7 @@ func (this *ActivityInfo) String() string {
 		`RetryExpirationTime:` + strings.Replace(fmt.Sprintf("%v", this.RetryExpirationTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`RetryBackoffCoefficient:` + fmt.Sprintf("%v", this.RetryBackoffCoefficient) + `,`,
 		`RetryNonRetryableErrorTypes:` + fmt.Sprintf("%v", this.RetryNonRetryableErrorTypes) + `,`,
-		`RetryLastFailure:` + strings.Replace(fmt.Sprintf("%v", this.RetryLastFailure), "Failure", "v16.Failure", 1) + `,`,
+		`RetryLastFailure:` + strings.Replace(fmt.Sprintf("%v", this.RetryLastFailure), "Failure", "v17.Failure", 1) + `,`,
 		`RetryLastWorkerIdentity:` + fmt.Sprintf("%v", this.RetryLastWorkerIdentity) + `,`,
 		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
 		`ScheduleId:` + fmt.Sprintf("%v", this.ScheduleId) + `,`,
@@ -8989,6 +9207,275 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 58:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tags == nil {
+				m.Tags = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowExecutions
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowExecutions
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthExecutions
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthExecutions
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowExecutions
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthExecutions
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthExecutions
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipExecutions(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthExecutions
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Tags[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 59:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTaskStartedIdentity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowTaskStartedIdentity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 60:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PauseInfo == nil {
+				m.PauseInfo = &v14.WorkflowPauseInfo{}
+			}
+			if err := m.PauseInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 61:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseStateVersion", wireType)
+			}
+			m.PauseStateVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PauseStateVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 62:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseStateUpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PauseStateUpdateTime == nil {
+				m.PauseStateUpdateTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.PauseStateUpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 63:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TagsVersion", wireType)
+			}
+			m.TagsVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TagsVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExecutions(dAtA[iNdEx:])
@@ -9192,7 +9679,7 @@ func (m *WorkflowExecutionState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= v14.WorkflowExecutionState(b&0x7F) << shift
+				m.State |= v15.WorkflowExecutionState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -9211,7 +9698,7 @@ func (m *WorkflowExecutionState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= v15.WorkflowExecutionStatus(b&0x7F) << shift
+				m.Status |= v16.WorkflowExecutionStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -9379,7 +9866,7 @@ func (m *TransferTaskInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskType |= v14.TaskType(b&0x7F) << shift
+				m.TaskType |= v15.TaskType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -9808,7 +10295,7 @@ func (m *ReplicationTaskInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskType |= v14.TaskType(b&0x7F) << shift
+				m.TaskType |= v15.TaskType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -10139,7 +10626,7 @@ func (m *VisibilityTaskInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskType |= v14.TaskType(b&0x7F) << shift
+				m.TaskType |= v15.TaskType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -10381,7 +10868,7 @@ func (m *TimerTaskInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskType |= v14.TaskType(b&0x7F) << shift
+				m.TaskType |= v15.TaskType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -10400,7 +10887,7 @@ func (m *TimerTaskInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TimeoutType |= v15.TimeoutType(b&0x7F) << shift
+				m.TimeoutType |= v16.TimeoutType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -10419,7 +10906,7 @@ func (m *TimerTaskInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WorkflowBackoffType |= v14.WorkflowBackoffType(b&0x7F) << shift
+				m.WorkflowBackoffType |= v15.WorkflowBackoffType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -11359,7 +11846,7 @@ func (m *ActivityInfo) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.RetryLastFailure == nil {
-				m.RetryLastFailure = &v16.Failure{}
+				m.RetryLastFailure = &v17.Failure{}
 			}
 			if err := m.RetryLastFailure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -12054,7 +12541,7 @@ func (m *ChildExecutionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ParentClosePolicy |= v15.ParentClosePolicy(b&0x7F) << shift
+				m.ParentClosePolicy |= v16.ParentClosePolicy(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -12548,7 +13035,7 @@ func (m *Checksum) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Flavor |= v14.ChecksumFlavor(b&0x7F) << shift
+				m.Flavor |= v15.ChecksumFlavor(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
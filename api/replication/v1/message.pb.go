@@ -38,6 +38,7 @@ import (
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
 	_ "github.com/gogo/protobuf/types"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	v14 "go.temporal.io/api/common/v1"
@@ -47,6 +48,7 @@ import (
 	v12 "go.temporal.io/api/replication/v1"
 	v1 "go.temporal.io/server/api/enums/v1"
 	v16 "go.temporal.io/server/api/history/v1"
+	v17 "go.temporal.io/server/api/workflow/v1"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -71,6 +73,7 @@ type ReplicationTask struct {
 	//	*ReplicationTask_SyncActivityTaskAttributes
 	//	*ReplicationTask_HistoryMetadataTaskAttributes
 	//	*ReplicationTask_HistoryTaskV2Attributes
+	//	*ReplicationTask_SyncWorkflowStateTaskAttributes
 	Attributes isReplicationTask_Attributes `protobuf_oneof:"attributes"`
 }
 
@@ -131,13 +134,17 @@ type ReplicationTask_HistoryMetadataTaskAttributes struct {
 type ReplicationTask_HistoryTaskV2Attributes struct {
 	HistoryTaskV2Attributes *HistoryTaskV2Attributes `protobuf:"bytes,8,opt,name=history_task_v2_attributes,json=historyTaskV2Attributes,proto3,oneof" json:"history_task_v2_attributes,omitempty"`
 }
+type ReplicationTask_SyncWorkflowStateTaskAttributes struct {
+	SyncWorkflowStateTaskAttributes *SyncWorkflowStateTaskAttributes `protobuf:"bytes,9,opt,name=sync_workflow_state_task_attributes,json=syncWorkflowStateTaskAttributes,proto3,oneof" json:"sync_workflow_state_task_attributes,omitempty"`
+}
 
-func (*ReplicationTask_NamespaceTaskAttributes) isReplicationTask_Attributes()       {}
-func (*ReplicationTask_HistoryTaskAttributes) isReplicationTask_Attributes()         {}
-func (*ReplicationTask_SyncShardStatusTaskAttributes) isReplicationTask_Attributes() {}
-func (*ReplicationTask_SyncActivityTaskAttributes) isReplicationTask_Attributes()    {}
-func (*ReplicationTask_HistoryMetadataTaskAttributes) isReplicationTask_Attributes() {}
-func (*ReplicationTask_HistoryTaskV2Attributes) isReplicationTask_Attributes()       {}
+func (*ReplicationTask_NamespaceTaskAttributes) isReplicationTask_Attributes()         {}
+func (*ReplicationTask_HistoryTaskAttributes) isReplicationTask_Attributes()           {}
+func (*ReplicationTask_SyncShardStatusTaskAttributes) isReplicationTask_Attributes()   {}
+func (*ReplicationTask_SyncActivityTaskAttributes) isReplicationTask_Attributes()      {}
+func (*ReplicationTask_HistoryMetadataTaskAttributes) isReplicationTask_Attributes()   {}
+func (*ReplicationTask_HistoryTaskV2Attributes) isReplicationTask_Attributes()         {}
+func (*ReplicationTask_SyncWorkflowStateTaskAttributes) isReplicationTask_Attributes() {}
 
 func (m *ReplicationTask) GetAttributes() isReplicationTask_Attributes {
 	if m != nil {
@@ -202,6 +209,13 @@ func (m *ReplicationTask) GetHistoryTaskV2Attributes() *HistoryTaskV2Attributes
 	return nil
 }
 
+func (m *ReplicationTask) GetSyncWorkflowStateTaskAttributes() *SyncWorkflowStateTaskAttributes {
+	if x, ok := m.GetAttributes().(*ReplicationTask_SyncWorkflowStateTaskAttributes); ok {
+		return x.SyncWorkflowStateTaskAttributes
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ReplicationTask) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -211,6 +225,7 @@ func (*ReplicationTask) XXX_OneofWrappers() []interface{} {
 		(*ReplicationTask_SyncActivityTaskAttributes)(nil),
 		(*ReplicationTask_HistoryMetadataTaskAttributes)(nil),
 		(*ReplicationTask_HistoryTaskV2Attributes)(nil),
+		(*ReplicationTask_SyncWorkflowStateTaskAttributes)(nil),
 	}
 }
 
@@ -990,6 +1005,114 @@ func (m *SyncActivityTaskAttributes) GetVersionHistory() *v16.VersionHistory {
 	return nil
 }
 
+type SyncWorkflowStateTaskAttributes struct {
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	WorkflowId  string `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	RunId       string `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	// Failover version of the pause state.
+	Version              int64                  `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	VersionHistory       *v16.VersionHistory    `protobuf:"bytes,5,opt,name=version_history,json=versionHistory,proto3" json:"version_history,omitempty"`
+	PauseInfo            *v17.WorkflowPauseInfo `protobuf:"bytes,6,opt,name=pause_info,json=pauseInfo,proto3" json:"pause_info,omitempty"`
+	PauseStateUpdateTime *time.Time             `protobuf:"bytes,7,opt,name=pause_state_update_time,json=pauseStateUpdateTime,proto3,stdtime" json:"pause_state_update_time,omitempty"`
+	Tags                 map[string]string      `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	TagsVersion          int64                  `protobuf:"varint,9,opt,name=tags_version,json=tagsVersion,proto3" json:"tags_version,omitempty"`
+}
+
+func (m *SyncWorkflowStateTaskAttributes) Reset()      { *m = SyncWorkflowStateTaskAttributes{} }
+func (*SyncWorkflowStateTaskAttributes) ProtoMessage() {}
+func (*SyncWorkflowStateTaskAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edd9fae2af6b0532, []int{10}
+}
+func (m *SyncWorkflowStateTaskAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncWorkflowStateTaskAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SyncWorkflowStateTaskAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SyncWorkflowStateTaskAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncWorkflowStateTaskAttributes.Merge(m, src)
+}
+func (m *SyncWorkflowStateTaskAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncWorkflowStateTaskAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncWorkflowStateTaskAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncWorkflowStateTaskAttributes proto.InternalMessageInfo
+
+func (m *SyncWorkflowStateTaskAttributes) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *SyncWorkflowStateTaskAttributes) GetWorkflowId() string {
+	if m != nil {
+		return m.WorkflowId
+	}
+	return ""
+}
+
+func (m *SyncWorkflowStateTaskAttributes) GetRunId() string {
+	if m != nil {
+		return m.RunId
+	}
+	return ""
+}
+
+func (m *SyncWorkflowStateTaskAttributes) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *SyncWorkflowStateTaskAttributes) GetVersionHistory() *v16.VersionHistory {
+	if m != nil {
+		return m.VersionHistory
+	}
+	return nil
+}
+
+func (m *SyncWorkflowStateTaskAttributes) GetPauseInfo() *v17.WorkflowPauseInfo {
+	if m != nil {
+		return m.PauseInfo
+	}
+	return nil
+}
+
+func (m *SyncWorkflowStateTaskAttributes) GetPauseStateUpdateTime() *time.Time {
+	if m != nil {
+		return m.PauseStateUpdateTime
+	}
+	return nil
+}
+
+func (m *SyncWorkflowStateTaskAttributes) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *SyncWorkflowStateTaskAttributes) GetTagsVersion() int64 {
+	if m != nil {
+		return m.TagsVersion
+	}
+	return 0
+}
+
 type HistoryTaskV2Attributes struct {
 	// TODO remove this task_id attribute once kafka deprecation is done
 	TaskId              int64                     `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
@@ -1005,7 +1128,7 @@ type HistoryTaskV2Attributes struct {
 func (m *HistoryTaskV2Attributes) Reset()      { *m = HistoryTaskV2Attributes{} }
 func (*HistoryTaskV2Attributes) ProtoMessage() {}
 func (*HistoryTaskV2Attributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edd9fae2af6b0532, []int{10}
+	return fileDescriptor_edd9fae2af6b0532, []int{11}
 }
 func (m *HistoryTaskV2Attributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1094,6 +1217,8 @@ func init() {
 	proto.RegisterType((*HistoryMetadataTaskAttributes)(nil), "temporal.server.api.replication.v1.HistoryMetadataTaskAttributes")
 	proto.RegisterType((*SyncShardStatusTaskAttributes)(nil), "temporal.server.api.replication.v1.SyncShardStatusTaskAttributes")
 	proto.RegisterType((*SyncActivityTaskAttributes)(nil), "temporal.server.api.replication.v1.SyncActivityTaskAttributes")
+	proto.RegisterType((*SyncWorkflowStateTaskAttributes)(nil), "temporal.server.api.replication.v1.SyncWorkflowStateTaskAttributes")
+	proto.RegisterMapType((map[string]string)(nil), "temporal.server.api.replication.v1.SyncWorkflowStateTaskAttributes.TagsEntry")
 	proto.RegisterType((*HistoryTaskV2Attributes)(nil), "temporal.server.api.replication.v1.HistoryTaskV2Attributes")
 }
 
@@ -1102,101 +1227,111 @@ func init() {
 }
 
 var fileDescriptor_edd9fae2af6b0532 = []byte{
-	// 1503 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x3b, 0x6f, 0x1b, 0xc7,
-	0x16, 0xd6, 0xf2, 0xcd, 0xe1, 0xd3, 0xa3, 0xab, 0x2b, 0x8a, 0x80, 0x68, 0x89, 0xb0, 0xaf, 0xe5,
-	0x8b, 0x60, 0x69, 0x51, 0x45, 0x62, 0x3b, 0x08, 0x20, 0x39, 0x71, 0x44, 0x01, 0x76, 0x8c, 0xb5,
-	0x60, 0x03, 0x69, 0x36, 0x23, 0xee, 0x90, 0x5c, 0x88, 0xdc, 0x25, 0x66, 0x86, 0x54, 0x98, 0x2a,
-	0x40, 0x8a, 0x34, 0x09, 0xe0, 0xff, 0xe0, 0x20, 0x48, 0x95, 0xdf, 0xe1, 0xd2, 0x4d, 0x00, 0xa7,
-	0x4a, 0x4c, 0x37, 0x29, 0xdd, 0xa5, 0x0d, 0xe6, 0xb1, 0xe4, 0x2e, 0x97, 0xa4, 0x19, 0x07, 0xae,
-	0xd2, 0x71, 0xce, 0xe3, 0x3b, 0x33, 0x67, 0xce, 0x39, 0xdf, 0x2c, 0xc1, 0x0d, 0x86, 0x7b, 0x7d,
-	0x97, 0xa0, 0x6e, 0x8d, 0x62, 0x32, 0xc4, 0xa4, 0x86, 0xfa, 0x76, 0x8d, 0xe0, 0x7e, 0xd7, 0x6e,
-	0x22, 0x66, 0xbb, 0x4e, 0x6d, 0xb8, 0x5f, 0xeb, 0x61, 0x4a, 0x51, 0x1b, 0xeb, 0x7d, 0xe2, 0x32,
-	0x17, 0x56, 0x3d, 0x0f, 0x5d, 0x7a, 0xe8, 0xa8, 0x6f, 0xeb, 0x3e, 0x0f, 0x7d, 0xb8, 0x5f, 0xbe,
-	0xdc, 0x76, 0xdd, 0x76, 0x17, 0xd7, 0x84, 0xc7, 0xd9, 0xa0, 0x55, 0x63, 0x76, 0x0f, 0x53, 0x86,
-	0x7a, 0x7d, 0x09, 0x52, 0xde, 0xb5, 0x70, 0x1f, 0x3b, 0x16, 0x76, 0x9a, 0x36, 0xa6, 0xb5, 0xb6,
-	0xdb, 0x76, 0x85, 0x5c, 0xfc, 0x52, 0x26, 0xfa, 0xbc, 0x9d, 0x61, 0x67, 0xd0, 0xa3, 0x7c, 0x4f,
-	0xfe, 0x80, 0xd2, 0xfe, 0xda, 0x52, 0x7b, 0x86, 0xe8, 0xb9, 0x32, 0x7c, 0x6f, 0x9e, 0x61, 0xc7,
-	0xa6, 0xcc, 0x25, 0xa3, 0xd0, 0x71, 0xcb, 0x57, 0x26, 0xd6, 0xdc, 0xac, 0xe9, 0xf6, 0x7a, 0x73,
-	0x92, 0xe2, 0x0b, 0xce, 0xad, 0x1c, 0xd4, 0xc3, 0xb4, 0x8f, 0x9a, 0x38, 0x6c, 0x78, 0x3d, 0x60,
-	0xb8, 0x2c, 0xd1, 0xe5, 0xab, 0x01, 0xd3, 0x85, 0x1b, 0x0c, 0x9a, 0xb5, 0x90, 0xdd, 0x1d, 0x90,
-	0x70, 0xe0, 0xea, 0x8f, 0x49, 0x50, 0x30, 0xa6, 0xe1, 0x4e, 0x11, 0x3d, 0x87, 0xf7, 0x41, 0x9a,
-	0xe7, 0xc5, 0x64, 0xa3, 0x3e, 0x2e, 0x69, 0x3b, 0xda, 0x5e, 0xbe, 0xbe, 0xaf, 0xcf, 0xbb, 0x5e,
-	0x91, 0x46, 0x7d, 0xb8, 0xaf, 0xcf, 0x20, 0x9c, 0x8e, 0xfa, 0xd8, 0x48, 0x31, 0xf5, 0x0b, 0x5e,
-	0x01, 0x79, 0xea, 0x0e, 0x48, 0x13, 0x9b, 0x02, 0xd6, 0xb6, 0x4a, 0x91, 0x1d, 0x6d, 0x2f, 0x6a,
-	0x64, 0xa5, 0x94, 0x7b, 0x34, 0x2c, 0x38, 0x02, 0x5b, 0x93, 0x04, 0x49, 0x43, 0xc4, 0x18, 0xb1,
-	0xcf, 0x06, 0x0c, 0xd3, 0x52, 0x74, 0x47, 0xdb, 0xcb, 0xd4, 0x6f, 0xeb, 0x6f, 0x2e, 0x32, 0xfd,
-	0xbe, 0x07, 0xc2, 0x71, 0x0f, 0x27, 0x10, 0xc7, 0x6b, 0xc6, 0xa6, 0x33, 0x5f, 0x05, 0x29, 0xd8,
-	0x54, 0x79, 0x0c, 0x05, 0x8e, 0x89, 0xc0, 0x37, 0x57, 0x09, 0x7c, 0x2c, 0x21, 0x42, 0x61, 0x37,
-	0x3a, 0xf3, 0x14, 0xf0, 0x7b, 0x0d, 0xec, 0xd2, 0x91, 0xd3, 0x34, 0x69, 0x07, 0x11, 0xcb, 0xa4,
-	0x0c, 0xb1, 0x01, 0x0d, 0xc5, 0x8f, 0x8b, 0xf8, 0x87, 0xab, 0xc4, 0x7f, 0x38, 0x72, 0x9a, 0x0f,
-	0x39, 0xd6, 0x43, 0x01, 0x15, 0xda, 0xc7, 0x36, 0x5d, 0x66, 0x00, 0xbf, 0xd1, 0x80, 0xb0, 0x30,
-	0x51, 0x93, 0xd9, 0x43, 0x9b, 0x85, 0x73, 0x91, 0x10, 0x7b, 0xf9, 0x68, 0xd5, 0xbd, 0x1c, 0x2a,
-	0x9c, 0xd0, 0x46, 0xca, 0x74, 0xa1, 0x16, 0x7e, 0xa7, 0x81, 0x1d, 0xef, 0x2e, 0x7a, 0x98, 0x21,
-	0x0b, 0x31, 0x14, 0xda, 0x48, 0x72, 0xf5, 0xa4, 0xa8, 0x4b, 0xb9, 0xa7, 0xa0, 0xc2, 0x49, 0xe9,
-	0x2c, 0x33, 0x80, 0x5f, 0x81, 0x72, 0xa0, 0x32, 0x86, 0x75, 0xff, 0x3e, 0x52, 0xab, 0x57, 0xa5,
-	0xaf, 0x38, 0x1e, 0xd5, 0x83, 0x55, 0xd9, 0x99, 0xaf, 0x3a, 0xca, 0x02, 0x30, 0x8d, 0x55, 0x7d,
-	0xaa, 0x81, 0xa2, 0xbf, 0xcd, 0xdc, 0x73, 0xec, 0xc0, 0x2d, 0x90, 0x92, 0xd5, 0x63, 0x5b, 0xa2,
-	0x51, 0xe3, 0x46, 0x52, 0xac, 0x1b, 0x16, 0xbc, 0x09, 0xb6, 0xba, 0x88, 0x32, 0x93, 0x60, 0x46,
-	0x6c, 0x3c, 0xc4, 0x96, 0xa9, 0x1a, 0x7f, 0xda, 0x7f, 0xff, 0xe5, 0x06, 0x86, 0xa7, 0xbf, 0x27,
-	0xd5, 0x3e, 0xd7, 0x3e, 0x71, 0x9b, 0x98, 0xd2, 0xa0, 0x6b, 0x74, 0xea, 0xfa, 0xc0, 0xd3, 0x4f,
-	0x5c, 0xab, 0xa7, 0xa0, 0x30, 0x53, 0x86, 0xf0, 0x10, 0x64, 0xbc, 0xda, 0xb6, 0x7b, 0x72, 0x9e,
-	0x64, 0xea, 0x65, 0x5d, 0x52, 0x81, 0xee, 0x51, 0x81, 0x7e, 0xea, 0x51, 0xc1, 0x51, 0xec, 0xc9,
-	0x6f, 0x97, 0x35, 0x03, 0x48, 0x27, 0x2e, 0xae, 0xfe, 0x1c, 0x01, 0xeb, 0xbe, 0xb3, 0xab, 0x70,
-	0x14, 0x7e, 0x01, 0x2e, 0xf9, 0xd2, 0x2c, 0x6e, 0x88, 0x96, 0xb4, 0x9d, 0xe8, 0x5e, 0xa6, 0x7e,
-	0xb0, 0xca, 0xa5, 0xcc, 0x8c, 0x2d, 0xa3, 0x48, 0x82, 0x02, 0xfa, 0x4f, 0xb2, 0xb8, 0x05, 0x52,
-	0x1d, 0x44, 0xcd, 0x9e, 0x4b, 0xb0, 0x48, 0x5a, 0xca, 0x48, 0x76, 0x10, 0xbd, 0xe7, 0x12, 0x0c,
-	0x4d, 0x70, 0x29, 0xd4, 0xf9, 0x6a, 0xd2, 0x1c, 0xbc, 0x45, 0xa7, 0x1b, 0x85, 0x99, 0xce, 0xae,
-	0xfe, 0x12, 0x4c, 0x98, 0x98, 0xb0, 0x4e, 0xcb, 0x85, 0xbb, 0x20, 0x3b, 0x9d, 0xb1, 0xaa, 0x66,
-	0xd2, 0x46, 0x66, 0x22, 0x6b, 0x58, 0xf0, 0x32, 0xc8, 0x5c, 0xb8, 0xe4, 0xbc, 0xd5, 0x75, 0x2f,
-	0xbc, 0x33, 0xa6, 0x0d, 0xe0, 0x89, 0x1a, 0x16, 0xdc, 0x00, 0x09, 0x32, 0x70, 0xbc, 0x52, 0x48,
-	0x1b, 0x71, 0x32, 0x70, 0x1a, 0x16, 0xbc, 0xe3, 0x27, 0x8d, 0x98, 0x20, 0x8d, 0xff, 0x2d, 0x27,
-	0x8d, 0x39, 0x4c, 0xb1, 0x09, 0x92, 0x1e, 0x45, 0xc4, 0x45, 0x72, 0x13, 0x4c, 0x92, 0x43, 0x09,
-	0x24, 0x87, 0x98, 0x50, 0xdb, 0x75, 0xc4, 0x14, 0x8a, 0x1a, 0xde, 0x92, 0x93, 0x4b, 0xcb, 0x26,
-	0x94, 0x99, 0x78, 0x88, 0x1d, 0xc6, 0x3d, 0x93, 0x92, 0x5c, 0x84, 0xf4, 0x13, 0x2e, 0x6c, 0x58,
-	0xb0, 0x0a, 0x72, 0x0e, 0xfe, 0xd2, 0x67, 0x94, 0x12, 0x46, 0x19, 0x2e, 0xf4, 0x6c, 0x76, 0x41,
-	0x96, 0x36, 0x3b, 0xd8, 0x1a, 0x74, 0xb1, 0x68, 0xa8, 0xb4, 0x34, 0x99, 0xc8, 0x1a, 0x56, 0xf5,
-	0x59, 0x14, 0x6c, 0x2e, 0xe0, 0x17, 0x88, 0xc0, 0xfa, 0x34, 0xb7, 0x6e, 0x1f, 0x13, 0x91, 0x7a,
-	0xc5, 0x9f, 0x37, 0x96, 0xa7, 0x62, 0x82, 0xf9, 0x99, 0xe7, 0x67, 0x40, 0x27, 0x24, 0x83, 0x79,
-	0x10, 0x99, 0x5c, 0x49, 0xc4, 0xb6, 0xe0, 0x87, 0x20, 0x66, 0x3b, 0x2d, 0x57, 0xb1, 0xe3, 0xde,
-	0x34, 0x06, 0x07, 0x9f, 0xf8, 0x07, 0x02, 0xf0, 0x32, 0x30, 0x84, 0x17, 0x3c, 0x02, 0x89, 0xa6,
-	0xeb, 0xb4, 0xec, 0xb6, 0x2a, 0xbd, 0xff, 0xaf, 0xe2, 0x7f, 0x47, 0x78, 0x18, 0xca, 0x13, 0xb6,
-	0x00, 0xf4, 0x77, 0xa0, 0xc2, 0x93, 0xa4, 0xf5, 0x7e, 0x10, 0x6f, 0x11, 0x4d, 0xfb, 0xea, 0x54,
-	0x81, 0xfb, 0x9b, 0x5a, 0x8a, 0xe0, 0x55, 0x90, 0x97, 0xd8, 0x66, 0xb0, 0x0c, 0x72, 0x52, 0xfa,
-	0x48, 0x15, 0xc3, 0x75, 0x50, 0xe4, 0x2f, 0x1d, 0x77, 0x88, 0xc9, 0xc4, 0x50, 0x96, 0x43, 0xc1,
-	0x93, 0x2b, 0xd3, 0xea, 0xd3, 0x28, 0xd8, 0x98, 0xcb, 0xd8, 0xf0, 0x1a, 0x28, 0x30, 0x44, 0xda,
-	0x98, 0x99, 0xcd, 0xee, 0x80, 0x32, 0x4c, 0xe4, 0x4c, 0x49, 0x1b, 0x79, 0x29, 0xbe, 0xa3, 0xa4,
-	0xa1, 0x6e, 0x8a, 0xbc, 0xb1, 0x9b, 0xa2, 0x4b, 0xba, 0x29, 0xe6, 0xef, 0xa6, 0x70, 0x55, 0xc7,
-	0x57, 0xa9, 0xea, 0x44, 0xb8, 0xaa, 0x7d, 0x9d, 0x93, 0x0c, 0x76, 0xce, 0x2d, 0x90, 0x54, 0xd4,
-	0x23, 0x4a, 0x3d, 0x53, 0xdf, 0x09, 0x5e, 0x98, 0x52, 0xfa, 0xd8, 0xcb, 0xf0, 0x1c, 0xe0, 0x31,
-	0x28, 0x38, 0xf8, 0xc2, 0xe4, 0x5b, 0xf7, 0x30, 0xc0, 0x8a, 0x18, 0x39, 0x07, 0x5f, 0x18, 0x03,
-	0x47, 0x2d, 0x4f, 0x62, 0xa9, 0x54, 0x31, 0x7d, 0x12, 0x4b, 0x65, 0x8a, 0xd9, 0x93, 0x58, 0x2a,
-	0x5b, 0xcc, 0x9d, 0xc4, 0x52, 0xb9, 0x62, 0xfe, 0x24, 0x96, 0xca, 0x17, 0x0b, 0xd5, 0x6f, 0x23,
-	0x60, 0x7b, 0x29, 0x85, 0xff, 0x5b, 0x6e, 0xab, 0xfa, 0x83, 0x06, 0xb6, 0x97, 0xbe, 0xf0, 0x78,
-	0x8f, 0xa8, 0x67, 0xb6, 0xca, 0x84, 0x1a, 0xef, 0x39, 0x29, 0x55, 0x89, 0x08, 0xbc, 0x19, 0x22,
-	0xc1, 0x37, 0xc3, 0x0c, 0x55, 0x47, 0xdf, 0x82, 0xaa, 0x7f, 0x8d, 0x83, 0xf2, 0xe2, 0xc7, 0xdf,
-	0xbb, 0x24, 0x20, 0x5f, 0xea, 0x62, 0xc1, 0x42, 0x9f, 0x1d, 0xec, 0xf1, 0xd0, 0x60, 0x87, 0x9f,
-	0x82, 0xfc, 0xd4, 0x44, 0x1c, 0x3e, 0xb1, 0xe2, 0xe1, 0x73, 0x13, 0x3f, 0xae, 0x81, 0xdb, 0x80,
-	0x67, 0x83, 0x30, 0x19, 0x49, 0xde, 0x61, 0x5a, 0x49, 0x04, 0x4b, 0x66, 0x3d, 0xb5, 0x88, 0x92,
-	0x5a, 0x31, 0x4a, 0x46, 0x79, 0x89, 0x18, 0x0f, 0xc0, 0xba, 0x78, 0x94, 0x74, 0x30, 0x22, 0xec,
-	0x0c, 0x23, 0x26, 0xb1, 0xd2, 0x2b, 0x62, 0x5d, 0xe2, 0xce, 0xc7, 0x9e, 0xaf, 0x40, 0xbc, 0x05,
-	0x92, 0x16, 0x66, 0xc8, 0xee, 0xd2, 0xf9, 0x6d, 0x2c, 0xbf, 0x6f, 0x79, 0x17, 0x3f, 0x40, 0xa3,
-	0xae, 0x8b, 0x2c, 0x6a, 0x78, 0x0e, 0x3c, 0xef, 0x88, 0x71, 0x6b, 0x56, 0xca, 0xc8, 0x72, 0x52,
-	0x4b, 0x7e, 0x58, 0xb1, 0x4f, 0xf5, 0xf1, 0x59, 0xca, 0xce, 0x83, 0x56, 0x4a, 0x8e, 0x7d, 0x57,
-	0xfe, 0x34, 0x32, 0xdc, 0x4b, 0x2d, 0xe0, 0x0d, 0xf0, 0x1f, 0x01, 0xc2, 0x0b, 0x00, 0x13, 0xd3,
-	0xb6, 0xb0, 0xc3, 0x6c, 0x36, 0x2a, 0xe5, 0xc4, 0xdd, 0x43, 0xae, 0x7b, 0x2c, 0x54, 0x0d, 0xa5,
-	0x81, 0x8f, 0x41, 0x41, 0xdd, 0xfc, 0x64, 0x36, 0xe5, 0x45, 0x64, 0x7d, 0x2e, 0x09, 0xfb, 0x46,
-	0x94, 0xe2, 0x06, 0x6f, 0x52, 0xe5, 0x87, 0x81, 0x75, 0xf5, 0xcf, 0x08, 0xd8, 0x5c, 0xf0, 0x8e,
-	0xf7, 0xbf, 0x5c, 0xb4, 0xc0, 0xcb, 0xe5, 0x1d, 0x8e, 0x9d, 0x16, 0xd8, 0x98, 0x39, 0xa8, 0x69,
-	0x33, 0xdc, 0xe3, 0x1f, 0x8d, 0xfc, 0x09, 0x5c, 0xff, 0x7b, 0xc7, 0x6d, 0x30, 0xdc, 0x33, 0xd6,
-	0x87, 0x21, 0x19, 0x85, 0x1f, 0x80, 0x84, 0x98, 0x59, 0xde, 0x17, 0xe0, 0xc2, 0xe2, 0xf8, 0x18,
-	0x31, 0x74, 0xd4, 0x75, 0xcf, 0x0c, 0x65, 0x0f, 0xef, 0x82, 0xbc, 0x47, 0x13, 0x0a, 0x21, 0xb9,
-	0x22, 0x42, 0x56, 0xb2, 0x84, 0x98, 0x8b, 0xf4, 0xc8, 0x7e, 0xfe, 0xb2, 0xb2, 0xf6, 0xe2, 0x65,
-	0x65, 0xed, 0xf5, 0xcb, 0x8a, 0xf6, 0xf5, 0xb8, 0xa2, 0xfd, 0x34, 0xae, 0x68, 0xcf, 0xc6, 0x15,
-	0xed, 0xf9, 0xb8, 0xa2, 0xfd, 0x3e, 0xae, 0x68, 0x7f, 0x8c, 0x2b, 0x6b, 0xaf, 0xc7, 0x15, 0xed,
-	0xc9, 0xab, 0xca, 0xda, 0xf3, 0x57, 0x95, 0xb5, 0x17, 0xaf, 0x2a, 0x6b, 0x9f, 0x1f, 0xb4, 0xdd,
-	0x69, 0x1c, 0xdb, 0x5d, 0xfc, 0x57, 0xd6, 0x6d, 0x82, 0xfb, 0x6a, 0x75, 0x96, 0x10, 0x7d, 0x73,
-	0xf0, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x00, 0xb4, 0x0f, 0x2d, 0x02, 0x13, 0x00, 0x00,
+	// 1654 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe5, 0x58, 0xcd, 0x6f, 0x1b, 0x55,
+	0x10, 0xaf, 0xbf, 0xed, 0xb1, 0x63, 0xbb, 0x2f, 0x0d, 0x49, 0x2d, 0x35, 0x69, 0x4d, 0x4b, 0x5b,
+	0x04, 0x76, 0x9b, 0x1c, 0xfa, 0x85, 0x90, 0x92, 0xd0, 0x92, 0x22, 0xa5, 0x84, 0x6d, 0x68, 0x25,
+	0x2e, 0x66, 0x63, 0x3f, 0xdb, 0xab, 0xd8, 0xbb, 0xd6, 0xbe, 0xb5, 0x43, 0x38, 0x21, 0x71, 0xe0,
+	0x02, 0x52, 0x25, 0xfe, 0x84, 0x72, 0xe0, 0xc4, 0x5f, 0xc0, 0x1f, 0xd0, 0x63, 0x2f, 0x48, 0x85,
+	0x0b, 0xb4, 0x5c, 0x7a, 0xe4, 0xc6, 0x95, 0x79, 0x1f, 0xbb, 0xde, 0xcd, 0xae, 0x5d, 0xab, 0xb4,
+	0xe2, 0xc0, 0x61, 0x9d, 0x7d, 0xf3, 0x66, 0x7e, 0x33, 0x6f, 0xde, 0x7c, 0x6d, 0xe0, 0x92, 0x43,
+	0xfb, 0x03, 0xcb, 0xd6, 0x7b, 0x75, 0x46, 0xed, 0x11, 0xb5, 0xeb, 0xfa, 0xc0, 0xa8, 0xdb, 0x74,
+	0xd0, 0x33, 0x9a, 0xba, 0x63, 0x58, 0x66, 0x7d, 0x74, 0xb9, 0xde, 0xa7, 0x8c, 0xe9, 0x1d, 0x5a,
+	0x1b, 0xd8, 0x96, 0x63, 0x91, 0xaa, 0x2b, 0x51, 0x93, 0x12, 0x35, 0x94, 0xa8, 0xf9, 0x24, 0x6a,
+	0xa3, 0xcb, 0x95, 0x95, 0x8e, 0x65, 0x75, 0x7a, 0xb4, 0x2e, 0x24, 0xf6, 0x86, 0xed, 0xba, 0x63,
+	0x20, 0x88, 0xa3, 0xf7, 0x07, 0x12, 0xa4, 0x72, 0xa6, 0x45, 0x07, 0xd4, 0x6c, 0x51, 0xb3, 0x69,
+	0x50, 0x56, 0xef, 0x58, 0x1d, 0x4b, 0xd0, 0xc5, 0x9b, 0x62, 0xa9, 0x45, 0x59, 0x46, 0xcd, 0x61,
+	0x9f, 0x71, 0x9b, 0xfc, 0x0a, 0x25, 0xff, 0xf9, 0xa9, 0xfc, 0x8e, 0xce, 0xf6, 0x15, 0xe3, 0x3b,
+	0x51, 0x8c, 0x5d, 0x83, 0x39, 0x96, 0x7d, 0x18, 0x3a, 0x6e, 0xe5, 0xac, 0xc7, 0xcd, 0xd9, 0x9a,
+	0x56, 0xbf, 0x1f, 0xe1, 0x14, 0x9f, 0x72, 0xce, 0x65, 0xea, 0xb8, 0x3b, 0xd0, 0x9b, 0x34, 0xcc,
+	0x78, 0x31, 0xc0, 0x38, 0xcd, 0xd1, 0x95, 0x73, 0x01, 0xd6, 0x89, 0x06, 0x06, 0xd9, 0xda, 0xba,
+	0xd1, 0x1b, 0xda, 0x11, 0x8a, 0xdf, 0x8d, 0x3a, 0xf5, 0x81, 0x65, 0xef, 0xb7, 0x7b, 0xd6, 0x41,
+	0x88, 0xbd, 0xfa, 0x73, 0x16, 0x4a, 0xda, 0xd8, 0xba, 0x5d, 0x74, 0x1f, 0xb9, 0x03, 0x39, 0xee,
+	0xc6, 0x86, 0x73, 0x38, 0xa0, 0x4b, 0xb1, 0xd3, 0xb1, 0x0b, 0xc5, 0xd5, 0xcb, 0xb5, 0xa8, 0x68,
+	0x10, 0x5e, 0xc7, 0x38, 0xa8, 0x1d, 0x41, 0xd8, 0x45, 0x41, 0x2d, 0xeb, 0xa8, 0x37, 0x72, 0x16,
+	0x8a, 0xcc, 0x1a, 0xda, 0x4d, 0xda, 0x10, 0xb0, 0x46, 0x6b, 0x29, 0x8e, 0xa0, 0x09, 0xad, 0x20,
+	0xa9, 0x5c, 0xe2, 0x76, 0x8b, 0x1c, 0xc2, 0x49, 0xcf, 0x9f, 0x92, 0x51, 0x77, 0x1c, 0xdb, 0xd8,
+	0x1b, 0x3a, 0x94, 0x2d, 0x25, 0x50, 0x20, 0xbf, 0x7a, 0xa3, 0xf6, 0xe2, 0x98, 0xac, 0xdd, 0x71,
+	0x41, 0x38, 0xee, 0xba, 0x07, 0xb1, 0x75, 0x4c, 0x5b, 0x34, 0xa3, 0xb7, 0x08, 0x83, 0x45, 0xe5,
+	0xf6, 0x90, 0xe2, 0xa4, 0x50, 0x7c, 0x6d, 0x16, 0xc5, 0x5b, 0x12, 0x22, 0xa4, 0x76, 0xa1, 0x1b,
+	0xb5, 0x41, 0xbe, 0x8b, 0xc1, 0x19, 0x76, 0x68, 0x36, 0x1b, 0xac, 0xab, 0xdb, 0xad, 0x06, 0x66,
+	0x8d, 0x33, 0x64, 0x21, 0xfd, 0x29, 0xa1, 0x7f, 0x7d, 0x16, 0xfd, 0x77, 0x11, 0xec, 0x2e, 0xc7,
+	0xba, 0x2b, 0xa0, 0x42, 0x76, 0x9c, 0x62, 0xd3, 0x18, 0xc8, 0xd7, 0x31, 0x10, 0x1c, 0x0d, 0xbd,
+	0xe9, 0x18, 0x23, 0xc3, 0x09, 0xfb, 0x22, 0x2d, 0x6c, 0x79, 0x7f, 0x56, 0x5b, 0xd6, 0x15, 0x4e,
+	0xc8, 0x90, 0x0a, 0x9b, 0xb8, 0x4b, 0xbe, 0x8d, 0xc1, 0x69, 0xf7, 0x2e, 0xfa, 0xd4, 0xd1, 0x5b,
+	0xba, 0xa3, 0x87, 0x0c, 0xc9, 0xcc, 0xee, 0x14, 0x75, 0x29, 0xdb, 0x0a, 0x2a, 0xec, 0x94, 0xee,
+	0x34, 0x06, 0xf2, 0x25, 0x54, 0x02, 0x91, 0x31, 0x5a, 0xf5, 0xdb, 0x91, 0x9d, 0x3d, 0x2a, 0x7d,
+	0xc1, 0x71, 0x6f, 0x35, 0x18, 0x95, 0xdd, 0xe8, 0x2d, 0xf2, 0x7d, 0x0c, 0xde, 0x14, 0x17, 0xe2,
+	0x66, 0xaf, 0x88, 0x91, 0x70, 0x6e, 0xe4, 0x84, 0x15, 0x9b, 0xb3, 0x5e, 0xcb, 0x7d, 0x85, 0xc6,
+	0x83, 0x20, 0x9c, 0x23, 0x2b, 0x6c, 0x3a, 0xcb, 0x46, 0x01, 0x60, 0xac, 0xbb, 0xfa, 0x30, 0x06,
+	0x65, 0x7f, 0xf2, 0x5b, 0xfb, 0xd4, 0x24, 0x27, 0x21, 0x2b, 0x63, 0x1a, 0x33, 0x9d, 0x97, 0x8f,
+	0x94, 0x96, 0x11, 0x6b, 0x4c, 0xf2, 0x6b, 0x70, 0xb2, 0xa7, 0x33, 0xa7, 0x61, 0x53, 0x84, 0xa0,
+	0x23, 0xda, 0x6a, 0xa8, 0x72, 0x34, 0xae, 0x0a, 0x6f, 0x70, 0x06, 0xcd, 0xdd, 0xdf, 0x96, 0xdb,
+	0x3e, 0x51, 0xac, 0x5b, 0x4d, 0x24, 0x06, 0x45, 0x13, 0x63, 0xd1, 0x1d, 0x77, 0xdf, 0x13, 0xad,
+	0xee, 0x42, 0xe9, 0x48, 0x72, 0x90, 0x75, 0xc8, 0xbb, 0x19, 0x87, 0x2d, 0x4b, 0x98, 0x99, 0x5f,
+	0xad, 0xd4, 0x64, 0x3f, 0xab, 0xb9, 0xfd, 0xac, 0xb6, 0xeb, 0xf6, 0xb3, 0x8d, 0xe4, 0x83, 0xdf,
+	0x57, 0x62, 0x1a, 0x48, 0x21, 0x4e, 0xae, 0xfe, 0x14, 0x87, 0x79, 0xdf, 0xd9, 0x95, 0x3a, 0x46,
+	0x3e, 0x87, 0xe3, 0x3e, 0xb7, 0x8b, 0xeb, 0x62, 0xa8, 0x20, 0x81, 0x0a, 0xd6, 0x66, 0xb9, 0xa4,
+	0x23, 0xc5, 0x54, 0x2b, 0xdb, 0x41, 0x02, 0xfb, 0x37, 0x5e, 0xc4, 0xbb, 0xe9, 0xea, 0xac, 0xd1,
+	0xb7, 0x6c, 0x2a, 0x9c, 0x96, 0xd5, 0x32, 0xb8, 0xde, 0xc6, 0x25, 0x69, 0xc0, 0xf1, 0x50, 0x3d,
+	0x52, 0xf5, 0x6f, 0xed, 0x25, 0xea, 0x8f, 0x56, 0x3a, 0x52, 0x6f, 0xaa, 0xbf, 0x04, 0x1d, 0x26,
+	0xea, 0xbe, 0xd9, 0xb6, 0xc8, 0x19, 0x28, 0x8c, 0x2b, 0xbf, 0x8a, 0x99, 0x9c, 0x96, 0xf7, 0x68,
+	0x68, 0xf6, 0x0a, 0xe4, 0xbd, 0x2c, 0x50, 0x67, 0xcc, 0x69, 0xe0, 0x92, 0x90, 0x61, 0x01, 0xd2,
+	0xf6, 0xd0, 0x74, 0x43, 0x21, 0xa7, 0xa5, 0x70, 0x85, 0xe4, 0x4d, 0x7f, 0x2b, 0x4b, 0x8a, 0x56,
+	0xf6, 0xd6, 0xf4, 0x56, 0x16, 0xd1, 0xbf, 0x16, 0x21, 0xe3, 0x36, 0xae, 0x94, 0x70, 0x6e, 0xda,
+	0x91, 0x2d, 0x6b, 0x09, 0x32, 0x28, 0xcf, 0xf0, 0x2c, 0xa2, 0x36, 0x26, 0x34, 0x77, 0xc9, 0x5b,
+	0x5e, 0xdb, 0xb0, 0xf1, 0x8a, 0xd0, 0xfb, 0xa6, 0xc3, 0x25, 0x33, 0xb2, 0xe5, 0x09, 0xea, 0x4d,
+	0x4e, 0x44, 0xf9, 0x2a, 0xcc, 0x99, 0xf4, 0x0b, 0x1f, 0x53, 0x56, 0x30, 0xe5, 0x39, 0xd1, 0xe5,
+	0x41, 0xe7, 0xb0, 0x66, 0x97, 0xb6, 0x86, 0x3d, 0x2a, 0x12, 0x2a, 0x27, 0x59, 0x3c, 0x1a, 0x86,
+	0xf7, 0xa3, 0x04, 0x2c, 0x4e, 0xe8, 0x7a, 0x44, 0x87, 0xf9, 0xb1, 0x6f, 0xad, 0x01, 0xb5, 0x85,
+	0xeb, 0x55, 0x57, 0xbf, 0x34, 0xdd, 0x15, 0x1e, 0xe6, 0xc7, 0xae, 0x9c, 0x46, 0xcc, 0x10, 0x8d,
+	0x14, 0x21, 0xee, 0x5d, 0x09, 0xbe, 0x91, 0xf7, 0x20, 0x69, 0xe0, 0xb5, 0xaa, 0x9e, 0x7d, 0x61,
+	0xac, 0x83, 0x83, 0x7b, 0xf2, 0x01, 0x05, 0x3c, 0x0c, 0x34, 0x21, 0x45, 0x36, 0x20, 0xdd, 0xb4,
+	0xcc, 0xb6, 0xd1, 0x51, 0xa1, 0xf7, 0xf6, 0x2c, 0xf2, 0x9b, 0x42, 0x42, 0x53, 0x92, 0xa4, 0x0d,
+	0xc4, 0x9f, 0x81, 0x0a, 0x4f, 0xb6, 0xd2, 0x2b, 0x41, 0xbc, 0x49, 0xc3, 0x83, 0x2f, 0x4e, 0x15,
+	0xb8, 0x3f, 0xa9, 0x25, 0x89, 0x9c, 0x83, 0xa2, 0xc4, 0x6e, 0x04, 0xc3, 0x60, 0x4e, 0x52, 0xef,
+	0xa9, 0x60, 0xb8, 0x08, 0x65, 0x3e, 0xae, 0x59, 0xc8, 0xe4, 0x31, 0xca, 0x70, 0x28, 0xb9, 0x74,
+	0xc5, 0x5a, 0x7d, 0x98, 0x80, 0x85, 0xc8, 0x39, 0x82, 0x9c, 0x87, 0x92, 0xa3, 0xdb, 0x1d, 0xea,
+	0x34, 0x9a, 0xbd, 0x21, 0x73, 0x90, 0x5f, 0xd4, 0x94, 0x9c, 0x56, 0x94, 0xe4, 0x4d, 0x45, 0x0d,
+	0x65, 0x53, 0xfc, 0x85, 0xd9, 0x94, 0x98, 0x92, 0x4d, 0x49, 0x7f, 0x36, 0x85, 0xa3, 0x3a, 0x35,
+	0x4b, 0x54, 0xa7, 0xc3, 0x51, 0xed, 0xcb, 0x9c, 0x4c, 0x30, 0x73, 0xae, 0x43, 0x46, 0x35, 0x44,
+	0xd5, 0xd8, 0x4e, 0x07, 0x2f, 0x4c, 0x6d, 0xfa, 0x7a, 0xaa, 0xe6, 0x0a, 0x90, 0x2d, 0x28, 0x99,
+	0xf4, 0xa0, 0xc1, 0x4d, 0x77, 0x31, 0x60, 0x46, 0x0c, 0x34, 0xf9, 0x40, 0x1b, 0x9a, 0x6a, 0xf9,
+	0x51, 0x32, 0x9b, 0x2d, 0xe7, 0xf0, 0x37, 0x5f, 0x2e, 0xe0, 0x6f, 0xa1, 0x3c, 0x87, 0xbf, 0x73,
+	0xe5, 0x22, 0xfe, 0x16, 0xcb, 0xa5, 0xea, 0x37, 0x71, 0x38, 0x35, 0x75, 0xb0, 0xf8, 0xbf, 0xdc,
+	0x56, 0xf5, 0x07, 0x1c, 0x1a, 0xa7, 0xce, 0x9d, 0x3c, 0x47, 0xd4, 0xf0, 0xaf, 0x3c, 0xa1, 0xca,
+	0xfb, 0x9c, 0xa4, 0x2a, 0x47, 0x04, 0x66, 0x86, 0x78, 0x70, 0x66, 0x38, 0xd2, 0xaa, 0x13, 0x2f,
+	0xd1, 0xaa, 0x7f, 0x4d, 0x41, 0x65, 0xf2, 0x48, 0xfa, 0x3a, 0x1b, 0x90, 0xcf, 0x75, 0xc9, 0x60,
+	0xa0, 0x1f, 0x2d, 0xec, 0xa9, 0x50, 0x61, 0x27, 0x1f, 0xa2, 0xef, 0x3c, 0x16, 0x71, 0xf8, 0xf4,
+	0x8c, 0x87, 0x9f, 0xf3, 0xe4, 0xf8, 0x0e, 0x39, 0x05, 0xdc, 0x1b, 0xb6, 0x23, 0x35, 0xc9, 0x3b,
+	0xcc, 0x29, 0x8a, 0xe8, 0x92, 0x05, 0x77, 0x5b, 0x68, 0xc9, 0xce, 0xa8, 0x25, 0xaf, 0xa4, 0x84,
+	0x8e, 0x1d, 0x98, 0x17, 0x43, 0x49, 0x97, 0x22, 0x6d, 0x8f, 0xea, 0x8e, 0xc4, 0xca, 0xcd, 0x88,
+	0x75, 0x9c, 0x0b, 0x6f, 0xb9, 0xb2, 0x02, 0x11, 0x4b, 0x41, 0x0b, 0xd3, 0xcb, 0xe8, 0xb1, 0xe8,
+	0x34, 0x96, 0x1f, 0xe9, 0x3c, 0x8b, 0x77, 0xf4, 0xc3, 0x9e, 0xa5, 0xb7, 0x98, 0xe6, 0x0a, 0x70,
+	0xbf, 0xe3, 0x98, 0x8a, 0xdc, 0xce, 0x52, 0x5e, 0x86, 0x93, 0x5a, 0xf2, 0xc3, 0x0a, 0x3b, 0xd5,
+	0x17, 0xf4, 0x52, 0x21, 0x0a, 0x5a, 0x6d, 0x72, 0xec, 0x5b, 0xf2, 0x55, 0xcb, 0x73, 0x29, 0xb5,
+	0x20, 0x97, 0xe0, 0x84, 0x00, 0xe1, 0x01, 0x80, 0x55, 0xdd, 0x68, 0x61, 0xa2, 0x60, 0x5c, 0x2d,
+	0xcd, 0x89, 0xbb, 0x27, 0x7c, 0xef, 0xbe, 0xd8, 0xba, 0xad, 0x76, 0xc8, 0x7d, 0x28, 0xa9, 0x9b,
+	0xf7, 0x6a, 0x53, 0x51, 0x68, 0xae, 0x45, 0x36, 0x61, 0x5f, 0x89, 0x52, 0xbd, 0xc1, 0xad, 0x54,
+	0xc5, 0x51, 0x60, 0x5d, 0xfd, 0x2d, 0x09, 0x2b, 0x2f, 0x98, 0xeb, 0xff, 0x9b, 0x00, 0x8f, 0x38,
+	0x71, 0xea, 0x55, 0x9c, 0x98, 0x7c, 0x02, 0x30, 0xd0, 0x87, 0x0c, 0x4f, 0xc2, 0xc7, 0x0c, 0x99,
+	0x12, 0xab, 0x91, 0x98, 0xae, 0xf9, 0x1c, 0xd4, 0xf5, 0xcf, 0x0e, 0x17, 0x15, 0x03, 0x47, 0x6e,
+	0xe0, 0xbe, 0xa2, 0xad, 0x8b, 0x12, 0x52, 0x7e, 0x62, 0x0d, 0x07, 0x2d, 0xf1, 0xa5, 0xc5, 0x03,
+	0x38, 0x33, 0x63, 0x00, 0x9f, 0x10, 0x00, 0xc2, 0xfb, 0x9f, 0x0a, 0x71, 0x11, 0xc3, 0x3a, 0x24,
+	0x1d, 0xbd, 0xc3, 0x3f, 0x15, 0xf9, 0xfc, 0xbf, 0xfd, 0x0a, 0x3e, 0xd2, 0x70, 0x42, 0xed, 0xb0,
+	0x9b, 0xa6, 0x83, 0x8e, 0x11, 0xd0, 0xfc, 0x72, 0xf9, 0x5f, 0x6f, 0xb4, 0x50, 0x13, 0x22, 0xa7,
+	0x29, 0x47, 0x56, 0xae, 0x40, 0xce, 0x93, 0x22, 0x65, 0x48, 0xec, 0xd3, 0x43, 0x15, 0x03, 0xfc,
+	0x95, 0x9c, 0x80, 0xd4, 0x48, 0xef, 0x0d, 0xa9, 0xba, 0x75, 0xb9, 0xb8, 0x1e, 0xbf, 0x1a, 0xab,
+	0xfe, 0x1d, 0x87, 0xc5, 0x09, 0x9f, 0xae, 0xfe, 0xb1, 0x38, 0x16, 0x18, 0x8b, 0x5f, 0x63, 0x4f,
+	0x6b, 0xc3, 0xc2, 0x91, 0x98, 0x6a, 0x18, 0xe8, 0x52, 0xfe, 0x7f, 0x92, 0xc4, 0xc4, 0x28, 0x98,
+	0x18, 0x59, 0xb7, 0x91, 0x5b, 0x9b, 0x1f, 0x85, 0x68, 0x8c, 0x5c, 0x85, 0xb4, 0x68, 0x88, 0xee,
+	0x3f, 0x3d, 0x26, 0x56, 0x9e, 0x0f, 0xb0, 0xf7, 0x6f, 0xf4, 0xac, 0x3d, 0x4d, 0xf1, 0x93, 0x5b,
+	0x50, 0x74, 0x67, 0x10, 0x85, 0x90, 0x99, 0x11, 0xa1, 0x20, 0x47, 0x10, 0xd1, 0x74, 0xd9, 0x86,
+	0xf1, 0xf8, 0xe9, 0xf2, 0xb1, 0x27, 0xf8, 0xfc, 0xf5, 0x74, 0x39, 0xf6, 0xd5, 0xb3, 0xe5, 0xd8,
+	0x8f, 0xf8, 0x3c, 0xc2, 0xe7, 0x31, 0x3e, 0x7f, 0xe0, 0xf3, 0xfc, 0x19, 0xee, 0xe1, 0xdf, 0x07,
+	0x7f, 0x2e, 0x1f, 0x7b, 0x8c, 0xcf, 0x13, 0x7c, 0x3e, 0x5b, 0xeb, 0x58, 0x63, 0x3d, 0x86, 0x35,
+	0xf9, 0x9f, 0xbd, 0x37, 0x70, 0xa9, 0x56, 0x7b, 0x69, 0x11, 0xd3, 0x6b, 0xff, 0x00, 0xd4, 0x4b,
+	0x24, 0x05, 0x24, 0x16, 0x00, 0x00,
 }
 
 func (this *ReplicationTask) Equal(that interface{}) bool {
@@ -1379,6 +1514,30 @@ func (this *ReplicationTask_HistoryTaskV2Attributes) Equal(that interface{}) boo
 	}
 	return true
 }
+func (this *ReplicationTask_SyncWorkflowStateTaskAttributes) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReplicationTask_SyncWorkflowStateTaskAttributes)
+	if !ok {
+		that2, ok := that.(ReplicationTask_SyncWorkflowStateTaskAttributes)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.SyncWorkflowStateTaskAttributes.Equal(that1.SyncWorkflowStateTaskAttributes) {
+		return false
+	}
+	return true
+}
 func (this *ReplicationToken) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1774,6 +1933,63 @@ func (this *SyncActivityTaskAttributes) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *SyncWorkflowStateTaskAttributes) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SyncWorkflowStateTaskAttributes)
+	if !ok {
+		that2, ok := that.(SyncWorkflowStateTaskAttributes)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if this.WorkflowId != that1.WorkflowId {
+		return false
+	}
+	if this.RunId != that1.RunId {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if !this.VersionHistory.Equal(that1.VersionHistory) {
+		return false
+	}
+	if !this.PauseInfo.Equal(that1.PauseInfo) {
+		return false
+	}
+	if that1.PauseStateUpdateTime == nil {
+		if this.PauseStateUpdateTime != nil {
+			return false
+		}
+	} else if !this.PauseStateUpdateTime.Equal(*that1.PauseStateUpdateTime) {
+		return false
+	}
+	if len(this.Tags) != len(that1.Tags) {
+		return false
+	}
+	for i := range this.Tags {
+		if this.Tags[i] != that1.Tags[i] {
+			return false
+		}
+	}
+	if this.TagsVersion != that1.TagsVersion {
+		return false
+	}
+	return true
+}
 func (this *HistoryTaskV2Attributes) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1825,7 +2041,7 @@ func (this *ReplicationTask) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 12)
+	s := make([]string, 0, 13)
 	s = append(s, "&repication.ReplicationTask{")
 	s = append(s, "TaskType: "+fmt.Sprintf("%#v", this.TaskType)+",\n")
 	s = append(s, "SourceTaskId: "+fmt.Sprintf("%#v", this.SourceTaskId)+",\n")
@@ -1883,6 +2099,14 @@ func (this *ReplicationTask_HistoryTaskV2Attributes) GoString() string {
 		`HistoryTaskV2Attributes:` + fmt.Sprintf("%#v", this.HistoryTaskV2Attributes) + `}`}, ", ")
 	return s
 }
+func (this *ReplicationTask_SyncWorkflowStateTaskAttributes) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&repication.ReplicationTask_SyncWorkflowStateTaskAttributes{` +
+		`SyncWorkflowStateTaskAttributes:` + fmt.Sprintf("%#v", this.SyncWorkflowStateTaskAttributes) + `}`}, ", ")
+	return s
+}
 func (this *ReplicationToken) GoString() string {
 	if this == nil {
 		return "nil"
@@ -2041,6 +2265,40 @@ func (this *SyncActivityTaskAttributes) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *SyncWorkflowStateTaskAttributes) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 13)
+	s = append(s, "&repication.SyncWorkflowStateTaskAttributes{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
+	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	if this.VersionHistory != nil {
+		s = append(s, "VersionHistory: "+fmt.Sprintf("%#v", this.VersionHistory)+",\n")
+	}
+	if this.PauseInfo != nil {
+		s = append(s, "PauseInfo: "+fmt.Sprintf("%#v", this.PauseInfo)+",\n")
+	}
+	s = append(s, "PauseStateUpdateTime: "+fmt.Sprintf("%#v", this.PauseStateUpdateTime)+",\n")
+	keysForTags := make([]string, 0, len(this.Tags))
+	for k, _ := range this.Tags {
+		keysForTags = append(keysForTags, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForTags)
+	mapStringForTags := "map[string]string{"
+	for _, k := range keysForTags {
+		mapStringForTags += fmt.Sprintf("%#v: %#v,", k, this.Tags[k])
+	}
+	mapStringForTags += "}"
+	if this.Tags != nil {
+		s = append(s, "Tags: "+mapStringForTags+",\n")
+	}
+	s = append(s, "TagsVersion: "+fmt.Sprintf("%#v", this.TagsVersion)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *HistoryTaskV2Attributes) GoString() string {
 	if this == nil {
 		return "nil"
@@ -2239,6 +2497,27 @@ func (m *ReplicationTask_HistoryTaskV2Attributes) MarshalToSizedBuffer(dAtA []by
 	}
 	return len(dAtA) - i, nil
 }
+func (m *ReplicationTask_SyncWorkflowStateTaskAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicationTask_SyncWorkflowStateTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SyncWorkflowStateTaskAttributes != nil {
+		{
+			size, err := m.SyncWorkflowStateTaskAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *ReplicationToken) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2298,12 +2577,12 @@ func (m *SyncShardStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.StatusTime != nil {
-		n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StatusTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StatusTime):])
-		if err7 != nil {
-			return 0, err7
+		n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StatusTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StatusTime):])
+		if err8 != nil {
+			return 0, err8
 		}
-		i -= n7
-		i = encodeVarintMessage(dAtA, i, uint64(n7))
+		i -= n8
+		i = encodeVarintMessage(dAtA, i, uint64(n8))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -2710,12 +2989,12 @@ func (m *SyncShardStatusTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int,
 	var l int
 	_ = l
 	if m.StatusTime != nil {
-		n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StatusTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StatusTime):])
-		if err14 != nil {
-			return 0, err14
+		n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StatusTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StatusTime):])
+		if err15 != nil {
+			return 0, err15
 		}
-		i -= n14
-		i = encodeVarintMessage(dAtA, i, uint64(n14))
+		i -= n15
+		i = encodeVarintMessage(dAtA, i, uint64(n15))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -2803,22 +3082,22 @@ func (m *SyncActivityTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, err
 		dAtA[i] = 0x52
 	}
 	if m.LastHeartbeatTime != nil {
-		n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatTime):])
-		if err18 != nil {
-			return 0, err18
+		n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatTime):])
+		if err19 != nil {
+			return 0, err19
 		}
-		i -= n18
-		i = encodeVarintMessage(dAtA, i, uint64(n18))
+		i -= n19
+		i = encodeVarintMessage(dAtA, i, uint64(n19))
 		i--
 		dAtA[i] = 0x4a
 	}
 	if m.StartedTime != nil {
-		n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
-		if err19 != nil {
-			return 0, err19
+		n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
+		if err20 != nil {
+			return 0, err20
 		}
-		i -= n19
-		i = encodeVarintMessage(dAtA, i, uint64(n19))
+		i -= n20
+		i = encodeVarintMessage(dAtA, i, uint64(n20))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -2828,12 +3107,12 @@ func (m *SyncActivityTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, err
 		dAtA[i] = 0x38
 	}
 	if m.ScheduledTime != nil {
-		n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
-		if err20 != nil {
-			return 0, err20
+		n21, err21 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
+		if err21 != nil {
+			return 0, err21
 		}
-		i -= n20
-		i = encodeVarintMessage(dAtA, i, uint64(n20))
+		i -= n21
+		i = encodeVarintMessage(dAtA, i, uint64(n21))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -2871,7 +3150,7 @@ func (m *SyncActivityTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *HistoryTaskV2Attributes) Marshal() (dAtA []byte, err error) {
+func (m *SyncWorkflowStateTaskAttributes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2881,19 +3160,53 @@ func (m *HistoryTaskV2Attributes) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HistoryTaskV2Attributes) MarshalTo(dAtA []byte) (int, error) {
+func (m *SyncWorkflowStateTaskAttributes) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HistoryTaskV2Attributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SyncWorkflowStateTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.NewRunEvents != nil {
+	if m.TagsVersion != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.TagsVersion))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Tags) > 0 {
+		for k := range m.Tags {
+			v := m.Tags[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintMessage(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintMessage(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintMessage(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.PauseStateUpdateTime != nil {
+		n22, err22 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.PauseStateUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.PauseStateUpdateTime):])
+		if err22 != nil {
+			return 0, err22
+		}
+		i -= n22
+		i = encodeVarintMessage(dAtA, i, uint64(n22))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.PauseInfo != nil {
 		{
-			size, err := m.NewRunEvents.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.PauseInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2901,11 +3214,11 @@ func (m *HistoryTaskV2Attributes) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintMessage(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x32
 	}
-	if m.Events != nil {
+	if m.VersionHistory != nil {
 		{
-			size, err := m.Events.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.VersionHistory.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2913,52 +3226,125 @@ func (m *HistoryTaskV2Attributes) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintMessage(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x2a
 	}
-	if len(m.VersionHistoryItems) > 0 {
-		for iNdEx := len(m.VersionHistoryItems) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.VersionHistoryItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMessage(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
+	if m.Version != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x20
 	}
 	if len(m.RunId) > 0 {
 		i -= len(m.RunId)
 		copy(dAtA[i:], m.RunId)
 		i = encodeVarintMessage(dAtA, i, uint64(len(m.RunId)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x1a
 	}
 	if len(m.WorkflowId) > 0 {
 		i -= len(m.WorkflowId)
 		copy(dAtA[i:], m.WorkflowId)
 		i = encodeVarintMessage(dAtA, i, uint64(len(m.WorkflowId)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x12
 	}
 	if len(m.NamespaceId) > 0 {
 		i -= len(m.NamespaceId)
 		copy(dAtA[i:], m.NamespaceId)
 		i = encodeVarintMessage(dAtA, i, uint64(len(m.NamespaceId)))
 		i--
-		dAtA[i] = 0x12
-	}
-	if m.TaskId != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.TaskId))
-		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
+func (m *HistoryTaskV2Attributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoryTaskV2Attributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryTaskV2Attributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewRunEvents != nil {
+		{
+			size, err := m.NewRunEvents.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Events != nil {
+		{
+			size, err := m.Events.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.VersionHistoryItems) > 0 {
+		for iNdEx := len(m.VersionHistoryItems) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VersionHistoryItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.RunId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.WorkflowId) > 0 {
+		i -= len(m.WorkflowId)
+		copy(dAtA[i:], m.WorkflowId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.WorkflowId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TaskId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMessage(v)
 	base := offset
 	for v >= 1<<7 {
@@ -3059,6 +3445,18 @@ func (m *ReplicationTask_HistoryTaskV2Attributes) Size() (n int) {
 	}
 	return n
 }
+func (m *ReplicationTask_SyncWorkflowStateTaskAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SyncWorkflowStateTaskAttributes != nil {
+		l = m.SyncWorkflowStateTaskAttributes.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
 func (m *ReplicationToken) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3349,6 +3747,53 @@ func (m *SyncActivityTaskAttributes) Size() (n int) {
 	return n
 }
 
+func (m *SyncWorkflowStateTaskAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovMessage(uint64(m.Version))
+	}
+	if m.VersionHistory != nil {
+		l = m.VersionHistory.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.PauseInfo != nil {
+		l = m.PauseInfo.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.PauseStateUpdateTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.PauseStateUpdateTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for k, v := range m.Tags {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovMessage(uint64(len(k))) + 1 + len(v) + sovMessage(uint64(len(v)))
+			n += mapEntrySize + 1 + sovMessage(uint64(mapEntrySize))
+		}
+	}
+	if m.TagsVersion != 0 {
+		n += 1 + sovMessage(uint64(m.TagsVersion))
+	}
+	return n
+}
+
 func (m *HistoryTaskV2Attributes) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3465,6 +3910,16 @@ func (this *ReplicationTask_HistoryTaskV2Attributes) String() string {
 	}, "")
 	return s
 }
+func (this *ReplicationTask_SyncWorkflowStateTaskAttributes) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReplicationTask_SyncWorkflowStateTaskAttributes{`,
+		`SyncWorkflowStateTaskAttributes:` + strings.Replace(fmt.Sprintf("%v", this.SyncWorkflowStateTaskAttributes), "SyncWorkflowStateTaskAttributes", "SyncWorkflowStateTaskAttributes", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ReplicationToken) String() string {
 	if this == nil {
 		return "nil"
@@ -3608,6 +4063,34 @@ func (this *SyncActivityTaskAttributes) String() string {
 	}, "")
 	return s
 }
+func (this *SyncWorkflowStateTaskAttributes) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForTags := make([]string, 0, len(this.Tags))
+	for k, _ := range this.Tags {
+		keysForTags = append(keysForTags, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForTags)
+	mapStringForTags := "map[string]string{"
+	for _, k := range keysForTags {
+		mapStringForTags += fmt.Sprintf("%v: %v,", k, this.Tags[k])
+	}
+	mapStringForTags += "}"
+	s := strings.Join([]string{`&SyncWorkflowStateTaskAttributes{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
+		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`VersionHistory:` + strings.Replace(fmt.Sprintf("%v", this.VersionHistory), "VersionHistory", "v16.VersionHistory", 1) + `,`,
+		`PauseInfo:` + strings.Replace(fmt.Sprintf("%v", this.PauseInfo), "WorkflowPauseInfo", "v17.WorkflowPauseInfo", 1) + `,`,
+		`PauseStateUpdateTime:` + strings.Replace(fmt.Sprintf("%v", this.PauseStateUpdateTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Tags:` + mapStringForTags + `,`,
+		`TagsVersion:` + fmt.Sprintf("%v", this.TagsVersion) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *HistoryTaskV2Attributes) String() string {
 	if this == nil {
 		return "nil"
@@ -3914,6 +4397,41 @@ func (m *ReplicationTask) Unmarshal(dAtA []byte) error {
 			}
 			m.Attributes = &ReplicationTask_HistoryTaskV2Attributes{v}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncWorkflowStateTaskAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SyncWorkflowStateTaskAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &ReplicationTask_SyncWorkflowStateTaskAttributes{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -5973,6 +6491,428 @@ func (m *SyncActivityTaskAttributes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SyncWorkflowStateTaskAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncWorkflowStateTaskAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncWorkflowStateTaskAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VersionHistory == nil {
+				m.VersionHistory = &v16.VersionHistory{}
+			}
+			if err := m.VersionHistory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PauseInfo == nil {
+				m.PauseInfo = &v17.WorkflowPauseInfo{}
+			}
+			if err := m.PauseInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseStateUpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PauseStateUpdateTime == nil {
+				m.PauseStateUpdateTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.PauseStateUpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tags == nil {
+				m.Tags = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthMessage
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipMessage(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Tags[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TagsVersion", wireType)
+			}
+			m.TagsVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TagsVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *HistoryTaskV2Attributes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
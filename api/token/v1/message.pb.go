@@ -407,11 +407,63 @@ func (m *QueryTask) GetTaskId() string {
 	return ""
 }
 
+type WorkflowExecutionChainContinuation struct {
+	RunId       string `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	ForwardWalk bool   `protobuf:"varint,2,opt,name=forward_walk,json=forwardWalk,proto3" json:"forward_walk,omitempty"`
+}
+
+func (m *WorkflowExecutionChainContinuation) Reset()      { *m = WorkflowExecutionChainContinuation{} }
+func (*WorkflowExecutionChainContinuation) ProtoMessage() {}
+func (*WorkflowExecutionChainContinuation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_020fff7d28118bec, []int{4}
+}
+func (m *WorkflowExecutionChainContinuation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowExecutionChainContinuation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowExecutionChainContinuation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowExecutionChainContinuation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowExecutionChainContinuation.Merge(m, src)
+}
+func (m *WorkflowExecutionChainContinuation) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowExecutionChainContinuation) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowExecutionChainContinuation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowExecutionChainContinuation proto.InternalMessageInfo
+
+func (m *WorkflowExecutionChainContinuation) GetRunId() string {
+	if m != nil {
+		return m.RunId
+	}
+	return ""
+}
+
+func (m *WorkflowExecutionChainContinuation) GetForwardWalk() bool {
+	if m != nil {
+		return m.ForwardWalk
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*HistoryContinuation)(nil), "temporal.server.api.token.v1.HistoryContinuation")
 	proto.RegisterType((*RawHistoryContinuation)(nil), "temporal.server.api.token.v1.RawHistoryContinuation")
 	proto.RegisterType((*Task)(nil), "temporal.server.api.token.v1.Task")
 	proto.RegisterType((*QueryTask)(nil), "temporal.server.api.token.v1.QueryTask")
+	proto.RegisterType((*WorkflowExecutionChainContinuation)(nil), "temporal.server.api.token.v1.WorkflowExecutionChainContinuation")
 }
 
 func init() {
@@ -419,49 +471,50 @@ func init() {
 }
 
 var fileDescriptor_020fff7d28118bec = []byte{
-	// 665 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x41, 0x6f, 0xd3, 0x3c,
-	0x1c, 0xc6, 0x9b, 0x75, 0xeb, 0x9a, 0x7f, 0xbb, 0x77, 0x6d, 0xa6, 0xbd, 0xab, 0xd0, 0xc8, 0x4a,
-	0xe1, 0x50, 0x06, 0x4a, 0x19, 0x9c, 0x10, 0x27, 0x40, 0x48, 0x0b, 0xb7, 0x45, 0x15, 0x48, 0x48,
-	0x10, 0x79, 0x8d, 0xbb, 0x59, 0xdd, 0x9c, 0xcc, 0x76, 0x32, 0x7a, 0xe3, 0x23, 0x70, 0xe4, 0xc4,
-	0x99, 0x8f, 0xc2, 0x71, 0xc7, 0x1d, 0x59, 0x76, 0xe1, 0xc6, 0x3e, 0x02, 0xb2, 0x13, 0x27, 0xd5,
-	0x28, 0x02, 0x71, 0x6b, 0x9f, 0xff, 0x93, 0xc7, 0xf6, 0xf3, 0x93, 0x0d, 0xdb, 0x02, 0x1f, 0x47,
-	0x21, 0x43, 0x47, 0x03, 0x8e, 0x59, 0x82, 0xd9, 0x00, 0x45, 0x64, 0x20, 0xc2, 0x09, 0xa6, 0x83,
-	0x64, 0x67, 0x70, 0x8c, 0x39, 0x47, 0x07, 0xd8, 0x89, 0x58, 0x28, 0x42, 0x6b, 0x53, 0x7b, 0x9d,
-	0xcc, 0xeb, 0xa0, 0x88, 0x38, 0xca, 0xeb, 0x24, 0x3b, 0x37, 0xee, 0xcf, 0x4b, 0x3a, 0x24, 0x5c,
-	0x84, 0x6c, 0xfa, 0x4b, 0x56, 0xef, 0xc7, 0x02, 0xac, 0xed, 0x66, 0xc3, 0xe7, 0x21, 0x15, 0x84,
-	0xc6, 0x48, 0x90, 0x90, 0x5a, 0xeb, 0x50, 0x63, 0x31, 0xf5, 0x49, 0xd0, 0x31, 0xba, 0x46, 0xdf,
-	0xf4, 0x96, 0x58, 0x4c, 0xdd, 0xc0, 0xba, 0x03, 0xff, 0x8d, 0x09, 0xe3, 0xc2, 0xc7, 0x09, 0xa6,
-	0x42, 0x8e, 0x17, 0xba, 0x46, 0xbf, 0xea, 0x35, 0x95, 0xfa, 0x42, 0x8a, 0x6e, 0x60, 0xf5, 0x60,
-	0x85, 0xe2, 0xf7, 0x33, 0xa6, 0xaa, 0x32, 0x35, 0xa4, 0xa8, 0x3d, 0x0e, 0xac, 0x11, 0xee, 0x9f,
-	0x86, 0x6c, 0x32, 0x3e, 0x0a, 0x4f, 0x7d, 0x16, 0x53, 0x4a, 0xe8, 0x41, 0x67, 0xa9, 0x6b, 0xf4,
-	0xeb, 0x5e, 0x9b, 0xf0, 0xd7, 0xf9, 0xc4, 0xcb, 0x06, 0xd6, 0x3d, 0x68, 0x47, 0x98, 0x71, 0xc2,
-	0x05, 0xa6, 0x23, 0xec, 0xab, 0xe3, 0x76, 0x6a, 0x5d, 0xa3, 0xdf, 0xf4, 0x5a, 0x33, 0x83, 0xa1,
-	0xd4, 0xad, 0x13, 0xd8, 0x10, 0x0c, 0x51, 0x4e, 0xe4, 0xfa, 0xc5, 0x1a, 0x02, 0xf1, 0x49, 0x67,
-	0xb9, 0x6b, 0xf4, 0x1b, 0x0f, 0x1f, 0x3b, 0xf3, 0x3a, 0xcc, 0x5b, 0x72, 0x92, 0x1d, 0x67, 0xa8,
-	0x3f, 0xd7, 0xfb, 0x18, 0x22, 0x3e, 0x71, 0xe9, 0x38, 0xf4, 0xd6, 0xc5, 0xbc, 0x91, 0x75, 0x0b,
-	0x9a, 0xfb, 0x0c, 0xd1, 0xd1, 0x61, 0xbe, 0xb5, 0xba, 0xda, 0x5a, 0x23, 0xd3, 0xd4, 0xae, 0x5e,
-	0x2e, 0xd6, 0xcd, 0x16, 0xf4, 0x3e, 0x57, 0xe1, 0x7f, 0x0f, 0x9d, 0xce, 0x2b, 0x7d, 0x13, 0x4c,
-	0x8a, 0x8e, 0x31, 0x8f, 0xd0, 0x08, 0xe7, 0xbd, 0x97, 0x82, 0xb5, 0x05, 0x8d, 0xe2, 0x28, 0x79,
-	0xf1, 0xa6, 0x07, 0x5a, 0x72, 0x83, 0x19, 0x66, 0xd5, 0x6b, 0xcc, 0xb8, 0x40, 0x6c, 0x06, 0xc7,
-	0x62, 0xc6, 0x4c, 0xa9, 0x33, 0x3c, 0x66, 0x5d, 0x89, 0xac, 0x34, 0xa4, 0x8a, 0x47, 0xd5, 0x6b,
-	0x97, 0xd6, 0x57, 0xd9, 0xc0, 0xea, 0x42, 0x13, 0xd3, 0xa0, 0xcc, 0xac, 0x29, 0x23, 0x60, 0x1a,
-	0xe8, 0xc4, 0x6d, 0x68, 0x97, 0x0e, 0x9d, 0xb7, 0xac, 0x6c, 0xab, 0xda, 0xa6, 0xd3, 0xe6, 0xd2,
-	0xad, 0xff, 0x86, 0xee, 0x5b, 0x68, 0xe7, 0x71, 0x7e, 0x46, 0x8c, 0x60, 0xde, 0x31, 0x15, 0xd7,
-	0x07, 0x7f, 0xe2, 0x9a, 0x2f, 0xb8, 0xab, 0xbf, 0xf3, 0x5a, 0xc9, 0x35, 0xa5, 0xf7, 0x69, 0x01,
-	0x16, 0x35, 0xd2, 0xa2, 0xfd, 0xf2, 0x26, 0x34, 0x0a, 0xcd, 0x0d, 0xfe, 0x99, 0xc9, 0x16, 0x34,
-	0xf8, 0xe8, 0x10, 0x07, 0xf1, 0x11, 0x2e, 0x81, 0x80, 0x96, 0xdc, 0xc0, 0xba, 0x0b, 0xad, 0xc2,
-	0x80, 0x84, 0x3c, 0x94, 0x50, 0x2c, 0x96, 0xbc, 0x55, 0xad, 0x3f, 0xcd, 0x64, 0x99, 0x85, 0x46,
-	0x82, 0x24, 0x44, 0x4c, 0x35, 0x08, 0xd3, 0x03, 0x2d, 0xb9, 0x81, 0x75, 0x1b, 0x56, 0xca, 0x3b,
-	0x30, 0x8d, 0xb0, 0x82, 0x60, 0x7a, 0x4d, 0x2d, 0x0e, 0xa7, 0x11, 0x96, 0xa6, 0x22, 0x45, 0x99,
-	0xea, 0x99, 0x49, 0x8b, 0xd2, 0xd4, 0x1b, 0x83, 0xb9, 0x17, 0x63, 0x36, 0xfd, 0xdb, 0x7a, 0x6e,
-	0x02, 0xc8, 0x4b, 0xe7, 0x9f, 0xc4, 0x38, 0xc6, 0x79, 0x3b, 0xa6, 0x54, 0xf6, 0xa4, 0x60, 0x6d,
-	0xc0, 0xb2, 0x1a, 0x17, 0xed, 0xd4, 0xe4, 0x5f, 0x37, 0x78, 0xf6, 0xee, 0xec, 0xc2, 0xae, 0x9c,
-	0x5f, 0xd8, 0x95, 0xab, 0x0b, 0xdb, 0xf8, 0x90, 0xda, 0xc6, 0x97, 0xd4, 0x36, 0xbe, 0xa6, 0xb6,
-	0x71, 0x96, 0xda, 0xc6, 0xb7, 0xd4, 0x36, 0xbe, 0xa7, 0x76, 0xe5, 0x2a, 0xb5, 0x8d, 0x8f, 0x97,
-	0x76, 0xe5, 0xec, 0xd2, 0xae, 0x9c, 0x5f, 0xda, 0x95, 0x37, 0xfd, 0x83, 0xb0, 0xc4, 0x4f, 0xc2,
-	0x79, 0x2f, 0xe9, 0x13, 0xf5, 0x63, 0xbf, 0xa6, 0x1e, 0xbf, 0x47, 0x3f, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0x82, 0x84, 0xbc, 0x27, 0x76, 0x05, 0x00, 0x00,
+	// 680 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9d, 0x54, 0x41, 0x6f, 0xd3, 0x30,
+	0x18, 0x5d, 0xd7, 0xb5, 0x6b, 0xdc, 0x8e, 0xb5, 0x99, 0xc6, 0x2a, 0x34, 0xca, 0x08, 0x1c, 0xc6,
+	0x40, 0x29, 0x83, 0x13, 0xe2, 0x04, 0xd3, 0xa4, 0x95, 0xdb, 0xa2, 0x8a, 0x49, 0x48, 0x2c, 0xf2,
+	0x5a, 0x77, 0xb5, 0xda, 0xd9, 0x9d, 0xe3, 0xa4, 0xeb, 0x8d, 0x9f, 0xc0, 0x91, 0x13, 0x67, 0x7e,
+	0x0a, 0xc7, 0x1d, 0x77, 0x64, 0xe3, 0xc2, 0x0d, 0x7e, 0x02, 0x9f, 0x9d, 0x38, 0xa9, 0x46, 0x11,
+	0x13, 0x87, 0x4f, 0x69, 0xde, 0xf7, 0xfc, 0xfc, 0xf9, 0xbd, 0x3a, 0x68, 0x4b, 0x92, 0x93, 0x11,
+	0x17, 0x78, 0xd8, 0x0c, 0x88, 0x88, 0x88, 0x68, 0xe2, 0x11, 0x6d, 0x4a, 0x3e, 0x20, 0xac, 0x19,
+	0x6d, 0x37, 0x4f, 0x48, 0x10, 0xe0, 0x63, 0xe2, 0x8e, 0x04, 0x97, 0xdc, 0x5e, 0x37, 0x5c, 0x37,
+	0xe6, 0xba, 0xc0, 0x75, 0x35, 0xd7, 0x8d, 0xb6, 0xef, 0x3c, 0x99, 0xa5, 0xd4, 0xa7, 0x81, 0xe4,
+	0x62, 0xf2, 0x87, 0x96, 0xf3, 0x73, 0x1e, 0xad, 0xec, 0xc5, 0xcd, 0x1d, 0xce, 0x24, 0x65, 0x21,
+	0x96, 0x94, 0x33, 0x7b, 0x15, 0x15, 0x45, 0xc8, 0x7c, 0xda, 0xad, 0xe7, 0x36, 0x72, 0x9b, 0x96,
+	0x57, 0x80, 0xb7, 0x56, 0xd7, 0x7e, 0x88, 0x6e, 0xf5, 0xa8, 0x08, 0xa4, 0x4f, 0x22, 0xc2, 0xa4,
+	0x6a, 0xcf, 0x43, 0x3b, 0xef, 0x55, 0x34, 0xba, 0xab, 0x40, 0x60, 0x39, 0x68, 0x89, 0x91, 0xb3,
+	0x29, 0x52, 0x5e, 0x93, 0xca, 0x0a, 0x34, 0x1c, 0x17, 0xad, 0xd0, 0xc0, 0x1f, 0x73, 0x31, 0xe8,
+	0x0d, 0xf9, 0xd8, 0x07, 0x79, 0x46, 0xd9, 0x71, 0xbd, 0x00, 0xcc, 0x92, 0x57, 0xa3, 0xc1, 0x41,
+	0xd2, 0xf1, 0xe2, 0x86, 0xfd, 0x18, 0xd5, 0x46, 0x44, 0x04, 0x30, 0x2a, 0x61, 0x1d, 0xe2, 0xeb,
+	0xe3, 0xd6, 0x8b, 0xc0, 0xae, 0x78, 0xd5, 0xa9, 0x46, 0x5b, 0xe1, 0xf6, 0x29, 0x5a, 0x93, 0x02,
+	0xb3, 0x80, 0xaa, 0xfd, 0xd3, 0x3d, 0x24, 0x0e, 0x06, 0xf5, 0x45, 0x58, 0x52, 0x7e, 0xf6, 0xc2,
+	0x9d, 0xe5, 0x61, 0xe2, 0x12, 0xb8, 0xe8, 0xb6, 0xcd, 0x72, 0x33, 0x47, 0x1b, 0x16, 0xb7, 0x58,
+	0x8f, 0x7b, 0xab, 0x72, 0x56, 0xcb, 0xbe, 0x8f, 0x2a, 0x47, 0xd0, 0xe8, 0xf4, 0x93, 0xd1, 0x4a,
+	0x7a, 0xb4, 0x72, 0x8c, 0xe9, 0xa9, 0xde, 0x2c, 0x94, 0xac, 0x2a, 0x72, 0x3e, 0xe7, 0xd1, 0x6d,
+	0x0f, 0x8f, 0x67, 0x99, 0xbe, 0x8e, 0x2c, 0x86, 0x21, 0x9f, 0x11, 0xee, 0x90, 0xc4, 0xf7, 0x0c,
+	0xb0, 0xef, 0xa1, 0x72, 0x7a, 0x94, 0xc4, 0x78, 0xcb, 0x43, 0x06, 0x02, 0x4b, 0xb3, 0xcc, 0xf2,
+	0xd7, 0x32, 0x0b, 0x24, 0x16, 0x53, 0x71, 0x2c, 0xc4, 0x99, 0x69, 0x74, 0x2a, 0x8f, 0x69, 0x56,
+	0xa4, 0x2c, 0xe5, 0x4c, 0xe7, 0x91, 0xf7, 0x6a, 0x19, 0xf5, 0x6d, 0xdc, 0xb0, 0x37, 0x50, 0x85,
+	0xb0, 0x6e, 0xa6, 0x59, 0xd4, 0x44, 0x04, 0x98, 0x51, 0xdc, 0x42, 0xb5, 0x8c, 0x61, 0xf4, 0x16,
+	0x35, 0x6d, 0xd9, 0xd0, 0x8c, 0xda, 0xcc, 0x74, 0x4b, 0x7f, 0x49, 0xf7, 0x3d, 0xaa, 0x25, 0x72,
+	0x7e, 0x9c, 0x18, 0x25, 0x41, 0xdd, 0xd2, 0xb9, 0x3e, 0xfd, 0x57, 0xae, 0xc9, 0x86, 0x7b, 0x66,
+	0x9d, 0x57, 0x8d, 0xae, 0x21, 0xce, 0xa7, 0x79, 0xb4, 0x60, 0x22, 0x4d, 0xdd, 0xcf, 0x6e, 0x42,
+	0x39, 0xc5, 0xe0, 0x8c, 0xff, 0x9b, 0x09, 0xac, 0x0b, 0x3a, 0x7d, 0xd2, 0x0d, 0x87, 0x24, 0x0b,
+	0x04, 0x19, 0x08, 0x08, 0x8f, 0x50, 0x35, 0x25, 0x60, 0xa9, 0x0e, 0x25, 0x75, 0x16, 0x05, 0x6f,
+	0xd9, 0xe0, 0xaf, 0x62, 0x58, 0x69, 0xe1, 0x8e, 0xa4, 0x11, 0x95, 0x13, 0x13, 0x04, 0xcc, 0x60,
+	0x20, 0xd0, 0x7a, 0x80, 0x96, 0xb2, 0x3b, 0x30, 0x19, 0x11, 0x1d, 0x82, 0xe5, 0x55, 0x0c, 0xd8,
+	0x06, 0x4c, 0x91, 0x52, 0x15, 0x4d, 0x2a, 0xc5, 0x24, 0x03, 0x2a, 0x92, 0xd3, 0x43, 0xd6, 0x7e,
+	0x48, 0xc4, 0xe4, 0xa6, 0xf6, 0xdc, 0x45, 0x48, 0x5d, 0x3a, 0xff, 0x34, 0x24, 0x21, 0x49, 0xdc,
+	0xb1, 0x14, 0xb2, 0xaf, 0x00, 0x7b, 0x0d, 0x2d, 0xea, 0x76, 0xea, 0x4e, 0x51, 0xbd, 0xb6, 0xba,
+	0xce, 0x21, 0x72, 0xcc, 0xe5, 0xda, 0x3d, 0x23, 0x9d, 0x50, 0xdd, 0x8e, 0x9d, 0x3e, 0xa6, 0xec,
+	0x26, 0xdf, 0x28, 0x98, 0xab, 0xc7, 0xc5, 0x18, 0x8b, 0xae, 0x3f, 0xc6, 0xc3, 0x81, 0xde, 0xb6,
+	0xe4, 0x95, 0x13, 0xec, 0x00, 0xa0, 0xd7, 0x87, 0xe7, 0x97, 0x8d, 0xb9, 0x0b, 0xa8, 0x5f, 0x97,
+	0x8d, 0xdc, 0x87, 0xab, 0x46, 0xee, 0x0b, 0xd4, 0x57, 0xa8, 0x73, 0xa8, 0x6f, 0x50, 0x3f, 0xae,
+	0xa0, 0x07, 0xcf, 0x8f, 0xdf, 0x1b, 0x73, 0xe7, 0x50, 0x17, 0x50, 0xef, 0x36, 0x8f, 0x79, 0xf6,
+	0xf7, 0xa2, 0x7c, 0xd6, 0x97, 0xfa, 0xa5, 0xfe, 0x71, 0x54, 0xd4, 0x1f, 0xd7, 0xe7, 0xbf, 0x01,
+	0x1d, 0x2c, 0xe7, 0xd1, 0xd6, 0x05, 0x00, 0x00,
 }
 
 func (this *HistoryContinuation) Equal(that interface{}) bool {
@@ -629,6 +682,33 @@ func (this *QueryTask) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *WorkflowExecutionChainContinuation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WorkflowExecutionChainContinuation)
+	if !ok {
+		that2, ok := that.(WorkflowExecutionChainContinuation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RunId != that1.RunId {
+		return false
+	}
+	if this.ForwardWalk != that1.ForwardWalk {
+		return false
+	}
+	return true
+}
 func (this *HistoryContinuation) GoString() string {
 	if this == nil {
 		return "nil"
@@ -696,6 +776,17 @@ func (this *QueryTask) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *WorkflowExecutionChainContinuation) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&token.WorkflowExecutionChainContinuation{")
+	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
+	s = append(s, "ForwardWalk: "+fmt.Sprintf("%#v", this.ForwardWalk)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringMessage(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -982,6 +1073,46 @@ func (m *QueryTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WorkflowExecutionChainContinuation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowExecutionChainContinuation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowExecutionChainContinuation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ForwardWalk {
+		i--
+		if m.ForwardWalk {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.RunId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMessage(v)
 	base := offset
@@ -1128,6 +1259,22 @@ func (m *QueryTask) Size() (n int) {
 	return n
 }
 
+func (m *WorkflowExecutionChainContinuation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.ForwardWalk {
+		n += 2
+	}
+	return n
+}
+
 func sovMessage(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1197,6 +1344,17 @@ func (this *QueryTask) String() string {
 	}, "")
 	return s
 }
+func (this *WorkflowExecutionChainContinuation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WorkflowExecutionChainContinuation{`,
+		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
+		`ForwardWalk:` + fmt.Sprintf("%v", this.ForwardWalk) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringMessage(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -2179,6 +2337,111 @@ func (m *QueryTask) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *WorkflowExecutionChainContinuation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowExecutionChainContinuation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowExecutionChainContinuation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardWalk", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForwardWalk = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMessage(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -34,8 +34,12 @@ import (
 	math_bits "math/bits"
 	reflect "reflect"
 	strings "strings"
+	time "time"
 
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	v1 "go.temporal.io/api/common/v1"
 )
 
@@ -43,6 +47,7 @@ import (
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -117,8 +122,161 @@ func (m *ParentExecutionInfo) GetInitiatedId() int64 {
 	return 0
 }
 
+type PendingWorkflowTaskInfo struct {
+	ScheduleId    int64      `protobuf:"varint,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	StartedId     int64      `protobuf:"varint,2,opt,name=started_id,json=startedId,proto3" json:"started_id,omitempty"`
+	Attempt       int32      `protobuf:"varint,3,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	ScheduledTime *time.Time `protobuf:"bytes,4,opt,name=scheduled_time,json=scheduledTime,proto3,stdtime" json:"scheduled_time,omitempty"`
+	StartedTime   *time.Time `protobuf:"bytes,5,opt,name=started_time,json=startedTime,proto3,stdtime" json:"started_time,omitempty"`
+	// Identity of the worker which currently holds the started workflow task.
+	Identity string `protobuf:"bytes,6,opt,name=identity,proto3" json:"identity,omitempty"`
+}
+
+func (m *PendingWorkflowTaskInfo) Reset()      { *m = PendingWorkflowTaskInfo{} }
+func (*PendingWorkflowTaskInfo) ProtoMessage() {}
+func (*PendingWorkflowTaskInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4f1ca48d03c9ded, []int{1}
+}
+func (m *PendingWorkflowTaskInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingWorkflowTaskInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingWorkflowTaskInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingWorkflowTaskInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingWorkflowTaskInfo.Merge(m, src)
+}
+func (m *PendingWorkflowTaskInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingWorkflowTaskInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingWorkflowTaskInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingWorkflowTaskInfo proto.InternalMessageInfo
+
+func (m *PendingWorkflowTaskInfo) GetScheduleId() int64 {
+	if m != nil {
+		return m.ScheduleId
+	}
+	return 0
+}
+
+func (m *PendingWorkflowTaskInfo) GetStartedId() int64 {
+	if m != nil {
+		return m.StartedId
+	}
+	return 0
+}
+
+func (m *PendingWorkflowTaskInfo) GetAttempt() int32 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
+func (m *PendingWorkflowTaskInfo) GetScheduledTime() *time.Time {
+	if m != nil {
+		return m.ScheduledTime
+	}
+	return nil
+}
+
+func (m *PendingWorkflowTaskInfo) GetStartedTime() *time.Time {
+	if m != nil {
+		return m.StartedTime
+	}
+	return nil
+}
+
+func (m *PendingWorkflowTaskInfo) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+type WorkflowPauseInfo struct {
+	PauseTime    *time.Time `protobuf:"bytes,1,opt,name=pause_time,json=pauseTime,proto3,stdtime" json:"pause_time,omitempty"`
+	Identity     string     `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
+	Reason       string     `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	FreezeTimers bool       `protobuf:"varint,4,opt,name=freeze_timers,json=freezeTimers,proto3" json:"freeze_timers,omitempty"`
+}
+
+func (m *WorkflowPauseInfo) Reset()      { *m = WorkflowPauseInfo{} }
+func (*WorkflowPauseInfo) ProtoMessage() {}
+func (*WorkflowPauseInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4f1ca48d03c9ded, []int{2}
+}
+func (m *WorkflowPauseInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowPauseInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowPauseInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowPauseInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowPauseInfo.Merge(m, src)
+}
+func (m *WorkflowPauseInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowPauseInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowPauseInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowPauseInfo proto.InternalMessageInfo
+
+func (m *WorkflowPauseInfo) GetPauseTime() *time.Time {
+	if m != nil {
+		return m.PauseTime
+	}
+	return nil
+}
+
+func (m *WorkflowPauseInfo) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+func (m *WorkflowPauseInfo) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *WorkflowPauseInfo) GetFreezeTimers() bool {
+	if m != nil {
+		return m.FreezeTimers
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*ParentExecutionInfo)(nil), "temporal.server.api.workflow.v1.ParentExecutionInfo")
+	proto.RegisterType((*PendingWorkflowTaskInfo)(nil), "temporal.server.api.workflow.v1.PendingWorkflowTaskInfo")
+	proto.RegisterType((*WorkflowPauseInfo)(nil), "temporal.server.api.workflow.v1.WorkflowPauseInfo")
 }
 
 func init() {
@@ -126,26 +284,40 @@ func init() {
 }
 
 var fileDescriptor_c4f1ca48d03c9ded = []byte{
-	// 298 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0x31, 0x4e, 0xc3, 0x30,
-	0x18, 0x85, 0xfd, 0x53, 0x84, 0xd4, 0x94, 0x29, 0x2c, 0x15, 0x42, 0x3f, 0x05, 0x31, 0x94, 0x01,
-	0x47, 0x85, 0x91, 0x0d, 0x09, 0xa1, 0x6c, 0x28, 0x0b, 0x12, 0x0b, 0x32, 0x89, 0x5b, 0x59, 0x34,
-	0x76, 0xe4, 0x98, 0x94, 0x91, 0x23, 0x70, 0x0c, 0xce, 0xc1, 0xc4, 0x98, 0xb1, 0x23, 0x71, 0x16,
-	0xc6, 0x1e, 0x01, 0xa5, 0x25, 0xce, 0x80, 0xd8, 0xec, 0xe7, 0xe7, 0x4f, 0x9f, 0x9e, 0x77, 0x66,
-	0x78, 0x9a, 0x29, 0xcd, 0xe6, 0x41, 0xce, 0x75, 0xc1, 0x75, 0xc0, 0x32, 0x11, 0x2c, 0x94, 0x7e,
-	0x9a, 0xce, 0xd5, 0x22, 0x28, 0x26, 0x41, 0xca, 0xf3, 0x9c, 0xcd, 0x38, 0xcd, 0xb4, 0x32, 0xca,
-	0x3f, 0x6c, 0xeb, 0x74, 0x53, 0xa7, 0x2c, 0x13, 0xb4, 0xad, 0xd3, 0x62, 0xb2, 0x7f, 0xe2, 0x78,
-	0x0d, 0x28, 0x56, 0x69, 0xaa, 0xe4, 0x1f, 0xcc, 0xf1, 0x07, 0x78, 0x7b, 0xb7, 0x4c, 0x73, 0x69,
-	0xae, 0x5f, 0x78, 0xfc, 0x6c, 0x84, 0x92, 0xa1, 0x9c, 0x2a, 0xff, 0xc8, 0xdb, 0x95, 0x2c, 0xe5,
-	0x79, 0xc6, 0x62, 0xfe, 0x20, 0x92, 0x21, 0x8c, 0x60, 0xdc, 0x8f, 0x06, 0x2e, 0x0b, 0x13, 0xff,
-	0xc0, 0xeb, 0xbb, 0xeb, 0x70, 0x6b, 0xfd, 0xde, 0x05, 0xfe, 0x8d, 0xd7, 0xe7, 0x2d, 0x71, 0xd8,
-	0x1b, 0xc1, 0x78, 0x70, 0x7e, 0x4a, 0x9d, 0x73, 0x23, 0xbb, 0x51, 0xa2, 0xc5, 0x84, 0xde, 0xfd,
-	0x6a, 0x3b, 0x85, 0xa8, 0xfb, 0xdb, 0x98, 0x08, 0x29, 0x8c, 0x60, 0x86, 0x27, 0x8d, 0xc9, 0xf6,
-	0x08, 0xc6, 0xbd, 0x68, 0xe0, 0xb2, 0x30, 0xb9, 0x4a, 0xca, 0x0a, 0xc9, 0xb2, 0x42, 0xb2, 0xaa,
-	0x10, 0x5e, 0x2d, 0xc2, 0xbb, 0x45, 0xf8, 0xb4, 0x08, 0xa5, 0x45, 0xf8, 0xb2, 0x08, 0xdf, 0x16,
-	0xc9, 0xca, 0x22, 0xbc, 0xd5, 0x48, 0xca, 0x1a, 0xc9, 0xb2, 0x46, 0x72, 0x4f, 0x67, 0xaa, 0x13,
-	0x12, 0xea, 0x9f, 0xd9, 0x2f, 0xdb, 0xf3, 0xe3, 0xce, 0x7a, 0xb1, 0x8b, 0x9f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xd4, 0x7b, 0x8c, 0xc8, 0xa9, 0x01, 0x00, 0x00,
+	// 517 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8d, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
+	0x14, 0xaf, 0x93, 0x36, 0xd4, 0xe7, 0x14, 0xa9, 0xae, 0x04, 0x56, 0x04, 0x09, 0x0d, 0x0c, 0x30,
+	0xf4, 0xac, 0x96, 0xb1, 0x03, 0x52, 0x11, 0x42, 0xd9, 0x22, 0x2b, 0x12, 0x12, 0x4b, 0x75, 0xb5,
+	0x5f, 0xcc, 0xa9, 0xf1, 0x9d, 0x75, 0xbe, 0xa4, 0x94, 0x89, 0x8f, 0xd0, 0x2f, 0xc0, 0xce, 0xd2,
+	0x2f, 0xc1, 0xc4, 0x98, 0xb1, 0x1b, 0xb4, 0x2c, 0x8c, 0x7c, 0x04, 0x9e, 0xcf, 0x3e, 0x47, 0xa8,
+	0x42, 0xea, 0xf0, 0xe4, 0x7b, 0x7f, 0xee, 0xf7, 0x7e, 0xbf, 0xf7, 0xce, 0x64, 0x4f, 0x43, 0x96,
+	0x4b, 0xc5, 0x66, 0x61, 0x01, 0x6a, 0x01, 0x2a, 0x64, 0x39, 0x0f, 0xcf, 0xa4, 0x3a, 0x9d, 0xce,
+	0xe4, 0x59, 0xb8, 0xd8, 0x0f, 0x33, 0x28, 0x0a, 0x96, 0x02, 0xcd, 0x95, 0xd4, 0xd2, 0x1f, 0xd8,
+	0x72, 0x5a, 0x95, 0x53, 0x2c, 0xa7, 0xb6, 0x9c, 0x2e, 0xf6, 0x7b, 0x83, 0x54, 0xca, 0x74, 0x06,
+	0xa1, 0x29, 0x3f, 0x99, 0x4f, 0x43, 0xcd, 0x11, 0x41, 0xb3, 0x2c, 0xaf, 0x10, 0x7a, 0xbb, 0x09,
+	0xe4, 0x20, 0x12, 0x10, 0x31, 0x87, 0x22, 0x4c, 0x65, 0x2a, 0x4d, 0xdc, 0x9c, 0xea, 0x92, 0x67,
+	0x0d, 0xa7, 0x92, 0x4c, 0x2c, 0xb3, 0x4c, 0x8a, 0x5b, 0x54, 0x86, 0xdf, 0x1c, 0xb2, 0x33, 0x66,
+	0x0a, 0x84, 0x7e, 0xf3, 0x11, 0xe2, 0xb9, 0xe6, 0x52, 0x8c, 0xc4, 0x54, 0xfa, 0xbb, 0xa4, 0x2b,
+	0x18, 0x96, 0xe6, 0x2c, 0x86, 0x63, 0x9e, 0x04, 0xce, 0x13, 0xe7, 0xb9, 0x1b, 0x79, 0x4d, 0x6c,
+	0x94, 0xf8, 0x8f, 0x88, 0xdb, 0xb8, 0x41, 0xcb, 0xe4, 0x57, 0x01, 0xff, 0x2d, 0x71, 0xc1, 0x22,
+	0x06, 0x6d, 0xcc, 0x7a, 0x07, 0x2f, 0x68, 0xa3, 0xbb, 0x14, 0x5c, 0x51, 0x42, 0xb9, 0xf4, 0x5d,
+	0x2d, 0xbd, 0xa1, 0x10, 0xad, 0xee, 0x96, 0x4c, 0xb8, 0xe0, 0x9a, 0x33, 0x0d, 0x49, 0xc9, 0x64,
+	0x1d, 0xb1, 0xda, 0x91, 0xd7, 0xc4, 0x46, 0xc9, 0xf0, 0x4b, 0x8b, 0x3c, 0x1c, 0xe3, 0x38, 0xb8,
+	0x48, 0x2d, 0xd4, 0x84, 0x15, 0xa7, 0x46, 0xc8, 0x80, 0x78, 0x45, 0xfc, 0x01, 0x92, 0xf9, 0xac,
+	0xd1, 0xd1, 0x8e, 0x88, 0x0d, 0xa1, 0x8c, 0xc7, 0x84, 0xe0, 0x64, 0x55, 0x8d, 0xde, 0x32, 0x79,
+	0xb7, 0x8e, 0x60, 0x3a, 0x20, 0xf7, 0x98, 0x2e, 0x79, 0x6b, 0xa3, 0x62, 0x23, 0xb2, 0x2e, 0x2a,
+	0xbc, 0x6f, 0x61, 0x92, 0xe3, 0x72, 0x41, 0x86, 0x9a, 0x77, 0xd0, 0xa3, 0xd5, 0xf6, 0xa8, 0xdd,
+	0x1e, 0x9d, 0xd8, 0xed, 0x1d, 0xad, 0x5f, 0xfc, 0x18, 0x38, 0xd1, 0x56, 0x73, 0xaf, 0xcc, 0xf8,
+	0xaf, 0x49, 0xd7, 0x32, 0x30, 0x30, 0x1b, 0x77, 0x84, 0xf1, 0xea, 0x5b, 0x06, 0xa4, 0x47, 0x36,
+	0x39, 0xbe, 0x07, 0xcd, 0xf5, 0x79, 0xd0, 0x31, 0xcb, 0x68, 0xfc, 0xe1, 0xa5, 0x43, 0xb6, 0xed,
+	0x60, 0xc6, 0x6c, 0x5e, 0x80, 0x99, 0xcc, 0x2b, 0x42, 0xf2, 0xd2, 0xa9, 0x9a, 0x3a, 0x77, 0x6c,
+	0xea, 0x9a, 0x3b, 0xb7, 0x5a, 0xb6, 0xfe, 0x6d, 0xe9, 0x3f, 0x20, 0x1d, 0x05, 0xac, 0xa8, 0x77,
+	0xef, 0x46, 0xb5, 0xe7, 0x3f, 0x25, 0x5b, 0x53, 0x05, 0xf0, 0xa9, 0xea, 0xaa, 0x0a, 0x33, 0xb3,
+	0xcd, 0xa8, 0x5b, 0x05, 0x27, 0x26, 0x76, 0x94, 0x2c, 0xaf, 0xfb, 0x6b, 0x57, 0x68, 0x7f, 0xae,
+	0xfb, 0xce, 0xe7, 0x9b, 0xbe, 0xf3, 0x15, 0xed, 0x3b, 0xda, 0x12, 0xed, 0x27, 0xda, 0xef, 0x1b,
+	0xcc, 0xe1, 0xf7, 0xe2, 0x57, 0x7f, 0x6d, 0x89, 0x76, 0x85, 0xf6, 0x1e, 0xa9, 0xaf, 0x1e, 0x18,
+	0x97, 0xff, 0xf9, 0x15, 0x0f, 0xed, 0xf9, 0xa4, 0x63, 0x34, 0xbe, 0xfc, 0x0b, 0x61, 0x0f, 0x21,
+	0x3d, 0xbd, 0x03, 0x00, 0x00,
 }
 
 func (this *ParentExecutionInfo) Equal(that interface{}) bool {
@@ -181,6 +353,90 @@ func (this *ParentExecutionInfo) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *PendingWorkflowTaskInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PendingWorkflowTaskInfo)
+	if !ok {
+		that2, ok := that.(PendingWorkflowTaskInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ScheduleId != that1.ScheduleId {
+		return false
+	}
+	if this.StartedId != that1.StartedId {
+		return false
+	}
+	if this.Attempt != that1.Attempt {
+		return false
+	}
+	if that1.ScheduledTime == nil {
+		if this.ScheduledTime != nil {
+			return false
+		}
+	} else if !this.ScheduledTime.Equal(*that1.ScheduledTime) {
+		return false
+	}
+	if that1.StartedTime == nil {
+		if this.StartedTime != nil {
+			return false
+		}
+	} else if !this.StartedTime.Equal(*that1.StartedTime) {
+		return false
+	}
+	if this.Identity != that1.Identity {
+		return false
+	}
+	return true
+}
+func (this *WorkflowPauseInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WorkflowPauseInfo)
+	if !ok {
+		that2, ok := that.(WorkflowPauseInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.PauseTime == nil {
+		if this.PauseTime != nil {
+			return false
+		}
+	} else if !this.PauseTime.Equal(*that1.PauseTime) {
+		return false
+	}
+	if this.Identity != that1.Identity {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if this.FreezeTimers != that1.FreezeTimers {
+		return false
+	}
+	return true
+}
 func (this *ParentExecutionInfo) GoString() string {
 	if this == nil {
 		return "nil"
@@ -196,6 +452,34 @@ func (this *ParentExecutionInfo) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *PendingWorkflowTaskInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&workflow.PendingWorkflowTaskInfo{")
+	s = append(s, "ScheduleId: "+fmt.Sprintf("%#v", this.ScheduleId)+",\n")
+	s = append(s, "StartedId: "+fmt.Sprintf("%#v", this.StartedId)+",\n")
+	s = append(s, "Attempt: "+fmt.Sprintf("%#v", this.Attempt)+",\n")
+	s = append(s, "ScheduledTime: "+fmt.Sprintf("%#v", this.ScheduledTime)+",\n")
+	s = append(s, "StartedTime: "+fmt.Sprintf("%#v", this.StartedTime)+",\n")
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *WorkflowPauseInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&workflow.WorkflowPauseInfo{")
+	s = append(s, "PauseTime: "+fmt.Sprintf("%#v", this.PauseTime)+",\n")
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "FreezeTimers: "+fmt.Sprintf("%#v", this.FreezeTimers)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringMessage(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -258,6 +542,128 @@ func (m *ParentExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PendingWorkflowTaskInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingWorkflowTaskInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingWorkflowTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.StartedTime != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintMessage(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ScheduledTime != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintMessage(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartedId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.StartedId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ScheduleId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.ScheduleId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowPauseInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowPauseInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowPauseInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FreezeTimers {
+		i--
+		if m.FreezeTimers {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PauseTime != nil {
+		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.PauseTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.PauseTime):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintMessage(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMessage(v)
 	base := offset
@@ -293,25 +699,107 @@ func (m *ParentExecutionInfo) Size() (n int) {
 	return n
 }
 
-func sovMessage(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMessage(x uint64) (n int) {
-	return sovMessage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *ParentExecutionInfo) String() string {
-	if this == nil {
-		return "nil"
+func (m *PendingWorkflowTaskInfo) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ParentExecutionInfo{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
-		`InitiatedId:` + fmt.Sprintf("%v", this.InitiatedId) + `,`,
-		`}`,
-	}, "")
-	return s
-}
+	var l int
+	_ = l
+	if m.ScheduleId != 0 {
+		n += 1 + sovMessage(uint64(m.ScheduleId))
+	}
+	if m.StartedId != 0 {
+		n += 1 + sovMessage(uint64(m.StartedId))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovMessage(uint64(m.Attempt))
+	}
+	if m.ScheduledTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.StartedTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *WorkflowPauseInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PauseTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.PauseTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.FreezeTimers {
+		n += 2
+	}
+	return n
+}
+
+func sovMessage(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMessage(x uint64) (n int) {
+	return sovMessage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ParentExecutionInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ParentExecutionInfo{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
+		`InitiatedId:` + fmt.Sprintf("%v", this.InitiatedId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PendingWorkflowTaskInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PendingWorkflowTaskInfo{`,
+		`ScheduleId:` + fmt.Sprintf("%v", this.ScheduleId) + `,`,
+		`StartedId:` + fmt.Sprintf("%v", this.StartedId) + `,`,
+		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
+		`ScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`StartedTime:` + strings.Replace(fmt.Sprintf("%v", this.StartedTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WorkflowPauseInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WorkflowPauseInfo{`,
+		`PauseTime:` + strings.Replace(fmt.Sprintf("%v", this.PauseTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`FreezeTimers:` + fmt.Sprintf("%v", this.FreezeTimers) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringMessage(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -492,6 +980,393 @@ func (m *ParentExecutionInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PendingWorkflowTaskInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingWorkflowTaskInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingWorkflowTaskInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			m.ScheduleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduleId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedId", wireType)
+			}
+			m.StartedId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledTime == nil {
+				m.ScheduledTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartedTime == nil {
+				m.StartedTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowPauseInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowPauseInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowPauseInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PauseTime == nil {
+				m.PauseTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.PauseTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreezeTimers", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FreezeTimers = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMessage(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -133,6 +133,20 @@ func (c *clientImpl) DescribeMutableState(
 	return client.DescribeMutableState(ctx, request, opts...)
 }
 
+func (c *clientImpl) BatchDescribeMutableState(
+	ctx context.Context,
+	request *adminservice.BatchDescribeMutableStateRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.BatchDescribeMutableStateResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContextWithLargeTimeout(ctx)
+	defer cancel()
+	return client.BatchDescribeMutableState(ctx, request, opts...)
+}
+
 func (c *clientImpl) GetWorkflowExecutionRawHistoryV2(
 	ctx context.Context,
 	request *adminservice.GetWorkflowExecutionRawHistoryV2Request,
@@ -274,6 +288,188 @@ func (c *clientImpl) RefreshWorkflowTasks(
 	return client.RefreshWorkflowTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) UpdateWorkflowExecutionTags(
+	ctx context.Context,
+	request *adminservice.UpdateWorkflowExecutionTagsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateWorkflowExecutionTagsResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UpdateWorkflowExecutionTags(ctx, request, opts...)
+}
+
+func (c *clientImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.PauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PauseWorkflowExecutionResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.PauseWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *clientImpl) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.UnpauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UnpauseWorkflowExecutionResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UnpauseWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *clientImpl) ShutdownWorker(
+	ctx context.Context,
+	request *adminservice.ShutdownWorkerRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ShutdownWorkerResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ShutdownWorker(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeNamespaceConfig(
+	ctx context.Context,
+	request *adminservice.DescribeNamespaceConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeNamespaceConfigResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DescribeNamespaceConfig(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListDynamicConfigKeys(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigKeysRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListDynamicConfigKeysResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ListDynamicConfigKeys(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeWorkflowLocks(
+	ctx context.Context,
+	request *adminservice.DescribeWorkflowLocksRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeWorkflowLocksResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DescribeWorkflowLocks(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListWorkflowExecutionChain(
+	ctx context.Context,
+	request *adminservice.ListWorkflowExecutionChainRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListWorkflowExecutionChainResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ListWorkflowExecutionChain(ctx, request, opts...)
+}
+
+func (c *clientImpl) GetWorkflowExecutionEvents(
+	ctx context.Context,
+	request *adminservice.GetWorkflowExecutionEventsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetWorkflowExecutionEventsResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.GetWorkflowExecutionEvents(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeTaskQueue(
+	ctx context.Context,
+	request *adminservice.DescribeTaskQueueRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeTaskQueueResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DescribeTaskQueue(ctx, request, opts...)
+}
+
+func (c *clientImpl) BatchCompleteActivityTasksById(
+	ctx context.Context,
+	request *adminservice.BatchCompleteActivityTasksByIdRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.BatchCompleteActivityTasksByIdResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContextWithLargeTimeout(ctx)
+	defer cancel()
+	return client.BatchCompleteActivityTasksById(ctx, request, opts...)
+}
+
+func (c *clientImpl) BatchFailActivityTasksById(
+	ctx context.Context,
+	request *adminservice.BatchFailActivityTasksByIdRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.BatchFailActivityTasksByIdResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContextWithLargeTimeout(ctx)
+	defer cancel()
+	return client.BatchFailActivityTasksById(ctx, request, opts...)
+}
+
+func (c *clientImpl) ExecuteCrossClusterTask(
+	ctx context.Context,
+	request *adminservice.ExecuteCrossClusterTaskRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ExecuteCrossClusterTaskResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ExecuteCrossClusterTask(ctx, request, opts...)
+}
+
 func (c *clientImpl) ResendReplicationTasks(
 	ctx context.Context,
 	request *adminservice.ResendReplicationTasksRequest,
@@ -138,6 +138,24 @@ func (c *metricClient) DescribeMutableState(
 	return resp, err
 }
 
+func (c *metricClient) BatchDescribeMutableState(
+	ctx context.Context,
+	request *adminservice.BatchDescribeMutableStateRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.BatchDescribeMutableStateResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientBatchDescribeMutableStateScope, metrics.ClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientBatchDescribeMutableStateScope, metrics.ClientLatency)
+	resp, err := c.client.BatchDescribeMutableState(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientBatchDescribeMutableStateScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
 func (c *metricClient) GetWorkflowExecutionRawHistoryV2(
 	ctx context.Context,
 	request *adminservice.GetWorkflowExecutionRawHistoryV2Request,
@@ -310,6 +328,227 @@ func (c *metricClient) RefreshWorkflowTasks(
 	return resp, err
 }
 
+func (c *metricClient) UpdateWorkflowExecutionTags(
+	ctx context.Context,
+	request *adminservice.UpdateWorkflowExecutionTagsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateWorkflowExecutionTagsResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientUpdateWorkflowExecutionTagsScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientUpdateWorkflowExecutionTagsScope, metrics.ClientLatency)
+	resp, err := c.client.UpdateWorkflowExecutionTags(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientUpdateWorkflowExecutionTagsScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) PauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.PauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PauseWorkflowExecutionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientPauseWorkflowExecutionScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientPauseWorkflowExecutionScope, metrics.ClientLatency)
+	resp, err := c.client.PauseWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientPauseWorkflowExecutionScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.UnpauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UnpauseWorkflowExecutionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientUnpauseWorkflowExecutionScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientUnpauseWorkflowExecutionScope, metrics.ClientLatency)
+	resp, err := c.client.UnpauseWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientUnpauseWorkflowExecutionScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ShutdownWorker(
+	ctx context.Context,
+	request *adminservice.ShutdownWorkerRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ShutdownWorkerResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientShutdownWorkerScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientShutdownWorkerScope, metrics.ClientLatency)
+	resp, err := c.client.ShutdownWorker(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientShutdownWorkerScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DescribeNamespaceConfig(
+	ctx context.Context,
+	request *adminservice.DescribeNamespaceConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeNamespaceConfigResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDescribeNamespaceConfigScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDescribeNamespaceConfigScope, metrics.ClientLatency)
+	resp, err := c.client.DescribeNamespaceConfig(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDescribeNamespaceConfigScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ListDynamicConfigKeys(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigKeysRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListDynamicConfigKeysResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientListDynamicConfigKeysScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientListDynamicConfigKeysScope, metrics.ClientLatency)
+	resp, err := c.client.ListDynamicConfigKeys(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientListDynamicConfigKeysScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DescribeWorkflowLocks(
+	ctx context.Context,
+	request *adminservice.DescribeWorkflowLocksRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeWorkflowLocksResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDescribeWorkflowLocksScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDescribeWorkflowLocksScope, metrics.ClientLatency)
+	resp, err := c.client.DescribeWorkflowLocks(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDescribeWorkflowLocksScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ListWorkflowExecutionChain(
+	ctx context.Context,
+	request *adminservice.ListWorkflowExecutionChainRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListWorkflowExecutionChainResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientListWorkflowExecutionChainScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientListWorkflowExecutionChainScope, metrics.ClientLatency)
+	resp, err := c.client.ListWorkflowExecutionChain(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientListWorkflowExecutionChainScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) GetWorkflowExecutionEvents(
+	ctx context.Context,
+	request *adminservice.GetWorkflowExecutionEventsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetWorkflowExecutionEventsResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientGetWorkflowExecutionEventsScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientGetWorkflowExecutionEventsScope, metrics.ClientLatency)
+	resp, err := c.client.GetWorkflowExecutionEvents(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientGetWorkflowExecutionEventsScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DescribeTaskQueue(
+	ctx context.Context,
+	request *adminservice.DescribeTaskQueueRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeTaskQueueResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDescribeTaskQueueScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDescribeTaskQueueScope, metrics.ClientLatency)
+	resp, err := c.client.DescribeTaskQueue(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDescribeTaskQueueScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) BatchCompleteActivityTasksById(
+	ctx context.Context,
+	request *adminservice.BatchCompleteActivityTasksByIdRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.BatchCompleteActivityTasksByIdResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientBatchCompleteActivityTasksByIdScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientBatchCompleteActivityTasksByIdScope, metrics.ClientLatency)
+	resp, err := c.client.BatchCompleteActivityTasksById(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientBatchCompleteActivityTasksByIdScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) BatchFailActivityTasksById(
+	ctx context.Context,
+	request *adminservice.BatchFailActivityTasksByIdRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.BatchFailActivityTasksByIdResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientBatchFailActivityTasksByIdScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientBatchFailActivityTasksByIdScope, metrics.ClientLatency)
+	resp, err := c.client.BatchFailActivityTasksById(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientBatchFailActivityTasksByIdScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ExecuteCrossClusterTask(
+	ctx context.Context,
+	request *adminservice.ExecuteCrossClusterTaskRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ExecuteCrossClusterTaskResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientExecuteCrossClusterTaskScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientExecuteCrossClusterTaskScope, metrics.ClientLatency)
+	resp, err := c.client.ExecuteCrossClusterTask(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientExecuteCrossClusterTaskScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
 func (c *metricClient) ResendReplicationTasks(
 	ctx context.Context,
 	request *adminservice.ResendReplicationTasksRequest,
@@ -130,6 +130,22 @@ func (c *retryableClient) DescribeMutableState(
 	return resp, err
 }
 
+func (c *retryableClient) BatchDescribeMutableState(
+	ctx context.Context,
+	request *adminservice.BatchDescribeMutableStateRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.BatchDescribeMutableStateResponse, error) {
+
+	var resp *adminservice.BatchDescribeMutableStateResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.BatchDescribeMutableState(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GetWorkflowExecutionRawHistoryV2(
 	ctx context.Context,
 	request *adminservice.GetWorkflowExecutionRawHistoryV2Request,
@@ -286,6 +302,214 @@ func (c *retryableClient) RefreshWorkflowTasks(
 	return resp, err
 }
 
+func (c *retryableClient) UpdateWorkflowExecutionTags(
+	ctx context.Context,
+	request *adminservice.UpdateWorkflowExecutionTagsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateWorkflowExecutionTagsResponse, error) {
+
+	var resp *adminservice.UpdateWorkflowExecutionTagsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UpdateWorkflowExecutionTags(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) PauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.PauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PauseWorkflowExecutionResponse, error) {
+
+	var resp *adminservice.PauseWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.PauseWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.UnpauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UnpauseWorkflowExecutionResponse, error) {
+
+	var resp *adminservice.UnpauseWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UnpauseWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ShutdownWorker(
+	ctx context.Context,
+	request *adminservice.ShutdownWorkerRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ShutdownWorkerResponse, error) {
+
+	var resp *adminservice.ShutdownWorkerResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ShutdownWorker(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeNamespaceConfig(
+	ctx context.Context,
+	request *adminservice.DescribeNamespaceConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeNamespaceConfigResponse, error) {
+
+	var resp *adminservice.DescribeNamespaceConfigResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeNamespaceConfig(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ListDynamicConfigKeys(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigKeysRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListDynamicConfigKeysResponse, error) {
+
+	var resp *adminservice.ListDynamicConfigKeysResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListDynamicConfigKeys(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeWorkflowLocks(
+	ctx context.Context,
+	request *adminservice.DescribeWorkflowLocksRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeWorkflowLocksResponse, error) {
+
+	var resp *adminservice.DescribeWorkflowLocksResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeWorkflowLocks(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ListWorkflowExecutionChain(
+	ctx context.Context,
+	request *adminservice.ListWorkflowExecutionChainRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListWorkflowExecutionChainResponse, error) {
+
+	var resp *adminservice.ListWorkflowExecutionChainResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListWorkflowExecutionChain(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) GetWorkflowExecutionEvents(
+	ctx context.Context,
+	request *adminservice.GetWorkflowExecutionEventsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetWorkflowExecutionEventsResponse, error) {
+
+	var resp *adminservice.GetWorkflowExecutionEventsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetWorkflowExecutionEvents(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeTaskQueue(
+	ctx context.Context,
+	request *adminservice.DescribeTaskQueueRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeTaskQueueResponse, error) {
+
+	var resp *adminservice.DescribeTaskQueueResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeTaskQueue(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) BatchCompleteActivityTasksById(
+	ctx context.Context,
+	request *adminservice.BatchCompleteActivityTasksByIdRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.BatchCompleteActivityTasksByIdResponse, error) {
+
+	var resp *adminservice.BatchCompleteActivityTasksByIdResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.BatchCompleteActivityTasksById(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) BatchFailActivityTasksById(
+	ctx context.Context,
+	request *adminservice.BatchFailActivityTasksByIdRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.BatchFailActivityTasksByIdResponse, error) {
+
+	var resp *adminservice.BatchFailActivityTasksByIdResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.BatchFailActivityTasksById(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ExecuteCrossClusterTask(
+	ctx context.Context,
+	request *adminservice.ExecuteCrossClusterTaskRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ExecuteCrossClusterTaskResponse, error) {
+
+	var resp *adminservice.ExecuteCrossClusterTaskResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ExecuteCrossClusterTask(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ResendReplicationTasks(
 	ctx context.Context,
 	request *adminservice.ResendReplicationTasksRequest,
@@ -81,7 +81,7 @@ func NewClientBean(factory Factory, clusterMetadata cluster.Metadata) (Bean, err
 		}
 
 		adminClient, err := factory.NewAdminClientWithTimeout(
-			info.RPCAddress,
+			info.GetOperatorRPCAddress(),
 			admin.DefaultTimeout,
 			admin.DefaultLargeTimeout,
 		)
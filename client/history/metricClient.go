@@ -92,6 +92,23 @@ func (c *metricClient) CloseShard(
 	return resp, err
 }
 
+func (c *metricClient) DescribeWorkflowLocks(
+	ctx context.Context,
+	request *historyservice.DescribeWorkflowLocksRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DescribeWorkflowLocksResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientDescribeWorkflowLocksScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientDescribeWorkflowLocksScope, metrics.ClientLatency)
+	resp, err := c.client.DescribeWorkflowLocks(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientDescribeWorkflowLocksScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
 func (c *metricClient) DescribeMutableState(
 	context context.Context,
 	request *historyservice.DescribeMutableStateRequest,
@@ -626,3 +643,54 @@ func (c *metricClient) RefreshWorkflowTasks(
 	}
 	return resp, err
 }
+
+func (c *metricClient) UpdateWorkflowExecutionTags(
+	ctx context.Context,
+	request *historyservice.UpdateWorkflowExecutionTagsRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UpdateWorkflowExecutionTagsResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientUpdateWorkflowExecutionTagsScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientUpdateWorkflowExecutionTagsScope, metrics.ClientLatency)
+	resp, err := c.client.UpdateWorkflowExecutionTags(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientUpdateWorkflowExecutionTagsScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) PauseWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.PauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.PauseWorkflowExecutionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientPauseWorkflowExecutionScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientPauseWorkflowExecutionScope, metrics.ClientLatency)
+	resp, err := c.client.PauseWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientPauseWorkflowExecutionScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.UnpauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UnpauseWorkflowExecutionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientUnpauseWorkflowExecutionScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientUnpauseWorkflowExecutionScope, metrics.ClientLatency)
+	resp, err := c.client.UnpauseWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientUnpauseWorkflowExecutionScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
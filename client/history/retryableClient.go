@@ -98,6 +98,22 @@ func (c *retryableClient) CloseShard(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeWorkflowLocks(
+	ctx context.Context,
+	request *historyservice.DescribeWorkflowLocksRequest,
+	opts ...grpc.CallOption) (*historyservice.DescribeWorkflowLocksResponse, error) {
+
+	var resp *historyservice.DescribeWorkflowLocksResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeWorkflowLocks(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) RemoveTask(
 	ctx context.Context,
 	request *historyservice.RemoveTaskRequest,
@@ -626,3 +642,54 @@ func (c *retryableClient) RefreshWorkflowTasks(
 	err := backoff.Retry(op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) UpdateWorkflowExecutionTags(
+	ctx context.Context,
+	request *historyservice.UpdateWorkflowExecutionTagsRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UpdateWorkflowExecutionTagsResponse, error) {
+
+	var resp *historyservice.UpdateWorkflowExecutionTagsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UpdateWorkflowExecutionTags(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) PauseWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.PauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.PauseWorkflowExecutionResponse, error) {
+
+	var resp *historyservice.PauseWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.PauseWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.UnpauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UnpauseWorkflowExecutionResponse, error) {
+
+	var resp *historyservice.UnpauseWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UnpauseWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
@@ -183,6 +183,16 @@ func (c *clientImpl) CancelOutstandingPoll(ctx context.Context, request *matchin
 	return client.CancelOutstandingPoll(ctx, request, opts...)
 }
 
+func (c *clientImpl) RemovePoller(ctx context.Context, request *matchingservice.RemovePollerRequest, opts ...grpc.CallOption) (*matchingservice.RemovePollerResponse, error) {
+	client, err := c.getClientForTaskqueue(request.TaskQueue.GetName())
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.RemovePoller(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeTaskQueue(ctx context.Context, request *matchingservice.DescribeTaskQueueRequest, opts ...grpc.CallOption) (*matchingservice.DescribeTaskQueueResponse, error) {
 	client, err := c.getClientForTaskqueue(request.DescRequest.TaskQueue.GetName())
 	if err != nil {
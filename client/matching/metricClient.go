@@ -210,6 +210,24 @@ func (c *metricClient) CancelOutstandingPoll(
 	return resp, err
 }
 
+func (c *metricClient) RemovePoller(
+	ctx context.Context,
+	request *matchingservice.RemovePollerRequest,
+	opts ...grpc.CallOption) (*matchingservice.RemovePollerResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.MatchingClientRemovePollerScope, metrics.ClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientRemovePollerScope, metrics.ClientLatency)
+	resp, err := c.client.RemovePoller(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientRemovePollerScope, metrics.ClientFailures)
+	}
+
+	return resp, err
+}
+
 func (c *metricClient) DescribeTaskQueue(
 	ctx context.Context,
 	request *matchingservice.DescribeTaskQueueRequest,
@@ -162,6 +162,22 @@ func (c *retryableClient) CancelOutstandingPoll(
 	return resp, err
 }
 
+func (c *retryableClient) RemovePoller(
+	ctx context.Context,
+	request *matchingservice.RemovePollerRequest,
+	opts ...grpc.CallOption) (*matchingservice.RemovePollerResponse, error) {
+
+	var resp *matchingservice.RemovePollerResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.RemovePoller(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DescribeTaskQueue(
 	ctx context.Context,
 	request *matchingservice.DescribeTaskQueueRequest,
@@ -130,6 +130,10 @@ func buildCLI() *cli.App {
 				if err != nil {
 					cli.Exit(fmt.Sprintf("Unable to instantiate authorizer: %v.", err), 1)
 				}
+				operatorAuthorizer, err := authorization.GetOperatorAuthorizerFromConfig(&cfg.Global.Authorization)
+				if err != nil {
+					cli.Exit(fmt.Sprintf("Unable to instantiate operator authorizer: %v.", err), 1)
+				}
 				claimMapper, err := authorization.GetClaimMapperFromConfig(cfg)
 				if err != nil {
 					cli.Exit(fmt.Sprintf("Unable to instantiate claim mapper: %v.", err), 1)
@@ -140,6 +144,7 @@ func buildCLI() *cli.App {
 					temporal.WithConfig(cfg),
 					temporal.InterruptOn(temporal.InterruptCh()),
 					temporal.WithAuthorizer(authorizer),
+					temporal.WithOperatorAuthorizer(operatorAuthorizer),
 					temporal.WithClaimMapper(func(cfg *config.Config) authorization.ClaimMapper {
 						return claimMapper
 					}),
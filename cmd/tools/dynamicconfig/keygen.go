@@ -0,0 +1,723 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type (
+	// task that generates the registry of dynamic config keys
+	// from the key definitions and the places keys are read
+	generateKeyRegistryTask struct {
+		config       *config
+		fset         *token.FileSet
+		modulePath   string
+		keys         map[string]*keyInfo              // key const name -> info
+		packageNames map[string]string                // package dir -> package name
+		consts       map[string]map[string]*constDecl // package dir -> const name -> declaration
+	}
+
+	// keyInfo is the information collected about a single key
+	keyInfo struct {
+		constName       string
+		name            string
+		description     string
+		valueType       string
+		filters         []string
+		defaultExprs    []*exprInFile
+		defaults        []string
+		computedDefault bool
+		min             string
+		max             string
+		allowedValues   []string
+	}
+
+	// exprInFile is an expression with the file it is written in, to resolve the identifiers it refers to
+	exprInFile struct {
+		expr   ast.Expr
+		file   *ast.File
+		pkgDir string
+	}
+
+	// constDecl is a package level const declaration
+	constDecl struct {
+		exprInFile
+		iota int
+	}
+
+	// propertyGetter is the value type and filters of a dynamic config Collection getter
+	propertyGetter struct {
+		valueType string
+		filters   []string
+	}
+
+	// command line config params
+	config struct {
+		rootDir    string
+		outputFile string
+	}
+)
+
+const (
+	dynamicConfigDir  = "common/service/dynamicconfig"
+	constantsFileName = "constants.go"
+	licenseFileName   = "LICENSE"
+	goModFileName     = "go.mod"
+	keyTypeName       = "Key"
+	keysVarName       = "keys"
+
+	// doc comment lines of a key starting with these prefixes are its constraints instead of its description
+	minPrefix           = "Min:"
+	maxPrefix           = "Max:"
+	allowedValuesPrefix = "Allowed values:"
+
+	// max depth of const references followed while evaluating a default
+	maxEvalDepth = 16
+)
+
+var (
+	// directories to be excluded
+	dirBlocklist = []string{".git", ".gen", ".idea", ".vscode", "proto", "vendor"}
+
+	// duration constants of the time package, the only package outside of the module defaults refer to
+	timeConsts = map[string]time.Duration{
+		"Nanosecond":  time.Nanosecond,
+		"Microsecond": time.Microsecond,
+		"Millisecond": time.Millisecond,
+		"Second":      time.Second,
+		"Minute":      time.Minute,
+		"Hour":        time.Hour,
+	}
+
+	// types defaults are converted to, the conversion doesn't change the constant value
+	conversionTypes = map[string]struct{}{
+		"int": {}, "int32": {}, "int64": {}, "uint": {}, "uint32": {}, "uint64": {},
+		"float32": {}, "float64": {}, "Duration": {},
+	}
+
+	namespaceFilters     = []string{"Namespace"}
+	taskQueueInfoFilters = []string{"Namespace", "TaskQueueName", "TaskType"}
+
+	// Collection getters, keyed by method name
+	propertyGetters = map[string]propertyGetter{
+		"GetProperty":                                {valueType: "TypeAny"},
+		"GetIntProperty":                             {valueType: "TypeInt"},
+		"GetIntPropertyFilteredByNamespace":          {valueType: "TypeInt", filters: namespaceFilters},
+		"GetIntPropertyFilteredByTaskQueueInfo":      {valueType: "TypeInt", filters: taskQueueInfoFilters},
+		"GetIntPropertyFilteredByShardID":            {valueType: "TypeInt", filters: []string{"ShardID"}},
+		"GetFloat64Property":                         {valueType: "TypeFloat"},
+		"GetFloat64PropertyFilteredByShardID":        {valueType: "TypeFloat", filters: []string{"ShardID"}},
+		"GetFloatPropertyFilteredByNamespace":        {valueType: "TypeFloat", filters: namespaceFilters},
+		"GetFloatPropertyFilteredByTaskQueueInfo":    {valueType: "TypeFloat", filters: taskQueueInfoFilters},
+		"GetDurationProperty":                        {valueType: "TypeDuration"},
+		"GetDurationPropertyFilteredByNamespace":     {valueType: "TypeDuration", filters: namespaceFilters},
+		"GetDurationPropertyFilteredByNamespaceID":   {valueType: "TypeDuration", filters: []string{"NamespaceID"}},
+		"GetDurationPropertyFilteredByTaskQueueInfo": {valueType: "TypeDuration", filters: taskQueueInfoFilters},
+		"GetDurationPropertyFilteredByAPIName":       {valueType: "TypeDuration", filters: []string{"Namespace", "APIName"}},
+		"GetDurationPropertyFilteredByShardID":       {valueType: "TypeDuration", filters: []string{"ShardID"}},
+		"GetBoolProperty":                            {valueType: "TypeBool"},
+		"GetBoolPropertyFnWithNamespaceFilter":       {valueType: "TypeBool", filters: namespaceFilters},
+		"GetBoolPropertyFnWithNamespaceIDFilter":     {valueType: "TypeBool", filters: []string{"NamespaceID"}},
+		"GetBoolPropertyFilteredByTaskQueueInfo":     {valueType: "TypeBool", filters: taskQueueInfoFilters},
+		"GetStringProperty":                          {valueType: "TypeString"},
+		"GetStringPropertyFnWithNamespaceFilter":     {valueType: "TypeString", filters: namespaceFilters},
+		"GetMapProperty":                             {valueType: "TypeMap"},
+		"GetMapPropertyFnWithNamespaceFilter":        {valueType: "TypeMap", filters: namespaceFilters},
+		"GetMapPropertyFnWithActivityTypeFilter":     {valueType: "TypeMap", filters: []string{"Namespace", "ActivityType"}},
+	}
+)
+
+// command line utility that generates the registry of all dynamic config keys,
+// with their value types, defaults, constraints, filters and descriptions. Usage as follows:
+//
+//	go run ./cmd/tools/dynamicconfig/keygen.go
+func main() {
+	var cfg config
+	flag.StringVar(&cfg.rootDir, "rootDir", ".", "root directory of the project source")
+	flag.StringVar(&cfg.outputFile, "outputFile", filepath.Join(dynamicConfigDir, "registry_gen.go"), "generated file, relative to root directory")
+	flag.Parse()
+
+	task := newGenerateKeyRegistryTask(&cfg)
+	if err := task.run(); err != nil {
+		fmt.Println(err)
+		os.Exit(-1)
+	}
+}
+
+func newGenerateKeyRegistryTask(cfg *config) *generateKeyRegistryTask {
+	return &generateKeyRegistryTask{
+		config:       cfg,
+		fset:         token.NewFileSet(),
+		keys:         make(map[string]*keyInfo),
+		packageNames: make(map[string]string),
+		consts:       make(map[string]map[string]*constDecl),
+	}
+}
+
+func (task *generateKeyRegistryTask) run() error {
+	if err := task.loadModulePath(); err != nil {
+		return fmt.Errorf("error loading module path, err=%v", err.Error())
+	}
+
+	if err := task.loadKeys(); err != nil {
+		return fmt.Errorf("error loading dynamic config keys, err=%v", err.Error())
+	}
+
+	if err := filepath.Walk(task.config.rootDir, task.handleFile); err != nil {
+		return fmt.Errorf("error scanning dynamic config usages, err=%v", err.Error())
+	}
+
+	task.evalDefaults()
+
+	source, err := task.generate()
+	if err != nil {
+		return fmt.Errorf("error generating dynamic config key registry, err=%v", err.Error())
+	}
+	return ioutil.WriteFile(filepath.Join(task.config.rootDir, task.config.outputFile), source, 0644)
+}
+
+// loadKeys collects the exported keys, with their names and doc comments, from the Key definitions
+func (task *generateKeyRegistryTask) loadKeys() error {
+	path := filepath.Join(task.config.rootDir, dynamicConfigDir, constantsFileName)
+	file, err := parser.ParseFile(task.fset, path, nil, parser.ParseComments)
+	if err != nil {
+		return err
+	}
+
+	// the keys map is declared before the Key consts, so the consts are collected first
+	var keysSpec *ast.ValueSpec
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		switch genDecl.Tok {
+		case token.CONST:
+			if !isKeyConstDecl(genDecl) {
+				continue
+			}
+			for _, spec := range genDecl.Specs {
+				valueSpec := spec.(*ast.ValueSpec)
+				for _, ident := range valueSpec.Names {
+					if !ident.IsExported() {
+						continue
+					}
+					key := &keyInfo{constName: ident.Name}
+					if err := key.parseDoc(valueSpec.Doc); err != nil {
+						return fmt.Errorf("key %v: %v", ident.Name, err)
+					}
+					task.keys[ident.Name] = key
+				}
+			}
+
+		case token.VAR:
+			for _, spec := range genDecl.Specs {
+				valueSpec := spec.(*ast.ValueSpec)
+				if len(valueSpec.Names) == 1 && valueSpec.Names[0].Name == keysVarName {
+					keysSpec = valueSpec
+				}
+			}
+		}
+	}
+	if keysSpec == nil {
+		return fmt.Errorf("%v is not declared in %v", keysVarName, path)
+	}
+
+	for _, elt := range keysSpec.Values[0].(*ast.CompositeLit).Elts {
+		kv := elt.(*ast.KeyValueExpr)
+		key, ok := task.keys[kv.Key.(*ast.Ident).Name]
+		if !ok {
+			continue
+		}
+		if key.name, err = strconv.Unquote(kv.Value.(*ast.BasicLit).Value); err != nil {
+			return err
+		}
+	}
+
+	constNames := make(map[string]string, len(task.keys)) // key name -> key const name
+	for _, key := range task.keys {
+		if key.name == "" {
+			return fmt.Errorf("key %v has no name in %v", key.constName, keysVarName)
+		}
+		if constName, ok := constNames[key.name]; ok {
+			return fmt.Errorf("keys %v and %v have the same name %q", constName, key.constName, key.name)
+		}
+		constNames[key.name] = key.constName
+	}
+	return nil
+}
+
+// loadModulePath reads the module path, to map the import paths of the module to directories
+func (task *generateKeyRegistryTask) loadModulePath() error {
+	goMod, err := ioutil.ReadFile(filepath.Join(task.config.rootDir, goModFileName))
+	if err != nil {
+		return err
+	}
+	for _, line := range strings.Split(string(goMod), "\n") {
+		if fields := strings.Fields(line); len(fields) == 2 && fields[0] == "module" {
+			task.modulePath = fields[1]
+			return nil
+		}
+	}
+	return fmt.Errorf("no module directive in %v", goModFileName)
+}
+
+func (task *generateKeyRegistryTask) handleFile(path string, fileInfo os.FileInfo, err error) error {
+	if err != nil {
+		return err
+	}
+
+	if fileInfo.IsDir() {
+		if isBlocklisted(task.config.rootDir, path) {
+			return filepath.SkipDir
+		}
+		return nil
+	}
+
+	if !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") || strings.HasSuffix(path, "_mock.go") {
+		return nil
+	}
+
+	file, err := parser.ParseFile(task.fset, path, nil, 0)
+	if err != nil {
+		return err
+	}
+
+	// consts of every package are collected to evaluate the defaults which refer to them,
+	// but the dynamic config package only reads keys passed by its callers
+	pkgDir := filepath.Dir(path)
+	task.loadConsts(file, pkgDir)
+	if relPath, err := filepath.Rel(task.config.rootDir, pkgDir); err == nil && relPath == filepath.FromSlash(dynamicConfigDir) {
+		return nil
+	}
+
+	ast.Inspect(file, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok || len(call.Args) != 2 {
+			return true
+		}
+		method, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		getter, ok := propertyGetters[method.Sel.Name]
+		if !ok {
+			return true
+		}
+		keyExpr, ok := call.Args[0].(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		key, ok := task.keys[keyExpr.Sel.Name]
+		if !ok {
+			return true
+		}
+
+		if key.valueType == "" {
+			key.valueType = getter.valueType
+		} else if key.valueType != getter.valueType {
+			err = fmt.Errorf("key %v is read as both %v and %v at %v", key.constName, key.valueType, getter.valueType, task.fset.Position(call.Pos()))
+			return false
+		}
+		key.filters = appendUnique(key.filters, getter.filters...)
+		key.defaultExprs = append(key.defaultExprs, &exprInFile{expr: call.Args[1], file: file, pkgDir: pkgDir})
+		return true
+	})
+	return err
+}
+
+// loadConsts collects the package level consts declared in the file
+func (task *generateKeyRegistryTask) loadConsts(file *ast.File, pkgDir string) {
+	task.packageNames[pkgDir] = file.Name.Name
+	consts, ok := task.consts[pkgDir]
+	if !ok {
+		consts = make(map[string]*constDecl)
+		task.consts[pkgDir] = consts
+	}
+
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.CONST {
+			continue
+		}
+		// a const without value repeats the values of the previous one in the declaration
+		var values []ast.Expr
+		for i, spec := range genDecl.Specs {
+			valueSpec := spec.(*ast.ValueSpec)
+			if len(valueSpec.Values) > 0 {
+				values = valueSpec.Values
+			}
+			for j, ident := range valueSpec.Names {
+				if j >= len(values) {
+					break
+				}
+				consts[ident.Name] = &constDecl{
+					exprInFile: exprInFile{expr: values[j], file: file, pkgDir: pkgDir},
+					iota:       i,
+				}
+			}
+		}
+	}
+}
+
+// evalDefaults evaluates the default expressions of all keys. Defaults which are not constant,
+// such as the ones read from the static config, are computed by the server at runtime
+func (task *generateKeyRegistryTask) evalDefaults() {
+	for _, key := range task.keys {
+		for _, expr := range key.defaultExprs {
+			value, ok := task.eval(expr, 0, 0)
+			if !ok {
+				key.computedDefault = true
+				continue
+			}
+			formatted, ok := formatValue(value, key.valueType)
+			if !ok {
+				key.computedDefault = true
+				continue
+			}
+			key.defaults = appendUnique(key.defaults, formatted)
+		}
+	}
+}
+
+// eval evaluates a constant expression
+func (task *generateKeyRegistryTask) eval(e *exprInFile, iota int, depth int) (constant.Value, bool) {
+	if depth > maxEvalDepth {
+		return nil, false
+	}
+	sub := func(expr ast.Expr) (constant.Value, bool) {
+		return task.eval(&exprInFile{expr: expr, file: e.file, pkgDir: e.pkgDir}, iota, depth+1)
+	}
+
+	switch expr := e.expr.(type) {
+	case *ast.BasicLit:
+		value := constant.MakeFromLiteral(expr.Value, expr.Kind, 0)
+		return value, value.Kind() != constant.Unknown
+	case *ast.ParenExpr:
+		return sub(expr.X)
+	case *ast.Ident:
+		switch expr.Name {
+		case "true", "false":
+			return constant.MakeBool(expr.Name == "true"), true
+		case "iota":
+			return constant.MakeInt64(int64(iota)), true
+		}
+		return task.evalConst(e.pkgDir, expr.Name, depth)
+	case *ast.SelectorExpr:
+		pkgIdent, ok := expr.X.(*ast.Ident)
+		if !ok {
+			return nil, false
+		}
+		importPath, ok := task.importPath(e.file, pkgIdent.Name)
+		if !ok {
+			return nil, false
+		}
+		if importPath == "time" {
+			d, ok := timeConsts[expr.Sel.Name]
+			return constant.MakeInt64(int64(d)), ok
+		}
+		if !strings.HasPrefix(importPath, task.modulePath+"/") {
+			return nil, false
+		}
+		pkgDir := filepath.Join(task.config.rootDir, filepath.FromSlash(strings.TrimPrefix(importPath, task.modulePath+"/")))
+		return task.evalConst(pkgDir, expr.Sel.Name, depth)
+	case *ast.UnaryExpr:
+		x, ok := sub(expr.X)
+		if !ok {
+			return nil, false
+		}
+		return constant.UnaryOp(expr.Op, x, 0), true
+	case *ast.BinaryExpr:
+		x, ok := sub(expr.X)
+		if !ok {
+			return nil, false
+		}
+		y, ok := sub(expr.Y)
+		if !ok {
+			return nil, false
+		}
+		if x.Kind() != y.Kind() && (!isNumeric(x) || !isNumeric(y)) {
+			return nil, false
+		}
+		switch expr.Op {
+		case token.SHL, token.SHR:
+			s, ok := constant.Uint64Val(y)
+			if !ok {
+				return nil, false
+			}
+			return constant.Shift(x, expr.Op, uint(s)), true
+		case token.EQL, token.NEQ, token.LSS, token.LEQ, token.GTR, token.GEQ:
+			return constant.MakeBool(constant.Compare(x, expr.Op, y)), true
+		case token.QUO:
+			if x.Kind() == constant.Int && y.Kind() == constant.Int {
+				if constant.Sign(y) == 0 {
+					return nil, false
+				}
+				return constant.BinaryOp(x, token.QUO_ASSIGN, y), true
+			}
+		}
+		return constant.BinaryOp(x, expr.Op, y), true
+	case *ast.CallExpr:
+		// only conversions, e.g. int(task.SchedulerTypeWRR), are constant
+		if len(expr.Args) != 1 || !isConversion(expr.Fun) {
+			return nil, false
+		}
+		return sub(expr.Args[0])
+	}
+	return nil, false
+}
+
+// evalConst evaluates a package level const
+func (task *generateKeyRegistryTask) evalConst(pkgDir string, name string, depth int) (constant.Value, bool) {
+	decl, ok := task.consts[pkgDir][name]
+	if !ok {
+		return nil, false
+	}
+	return task.eval(&decl.exprInFile, decl.iota, depth+1)
+}
+
+// importPath returns the path of the package imported by the file with the name
+func (task *generateKeyRegistryTask) importPath(file *ast.File, name string) (string, bool) {
+	for _, imp := range file.Imports {
+		importPath, err := strconv.Unquote(imp.Path.Value)
+		if err != nil {
+			continue
+		}
+		var importName string
+		switch {
+		case imp.Name != nil:
+			importName = imp.Name.Name
+		case strings.HasPrefix(importPath, task.modulePath+"/"):
+			pkgDir := filepath.Join(task.config.rootDir, filepath.FromSlash(strings.TrimPrefix(importPath, task.modulePath+"/")))
+			importName = task.packageNames[pkgDir]
+		default:
+			importName = path.Base(importPath)
+		}
+		if importName == name {
+			return importPath, true
+		}
+	}
+	return "", false
+}
+
+// generate renders the registry source, keys are sorted by name to keep the output stable
+func (task *generateKeyRegistryTask) generate() ([]byte, error) {
+	license, err := ioutil.ReadFile(filepath.Join(task.config.rootDir, licenseFileName))
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]*keyInfo, 0, len(task.keys))
+	for _, key := range task.keys {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].name < keys[j].name
+	})
+
+	var buf bytes.Buffer
+	for _, line := range strings.Split(strings.TrimRight(string(license), "\n"), "\n") {
+		fmt.Fprintln(&buf, strings.TrimRight("// "+line, " "))
+	}
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "// Code generated by cmd/tools/dynamicconfig/keygen.go. DO NOT EDIT.")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "package dynamicconfig")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "var registry = map[Key]KeyInfo{")
+	for _, key := range keys {
+		valueType := key.valueType
+		if valueType == "" {
+			valueType = "TypeUnknown"
+		}
+		sort.Strings(key.defaults)
+
+		fmt.Fprintf(&buf, "%v: {\n", key.constName)
+		fmt.Fprintf(&buf, "Key: %v,\n", key.constName)
+		fmt.Fprintf(&buf, "Type: %v,\n", valueType)
+		if len(key.defaults) > 0 {
+			fmt.Fprintf(&buf, "Defaults: %#v,\n", key.defaults)
+		}
+		if key.computedDefault {
+			fmt.Fprintln(&buf, "ComputedDefault: true,")
+		}
+		if key.min != "" {
+			fmt.Fprintf(&buf, "Min: %q,\n", key.min)
+		}
+		if key.max != "" {
+			fmt.Fprintf(&buf, "Max: %q,\n", key.max)
+		}
+		if len(key.allowedValues) > 0 {
+			fmt.Fprintf(&buf, "AllowedValues: %#v,\n", key.allowedValues)
+		}
+		if len(key.filters) > 0 {
+			fmt.Fprintf(&buf, "Filters: []Filter{%v},\n", strings.Join(key.filters, ", "))
+		}
+		fmt.Fprintf(&buf, "Description: %q,\n", key.description)
+		fmt.Fprintln(&buf, "},")
+	}
+	fmt.Fprintln(&buf, "}")
+
+	return format.Source(buf.Bytes())
+}
+
+// parseDoc sets the description and the constraints of the key from its doc comment
+func (key *keyInfo) parseDoc(doc *ast.CommentGroup) error {
+	if doc == nil {
+		return nil
+	}
+
+	var descriptionLines []string
+	for _, line := range strings.Split(doc.Text(), "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(line, minPrefix):
+			key.min = strings.TrimSpace(strings.TrimPrefix(line, minPrefix))
+		case strings.HasPrefix(line, maxPrefix):
+			key.max = strings.TrimSpace(strings.TrimPrefix(line, maxPrefix))
+		case strings.HasPrefix(line, allowedValuesPrefix):
+			for _, value := range strings.Split(strings.TrimPrefix(line, allowedValuesPrefix), ",") {
+				key.allowedValues = append(key.allowedValues, strings.TrimSpace(value))
+			}
+		default:
+			descriptionLines = append(descriptionLines, line)
+		}
+	}
+	key.description = strings.Join(strings.Fields(strings.Join(descriptionLines, " ")), " ")
+
+	if key.min != "" && key.max != "" {
+		min, minErr := strconv.ParseFloat(key.min, 64)
+		max, maxErr := strconv.ParseFloat(key.max, 64)
+		if minErr == nil && maxErr == nil && min > max {
+			return fmt.Errorf("min %v is greater than max %v", key.min, key.max)
+		}
+	}
+	return nil
+}
+
+// formatValue formats a constant the way values of the type are written in dynamic config files
+func formatValue(value constant.Value, valueType string) (string, bool) {
+	switch valueType {
+	case "TypeInt":
+		if i, ok := constant.Int64Val(constant.ToInt(value)); ok {
+			return strconv.FormatInt(i, 10), true
+		}
+	case "TypeFloat":
+		if value = constant.ToFloat(value); value.Kind() == constant.Float {
+			// most decimal fractions are not exact as float64, the nearest value is what the server uses
+			f, _ := constant.Float64Val(value)
+			return strconv.FormatFloat(f, 'f', -1, 64), true
+		}
+	case "TypeDuration":
+		if d, ok := constant.Int64Val(constant.ToInt(value)); ok {
+			return time.Duration(d).String(), true
+		}
+	case "TypeBool":
+		if value.Kind() == constant.Bool {
+			return strconv.FormatBool(constant.BoolVal(value)), true
+		}
+	case "TypeString":
+		if value.Kind() == constant.String {
+			return constant.StringVal(value), true
+		}
+	}
+	return "", false
+}
+
+func isNumeric(value constant.Value) bool {
+	switch value.Kind() {
+	case constant.Int, constant.Float:
+		return true
+	}
+	return false
+}
+
+// isConversion returns true if the expression is a type that values can be converted to
+func isConversion(fun ast.Expr) bool {
+	switch fun := fun.(type) {
+	case *ast.Ident:
+		_, ok := conversionTypes[fun.Name]
+		return ok
+	case *ast.SelectorExpr:
+		_, ok := conversionTypes[fun.Sel.Name]
+		return ok
+	}
+	return false
+}
+
+func isKeyConstDecl(genDecl *ast.GenDecl) bool {
+	for _, spec := range genDecl.Specs {
+		valueSpec := spec.(*ast.ValueSpec)
+		if ident, ok := valueSpec.Type.(*ast.Ident); ok && ident.Name == keyTypeName {
+			return true
+		}
+	}
+	return false
+}
+
+func isBlocklisted(rootDir string, path string) bool {
+	relPath, err := filepath.Rel(rootDir, path)
+	if err != nil {
+		return false
+	}
+	for _, dir := range dirBlocklist {
+		if relPath == filepath.FromSlash(dir) {
+			return true
+		}
+	}
+	return false
+}
+
+func appendUnique(values []string, newValues ...string) []string {
+	for _, newValue := range newValues {
+		found := false
+		for _, value := range values {
+			if value == newValue {
+				found = true
+				break
+			}
+		}
+		if !found {
+			values = append(values, newValue)
+		}
+	}
+	return values
+}
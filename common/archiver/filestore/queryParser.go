@@ -36,6 +36,7 @@ import (
 	"github.com/xwb1989/sqlparser"
 	enumspb "go.temporal.io/api/enums/v1"
 
+	archiverspb "go.temporal.io/server/api/archiver/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -57,7 +58,12 @@ type (
 		workflowTypeName  *string
 		status            *enumspb.WorkflowExecutionStatus
 		emptyResult       bool
+		// filter is only set when the query can not be expressed by the fields above,
+		// e.g. queries with "or", "in", "between" or "!=", and is applied to every record
+		filter recordFilter
 	}
+
+	recordFilter func(record *archiverspb.VisibilityRecord) bool
 )
 
 // All allowed fields for filtering
@@ -68,6 +74,31 @@ const (
 	CloseTime    = "CloseTime"
 	// Field name can't be just "Status" because it is reserved keyword in MySQL parser.
 	ExecutionStatus = "ExecutionStatus"
+	StartTime       = "StartTime"
+	ExecutionTime   = "ExecutionTime"
+	HistoryLength   = "HistoryLength"
+)
+
+var (
+	stringFields = map[string]func(record *archiverspb.VisibilityRecord) string{
+		WorkflowID:   func(record *archiverspb.VisibilityRecord) string { return record.GetWorkflowId() },
+		RunID:        func(record *archiverspb.VisibilityRecord) string { return record.GetRunId() },
+		WorkflowType: func(record *archiverspb.VisibilityRecord) string { return record.GetWorkflowTypeName() },
+	}
+
+	// time fields are compared as unix nanos
+	intFields = map[string]func(record *archiverspb.VisibilityRecord) int64{
+		StartTime: func(record *archiverspb.VisibilityRecord) int64 {
+			return timestamp.TimeValue(record.StartTime).UnixNano()
+		},
+		ExecutionTime: func(record *archiverspb.VisibilityRecord) int64 {
+			return timestamp.TimeValue(record.ExecutionTime).UnixNano()
+		},
+		CloseTime: func(record *archiverspb.VisibilityRecord) int64 {
+			return timestamp.TimeValue(record.CloseTime).UnixNano()
+		},
+		HistoryLength: func(record *archiverspb.VisibilityRecord) int64 { return record.GetHistoryLength() },
+	}
 )
 
 const (
@@ -86,17 +117,247 @@ func (p *queryParser) Parse(query string) (*parsedQuery, error) {
 	if err != nil {
 		return nil, err
 	}
-	whereExpr := stmt.(*sqlparser.Select).Where.Expr
+	selectStmt := stmt.(*sqlparser.Select)
+	if err := validateSelectClauses(selectStmt); err != nil {
+		return nil, err
+	}
+	whereExpr := selectStmt.Where.Expr
 	parsedQuery := &parsedQuery{
 		earliestCloseTime: time.Time{},
 		latestCloseTime:   time.Now().UTC(),
 	}
+	if !isSimpleConjunction(whereExpr) {
+		filter, err := p.convertFilterExpr(whereExpr)
+		if err != nil {
+			return nil, err
+		}
+		parsedQuery.filter = filter
+		// close time range of top level conjuncts is still used to skip archived records early
+		p.extractCloseTimeRange(whereExpr, parsedQuery)
+		return parsedQuery, nil
+	}
 	if err := p.convertWhereExpr(whereExpr, parsedQuery); err != nil {
 		return nil, err
 	}
 	return parsedQuery, nil
 }
 
+func validateSelectClauses(stmt *sqlparser.Select) error {
+	if len(stmt.OrderBy) != 0 {
+		return errors.New("order by is not supported by archival visibility query, records are returned in close time descending order")
+	}
+	if len(stmt.GroupBy) != 0 || stmt.Having != nil {
+		return errors.New("group by is not supported by archival visibility query")
+	}
+	if stmt.Limit != nil {
+		return errors.New("limit is not supported by archival visibility query, use page size instead")
+	}
+	return nil
+}
+
+// isSimpleConjunction returns true if the expression only contains "and" of equality
+// filters and close time ranges, which can be captured by parsedQuery fields
+func isSimpleConjunction(expr sqlparser.Expr) bool {
+	switch expr := expr.(type) {
+	case *sqlparser.AndExpr:
+		return isSimpleConjunction(expr.Left) && isSimpleConjunction(expr.Right)
+	case *sqlparser.ParenExpr:
+		return isSimpleConjunction(expr.Expr)
+	case *sqlparser.ComparisonExpr:
+		colName, ok := expr.Left.(*sqlparser.ColName)
+		if !ok {
+			return false
+		}
+		switch sqlparser.String(colName) {
+		case WorkflowID, RunID, WorkflowType, ExecutionStatus:
+			return expr.Operator == sqlparser.EqualStr
+		case CloseTime:
+			return true
+		default:
+			return false
+		}
+	default:
+		return false
+	}
+}
+
+func (p *queryParser) convertFilterExpr(expr sqlparser.Expr) (recordFilter, error) {
+	if expr == nil {
+		return nil, errors.New("where expression is nil")
+	}
+
+	switch expr := expr.(type) {
+	case *sqlparser.AndExpr:
+		left, right, err := p.convertFilterExprPair(expr.Left, expr.Right)
+		if err != nil {
+			return nil, err
+		}
+		return func(record *archiverspb.VisibilityRecord) bool {
+			return left(record) && right(record)
+		}, nil
+	case *sqlparser.OrExpr:
+		left, right, err := p.convertFilterExprPair(expr.Left, expr.Right)
+		if err != nil {
+			return nil, err
+		}
+		return func(record *archiverspb.VisibilityRecord) bool {
+			return left(record) || right(record)
+		}, nil
+	case *sqlparser.NotExpr:
+		filter, err := p.convertFilterExpr(expr.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return func(record *archiverspb.VisibilityRecord) bool {
+			return !filter(record)
+		}, nil
+	case *sqlparser.ParenExpr:
+		return p.convertFilterExpr(expr.Expr)
+	case *sqlparser.ComparisonExpr:
+		return p.convertComparisonFilter(expr)
+	case *sqlparser.RangeCond:
+		return p.convertRangeFilter(expr)
+	default:
+		return nil, fmt.Errorf("expression %s is not supported by archival visibility query", sqlparser.String(expr))
+	}
+}
+
+func (p *queryParser) convertFilterExprPair(left sqlparser.Expr, right sqlparser.Expr) (recordFilter, recordFilter, error) {
+	leftFilter, err := p.convertFilterExpr(left)
+	if err != nil {
+		return nil, nil, err
+	}
+	rightFilter, err := p.convertFilterExpr(right)
+	if err != nil {
+		return nil, nil, err
+	}
+	return leftFilter, rightFilter, nil
+}
+
+func (p *queryParser) convertComparisonFilter(compExpr *sqlparser.ComparisonExpr) (recordFilter, error) {
+	colName, ok := compExpr.Left.(*sqlparser.ColName)
+	if !ok {
+		return nil, fmt.Errorf("invalid filter name: %s", sqlparser.String(compExpr.Left))
+	}
+	colNameStr := sqlparser.String(colName)
+	op := compExpr.Operator
+
+	if getter, ok := stringFields[colNameStr]; ok {
+		values, err := convertStringValues(compExpr.Right, op, extractStringValue)
+		if err != nil {
+			return nil, fmt.Errorf("%v for %s", err, colNameStr)
+		}
+		return newSetFilter(op, values, getter), nil
+	}
+
+	if colNameStr == ExecutionStatus {
+		// statuses are compared by their enum names
+		values, err := convertStringValues(compExpr.Right, op, func(valStr string) (string, error) {
+			val, err := extractStringValue(valStr)
+			if err != nil {
+				// if failed to extract string value, it means user input close status as a number
+				val = valStr
+			}
+			status, err := convertStatusStr(val)
+			if err != nil {
+				return "", err
+			}
+			return status.String(), nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("%v for %s", err, colNameStr)
+		}
+		return newSetFilter(op, values, func(record *archiverspb.VisibilityRecord) string {
+			return record.GetStatus().String()
+		}), nil
+	}
+
+	if getter, ok := intFields[colNameStr]; ok {
+		valExpr, ok := compExpr.Right.(*sqlparser.SQLVal)
+		if !ok {
+			return nil, fmt.Errorf("invalid value: %s", sqlparser.String(compExpr.Right))
+		}
+		val, err := convertIntFieldValue(colNameStr, sqlparser.String(valExpr))
+		if err != nil {
+			return nil, err
+		}
+		return newRangeFilter(colNameStr, op, val, getter)
+	}
+
+	return nil, fmt.Errorf("unknown filter name: %s", colNameStr)
+}
+
+func (p *queryParser) convertRangeFilter(rangeCond *sqlparser.RangeCond) (recordFilter, error) {
+	colName, ok := rangeCond.Left.(*sqlparser.ColName)
+	if !ok {
+		return nil, fmt.Errorf("invalid filter name: %s", sqlparser.String(rangeCond.Left))
+	}
+	colNameStr := sqlparser.String(colName)
+	getter, ok := intFields[colNameStr]
+	if !ok {
+		return nil, fmt.Errorf("operation %s is not supported for %s", rangeCond.Operator, colNameStr)
+	}
+	from, err := convertIntFieldValue(colNameStr, sqlparser.String(rangeCond.From))
+	if err != nil {
+		return nil, err
+	}
+	to, err := convertIntFieldValue(colNameStr, sqlparser.String(rangeCond.To))
+	if err != nil {
+		return nil, err
+	}
+	inRange := func(record *archiverspb.VisibilityRecord) bool {
+		val := getter(record)
+		return val >= from && val <= to
+	}
+
+	switch rangeCond.Operator {
+	case sqlparser.BetweenStr:
+		return inRange, nil
+	case sqlparser.NotBetweenStr:
+		return func(record *archiverspb.VisibilityRecord) bool {
+			return !inRange(record)
+		}, nil
+	default:
+		return nil, fmt.Errorf("operation %s is not supported for %s", rangeCond.Operator, colNameStr)
+	}
+}
+
+func (p *queryParser) extractCloseTimeRange(expr sqlparser.Expr, parsedQuery *parsedQuery) {
+	switch expr := expr.(type) {
+	case *sqlparser.AndExpr:
+		p.extractCloseTimeRange(expr.Left, parsedQuery)
+		p.extractCloseTimeRange(expr.Right, parsedQuery)
+	case *sqlparser.ParenExpr:
+		p.extractCloseTimeRange(expr.Expr, parsedQuery)
+	case *sqlparser.ComparisonExpr:
+		colName, ok := expr.Left.(*sqlparser.ColName)
+		if !ok || sqlparser.String(colName) != CloseTime {
+			return
+		}
+		closeTime, err := convertToTime(sqlparser.String(expr.Right))
+		if err != nil {
+			return
+		}
+		// operators other than range operators are ignored here and handled by the filter
+		_ = p.convertCloseTime(closeTime, expr.Operator, parsedQuery)
+	case *sqlparser.RangeCond:
+		colName, ok := expr.Left.(*sqlparser.ColName)
+		if !ok || sqlparser.String(colName) != CloseTime || expr.Operator != sqlparser.BetweenStr {
+			return
+		}
+		from, err := convertToTime(sqlparser.String(expr.From))
+		if err != nil {
+			return
+		}
+		to, err := convertToTime(sqlparser.String(expr.To))
+		if err != nil {
+			return
+		}
+		_ = p.convertCloseTime(from, ">=", parsedQuery)
+		_ = p.convertCloseTime(to, "<=", parsedQuery)
+	}
+}
+
 func (p *queryParser) convertWhereExpr(expr sqlparser.Expr, parsedQuery *parsedQuery) error {
 	if expr == nil {
 		return errors.New("where expression is nil")
@@ -232,6 +493,93 @@ func (p *queryParser) convertCloseTime(timestamp time.Time, op string, parsedQue
 	return nil
 }
 
+func convertStringValues(expr sqlparser.Expr, op string, convert func(valStr string) (string, error)) ([]string, error) {
+	var valExprs []sqlparser.Expr
+	switch op {
+	case sqlparser.EqualStr, sqlparser.NotEqualStr:
+		valExprs = []sqlparser.Expr{expr}
+	case sqlparser.InStr, sqlparser.NotInStr:
+		tuple, ok := expr.(sqlparser.ValTuple)
+		if !ok {
+			return nil, fmt.Errorf("invalid value list: %s", sqlparser.String(expr))
+		}
+		valExprs = tuple
+	default:
+		return nil, fmt.Errorf("operation %s is not supported", op)
+	}
+
+	values := make([]string, 0, len(valExprs))
+	for _, valExpr := range valExprs {
+		sqlVal, ok := valExpr.(*sqlparser.SQLVal)
+		if !ok {
+			return nil, fmt.Errorf("invalid value: %s", sqlparser.String(valExpr))
+		}
+		val, err := convert(sqlparser.String(sqlVal))
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, val)
+	}
+	return values, nil
+}
+
+func convertIntFieldValue(colName string, valStr string) (int64, error) {
+	if colName == HistoryLength {
+		return strconv.ParseInt(valStr, 10, 64)
+	}
+	t, err := convertToTime(valStr)
+	if err != nil {
+		return 0, err
+	}
+	return t.UnixNano(), nil
+}
+
+// newSetFilter creates a filter for =, !=, in and not in operations, the operation is already validated
+func newSetFilter(
+	op string,
+	values []string,
+	getter func(record *archiverspb.VisibilityRecord) string,
+) recordFilter {
+	valueSet := make(map[string]struct{}, len(values))
+	for _, val := range values {
+		valueSet[val] = struct{}{}
+	}
+	negate := op == sqlparser.NotEqualStr || op == sqlparser.NotInStr
+	return func(record *archiverspb.VisibilityRecord) bool {
+		_, ok := valueSet[getter(record)]
+		return ok != negate
+	}
+}
+
+// newRangeFilter creates a filter for comparison operations on time and integer fields
+func newRangeFilter(
+	colName string,
+	op string,
+	val int64,
+	getter func(record *archiverspb.VisibilityRecord) int64,
+) (recordFilter, error) {
+	var compare func(recordVal int64) bool
+	switch op {
+	case sqlparser.EqualStr:
+		compare = func(recordVal int64) bool { return recordVal == val }
+	case sqlparser.NotEqualStr:
+		compare = func(recordVal int64) bool { return recordVal != val }
+	case sqlparser.LessThanStr:
+		compare = func(recordVal int64) bool { return recordVal < val }
+	case sqlparser.LessEqualStr:
+		compare = func(recordVal int64) bool { return recordVal <= val }
+	case sqlparser.GreaterThanStr:
+		compare = func(recordVal int64) bool { return recordVal > val }
+	case sqlparser.GreaterEqualStr:
+		compare = func(recordVal int64) bool { return recordVal >= val }
+	default:
+		return nil, fmt.Errorf("operation %s is not supported for %s", op, colName)
+	}
+	return func(record *archiverspb.VisibilityRecord) bool {
+		return compare(getter(record))
+	}, nil
+}
+
 func convertToTime(timeStr string) (time.Time, error) {
 	ts, err := strconv.ParseInt(timeStr, 10, 64)
 	if err == nil {
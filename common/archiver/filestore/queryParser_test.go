@@ -32,6 +32,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	enumspb "go.temporal.io/api/enums/v1"
 
+	archiverspb "go.temporal.io/server/api/archiver/v1"
 	"go.temporal.io/server/common/convert"
 )
 
@@ -113,8 +114,9 @@ func (s *queryParserSuite) TestParseWorkflowID_RunID_WorkflowType() {
 			expectErr: true,
 		},
 		{
-			query:     "WorkflowId = \"random workflowID\" or WorkflowId = \"another workflowID\"",
-			expectErr: true,
+			query:       "WorkflowId = \"random workflowID\" or WorkflowId = \"another workflowID\"",
+			expectErr:   false,
+			parsedQuery: &parsedQuery{},
 		},
 		{
 			query:     "WorkflowId = \"random workflowID\" or runId = \"random runID\"",
@@ -213,8 +215,9 @@ func (s *queryParserSuite) TestParseCloseStatus() {
 			expectErr: true,
 		},
 		{
-			query:     "ExecutionStatus = \"Failed\" or ExecutionStatus = \"Failed\"",
-			expectErr: true,
+			query:       "ExecutionStatus = \"Failed\" or ExecutionStatus = \"Failed\"",
+			expectErr:   false,
+			parsedQuery: &parsedQuery{},
 		},
 		{
 			query:     "ExecutionStatus = \"unknown\"",
@@ -365,3 +368,124 @@ func (s *queryParserSuite) TestParse() {
 		}
 	}
 }
+
+func (s *queryParserSuite) TestParseFilter() {
+	startTime := time.Date(2019, 01, 01, 11, 11, 11, 0, time.UTC)
+	closeTime := startTime.Add(time.Hour)
+	record := &archiverspb.VisibilityRecord{
+		WorkflowId:       "random workflowID",
+		RunId:            "random runID",
+		WorkflowTypeName: "random typeName",
+		StartTime:        &startTime,
+		ExecutionTime:    &startTime,
+		CloseTime:        &closeTime,
+		Status:           enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
+		HistoryLength:    10,
+	}
+
+	testCases := []struct {
+		query       string
+		expectErr   bool
+		expectMatch bool
+	}{
+		{
+			query:       "WorkflowId = 'another workflowID' or RunId = 'random runID'",
+			expectMatch: true,
+		},
+		{
+			query:       "WorkflowId = 'another workflowID' or (RunId = 'random runID' and WorkflowType = 'another typeName')",
+			expectMatch: false,
+		},
+		{
+			query:       "WorkflowType in ('random typeName', 'another typeName')",
+			expectMatch: true,
+		},
+		{
+			query:       "WorkflowType not in ('random typeName', 'another typeName')",
+			expectMatch: false,
+		},
+		{
+			query:       "WorkflowId != 'another workflowID'",
+			expectMatch: true,
+		},
+		{
+			query:       "not (ExecutionStatus = 'Failed')",
+			expectMatch: false,
+		},
+		{
+			query:       "ExecutionStatus in ('Completed', 'Failed')",
+			expectMatch: true,
+		},
+		{
+			query:       "ExecutionStatus != 'Failed'",
+			expectMatch: false,
+		},
+		{
+			query:       "StartTime between '2019-01-01T00:00:00Z' and '2019-01-02T00:00:00Z'",
+			expectMatch: true,
+		},
+		{
+			query:       "StartTime not between '2019-01-01T00:00:00Z' and '2019-01-02T00:00:00Z'",
+			expectMatch: false,
+		},
+		{
+			query:       "ExecutionTime > '2019-01-01T11:11:11Z'",
+			expectMatch: false,
+		},
+		{
+			query:       "HistoryLength >= 10 and CloseTime <= '2019-01-01T12:11:11Z'",
+			expectMatch: true,
+		},
+		{
+			query:       "HistoryLength < 10 or CloseTime != '2019-01-01T12:11:11Z'",
+			expectMatch: false,
+		},
+		{
+			query:     "WorkflowId > 'random workflowID' or RunId = 'random runID'",
+			expectErr: true,
+		},
+		{
+			query:     "HistoryLength in (1, 2)",
+			expectErr: true,
+		},
+		{
+			query:     "WorkflowId like 'random%'",
+			expectErr: true,
+		},
+		{
+			query:     "RunId = 'random runID' or unknownField = 'value'",
+			expectErr: true,
+		},
+	}
+
+	for i, tc := range testCases {
+		parsedQuery, err := s.parser.Parse(tc.query)
+		if tc.expectErr {
+			s.Error(err, "case %d", i)
+			continue
+		}
+		s.NoError(err, "case %d", i)
+		s.Equal(tc.expectMatch, matchQuery(record, parsedQuery), "case %d", i)
+	}
+}
+
+func (s *queryParserSuite) TestParseFilter_CloseTimeRange() {
+	parsedQuery, err := s.parser.Parse("CloseTime >= 1000 and CloseTime < 2000 and (WorkflowId = 'random workflowID' or RunId = 'random runID')")
+	s.NoError(err)
+	s.NotNil(parsedQuery.filter)
+	s.True(time.Unix(0, 1000).Equal(parsedQuery.earliestCloseTime))
+	s.True(time.Unix(0, 1999).Equal(parsedQuery.latestCloseTime))
+}
+
+func (s *queryParserSuite) TestParse_UnsupportedClauses() {
+	testCases := []string{
+		"WorkflowId = 'random workflowID' order by CloseTime desc",
+		"WorkflowId = 'random workflowID' limit 10",
+		"WorkflowId = 'random workflowID' group by WorkflowType",
+	}
+
+	for _, query := range testCases {
+		_, err := s.parser.Parse(query)
+		s.Error(err, query)
+	}
+}
@@ -325,6 +325,9 @@ func matchQuery(record *archiverspb.VisibilityRecord, query *parsedQuery) bool {
 	if query.status != nil && record.Status != *query.status {
 		return false
 	}
+	if query.filter != nil && !query.filter(record) {
+		return false
+	}
 	return true
 }
 
@@ -89,7 +89,11 @@ func (p *queryParser) Parse(query string) (*parsedQuery, error) {
 	if err != nil {
 		return nil, err
 	}
-	whereExpr := stmt.(*sqlparser.Select).Where.Expr
+	selectStmt := stmt.(*sqlparser.Select)
+	if err := validateSelectClauses(selectStmt); err != nil {
+		return nil, err
+	}
+	whereExpr := selectStmt.Where.Expr
 	parsedQuery := &parsedQuery{}
 	if err := p.convertWhereExpr(whereExpr, parsedQuery); err != nil {
 		return nil, err
@@ -118,6 +122,12 @@ func (p *queryParser) convertWhereExpr(expr sqlparser.Expr, parsedQuery *parsedQ
 		return p.convertAndExpr(expr.(*sqlparser.AndExpr), parsedQuery)
 	case *sqlparser.ParenExpr:
 		return p.convertParenExpr(expr.(*sqlparser.ParenExpr), parsedQuery)
+	case *sqlparser.OrExpr:
+		return errUnsupportedExpr("or")
+	case *sqlparser.NotExpr:
+		return errUnsupportedExpr("not")
+	case *sqlparser.RangeCond:
+		return errUnsupportedExpr("between")
 	default:
 		return errors.New("only comparsion and \"and\" expression is supported")
 	}
@@ -141,6 +151,9 @@ func (p *queryParser) convertComparisonExpr(compExpr *sqlparser.ComparisonExpr,
 	}
 	colNameStr := sqlparser.String(colName)
 	op := compExpr.Operator
+	if op != sqlparser.EqualStr {
+		return errUnsupportedExpr(op)
+	}
 	valExpr, ok := compExpr.Right.(*sqlparser.SQLVal)
 	if !ok {
 		return fmt.Errorf("invalid value: %s", sqlparser.String(compExpr.Right))
@@ -248,6 +261,25 @@ func (p *queryParser) convertCloseTime(timestamp time.Time, op string, parsedQue
 	return nil
 }
 
+// The gcloud archiver lists records by the prefixes it indexes them with, so unlike the
+// filestore archiver it can not evaluate "or", "not", "in", "between" or range filters.
+func errUnsupportedExpr(expr string) error {
+	return fmt.Errorf("%s is not supported by gcloud archival visibility query, only = filters combined with and are supported", expr)
+}
+
+func validateSelectClauses(stmt *sqlparser.Select) error {
+	if len(stmt.OrderBy) != 0 {
+		return errors.New("order by is not supported by archival visibility query")
+	}
+	if len(stmt.GroupBy) != 0 || stmt.Having != nil {
+		return errors.New("group by is not supported by archival visibility query")
+	}
+	if stmt.Limit != nil {
+		return errors.New("limit is not supported by archival visibility query, use page size instead")
+	}
+	return nil
+}
+
 func convertToTime(timeStr string) (time.Time, error) {
 	timestampStr, err := extractStringValue(timeStr)
 	if err != nil {
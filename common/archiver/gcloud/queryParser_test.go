@@ -0,0 +1,91 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gcloud
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type queryParserSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	parser QueryParser
+}
+
+func TestQueryParserSuite(t *testing.T) {
+	suite.Run(t, new(queryParserSuite))
+}
+
+func (s *queryParserSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.parser = NewQueryParser()
+}
+
+func (s *queryParserSuite) TestParseUnsupportedExpressions() {
+	commonQueryPart := "StartTime = '2019-10-04T11:00:00+00:00' and SearchPrecision = 'Day' and "
+	testCases := []struct {
+		query  string
+		errMsg string
+	}{
+		{
+			query:  commonQueryPart + "(WorkflowId = 'random workflowID' or WorkflowId = 'another workflowID')",
+			errMsg: "or is not supported by gcloud archival visibility query",
+		},
+		{
+			query:  commonQueryPart + "not WorkflowId = 'random workflowID'",
+			errMsg: "not is not supported by gcloud archival visibility query",
+		},
+		{
+			query:  commonQueryPart + "WorkflowType in ('random workflowType', 'another workflowType')",
+			errMsg: "in is not supported by gcloud archival visibility query",
+		},
+		{
+			query:  commonQueryPart + "RunId != 'random runID'",
+			errMsg: "!= is not supported by gcloud archival visibility query",
+		},
+		{
+			query:  "CloseTime between '2019-10-04T11:00:00+00:00' and '2019-10-05T11:00:00+00:00' and SearchPrecision = 'Day'",
+			errMsg: "between is not supported by gcloud archival visibility query",
+		},
+		{
+			query:  commonQueryPart + "WorkflowId = 'random workflowID' order by CloseTime",
+			errMsg: "order by is not supported by archival visibility query",
+		},
+		{
+			query:  commonQueryPart + "WorkflowId = 'random workflowID' group by WorkflowType",
+			errMsg: "group by is not supported by archival visibility query",
+		},
+	}
+
+	for _, tc := range testCases {
+		_, err := s.parser.Parse(tc.query)
+		s.Error(err)
+		s.Contains(err.Error(), tc.errMsg)
+	}
+}
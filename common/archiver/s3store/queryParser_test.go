@@ -274,3 +274,45 @@ func (s *queryParserSuite) TestParseStartTime() {
 		s.Equal(tc.parsedQuery.closeTime, parsedQuery.closeTime)
 	}
 }
+
+func (s *queryParserSuite) TestParseUnsupportedExpressions() {
+	testCases := []struct {
+		query  string
+		errMsg string
+	}{
+		{
+			query:  "WorkflowId = \"random workflowID\" or WorkflowId = \"another workflowID\"",
+			errMsg: "or is not supported by s3store archival visibility query",
+		},
+		{
+			query:  "not WorkflowId = \"random workflowID\"",
+			errMsg: "not is not supported by s3store archival visibility query",
+		},
+		{
+			query:  "WorkflowId in (\"random workflowID\", \"another workflowID\")",
+			errMsg: "in is not supported by s3store archival visibility query",
+		},
+		{
+			query:  "WorkflowId != \"random workflowID\"",
+			errMsg: "!= is not supported by s3store archival visibility query",
+		},
+		{
+			query:  "WorkflowId = \"random workflowID\" and CloseTime between 1000 and 2000",
+			errMsg: "between is not supported by s3store archival visibility query",
+		},
+		{
+			query:  "WorkflowId = \"random workflowID\" order by CloseTime",
+			errMsg: "order by is not supported by archival visibility query",
+		},
+		{
+			query:  "WorkflowId = \"random workflowID\" limit 10",
+			errMsg: "limit is not supported by archival visibility query",
+		},
+	}
+
+	for _, tc := range testCases {
+		_, err := s.parser.Parse(tc.query)
+		s.Error(err)
+		s.Contains(err.Error(), tc.errMsg)
+	}
+}
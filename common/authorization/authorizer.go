@@ -77,12 +77,25 @@ type requestWithNamespace interface {
 }
 
 func GetAuthorizerFromConfig(config *config.Authorization) (Authorizer, error) {
+	return newAuthorizer(config.Authorizer)
+}
+
+// GetOperatorAuthorizerFromConfig returns the authorizer for operator (admin service) APIs,
+// which falls back to the data plane authorizer if no operator authorizer is configured
+func GetOperatorAuthorizerFromConfig(config *config.Authorization) (Authorizer, error) {
+	if config.OperatorAuthorizer == "" {
+		return GetAuthorizerFromConfig(config)
+	}
+	return newAuthorizer(config.OperatorAuthorizer)
+}
+
+func newAuthorizer(name string) (Authorizer, error) {
 
-	switch strings.ToLower(config.Authorizer) {
+	switch strings.ToLower(name) {
 	case "":
 		return NewNoopAuthorizer(), nil
 	case "default":
 		return NewDefaultAuthorizer(), nil
 	}
-	return nil, fmt.Errorf("unknown authorizer: %s", config.Authorizer)
+	return nil, fmt.Errorf("unknown authorizer: %s", name)
 }
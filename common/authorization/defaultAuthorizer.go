@@ -24,7 +24,38 @@
 
 package authorization
 
-import "context"
+import (
+	"context"
+	"strings"
+)
+
+const (
+	adminServiceAPIPrefix = "/temporal.server.api.adminservice.v1.AdminService/"
+)
+
+// namespaceAdminAPIs are admin APIs which only access data of a single namespace,
+// mapped to the system and namespace roles they are available to. This way tooling
+// and workers don't need system writer or admin access. All other admin APIs are
+// operator APIs, see IsNamespaceAdminAPI.
+var namespaceAdminAPIs = map[string]Role{
+	"GetWorkflowExecutionRawHistoryV2": RoleReader | RoleWriter | RoleAdmin,
+	"ShutdownWorker":                   RoleWorker | RoleWriter | RoleAdmin,
+	"DescribeNamespaceConfig":          RoleReader | RoleWriter | RoleAdmin,
+	"PauseWorkflowExecution":           RoleWriter | RoleAdmin,
+	"UnpauseWorkflowExecution":         RoleWriter | RoleAdmin,
+	"BatchCompleteActivityTasksById":   RoleWorker | RoleWriter | RoleAdmin,
+	"BatchFailActivityTasksById":       RoleWorker | RoleWriter | RoleAdmin,
+}
+
+// mutatingAdminAPIRoles are the namespace roles which may call admin APIs that are not namespace admin APIs.
+// These APIs may change the state of the namespace, so readers and workers can't call them.
+const mutatingAdminAPIRoles = RoleWriter | RoleAdmin
+
+// systemAdminAPIs are admin APIs which are called by the services of remote clusters and must never be
+// allowed based on the namespace of the call, since their requests don't carry a namespace name.
+var systemAdminAPIs = map[string]struct{}{
+	"ExecuteCrossClusterTask": {},
+}
 
 type defaultAuthorizer struct{}
 
@@ -35,6 +66,13 @@ func NewDefaultAuthorizer() Authorizer {
 
 func (a *defaultAuthorizer) Authorize(_ context.Context, claims *Claims, target *CallTarget) (Result, error) {
 
+	if a.isSystemAdminAPI(target) {
+		if claims != nil && (claims.System == RoleAdmin || claims.System == RoleWriter) {
+			return Result{Decision: DecisionAllow}, nil
+		}
+		return Result{Decision: DecisionDeny}, nil
+	}
+
 	// TODO: This is a temporary workaround to allow calls to system namespace and
 	// calls with no namespace to pass through. When handling of mTLS data is added,
 	// we should remove "temporal-system" from here. Handling of call with
@@ -50,11 +88,59 @@ func (a *defaultAuthorizer) Authorize(_ context.Context, claims *Claims, target
 	if claims.System == RoleAdmin || claims.System == RoleWriter {
 		return Result{Decision: DecisionAllow}, nil
 	}
+	if a.isNamespaceAdminAPIAllowed(claims, target) {
+		return Result{Decision: DecisionAllow}, nil
+	}
 	roles, found := claims.Namespaces[target.Namespace]
 	if !found || roles == RoleUndefined {
 		return Result{Decision: DecisionDeny}, nil
 	}
+	if IsAdminAPI(target.APIName) && roles&adminAPIRoles(target.APIName) == 0 {
+		return Result{Decision: DecisionDeny}, nil
+	}
 	return Result{Decision: DecisionAllow}, nil
 }
 
+// isNamespaceAdminAPIAllowed returns true if the call targets a namespace admin API
+// which is available to the system role of the caller
+func (a *defaultAuthorizer) isNamespaceAdminAPIAllowed(claims *Claims, target *CallTarget) bool {
+	if !IsNamespaceAdminAPI(target.APIName) {
+		return false
+	}
+	return claims.System&adminAPIRoles(target.APIName) != 0
+}
+
+// adminAPIRoles returns the namespace roles which may call the admin API
+func adminAPIRoles(apiName string) Role {
+	if allowedRoles, ok := namespaceAdminAPIs[strings.TrimPrefix(apiName, adminServiceAPIPrefix)]; ok {
+		return allowedRoles
+	}
+	return mutatingAdminAPIRoles
+}
+
+// IsAdminAPI returns true if the full grpc method name is an API of the admin service
+func IsAdminAPI(apiName string) bool {
+	return strings.HasPrefix(apiName, adminServiceAPIPrefix)
+}
+
+// IsNamespaceAdminAPI returns true if the full grpc method name is an admin API which only accesses
+// data of a single namespace. These APIs are served to data plane callers, all other admin APIs are
+// operator APIs which can be served on a separate listener with their own authorizer.
+func IsNamespaceAdminAPI(apiName string) bool {
+	if !IsAdminAPI(apiName) {
+		return false
+	}
+	_, ok := namespaceAdminAPIs[strings.TrimPrefix(apiName, adminServiceAPIPrefix)]
+	return ok
+}
+
+// isSystemAdminAPI returns true if the call targets an admin API which is only available to system writers and admins
+func (a *defaultAuthorizer) isSystemAdminAPI(target *CallTarget) bool {
+	if !IsAdminAPI(target.APIName) {
+		return false
+	}
+	_, ok := systemAdminAPIs[strings.TrimPrefix(target.APIName, adminServiceAPIPrefix)]
+	return ok
+}
+
 var _ Authorizer = (*defaultAuthorizer)(nil)
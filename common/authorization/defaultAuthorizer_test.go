@@ -45,6 +45,9 @@ var (
 	claimsSystemReader = Claims{
 		System: RoleReader,
 	}
+	claimsSystemWorker = Claims{
+		System: RoleWorker,
+	}
 	claimsSystemReaderNamespaceUndefined = Claims{
 		System: RoleReader,
 		Namespaces: map[string]Role{
@@ -58,10 +61,50 @@ var (
 		},
 	}
 
+	claimsSystemUndefinedNamespaceWorker = Claims{
+		System: RoleUndefined,
+		Namespaces: map[string]Role{
+			"Bar": RoleWorker,
+		},
+	}
+	claimsSystemUndefinedNamespaceWriter = Claims{
+		System: RoleUndefined,
+		Namespaces: map[string]Role{
+			"Bar": RoleWriter,
+		},
+	}
+
 	targetFooBar = CallTarget{
 		APIName:   "Foo",
 		Namespace: "Bar",
 	}
+	targetAdminRawHistoryBar = CallTarget{
+		APIName:   "/temporal.server.api.adminservice.v1.AdminService/GetWorkflowExecutionRawHistoryV2",
+		Namespace: "Bar",
+	}
+	targetAdminShutdownWorkerBar = CallTarget{
+		APIName:   "/temporal.server.api.adminservice.v1.AdminService/ShutdownWorker",
+		Namespace: "Bar",
+	}
+	targetAdminDescribeNamespaceConfigBar = CallTarget{
+		APIName:   "/temporal.server.api.adminservice.v1.AdminService/DescribeNamespaceConfig",
+		Namespace: "Bar",
+	}
+	targetAdminPauseWorkflowBar = CallTarget{
+		APIName:   "/temporal.server.api.adminservice.v1.AdminService/PauseWorkflowExecution",
+		Namespace: "Bar",
+	}
+	targetAdminBatchCompleteActivityTasksBar = CallTarget{
+		APIName:   "/temporal.server.api.adminservice.v1.AdminService/BatchCompleteActivityTasksById",
+		Namespace: "Bar",
+	}
+	targetAdminExecuteCrossClusterTask = CallTarget{
+		APIName: "/temporal.server.api.adminservice.v1.AdminService/ExecuteCrossClusterTask",
+	}
+	targetAdminRefreshTasksBar = CallTarget{
+		APIName:   "/temporal.server.api.adminservice.v1.AdminService/RefreshWorkflowTasks",
+		Namespace: "Bar",
+	}
 )
 
 type (
@@ -114,6 +157,100 @@ func (s *defaultAuthorizerSuite) TestSystemUndefinedNamespaceReaderAuthZ() {
 	s.NoError(err)
 	s.Equal(DecisionDeny, result.Decision)
 }
+func (s *defaultAuthorizerSuite) TestAdminAPISystemAdminAuthZ() {
+	result, err := s.authorizer.Authorize(nil, &claimsSystemAdmin, &targetAdminRefreshTasksBar)
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+}
+func (s *defaultAuthorizerSuite) TestAdminAPISystemWriterAuthZ() {
+	result, err := s.authorizer.Authorize(nil, &claimsSystemWriter, &targetAdminRefreshTasksBar)
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+}
+func (s *defaultAuthorizerSuite) TestAdminAPISystemReaderAuthZ() {
+	result, err := s.authorizer.Authorize(nil, &claimsSystemReader, &targetAdminRefreshTasksBar)
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+}
+func (s *defaultAuthorizerSuite) TestAdminAPINamespaceReaderAuthZ() {
+	result, err := s.authorizer.Authorize(nil, &claimsSystemUndefinedNamespaceReader, &targetAdminRefreshTasksBar)
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+}
+func (s *defaultAuthorizerSuite) TestAdminAPINamespaceWorkerAuthZ() {
+	result, err := s.authorizer.Authorize(nil, &claimsSystemUndefinedNamespaceWorker, &targetAdminRefreshTasksBar)
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+}
+func (s *defaultAuthorizerSuite) TestAdminAPINamespaceWriterAuthZ() {
+	result, err := s.authorizer.Authorize(nil, &claimsSystemUndefinedNamespaceWriter, &targetAdminRefreshTasksBar)
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+}
+func (s *defaultAuthorizerSuite) TestAdminRawHistoryNamespaceReaderAuthZ() {
+	result, err := s.authorizer.Authorize(nil, &claimsSystemUndefinedNamespaceReader, &targetAdminRawHistoryBar)
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+}
+func (s *defaultAuthorizerSuite) TestAdminRawHistorySystemReaderAuthZ() {
+	result, err := s.authorizer.Authorize(nil, &claimsSystemReader, &targetAdminRawHistoryBar)
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+}
+func (s *defaultAuthorizerSuite) TestAdminRawHistorySystemWorkerAuthZ() {
+	result, err := s.authorizer.Authorize(nil, &claimsSystemWorker, &targetAdminRawHistoryBar)
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+}
+func (s *defaultAuthorizerSuite) TestAdminShutdownWorkerSystemWorkerAuthZ() {
+	result, err := s.authorizer.Authorize(nil, &claimsSystemWorker, &targetAdminShutdownWorkerBar)
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+}
+func (s *defaultAuthorizerSuite) TestAdminDescribeNamespaceConfigSystemReaderAuthZ() {
+	result, err := s.authorizer.Authorize(nil, &claimsSystemReader, &targetAdminDescribeNamespaceConfigBar)
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+}
+func (s *defaultAuthorizerSuite) TestAdminPauseWorkflowSystemReaderAuthZ() {
+	result, err := s.authorizer.Authorize(nil, &claimsSystemReader, &targetAdminPauseWorkflowBar)
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+}
+func (s *defaultAuthorizerSuite) TestAdminPauseWorkflowNamespaceReaderAuthZ() {
+	result, err := s.authorizer.Authorize(nil, &claimsSystemUndefinedNamespaceReader, &targetAdminPauseWorkflowBar)
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+}
+func (s *defaultAuthorizerSuite) TestAdminPauseWorkflowNamespaceWriterAuthZ() {
+	result, err := s.authorizer.Authorize(nil, &claimsSystemUndefinedNamespaceWriter, &targetAdminPauseWorkflowBar)
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+}
+func (s *defaultAuthorizerSuite) TestAdminBatchCompleteActivityTasksSystemWorkerAuthZ() {
+	result, err := s.authorizer.Authorize(nil, &claimsSystemWorker, &targetAdminBatchCompleteActivityTasksBar)
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+}
+func (s *defaultAuthorizerSuite) TestAdminBatchCompleteActivityTasksSystemReaderAuthZ() {
+	result, err := s.authorizer.Authorize(nil, &claimsSystemReader, &targetAdminBatchCompleteActivityTasksBar)
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+}
+func (s *defaultAuthorizerSuite) TestAdminExecuteCrossClusterTaskAuthZ() {
+	result, err := s.authorizer.Authorize(nil, nil, &targetAdminExecuteCrossClusterTask)
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+	result, err = s.authorizer.Authorize(nil, &claimsSystemReader, &targetAdminExecuteCrossClusterTask)
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+	result, err = s.authorizer.Authorize(nil, &claimsSystemUndefinedNamespaceReader, &targetAdminExecuteCrossClusterTask)
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+	result, err = s.authorizer.Authorize(nil, &claimsSystemWriter, &targetAdminExecuteCrossClusterTask)
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+}
 func (s *defaultAuthorizerSuite) TestGetAuthorizerFromConfigNoop() {
 	s.testGetAuthorizerFromConfig("", true, reflect.TypeOf(&noopAuthorizer{}))
 }
@@ -124,6 +261,42 @@ func (s *defaultAuthorizerSuite) TestGetAuthorizerFromConfigUnknown() {
 	s.testGetAuthorizerFromConfig("foo", false, nil)
 }
 
+func (s *defaultAuthorizerSuite) TestGetOperatorAuthorizerFromConfig() {
+	auth, err := GetOperatorAuthorizerFromConfig(&config.Authorization{Authorizer: "default"})
+	s.NoError(err)
+	s.Equal(reflect.TypeOf(&defaultAuthorizer{}), reflect.TypeOf(auth))
+
+	auth, err = GetOperatorAuthorizerFromConfig(&config.Authorization{OperatorAuthorizer: "default"})
+	s.NoError(err)
+	s.Equal(reflect.TypeOf(&defaultAuthorizer{}), reflect.TypeOf(auth))
+
+	_, err = GetOperatorAuthorizerFromConfig(&config.Authorization{OperatorAuthorizer: "foo"})
+	s.Error(err)
+}
+
+func (s *defaultAuthorizerSuite) TestOperatorRoutingAuthorizer() {
+	authorizer := NewOperatorRoutingAuthorizer(NewNoopAuthorizer(), NewDefaultAuthorizer())
+	result, err := authorizer.Authorize(nil, &claimsSystemReader, &targetAdminRefreshTasksBar)
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+	result, err = authorizer.Authorize(nil, &claimsSystemUndefinedNamespaceWorker, &targetFooBar)
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+	// namespace admin APIs are served to data plane callers
+	result, err = authorizer.Authorize(nil, &claimsSystemUndefinedNamespaceWorker, &targetAdminRawHistoryBar)
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+}
+
+func (s *defaultAuthorizerSuite) TestIsNamespaceAdminAPI() {
+	s.True(IsAdminAPI(targetAdminRawHistoryBar.APIName))
+	s.True(IsNamespaceAdminAPI(targetAdminRawHistoryBar.APIName))
+	s.True(IsAdminAPI(targetAdminRefreshTasksBar.APIName))
+	s.False(IsNamespaceAdminAPI(targetAdminRefreshTasksBar.APIName))
+	s.False(IsAdminAPI(targetFooBar.APIName))
+	s.False(IsNamespaceAdminAPI(targetFooBar.APIName))
+}
+
 func (s *defaultAuthorizerSuite) testGetAuthorizerFromConfig(name string, valid bool, authorizerType reflect.Type) {
 
 	cfg := config.Authorization{Authorizer: name}
@@ -0,0 +1,52 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+)
+
+type operatorRoutingAuthorizer struct {
+	authorizer         Authorizer
+	operatorAuthorizer Authorizer
+}
+
+// NewOperatorRoutingAuthorizer creates an authorizer which authorizes operator (admin service) APIs
+// with operatorAuthorizer and all other APIs with authorizer. Admin service APIs which only access
+// data of a single namespace are served to data plane callers and authorized with authorizer.
+// It is used when operator APIs share the listener of data plane APIs.
+func NewOperatorRoutingAuthorizer(authorizer Authorizer, operatorAuthorizer Authorizer) Authorizer {
+	return &operatorRoutingAuthorizer{
+		authorizer:         authorizer,
+		operatorAuthorizer: operatorAuthorizer,
+	}
+}
+
+func (a *operatorRoutingAuthorizer) Authorize(ctx context.Context, claims *Claims, target *CallTarget) (Result, error) {
+	if IsAdminAPI(target.APIName) && !IsNamespaceAdminAPI(target.APIName) {
+		return a.operatorAuthorizer.Authorize(ctx, claims, target)
+	}
+	return a.authorizer.Authorize(ctx, claims, target)
+}
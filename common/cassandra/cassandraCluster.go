@@ -139,10 +139,51 @@ func NewCassandraCluster(cfg config.Cassandra, r resolver.ServiceResolver) (*goc
 		cluster.ConnectTimeout = cfg.ConnectTimeout
 	}
 
-	cluster.PoolConfig.HostSelectionPolicy = gocql.TokenAwareHostPolicy(gocql.RoundRobinHostPolicy())
+	cluster.PoolConfig.HostSelectionPolicy = newHostSelectionPolicy(cfg)
 	return cluster, nil
 }
 
+func newHostSelectionPolicy(cfg config.Cassandra) gocql.HostSelectionPolicy {
+	policyCfg := cfg.HostSelectionPolicy
+	if policyCfg == nil {
+		return gocql.TokenAwareHostPolicy(gocql.RoundRobinHostPolicy())
+	}
+
+	var policy gocql.HostSelectionPolicy
+	switch policyCfg.Policy {
+	case config.CassandraDCAwareRoundRobinHostPolicy:
+		localDatacenter := policyCfg.LocalDatacenter
+		if localDatacenter == "" {
+			localDatacenter = cfg.Datacenter
+		}
+		policy = gocql.DCAwareRoundRobinPolicy(localDatacenter)
+	case config.CassandraRackAwareRoundRobinHostPolicy:
+		localDatacenter := policyCfg.LocalDatacenter
+		if localDatacenter == "" {
+			localDatacenter = cfg.Datacenter
+		}
+		policy = newRackAwareRoundRobinPolicy(localDatacenter, policyCfg.LocalRack)
+	default:
+		policy = gocql.RoundRobinHostPolicy()
+	}
+
+	if policyCfg.DisableTokenAware {
+		return policy
+	}
+
+	// token aware options are typed on an unexported gocql type so they cannot be collected into a slice
+	switch {
+	case policyCfg.ShuffleReplicas && policyCfg.NonLocalReplicasFallback:
+		return gocql.TokenAwareHostPolicy(policy, gocql.ShuffleReplicas(), gocql.NonLocalReplicasFallback())
+	case policyCfg.ShuffleReplicas:
+		return gocql.TokenAwareHostPolicy(policy, gocql.ShuffleReplicas())
+	case policyCfg.NonLocalReplicasFallback:
+		return gocql.TokenAwareHostPolicy(policy, gocql.NonLocalReplicasFallback())
+	default:
+		return gocql.TokenAwareHostPolicy(policy)
+	}
+}
+
 func parseHosts(input string) []string {
 	var hosts []string
 	for _, h := range strings.Split(input, ",") {
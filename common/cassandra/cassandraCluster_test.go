@@ -128,6 +128,25 @@ func TestNewCassandraCluster(t *testing.T) {
 			},
 			err: errors.New("Cannot specify both caData and caFile properties"),
 		},
+		"hostSelectionPolicy_dcAware": {
+			cfg: config.Cassandra{
+				Datacenter: "dc1",
+				HostSelectionPolicy: &config.CassandraHostSelectionPolicy{
+					Policy:                   config.CassandraDCAwareRoundRobinHostPolicy,
+					ShuffleReplicas:          true,
+					NonLocalReplicasFallback: true,
+				},
+			},
+			err: nil,
+		},
+		"hostSelectionPolicy_tokenAwareDisabled": {
+			cfg: config.Cassandra{
+				HostSelectionPolicy: &config.CassandraHostSelectionPolicy{
+					DisableTokenAware: true,
+				},
+			},
+			err: nil,
+		},
 	}
 
 	for name, tc := range tests {
@@ -142,3 +161,79 @@ func TestNewCassandraCluster(t *testing.T) {
 		})
 	}
 }
+
+func TestNewHostSelectionPolicy(t *testing.T) {
+	tests := map[string]struct {
+		cfg        config.Cassandra
+		policyType string
+	}{
+		"default": {
+			cfg:        config.Cassandra{},
+			policyType: "*gocql.tokenAwareHostPolicy",
+		},
+		"roundRobin": {
+			cfg: config.Cassandra{
+				HostSelectionPolicy: &config.CassandraHostSelectionPolicy{
+					DisableTokenAware: true,
+				},
+			},
+			policyType: "*gocql.roundRobinHostPolicy",
+		},
+		"dcAware": {
+			cfg: config.Cassandra{
+				Datacenter: "dc1",
+				HostSelectionPolicy: &config.CassandraHostSelectionPolicy{
+					Policy:            config.CassandraDCAwareRoundRobinHostPolicy,
+					DisableTokenAware: true,
+				},
+			},
+			policyType: "*gocql.dcAwareRR",
+		},
+		"rackAware": {
+			cfg: config.Cassandra{
+				Datacenter: "dc1",
+				HostSelectionPolicy: &config.CassandraHostSelectionPolicy{
+					Policy:            config.CassandraRackAwareRoundRobinHostPolicy,
+					LocalRack:         "rack1",
+					DisableTokenAware: true,
+				},
+			},
+			policyType: "*cassandra.rackAwareRoundRobinPolicy",
+		},
+		"rackAware_tokenAware": {
+			cfg: config.Cassandra{
+				Datacenter: "dc1",
+				HostSelectionPolicy: &config.CassandraHostSelectionPolicy{
+					Policy:    config.CassandraRackAwareRoundRobinHostPolicy,
+					LocalRack: "rack1",
+				},
+			},
+			policyType: "*gocql.tokenAwareHostPolicy",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.policyType, fmt.Sprintf("%T", newHostSelectionPolicy(tc.cfg)))
+		})
+	}
+}
+
+func TestNewHostSelectionPolicy_RackAware(t *testing.T) {
+	policy, ok := newHostSelectionPolicy(config.Cassandra{
+		HostSelectionPolicy: &config.CassandraHostSelectionPolicy{
+			Policy:            config.CassandraRackAwareRoundRobinHostPolicy,
+			LocalDatacenter:   "dc1",
+			LocalRack:         "rack1",
+			DisableTokenAware: true,
+		},
+	}).(*rackAwareRoundRobinPolicy)
+	assert.True(t, ok)
+	assert.Equal(t, "dc1", policy.localDatacenter)
+	assert.Equal(t, "rack1", policy.localRack)
+
+	assert.Equal(t, hostTierLocalRack, policy.hostTier("dc1", "rack1"))
+	assert.Equal(t, hostTierLocalDC, policy.hostTier("dc1", "rack2"))
+	assert.Equal(t, hostTierRemote, policy.hostTier("dc2", "rack1"))
+	assert.Equal(t, hostTierRemote, policy.hostTier("dc2", "rack2"))
+}
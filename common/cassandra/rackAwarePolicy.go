@@ -0,0 +1,144 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cassandra
+
+import (
+	"github.com/gocql/gocql"
+)
+
+type (
+	// rackAwareRoundRobinPolicy is a host selection policy that picks hosts in the local rack of the local
+	// datacenter first, then other hosts in the local datacenter and finally hosts in remote datacenters,
+	// round robin within each tier
+	rackAwareRoundRobinPolicy struct {
+		localDatacenter string
+		localRack       string
+
+		localRackHosts gocql.HostSelectionPolicy
+		localDCHosts   gocql.HostSelectionPolicy
+		remoteHosts    gocql.HostSelectionPolicy
+	}
+
+	hostTier int
+)
+
+const (
+	hostTierLocalRack hostTier = iota
+	hostTierLocalDC
+	hostTierRemote
+)
+
+var _ gocql.HostSelectionPolicy = (*rackAwareRoundRobinPolicy)(nil)
+
+func newRackAwareRoundRobinPolicy(localDatacenter string, localRack string) *rackAwareRoundRobinPolicy {
+	return &rackAwareRoundRobinPolicy{
+		localDatacenter: localDatacenter,
+		localRack:       localRack,
+		localRackHosts:  gocql.RoundRobinHostPolicy(),
+		localDCHosts:    gocql.RoundRobinHostPolicy(),
+		remoteHosts:     gocql.RoundRobinHostPolicy(),
+	}
+}
+
+func (p *rackAwareRoundRobinPolicy) hostTier(datacenter string, rack string) hostTier {
+	switch {
+	case datacenter != p.localDatacenter:
+		return hostTierRemote
+	case rack != p.localRack:
+		return hostTierLocalDC
+	default:
+		return hostTierLocalRack
+	}
+}
+
+func (p *rackAwareRoundRobinPolicy) policyFor(host *gocql.HostInfo) gocql.HostSelectionPolicy {
+	switch p.hostTier(host.DataCenter(), host.Rack()) {
+	case hostTierLocalRack:
+		return p.localRackHosts
+	case hostTierLocalDC:
+		return p.localDCHosts
+	default:
+		return p.remoteHosts
+	}
+}
+
+func (p *rackAwareRoundRobinPolicy) tiers() []gocql.HostSelectionPolicy {
+	return []gocql.HostSelectionPolicy{p.localRackHosts, p.localDCHosts, p.remoteHosts}
+}
+
+func (p *rackAwareRoundRobinPolicy) AddHost(host *gocql.HostInfo) {
+	p.policyFor(host).AddHost(host)
+}
+
+func (p *rackAwareRoundRobinPolicy) RemoveHost(host *gocql.HostInfo) {
+	p.policyFor(host).RemoveHost(host)
+}
+
+func (p *rackAwareRoundRobinPolicy) HostUp(host *gocql.HostInfo) {
+	p.policyFor(host).HostUp(host)
+}
+
+func (p *rackAwareRoundRobinPolicy) HostDown(host *gocql.HostInfo) {
+	p.policyFor(host).HostDown(host)
+}
+
+func (p *rackAwareRoundRobinPolicy) SetPartitioner(partitioner string) {
+	for _, tier := range p.tiers() {
+		tier.SetPartitioner(partitioner)
+	}
+}
+
+func (p *rackAwareRoundRobinPolicy) KeyspaceChanged(event gocql.KeyspaceUpdateEvent) {
+	for _, tier := range p.tiers() {
+		tier.KeyspaceChanged(event)
+	}
+}
+
+func (p *rackAwareRoundRobinPolicy) Init(session *gocql.Session) {
+	for _, tier := range p.tiers() {
+		tier.Init(session)
+	}
+}
+
+func (p *rackAwareRoundRobinPolicy) IsLocal(host *gocql.HostInfo) bool {
+	return host.DataCenter() == p.localDatacenter
+}
+
+func (p *rackAwareRoundRobinPolicy) Pick(query gocql.ExecutableQuery) gocql.NextHost {
+	tiers := p.tiers()
+	next := tiers[0].Pick(query)
+	return func() gocql.SelectedHost {
+		for {
+			if host := next(); host != nil {
+				return host
+			}
+			tiers = tiers[1:]
+			if len(tiers) == 0 {
+				return nil
+			}
+			next = tiers[0].Pick(query)
+		}
+	}
+}
@@ -0,0 +1,276 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package claimcheck
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/pborman/uuid"
+	commonpb "go.temporal.io/api/common/v1"
+	historypb "go.temporal.io/api/history/v1"
+)
+
+const (
+	// MetadataEncodingClaimCheck is the encoding of a reference payload substituted for an offloaded payload
+	MetadataEncodingClaimCheck = "binary/claim-check"
+	// MetadataKeyClaimCheckKey is the payload metadata key the store key of an offloaded payload is kept under
+	MetadataKeyClaimCheckKey = "claim-check-key"
+
+	metadataKeyEncoding = "encoding"
+	metadataKeyOwners   = "claim-check-owners"
+	keySeparator        = "/"
+)
+
+var (
+	errInvalidKey = errors.New("claim check key is invalid")
+)
+
+type (
+	// Processor offloads large payloads to a Store and resolves the references substituted for them
+	Processor struct {
+		store Store
+	}
+
+	// Owner is a workflow which has a reference to an offloaded payload in its history,
+	// the blob of the payload can only be deleted once every owner is closed and past retention
+	Owner struct {
+		NamespaceID string `json:"namespaceId"`
+		WorkflowID  string `json:"workflowId"`
+	}
+)
+
+// NewProcessor creates a new Processor backed by store
+func NewProcessor(store Store) *Processor {
+	return &Processor{
+		store: store,
+	}
+}
+
+// IsReference returns true if payload is a reference to an offloaded payload
+func IsReference(payload *commonpb.Payload) bool {
+	return string(payload.GetMetadata()[metadataKeyEncoding]) == MetadataEncodingClaimCheck
+}
+
+// NeedsOffload returns true if any of payloads is larger than threshold bytes
+func NeedsOffload(threshold int, payloads *commonpb.Payloads) bool {
+	for _, payload := range payloads.GetPayloads() {
+		if payload.Size() > threshold && !IsReference(payload) {
+			return true
+		}
+	}
+	return false
+}
+
+// OffloadPayloads stores every payload larger than threshold bytes as a blob of namespaceID
+// and replaces it in place with a reference. The owners are stored with the blob.
+func (p *Processor) OffloadPayloads(
+	ctx context.Context,
+	namespaceID string,
+	owners []Owner,
+	threshold int,
+	payloads *commonpb.Payloads,
+) error {
+
+	for i, payload := range payloads.GetPayloads() {
+		if payload.Size() <= threshold || IsReference(payload) {
+			continue
+		}
+		data, err := encodeBlob(payload, owners)
+		if err != nil {
+			return err
+		}
+		key := namespaceID + keySeparator + uuid.New()
+		if err := p.store.Put(ctx, key, data); err != nil {
+			return err
+		}
+		payloads.Payloads[i] = &commonpb.Payload{
+			Metadata: map[string][]byte{
+				metadataKeyEncoding:      []byte(MetadataEncodingClaimCheck),
+				MetadataKeyClaimCheckKey: []byte(key),
+			},
+		}
+	}
+	return nil
+}
+
+// HasReference returns true if any of payloads is a reference. References are only created by the server,
+// so requests containing them are rejected, otherwise callers could read payloads of other namespaces.
+func HasReference(payloads *commonpb.Payloads) bool {
+	for _, payload := range payloads.GetPayloads() {
+		if IsReference(payload) {
+			return true
+		}
+	}
+	return false
+}
+
+// ResolvePayloads replaces in place every reference with the payload it refers to.
+// References are resolved regardless of the namespace reading them, since children and signals
+// of other namespaces share the payloads offloaded by the namespace that sent them.
+func (p *Processor) ResolvePayloads(
+	ctx context.Context,
+	payloads *commonpb.Payloads,
+) error {
+
+	for i, payload := range payloads.GetPayloads() {
+		if !IsReference(payload) {
+			continue
+		}
+		key := string(payload.GetMetadata()[MetadataKeyClaimCheckKey])
+		if _, _, err := parseKey(key); err != nil {
+			return err
+		}
+		data, err := p.store.Get(ctx, key)
+		if err != nil {
+			return err
+		}
+		resolved, _, err := decodeBlob(data)
+		if err != nil {
+			return err
+		}
+		payloads.Payloads[i] = resolved
+	}
+	return nil
+}
+
+// ResolveHistory resolves the references in the input and result payloads of history events
+func (p *Processor) ResolveHistory(
+	ctx context.Context,
+	history *historypb.History,
+) error {
+
+	for _, event := range history.GetEvents() {
+		if err := p.ResolvePayloads(ctx, eventPayloads(event)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteExpired deletes the blobs of namespaceID which were stored before createdBefore
+// and for which isExpired returns true for their owners, and returns the number of deleted blobs
+func (p *Processor) DeleteExpired(
+	ctx context.Context,
+	namespaceID string,
+	createdBefore time.Time,
+	isExpired func(owners []Owner) (bool, error),
+) (int, error) {
+
+	deleted := 0
+	err := p.store.List(ctx, namespaceID, createdBefore, func(key string) error {
+		data, err := p.store.Get(ctx, key)
+		if err != nil {
+			return err
+		}
+		_, owners, err := decodeBlob(data)
+		if err != nil {
+			return err
+		}
+		expired, err := isExpired(owners)
+		if err != nil || !expired {
+			return err
+		}
+		if err := p.store.Delete(ctx, key); err != nil {
+			return err
+		}
+		deleted++
+		return nil
+	})
+	return deleted, err
+}
+
+// encodeBlob wraps an offloaded payload together with its owners into the data of a blob
+func encodeBlob(payload *commonpb.Payload, owners []Owner) ([]byte, error) {
+	data, err := payload.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	encodedOwners, err := json.Marshal(owners)
+	if err != nil {
+		return nil, err
+	}
+	blob := &commonpb.Payload{
+		Metadata: map[string][]byte{
+			metadataKeyOwners: encodedOwners,
+		},
+		Data: data,
+	}
+	return blob.Marshal()
+}
+
+// decodeBlob returns the offloaded payload and its owners from the data of a blob
+func decodeBlob(data []byte) (*commonpb.Payload, []Owner, error) {
+	blob := &commonpb.Payload{}
+	if err := blob.Unmarshal(data); err != nil {
+		return nil, nil, err
+	}
+	var owners []Owner
+	if err := json.Unmarshal(blob.GetMetadata()[metadataKeyOwners], &owners); err != nil {
+		return nil, nil, err
+	}
+	payload := &commonpb.Payload{}
+	if err := payload.Unmarshal(blob.GetData()); err != nil {
+		return nil, nil, err
+	}
+	return payload, owners, nil
+}
+
+// parseKey splits a key of the form <namespaceID>/<blobID>, both parts must be UUIDs
+func parseKey(key string) (string, string, error) {
+	parts := strings.Split(key, keySeparator)
+	if len(parts) != 2 || uuid.Parse(parts[0]) == nil || uuid.Parse(parts[1]) == nil {
+		return "", "", errInvalidKey
+	}
+	return parts[0], parts[1], nil
+}
+
+func eventPayloads(event *historypb.HistoryEvent) *commonpb.Payloads {
+	switch attributes := event.GetAttributes().(type) {
+	case *historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes:
+		return attributes.WorkflowExecutionStartedEventAttributes.GetInput()
+	case *historypb.HistoryEvent_WorkflowExecutionCompletedEventAttributes:
+		return attributes.WorkflowExecutionCompletedEventAttributes.GetResult()
+	case *historypb.HistoryEvent_WorkflowExecutionContinuedAsNewEventAttributes:
+		return attributes.WorkflowExecutionContinuedAsNewEventAttributes.GetInput()
+	case *historypb.HistoryEvent_WorkflowExecutionSignaledEventAttributes:
+		return attributes.WorkflowExecutionSignaledEventAttributes.GetInput()
+	case *historypb.HistoryEvent_ActivityTaskScheduledEventAttributes:
+		return attributes.ActivityTaskScheduledEventAttributes.GetInput()
+	case *historypb.HistoryEvent_ActivityTaskCompletedEventAttributes:
+		return attributes.ActivityTaskCompletedEventAttributes.GetResult()
+	case *historypb.HistoryEvent_StartChildWorkflowExecutionInitiatedEventAttributes:
+		return attributes.StartChildWorkflowExecutionInitiatedEventAttributes.GetInput()
+	case *historypb.HistoryEvent_ChildWorkflowExecutionCompletedEventAttributes:
+		return attributes.ChildWorkflowExecutionCompletedEventAttributes.GetResult()
+	case *historypb.HistoryEvent_SignalExternalWorkflowExecutionInitiatedEventAttributes:
+		return attributes.SignalExternalWorkflowExecutionInitiatedEventAttributes.GetInput()
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,223 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package claimcheck
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+
+	"go.temporal.io/server/common/service/config"
+)
+
+type (
+	claimCheckSuite struct {
+		*require.Assertions
+		suite.Suite
+
+		directory string
+		processor *Processor
+	}
+)
+
+func TestClaimCheckSuite(t *testing.T) {
+	suite.Run(t, new(claimCheckSuite))
+}
+
+func (s *claimCheckSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	directory, err := ioutil.TempDir("", "claimCheck")
+	s.NoError(err)
+	s.directory = directory
+
+	store, err := NewStore(config.ClaimCheck{
+		Filestore: &config.FilestoreClaimCheck{Directory: directory},
+	})
+	s.NoError(err)
+	s.processor = NewProcessor(store)
+}
+
+func (s *claimCheckSuite) TearDownTest() {
+	s.NoError(os.RemoveAll(s.directory))
+}
+
+func (s *claimCheckSuite) TestNewStore_NotConfigured() {
+	store, err := NewStore(config.ClaimCheck{})
+	s.NoError(err)
+	s.Nil(store)
+}
+
+func (s *claimCheckSuite) TestNewStore_MultipleStores() {
+	_, err := NewStore(config.ClaimCheck{
+		Filestore: &config.FilestoreClaimCheck{Directory: s.directory},
+		S3store:   &config.S3ClaimCheck{Region: "us-east-1", Bucket: "bucket"},
+	})
+	s.Equal(errMultipleStoresEnabled, err)
+}
+
+func (s *claimCheckSuite) TestNewFileStore_EmptyDirectory() {
+	_, err := NewFileStore(&config.FilestoreClaimCheck{})
+	s.Equal(errEmptyDirectoryPath, err)
+}
+
+func (s *claimCheckSuite) TestOffloadAndResolvePayloads() {
+	namespaceID := uuid.New()
+	small := newPayload("small")
+	large := newPayload(string(make([]byte, 1024)))
+	payloads := &commonpb.Payloads{Payloads: []*commonpb.Payload{small, large}}
+	s.True(NeedsOffload(100, payloads))
+
+	s.NoError(s.processor.OffloadPayloads(context.Background(), namespaceID, nil, 100, payloads))
+	s.False(NeedsOffload(100, payloads))
+	s.Equal(small, payloads.Payloads[0])
+	s.False(IsReference(payloads.Payloads[0]))
+	s.True(IsReference(payloads.Payloads[1]))
+
+	s.False(HasReference(&commonpb.Payloads{Payloads: []*commonpb.Payload{small}}))
+	s.True(HasReference(payloads))
+
+	s.NoError(s.processor.ResolvePayloads(context.Background(), payloads))
+	s.Equal(small, payloads.Payloads[0])
+	s.Equal(large, payloads.Payloads[1])
+}
+
+func (s *claimCheckSuite) TestResolvePayloads_OtherNamespace() {
+	large := newPayload(string(make([]byte, 1024)))
+	payloads := &commonpb.Payloads{Payloads: []*commonpb.Payload{large}}
+
+	s.NoError(s.processor.OffloadPayloads(context.Background(), uuid.New(), nil, 100, payloads))
+	s.True(IsReference(payloads.Payloads[0]))
+
+	s.NoError(s.processor.ResolvePayloads(context.Background(), payloads))
+	s.Equal(large, payloads.Payloads[0])
+}
+
+func (s *claimCheckSuite) TestResolvePayloads_InvalidKey() {
+	namespaceID := uuid.New()
+	payloads := &commonpb.Payloads{Payloads: []*commonpb.Payload{{
+		Metadata: map[string][]byte{
+			metadataKeyEncoding:      []byte(MetadataEncodingClaimCheck),
+			MetadataKeyClaimCheckKey: []byte(namespaceID + "/../../etc/passwd"),
+		},
+	}}}
+
+	s.Equal(errInvalidKey, s.processor.ResolvePayloads(context.Background(), payloads))
+}
+
+func (s *claimCheckSuite) TestResolveHistory() {
+	namespaceID := uuid.New()
+	large := newPayload(string(make([]byte, 1024)))
+	input := &commonpb.Payloads{Payloads: []*commonpb.Payload{large}}
+	s.NoError(s.processor.OffloadPayloads(context.Background(), namespaceID, nil, 100, input))
+	s.True(IsReference(input.Payloads[0]))
+
+	history := &historypb.History{
+		Events: []*historypb.HistoryEvent{{
+			EventId:   1,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{
+				WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{
+					Input: input,
+				},
+			},
+		}},
+	}
+
+	s.NoError(s.processor.ResolveHistory(context.Background(), history))
+	s.Equal(large, history.Events[0].GetWorkflowExecutionStartedEventAttributes().GetInput().Payloads[0])
+}
+
+func (s *claimCheckSuite) TestDeleteExpired() {
+	namespaceID := uuid.New()
+	closedOwner := Owner{NamespaceID: namespaceID, WorkflowID: "closed"}
+	openOwner := Owner{NamespaceID: namespaceID, WorkflowID: "open"}
+	offload := func(namespaceID string, age time.Duration, owners ...Owner) *commonpb.Payloads {
+		payloads := &commonpb.Payloads{Payloads: []*commonpb.Payload{newPayload(string(make([]byte, 1024)))}}
+		s.NoError(s.processor.OffloadPayloads(context.Background(), namespaceID, owners, 100, payloads))
+		path := filepath.Join(s.directory, string(payloads.Payloads[0].GetMetadata()[MetadataKeyClaimCheckKey]))
+		s.NoError(os.Chtimes(path, time.Now().Add(-age), time.Now().Add(-age)))
+		return payloads
+	}
+	expired := offload(namespaceID, time.Hour, closedOwner)
+	stillOwned := offload(namespaceID, time.Hour, closedOwner, openOwner)
+	recent := offload(namespaceID, 0, closedOwner)
+	otherNamespace := offload(uuid.New(), time.Hour, closedOwner)
+
+	isExpired := func(owners []Owner) (bool, error) {
+		for _, owner := range owners {
+			if owner == openOwner {
+				return false, nil
+			}
+		}
+		return true, nil
+	}
+	deleted, err := s.processor.DeleteExpired(context.Background(), namespaceID, time.Now().Add(-time.Minute), isExpired)
+	s.NoError(err)
+	s.Equal(1, deleted)
+	s.Error(s.processor.ResolvePayloads(context.Background(), expired))
+	s.NoError(s.processor.ResolvePayloads(context.Background(), stillOwned))
+	s.NoError(s.processor.ResolvePayloads(context.Background(), recent))
+	s.NoError(s.processor.ResolvePayloads(context.Background(), otherNamespace))
+
+	deleted, err = s.processor.DeleteExpired(context.Background(), uuid.New(), time.Now(), isExpired)
+	s.NoError(err)
+	s.Zero(deleted)
+}
+
+func (s *claimCheckSuite) TestDeleteExpired_OwnerCheckFailed() {
+	namespaceID := uuid.New()
+	payloads := &commonpb.Payloads{Payloads: []*commonpb.Payload{newPayload(string(make([]byte, 1024)))}}
+	s.NoError(s.processor.OffloadPayloads(context.Background(), namespaceID, []Owner{{NamespaceID: namespaceID, WorkflowID: "wid"}}, 100, payloads))
+
+	checkErr := errors.New("owner check failed")
+	deleted, err := s.processor.DeleteExpired(context.Background(), namespaceID, time.Now().Add(time.Minute), func(owners []Owner) (bool, error) {
+		s.Equal([]Owner{{NamespaceID: namespaceID, WorkflowID: "wid"}}, owners)
+		return false, checkErr
+	})
+	s.Equal(checkErr, err)
+	s.Zero(deleted)
+	s.NoError(s.processor.ResolvePayloads(context.Background(), payloads))
+}
+
+func newPayload(data string) *commonpb.Payload {
+	return &commonpb.Payload{
+		Metadata: map[string][]byte{
+			metadataKeyEncoding: []byte("json/plain"),
+		},
+		Data: []byte(data),
+	}
+}
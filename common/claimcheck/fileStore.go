@@ -0,0 +1,158 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package claimcheck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/pborman/uuid"
+
+	"go.temporal.io/server/common/service/config"
+)
+
+const (
+	defaultFileMode = os.FileMode(0666)
+	defaultDirMode  = os.FileMode(0766)
+)
+
+type (
+	fileStore struct {
+		directory string
+		fileMode  os.FileMode
+		dirMode   os.FileMode
+	}
+)
+
+var (
+	errEmptyDirectoryPath = errors.New("directory path is empty")
+)
+
+// NewFileStore creates a Store which keeps offloaded payloads as files on a file system,
+// which must be shared by all frontend hosts of a cluster
+func NewFileStore(cfg *config.FilestoreClaimCheck) (Store, error) {
+	if cfg == nil {
+		return nil, errStoreNotConfigured
+	}
+	if len(cfg.Directory) == 0 {
+		return nil, errEmptyDirectoryPath
+	}
+
+	fileMode := defaultFileMode
+	if len(cfg.FileMode) != 0 {
+		mode, err := strconv.ParseUint(cfg.FileMode, 0, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid file mode %v: %v", cfg.FileMode, err)
+		}
+		fileMode = os.FileMode(mode)
+	}
+	dirMode := defaultDirMode
+	if len(cfg.DirMode) != 0 {
+		mode, err := strconv.ParseUint(cfg.DirMode, 0, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid dir mode %v: %v", cfg.DirMode, err)
+		}
+		dirMode = os.FileMode(mode)
+	}
+
+	return &fileStore{
+		directory: cfg.Directory,
+		fileMode:  fileMode,
+		dirMode:   dirMode,
+	}, nil
+}
+
+func (s *fileStore) Put(_ context.Context, key string, data []byte) error {
+	path, err := s.filePath(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), s.dirMode); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, s.fileMode)
+}
+
+func (s *fileStore) Get(_ context.Context, key string) ([]byte, error) {
+	path, err := s.filePath(key)
+	if err != nil {
+		return nil, err
+	}
+	// #nosec path only consists of validated UUIDs
+	return ioutil.ReadFile(path)
+}
+
+func (s *fileStore) List(ctx context.Context, namespaceID string, createdBefore time.Time, fn func(key string) error) error {
+	if uuid.Parse(namespaceID) == nil {
+		return errInvalidKey
+	}
+	files, err := ioutil.ReadDir(filepath.Join(s.directory, namespaceID))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	for _, file := range files {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if file.IsDir() || !file.ModTime().Before(createdBefore) {
+			continue
+		}
+		if err := fn(namespaceID + keySeparator + file.Name()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *fileStore) Delete(_ context.Context, key string) error {
+	path, err := s.filePath(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// filePath maps a key of the form <namespaceID>/<blobID> to a file in the store directory,
+// both parts must be UUIDs so that keys can never point outside of the store directory
+func (s *fileStore) filePath(key string) (string, error) {
+	namespaceID, blobID, err := parseKey(key)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(s.directory, namespaceID, blobID), nil
+}
@@ -0,0 +1,69 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package claimcheck
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.temporal.io/server/common/service/config"
+)
+
+type (
+	// Store is the blob storage offloaded payloads are kept in.
+	// Keys have the form <namespaceID>/<blobID>, where the namespace is the owner of the blob.
+	Store interface {
+		// Put stores data under key, overwriting any existing blob
+		Put(ctx context.Context, key string, data []byte) error
+		// Get returns the data stored under key
+		Get(ctx context.Context, key string) ([]byte, error)
+		// List calls fn with the key of every blob of namespaceID which was stored before createdBefore,
+		// listing stops at the first error returned by fn
+		List(ctx context.Context, namespaceID string, createdBefore time.Time, fn func(key string) error) error
+		// Delete deletes the blob stored under key, deleting a blob which doesn't exist is not an error
+		Delete(ctx context.Context, key string) error
+	}
+)
+
+var (
+	errStoreNotConfigured    = errors.New("claim check store is not configured")
+	errMultipleStoresEnabled = errors.New("only one claim check store can be configured")
+)
+
+// NewStore creates the claim check Store from static config,
+// a nil Store is returned if no store is configured, which disables claim check
+func NewStore(cfg config.ClaimCheck) (Store, error) {
+	switch {
+	case cfg.Filestore != nil && cfg.S3store != nil:
+		return nil, errMultipleStoresEnabled
+	case cfg.Filestore != nil:
+		return NewFileStore(cfg.Filestore)
+	case cfg.S3store != nil:
+		return NewS3Store(cfg.S3store)
+	default:
+		return nil, nil
+	}
+}
@@ -112,6 +112,14 @@ const (
 const (
 	// DefaultTransactionSizeLimit is the largest allowed transaction size to persistence
 	DefaultTransactionSizeLimit = 14 * 1024 * 1024
+	// DefaultHistorySizeLimitError is the default history size of a workflow beyond which it is terminated
+	DefaultHistorySizeLimitError = 50 * 1024 * 1024
+	// DefaultHistoryCountLimitError is the default history event count of a workflow beyond which it is terminated
+	DefaultHistoryCountLimitError = 50 * 1024
+	// DefaultHistoryBlobSizeLimitError is the default size of a payload recorded by history beyond which the request fails
+	DefaultHistoryBlobSizeLimitError = 2 * 1024 * 1024
+	// DefaultHistoryBlobSizeLimitWarn is the default size of a payload recorded by history beyond which a warning is logged
+	DefaultHistoryBlobSizeLimitWarn = 512 * 1024
 )
 
 const (
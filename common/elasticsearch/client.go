@@ -49,6 +49,7 @@ type (
 		Count(ctx context.Context, index, query string) (int64, error)
 		RunBulkProcessor(ctx context.Context, p *BulkProcessorParameters) (BulkProcessor, error)
 		PutMapping(ctx context.Context, index, root, key, valueType string) error
+		IndexExists(ctx context.Context, indexName string) (bool, error)
 	}
 
 	CLIClient interface {
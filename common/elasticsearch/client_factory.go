@@ -25,9 +25,13 @@
 package elasticsearch
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
+	elastic6 "github.com/olivere/elastic"
+	"github.com/olivere/elastic/v7"
+
 	"go.temporal.io/server/common/log"
 )
 
@@ -63,3 +67,12 @@ func NewIntegrationTestsClient(url string, version string) (IntegrationTestsClie
 		return nil, fmt.Errorf("not supported ElasticSearch version: %v", version)
 	}
 }
+
+// IsUnavailableError returns true if err indicates that no Elasticsearch node is available to serve requests
+func IsUnavailableError(err error) bool {
+	if errors.Is(err, elastic.ErrNoClient) || errors.Is(err, elastic6.ErrNoClient) {
+		return true
+	}
+	var esErr *elastic.Error
+	return errors.As(err, &esErr) && esErr.Status == http.StatusServiceUnavailable
+}
@@ -25,7 +25,9 @@
 package elasticsearch
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/olivere/elastic/v7"
@@ -65,3 +67,11 @@ func Test_ConvertV7Sorters(t *testing.T) {
 	require.NoError(t, err0)
 	require.NotNil(t, source0)
 }
+
+func Test_IsUnavailableError(t *testing.T) {
+	require.True(t, IsUnavailableError(elastic.ErrNoClient))
+	require.True(t, IsUnavailableError(fmt.Errorf("index check failed: %w", elastic.ErrNoClient)))
+	require.True(t, IsUnavailableError(&elastic.Error{Status: http.StatusServiceUnavailable}))
+	require.False(t, IsUnavailableError(&elastic.Error{Status: http.StatusUnauthorized}))
+	require.False(t, IsUnavailableError(errors.New("visibility index does not exist")))
+}
@@ -26,8 +26,11 @@ package validator
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 
 	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 
 	"go.temporal.io/server/common"
@@ -65,7 +68,9 @@ func NewSearchAttributesValidator(
 	}
 }
 
-// ValidateSearchAttributes validate search attributes are valid for writing and not exceed limits
+// ValidateSearchAttributes validate search attributes are valid for writing and not exceed limits.
+// All offending keys are reported in a single InvalidArgument error so callers can fix them at once,
+// they are caused by the caller and so are logged at Info level.
 func (sv *SearchAttributesValidator) ValidateSearchAttributes(input *commonpb.SearchAttributes, namespace string) error {
 	if input == nil {
 		return nil
@@ -76,56 +81,82 @@ func (sv *SearchAttributesValidator) ValidateSearchAttributes(input *commonpb.Se
 	lengthOfFields := len(fields)
 	if lengthOfFields > sv.searchAttributesNumberOfKeysLimit(namespace) {
 		sv.logger.WithTags(tag.Number(int64(lengthOfFields)), tag.WorkflowNamespace(namespace)).
-			Error("number of keys in search attributes exceed limit")
+			Info("number of keys in search attributes exceed limit")
 		return serviceerror.NewInvalidArgument(fmt.Sprintf("number of keys %d exceed limit", lengthOfFields))
 	}
 
+	// iterate in key order so the reported violations are stable across calls
+	keys := make([]string, 0, lengthOfFields)
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var violations []string
 	totalSize := 0
 	validAttr := sv.validSearchAttributes()
-	for key, val := range fields {
-		// verify: key is whitelisted
-		if !sv.isValidSearchAttributesKey(validAttr, key) {
-			sv.logger.WithTags(tag.ESKey(key), tag.WorkflowNamespace(namespace)).
-				Error("invalid search attribute key")
-			return serviceerror.NewInvalidArgument(fmt.Sprintf("%s is not valid search attribute key", key))
-		}
-		// verify: value has the correct type
-		if !sv.isValidSearchAttributesValue(validAttr, key, val) {
-			var invalidValue interface{}
-			if err := payload.Decode(val, &invalidValue); err != nil {
-				invalidValue = fmt.Sprintf("value from %q", val.String())
-			}
-
-			sv.logger.WithTags(tag.ESKey(key), tag.Value(invalidValue), tag.WorkflowNamespace(namespace)).
-				Error("invalid search attribute value")
-			return serviceerror.NewInvalidArgument(fmt.Sprintf("%v is not a valid search attribute value for key %s", invalidValue, key))
-		}
-		// verify: key is not system reserved
-		if definition.IsSystemIndexedKey(key) {
-			sv.logger.WithTags(tag.ESKey(key), tag.WorkflowNamespace(namespace)).
-				Error("illegal update of system reserved attribute")
-			return serviceerror.NewInvalidArgument(fmt.Sprintf("%s is read-only Temporal reservered attribute", key))
-		}
-		// verify: size of single value <= limit
-		dataSize := len(val.GetData())
-		if dataSize > sv.searchAttributesSizeOfValueLimit(namespace) {
-			sv.logger.WithTags(tag.ESKey(key), tag.Number(int64(dataSize)), tag.WorkflowNamespace(namespace)).
-				Error("value size of search attribute exceed limit")
-			return serviceerror.NewInvalidArgument(fmt.Sprintf("size limit exceed for key %s", key))
+	for _, key := range keys {
+		if violation := sv.validateSearchAttribute(validAttr, key, fields[key], namespace); violation != "" {
+			violations = append(violations, violation)
+			continue
 		}
-		totalSize += len(key) + dataSize
+		totalSize += len(key) + len(fields[key].GetData())
+	}
+	if len(violations) > 0 {
+		return serviceerror.NewInvalidArgument(strings.Join(violations, "; "))
 	}
 
 	// verify: total size <= limit
 	if totalSize > sv.searchAttributesTotalSizeLimit(namespace) {
 		sv.logger.WithTags(tag.Number(int64(totalSize)), tag.WorkflowNamespace(namespace)).
-			Error("total size of search attributes exceed limit")
+			Info("total size of search attributes exceed limit")
 		return serviceerror.NewInvalidArgument(fmt.Sprintf("total size %d exceed limit", totalSize))
 	}
 
 	return nil
 }
 
+// validateSearchAttribute return a description of what is wrong with a single search attribute, or empty string if it is valid
+func (sv *SearchAttributesValidator) validateSearchAttribute(
+	validAttr map[string]interface{},
+	key string,
+	val *commonpb.Payload,
+	namespace string,
+) string {
+	// verify: key is whitelisted
+	if !sv.isValidSearchAttributesKey(validAttr, key) {
+		sv.logger.WithTags(tag.ESKey(key), tag.WorkflowNamespace(namespace)).
+			Info("invalid search attribute key")
+		return fmt.Sprintf("%s is not valid search attribute key", key)
+	}
+	// verify: value has the correct type
+	valueType := common.ConvertIndexedValueTypeToProtoType(validAttr[key], sv.logger)
+	if !sv.isValidSearchAttributesValue(valueType, val) {
+		var invalidValue interface{}
+		if err := payload.Decode(val, &invalidValue); err != nil {
+			invalidValue = fmt.Sprintf("value from %q", val.String())
+		}
+
+		sv.logger.WithTags(tag.ESKey(key), tag.Value(invalidValue), tag.WorkflowNamespace(namespace)).
+			Info("invalid search attribute value")
+		return fmt.Sprintf("%v is not a valid search attribute value for key %s of type %s", invalidValue, key, valueType)
+	}
+	// verify: key is not system reserved
+	if definition.IsSystemIndexedKey(key) {
+		sv.logger.WithTags(tag.ESKey(key), tag.WorkflowNamespace(namespace)).
+			Info("illegal update of system reserved attribute")
+		return fmt.Sprintf("%s is read-only Temporal reservered attribute", key)
+	}
+	// verify: size of single value <= limit
+	dataSize := len(val.GetData())
+	if dataSize > sv.searchAttributesSizeOfValueLimit(namespace) {
+		sv.logger.WithTags(tag.ESKey(key), tag.Number(int64(dataSize)), tag.WorkflowNamespace(namespace)).
+			Info("value size of search attribute exceed limit")
+		return fmt.Sprintf("size limit exceed for key %s", key)
+	}
+	return ""
+}
+
 // isValidSearchAttributesKey return true if key is registered
 func (sv *SearchAttributesValidator) isValidSearchAttributesKey(
 	validAttr map[string]interface{},
@@ -135,13 +166,11 @@ func (sv *SearchAttributesValidator) isValidSearchAttributesKey(
 	return isValidKey
 }
 
-// isValidSearchAttributesValue return true if value has the correct representation for the attribute key
+// isValidSearchAttributesValue return true if value has the correct representation for the attribute type
 func (sv *SearchAttributesValidator) isValidSearchAttributesValue(
-	validAttr map[string]interface{},
-	key string,
+	valueType enumspb.IndexedValueType,
 	value *commonpb.Payload,
 ) bool {
-	valueType := common.ConvertIndexedValueTypeToProtoType(validAttr[key], sv.logger)
 	_, err := common.DeserializeSearchAttributeValue(value, valueType)
 	return err == nil
 }
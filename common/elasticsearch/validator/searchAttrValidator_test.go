@@ -29,6 +29,8 @@ import (
 
 	"github.com/stretchr/testify/suite"
 	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
 
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/log"
@@ -95,7 +97,7 @@ func (s *searchAttributesValidatorSuite) TestValidateSearchAttributes() {
 	}
 	attr.IndexedFields = fields
 	err = validator.ValidateSearchAttributes(attr, namespace)
-	s.Equal("123 is not a valid search attribute value for key CustomBoolField", err.Error())
+	s.Equal("123 is not a valid search attribute value for key CustomBoolField of type "+enumspb.INDEXED_VALUE_TYPE_BOOL.String(), err.Error())
 
 	intArrayPayload, err := payload.Encode([]int{1, 2})
 	s.NoError(err)
@@ -128,3 +130,25 @@ func (s *searchAttributesValidatorSuite) TestValidateSearchAttributes() {
 	err = validator.ValidateSearchAttributes(attr, namespace)
 	s.Equal("total size 44 exceed limit", err.Error())
 }
+
+func (s *searchAttributesValidatorSuite) TestValidateSearchAttributes_ReportsAllInvalidKeys() {
+	validator := NewSearchAttributesValidator(log.NewNoop(),
+		dynamicconfig.GetMapPropertyFn(definition.GetDefaultIndexedKeys()),
+		dynamicconfig.GetIntPropertyFilteredByNamespace(10),
+		dynamicconfig.GetIntPropertyFilteredByNamespace(5),
+		dynamicconfig.GetIntPropertyFilteredByNamespace(100))
+
+	attr := &commonpb.SearchAttributes{
+		IndexedFields: map[string]*commonpb.Payload{
+			"CustomStringField":  payload.EncodeString("1"),
+			"CustomBoolField":    payload.EncodeString("123"),
+			"InvalidKey":         payload.EncodeString("1"),
+			"CustomKeywordField": payload.EncodeString("123456"),
+		},
+	}
+	err := validator.ValidateSearchAttributes(attr, "namespace")
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+	s.Equal("123 is not a valid search attribute value for key CustomBoolField of type "+enumspb.INDEXED_VALUE_TYPE_BOOL.String()+
+		"; size limit exceed for key CustomKeywordField"+
+		"; InvalidKey is not valid search attribute key", err.Error())
+}
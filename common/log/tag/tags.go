@@ -90,6 +90,16 @@ func WorkflowPollContextTimeout(pollContextTimeout time.Duration) Tag {
 	return newDurationTag("wf-poll-context-timeout", pollContextTimeout)
 }
 
+// WorkflowLockHoldDuration returns tag for WorkflowLockHoldDuration
+func WorkflowLockHoldDuration(holdDuration time.Duration) Tag {
+	return newDurationTag("wf-lock-hold-duration", holdDuration)
+}
+
+// WorkflowLockWaiters returns tag for WorkflowLockWaiters
+func WorkflowLockWaiters(waiters int32) Tag {
+	return newInt32("wf-lock-waiters", waiters)
+}
+
 // WorkflowHandlerName returns tag for WorkflowHandlerName
 func WorkflowHandlerName(handlerName string) Tag {
 	return newStringTag("wf-handler-name", handlerName)
@@ -41,6 +41,13 @@ var (
 	WorkflowActionWorkflowRecordMarker           = workflowAction("add-workflow-marker-record-event")
 	WorkflowActionUpsertWorkflowSearchAttributes = workflowAction("add-workflow-upsert-search-attributes-event")
 
+	// workflow pause
+	WorkflowActionWorkflowPaused   = workflowAction("update-workflow-paused")
+	WorkflowActionWorkflowUnpaused = workflowAction("update-workflow-unpaused")
+
+	// workflow tags
+	WorkflowActionWorkflowTagsUpdated = workflowAction("update-workflow-tags")
+
 	// workflow task
 	WorkflowActionWorkflowTaskScheduled = workflowAction("add-workflowtask-scheduled-event")
 	WorkflowActionWorkflowTaskStarted   = workflowAction("add-workflowtask-started-event")
@@ -124,6 +131,8 @@ var (
 	ComponentESVisibilityManager      = component("es-visibility-manager")
 	ComponentArchiver                 = component("archiver")
 	ComponentBatcher                  = component("batcher")
+	ComponentLoadGenerator            = component("load-generator")
+	ComponentCanary                   = component("canary")
 	ComponentWorker                   = component("worker")
 	ComponentServiceResolver          = component("service-resolver")
 	ComponentMetadataInitializer      = component("metadata-initializer")
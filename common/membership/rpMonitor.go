@@ -35,6 +35,7 @@ import (
 	"time"
 
 	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/primitives"
 
 	"github.com/pborman/uuid"
@@ -134,6 +135,10 @@ func (rpo *ringpopMonitor) Start() {
 		rpo.logger.Fatal("unable to set ring pop ServiceRole label", tag.Error(err))
 	}
 
+	if err = labels.Set(RoleVersion, headers.ServerVersion); err != nil {
+		rpo.logger.Fatal("unable to set ring pop ServerVersion label", tag.Error(err))
+	}
+
 	for _, ring := range rpo.rings {
 		ring.Start()
 	}
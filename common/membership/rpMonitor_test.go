@@ -105,12 +105,16 @@ func (s *RpoSuite) TestCompareMembers() {
 
 func (s *RpoSuite) testCompareMembers(curr []string, new []string, hasDiff bool) {
 	resolver := &ringpopServiceResolver{}
-	currMembers := make(map[string]struct{}, len(curr))
+	currMembers := make(map[string]string, len(curr))
 	for _, m := range curr {
-		currMembers[m] = struct{}{}
+		currMembers[m] = ""
 	}
 	resolver.membersMap = currMembers
-	newMembers, changed := resolver.compareMembers(new)
+	newHosts := make([]*HostInfo, 0, len(new))
+	for _, m := range new {
+		newHosts = append(newHosts, NewHostInfo(m, nil))
+	}
+	newMembers, changed := resolver.compareMembers(newHosts)
 	s.Equal(hasDiff, changed)
 	s.Equal(len(new), len(newMembers))
 	for _, m := range new {
@@ -118,3 +122,14 @@ func (s *RpoSuite) testCompareMembers(curr []string, new []string, hasDiff bool)
 		s.True(ok)
 	}
 }
+
+func (s *RpoSuite) TestCompareMembers_VersionChanged() {
+	resolver := &ringpopServiceResolver{
+		membersMap: map[string]string{"a": "1.7.0"},
+	}
+	newMembers, changed := resolver.compareMembers([]*HostInfo{
+		NewHostInfo("a", map[string]string{RoleVersion: "1.8.0"}),
+	})
+	s.True(changed)
+	s.Equal(map[string]string{"a": "1.8.0"}, newMembers)
+}
@@ -55,6 +55,11 @@ const (
 	// the service can be accessed.
 	RolePort = "servicePort"
 
+	// RoleVersion label is set by every single service as soon as it bootstraps its
+	// ringpop instance. The data for this key is the server version of the service,
+	// members running releases older than this label do not advertise it.
+	RoleVersion = "serverVersion"
+
 	minRefreshInternal     = time.Second * 4
 	defaultRefreshInterval = time.Second * 10
 	replicaPoints          = 100
@@ -70,11 +75,12 @@ type ringpopServiceResolver struct {
 	shutdownWG  sync.WaitGroup
 	logger      log.Logger
 
-	ringValue atomic.Value // this stores the current hashring
+	ringValue    atomic.Value // this stores the current hashring
+	membersValue atomic.Value // this stores the current members keyed by address
 
 	refreshLock     sync.Mutex
 	lastRefreshTime time.Time
-	membersMap      map[string]struct{} // for de-duping change notifications
+	membersMap      map[string]string // for de-duping change notifications, maps address to server version
 
 	listenerLock sync.RWMutex
 	listeners    map[string]chan<- *ChangedEvent
@@ -97,10 +103,11 @@ func newRingpopServiceResolver(
 		refreshChan: make(chan struct{}),
 		shutdownCh:  make(chan struct{}),
 		logger:      logger.WithTags(tag.ComponentServiceResolver, tag.Service(service)),
-		membersMap:  make(map[string]struct{}),
+		membersMap:  make(map[string]string),
 		listeners:   make(map[string]chan<- *ChangedEvent),
 	}
 	resolver.ringValue.Store(newHashRing())
+	resolver.membersValue.Store(make(map[string]*HostInfo))
 	return resolver
 }
 
@@ -163,7 +170,7 @@ func (r *ringpopServiceResolver) Lookup(
 		return nil, ErrInsufficientHosts
 	}
 
-	return NewHostInfo(addr, r.getLabelsMap()), nil
+	return r.hostInfo(addr), nil
 }
 
 func (r *ringpopServiceResolver) AddListener(
@@ -202,7 +209,7 @@ func (r *ringpopServiceResolver) MemberCount() int {
 func (r *ringpopServiceResolver) Members() []*HostInfo {
 	var servers []*HostInfo
 	for _, s := range r.ring().Servers() {
-		servers = append(servers, NewHostInfo(s, r.getLabelsMap()))
+		servers = append(servers, r.hostInfo(s))
 	}
 
 	return servers
@@ -244,36 +251,40 @@ func (r *ringpopServiceResolver) refreshWithBackoff() error {
 }
 
 func (r *ringpopServiceResolver) refreshNoLock() error {
-	addrs, err := r.getReachableMembers()
+	hosts, err := r.getReachableMembers()
 	if err != nil {
 		return err
 	}
 
-	newMembersMap, changed := r.compareMembers(addrs)
+	newMembersMap, changed := r.compareMembers(hosts)
 	if !changed {
 		return nil
 	}
 
 	ring := newHashRing()
-	for _, addr := range addrs {
-		host := NewHostInfo(addr, r.getLabelsMap())
+	members := make(map[string]*HostInfo, len(hosts))
+	addrs := make([]string, 0, len(hosts))
+	for _, host := range hosts {
 		ring.AddMembers(host)
+		members[host.GetAddress()] = host
+		addrs = append(addrs, host.GetAddress())
 	}
 
 	r.membersMap = newMembersMap
 	r.lastRefreshTime = time.Now().UTC()
 	r.ringValue.Store(ring)
+	r.membersValue.Store(members)
 	r.logger.Info("Current reachable members", tag.Addresses(addrs))
 	return nil
 }
 
-func (r *ringpopServiceResolver) getReachableMembers() ([]string, error) {
+func (r *ringpopServiceResolver) getReachableMembers() ([]*HostInfo, error) {
 	members, err := r.rp.GetReachableMemberObjects(swim.MemberWithLabelAndValue(RoleKey, r.service))
 	if err != nil {
 		return nil, err
 	}
 
-	var hostPorts []string
+	var hosts []*HostInfo
 	for _, member := range members {
 		servicePort := r.port
 
@@ -295,10 +306,14 @@ func (r *ringpopServiceResolver) getReachableMembers() ([]string, error) {
 			return nil, err
 		}
 
-		hostPorts = append(hostPorts, hostPort)
+		labels := r.getLabelsMap()
+		if version, ok := member.Label(RoleVersion); ok {
+			labels[RoleVersion] = version
+		}
+		hosts = append(hosts, NewHostInfo(hostPort, labels))
 	}
 
-	return hostPorts, nil
+	return hosts, nil
 }
 
 func (r *ringpopServiceResolver) emitEvent(
@@ -308,13 +323,13 @@ func (r *ringpopServiceResolver) emitEvent(
 	// Marshall the event object into the required type
 	event := &ChangedEvent{}
 	for _, addr := range rpEvent.ServersAdded {
-		event.HostsAdded = append(event.HostsAdded, NewHostInfo(addr, r.getLabelsMap()))
+		event.HostsAdded = append(event.HostsAdded, r.hostInfo(addr))
 	}
 	for _, addr := range rpEvent.ServersRemoved {
 		event.HostsRemoved = append(event.HostsRemoved, NewHostInfo(addr, r.getLabelsMap()))
 	}
 	for _, addr := range rpEvent.ServersUpdated {
-		event.HostsUpdated = append(event.HostsUpdated, NewHostInfo(addr, r.getLabelsMap()))
+		event.HostsUpdated = append(event.HostsUpdated, r.hostInfo(addr))
 	}
 
 	// Notify listeners
@@ -356,18 +371,31 @@ func (r *ringpopServiceResolver) ring() *hashring.HashRing {
 	return r.ringValue.Load().(*hashring.HashRing)
 }
 
+// hostInfo returns a copy of the member with the given address, including the labels it advertises
+func (r *ringpopServiceResolver) hostInfo(addr string) *HostInfo {
+	labels := r.getLabelsMap()
+	if host, ok := r.membersValue.Load().(map[string]*HostInfo)[addr]; ok {
+		for key, value := range host.labels {
+			labels[key] = value
+		}
+	}
+	return NewHostInfo(addr, labels)
+}
+
 func (r *ringpopServiceResolver) getLabelsMap() map[string]string {
 	labels := make(map[string]string)
 	labels[RoleKey] = r.service
 	return labels
 }
 
-func (r *ringpopServiceResolver) compareMembers(addrs []string) (map[string]struct{}, bool) {
+func (r *ringpopServiceResolver) compareMembers(hosts []*HostInfo) (map[string]string, bool) {
 	changed := false
-	newMembersMap := make(map[string]struct{}, len(addrs))
-	for _, addr := range addrs {
-		newMembersMap[addr] = struct{}{}
-		if _, ok := r.membersMap[addr]; !ok {
+	newMembersMap := make(map[string]string, len(hosts))
+	for _, host := range hosts {
+		// a member restarted on the same address with another version is a change as well
+		version, _ := host.Label(RoleVersion)
+		newMembersMap[host.GetAddress()] = version
+		if oldVersion, ok := r.membersMap[host.GetAddress()]; !ok || oldVersion != version {
 			changed = true
 		}
 	}
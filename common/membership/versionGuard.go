@@ -0,0 +1,134 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"sync"
+	"time"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/service/dynamicconfig"
+)
+
+const (
+	versionCheckInterval = 10 * time.Second
+)
+
+type (
+	// VersionGuard decides whether features which older releases do not understand can be activated.
+	// They can only be activated when all members of the cluster run the same server version,
+	// unless mixed versions are explicitly allowed by an admin override.
+	VersionGuard struct {
+		monitor            Monitor
+		allowMixedVersions dynamicconfig.BoolPropertyFn
+		logger             log.Logger
+
+		lock          sync.Mutex
+		lastCheckTime time.Time
+		mixedVersions bool
+	}
+)
+
+// NewVersionGuard creates a new VersionGuard
+func NewVersionGuard(
+	monitor Monitor,
+	allowMixedVersions dynamicconfig.BoolPropertyFn,
+	logger log.Logger,
+) *VersionGuard {
+
+	return &VersionGuard{
+		monitor:            monitor,
+		allowMixedVersions: allowMixedVersions,
+		logger:             logger,
+	}
+}
+
+// AllowFeature returns false if members of the cluster run mixed server versions and the admin override is not set
+func (g *VersionGuard) AllowFeature() bool {
+	if g.allowMixedVersions() {
+		return true
+	}
+	return !g.isMixedVersions()
+}
+
+func (g *VersionGuard) isMixedVersions() bool {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	if time.Since(g.lastCheckTime) < versionCheckInterval {
+		return g.mixedVersions
+	}
+	g.lastCheckTime = time.Now()
+
+	versions, err := GetMemberVersions(g.monitor)
+	if err != nil {
+		// keep the last known state if membership is not available
+		g.logger.Warn("Unable to get server versions of cluster members.", tag.Error(err))
+		return g.mixedVersions
+	}
+
+	mixedVersions := IsMixedVersions(versions)
+	if mixedVersions && !g.mixedVersions {
+		g.logger.Warn("Cluster members run mixed server versions, features requiring the same version are not activated.",
+			tag.Value(versions))
+	} else if !mixedVersions && g.mixedVersions {
+		g.logger.Info("Cluster members run the same server version, features requiring the same version are activated.")
+	}
+	g.mixedVersions = mixedVersions
+	return mixedVersions
+}
+
+// GetMemberVersions returns the server versions of the reachable members of all services keyed by host address.
+// The version of members running releases which do not advertise their version is empty.
+func GetMemberVersions(monitor Monitor) (map[string]string, error) {
+	versions := make(map[string]string)
+	for _, service := range []string{
+		primitives.FrontendService,
+		primitives.HistoryService,
+		primitives.MatchingService,
+		primitives.WorkerService,
+	} {
+		resolver, err := monitor.GetResolver(service)
+		if err != nil {
+			return nil, err
+		}
+		for _, host := range resolver.Members() {
+			version, _ := host.Label(RoleVersion)
+			versions[host.GetAddress()] = version
+		}
+	}
+	return versions, nil
+}
+
+// IsMixedVersions returns true if the given host versions contain more than one server version
+func IsMixedVersions(versions map[string]string) bool {
+	distinctVersions := make(map[string]struct{})
+	for _, version := range versions {
+		distinctVersions[version] = struct{}{}
+	}
+	return len(distinctVersions) > 1
+}
@@ -0,0 +1,107 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/log/loggerimpl"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/service/dynamicconfig"
+)
+
+type (
+	versionGuardSuite struct {
+		*require.Assertions
+		suite.Suite
+
+		controller  *gomock.Controller
+		mockMonitor *MockMonitor
+	}
+)
+
+func TestVersionGuardSuite(t *testing.T) {
+	suite.Run(t, new(versionGuardSuite))
+}
+
+func (s *versionGuardSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.mockMonitor = NewMockMonitor(s.controller)
+}
+
+func (s *versionGuardSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *versionGuardSuite) TestAllowFeature_SameVersion() {
+	s.expectMembers("1.8.0", "1.8.0")
+
+	guard := NewVersionGuard(s.mockMonitor, dynamicconfig.GetBoolPropertyFn(false), loggerimpl.NewNopLogger())
+	s.True(guard.AllowFeature())
+}
+
+func (s *versionGuardSuite) TestAllowFeature_MixedVersions() {
+	s.expectMembers("1.8.0", "1.7.0")
+
+	guard := NewVersionGuard(s.mockMonitor, dynamicconfig.GetBoolPropertyFn(false), loggerimpl.NewNopLogger())
+	s.False(guard.AllowFeature())
+	// the result is cached until the next check interval
+	s.False(guard.AllowFeature())
+}
+
+func (s *versionGuardSuite) TestAllowFeature_MixedVersionsOverride() {
+	guard := NewVersionGuard(s.mockMonitor, dynamicconfig.GetBoolPropertyFn(true), loggerimpl.NewNopLogger())
+	s.True(guard.AllowFeature())
+}
+
+func (s *versionGuardSuite) TestIsMixedVersions() {
+	s.False(IsMixedVersions(map[string]string{}))
+	s.False(IsMixedVersions(map[string]string{"a": "1.8.0", "b": "1.8.0"}))
+	s.True(IsMixedVersions(map[string]string{"a": "1.8.0", "b": "1.7.0"}))
+	// members which do not advertise their version run an older release
+	s.True(IsMixedVersions(map[string]string{"a": "1.8.0", "b": ""}))
+}
+
+// expectMembers sets up a single host per service with the given frontend and history versions
+func (s *versionGuardSuite) expectMembers(frontendVersion string, historyVersion string) {
+	versions := map[string]string{
+		primitives.FrontendService: frontendVersion,
+		primitives.HistoryService:  historyVersion,
+		primitives.MatchingService: frontendVersion,
+		primitives.WorkerService:   frontendVersion,
+	}
+	for service, version := range versions {
+		resolver := NewMockServiceResolver(s.controller)
+		resolver.EXPECT().Members().Return([]*HostInfo{
+			NewHostInfo(service+":7233", map[string]string{RoleKey: service, RoleVersion: version}),
+		}).Times(1)
+		s.mockMonitor.EXPECT().GetResolver(service).Return(resolver, nil).Times(1)
+	}
+}
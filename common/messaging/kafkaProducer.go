@@ -111,6 +111,10 @@ func (p *kafkaProducer) getKeyForReplicationTask(task *replicationspb.Replicatio
 		// the messaging layer perspective
 		attributes := task.GetSyncActivityTaskAttributes()
 		return sarama.StringEncoder(attributes.GetWorkflowId())
+	case enumsspb.REPLICATION_TASK_TYPE_SYNC_WORKFLOW_STATE_TASK:
+		// Use workflowID as the partition key, same as sync activity tasks
+		attributes := task.GetSyncWorkflowStateTaskAttributes()
+		return sarama.StringEncoder(attributes.GetWorkflowId())
 	case enumsspb.REPLICATION_TASK_TYPE_HISTORY_METADATA_TASK,
 		enumsspb.REPLICATION_TASK_TYPE_NAMESPACE_TASK,
 		enumsspb.REPLICATION_TASK_TYPE_SYNC_SHARD_STATUS_TASK:
@@ -80,6 +80,9 @@ const (
 	CacheTypeTagName   = "cache_type"
 	FailureTagName     = "failure"
 	ValidatorTagName   = "validator"
+	TableTagName       = "persistence_table"
+	ErrorClassTagName  = "error_class"
+	CanaryProbeTagName = "canary_probe"
 )
 
 // This package should hold all the metrics and tags for temporal
@@ -366,6 +369,14 @@ const (
 	HistoryClientMergeDLQMessagesScope
 	// HistoryClientRefreshWorkflowTasksScope tracks RPC calls to history service
 	HistoryClientRefreshWorkflowTasksScope
+	// HistoryClientUpdateWorkflowExecutionTagsScope tracks RPC calls to history service
+	HistoryClientUpdateWorkflowExecutionTagsScope
+	// HistoryClientPauseWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientPauseWorkflowExecutionScope
+	// HistoryClientUnpauseWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientUnpauseWorkflowExecutionScope
+	// HistoryClientDescribeWorkflowLocksScope tracks RPC calls to history service
+	HistoryClientDescribeWorkflowLocksScope
 	// MatchingClientPollWorkflowTaskQueueScope tracks RPC calls to matching service
 	MatchingClientPollWorkflowTaskQueueScope
 	// MatchingClientPollActivityTaskQueueScope tracks RPC calls to matching service
@@ -380,6 +391,8 @@ const (
 	MatchingClientRespondQueryTaskCompletedScope
 	// MatchingClientCancelOutstandingPollScope tracks RPC calls to matching service
 	MatchingClientCancelOutstandingPollScope
+	// MatchingClientRemovePollerScope tracks RPC calls to matching service
+	MatchingClientRemovePollerScope
 	// MatchingClientDescribeTaskQueueScope tracks RPC calls to matching service
 	MatchingClientDescribeTaskQueueScope
 	// MatchingClientListTaskQueuePartitionsScope tracks RPC calls to matching service
@@ -480,6 +493,8 @@ const (
 	AdminClientDescribeHistoryHostScope
 	// AdminClientDescribeWorkflowMutableStateScope tracks RPC calls to admin service
 	AdminClientDescribeWorkflowMutableStateScope
+	// AdminClientBatchDescribeMutableStateScope tracks RPC calls to admin service
+	AdminClientBatchDescribeMutableStateScope
 	// AdminClientGetWorkflowExecutionRawHistoryScope tracks RPC calls to admin service
 	AdminClientGetWorkflowExecutionRawHistoryScope
 	// AdminClientGetWorkflowExecutionRawHistoryV2Scope tracks RPC calls to admin service
@@ -494,8 +509,34 @@ const (
 	AdminClientMergeDLQMessagesScope
 	// AdminClientRefreshWorkflowTasksScope tracks RPC calls to admin service
 	AdminClientRefreshWorkflowTasksScope
+	// AdminClientUpdateWorkflowExecutionTagsScope tracks RPC calls to admin service
+	AdminClientUpdateWorkflowExecutionTagsScope
+	// AdminClientPauseWorkflowExecutionScope tracks RPC calls to admin service
+	AdminClientPauseWorkflowExecutionScope
+	// AdminClientUnpauseWorkflowExecutionScope tracks RPC calls to admin service
+	AdminClientUnpauseWorkflowExecutionScope
+	// AdminClientShutdownWorkerScope tracks RPC calls to admin service
+	AdminClientShutdownWorkerScope
+	// AdminClientDescribeNamespaceConfigScope tracks RPC calls to admin service
+	AdminClientDescribeNamespaceConfigScope
+	// AdminClientListDynamicConfigKeysScope tracks RPC calls to admin service
+	AdminClientListDynamicConfigKeysScope
 	// AdminClientResendReplicationTasksScope tracks RPC calls to admin service
 	AdminClientResendReplicationTasksScope
+	// AdminClientDescribeWorkflowLocksScope tracks RPC calls to admin service
+	AdminClientDescribeWorkflowLocksScope
+	// AdminClientListWorkflowExecutionChainScope tracks RPC calls to admin service
+	AdminClientListWorkflowExecutionChainScope
+	// AdminClientGetWorkflowExecutionEventsScope tracks RPC calls to admin service
+	AdminClientGetWorkflowExecutionEventsScope
+	// AdminClientDescribeTaskQueueScope tracks RPC calls to admin service
+	AdminClientDescribeTaskQueueScope
+	// AdminClientBatchCompleteActivityTasksByIdScope tracks RPC calls to admin service
+	AdminClientBatchCompleteActivityTasksByIdScope
+	// AdminClientBatchFailActivityTasksByIdScope tracks RPC calls to admin service
+	AdminClientBatchFailActivityTasksByIdScope
+	// AdminClientExecuteCrossClusterTaskScope tracks RPC calls to admin service
+	AdminClientExecuteCrossClusterTaskScope
 	// DCRedirectionDeprecateNamespaceScope tracks RPC calls for dc redirection
 	DCRedirectionDeprecateNamespaceScope
 	// DCRedirectionDescribeNamespaceScope tracks RPC calls for dc redirection
@@ -592,6 +633,8 @@ const (
 	HistoryRereplicationByHistoryMetadataReplicationScope
 	// HistoryRereplicationByActivityReplicationScope tracks history replication calls made by activity replication
 	HistoryRereplicationByActivityReplicationScope
+	// HistoryRereplicationByWorkflowStateReplicationScope tracks history replication calls made by workflow state replication
+	HistoryRereplicationByWorkflowStateReplicationScope
 
 	// PersistenceAppendHistoryNodesScope tracks AppendHistoryNodes calls made by service to persistence layer
 	PersistenceAppendHistoryNodesScope
@@ -683,6 +726,8 @@ const (
 	AdminAddSearchAttributeScope
 	// AdminDescribeWorkflowExecutionScope is the metric scope for admin.AdminDescribeWorkflowExecutionScope
 	AdminDescribeWorkflowExecutionScope
+	// AdminBatchDescribeMutableStateScope is the metric scope for admin.BatchDescribeMutableState
+	AdminBatchDescribeMutableStateScope
 	// AdminGetWorkflowExecutionRawHistoryScope is the metric scope for admin.GetWorkflowExecutionRawHistoryScope
 	AdminGetWorkflowExecutionRawHistoryScope
 	// AdminGetWorkflowExecutionRawHistoryV2Scope is the metric scope for admin.GetWorkflowExecutionRawHistoryScope
@@ -697,8 +742,30 @@ const (
 	AdminReapplyEventsScope
 	// AdminRefreshWorkflowTasksScope is the metric scope for admin.RefreshWorkflowTasks
 	AdminRefreshWorkflowTasksScope
+	// AdminUpdateWorkflowExecutionTagsScope is the metric scope for admin.UpdateWorkflowExecutionTags
+	AdminUpdateWorkflowExecutionTagsScope
+	// AdminPauseWorkflowExecutionScope is the metric scope for admin.PauseWorkflowExecution
+	AdminPauseWorkflowExecutionScope
+	// AdminUnpauseWorkflowExecutionScope is the metric scope for admin.UnpauseWorkflowExecution
+	AdminUnpauseWorkflowExecutionScope
+	// AdminShutdownWorkerScope is the metric scope for admin.ShutdownWorker
+	AdminShutdownWorkerScope
+	// AdminDescribeNamespaceConfigScope is the metric scope for admin.DescribeNamespaceConfig
+	AdminDescribeNamespaceConfigScope
+	// AdminListDynamicConfigKeysScope is the metric scope for admin.ListDynamicConfigKeys
+	AdminListDynamicConfigKeysScope
 	// AdminResendReplicationTasksScope is the metric scope for admin.ResendReplicationTasks
 	AdminResendReplicationTasksScope
+	// AdminDescribeWorkflowLocksScope is the metric scope for admin.DescribeWorkflowLocks
+	AdminDescribeWorkflowLocksScope
+	// AdminListWorkflowExecutionChainScope is the metric scope for admin.ListWorkflowExecutionChain
+	AdminListWorkflowExecutionChainScope
+	// AdminGetWorkflowExecutionEventsScope is the metric scope for admin.GetWorkflowExecutionEvents
+	AdminGetWorkflowExecutionEventsScope
+	// AdminDescribeTaskQueueScope is the metric scope for admin.DescribeTaskQueue
+	AdminDescribeTaskQueueScope
+	// AdminExecuteCrossClusterTaskScope is the metric scope for admin.ExecuteCrossClusterTask
+	AdminExecuteCrossClusterTaskScope
 	// AdminRemoveTaskScope is the metric scope for admin.AdminRemoveTaskScope
 	AdminRemoveTaskScope
 	// AdminCloseShardTaskScope is the metric scope for admin.AdminRemoveTaskScope
@@ -793,6 +860,10 @@ const (
 	FrontendResetWorkflowExecutionScope
 	// FrontendGetSearchAttributesScope is the metric scope for frontend.GetSearchAttributes
 	FrontendGetSearchAttributesScope
+	// FrontendBatchCompleteActivityTasksByIdScope is the metric scope for frontend.BatchCompleteActivityTasksById
+	FrontendBatchCompleteActivityTasksByIdScope
+	// FrontendBatchFailActivityTasksByIdScope is the metric scope for frontend.BatchFailActivityTasksById
+	FrontendBatchFailActivityTasksByIdScope
 	// VersionCheckScope is scope used by version checker
 	VersionCheckScope
 	// AuthorizationScope is the scope used by all metric emitted by authorization code
@@ -867,6 +938,14 @@ const (
 	HistoryReapplyEventsScope
 	// HistoryRefreshWorkflowTasksScope is the scope used by refresh workflow tasks API
 	HistoryRefreshWorkflowTasksScope
+	// HistoryUpdateWorkflowExecutionTagsScope is the scope used by update workflow execution tags API
+	HistoryUpdateWorkflowExecutionTagsScope
+	// HistoryPauseWorkflowExecutionScope is the scope used by pause workflow execution API
+	HistoryPauseWorkflowExecutionScope
+	// HistoryUnpauseWorkflowExecutionScope is the scope used by unpause workflow execution API
+	HistoryUnpauseWorkflowExecutionScope
+	// HistoryDescribeWorkflowLocksScope is the scope used by describe workflow locks API
+	HistoryDescribeWorkflowLocksScope
 	// TaskPriorityAssignerScope is the scope used by all metric emitted by task priority assigner
 	TaskPriorityAssignerScope
 	// TransferQueueProcessorScope is the scope used by all metric emitted by transfer queue processor
@@ -1036,6 +1115,8 @@ const (
 	MatchingRespondQueryTaskCompletedScope
 	// MatchingCancelOutstandingPollScope tracks CancelOutstandingPoll API calls received by service
 	MatchingCancelOutstandingPollScope
+	// MatchingRemovePollerScope tracks RemovePoller API calls received by service
+	MatchingRemovePollerScope
 	// MatchingDescribeTaskQueueScope tracks DescribeTaskQueue API calls received by service
 	MatchingDescribeTaskQueueScope
 	// MatchingListTaskQueuePartitionsScope tracks ListTaskQueuePartitions API calls received by service
@@ -1058,6 +1139,8 @@ const (
 	SyncShardTaskScope
 	// SyncActivityTaskScope is the scope used by sync activity information processing
 	SyncActivityTaskScope
+	// SyncWorkflowStateTaskScope is the scope used by sync workflow state information processing
+	SyncWorkflowStateTaskScope
 	// ESProcessorScope is scope used by all metric emitted by esProcessor
 	ESProcessorScope
 	// IndexProcessorScope is scope used by all metric emitted by index processor
@@ -1084,6 +1167,12 @@ const (
 	HistoryScavengerScope
 	// ParentClosePolicyProcessorScope is scope used by all metrics emitted by worker.ParentClosePolicyProcessor
 	ParentClosePolicyProcessorScope
+	// LoadGeneratorScope is scope used by all metrics emitted by worker.LoadGenerator module
+	LoadGeneratorScope
+	// CanaryScope is scope used by all metrics emitted by worker.Canary module
+	CanaryScope
+	// StorageUsageScope is scope used by all metrics emitted by worker.storageusage.Accountant module
+	StorageUsageScope
 
 	NumWorkerScopes
 )
@@ -1218,6 +1307,10 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryClientPurgeDLQMessagesScope:                    {operation: "HistoryClientPurgeDLQMessagesScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientMergeDLQMessagesScope:                    {operation: "HistoryClientMergeDLQMessagesScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientRefreshWorkflowTasksScope:                {operation: "HistoryClientRefreshWorkflowTasksScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientUpdateWorkflowExecutionTagsScope:         {operation: "HistoryClientUpdateWorkflowExecutionTags", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientPauseWorkflowExecutionScope:              {operation: "HistoryClientPauseWorkflowExecution", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientUnpauseWorkflowExecutionScope:            {operation: "HistoryClientUnpauseWorkflowExecution", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientDescribeWorkflowLocksScope:               {operation: "HistoryClientDescribeWorkflowLocks", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
 		MatchingClientPollWorkflowTaskQueueScope:              {operation: "MatchingClientPollWorkflowTaskQueue", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientPollActivityTaskQueueScope:              {operation: "MatchingClientPollActivityTaskQueue", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientAddActivityTaskScope:                    {operation: "MatchingClientAddActivityTask", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
@@ -1225,6 +1318,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		MatchingClientQueryWorkflowScope:                      {operation: "MatchingClientQueryWorkflow", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientRespondQueryTaskCompletedScope:          {operation: "MatchingClientRespondQueryTaskCompleted", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientCancelOutstandingPollScope:              {operation: "MatchingClientCancelOutstandingPoll", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
+		MatchingClientRemovePollerScope:                       {operation: "MatchingClientRemovePoller", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientDescribeTaskQueueScope:                  {operation: "MatchingClientDescribeTaskQueue", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientListTaskQueuePartitionsScope:            {operation: "MatchingClientListTaskQueuePartitions", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
 		FrontendClientDeprecateNamespaceScope:                 {operation: "FrontendClientDeprecateNamespace", tags: map[string]string{ServiceRoleTagName: FrontendRoleTagValue}},
@@ -1274,11 +1368,25 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminClientAddSearchAttributeScope:                    {operation: "AdminClientAddSearchAttribute", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientDescribeHistoryHostScope:                   {operation: "AdminClientDescribeHistoryHost", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientDescribeWorkflowMutableStateScope:          {operation: "AdminClientDescribeWorkflowMutableState", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientBatchDescribeMutableStateScope:             {operation: "AdminClientBatchDescribeMutableState", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientGetWorkflowExecutionRawHistoryScope:        {operation: "AdminClientGetWorkflowExecutionRawHistory", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientGetWorkflowExecutionRawHistoryV2Scope:      {operation: "AdminClientGetWorkflowExecutionRawHistoryV2", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientDescribeClusterScope:                       {operation: "AdminClientDescribeCluster", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientRefreshWorkflowTasksScope:                  {operation: "AdminClientRefreshWorkflowTasks", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientUpdateWorkflowExecutionTagsScope:           {operation: "AdminClientUpdateWorkflowExecutionTags", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientPauseWorkflowExecutionScope:                {operation: "AdminClientPauseWorkflowExecution", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientUnpauseWorkflowExecutionScope:              {operation: "AdminClientUnpauseWorkflowExecution", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientShutdownWorkerScope:                        {operation: "AdminClientShutdownWorker", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientDescribeNamespaceConfigScope:               {operation: "AdminClientDescribeNamespaceConfig", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientListDynamicConfigKeysScope:                 {operation: "AdminClientListDynamicConfigKeys", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientResendReplicationTasksScope:                {operation: "AdminClientResendReplicationTasks", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientDescribeWorkflowLocksScope:                 {operation: "AdminClientDescribeWorkflowLocks", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientListWorkflowExecutionChainScope:            {operation: "AdminClientListWorkflowExecutionChain", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientGetWorkflowExecutionEventsScope:            {operation: "AdminClientGetWorkflowExecutionEvents", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientDescribeTaskQueueScope:                     {operation: "AdminClientDescribeTaskQueue", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientBatchCompleteActivityTasksByIdScope:        {operation: "AdminClientBatchCompleteActivityTasksById", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientBatchFailActivityTasksByIdScope:            {operation: "AdminClientBatchFailActivityTasksById", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientExecuteCrossClusterTaskScope:               {operation: "AdminClientExecuteCrossClusterTask", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientCloseShardScope:                            {operation: "AdminClientCloseShard", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientGetDLQMessagesScope:                        {operation: "AdminClientGetDLQMessages", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientPurgeDLQMessagesScope:                      {operation: "AdminClientPurgeDLQMessages", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
@@ -1332,6 +1440,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryRereplicationByHistoryReplicationScope:         {operation: "HistoryRereplicationByHistoryReplication"},
 		HistoryRereplicationByHistoryMetadataReplicationScope: {operation: "HistoryRereplicationByHistoryMetadataReplication"},
 		HistoryRereplicationByActivityReplicationScope:        {operation: "HistoryRereplicationByActivityReplication"},
+		HistoryRereplicationByWorkflowStateReplicationScope:   {operation: "HistoryRereplicationByWorkflowStateReplication"},
 
 		ElasticsearchRecordWorkflowExecutionStartedScope:           {operation: "RecordWorkflowExecutionStarted"},
 		ElasticsearchRecordWorkflowExecutionClosedScope:            {operation: "RecordWorkflowExecutionClosed"},
@@ -1373,6 +1482,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminDescribeHistoryHostScope:              {operation: "DescribeHistoryHost"},
 		AdminAddSearchAttributeScope:               {operation: "AddSearchAttribute"},
 		AdminDescribeWorkflowExecutionScope:        {operation: "DescribeWorkflowExecution"},
+		AdminBatchDescribeMutableStateScope:        {operation: "BatchDescribeMutableState"},
 		AdminGetWorkflowExecutionRawHistoryScope:   {operation: "GetWorkflowExecutionRawHistory"},
 		AdminGetWorkflowExecutionRawHistoryV2Scope: {operation: "GetWorkflowExecutionRawHistoryV2"},
 		AdminGetReplicationMessagesScope:           {operation: "GetReplicationMessages"},
@@ -1380,7 +1490,18 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminGetDLQReplicationMessagesScope:        {operation: "AdminGetDLQReplicationMessages"},
 		AdminReapplyEventsScope:                    {operation: "ReapplyEvents"},
 		AdminRefreshWorkflowTasksScope:             {operation: "RefreshWorkflowTasks"},
+		AdminUpdateWorkflowExecutionTagsScope:      {operation: "UpdateWorkflowExecutionTags"},
+		AdminPauseWorkflowExecutionScope:           {operation: "PauseWorkflowExecution"},
+		AdminUnpauseWorkflowExecutionScope:         {operation: "UnpauseWorkflowExecution"},
+		AdminShutdownWorkerScope:                   {operation: "ShutdownWorker"},
+		AdminDescribeNamespaceConfigScope:          {operation: "DescribeNamespaceConfig"},
+		AdminListDynamicConfigKeysScope:            {operation: "ListDynamicConfigKeys"},
 		AdminResendReplicationTasksScope:           {operation: "ResendReplicationTasks"},
+		AdminDescribeWorkflowLocksScope:            {operation: "DescribeWorkflowLocks"},
+		AdminListWorkflowExecutionChainScope:       {operation: "ListWorkflowExecutionChain"},
+		AdminGetWorkflowExecutionEventsScope:       {operation: "GetWorkflowExecutionEvents"},
+		AdminDescribeTaskQueueScope:                {operation: "DescribeTaskQueue"},
+		AdminExecuteCrossClusterTaskScope:          {operation: "ExecuteCrossClusterTask"},
 
 		FrontendStartWorkflowExecutionScope:             {operation: "StartWorkflowExecution"},
 		FrontendPollWorkflowTaskQueueScope:              {operation: "PollWorkflowTaskQueue"},
@@ -1421,6 +1542,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendDescribeTaskQueueScope:                  {operation: "DescribeTaskQueue"},
 		FrontendResetStickyTaskQueueScope:               {operation: "ResetStickyTaskQueue"},
 		FrontendGetSearchAttributesScope:                {operation: "GetSearchAttributes"},
+		FrontendBatchCompleteActivityTasksByIdScope:     {operation: "BatchCompleteActivityTasksById"},
+		FrontendBatchFailActivityTasksByIdScope:         {operation: "BatchFailActivityTasksById"},
 		VersionCheckScope:                               {operation: "VersionCheck"},
 		AuthorizationScope:                              {operation: "Authorization"},
 	},
@@ -1461,6 +1584,10 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryShardControllerScope:                            {operation: "ShardController"},
 		HistoryReapplyEventsScope:                              {operation: "EventReapplication"},
 		HistoryRefreshWorkflowTasksScope:                       {operation: "RefreshWorkflowTasks"},
+		HistoryUpdateWorkflowExecutionTagsScope:                {operation: "UpdateWorkflowExecutionTags"},
+		HistoryPauseWorkflowExecutionScope:                     {operation: "PauseWorkflowExecution"},
+		HistoryUnpauseWorkflowExecutionScope:                   {operation: "UnpauseWorkflowExecution"},
+		HistoryDescribeWorkflowLocksScope:                      {operation: "DescribeWorkflowLocks"},
 		TaskPriorityAssignerScope:                              {operation: "TaskPriorityAssigner"},
 		TransferQueueProcessorScope:                            {operation: "TransferQueueProcessor"},
 		TransferActiveQueueProcessorScope:                      {operation: "TransferActiveQueueProcessor"},
@@ -1534,6 +1661,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		ElasticSearchVisibility:                   {operation: "ElasticSearchVisibility"},
 		SyncShardTaskScope:                        {operation: "SyncShardTask"},
 		SyncActivityTaskScope:                     {operation: "SyncActivityTask"},
+		SyncWorkflowStateTaskScope:                {operation: "SyncWorkflowStateTask"},
 		HistoryMetadataReplicationTaskScope:       {operation: "HistoryMetadataReplicationTask"},
 		HistoryReplicationTaskScope:               {operation: "HistoryReplicationTask"},
 		ReplicatorScope:                           {operation: "Replicator"},
@@ -1548,6 +1676,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		MatchingQueryWorkflowScope:             {operation: "QueryWorkflow"},
 		MatchingRespondQueryTaskCompletedScope: {operation: "RespondQueryTaskCompleted"},
 		MatchingCancelOutstandingPollScope:     {operation: "CancelOutstandingPoll"},
+		MatchingRemovePollerScope:              {operation: "RemovePoller"},
 		MatchingDescribeTaskQueueScope:         {operation: "DescribeTaskQueue"},
 		MatchingListTaskQueuePartitionsScope:   {operation: "ListTaskQueuePartitions"},
 	},
@@ -1572,6 +1701,9 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryScavengerScope:                  {operation: "historyscavenger"},
 		BatcherScope:                           {operation: "batcher"},
 		ParentClosePolicyProcessorScope:        {operation: "ParentClosePolicyProcessor"},
+		LoadGeneratorScope:                     {operation: "loadgenerator"},
+		CanaryScope:                            {operation: "canary"},
+		StorageUsageScope:                      {operation: "storageusage"},
 	},
 }
 
@@ -1611,6 +1743,7 @@ const (
 	PersistenceErrNamespaceAlreadyExistsCounter
 	PersistenceErrBadRequestCounter
 	PersistenceSampledCounter
+	PersistenceErrorWithClassCounter
 
 	ClientRequests
 	ClientFailures
@@ -1810,6 +1943,9 @@ const (
 	CacheMissCounter
 	AcquireLockFailedCounter
 	WorkflowContextCleared
+	WorkflowContextLockContended
+	WorkflowContextLockWaitLatency
+	WorkflowContextLockHoldLatency
 	MutableStateSize
 	ExecutionInfoSize
 	ActivityInfoSize
@@ -1920,6 +2056,10 @@ const (
 	LocalToRemoteMatchPerTaskQueueCounter
 	RemoteToLocalMatchPerTaskQueueCounter
 	RemoteToRemoteMatchPerTaskQueueCounter
+	SyncMatchedTasksPerTaskQueueCounter
+	SpilledTasksPerTaskQueueCounter
+	SyncMatchRatioPerTaskQueue
+	SpillLatencyPerTaskQueue
 
 	NumMatchingMetrics
 )
@@ -1985,6 +2125,21 @@ const (
 	ScavengerValidationFailuresCount
 	ExecutionsScavengerExecutionsCount
 	ExecutionsScavengerCorruptedExecutionsCount
+	LoadGeneratorWorkflowsStarted
+	LoadGeneratorWorkflowsCompleted
+	LoadGeneratorWorkflowsFailed
+	LoadGeneratorRunLatency
+	CanaryProbeRequests
+	CanaryProbeFailures
+	CanaryProbeLatency
+	StorageUsageExecutionCount
+	StorageUsageHistoryBytes
+	StorageUsageMutableStateBytes
+	StorageUsageVisibilityBytes
+	StorageUsageShardExecutionCount
+	StorageUsageShardHistoryBytes
+	StorageUsageShardMutableStateBytes
+	StorageUsageShardVisibilityBytes
 
 	NumWorkerMetrics
 )
@@ -2026,6 +2181,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		PersistenceErrNamespaceAlreadyExistsCounter:         {metricName: "persistence_errors_namespace_already_exists", metricType: Counter},
 		PersistenceErrBadRequestCounter:                     {metricName: "persistence_errors_bad_request", metricType: Counter},
 		PersistenceSampledCounter:                           {metricName: "persistence_sampled", metricType: Counter},
+		PersistenceErrorWithClassCounter:                    {metricName: "persistence_error_with_class", metricType: Counter},
 		ClientRequests:                                      {metricName: "client_requests", metricType: Counter},
 		ClientFailures:                                      {metricName: "client_errors", metricType: Counter},
 		ClientLatency:                                       {metricName: "client_latency", metricType: Timer},
@@ -2243,6 +2399,9 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		CacheMissCounter:                                  {metricName: "cache_miss", metricType: Counter},
 		AcquireLockFailedCounter:                          {metricName: "acquire_lock_failed", metricType: Counter},
 		WorkflowContextCleared:                            {metricName: "workflow_context_cleared", metricType: Counter},
+		WorkflowContextLockContended:                      {metricName: "workflow_context_lock_contended", metricType: Counter},
+		WorkflowContextLockWaitLatency:                    {metricName: "workflow_context_lock_wait_latency", metricType: Timer},
+		WorkflowContextLockHoldLatency:                    {metricName: "workflow_context_lock_hold_latency", metricType: Timer},
 		MutableStateSize:                                  {metricName: "mutable_state_size", metricType: Timer},
 		ExecutionInfoSize:                                 {metricName: "execution_info_size", metricType: Timer},
 		ActivityInfoSize:                                  {metricName: "activity_info_size", metricType: Timer},
@@ -2349,6 +2508,10 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		LocalToRemoteMatchPerTaskQueueCounter:     {metricName: "local_to_remote_matches_per_tl", metricRollupName: "local_to_remote_matches"},
 		RemoteToLocalMatchPerTaskQueueCounter:     {metricName: "remote_to_local_matches_per_tl", metricRollupName: "remote_to_local_matches"},
 		RemoteToRemoteMatchPerTaskQueueCounter:    {metricName: "remote_to_remote_matches_per_tl", metricRollupName: "remote_to_remote_matches"},
+		SyncMatchedTasksPerTaskQueueCounter:       {metricName: "sync_matched_tasks_per_tl", metricRollupName: "sync_matched_tasks"},
+		SpilledTasksPerTaskQueueCounter:           {metricName: "spilled_tasks_per_tl", metricRollupName: "spilled_tasks"},
+		SyncMatchRatioPerTaskQueue:                {metricName: "sync_match_ratio_per_tl", metricType: Gauge},
+		SpillLatencyPerTaskQueue:                  {metricName: "spill_latency_per_tl", metricRollupName: "spill_latency", metricType: Timer},
 	},
 	Worker: {
 		ReplicatorMessages:                            {metricName: "replicator_messages"},
@@ -2410,6 +2573,21 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ScavengerValidationFailuresCount:              {metricName: "scavenger_validation_failures", metricType: Counter},
 		ExecutionsScavengerExecutionsCount:            {metricName: "executions_scavenger_executions_count", metricType: Gauge},
 		ExecutionsScavengerCorruptedExecutionsCount:   {metricName: "executions_scavenger_corrupted_executions_count", metricType: Gauge},
+		LoadGeneratorWorkflowsStarted:                 {metricName: "loadgen_workflows_started", metricType: Counter},
+		LoadGeneratorWorkflowsCompleted:               {metricName: "loadgen_workflows_completed", metricType: Counter},
+		LoadGeneratorWorkflowsFailed:                  {metricName: "loadgen_workflows_failed", metricType: Counter},
+		LoadGeneratorRunLatency:                       {metricName: "loadgen_run_latency", metricType: Timer},
+		CanaryProbeRequests:                           {metricName: "canary_probe_requests", metricType: Counter},
+		CanaryProbeFailures:                           {metricName: "canary_probe_failures", metricType: Counter},
+		CanaryProbeLatency:                            {metricName: "canary_probe_latency", metricType: Timer},
+		StorageUsageExecutionCount:                    {metricName: "storage_usage_executions", metricType: Gauge},
+		StorageUsageHistoryBytes:                      {metricName: "storage_usage_history_bytes", metricType: Gauge},
+		StorageUsageMutableStateBytes:                 {metricName: "storage_usage_mutable_state_bytes", metricType: Gauge},
+		StorageUsageVisibilityBytes:                   {metricName: "storage_usage_visibility_bytes", metricType: Gauge},
+		StorageUsageShardExecutionCount:               {metricName: "storage_usage_shard_executions", metricType: Timer},
+		StorageUsageShardHistoryBytes:                 {metricName: "storage_usage_shard_history_bytes", metricType: Timer},
+		StorageUsageShardMutableStateBytes:            {metricName: "storage_usage_shard_mutable_state_bytes", metricType: Timer},
+		StorageUsageShardVisibilityBytes:              {metricName: "storage_usage_shard_visibility_bytes", metricType: Timer},
 	},
 }
 
@@ -99,6 +99,18 @@ type (
 	validatorTag struct {
 		value string
 	}
+
+	tableTag struct {
+		value string
+	}
+
+	errorClassTag struct {
+		value string
+	}
+
+	canaryProbeTag struct {
+		value string
+	}
 )
 
 // NamespaceTag returns a new namespace tag. For timers, this also ensures that we
@@ -327,3 +339,57 @@ func (d validatorTag) Key() string {
 func (d validatorTag) Value() string {
 	return d.value
 }
+
+// TableTag returns a new persistence table tag
+func TableTag(value string) Tag {
+	if len(value) == 0 {
+		value = unknownValue
+	}
+	return tableTag{value}
+}
+
+// Key returns the key of the tag
+func (d tableTag) Key() string {
+	return TableTagName
+}
+
+// Value returns the value of the tag
+func (d tableTag) Value() string {
+	return d.value
+}
+
+// ErrorClassTag returns a new persistence error class tag
+func ErrorClassTag(value string) Tag {
+	if len(value) == 0 {
+		value = unknownValue
+	}
+	return errorClassTag{value}
+}
+
+// Key returns the key of the tag
+func (d errorClassTag) Key() string {
+	return ErrorClassTagName
+}
+
+// Value returns the value of the tag
+func (d errorClassTag) Value() string {
+	return d.value
+}
+
+// CanaryProbeTag returns a new canary probe tag
+func CanaryProbeTag(value string) Tag {
+	if len(value) == 0 {
+		value = unknownValue
+	}
+	return canaryProbeTag{value}
+}
+
+// Key returns the key of the tag
+func (d canaryProbeTag) Key() string {
+	return CanaryProbeTagName
+}
+
+// Value returns the value of the tag
+func (d canaryProbeTag) Value() string {
+	return d.value
+}
@@ -26,6 +26,7 @@ package namespace
 
 import (
 	"fmt"
+	"net/url"
 	"time"
 
 	enumspb "go.temporal.io/api/enums/v1"
@@ -68,6 +69,21 @@ func (d *AttrValidatorImpl) validateNamespaceConfig(config *persistencespb.Names
 	return nil
 }
 
+func (d *AttrValidatorImpl) validateNamespaceData(data map[string]string) error {
+	endpoint, ok := data[CodecEndpointDataKey]
+	if !ok || endpoint == "" {
+		return nil
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil || !u.IsAbs() || u.Host == "" {
+		return errInvalidCodecEndpoint
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return errInvalidCodecEndpoint
+	}
+	return nil
+}
+
 func (d *AttrValidatorImpl) validateNamespaceReplicationConfigForLocalNamespace(
 	replicationConfig *persistencespb.NamespaceReplicationConfig,
 ) error {
@@ -98,6 +98,38 @@ func (s *attrValidatorSuite) TestValidateConfigRetentionPeriod() {
 	}
 }
 
+func (s *attrValidatorSuite) TestValidateNamespaceData() {
+	testCases := []struct {
+		data        map[string]string
+		expectedErr error
+	}{
+		{
+			data:        nil,
+			expectedErr: nil,
+		},
+		{
+			data:        map[string]string{CodecKeyIDDataKey: "key-1"},
+			expectedErr: nil,
+		},
+		{
+			data:        map[string]string{CodecEndpointDataKey: "https://codec.example.com/decode"},
+			expectedErr: nil,
+		},
+		{
+			data:        map[string]string{CodecEndpointDataKey: "codec.example.com"},
+			expectedErr: errInvalidCodecEndpoint,
+		},
+		{
+			data:        map[string]string{CodecEndpointDataKey: "ftp://codec.example.com"},
+			expectedErr: errInvalidCodecEndpoint,
+		},
+	}
+	for _, tc := range testCases {
+		err := s.validator.validateNamespaceData(tc.data)
+		s.Equal(tc.expectedErr, err)
+	}
+}
+
 func (s *attrValidatorSuite) TestClusterName() {
 	s.mockClusterMetadata.EXPECT().GetAllClusterInfo().Return(
 		cluster.TestAllClusterInfo,
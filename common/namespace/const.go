@@ -31,3 +31,12 @@ const (
 	// MaxBadBinaries is the maximal number of bad client binaries stored in a namespace
 	MaxBadBinaries = 10
 )
+
+const (
+	// CodecEndpointDataKey is the namespace data key holding the URL of the remote codec
+	// server which clients (e.g. UI, tctl) should use to decode payloads of this namespace
+	CodecEndpointDataKey = "temporal.codec.endpoint"
+	// CodecKeyIDDataKey is the namespace data key holding the ID of the encryption key
+	// used by the namespace data converter, the key material itself is never stored
+	CodecKeyIDDataKey = "temporal.codec.keyId"
+)
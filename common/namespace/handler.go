@@ -225,6 +225,9 @@ func (d *HandlerImpl) RegisterNamespace(
 	if err := d.namespaceAttrValidator.validateNamespaceConfig(config); err != nil {
 		return nil, err
 	}
+	if err := d.namespaceAttrValidator.validateNamespaceData(info.Data); err != nil {
+		return nil, err
+	}
 	if isGlobalNamespace {
 		if err := d.namespaceAttrValidator.validateNamespaceReplicationConfigForGlobalNamespace(
 			replicationConfig,
@@ -501,6 +504,10 @@ func (d *HandlerImpl) UpdateNamespace(
 	if err := d.namespaceAttrValidator.validateNamespaceConfig(config); err != nil {
 		return nil, err
 	}
+	// only validate the keys carried by the update so that existing data does not block unrelated updates
+	if err := d.namespaceAttrValidator.validateNamespaceData(updateRequest.GetUpdateInfo().GetData()); err != nil {
+		return nil, err
+	}
 	if isGlobalNamespace {
 		if err := d.namespaceAttrValidator.validateNamespaceReplicationConfigForGlobalNamespace(
 			replicationConfig,
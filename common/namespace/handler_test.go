@@ -424,6 +424,65 @@ func (s *namespaceHandlerCommonSuite) TestUpdateNamespace_InvalidRetentionPeriod
 	s.Nil(resp)
 }
 
+func (s *namespaceHandlerCommonSuite) TestUpdateNamespace_InvalidCodecEndpoint() {
+	namespace := s.getRandomNamespace()
+	registerRequest := &workflowservice.RegisterNamespaceRequest{
+		Namespace:                        namespace,
+		Description:                      namespace,
+		WorkflowExecutionRetentionPeriod: timestamp.DurationPtr(10 * time.Hour * 24),
+		IsGlobalNamespace:                false,
+	}
+	_, err := s.handler.RegisterNamespace(context.Background(), registerRequest)
+	s.NoError(err)
+
+	updateRequest := &workflowservice.UpdateNamespaceRequest{
+		Namespace: namespace,
+		UpdateInfo: &namespacepb.UpdateNamespaceInfo{
+			Data: map[string]string{CodecEndpointDataKey: "localhost:8888"},
+		},
+	}
+	resp, err := s.handler.UpdateNamespace(context.Background(), updateRequest)
+	s.Equal(errInvalidCodecEndpoint, err)
+	s.Nil(resp)
+}
+
+func (s *namespaceHandlerCommonSuite) TestUpdateNamespace_ExistingDataNotValidated() {
+	namespace := s.getRandomNamespace()
+	registerRequest := &workflowservice.RegisterNamespaceRequest{
+		Namespace:                        namespace,
+		Description:                      namespace,
+		WorkflowExecutionRetentionPeriod: timestamp.DurationPtr(10 * time.Hour * 24),
+		IsGlobalNamespace:                false,
+	}
+	_, err := s.handler.RegisterNamespace(context.Background(), registerRequest)
+	s.NoError(err)
+
+	// simulate data stored before the codec endpoint was validated
+	metadata, err := s.metadataMgr.GetMetadata()
+	s.NoError(err)
+	getResp, err := s.metadataMgr.GetNamespace(&persistence.GetNamespaceRequest{Name: namespace})
+	s.NoError(err)
+	getResp.Namespace.Info.Data = map[string]string{CodecEndpointDataKey: "localhost:8888"}
+	err = s.metadataMgr.UpdateNamespace(&persistence.UpdateNamespaceRequest{
+		Namespace:           getResp.Namespace,
+		NotificationVersion: metadata.NotificationVersion,
+	})
+	s.NoError(err)
+
+	updateRequest := &workflowservice.UpdateNamespaceRequest{
+		Namespace: namespace,
+		UpdateInfo: &namespacepb.UpdateNamespaceInfo{
+			Data: map[string]string{CodecKeyIDDataKey: "key-1"},
+		},
+	}
+	resp, err := s.handler.UpdateNamespace(context.Background(), updateRequest)
+	s.NoError(err)
+	s.Equal(map[string]string{
+		CodecEndpointDataKey: "localhost:8888",
+		CodecKeyIDDataKey:    "key-1",
+	}, resp.NamespaceInfo.GetData())
+}
+
 func (s *namespaceHandlerCommonSuite) getRandomNamespace() string {
 	return "namespace" + uuid.New()
 }
@@ -691,6 +691,9 @@ func createReplicationTasks(
 			version = task.GetVersion()
 			activityScheduleID = task.(*p.SyncActivityTask).ScheduledID
 
+		case enumsspb.TASK_TYPE_REPLICATION_SYNC_WORKFLOW_STATE:
+			version = task.GetVersion()
+
 		default:
 			return serviceerror.NewInternal(fmt.Sprintf("Unknow replication type: %v", task.GetType()))
 		}
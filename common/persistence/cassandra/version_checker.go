@@ -26,7 +26,9 @@ package cassandra
 
 import (
 	"context"
+	"fmt"
 
+	"go.temporal.io/api/serviceerror"
 	"golang.org/x/sync/errgroup"
 
 	"go.temporal.io/server/common/persistence/schema"
@@ -86,7 +88,7 @@ func checkCompatibleVersion(
 
 	session, err := NewSession(cfg, r)
 	if err != nil {
-		return err
+		return serviceerror.NewUnavailable(fmt.Sprintf("unable to connect to cassandra keyspace %v: %v", cfg.Keyspace, err))
 	}
 	defer session.Close()
 
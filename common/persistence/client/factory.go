@@ -106,6 +106,7 @@ type (
 		logger                   log.Logger
 		datastores               map[storeType]Datastore
 		clusterName              string
+		faultInjector            *p.FaultInjector
 	}
 
 	storeType int
@@ -139,7 +140,9 @@ var storeTypes = []storeType{
 // also contains config for individual datastores themselves.
 //
 // The objects returned by this factory enforce ratelimit and maxconns according to
-// given configuration. In addition, all objects will emit metrics automatically
+// given configuration. In addition, all objects will emit metrics automatically.
+// When fault injection is enabled in static config, objects will also inject errors and
+// latency into datastore calls, for chaos testing only
 func NewFactory(
 	cfg *config.Persistence,
 	r resolver.ServiceResolver,
@@ -156,6 +159,9 @@ func NewFactory(
 		logger:                   logger,
 		clusterName:              clusterName,
 	}
+	if cfg.FaultInjection != nil {
+		factory.faultInjector = p.NewFaultInjector(cfg.FaultInjection)
+	}
 	limiters := buildRateLimiters(cfg, persistenceMaxQPS)
 	factory.init(clusterName, limiters, r)
 	return factory
@@ -168,6 +174,9 @@ func (f *factoryImpl) NewTaskManager() (p.TaskManager, error) {
 	if err != nil {
 		return nil, err
 	}
+	if f.isFaultInjectionEnabled() {
+		result = p.NewTaskPersistenceFaultInjectionClient(result, f.faultInjector, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewTaskPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -184,6 +193,9 @@ func (f *factoryImpl) NewShardManager() (p.ShardManager, error) {
 	if err != nil {
 		return nil, err
 	}
+	if f.isFaultInjectionEnabled() {
+		result = p.NewShardPersistenceFaultInjectionClient(result, f.faultInjector, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewShardPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -201,6 +213,9 @@ func (f *factoryImpl) NewHistoryManager() (p.HistoryManager, error) {
 		return nil, err
 	}
 	result := p.NewHistoryV2ManagerImpl(store, f.logger, f.config.TransactionSizeLimit)
+	if f.isFaultInjectionEnabled() {
+		result = p.NewHistoryV2PersistenceFaultInjectionClient(result, f.faultInjector, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewHistoryV2PersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -221,6 +236,9 @@ func (f *factoryImpl) NewMetadataManager() (p.MetadataManager, error) {
 	}
 
 	result := p.NewMetadataManagerImpl(store, f.logger, f.clusterName)
+	if f.isFaultInjectionEnabled() {
+		result = p.NewMetadataPersistenceFaultInjectionClient(result, f.faultInjector, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewMetadataPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -241,6 +259,9 @@ func (f *factoryImpl) NewClusterMetadataManager() (p.ClusterMetadataManager, err
 	}
 
 	result := p.NewClusterMetadataManagerImpl(store, f.logger)
+	if f.isFaultInjectionEnabled() {
+		result = p.NewClusterMetadataPersistenceFaultInjectionClient(result, f.faultInjector, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewClusterMetadataPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -261,6 +282,9 @@ func (f *factoryImpl) NewExecutionManager(
 		return nil, err
 	}
 	result := p.NewExecutionManagerImpl(store, f.logger)
+	if f.isFaultInjectionEnabled() {
+		result = p.NewWorkflowExecutionPersistenceFaultInjectionClient(result, f.faultInjector, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewWorkflowExecutionPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -284,6 +308,9 @@ func (f *factoryImpl) NewVisibilityManager() (p.VisibilityManager, error) {
 	}
 
 	result := p.NewVisibilityManagerImpl(store, f.logger)
+	if f.isFaultInjectionEnabled() {
+		result = p.NewVisibilityPersistenceFaultInjectionClient(result, f.faultInjector, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewVisibilityPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -304,6 +331,9 @@ func (f *factoryImpl) NewNamespaceReplicationQueue() (p.NamespaceReplicationQueu
 	if err != nil {
 		return nil, err
 	}
+	if f.isFaultInjectionEnabled() {
+		result = p.NewQueuePersistenceFaultInjectionClient(result, f.faultInjector, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewQueuePersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -320,6 +350,15 @@ func (f *factoryImpl) Close() {
 	ds.factory.Close()
 	ds = f.datastores[storeTypeVisibility]
 	ds.factory.Close()
+	if f.faultInjector != nil {
+		f.faultInjector.Close()
+	}
+}
+
+// isFaultInjectionEnabled returns true if managers must be wrapped with the fault injection layer,
+// whether faults are actually injected is decided by FaultInjectionConfig.Enabled on each call
+func (f *factoryImpl) isFaultInjectionEnabled() bool {
+	return f.faultInjector != nil
 }
 
 func (f *factoryImpl) isCassandra() bool {
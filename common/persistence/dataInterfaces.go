@@ -404,6 +404,14 @@ type (
 		ScheduledID         int64
 	}
 
+	// SyncWorkflowStateTask is the replication task created for shipping workflow state which is not
+	// recorded in history events, such as the pause state, to other clusters
+	SyncWorkflowStateTask struct {
+		VisibilityTimestamp time.Time
+		TaskID              int64
+		Version             int64
+	}
+
 	// CreateShardRequest is used to create a shard in executions table
 	CreateShardRequest struct {
 		ShardInfo *persistencespb.ShardInfo
@@ -1024,6 +1032,10 @@ type (
 		NextPageToken []byte
 		// The shard to get history branch data
 		ShardID *int32
+		// ReadFromReplica allows ReadHistoryBranch and ReadHistoryBranchByBatch to be served by a read replica,
+		// only set it for branches which are no longer appended to, e.g. of closed workflows. The last page is read
+		// again from the primary database if the replica does not return the events up to MaxEventID.
+		ReadFromReplica bool
 	}
 
 	// ReadHistoryBranchResponse is the response to ReadHistoryBranchRequest
@@ -2160,6 +2172,41 @@ func (a *SyncActivityTask) SetVisibilityTimestamp(timestamp time.Time) {
 	a.VisibilityTimestamp = timestamp
 }
 
+// GetType returns the type of the sync workflow state replication task
+func (a *SyncWorkflowStateTask) GetType() enumsspb.TaskType {
+	return enumsspb.TASK_TYPE_REPLICATION_SYNC_WORKFLOW_STATE
+}
+
+// GetVersion returns the version of the sync workflow state replication task
+func (a *SyncWorkflowStateTask) GetVersion() int64 {
+	return a.Version
+}
+
+// SetVersion returns the version of the sync workflow state replication task
+func (a *SyncWorkflowStateTask) SetVersion(version int64) {
+	a.Version = version
+}
+
+// GetTaskID returns the sequence ID of the sync workflow state replication task
+func (a *SyncWorkflowStateTask) GetTaskID() int64 {
+	return a.TaskID
+}
+
+// SetTaskID sets the sequence ID of the sync workflow state replication task
+func (a *SyncWorkflowStateTask) SetTaskID(id int64) {
+	a.TaskID = id
+}
+
+// GetVisibilityTimestamp get the visibility timestamp
+func (a *SyncWorkflowStateTask) GetVisibilityTimestamp() time.Time {
+	return a.VisibilityTimestamp
+}
+
+// SetVisibilityTimestamp set the visibility timestamp
+func (a *SyncWorkflowStateTask) SetVisibilityTimestamp(timestamp time.Time) {
+	a.VisibilityTimestamp = timestamp
+}
+
 // DBTimestampToUnixNano converts CQL timestamp to UnixNano
 func DBTimestampToUnixNano(milliseconds int64) int64 {
 	return (time.Duration(milliseconds) * time.Millisecond).Nanoseconds()
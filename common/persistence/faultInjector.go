@@ -0,0 +1,111 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"math/rand"
+	"strings"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/service/config"
+)
+
+// ErrPersistenceFaultInjected is the error returned for calls failed by the fault injection layer.
+var ErrPersistenceFaultInjected = serviceerror.NewUnavailable("Persistence fault injected.")
+
+type (
+	// FaultInjector decides, per persistence API call, whether to delay and/or fail the call.
+	// It is shared by all fault injection persistence clients created by a factory.
+	FaultInjector struct {
+		config *config.FaultInjectionConfig
+		ctx    context.Context
+		cancel context.CancelFunc
+	}
+)
+
+// NewFaultInjector creates a new FaultInjector driven by the given config
+func NewFaultInjector(config *config.FaultInjectionConfig) *FaultInjector {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &FaultInjector{
+		config: config,
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// Inject applies the configured latency and error rate to a call of the given persistence API.
+// It returns a non nil error if the call must fail without reaching the datastore.
+// Persistence APIs do not take a request context, so injected latency is bounded by the
+// lifetime of the injector instead: Close interrupts all pending delays.
+func (f *FaultInjector) Inject(api string) error {
+	return f.InjectWithContext(f.ctx, api)
+}
+
+// InjectWithContext is the same as Inject, except injected latency is also interrupted when ctx is done.
+func (f *FaultInjector) InjectWithContext(ctx context.Context, api string) error {
+	if !f.config.Enabled() || !f.isTargeted(api) {
+		return nil
+	}
+
+	if latency := f.config.Latency(); latency > 0 {
+		timer := time.NewTimer(latency)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ErrPersistenceFaultInjected
+		case <-f.ctx.Done():
+			timer.Stop()
+			return ErrPersistenceFaultInjected
+		}
+	}
+
+	if errorRate := f.config.ErrorRate(); errorRate > 0 && rand.Float64() < errorRate {
+		return ErrPersistenceFaultInjected
+	}
+	return nil
+}
+
+// Close interrupts all pending injected delays
+func (f *FaultInjector) Close() {
+	f.cancel()
+}
+
+func (f *FaultInjector) isTargeted(api string) bool {
+	targetAPIs := strings.TrimSpace(f.config.TargetAPIs())
+	if targetAPIs == "" {
+		return true
+	}
+
+	for _, target := range strings.Split(targetAPIs, ",") {
+		if strings.TrimSpace(target) == api {
+			return true
+		}
+	}
+	return false
+}
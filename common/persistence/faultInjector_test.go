@@ -0,0 +1,114 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/service/config"
+	"go.temporal.io/server/common/service/dynamicconfig"
+)
+
+type (
+	faultInjectorSuite struct {
+		suite.Suite
+		*require.Assertions
+	}
+)
+
+func TestFaultInjectorSuite(t *testing.T) {
+	s := new(faultInjectorSuite)
+	suite.Run(t, s)
+}
+
+func (s *faultInjectorSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *faultInjectorSuite) newFaultInjector(errorRate float64, targetAPIs string) *FaultInjector {
+	return NewFaultInjector(&config.FaultInjectionConfig{
+		Enabled:    dynamicconfig.GetBoolPropertyFn(true),
+		ErrorRate:  dynamicconfig.GetFloatPropertyFn(errorRate),
+		Latency:    dynamicconfig.GetDurationPropertyFn(0),
+		TargetAPIs: dynamicconfig.GetStringPropertyFn(targetAPIs),
+	})
+}
+
+func (s *faultInjectorSuite) TestInject_NoErrorRate() {
+	faultInjector := s.newFaultInjector(0, "")
+	for i := 0; i < 100; i++ {
+		s.NoError(faultInjector.Inject("GetWorkflowExecution"))
+	}
+}
+
+func (s *faultInjectorSuite) TestInject_FullErrorRate() {
+	faultInjector := s.newFaultInjector(1, "")
+	for i := 0; i < 100; i++ {
+		s.Equal(ErrPersistenceFaultInjected, faultInjector.Inject("GetWorkflowExecution"))
+	}
+}
+
+func (s *faultInjectorSuite) TestInject_TargetAPIs() {
+	faultInjector := s.newFaultInjector(1, "GetWorkflowExecution, UpdateWorkflowExecution")
+	s.Equal(ErrPersistenceFaultInjected, faultInjector.Inject("GetWorkflowExecution"))
+	s.Equal(ErrPersistenceFaultInjected, faultInjector.Inject("UpdateWorkflowExecution"))
+	s.NoError(faultInjector.Inject("CreateWorkflowExecution"))
+	s.NoError(faultInjector.Inject("GetShard"))
+}
+
+func (s *faultInjectorSuite) TestInject_Disabled() {
+	enabled := false
+	faultInjector := NewFaultInjector(&config.FaultInjectionConfig{
+		Enabled:    func(...dynamicconfig.FilterOption) bool { return enabled },
+		ErrorRate:  dynamicconfig.GetFloatPropertyFn(1),
+		Latency:    dynamicconfig.GetDurationPropertyFn(0),
+		TargetAPIs: dynamicconfig.GetStringPropertyFn(""),
+	})
+	s.NoError(faultInjector.Inject("GetWorkflowExecution"))
+
+	enabled = true
+	s.Equal(ErrPersistenceFaultInjected, faultInjector.Inject("GetWorkflowExecution"))
+}
+
+func (s *faultInjectorSuite) TestInject_LatencyInterrupted() {
+	faultInjector := NewFaultInjector(&config.FaultInjectionConfig{
+		Enabled:    dynamicconfig.GetBoolPropertyFn(true),
+		ErrorRate:  dynamicconfig.GetFloatPropertyFn(0),
+		Latency:    dynamicconfig.GetDurationPropertyFn(time.Hour),
+		TargetAPIs: dynamicconfig.GetStringPropertyFn(""),
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	s.Equal(ErrPersistenceFaultInjected, faultInjector.InjectWithContext(ctx, "GetWorkflowExecution"))
+
+	faultInjector.Close()
+	s.Equal(ErrPersistenceFaultInjected, faultInjector.Inject("GetWorkflowExecution"))
+}
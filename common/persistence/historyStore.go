@@ -262,7 +262,7 @@ func (m *historyV2ManagerImpl) ReadRawHistoryBranch(
 	request *ReadHistoryBranchRequest,
 ) (*ReadRawHistoryBranchResponse, error) {
 
-	dataBlobs, token, dataSize, _, err := m.readRawHistoryBranch(request)
+	dataBlobs, token, dataSize, _, err := m.readRawHistoryBranch(request, false)
 	if err != nil {
 		return nil, err
 	}
@@ -288,6 +288,7 @@ func (m *historyV2ManagerImpl) GetAllHistoryTreeBranches(
 
 func (m *historyV2ManagerImpl) readRawHistoryBranch(
 	request *ReadHistoryBranchRequest,
+	readFromReplica bool,
 ) ([]*commonpb.DataBlob, *historyV2PagingToken, int, log.Logger, error) {
 
 	branch, err := serialization.HistoryBranchFromBlob(request.BranchToken, enumspb.ENCODING_TYPE_PROTO3.String())
@@ -373,6 +374,7 @@ func (m *historyV2ManagerImpl) readRawHistoryBranch(
 		LastTransactionID: token.LastTransactionID,
 		ShardID:           shardID,
 		PageSize:          pageSize,
+		ReadFromReplica:   readFromReplica,
 	}
 
 	resp, err := m.persistence.ReadHistoryBranch(req)
@@ -405,7 +407,7 @@ func (m *historyV2ManagerImpl) readHistoryBranch(
 	request *ReadHistoryBranchRequest,
 ) ([]*historypb.HistoryEvent, []*historypb.History, []byte, int, int64, int64, error) {
 
-	dataBlobs, token, dataSize, logger, err := m.readRawHistoryBranch(request)
+	dataBlobs, token, dataSize, logger, err := m.readRawHistoryBranch(request, request.ReadFromReplica)
 	if err != nil {
 		return nil, nil, nil, 0, 0, 0, err
 	}
@@ -481,6 +483,14 @@ func (m *historyV2ManagerImpl) readHistoryBranch(
 		return nil, nil, nil, 0, 0, 0, err
 	}
 
+	if request.ReadFromReplica && len(nextPageToken) == 0 && token.LastEventID < request.MaxEventID-1 {
+		// the read replica lags behind the primary database, read the last page again from the primary
+		logger.Debug("Incomplete history branch read from replica", tag.TokenLastEventID(token.LastEventID))
+		primaryRequest := *request
+		primaryRequest.ReadFromReplica = false
+		return m.readHistoryBranch(byBatch, &primaryRequest)
+	}
+
 	return historyEvents, historyEventBatches, nextPageToken, dataSize, lastFirstEventID, token.LastEventID, nil
 }
 
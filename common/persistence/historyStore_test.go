@@ -0,0 +1,143 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"testing"
+
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+
+	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/service/dynamicconfig"
+)
+
+type (
+	historyV2ManagerSuite struct {
+		*require.Assertions
+		suite.Suite
+
+		store   *replicaHistoryStore
+		manager HistoryManager
+	}
+
+	// replicaHistoryStore serves the history nodes of a single branch from a primary and a read replica
+	replicaHistoryStore struct {
+		HistoryStore
+
+		primary  []*commonpb.DataBlob
+		replica  []*commonpb.DataBlob
+		requests []*InternalReadHistoryBranchRequest
+	}
+)
+
+func TestHistoryV2ManagerSuite(t *testing.T) {
+	suite.Run(t, new(historyV2ManagerSuite))
+}
+
+func (s *historyV2ManagerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.store = &replicaHistoryStore{}
+	s.manager = NewHistoryV2ManagerImpl(s.store, log.NewNoop(), dynamicconfig.GetIntPropertyFn(1024*1024))
+}
+
+func (s *historyV2ManagerSuite) TestReadHistoryBranch_ReadFromReplica() {
+	s.store.primary = s.serializeBatches([]int64{1, 2}, []int64{3})
+	s.store.replica = s.store.primary
+
+	resp, err := s.manager.ReadHistoryBranch(s.newReadHistoryBranchRequest(4))
+	s.NoError(err)
+	s.Len(resp.HistoryEvents, 3)
+	s.Len(s.store.requests, 1)
+	s.True(s.store.requests[0].ReadFromReplica)
+}
+
+func (s *historyV2ManagerSuite) TestReadHistoryBranch_ReplicaLagging() {
+	s.store.primary = s.serializeBatches([]int64{1, 2}, []int64{3})
+	s.store.replica = s.store.primary[:1]
+
+	resp, err := s.manager.ReadHistoryBranch(s.newReadHistoryBranchRequest(4))
+	s.NoError(err)
+	s.Len(resp.HistoryEvents, 3)
+	s.Equal(int64(3), resp.HistoryEvents[2].GetEventId())
+	s.Len(s.store.requests, 2)
+	s.True(s.store.requests[0].ReadFromReplica)
+	s.False(s.store.requests[1].ReadFromReplica)
+}
+
+func (s *historyV2ManagerSuite) TestReadRawHistoryBranch_NotReadFromReplica() {
+	s.store.primary = s.serializeBatches([]int64{1, 2}, []int64{3})
+
+	resp, err := s.manager.ReadRawHistoryBranch(s.newReadHistoryBranchRequest(4))
+	s.NoError(err)
+	s.Len(resp.HistoryEventBlobs, 2)
+	s.Len(s.store.requests, 1)
+	s.False(s.store.requests[0].ReadFromReplica)
+}
+
+func (s *historyV2ManagerSuite) newReadHistoryBranchRequest(maxEventID int64) *ReadHistoryBranchRequest {
+	branchToken, err := NewHistoryBranchToken(uuid.New())
+	s.NoError(err)
+	return &ReadHistoryBranchRequest{
+		BranchToken:     branchToken,
+		MinEventID:      1,
+		MaxEventID:      maxEventID,
+		PageSize:        10,
+		ShardID:         convert.Int32Ptr(1),
+		ReadFromReplica: true,
+	}
+}
+
+func (s *historyV2ManagerSuite) serializeBatches(batches ...[]int64) []*commonpb.DataBlob {
+	serializer := NewPayloadSerializer()
+	var blobs []*commonpb.DataBlob
+	for _, eventIDs := range batches {
+		var events []*historypb.HistoryEvent
+		for _, eventID := range eventIDs {
+			events = append(events, &historypb.HistoryEvent{EventId: eventID})
+		}
+		blob, err := serializer.SerializeEvents(events, enumspb.ENCODING_TYPE_PROTO3)
+		s.NoError(err)
+		blobs = append(blobs, blob)
+	}
+	return blobs
+}
+
+func (st *replicaHistoryStore) ReadHistoryBranch(
+	request *InternalReadHistoryBranchRequest,
+) (*InternalReadHistoryBranchResponse, error) {
+
+	st.requests = append(st.requests, request)
+	history := st.primary
+	if request.ReadFromReplica {
+		history = st.replica
+	}
+	return &InternalReadHistoryBranchResponse{History: history}, nil
+}
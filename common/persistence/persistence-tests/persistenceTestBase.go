@@ -725,7 +725,7 @@ func (s *TestBase) UpdateWorkflowExecutionWithReplication(updatedInfo *persisten
 		switch t := task.(type) {
 		case *persistence.WorkflowTask, *persistence.ActivityTask, *persistence.CloseExecutionTask, *persistence.CancelExecutionTask, *persistence.StartChildExecutionTask, *persistence.SignalExecutionTask, *persistence.RecordWorkflowStartedTask:
 			transferTasks = append(transferTasks, t)
-		case *persistence.HistoryReplicationTask, *persistence.SyncActivityTask:
+		case *persistence.HistoryReplicationTask, *persistence.SyncActivityTask, *persistence.SyncWorkflowStateTask:
 			replicationTasks = append(replicationTasks, t)
 		default:
 			panic("Unknown transfer task type.")
@@ -0,0 +1,1007 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	commonpb "go.temporal.io/api/common/v1"
+
+	"go.temporal.io/server/common/log"
+)
+
+type (
+	shardFaultInjectionPersistenceClient struct {
+		faultInjector *FaultInjector
+		persistence   ShardManager
+		logger        log.Logger
+	}
+
+	workflowExecutionFaultInjectionPersistenceClient struct {
+		faultInjector *FaultInjector
+		persistence   ExecutionManager
+		logger        log.Logger
+	}
+
+	taskFaultInjectionPersistenceClient struct {
+		faultInjector *FaultInjector
+		persistence   TaskManager
+		logger        log.Logger
+	}
+
+	historyV2FaultInjectionPersistenceClient struct {
+		faultInjector *FaultInjector
+		persistence   HistoryManager
+		logger        log.Logger
+	}
+
+	metadataFaultInjectionPersistenceClient struct {
+		faultInjector *FaultInjector
+		persistence   MetadataManager
+		logger        log.Logger
+	}
+
+	clusterMetadataFaultInjectionPersistenceClient struct {
+		faultInjector *FaultInjector
+		persistence   ClusterMetadataManager
+		logger        log.Logger
+	}
+
+	visibilityFaultInjectionPersistenceClient struct {
+		faultInjector *FaultInjector
+		persistence   VisibilityManager
+		logger        log.Logger
+	}
+
+	queueFaultInjectionPersistenceClient struct {
+		faultInjector *FaultInjector
+		persistence   Queue
+		logger        log.Logger
+	}
+)
+
+var _ ShardManager = (*shardFaultInjectionPersistenceClient)(nil)
+var _ ExecutionManager = (*workflowExecutionFaultInjectionPersistenceClient)(nil)
+var _ TaskManager = (*taskFaultInjectionPersistenceClient)(nil)
+var _ HistoryManager = (*historyV2FaultInjectionPersistenceClient)(nil)
+var _ MetadataManager = (*metadataFaultInjectionPersistenceClient)(nil)
+var _ ClusterMetadataManager = (*clusterMetadataFaultInjectionPersistenceClient)(nil)
+var _ VisibilityManager = (*visibilityFaultInjectionPersistenceClient)(nil)
+var _ Queue = (*queueFaultInjectionPersistenceClient)(nil)
+
+// NewShardPersistenceFaultInjectionClient creates a client that injects faults into calls to shards
+func NewShardPersistenceFaultInjectionClient(persistence ShardManager, faultInjector *FaultInjector, logger log.Logger) ShardManager {
+	return &shardFaultInjectionPersistenceClient{
+		persistence:   persistence,
+		faultInjector: faultInjector,
+		logger:        logger,
+	}
+}
+
+// NewWorkflowExecutionPersistenceFaultInjectionClient creates a client that injects faults into calls to executions
+func NewWorkflowExecutionPersistenceFaultInjectionClient(persistence ExecutionManager, faultInjector *FaultInjector, logger log.Logger) ExecutionManager {
+	return &workflowExecutionFaultInjectionPersistenceClient{
+		persistence:   persistence,
+		faultInjector: faultInjector,
+		logger:        logger,
+	}
+}
+
+// NewTaskPersistenceFaultInjectionClient creates a client that injects faults into calls to tasks
+func NewTaskPersistenceFaultInjectionClient(persistence TaskManager, faultInjector *FaultInjector, logger log.Logger) TaskManager {
+	return &taskFaultInjectionPersistenceClient{
+		persistence:   persistence,
+		faultInjector: faultInjector,
+		logger:        logger,
+	}
+}
+
+// NewHistoryV2PersistenceFaultInjectionClient creates a HistoryManager client that injects faults into calls to workflow execution history
+func NewHistoryV2PersistenceFaultInjectionClient(persistence HistoryManager, faultInjector *FaultInjector, logger log.Logger) HistoryManager {
+	return &historyV2FaultInjectionPersistenceClient{
+		persistence:   persistence,
+		faultInjector: faultInjector,
+		logger:        logger,
+	}
+}
+
+// NewMetadataPersistenceFaultInjectionClient creates a MetadataManager client that injects faults into calls to metadata
+func NewMetadataPersistenceFaultInjectionClient(persistence MetadataManager, faultInjector *FaultInjector, logger log.Logger) MetadataManager {
+	return &metadataFaultInjectionPersistenceClient{
+		persistence:   persistence,
+		faultInjector: faultInjector,
+		logger:        logger,
+	}
+}
+
+// NewClusterMetadataPersistenceFaultInjectionClient creates a ClusterMetadataManager client that injects faults into calls to cluster metadata
+func NewClusterMetadataPersistenceFaultInjectionClient(persistence ClusterMetadataManager, faultInjector *FaultInjector, logger log.Logger) ClusterMetadataManager {
+	return &clusterMetadataFaultInjectionPersistenceClient{
+		persistence:   persistence,
+		faultInjector: faultInjector,
+		logger:        logger,
+	}
+}
+
+// NewVisibilityPersistenceFaultInjectionClient creates a client that injects faults into calls to visibility
+func NewVisibilityPersistenceFaultInjectionClient(persistence VisibilityManager, faultInjector *FaultInjector, logger log.Logger) VisibilityManager {
+	return &visibilityFaultInjectionPersistenceClient{
+		persistence:   persistence,
+		faultInjector: faultInjector,
+		logger:        logger,
+	}
+}
+
+// NewQueuePersistenceFaultInjectionClient creates a client that injects faults into calls to queue
+func NewQueuePersistenceFaultInjectionClient(persistence Queue, faultInjector *FaultInjector, logger log.Logger) Queue {
+	return &queueFaultInjectionPersistenceClient{
+		persistence:   persistence,
+		faultInjector: faultInjector,
+		logger:        logger,
+	}
+}
+
+func (p *shardFaultInjectionPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *shardFaultInjectionPersistenceClient) CreateShard(request *CreateShardRequest) error {
+	if err := p.faultInjector.Inject("CreateShard"); err != nil {
+		return err
+	}
+
+	err := p.persistence.CreateShard(request)
+	return err
+}
+
+func (p *shardFaultInjectionPersistenceClient) GetShard(request *GetShardRequest) (*GetShardResponse, error) {
+	if err := p.faultInjector.Inject("GetShard"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetShard(request)
+	return response, err
+}
+
+func (p *shardFaultInjectionPersistenceClient) UpdateShard(request *UpdateShardRequest) error {
+	if err := p.faultInjector.Inject("UpdateShard"); err != nil {
+		return err
+	}
+
+	err := p.persistence.UpdateShard(request)
+	return err
+}
+
+func (p *shardFaultInjectionPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) GetShardID() int32 {
+	return p.persistence.GetShardID()
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) CreateWorkflowExecution(request *CreateWorkflowExecutionRequest) (*CreateWorkflowExecutionResponse, error) {
+	if err := p.faultInjector.Inject("CreateWorkflowExecution"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.CreateWorkflowExecution(request)
+	return response, err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) GetWorkflowExecution(request *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error) {
+	if err := p.faultInjector.Inject("GetWorkflowExecution"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetWorkflowExecution(request)
+	return response, err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) UpdateWorkflowExecution(request *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error) {
+	if err := p.faultInjector.Inject("UpdateWorkflowExecution"); err != nil {
+		return nil, err
+	}
+
+	resp, err := p.persistence.UpdateWorkflowExecution(request)
+	return resp, err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) ConflictResolveWorkflowExecution(request *ConflictResolveWorkflowExecutionRequest) error {
+	if err := p.faultInjector.Inject("ConflictResolveWorkflowExecution"); err != nil {
+		return err
+	}
+
+	err := p.persistence.ConflictResolveWorkflowExecution(request)
+	return err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) DeleteWorkflowExecution(request *DeleteWorkflowExecutionRequest) error {
+	if err := p.faultInjector.Inject("DeleteWorkflowExecution"); err != nil {
+		return err
+	}
+
+	err := p.persistence.DeleteWorkflowExecution(request)
+	return err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) DeleteCurrentWorkflowExecution(request *DeleteCurrentWorkflowExecutionRequest) error {
+	if err := p.faultInjector.Inject("DeleteCurrentWorkflowExecution"); err != nil {
+		return err
+	}
+
+	err := p.persistence.DeleteCurrentWorkflowExecution(request)
+	return err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) GetCurrentExecution(request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error) {
+	if err := p.faultInjector.Inject("GetCurrentExecution"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetCurrentExecution(request)
+	return response, err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) ListConcreteExecutions(request *ListConcreteExecutionsRequest) (*ListConcreteExecutionsResponse, error) {
+	if err := p.faultInjector.Inject("ListConcreteExecutions"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ListConcreteExecutions(request)
+	return response, err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) AddTasks(request *AddTasksRequest) error {
+	if err := p.faultInjector.Inject("AddTasks"); err != nil {
+		return err
+	}
+
+	err := p.persistence.AddTasks(request)
+	return err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) GetTransferTask(request *GetTransferTaskRequest) (*GetTransferTaskResponse, error) {
+	if err := p.faultInjector.Inject("GetTransferTask"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetTransferTask(request)
+	return response, err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error) {
+	if err := p.faultInjector.Inject("GetTransferTasks"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetTransferTasks(request)
+	return response, err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) GetVisibilityTask(request *GetVisibilityTaskRequest) (*GetVisibilityTaskResponse, error) {
+	if err := p.faultInjector.Inject("GetVisibilityTask"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetVisibilityTask(request)
+	return response, err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) GetVisibilityTasks(request *GetVisibilityTasksRequest) (*GetVisibilityTasksResponse, error) {
+	if err := p.faultInjector.Inject("GetVisibilityTasks"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetVisibilityTasks(request)
+	return response, err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) GetReplicationTask(request *GetReplicationTaskRequest) (*GetReplicationTaskResponse, error) {
+	if err := p.faultInjector.Inject("GetReplicationTask"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetReplicationTask(request)
+	return response, err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) GetReplicationTasks(request *GetReplicationTasksRequest) (*GetReplicationTasksResponse, error) {
+	if err := p.faultInjector.Inject("GetReplicationTasks"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetReplicationTasks(request)
+	return response, err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) CompleteTransferTask(request *CompleteTransferTaskRequest) error {
+	if err := p.faultInjector.Inject("CompleteTransferTask"); err != nil {
+		return err
+	}
+
+	err := p.persistence.CompleteTransferTask(request)
+	return err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) RangeCompleteTransferTask(request *RangeCompleteTransferTaskRequest) error {
+	if err := p.faultInjector.Inject("RangeCompleteTransferTask"); err != nil {
+		return err
+	}
+
+	err := p.persistence.RangeCompleteTransferTask(request)
+	return err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) CompleteVisibilityTask(request *CompleteVisibilityTaskRequest) error {
+	if err := p.faultInjector.Inject("CompleteVisibilityTask"); err != nil {
+		return err
+	}
+
+	err := p.persistence.CompleteVisibilityTask(request)
+	return err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) RangeCompleteVisibilityTask(request *RangeCompleteVisibilityTaskRequest) error {
+	if err := p.faultInjector.Inject("RangeCompleteVisibilityTask"); err != nil {
+		return err
+	}
+
+	err := p.persistence.RangeCompleteVisibilityTask(request)
+	return err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) CompleteReplicationTask(request *CompleteReplicationTaskRequest) error {
+	if err := p.faultInjector.Inject("CompleteReplicationTask"); err != nil {
+		return err
+	}
+
+	err := p.persistence.CompleteReplicationTask(request)
+	return err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) RangeCompleteReplicationTask(request *RangeCompleteReplicationTaskRequest) error {
+	if err := p.faultInjector.Inject("RangeCompleteReplicationTask"); err != nil {
+		return err
+	}
+
+	err := p.persistence.RangeCompleteReplicationTask(request)
+	return err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) PutReplicationTaskToDLQ(
+	request *PutReplicationTaskToDLQRequest,
+) error {
+	if err := p.faultInjector.Inject("PutReplicationTaskToDLQ"); err != nil {
+		return err
+	}
+
+	return p.persistence.PutReplicationTaskToDLQ(request)
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) GetReplicationTasksFromDLQ(
+	request *GetReplicationTasksFromDLQRequest,
+) (*GetReplicationTasksFromDLQResponse, error) {
+	if err := p.faultInjector.Inject("GetReplicationTasksFromDLQ"); err != nil {
+		return nil, err
+	}
+
+	return p.persistence.GetReplicationTasksFromDLQ(request)
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) DeleteReplicationTaskFromDLQ(
+	request *DeleteReplicationTaskFromDLQRequest,
+) error {
+	if err := p.faultInjector.Inject("DeleteReplicationTaskFromDLQ"); err != nil {
+		return err
+	}
+
+	return p.persistence.DeleteReplicationTaskFromDLQ(request)
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) RangeDeleteReplicationTaskFromDLQ(
+	request *RangeDeleteReplicationTaskFromDLQRequest,
+) error {
+	if err := p.faultInjector.Inject("RangeDeleteReplicationTaskFromDLQ"); err != nil {
+		return err
+	}
+
+	return p.persistence.RangeDeleteReplicationTaskFromDLQ(request)
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) GetTimerTask(request *GetTimerTaskRequest) (*GetTimerTaskResponse, error) {
+	if err := p.faultInjector.Inject("GetTimerTask"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetTimerTask(request)
+	return response, err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) GetTimerIndexTasks(request *GetTimerIndexTasksRequest) (*GetTimerIndexTasksResponse, error) {
+	if err := p.faultInjector.Inject("GetTimerIndexTasks"); err != nil {
+		return nil, err
+	}
+
+	resonse, err := p.persistence.GetTimerIndexTasks(request)
+	return resonse, err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) CompleteTimerTask(request *CompleteTimerTaskRequest) error {
+	if err := p.faultInjector.Inject("CompleteTimerTask"); err != nil {
+		return err
+	}
+
+	err := p.persistence.CompleteTimerTask(request)
+	return err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) RangeCompleteTimerTask(request *RangeCompleteTimerTaskRequest) error {
+	if err := p.faultInjector.Inject("RangeCompleteTimerTask"); err != nil {
+		return err
+	}
+
+	err := p.persistence.RangeCompleteTimerTask(request)
+	return err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *taskFaultInjectionPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *taskFaultInjectionPersistenceClient) CreateTasks(request *CreateTasksRequest) (*CreateTasksResponse, error) {
+	if err := p.faultInjector.Inject("CreateTasks"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.CreateTasks(request)
+	return response, err
+}
+
+func (p *taskFaultInjectionPersistenceClient) GetTasks(request *GetTasksRequest) (*GetTasksResponse, error) {
+	if err := p.faultInjector.Inject("GetTasks"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetTasks(request)
+	return response, err
+}
+
+func (p *taskFaultInjectionPersistenceClient) CompleteTask(request *CompleteTaskRequest) error {
+	if err := p.faultInjector.Inject("CompleteTask"); err != nil {
+		return err
+	}
+
+	err := p.persistence.CompleteTask(request)
+	return err
+}
+
+func (p *taskFaultInjectionPersistenceClient) CompleteTasksLessThan(request *CompleteTasksLessThanRequest) (int, error) {
+	if err := p.faultInjector.Inject("CompleteTasksLessThan"); err != nil {
+		return 0, err
+	}
+	return p.persistence.CompleteTasksLessThan(request)
+}
+
+func (p *taskFaultInjectionPersistenceClient) LeaseTaskQueue(request *LeaseTaskQueueRequest) (*LeaseTaskQueueResponse, error) {
+	if err := p.faultInjector.Inject("LeaseTaskQueue"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.LeaseTaskQueue(request)
+	return response, err
+}
+
+func (p *taskFaultInjectionPersistenceClient) UpdateTaskQueue(request *UpdateTaskQueueRequest) (*UpdateTaskQueueResponse, error) {
+	if err := p.faultInjector.Inject("UpdateTaskQueue"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.UpdateTaskQueue(request)
+	return response, err
+}
+
+func (p *taskFaultInjectionPersistenceClient) ListTaskQueue(request *ListTaskQueueRequest) (*ListTaskQueueResponse, error) {
+	if err := p.faultInjector.Inject("ListTaskQueue"); err != nil {
+		return nil, err
+	}
+	return p.persistence.ListTaskQueue(request)
+}
+
+func (p *taskFaultInjectionPersistenceClient) DeleteTaskQueue(request *DeleteTaskQueueRequest) error {
+	if err := p.faultInjector.Inject("DeleteTaskQueue"); err != nil {
+		return err
+	}
+	return p.persistence.DeleteTaskQueue(request)
+}
+
+func (p *taskFaultInjectionPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *metadataFaultInjectionPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *metadataFaultInjectionPersistenceClient) CreateNamespace(request *CreateNamespaceRequest) (*CreateNamespaceResponse, error) {
+	if err := p.faultInjector.Inject("CreateNamespace"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.CreateNamespace(request)
+	return response, err
+}
+
+func (p *metadataFaultInjectionPersistenceClient) GetNamespace(request *GetNamespaceRequest) (*GetNamespaceResponse, error) {
+	if err := p.faultInjector.Inject("GetNamespace"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetNamespace(request)
+	return response, err
+}
+
+func (p *metadataFaultInjectionPersistenceClient) UpdateNamespace(request *UpdateNamespaceRequest) error {
+	if err := p.faultInjector.Inject("UpdateNamespace"); err != nil {
+		return err
+	}
+
+	err := p.persistence.UpdateNamespace(request)
+	return err
+}
+
+func (p *metadataFaultInjectionPersistenceClient) DeleteNamespace(request *DeleteNamespaceRequest) error {
+	if err := p.faultInjector.Inject("DeleteNamespace"); err != nil {
+		return err
+	}
+
+	err := p.persistence.DeleteNamespace(request)
+	return err
+}
+
+func (p *metadataFaultInjectionPersistenceClient) DeleteNamespaceByName(request *DeleteNamespaceByNameRequest) error {
+	if err := p.faultInjector.Inject("DeleteNamespaceByName"); err != nil {
+		return err
+	}
+
+	err := p.persistence.DeleteNamespaceByName(request)
+	return err
+}
+
+func (p *metadataFaultInjectionPersistenceClient) ListNamespaces(request *ListNamespacesRequest) (*ListNamespacesResponse, error) {
+	if err := p.faultInjector.Inject("ListNamespaces"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ListNamespaces(request)
+	return response, err
+}
+
+func (p *metadataFaultInjectionPersistenceClient) GetMetadata() (*GetMetadataResponse, error) {
+	if err := p.faultInjector.Inject("GetMetadata"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetMetadata()
+	return response, err
+}
+
+func (p *metadataFaultInjectionPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) RecordWorkflowExecutionStarted(request *RecordWorkflowExecutionStartedRequest) error {
+	if err := p.faultInjector.Inject("RecordWorkflowExecutionStarted"); err != nil {
+		return err
+	}
+
+	err := p.persistence.RecordWorkflowExecutionStarted(request)
+	return err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) RecordWorkflowExecutionStartedV2(request *RecordWorkflowExecutionStartedRequest) error {
+	if err := p.faultInjector.Inject("RecordWorkflowExecutionStartedV2"); err != nil {
+		return err
+	}
+
+	err := p.persistence.RecordWorkflowExecutionStartedV2(request)
+	return err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) RecordWorkflowExecutionClosed(request *RecordWorkflowExecutionClosedRequest) error {
+	if err := p.faultInjector.Inject("RecordWorkflowExecutionClosed"); err != nil {
+		return err
+	}
+
+	err := p.persistence.RecordWorkflowExecutionClosed(request)
+	return err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) RecordWorkflowExecutionClosedV2(request *RecordWorkflowExecutionClosedRequest) error {
+	if err := p.faultInjector.Inject("RecordWorkflowExecutionClosedV2"); err != nil {
+		return err
+	}
+
+	err := p.persistence.RecordWorkflowExecutionClosedV2(request)
+	return err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) UpsertWorkflowExecution(request *UpsertWorkflowExecutionRequest) error {
+	if err := p.faultInjector.Inject("UpsertWorkflowExecution"); err != nil {
+		return err
+	}
+
+	err := p.persistence.UpsertWorkflowExecution(request)
+	return err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) UpsertWorkflowExecutionV2(request *UpsertWorkflowExecutionRequest) error {
+	if err := p.faultInjector.Inject("UpsertWorkflowExecutionV2"); err != nil {
+		return err
+	}
+
+	err := p.persistence.UpsertWorkflowExecutionV2(request)
+	return err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ListOpenWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	if err := p.faultInjector.Inject("ListOpenWorkflowExecutions"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ListOpenWorkflowExecutions(request)
+	return response, err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ListClosedWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	if err := p.faultInjector.Inject("ListClosedWorkflowExecutions"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ListClosedWorkflowExecutions(request)
+	return response, err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ListOpenWorkflowExecutionsByType(request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+	if err := p.faultInjector.Inject("ListOpenWorkflowExecutionsByType"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ListOpenWorkflowExecutionsByType(request)
+	return response, err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ListClosedWorkflowExecutionsByType(request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+	if err := p.faultInjector.Inject("ListClosedWorkflowExecutionsByType"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ListClosedWorkflowExecutionsByType(request)
+	return response, err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ListOpenWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+	if err := p.faultInjector.Inject("ListOpenWorkflowExecutionsByWorkflowID"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ListOpenWorkflowExecutionsByWorkflowID(request)
+	return response, err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ListClosedWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+	if err := p.faultInjector.Inject("ListClosedWorkflowExecutionsByWorkflowID"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ListClosedWorkflowExecutionsByWorkflowID(request)
+	return response, err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ListClosedWorkflowExecutionsByStatus(request *ListClosedWorkflowExecutionsByStatusRequest) (*ListWorkflowExecutionsResponse, error) {
+	if err := p.faultInjector.Inject("ListClosedWorkflowExecutionsByStatus"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ListClosedWorkflowExecutionsByStatus(request)
+	return response, err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) GetClosedWorkflowExecution(request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error) {
+	if err := p.faultInjector.Inject("GetClosedWorkflowExecution"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetClosedWorkflowExecution(request)
+	return response, err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) DeleteWorkflowExecution(request *VisibilityDeleteWorkflowExecutionRequest) error {
+	if err := p.faultInjector.Inject("DeleteWorkflowExecution"); err != nil {
+		return err
+	}
+	return p.persistence.DeleteWorkflowExecution(request)
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) DeleteWorkflowExecutionV2(request *VisibilityDeleteWorkflowExecutionRequest) error {
+	if err := p.faultInjector.Inject("DeleteWorkflowExecutionV2"); err != nil {
+		return err
+	}
+	return p.persistence.DeleteWorkflowExecutionV2(request)
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ListWorkflowExecutions(request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error) {
+	if err := p.faultInjector.Inject("ListWorkflowExecutions"); err != nil {
+		return nil, err
+	}
+	return p.persistence.ListWorkflowExecutions(request)
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ScanWorkflowExecutions(request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error) {
+	if err := p.faultInjector.Inject("ScanWorkflowExecutions"); err != nil {
+		return nil, err
+	}
+	return p.persistence.ScanWorkflowExecutions(request)
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) CountWorkflowExecutions(request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
+	if err := p.faultInjector.Inject("CountWorkflowExecutions"); err != nil {
+		return nil, err
+	}
+	return p.persistence.CountWorkflowExecutions(request)
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *historyV2FaultInjectionPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *historyV2FaultInjectionPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+// AppendHistoryNodes add(or override) a node to a history branch
+func (p *historyV2FaultInjectionPersistenceClient) AppendHistoryNodes(request *AppendHistoryNodesRequest) (*AppendHistoryNodesResponse, error) {
+	if err := p.faultInjector.Inject("AppendHistoryNodes"); err != nil {
+		return nil, err
+	}
+	return p.persistence.AppendHistoryNodes(request)
+}
+
+// ReadHistoryBranch returns history node data for a branch
+func (p *historyV2FaultInjectionPersistenceClient) ReadHistoryBranch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchResponse, error) {
+	if err := p.faultInjector.Inject("ReadHistoryBranch"); err != nil {
+		return nil, err
+	}
+	response, err := p.persistence.ReadHistoryBranch(request)
+	return response, err
+}
+
+// ReadHistoryBranchByBatch returns history node data for a branch
+func (p *historyV2FaultInjectionPersistenceClient) ReadHistoryBranchByBatch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchByBatchResponse, error) {
+	if err := p.faultInjector.Inject("ReadHistoryBranchByBatch"); err != nil {
+		return nil, err
+	}
+	response, err := p.persistence.ReadHistoryBranchByBatch(request)
+	return response, err
+}
+
+// ReadHistoryBranchByBatch returns history node data for a branch
+func (p *historyV2FaultInjectionPersistenceClient) ReadRawHistoryBranch(request *ReadHistoryBranchRequest) (*ReadRawHistoryBranchResponse, error) {
+	if err := p.faultInjector.Inject("ReadRawHistoryBranch"); err != nil {
+		return nil, err
+	}
+	response, err := p.persistence.ReadRawHistoryBranch(request)
+	return response, err
+}
+
+// ForkHistoryBranch forks a new branch from a old branch
+func (p *historyV2FaultInjectionPersistenceClient) ForkHistoryBranch(request *ForkHistoryBranchRequest) (*ForkHistoryBranchResponse, error) {
+	if err := p.faultInjector.Inject("ForkHistoryBranch"); err != nil {
+		return nil, err
+	}
+	response, err := p.persistence.ForkHistoryBranch(request)
+	return response, err
+}
+
+// DeleteHistoryBranch removes a branch
+func (p *historyV2FaultInjectionPersistenceClient) DeleteHistoryBranch(request *DeleteHistoryBranchRequest) error {
+	if err := p.faultInjector.Inject("DeleteHistoryBranch"); err != nil {
+		return err
+	}
+	err := p.persistence.DeleteHistoryBranch(request)
+	return err
+}
+
+// GetHistoryTree returns all branch information of a tree
+func (p *historyV2FaultInjectionPersistenceClient) GetHistoryTree(request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error) {
+	if err := p.faultInjector.Inject("GetHistoryTree"); err != nil {
+		return nil, err
+	}
+	response, err := p.persistence.GetHistoryTree(request)
+	return response, err
+}
+
+func (p *historyV2FaultInjectionPersistenceClient) GetAllHistoryTreeBranches(request *GetAllHistoryTreeBranchesRequest) (*GetAllHistoryTreeBranchesResponse, error) {
+	if err := p.faultInjector.Inject("GetAllHistoryTreeBranches"); err != nil {
+		return nil, err
+	}
+	response, err := p.persistence.GetAllHistoryTreeBranches(request)
+	return response, err
+}
+
+func (p *queueFaultInjectionPersistenceClient) EnqueueMessage(blob commonpb.DataBlob) error {
+	if err := p.faultInjector.Inject("EnqueueMessage"); err != nil {
+		return err
+	}
+
+	return p.persistence.EnqueueMessage(blob)
+}
+
+func (p *queueFaultInjectionPersistenceClient) ReadMessages(lastMessageID int64, maxCount int) ([]*QueueMessage, error) {
+	if err := p.faultInjector.Inject("ReadMessages"); err != nil {
+		return nil, err
+	}
+
+	return p.persistence.ReadMessages(lastMessageID, maxCount)
+}
+
+func (p *queueFaultInjectionPersistenceClient) UpdateAckLevel(messageID int64, clusterName string) error {
+	if err := p.faultInjector.Inject("UpdateAckLevel"); err != nil {
+		return err
+	}
+
+	return p.persistence.UpdateAckLevel(messageID, clusterName)
+}
+
+func (p *queueFaultInjectionPersistenceClient) GetAckLevels() (map[string]int64, error) {
+	if err := p.faultInjector.Inject("GetAckLevels"); err != nil {
+		return nil, err
+	}
+
+	return p.persistence.GetAckLevels()
+}
+
+func (p *queueFaultInjectionPersistenceClient) DeleteMessagesBefore(messageID int64) error {
+	if err := p.faultInjector.Inject("DeleteMessagesBefore"); err != nil {
+		return err
+	}
+
+	return p.persistence.DeleteMessagesBefore(messageID)
+}
+
+func (p *queueFaultInjectionPersistenceClient) EnqueueMessageToDLQ(blob commonpb.DataBlob) (int64, error) {
+	if err := p.faultInjector.Inject("EnqueueMessageToDLQ"); err != nil {
+		return EmptyQueueMessageID, err
+	}
+
+	return p.persistence.EnqueueMessageToDLQ(blob)
+}
+
+func (p *queueFaultInjectionPersistenceClient) ReadMessagesFromDLQ(firstMessageID int64, lastMessageID int64, pageSize int, pageToken []byte) ([]*QueueMessage, []byte, error) {
+	if err := p.faultInjector.Inject("ReadMessagesFromDLQ"); err != nil {
+		return nil, nil, err
+	}
+
+	return p.persistence.ReadMessagesFromDLQ(firstMessageID, lastMessageID, pageSize, pageToken)
+}
+
+func (p *queueFaultInjectionPersistenceClient) RangeDeleteMessagesFromDLQ(firstMessageID int64, lastMessageID int64) error {
+	if err := p.faultInjector.Inject("RangeDeleteMessagesFromDLQ"); err != nil {
+		return err
+	}
+
+	return p.persistence.RangeDeleteMessagesFromDLQ(firstMessageID, lastMessageID)
+}
+func (p *queueFaultInjectionPersistenceClient) UpdateDLQAckLevel(messageID int64, clusterName string) error {
+	if err := p.faultInjector.Inject("UpdateDLQAckLevel"); err != nil {
+		return err
+	}
+
+	return p.persistence.UpdateDLQAckLevel(messageID, clusterName)
+}
+
+func (p *queueFaultInjectionPersistenceClient) GetDLQAckLevels() (map[string]int64, error) {
+	if err := p.faultInjector.Inject("GetDLQAckLevels"); err != nil {
+		return nil, err
+	}
+
+	return p.persistence.GetDLQAckLevels()
+}
+
+func (p *queueFaultInjectionPersistenceClient) DeleteMessageFromDLQ(messageID int64) error {
+	if err := p.faultInjector.Inject("DeleteMessageFromDLQ"); err != nil {
+		return err
+	}
+
+	return p.persistence.DeleteMessageFromDLQ(messageID)
+}
+
+func (p *queueFaultInjectionPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (c *clusterMetadataFaultInjectionPersistenceClient) Close() {
+	c.persistence.Close()
+}
+
+func (c *clusterMetadataFaultInjectionPersistenceClient) GetName() string {
+	return c.persistence.GetName()
+}
+
+func (c *clusterMetadataFaultInjectionPersistenceClient) GetClusterMembers(request *GetClusterMembersRequest) (*GetClusterMembersResponse, error) {
+	if err := c.faultInjector.Inject("GetClusterMembers"); err != nil {
+		return nil, err
+	}
+	return c.persistence.GetClusterMembers(request)
+}
+
+func (c *clusterMetadataFaultInjectionPersistenceClient) UpsertClusterMembership(request *UpsertClusterMembershipRequest) error {
+	if err := c.faultInjector.Inject("UpsertClusterMembership"); err != nil {
+		return err
+	}
+	return c.persistence.UpsertClusterMembership(request)
+}
+
+func (c *clusterMetadataFaultInjectionPersistenceClient) PruneClusterMembership(request *PruneClusterMembershipRequest) error {
+	if err := c.faultInjector.Inject("PruneClusterMembership"); err != nil {
+		return err
+	}
+	return c.persistence.PruneClusterMembership(request)
+}
+
+func (c *clusterMetadataFaultInjectionPersistenceClient) GetClusterMetadata() (*GetClusterMetadataResponse, error) {
+	if err := c.faultInjector.Inject("GetClusterMetadata"); err != nil {
+		return nil, err
+	}
+	return c.persistence.GetClusterMetadata()
+}
+
+func (c *clusterMetadataFaultInjectionPersistenceClient) SaveClusterMetadata(request *SaveClusterMetadataRequest) (bool, error) {
+	if err := c.faultInjector.Inject("SaveClusterMetadata"); err != nil {
+		return false, err
+	}
+	return c.persistence.SaveClusterMetadata(request)
+}
+
+func (c *metadataFaultInjectionPersistenceClient) InitializeSystemNamespaces(currentClusterName string) error {
+	if err := c.faultInjector.Inject("InitializeSystemNamespaces"); err != nil {
+		return err
+	}
+	return c.persistence.InitializeSystemNamespaces(currentClusterName)
+}
@@ -410,6 +410,8 @@ type (
 		LastTransactionID int64
 		// Used in sharded data stores to identify which shard to use
 		ShardID int32
+		// ReadFromReplica allows the nodes to be read from a read replica, if the store has one
+		ReadFromReplica bool
 	}
 
 	// InternalCompleteForkBranchRequest is used to update some tree/branch meta data for forking
@@ -25,6 +25,9 @@
 package persistence
 
 import (
+	"context"
+	"errors"
+
 	commonpb "go.temporal.io/api/common/v1"
 	"go.temporal.io/api/serviceerror"
 
@@ -33,6 +36,29 @@ import (
 	"go.temporal.io/server/common/metrics"
 )
 
+const (
+	tableShard           = "shards"
+	tableExecution       = "executions"
+	tableTask            = "tasks"
+	tableHistory         = "history"
+	tableNamespace       = "namespaces"
+	tableClusterMetadata = "cluster_metadata"
+	tableVisibility      = "visibility"
+	tableQueue           = "queue"
+)
+
+const (
+	errorClassTimeout            = "timeout"
+	errorClassUnavailable        = "unavailable"
+	errorClassThrottled          = "throttled"
+	errorClassConditionFailed    = "condition_failed"
+	errorClassShardOwnershipLost = "shard_ownership_lost"
+	errorClassNotFound           = "not_found"
+	errorClassAlreadyExists      = "already_exists"
+	errorClassBadRequest         = "bad_request"
+	errorClassInternal           = "internal"
+)
+
 type (
 	shardPersistenceClient struct {
 		metricClient metrics.Client
@@ -171,7 +197,7 @@ func (p *shardPersistenceClient) GetName() string {
 func (p *shardPersistenceClient) CreateShard(request *CreateShardRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceCreateShardScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceCreateShardScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceCreateShardScope, tableShard)
 	err := p.persistence.CreateShard(request)
 	sw.Stop()
 
@@ -186,7 +212,7 @@ func (p *shardPersistenceClient) GetShard(
 	request *GetShardRequest) (*GetShardResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetShardScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceGetShardScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceGetShardScope, tableShard)
 	response, err := p.persistence.GetShard(request)
 	sw.Stop()
 
@@ -200,7 +226,7 @@ func (p *shardPersistenceClient) GetShard(
 func (p *shardPersistenceClient) UpdateShard(request *UpdateShardRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceUpdateShardScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceUpdateShardScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceUpdateShardScope, tableShard)
 	err := p.persistence.UpdateShard(request)
 	sw.Stop()
 
@@ -212,6 +238,8 @@ func (p *shardPersistenceClient) UpdateShard(request *UpdateShardRequest) error
 }
 
 func (p *shardPersistenceClient) updateErrorMetric(scope int, err error) {
+	updateErrorClassMetric(p.metricClient, scope, tableShard, err)
+
 	switch err.(type) {
 	case *ShardAlreadyExistError:
 		p.metricClient.IncCounter(scope, metrics.PersistenceErrShardExistsCounter)
@@ -243,7 +271,7 @@ func (p *workflowExecutionPersistenceClient) GetShardID() int32 {
 func (p *workflowExecutionPersistenceClient) CreateWorkflowExecution(request *CreateWorkflowExecutionRequest) (*CreateWorkflowExecutionResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceCreateWorkflowExecutionScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceCreateWorkflowExecutionScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceCreateWorkflowExecutionScope, tableExecution)
 	response, err := p.persistence.CreateWorkflowExecution(request)
 	sw.Stop()
 
@@ -257,7 +285,7 @@ func (p *workflowExecutionPersistenceClient) CreateWorkflowExecution(request *Cr
 func (p *workflowExecutionPersistenceClient) GetWorkflowExecution(request *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetWorkflowExecutionScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceGetWorkflowExecutionScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceGetWorkflowExecutionScope, tableExecution)
 	response, err := p.persistence.GetWorkflowExecution(request)
 	sw.Stop()
 
@@ -271,7 +299,7 @@ func (p *workflowExecutionPersistenceClient) GetWorkflowExecution(request *GetWo
 func (p *workflowExecutionPersistenceClient) UpdateWorkflowExecution(request *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceUpdateWorkflowExecutionScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceUpdateWorkflowExecutionScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceUpdateWorkflowExecutionScope, tableExecution)
 	resp, err := p.persistence.UpdateWorkflowExecution(request)
 	sw.Stop()
 
@@ -285,7 +313,7 @@ func (p *workflowExecutionPersistenceClient) UpdateWorkflowExecution(request *Up
 func (p *workflowExecutionPersistenceClient) ConflictResolveWorkflowExecution(request *ConflictResolveWorkflowExecutionRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceConflictResolveWorkflowExecutionScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceConflictResolveWorkflowExecutionScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceConflictResolveWorkflowExecutionScope, tableExecution)
 	err := p.persistence.ConflictResolveWorkflowExecution(request)
 	sw.Stop()
 
@@ -299,7 +327,7 @@ func (p *workflowExecutionPersistenceClient) ConflictResolveWorkflowExecution(re
 func (p *workflowExecutionPersistenceClient) DeleteWorkflowExecution(request *DeleteWorkflowExecutionRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceDeleteWorkflowExecutionScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceDeleteWorkflowExecutionScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceDeleteWorkflowExecutionScope, tableExecution)
 	err := p.persistence.DeleteWorkflowExecution(request)
 	sw.Stop()
 
@@ -313,7 +341,7 @@ func (p *workflowExecutionPersistenceClient) DeleteWorkflowExecution(request *De
 func (p *workflowExecutionPersistenceClient) DeleteCurrentWorkflowExecution(request *DeleteCurrentWorkflowExecutionRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceDeleteCurrentWorkflowExecutionScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceDeleteCurrentWorkflowExecutionScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceDeleteCurrentWorkflowExecutionScope, tableExecution)
 	err := p.persistence.DeleteCurrentWorkflowExecution(request)
 	sw.Stop()
 
@@ -327,7 +355,7 @@ func (p *workflowExecutionPersistenceClient) DeleteCurrentWorkflowExecution(requ
 func (p *workflowExecutionPersistenceClient) GetCurrentExecution(request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetCurrentExecutionScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceGetCurrentExecutionScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceGetCurrentExecutionScope, tableExecution)
 	response, err := p.persistence.GetCurrentExecution(request)
 	sw.Stop()
 
@@ -341,7 +369,7 @@ func (p *workflowExecutionPersistenceClient) GetCurrentExecution(request *GetCur
 func (p *workflowExecutionPersistenceClient) ListConcreteExecutions(request *ListConcreteExecutionsRequest) (*ListConcreteExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceListConcreteExecutionsScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceListConcreteExecutionsScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceListConcreteExecutionsScope, tableExecution)
 	response, err := p.persistence.ListConcreteExecutions(request)
 	sw.Stop()
 
@@ -355,7 +383,7 @@ func (p *workflowExecutionPersistenceClient) ListConcreteExecutions(request *Lis
 func (p *workflowExecutionPersistenceClient) AddTasks(request *AddTasksRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceAddTasksScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceAddTasksScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceAddTasksScope, tableExecution)
 	err := p.persistence.AddTasks(request)
 	sw.Stop()
 
@@ -369,7 +397,7 @@ func (p *workflowExecutionPersistenceClient) AddTasks(request *AddTasksRequest)
 func (p *workflowExecutionPersistenceClient) GetTransferTask(request *GetTransferTaskRequest) (*GetTransferTaskResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetTransferTaskScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceGetTransferTaskScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceGetTransferTaskScope, tableExecution)
 	response, err := p.persistence.GetTransferTask(request)
 	sw.Stop()
 
@@ -383,7 +411,7 @@ func (p *workflowExecutionPersistenceClient) GetTransferTask(request *GetTransfe
 func (p *workflowExecutionPersistenceClient) GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetTransferTasksScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceGetTransferTasksScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceGetTransferTasksScope, tableExecution)
 	response, err := p.persistence.GetTransferTasks(request)
 	sw.Stop()
 
@@ -397,7 +425,7 @@ func (p *workflowExecutionPersistenceClient) GetTransferTasks(request *GetTransf
 func (p *workflowExecutionPersistenceClient) GetVisibilityTask(request *GetVisibilityTaskRequest) (*GetVisibilityTaskResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetVisibilityTaskScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceGetVisibilityTaskScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceGetVisibilityTaskScope, tableExecution)
 	response, err := p.persistence.GetVisibilityTask(request)
 	sw.Stop()
 
@@ -411,7 +439,7 @@ func (p *workflowExecutionPersistenceClient) GetVisibilityTask(request *GetVisib
 func (p *workflowExecutionPersistenceClient) GetVisibilityTasks(request *GetVisibilityTasksRequest) (*GetVisibilityTasksResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetVisibilityTasksScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceGetVisibilityTasksScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceGetVisibilityTasksScope, tableExecution)
 	response, err := p.persistence.GetVisibilityTasks(request)
 	sw.Stop()
 
@@ -425,7 +453,7 @@ func (p *workflowExecutionPersistenceClient) GetVisibilityTasks(request *GetVisi
 func (p *workflowExecutionPersistenceClient) GetReplicationTask(request *GetReplicationTaskRequest) (*GetReplicationTaskResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetReplicationTaskScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceGetReplicationTaskScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceGetReplicationTaskScope, tableExecution)
 	response, err := p.persistence.GetReplicationTask(request)
 	sw.Stop()
 
@@ -439,7 +467,7 @@ func (p *workflowExecutionPersistenceClient) GetReplicationTask(request *GetRepl
 func (p *workflowExecutionPersistenceClient) GetReplicationTasks(request *GetReplicationTasksRequest) (*GetReplicationTasksResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetReplicationTasksScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceGetReplicationTasksScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceGetReplicationTasksScope, tableExecution)
 	response, err := p.persistence.GetReplicationTasks(request)
 	sw.Stop()
 
@@ -453,7 +481,7 @@ func (p *workflowExecutionPersistenceClient) GetReplicationTasks(request *GetRep
 func (p *workflowExecutionPersistenceClient) CompleteTransferTask(request *CompleteTransferTaskRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceCompleteTransferTaskScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceCompleteTransferTaskScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceCompleteTransferTaskScope, tableExecution)
 	err := p.persistence.CompleteTransferTask(request)
 	sw.Stop()
 
@@ -467,7 +495,7 @@ func (p *workflowExecutionPersistenceClient) CompleteTransferTask(request *Compl
 func (p *workflowExecutionPersistenceClient) RangeCompleteTransferTask(request *RangeCompleteTransferTaskRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceRangeCompleteTransferTaskScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceRangeCompleteTransferTaskScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceRangeCompleteTransferTaskScope, tableExecution)
 	err := p.persistence.RangeCompleteTransferTask(request)
 	sw.Stop()
 
@@ -481,7 +509,7 @@ func (p *workflowExecutionPersistenceClient) RangeCompleteTransferTask(request *
 func (p *workflowExecutionPersistenceClient) CompleteVisibilityTask(request *CompleteVisibilityTaskRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceCompleteVisibilityTaskScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceCompleteVisibilityTaskScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceCompleteVisibilityTaskScope, tableExecution)
 	err := p.persistence.CompleteVisibilityTask(request)
 	sw.Stop()
 
@@ -495,7 +523,7 @@ func (p *workflowExecutionPersistenceClient) CompleteVisibilityTask(request *Com
 func (p *workflowExecutionPersistenceClient) RangeCompleteVisibilityTask(request *RangeCompleteVisibilityTaskRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceRangeCompleteVisibilityTaskScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceRangeCompleteVisibilityTaskScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceRangeCompleteVisibilityTaskScope, tableExecution)
 	err := p.persistence.RangeCompleteVisibilityTask(request)
 	sw.Stop()
 
@@ -509,7 +537,7 @@ func (p *workflowExecutionPersistenceClient) RangeCompleteVisibilityTask(request
 func (p *workflowExecutionPersistenceClient) CompleteReplicationTask(request *CompleteReplicationTaskRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceCompleteReplicationTaskScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceCompleteReplicationTaskScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceCompleteReplicationTaskScope, tableExecution)
 	err := p.persistence.CompleteReplicationTask(request)
 	sw.Stop()
 
@@ -523,7 +551,7 @@ func (p *workflowExecutionPersistenceClient) CompleteReplicationTask(request *Co
 func (p *workflowExecutionPersistenceClient) RangeCompleteReplicationTask(request *RangeCompleteReplicationTaskRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceRangeCompleteReplicationTaskScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceRangeCompleteReplicationTaskScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceRangeCompleteReplicationTaskScope, tableExecution)
 	err := p.persistence.RangeCompleteReplicationTask(request)
 	sw.Stop()
 
@@ -539,7 +567,7 @@ func (p *workflowExecutionPersistenceClient) PutReplicationTaskToDLQ(
 ) error {
 	p.metricClient.IncCounter(metrics.PersistencePutReplicationTaskToDLQScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistencePutReplicationTaskToDLQScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistencePutReplicationTaskToDLQScope, tableExecution)
 	err := p.persistence.PutReplicationTaskToDLQ(request)
 	sw.Stop()
 
@@ -555,7 +583,7 @@ func (p *workflowExecutionPersistenceClient) GetReplicationTasksFromDLQ(
 ) (*GetReplicationTasksFromDLQResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetReplicationTasksFromDLQScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceGetReplicationTasksFromDLQScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceGetReplicationTasksFromDLQScope, tableExecution)
 	response, err := p.persistence.GetReplicationTasksFromDLQ(request)
 	sw.Stop()
 
@@ -571,7 +599,7 @@ func (p *workflowExecutionPersistenceClient) DeleteReplicationTaskFromDLQ(
 ) error {
 	p.metricClient.IncCounter(metrics.PersistenceDeleteReplicationTaskFromDLQScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceDeleteReplicationTaskFromDLQScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceDeleteReplicationTaskFromDLQScope, tableExecution)
 	err := p.persistence.DeleteReplicationTaskFromDLQ(request)
 	sw.Stop()
 
@@ -587,7 +615,7 @@ func (p *workflowExecutionPersistenceClient) RangeDeleteReplicationTaskFromDLQ(
 ) error {
 	p.metricClient.IncCounter(metrics.PersistenceRangeDeleteReplicationTaskFromDLQScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceRangeDeleteReplicationTaskFromDLQScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceRangeDeleteReplicationTaskFromDLQScope, tableExecution)
 	err := p.persistence.RangeDeleteReplicationTaskFromDLQ(request)
 	sw.Stop()
 
@@ -601,7 +629,7 @@ func (p *workflowExecutionPersistenceClient) RangeDeleteReplicationTaskFromDLQ(
 func (p *workflowExecutionPersistenceClient) GetTimerTask(request *GetTimerTaskRequest) (*GetTimerTaskResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetTimerTaskScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceGetTimerTaskScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceGetTimerTaskScope, tableExecution)
 	response, err := p.persistence.GetTimerTask(request)
 	sw.Stop()
 
@@ -615,7 +643,7 @@ func (p *workflowExecutionPersistenceClient) GetTimerTask(request *GetTimerTaskR
 func (p *workflowExecutionPersistenceClient) GetTimerIndexTasks(request *GetTimerIndexTasksRequest) (*GetTimerIndexTasksResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetTimerIndexTasksScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceGetTimerIndexTasksScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceGetTimerIndexTasksScope, tableExecution)
 	response, err := p.persistence.GetTimerIndexTasks(request)
 	sw.Stop()
 
@@ -629,7 +657,7 @@ func (p *workflowExecutionPersistenceClient) GetTimerIndexTasks(request *GetTime
 func (p *workflowExecutionPersistenceClient) CompleteTimerTask(request *CompleteTimerTaskRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceCompleteTimerTaskScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceCompleteTimerTaskScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceCompleteTimerTaskScope, tableExecution)
 	err := p.persistence.CompleteTimerTask(request)
 	sw.Stop()
 
@@ -643,7 +671,7 @@ func (p *workflowExecutionPersistenceClient) CompleteTimerTask(request *Complete
 func (p *workflowExecutionPersistenceClient) RangeCompleteTimerTask(request *RangeCompleteTimerTaskRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceRangeCompleteTimerTaskScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceRangeCompleteTimerTaskScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceRangeCompleteTimerTaskScope, tableExecution)
 	err := p.persistence.RangeCompleteTimerTask(request)
 	sw.Stop()
 
@@ -655,6 +683,8 @@ func (p *workflowExecutionPersistenceClient) RangeCompleteTimerTask(request *Ran
 }
 
 func (p *workflowExecutionPersistenceClient) updateErrorMetric(scope int, err error) {
+	updateErrorClassMetric(p.metricClient, scope, tableExecution, err)
+
 	switch err.(type) {
 	case *WorkflowExecutionAlreadyStartedError:
 		p.metricClient.IncCounter(scope, metrics.PersistenceErrExecutionAlreadyStartedCounter)
@@ -690,7 +720,7 @@ func (p *taskPersistenceClient) GetName() string {
 func (p *taskPersistenceClient) CreateTasks(request *CreateTasksRequest) (*CreateTasksResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceCreateTaskScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceCreateTaskScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceCreateTaskScope, tableTask)
 	response, err := p.persistence.CreateTasks(request)
 	sw.Stop()
 
@@ -704,7 +734,7 @@ func (p *taskPersistenceClient) CreateTasks(request *CreateTasksRequest) (*Creat
 func (p *taskPersistenceClient) GetTasks(request *GetTasksRequest) (*GetTasksResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetTasksScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceGetTasksScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceGetTasksScope, tableTask)
 	response, err := p.persistence.GetTasks(request)
 	sw.Stop()
 
@@ -718,7 +748,7 @@ func (p *taskPersistenceClient) GetTasks(request *GetTasksRequest) (*GetTasksRes
 func (p *taskPersistenceClient) CompleteTask(request *CompleteTaskRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceCompleteTaskScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceCompleteTaskScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceCompleteTaskScope, tableTask)
 	err := p.persistence.CompleteTask(request)
 	sw.Stop()
 
@@ -731,7 +761,7 @@ func (p *taskPersistenceClient) CompleteTask(request *CompleteTaskRequest) error
 
 func (p *taskPersistenceClient) CompleteTasksLessThan(request *CompleteTasksLessThanRequest) (int, error) {
 	p.metricClient.IncCounter(metrics.PersistenceCompleteTasksLessThanScope, metrics.PersistenceRequests)
-	sw := p.metricClient.StartTimer(metrics.PersistenceCompleteTasksLessThanScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceCompleteTasksLessThanScope, tableTask)
 	result, err := p.persistence.CompleteTasksLessThan(request)
 	sw.Stop()
 	if err != nil {
@@ -743,7 +773,7 @@ func (p *taskPersistenceClient) CompleteTasksLessThan(request *CompleteTasksLess
 func (p *taskPersistenceClient) LeaseTaskQueue(request *LeaseTaskQueueRequest) (*LeaseTaskQueueResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceLeaseTaskQueueScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceLeaseTaskQueueScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceLeaseTaskQueueScope, tableTask)
 	response, err := p.persistence.LeaseTaskQueue(request)
 	sw.Stop()
 
@@ -756,7 +786,7 @@ func (p *taskPersistenceClient) LeaseTaskQueue(request *LeaseTaskQueueRequest) (
 
 func (p *taskPersistenceClient) ListTaskQueue(request *ListTaskQueueRequest) (*ListTaskQueueResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceListTaskQueueScope, metrics.PersistenceRequests)
-	sw := p.metricClient.StartTimer(metrics.PersistenceListTaskQueueScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceListTaskQueueScope, tableTask)
 	response, err := p.persistence.ListTaskQueue(request)
 	sw.Stop()
 	if err != nil {
@@ -767,7 +797,7 @@ func (p *taskPersistenceClient) ListTaskQueue(request *ListTaskQueueRequest) (*L
 
 func (p *taskPersistenceClient) DeleteTaskQueue(request *DeleteTaskQueueRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceDeleteTaskQueueScope, metrics.PersistenceRequests)
-	sw := p.metricClient.StartTimer(metrics.PersistenceDeleteTaskQueueScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceDeleteTaskQueueScope, tableTask)
 	err := p.persistence.DeleteTaskQueue(request)
 	sw.Stop()
 	if err != nil {
@@ -779,7 +809,7 @@ func (p *taskPersistenceClient) DeleteTaskQueue(request *DeleteTaskQueueRequest)
 func (p *taskPersistenceClient) UpdateTaskQueue(request *UpdateTaskQueueRequest) (*UpdateTaskQueueResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceUpdateTaskQueueScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceUpdateTaskQueueScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceUpdateTaskQueueScope, tableTask)
 	response, err := p.persistence.UpdateTaskQueue(request)
 	sw.Stop()
 
@@ -791,6 +821,8 @@ func (p *taskPersistenceClient) UpdateTaskQueue(request *UpdateTaskQueueRequest)
 }
 
 func (p *taskPersistenceClient) updateErrorMetric(scope int, err error) {
+	updateErrorClassMetric(p.metricClient, scope, tableTask, err)
+
 	switch err.(type) {
 	case *ConditionFailedError:
 		p.metricClient.IncCounter(scope, metrics.PersistenceErrConditionFailedCounter)
@@ -818,7 +850,7 @@ func (p *metadataPersistenceClient) GetName() string {
 func (p *metadataPersistenceClient) CreateNamespace(request *CreateNamespaceRequest) (*CreateNamespaceResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceCreateNamespaceScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceCreateNamespaceScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceCreateNamespaceScope, tableNamespace)
 	response, err := p.persistence.CreateNamespace(request)
 	sw.Stop()
 
@@ -832,7 +864,7 @@ func (p *metadataPersistenceClient) CreateNamespace(request *CreateNamespaceRequ
 func (p *metadataPersistenceClient) GetNamespace(request *GetNamespaceRequest) (*GetNamespaceResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetNamespaceScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceGetNamespaceScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceGetNamespaceScope, tableNamespace)
 	response, err := p.persistence.GetNamespace(request)
 	sw.Stop()
 
@@ -846,7 +878,7 @@ func (p *metadataPersistenceClient) GetNamespace(request *GetNamespaceRequest) (
 func (p *metadataPersistenceClient) UpdateNamespace(request *UpdateNamespaceRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceUpdateNamespaceScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceUpdateNamespaceScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceUpdateNamespaceScope, tableNamespace)
 	err := p.persistence.UpdateNamespace(request)
 	sw.Stop()
 
@@ -860,7 +892,7 @@ func (p *metadataPersistenceClient) UpdateNamespace(request *UpdateNamespaceRequ
 func (p *metadataPersistenceClient) DeleteNamespace(request *DeleteNamespaceRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceDeleteNamespaceScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceDeleteNamespaceScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceDeleteNamespaceScope, tableNamespace)
 	err := p.persistence.DeleteNamespace(request)
 	sw.Stop()
 
@@ -874,7 +906,7 @@ func (p *metadataPersistenceClient) DeleteNamespace(request *DeleteNamespaceRequ
 func (p *metadataPersistenceClient) DeleteNamespaceByName(request *DeleteNamespaceByNameRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceDeleteNamespaceByNameScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceDeleteNamespaceByNameScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceDeleteNamespaceByNameScope, tableNamespace)
 	err := p.persistence.DeleteNamespaceByName(request)
 	sw.Stop()
 
@@ -888,7 +920,7 @@ func (p *metadataPersistenceClient) DeleteNamespaceByName(request *DeleteNamespa
 func (p *metadataPersistenceClient) ListNamespaces(request *ListNamespacesRequest) (*ListNamespacesResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceListNamespaceScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceListNamespaceScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceListNamespaceScope, tableNamespace)
 	response, err := p.persistence.ListNamespaces(request)
 	sw.Stop()
 
@@ -902,7 +934,7 @@ func (p *metadataPersistenceClient) ListNamespaces(request *ListNamespacesReques
 func (p *metadataPersistenceClient) GetMetadata() (*GetMetadataResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetMetadataScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceGetMetadataScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceGetMetadataScope, tableNamespace)
 	response, err := p.persistence.GetMetadata()
 	sw.Stop()
 
@@ -918,6 +950,8 @@ func (p *metadataPersistenceClient) Close() {
 }
 
 func (p *metadataPersistenceClient) updateErrorMetric(scope int, err error) {
+	updateErrorClassMetric(p.metricClient, scope, tableNamespace, err)
+
 	switch err.(type) {
 	case *serviceerror.NamespaceAlreadyExists:
 		p.metricClient.IncCounter(scope, metrics.PersistenceErrNamespaceAlreadyExistsCounter)
@@ -942,7 +976,7 @@ func (p *visibilityPersistenceClient) GetName() string {
 func (p *visibilityPersistenceClient) RecordWorkflowExecutionStarted(request *RecordWorkflowExecutionStartedRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceRecordWorkflowExecutionStartedScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceRecordWorkflowExecutionStartedScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceRecordWorkflowExecutionStartedScope, tableVisibility)
 	err := p.persistence.RecordWorkflowExecutionStarted(request)
 	sw.Stop()
 
@@ -956,7 +990,7 @@ func (p *visibilityPersistenceClient) RecordWorkflowExecutionStarted(request *Re
 func (p *visibilityPersistenceClient) RecordWorkflowExecutionStartedV2(request *RecordWorkflowExecutionStartedRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceRecordWorkflowExecutionStartedScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceRecordWorkflowExecutionStartedScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceRecordWorkflowExecutionStartedScope, tableVisibility)
 	err := p.persistence.RecordWorkflowExecutionStartedV2(request)
 	sw.Stop()
 
@@ -970,7 +1004,7 @@ func (p *visibilityPersistenceClient) RecordWorkflowExecutionStartedV2(request *
 func (p *visibilityPersistenceClient) RecordWorkflowExecutionClosed(request *RecordWorkflowExecutionClosedRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceRecordWorkflowExecutionClosedScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceRecordWorkflowExecutionClosedScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceRecordWorkflowExecutionClosedScope, tableVisibility)
 	err := p.persistence.RecordWorkflowExecutionClosed(request)
 	sw.Stop()
 
@@ -984,7 +1018,7 @@ func (p *visibilityPersistenceClient) RecordWorkflowExecutionClosed(request *Rec
 func (p *visibilityPersistenceClient) RecordWorkflowExecutionClosedV2(request *RecordWorkflowExecutionClosedRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceRecordWorkflowExecutionClosedScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceRecordWorkflowExecutionClosedScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceRecordWorkflowExecutionClosedScope, tableVisibility)
 	err := p.persistence.RecordWorkflowExecutionClosedV2(request)
 	sw.Stop()
 
@@ -998,7 +1032,7 @@ func (p *visibilityPersistenceClient) RecordWorkflowExecutionClosedV2(request *R
 func (p *visibilityPersistenceClient) UpsertWorkflowExecution(request *UpsertWorkflowExecutionRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceUpsertWorkflowExecutionScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceUpsertWorkflowExecutionScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceUpsertWorkflowExecutionScope, tableVisibility)
 	err := p.persistence.UpsertWorkflowExecution(request)
 	sw.Stop()
 
@@ -1012,7 +1046,7 @@ func (p *visibilityPersistenceClient) UpsertWorkflowExecution(request *UpsertWor
 func (p *visibilityPersistenceClient) UpsertWorkflowExecutionV2(request *UpsertWorkflowExecutionRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceUpsertWorkflowExecutionScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceUpsertWorkflowExecutionScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceUpsertWorkflowExecutionScope, tableVisibility)
 	err := p.persistence.UpsertWorkflowExecutionV2(request)
 	sw.Stop()
 
@@ -1026,7 +1060,7 @@ func (p *visibilityPersistenceClient) UpsertWorkflowExecutionV2(request *UpsertW
 func (p *visibilityPersistenceClient) ListOpenWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceListOpenWorkflowExecutionsScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceListOpenWorkflowExecutionsScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceListOpenWorkflowExecutionsScope, tableVisibility)
 	response, err := p.persistence.ListOpenWorkflowExecutions(request)
 	sw.Stop()
 
@@ -1040,7 +1074,7 @@ func (p *visibilityPersistenceClient) ListOpenWorkflowExecutions(request *ListWo
 func (p *visibilityPersistenceClient) ListClosedWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceListClosedWorkflowExecutionsScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceListClosedWorkflowExecutionsScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceListClosedWorkflowExecutionsScope, tableVisibility)
 	response, err := p.persistence.ListClosedWorkflowExecutions(request)
 	sw.Stop()
 
@@ -1054,7 +1088,7 @@ func (p *visibilityPersistenceClient) ListClosedWorkflowExecutions(request *List
 func (p *visibilityPersistenceClient) ListOpenWorkflowExecutionsByType(request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceListOpenWorkflowExecutionsByTypeScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceListOpenWorkflowExecutionsByTypeScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceListOpenWorkflowExecutionsByTypeScope, tableVisibility)
 	response, err := p.persistence.ListOpenWorkflowExecutionsByType(request)
 	sw.Stop()
 
@@ -1068,7 +1102,7 @@ func (p *visibilityPersistenceClient) ListOpenWorkflowExecutionsByType(request *
 func (p *visibilityPersistenceClient) ListClosedWorkflowExecutionsByType(request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceListClosedWorkflowExecutionsByTypeScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceListClosedWorkflowExecutionsByTypeScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceListClosedWorkflowExecutionsByTypeScope, tableVisibility)
 	response, err := p.persistence.ListClosedWorkflowExecutionsByType(request)
 	sw.Stop()
 
@@ -1082,7 +1116,7 @@ func (p *visibilityPersistenceClient) ListClosedWorkflowExecutionsByType(request
 func (p *visibilityPersistenceClient) ListOpenWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceListOpenWorkflowExecutionsByWorkflowIDScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceListOpenWorkflowExecutionsByWorkflowIDScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceListOpenWorkflowExecutionsByWorkflowIDScope, tableVisibility)
 	response, err := p.persistence.ListOpenWorkflowExecutionsByWorkflowID(request)
 	sw.Stop()
 
@@ -1096,7 +1130,7 @@ func (p *visibilityPersistenceClient) ListOpenWorkflowExecutionsByWorkflowID(req
 func (p *visibilityPersistenceClient) ListClosedWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceListClosedWorkflowExecutionsByWorkflowIDScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceListClosedWorkflowExecutionsByWorkflowIDScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceListClosedWorkflowExecutionsByWorkflowIDScope, tableVisibility)
 	response, err := p.persistence.ListClosedWorkflowExecutionsByWorkflowID(request)
 	sw.Stop()
 
@@ -1110,7 +1144,7 @@ func (p *visibilityPersistenceClient) ListClosedWorkflowExecutionsByWorkflowID(r
 func (p *visibilityPersistenceClient) ListClosedWorkflowExecutionsByStatus(request *ListClosedWorkflowExecutionsByStatusRequest) (*ListWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceListClosedWorkflowExecutionsByStatusScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceListClosedWorkflowExecutionsByStatusScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceListClosedWorkflowExecutionsByStatusScope, tableVisibility)
 	response, err := p.persistence.ListClosedWorkflowExecutionsByStatus(request)
 	sw.Stop()
 
@@ -1124,7 +1158,7 @@ func (p *visibilityPersistenceClient) ListClosedWorkflowExecutionsByStatus(reque
 func (p *visibilityPersistenceClient) GetClosedWorkflowExecution(request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetClosedWorkflowExecutionScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceGetClosedWorkflowExecutionScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceGetClosedWorkflowExecutionScope, tableVisibility)
 	response, err := p.persistence.GetClosedWorkflowExecution(request)
 	sw.Stop()
 
@@ -1138,7 +1172,7 @@ func (p *visibilityPersistenceClient) GetClosedWorkflowExecution(request *GetClo
 func (p *visibilityPersistenceClient) DeleteWorkflowExecution(request *VisibilityDeleteWorkflowExecutionRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceVisibilityDeleteWorkflowExecutionScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceVisibilityDeleteWorkflowExecutionScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceVisibilityDeleteWorkflowExecutionScope, tableVisibility)
 	err := p.persistence.DeleteWorkflowExecution(request)
 	sw.Stop()
 
@@ -1152,7 +1186,7 @@ func (p *visibilityPersistenceClient) DeleteWorkflowExecution(request *Visibilit
 func (p *visibilityPersistenceClient) DeleteWorkflowExecutionV2(request *VisibilityDeleteWorkflowExecutionRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceVisibilityDeleteWorkflowExecutionScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceVisibilityDeleteWorkflowExecutionScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceVisibilityDeleteWorkflowExecutionScope, tableVisibility)
 	err := p.persistence.DeleteWorkflowExecutionV2(request)
 	sw.Stop()
 
@@ -1166,7 +1200,7 @@ func (p *visibilityPersistenceClient) DeleteWorkflowExecutionV2(request *Visibil
 func (p *visibilityPersistenceClient) ListWorkflowExecutions(request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceListWorkflowExecutionsScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceListWorkflowExecutionsScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceListWorkflowExecutionsScope, tableVisibility)
 	response, err := p.persistence.ListWorkflowExecutions(request)
 	sw.Stop()
 
@@ -1180,7 +1214,7 @@ func (p *visibilityPersistenceClient) ListWorkflowExecutions(request *ListWorkfl
 func (p *visibilityPersistenceClient) ScanWorkflowExecutions(request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceScanWorkflowExecutionsScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceScanWorkflowExecutionsScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceScanWorkflowExecutionsScope, tableVisibility)
 	response, err := p.persistence.ScanWorkflowExecutions(request)
 	sw.Stop()
 
@@ -1194,7 +1228,7 @@ func (p *visibilityPersistenceClient) ScanWorkflowExecutions(request *ListWorkfl
 func (p *visibilityPersistenceClient) CountWorkflowExecutions(request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceCountWorkflowExecutionsScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceCountWorkflowExecutionsScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceCountWorkflowExecutionsScope, tableVisibility)
 	response, err := p.persistence.CountWorkflowExecutions(request)
 	sw.Stop()
 
@@ -1206,6 +1240,8 @@ func (p *visibilityPersistenceClient) CountWorkflowExecutions(request *CountWork
 }
 
 func (p *visibilityPersistenceClient) updateErrorMetric(scope int, err error) {
+	updateErrorClassMetric(p.metricClient, scope, tableVisibility, err)
+
 	switch err.(type) {
 	case *ConditionFailedError:
 		p.metricClient.IncCounter(scope, metrics.PersistenceErrConditionFailedCounter)
@@ -1239,7 +1275,7 @@ func (p *historyV2PersistenceClient) Close() {
 // AppendHistoryNodes add(or override) a node to a history branch
 func (p *historyV2PersistenceClient) AppendHistoryNodes(request *AppendHistoryNodesRequest) (*AppendHistoryNodesResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceAppendHistoryNodesScope, metrics.PersistenceRequests)
-	sw := p.metricClient.StartTimer(metrics.PersistenceAppendHistoryNodesScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceAppendHistoryNodesScope, tableHistory)
 	resp, err := p.persistence.AppendHistoryNodes(request)
 	sw.Stop()
 	if err != nil {
@@ -1251,7 +1287,7 @@ func (p *historyV2PersistenceClient) AppendHistoryNodes(request *AppendHistoryNo
 // ReadHistoryBranch returns history node data for a branch
 func (p *historyV2PersistenceClient) ReadHistoryBranch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceReadHistoryBranchScope, metrics.PersistenceRequests)
-	sw := p.metricClient.StartTimer(metrics.PersistenceReadHistoryBranchScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceReadHistoryBranchScope, tableHistory)
 	response, err := p.persistence.ReadHistoryBranch(request)
 	sw.Stop()
 	if err != nil {
@@ -1263,7 +1299,7 @@ func (p *historyV2PersistenceClient) ReadHistoryBranch(request *ReadHistoryBranc
 // ReadHistoryBranchByBatch returns history node data for a branch ByBatch
 func (p *historyV2PersistenceClient) ReadHistoryBranchByBatch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchByBatchResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceReadHistoryBranchScope, metrics.PersistenceRequests)
-	sw := p.metricClient.StartTimer(metrics.PersistenceReadHistoryBranchScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceReadHistoryBranchScope, tableHistory)
 	response, err := p.persistence.ReadHistoryBranchByBatch(request)
 	sw.Stop()
 	if err != nil {
@@ -1275,7 +1311,7 @@ func (p *historyV2PersistenceClient) ReadHistoryBranchByBatch(request *ReadHisto
 // ReadRawHistoryBranch returns history node raw data for a branch ByBatch
 func (p *historyV2PersistenceClient) ReadRawHistoryBranch(request *ReadHistoryBranchRequest) (*ReadRawHistoryBranchResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceReadHistoryBranchScope, metrics.PersistenceRequests)
-	sw := p.metricClient.StartTimer(metrics.PersistenceReadHistoryBranchScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceReadHistoryBranchScope, tableHistory)
 	response, err := p.persistence.ReadRawHistoryBranch(request)
 	sw.Stop()
 	if err != nil {
@@ -1287,7 +1323,7 @@ func (p *historyV2PersistenceClient) ReadRawHistoryBranch(request *ReadHistoryBr
 // ForkHistoryBranch forks a new branch from a old branch
 func (p *historyV2PersistenceClient) ForkHistoryBranch(request *ForkHistoryBranchRequest) (*ForkHistoryBranchResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceForkHistoryBranchScope, metrics.PersistenceRequests)
-	sw := p.metricClient.StartTimer(metrics.PersistenceForkHistoryBranchScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceForkHistoryBranchScope, tableHistory)
 	response, err := p.persistence.ForkHistoryBranch(request)
 	sw.Stop()
 	if err != nil {
@@ -1299,7 +1335,7 @@ func (p *historyV2PersistenceClient) ForkHistoryBranch(request *ForkHistoryBranc
 // DeleteHistoryBranch removes a branch
 func (p *historyV2PersistenceClient) DeleteHistoryBranch(request *DeleteHistoryBranchRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceDeleteHistoryBranchScope, metrics.PersistenceRequests)
-	sw := p.metricClient.StartTimer(metrics.PersistenceDeleteHistoryBranchScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceDeleteHistoryBranchScope, tableHistory)
 	err := p.persistence.DeleteHistoryBranch(request)
 	sw.Stop()
 	if err != nil {
@@ -1310,7 +1346,7 @@ func (p *historyV2PersistenceClient) DeleteHistoryBranch(request *DeleteHistoryB
 
 func (p *historyV2PersistenceClient) GetAllHistoryTreeBranches(request *GetAllHistoryTreeBranchesRequest) (*GetAllHistoryTreeBranchesResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetAllHistoryTreeBranchesScope, metrics.PersistenceRequests)
-	sw := p.metricClient.StartTimer(metrics.PersistenceGetAllHistoryTreeBranchesScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceGetAllHistoryTreeBranchesScope, tableHistory)
 	response, err := p.persistence.GetAllHistoryTreeBranches(request)
 	sw.Stop()
 	if err != nil {
@@ -1322,7 +1358,7 @@ func (p *historyV2PersistenceClient) GetAllHistoryTreeBranches(request *GetAllHi
 // GetHistoryTree returns all branch information of a tree
 func (p *historyV2PersistenceClient) GetHistoryTree(request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetHistoryTreeScope, metrics.PersistenceRequests)
-	sw := p.metricClient.StartTimer(metrics.PersistenceGetHistoryTreeScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceGetHistoryTreeScope, tableHistory)
 	response, err := p.persistence.GetHistoryTree(request)
 	sw.Stop()
 	if err != nil {
@@ -1332,6 +1368,8 @@ func (p *historyV2PersistenceClient) GetHistoryTree(request *GetHistoryTreeReque
 }
 
 func (p *historyV2PersistenceClient) updateErrorMetric(scope int, err error) {
+	updateErrorClassMetric(p.metricClient, scope, tableHistory, err)
+
 	switch err.(type) {
 	case *serviceerror.NotFound:
 		p.metricClient.IncCounter(scope, metrics.PersistenceErrEntityNotExistsCounter)
@@ -1353,12 +1391,12 @@ func (p *historyV2PersistenceClient) updateErrorMetric(scope int, err error) {
 func (p *queuePersistenceClient) EnqueueMessage(blob commonpb.DataBlob) error {
 	p.metricClient.IncCounter(metrics.PersistenceEnqueueMessageScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceEnqueueMessageScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceEnqueueMessageScope, tableQueue)
 	err := p.persistence.EnqueueMessage(blob)
 	sw.Stop()
 
 	if err != nil {
-		p.metricClient.IncCounter(metrics.PersistenceEnqueueMessageScope, metrics.PersistenceFailures)
+		p.updateErrorMetric(metrics.PersistenceEnqueueMessageScope, err)
 	}
 
 	return err
@@ -1367,12 +1405,12 @@ func (p *queuePersistenceClient) EnqueueMessage(blob commonpb.DataBlob) error {
 func (p *queuePersistenceClient) ReadMessages(lastMessageID int64, maxCount int) ([]*QueueMessage, error) {
 	p.metricClient.IncCounter(metrics.PersistenceReadQueueMessagesScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceReadQueueMessagesScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceReadQueueMessagesScope, tableQueue)
 	result, err := p.persistence.ReadMessages(lastMessageID, maxCount)
 	sw.Stop()
 
 	if err != nil {
-		p.metricClient.IncCounter(metrics.PersistenceReadQueueMessagesScope, metrics.PersistenceFailures)
+		p.updateErrorMetric(metrics.PersistenceReadQueueMessagesScope, err)
 	}
 
 	return result, err
@@ -1381,12 +1419,12 @@ func (p *queuePersistenceClient) ReadMessages(lastMessageID int64, maxCount int)
 func (p *queuePersistenceClient) UpdateAckLevel(messageID int64, clusterName string) error {
 	p.metricClient.IncCounter(metrics.PersistenceUpdateAckLevelScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceUpdateAckLevelScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceUpdateAckLevelScope, tableQueue)
 	err := p.persistence.UpdateAckLevel(messageID, clusterName)
 	sw.Stop()
 
 	if err != nil {
-		p.metricClient.IncCounter(metrics.PersistenceUpdateAckLevelScope, metrics.PersistenceFailures)
+		p.updateErrorMetric(metrics.PersistenceUpdateAckLevelScope, err)
 	}
 
 	return err
@@ -1395,12 +1433,12 @@ func (p *queuePersistenceClient) UpdateAckLevel(messageID int64, clusterName str
 func (p *queuePersistenceClient) GetAckLevels() (map[string]int64, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetAckLevelScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceGetAckLevelScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceGetAckLevelScope, tableQueue)
 	result, err := p.persistence.GetAckLevels()
 	sw.Stop()
 
 	if err != nil {
-		p.metricClient.IncCounter(metrics.PersistenceGetAckLevelScope, metrics.PersistenceFailures)
+		p.updateErrorMetric(metrics.PersistenceGetAckLevelScope, err)
 	}
 
 	return result, err
@@ -1409,12 +1447,12 @@ func (p *queuePersistenceClient) GetAckLevels() (map[string]int64, error) {
 func (p *queuePersistenceClient) DeleteMessagesBefore(messageID int64) error {
 	p.metricClient.IncCounter(metrics.PersistenceDeleteQueueMessagesScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceDeleteQueueMessagesScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceDeleteQueueMessagesScope, tableQueue)
 	err := p.persistence.DeleteMessagesBefore(messageID)
 	sw.Stop()
 
 	if err != nil {
-		p.metricClient.IncCounter(metrics.PersistenceDeleteQueueMessagesScope, metrics.PersistenceFailures)
+		p.updateErrorMetric(metrics.PersistenceDeleteQueueMessagesScope, err)
 	}
 
 	return err
@@ -1423,12 +1461,12 @@ func (p *queuePersistenceClient) DeleteMessagesBefore(messageID int64) error {
 func (p *queuePersistenceClient) EnqueueMessageToDLQ(blob commonpb.DataBlob) (int64, error) {
 	p.metricClient.IncCounter(metrics.PersistenceEnqueueMessageToDLQScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceEnqueueMessageToDLQScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceEnqueueMessageToDLQScope, tableQueue)
 	messageID, err := p.persistence.EnqueueMessageToDLQ(blob)
 	sw.Stop()
 
 	if err != nil {
-		p.metricClient.IncCounter(metrics.PersistenceEnqueueMessageToDLQScope, metrics.PersistenceFailures)
+		p.updateErrorMetric(metrics.PersistenceEnqueueMessageToDLQScope, err)
 	}
 
 	return messageID, err
@@ -1437,12 +1475,12 @@ func (p *queuePersistenceClient) EnqueueMessageToDLQ(blob commonpb.DataBlob) (in
 func (p *queuePersistenceClient) ReadMessagesFromDLQ(firstMessageID int64, lastMessageID int64, pageSize int, pageToken []byte) ([]*QueueMessage, []byte, error) {
 	p.metricClient.IncCounter(metrics.PersistenceReadQueueMessagesFromDLQScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceReadQueueMessagesFromDLQScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceReadQueueMessagesFromDLQScope, tableQueue)
 	result, token, err := p.persistence.ReadMessagesFromDLQ(firstMessageID, lastMessageID, pageSize, pageToken)
 	sw.Stop()
 
 	if err != nil {
-		p.metricClient.IncCounter(metrics.PersistenceReadQueueMessagesFromDLQScope, metrics.PersistenceFailures)
+		p.updateErrorMetric(metrics.PersistenceReadQueueMessagesFromDLQScope, err)
 	}
 
 	return result, token, err
@@ -1451,12 +1489,12 @@ func (p *queuePersistenceClient) ReadMessagesFromDLQ(firstMessageID int64, lastM
 func (p *queuePersistenceClient) DeleteMessageFromDLQ(messageID int64) error {
 	p.metricClient.IncCounter(metrics.PersistenceDeleteQueueMessageFromDLQScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceDeleteQueueMessageFromDLQScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceDeleteQueueMessageFromDLQScope, tableQueue)
 	err := p.persistence.DeleteMessageFromDLQ(messageID)
 	sw.Stop()
 
 	if err != nil {
-		p.metricClient.IncCounter(metrics.PersistenceDeleteQueueMessageFromDLQScope, metrics.PersistenceFailures)
+		p.updateErrorMetric(metrics.PersistenceDeleteQueueMessageFromDLQScope, err)
 	}
 
 	return err
@@ -1465,12 +1503,12 @@ func (p *queuePersistenceClient) DeleteMessageFromDLQ(messageID int64) error {
 func (p *queuePersistenceClient) RangeDeleteMessagesFromDLQ(firstMessageID int64, lastMessageID int64) error {
 	p.metricClient.IncCounter(metrics.PersistenceRangeDeleteMessagesFromDLQScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceRangeDeleteMessagesFromDLQScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceRangeDeleteMessagesFromDLQScope, tableQueue)
 	err := p.persistence.RangeDeleteMessagesFromDLQ(firstMessageID, lastMessageID)
 	sw.Stop()
 
 	if err != nil {
-		p.metricClient.IncCounter(metrics.PersistenceRangeDeleteMessagesFromDLQScope, metrics.PersistenceFailures)
+		p.updateErrorMetric(metrics.PersistenceRangeDeleteMessagesFromDLQScope, err)
 	}
 
 	return err
@@ -1479,12 +1517,12 @@ func (p *queuePersistenceClient) RangeDeleteMessagesFromDLQ(firstMessageID int64
 func (p *queuePersistenceClient) UpdateDLQAckLevel(messageID int64, clusterName string) error {
 	p.metricClient.IncCounter(metrics.PersistenceUpdateDLQAckLevelScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceUpdateDLQAckLevelScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceUpdateDLQAckLevelScope, tableQueue)
 	err := p.persistence.UpdateDLQAckLevel(messageID, clusterName)
 	sw.Stop()
 
 	if err != nil {
-		p.metricClient.IncCounter(metrics.PersistenceUpdateDLQAckLevelScope, metrics.PersistenceFailures)
+		p.updateErrorMetric(metrics.PersistenceUpdateDLQAckLevelScope, err)
 	}
 
 	return err
@@ -1493,21 +1531,33 @@ func (p *queuePersistenceClient) UpdateDLQAckLevel(messageID int64, clusterName
 func (p *queuePersistenceClient) GetDLQAckLevels() (map[string]int64, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetDLQAckLevelScope, metrics.PersistenceRequests)
 
-	sw := p.metricClient.StartTimer(metrics.PersistenceGetDLQAckLevelScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(p.metricClient, metrics.PersistenceGetDLQAckLevelScope, tableQueue)
 	result, err := p.persistence.GetDLQAckLevels()
 	sw.Stop()
 
 	if err != nil {
-		p.metricClient.IncCounter(metrics.PersistenceGetDLQAckLevelScope, metrics.PersistenceFailures)
+		p.updateErrorMetric(metrics.PersistenceGetDLQAckLevelScope, err)
 	}
 
 	return result, err
 }
 
+func (p *queuePersistenceClient) updateErrorMetric(scope int, err error) {
+	updateErrorClassMetric(p.metricClient, scope, tableQueue, err)
+
+	p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
+}
+
 func (p *queuePersistenceClient) Close() {
 	p.persistence.Close()
 }
 
+func (c *clusterMetadataPersistenceClient) updateErrorMetric(scope int, err error) {
+	updateErrorClassMetric(c.metricClient, scope, tableClusterMetadata, err)
+
+	c.metricClient.IncCounter(scope, metrics.PersistenceFailures)
+}
+
 func (c *clusterMetadataPersistenceClient) Close() {
 	c.persistence.Close()
 }
@@ -1515,12 +1565,12 @@ func (c *clusterMetadataPersistenceClient) Close() {
 func (c *clusterMetadataPersistenceClient) GetClusterMetadata() (*GetClusterMetadataResponse, error) {
 	c.metricClient.IncCounter(metrics.PersistenceGetClusterMetadataScope, metrics.PersistenceRequests)
 
-	sw := c.metricClient.StartTimer(metrics.PersistenceGetClusterMetadataScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(c.metricClient, metrics.PersistenceGetClusterMetadataScope, tableClusterMetadata)
 	result, err := c.persistence.GetClusterMetadata()
 	sw.Stop()
 
 	if err != nil {
-		c.metricClient.IncCounter(metrics.PersistenceGetClusterMetadataScope, metrics.PersistenceFailures)
+		c.updateErrorMetric(metrics.PersistenceGetClusterMetadataScope, err)
 	}
 
 	return result, err
@@ -1529,12 +1579,12 @@ func (c *clusterMetadataPersistenceClient) GetClusterMetadata() (*GetClusterMeta
 func (c *clusterMetadataPersistenceClient) SaveClusterMetadata(request *SaveClusterMetadataRequest) (bool, error) {
 	c.metricClient.IncCounter(metrics.PersistenceSaveClusterMetadataScope, metrics.PersistenceRequests)
 
-	sw := c.metricClient.StartTimer(metrics.PersistenceSaveClusterMetadataScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(c.metricClient, metrics.PersistenceSaveClusterMetadataScope, tableClusterMetadata)
 	applied, err := c.persistence.SaveClusterMetadata(request)
 	sw.Stop()
 
 	if err != nil {
-		c.metricClient.IncCounter(metrics.PersistenceSaveClusterMetadataScope, metrics.PersistenceFailures)
+		c.updateErrorMetric(metrics.PersistenceSaveClusterMetadataScope, err)
 	}
 
 	return applied, err
@@ -1547,12 +1597,12 @@ func (c *clusterMetadataPersistenceClient) GetName() string {
 func (c *clusterMetadataPersistenceClient) GetClusterMembers(request *GetClusterMembersRequest) (*GetClusterMembersResponse, error) {
 	c.metricClient.IncCounter(metrics.PersistenceGetClusterMembersScope, metrics.PersistenceRequests)
 
-	sw := c.metricClient.StartTimer(metrics.PersistenceGetClusterMembersScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(c.metricClient, metrics.PersistenceGetClusterMembersScope, tableClusterMetadata)
 	res, err := c.persistence.GetClusterMembers(request)
 	sw.Stop()
 
 	if err != nil {
-		c.metricClient.IncCounter(metrics.PersistenceGetClusterMembersScope, metrics.PersistenceFailures)
+		c.updateErrorMetric(metrics.PersistenceGetClusterMembersScope, err)
 	}
 
 	return res, err
@@ -1561,12 +1611,12 @@ func (c *clusterMetadataPersistenceClient) GetClusterMembers(request *GetCluster
 func (c *clusterMetadataPersistenceClient) UpsertClusterMembership(request *UpsertClusterMembershipRequest) error {
 	c.metricClient.IncCounter(metrics.PersistenceUpsertClusterMembershipScope, metrics.PersistenceRequests)
 
-	sw := c.metricClient.StartTimer(metrics.PersistenceUpsertClusterMembershipScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(c.metricClient, metrics.PersistenceUpsertClusterMembershipScope, tableClusterMetadata)
 	err := c.persistence.UpsertClusterMembership(request)
 	sw.Stop()
 
 	if err != nil {
-		c.metricClient.IncCounter(metrics.PersistenceUpsertClusterMembershipScope, metrics.PersistenceFailures)
+		c.updateErrorMetric(metrics.PersistenceUpsertClusterMembershipScope, err)
 	}
 
 	return err
@@ -1575,12 +1625,12 @@ func (c *clusterMetadataPersistenceClient) UpsertClusterMembership(request *Upse
 func (c *clusterMetadataPersistenceClient) PruneClusterMembership(request *PruneClusterMembershipRequest) error {
 	c.metricClient.IncCounter(metrics.PersistencePruneClusterMembershipScope, metrics.PersistenceRequests)
 
-	sw := c.metricClient.StartTimer(metrics.PersistencePruneClusterMembershipScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(c.metricClient, metrics.PersistencePruneClusterMembershipScope, tableClusterMetadata)
 	err := c.persistence.PruneClusterMembership(request)
 	sw.Stop()
 
 	if err != nil {
-		c.metricClient.IncCounter(metrics.PersistencePruneClusterMembershipScope, metrics.PersistenceFailures)
+		c.updateErrorMetric(metrics.PersistencePruneClusterMembershipScope, err)
 	}
 
 	return err
@@ -1589,13 +1639,58 @@ func (c *clusterMetadataPersistenceClient) PruneClusterMembership(request *Prune
 func (c *metadataPersistenceClient) InitializeSystemNamespaces(currentClusterName string) error {
 	c.metricClient.IncCounter(metrics.PersistenceInitializeSystemNamespaceScope, metrics.PersistenceRequests)
 
-	sw := c.metricClient.StartTimer(metrics.PersistenceInitializeSystemNamespaceScope, metrics.PersistenceLatency)
+	sw := startLatencyTimer(c.metricClient, metrics.PersistenceInitializeSystemNamespaceScope, tableNamespace)
 	err := c.persistence.InitializeSystemNamespaces(currentClusterName)
 	sw.Stop()
 
 	if err != nil {
-		c.metricClient.IncCounter(metrics.PersistenceInitializeSystemNamespaceScope, metrics.PersistenceFailures)
+		c.updateErrorMetric(metrics.PersistenceInitializeSystemNamespaceScope, err)
 	}
 
 	return err
 }
+
+// startLatencyTimer starts the latency timer tagged with the logical table, the operation is
+// already carried by the metric scope
+func startLatencyTimer(metricClient metrics.Client, scope int, table string) metrics.Stopwatch {
+	return metricClient.Scope(scope, metrics.TableTag(table)).StartTimer(metrics.PersistenceLatency)
+}
+
+// updateErrorClassMetric emits the error counter tagged with the logical table and
+// error class, the operation is already carried by the metric scope
+func updateErrorClassMetric(metricClient metrics.Client, scope int, table string, err error) {
+	metricClient.Scope(
+		scope,
+		metrics.TableTag(table),
+		metrics.ErrorClassTag(getErrorClass(err)),
+	).IncCounter(metrics.PersistenceErrorWithClassCounter)
+}
+
+// getErrorClass maps a persistence error to a coarse error class
+func getErrorClass(err error) string {
+	switch err.(type) {
+	case *TimeoutError:
+		return errorClassTimeout
+	case *serviceerror.Unavailable:
+		return errorClassUnavailable
+	case *serviceerror.ResourceExhausted:
+		return errorClassThrottled
+	case *ConditionFailedError,
+		*CurrentWorkflowConditionFailedError:
+		return errorClassConditionFailed
+	case *ShardOwnershipLostError:
+		return errorClassShardOwnershipLost
+	case *serviceerror.NotFound:
+		return errorClassNotFound
+	case *WorkflowExecutionAlreadyStartedError,
+		*ShardAlreadyExistError,
+		*serviceerror.NamespaceAlreadyExists:
+		return errorClassAlreadyExists
+	case *serviceerror.InvalidArgument:
+		return errorClassBadRequest
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return errorClassTimeout
+	}
+	return errorClassInternal
+}
@@ -0,0 +1,88 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/metrics"
+)
+
+type (
+	persistenceMetricClientsSuite struct {
+		suite.Suite
+	}
+)
+
+func TestPersistenceMetricClientsSuite(t *testing.T) {
+	s := new(persistenceMetricClientsSuite)
+	suite.Run(t, s)
+}
+
+func (s *persistenceMetricClientsSuite) TestGetErrorClass() {
+	testCases := []struct {
+		err   error
+		class string
+	}{
+		{err: &TimeoutError{Msg: "timeout"}, class: errorClassTimeout},
+		{err: fmt.Errorf("wrapped: %w", context.DeadlineExceeded), class: errorClassTimeout},
+		{err: serviceerror.NewUnavailable("unavailable"), class: errorClassUnavailable},
+		{err: serviceerror.NewResourceExhausted("busy"), class: errorClassThrottled},
+		{err: &ConditionFailedError{Msg: "condition failed"}, class: errorClassConditionFailed},
+		{err: &CurrentWorkflowConditionFailedError{Msg: "condition failed"}, class: errorClassConditionFailed},
+		{err: &ShardOwnershipLostError{Msg: "ownership lost"}, class: errorClassShardOwnershipLost},
+		{err: serviceerror.NewNotFound("not found"), class: errorClassNotFound},
+		{err: &ShardAlreadyExistError{Msg: "exists"}, class: errorClassAlreadyExists},
+		{err: serviceerror.NewInvalidArgument("bad request"), class: errorClassBadRequest},
+		{err: errors.New("some error"), class: errorClassInternal},
+	}
+
+	for _, tc := range testCases {
+		s.Equal(tc.class, getErrorClass(tc.err), tc.err.Error())
+	}
+}
+
+func (s *persistenceMetricClientsSuite) TestStartLatencyTimer() {
+	scope := tally.NewTestScope("test", nil)
+	metricClient := metrics.NewClient(scope, metrics.History)
+
+	sw := startLatencyTimer(metricClient, metrics.PersistenceUpdateShardScope, tableShard)
+	sw.Stop()
+
+	timers := scope.Snapshot().Timers()
+	s.Len(timers, 1)
+	for _, timer := range timers {
+		s.Equal("test.persistence_latency", timer.Name())
+		s.Equal(tableShard, timer.Tags()[metrics.TableTagName])
+		s.Equal("UpdateShard", timer.Tags()["operation"])
+	}
+}
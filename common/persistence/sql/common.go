@@ -31,6 +31,7 @@ import (
 	"encoding/binary"
 	"encoding/gob"
 	"fmt"
+	"time"
 
 	"go.temporal.io/api/serviceerror"
 
@@ -44,6 +45,8 @@ import (
 type sqlStore struct {
 	db     sqlplugin.DB
 	logger log.Logger
+	// readDB is the read replica connection, nil if no read replica is configured
+	readDB sqlplugin.DB
 }
 
 func (m *sqlStore) GetName() string {
@@ -54,6 +57,54 @@ func (m *sqlStore) Close() {
 	if m.db != nil {
 		m.db.Close()
 	}
+	if m.readDB != nil {
+		m.readDB.Close()
+	}
+}
+
+// readReplicaTimeoutRatio is the share of the remaining time given to the read replica,
+// the rest is left for falling back to the primary database
+const readReplicaTimeoutRatio = 0.5
+
+// readFromReplica executes the read only operation against the read replica if configured,
+// falling back to the primary database if the replica fails or returns sql.ErrNoRows,
+// since the rows may not have been replicated yet
+func (m *sqlStore) readFromReplica(
+	ctx context.Context,
+	operation string,
+	f func(ctx context.Context, db sqlplugin.DB) error,
+) error {
+
+	if m.readDB != nil {
+		err := m.readFromReplicaDB(ctx, m.readDB, f)
+		switch err {
+		case nil:
+			return nil
+		case sql.ErrNoRows:
+			m.logger.Debug("Read replica operation found no rows, falling back to primary database.",
+				tag.Name(operation))
+		default:
+			m.logger.Warn("Read replica operation failed, falling back to primary database.",
+				tag.Name(operation), tag.Error(err))
+		}
+	}
+	return f(ctx, m.db)
+}
+
+// readFromReplicaDB executes the operation against the read replica within its share of the ctx deadline
+func (m *sqlStore) readFromReplicaDB(
+	ctx context.Context,
+	readDB sqlplugin.DB,
+	f func(ctx context.Context, db sqlplugin.DB) error,
+) error {
+
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout := time.Duration(float64(time.Until(deadline)) * readReplicaTimeoutRatio)
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	return f(ctx, readDB)
 }
 
 func (m *sqlStore) txExecute(ctx context.Context, operation string, f func(tx sqlplugin.Tx) error) error {
@@ -0,0 +1,145 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+)
+
+type (
+	readReplicaSuite struct {
+		*require.Assertions
+		suite.Suite
+
+		primary *testDB
+		replica *testDB
+		store   *sqlStore
+	}
+
+	testDB struct {
+		sqlplugin.DB
+		name string
+	}
+)
+
+func TestReadReplicaSuite(t *testing.T) {
+	suite.Run(t, new(readReplicaSuite))
+}
+
+func (s *readReplicaSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.primary = &testDB{name: "primary"}
+	s.replica = &testDB{name: "replica"}
+	s.store = &sqlStore{
+		db:     s.primary,
+		readDB: s.replica,
+		logger: log.NewNoop(),
+	}
+}
+
+func (s *readReplicaSuite) TestReadFromReplica_NoReplica() {
+	s.store.readDB = nil
+
+	var dbs []sqlplugin.DB
+	err := s.store.readFromReplica(context.Background(), "test", func(ctx context.Context, db sqlplugin.DB) error {
+		dbs = append(dbs, db)
+		return nil
+	})
+	s.NoError(err)
+	s.Equal([]sqlplugin.DB{s.primary}, dbs)
+}
+
+func (s *readReplicaSuite) TestReadFromReplica_Success() {
+	var dbs []sqlplugin.DB
+	err := s.store.readFromReplica(context.Background(), "test", func(ctx context.Context, db sqlplugin.DB) error {
+		dbs = append(dbs, db)
+		return nil
+	})
+	s.NoError(err)
+	s.Equal([]sqlplugin.DB{s.replica}, dbs)
+}
+
+func (s *readReplicaSuite) TestReadFromReplica_NoRows() {
+	var dbs []sqlplugin.DB
+	err := s.store.readFromReplica(context.Background(), "test", func(ctx context.Context, db sqlplugin.DB) error {
+		dbs = append(dbs, db)
+		if db == s.replica {
+			return sql.ErrNoRows
+		}
+		return nil
+	})
+	s.NoError(err)
+	s.Equal([]sqlplugin.DB{s.replica, s.primary}, dbs)
+
+	dbs = nil
+	err = s.store.readFromReplica(context.Background(), "test", func(ctx context.Context, db sqlplugin.DB) error {
+		dbs = append(dbs, db)
+		return sql.ErrNoRows
+	})
+	s.Equal(sql.ErrNoRows, err)
+	s.Equal([]sqlplugin.DB{s.replica, s.primary}, dbs)
+}
+
+func (s *readReplicaSuite) TestReadFromReplica_Error() {
+	var dbs []sqlplugin.DB
+	err := s.store.readFromReplica(context.Background(), "test", func(ctx context.Context, db sqlplugin.DB) error {
+		dbs = append(dbs, db)
+		if db == s.replica {
+			return errors.New("replica unavailable")
+		}
+		return nil
+	})
+	s.NoError(err)
+	s.Equal([]sqlplugin.DB{s.replica, s.primary}, dbs)
+}
+
+func (s *readReplicaSuite) TestReadFromReplica_Timeout() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	var dbs []sqlplugin.DB
+	err := s.store.readFromReplica(ctx, "test", func(ctx context.Context, db sqlplugin.DB) error {
+		dbs = append(dbs, db)
+		if db == s.replica {
+			// the replica hangs until its sub-deadline
+			<-ctx.Done()
+			return ctx.Err()
+		}
+		// the primary still has the rest of the deadline
+		return ctx.Err()
+	})
+	s.NoError(err)
+	s.Equal([]sqlplugin.DB{s.replica, s.primary}, dbs)
+}
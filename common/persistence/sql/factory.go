@@ -48,8 +48,11 @@ type (
 		cfg              config.SQL
 		mainDBConn       dbConn
 		visibilityDBConn dbConn
-		clusterName      string
-		logger           log.Logger
+		// mainReadDBConn and visibilityReadDBConn are nil if no read replica is configured
+		mainReadDBConn       *dbConn
+		visibilityReadDBConn *dbConn
+		clusterName          string
+		logger               log.Logger
 	}
 
 	// dbConn represents a logical mysql connection - its a
@@ -75,13 +78,22 @@ func NewFactory(
 	clusterName string,
 	logger log.Logger,
 ) *Factory {
-	return &Factory{
+	factory := &Factory{
 		cfg:              cfg,
 		clusterName:      clusterName,
 		logger:           logger,
 		mainDBConn:       newRefCountedDBConn(sqlplugin.DbKindMain, &cfg, r),
 		visibilityDBConn: newRefCountedDBConn(sqlplugin.DbKindVisibility, &cfg, r),
 	}
+	if cfg.ReadReplicaConnectAddr != "" {
+		readReplicaCfg := cfg
+		readReplicaCfg.ConnectAddr = cfg.ReadReplicaConnectAddr
+		mainReadDBConn := newRefCountedDBConn(sqlplugin.DbKindMain, &readReplicaCfg, r)
+		factory.mainReadDBConn = &mainReadDBConn
+		visibilityReadDBConn := newRefCountedDBConn(sqlplugin.DbKindVisibility, &readReplicaCfg, r)
+		factory.visibilityReadDBConn = &visibilityReadDBConn
+	}
+	return factory
 }
 
 // NewTaskStore returns a new task store
@@ -108,7 +120,12 @@ func (f *Factory) NewHistoryStore() (p.HistoryStore, error) {
 	if err != nil {
 		return nil, err
 	}
-	return newHistoryV2Persistence(conn, f.logger)
+	readConn, err := f.getReadDBConn(f.mainReadDBConn)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return newHistoryV2Persistence(conn, readConn, f.logger)
 }
 
 // NewMetadataStore returns a new metadata store
@@ -144,7 +161,12 @@ func (f *Factory) NewVisibilityStore() (p.VisibilityStore, error) {
 	if err != nil {
 		return nil, err
 	}
-	return NewSQLVisibilityStore(conn, f.logger)
+	readConn, err := f.getReadDBConn(f.visibilityReadDBConn)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return NewSQLVisibilityStore(conn, readConn, f.logger)
 }
 
 // NewQueue returns a new queue backed by sql
@@ -161,6 +183,20 @@ func (f *Factory) NewQueue(queueType p.QueueType) (p.Queue, error) {
 func (f *Factory) Close() {
 	f.mainDBConn.forceClose()
 	f.visibilityDBConn.forceClose()
+	if f.mainReadDBConn != nil {
+		f.mainReadDBConn.forceClose()
+	}
+	if f.visibilityReadDBConn != nil {
+		f.visibilityReadDBConn.forceClose()
+	}
+}
+
+// getReadDBConn returns the read replica connection, or nil if no read replica is configured
+func (f *Factory) getReadDBConn(readDBConn *dbConn) (sqlplugin.DB, error) {
+	if readDBConn == nil {
+		return nil, nil
+	}
+	return readDBConn.get()
 }
 
 // newRefCountedDBConn returns a  logical mysql connection that
@@ -25,6 +25,7 @@
 package sql
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 
@@ -48,12 +49,14 @@ type sqlHistoryV2Manager struct {
 // newHistoryV2Persistence creates an instance of HistoryManager
 func newHistoryV2Persistence(
 	db sqlplugin.DB,
+	readDB sqlplugin.DB,
 	logger log.Logger,
 ) (p.HistoryStore, error) {
 
 	return &sqlHistoryV2Manager{
 		sqlStore: sqlStore{
 			db:     db,
+			readDB: readDB,
 			logger: logger,
 		},
 	}, nil
@@ -183,17 +186,33 @@ func (m *sqlHistoryV2Manager) ReadHistoryBranch(
 		minNodeID = lastNodeID + 1
 	}
 
-	rows, err := m.db.SelectFromHistoryNode(ctx, sqlplugin.HistoryNodeSelectFilter{
-		ShardID:   request.ShardID,
-		TreeID:    treeIDBytes,
-		BranchID:  branchIDBytes,
-		MinNodeID: minNodeID,
-		MaxNodeID: maxNodeID,
-		PageSize:  request.PageSize,
-	})
-	if err == sql.ErrNoRows || (err == nil && len(rows) == 0) {
+	var rows []sqlplugin.HistoryNodeRow
+	selectFromHistoryNode := func(ctx context.Context, db sqlplugin.DB) error {
+		var err error
+		rows, err = db.SelectFromHistoryNode(ctx, sqlplugin.HistoryNodeSelectFilter{
+			ShardID:   request.ShardID,
+			TreeID:    treeIDBytes,
+			BranchID:  branchIDBytes,
+			MinNodeID: minNodeID,
+			MaxNodeID: maxNodeID,
+			PageSize:  request.PageSize,
+		})
+		if err == nil && len(rows) == 0 {
+			return sql.ErrNoRows
+		}
+		return err
+	}
+	if request.ReadFromReplica {
+		err = m.readFromReplica(ctx, "SelectFromHistoryNode", selectFromHistoryNode)
+	} else {
+		err = selectFromHistoryNode(ctx, m.db)
+	}
+	if err == sql.ErrNoRows {
 		return &p.InternalReadHistoryBranchResponse{}, nil
 	}
+	if err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("ReadHistoryBranch: %v", err))
+	}
 
 	history := make([]*commonpb.DataBlob, 0, request.PageSize)
 
@@ -25,6 +25,7 @@
 package sql
 
 import (
+	"context"
 	"database/sql"
 	"encoding/json"
 	"fmt"
@@ -52,11 +53,13 @@ type (
 // NewSQLVisibilityStore creates an instance of ExecutionStore
 func NewSQLVisibilityStore(
 	db sqlplugin.DB,
+	readDB sqlplugin.DB,
 	logger log.Logger,
 ) (p.VisibilityStore, error) {
 	return &sqlVisibilityStore{
 		sqlStore: sqlStore{
 			db:     db,
+			readDB: readDB,
 			logger: logger,
 		},
 	}, nil
@@ -145,7 +148,7 @@ func (s *sqlVisibilityStore) ListOpenWorkflowExecutions(
 		false,
 		func(readLevel *visibilityPageToken) ([]sqlplugin.VisibilityRow, error) {
 			minStartTime := time.Unix(0, request.EarliestStartTime).UTC()
-			return s.db.SelectFromVisibility(ctx, sqlplugin.VisibilitySelectFilter{
+			return s.selectFromVisibility(ctx, sqlplugin.VisibilitySelectFilter{
 				NamespaceID: request.NamespaceID,
 				MinTime:     &minStartTime,
 				MaxTime:     &readLevel.Time,
@@ -168,7 +171,7 @@ func (s *sqlVisibilityStore) ListClosedWorkflowExecutions(
 		true,
 		func(readLevel *visibilityPageToken) ([]sqlplugin.VisibilityRow, error) {
 			minStartTime := time.Unix(0, request.EarliestStartTime).UTC()
-			return s.db.SelectFromVisibility(ctx, sqlplugin.VisibilitySelectFilter{
+			return s.selectFromVisibility(ctx, sqlplugin.VisibilitySelectFilter{
 				NamespaceID: request.NamespaceID,
 				MinTime:     &minStartTime,
 				MaxTime:     &readLevel.Time,
@@ -190,7 +193,7 @@ func (s *sqlVisibilityStore) ListOpenWorkflowExecutionsByType(
 		false,
 		func(readLevel *visibilityPageToken) ([]sqlplugin.VisibilityRow, error) {
 			minStartTime := time.Unix(0, request.EarliestStartTime).UTC()
-			return s.db.SelectFromVisibility(ctx, sqlplugin.VisibilitySelectFilter{
+			return s.selectFromVisibility(ctx, sqlplugin.VisibilitySelectFilter{
 				NamespaceID:      request.NamespaceID,
 				MinTime:          &minStartTime,
 				MaxTime:          &readLevel.Time,
@@ -214,7 +217,7 @@ func (s *sqlVisibilityStore) ListClosedWorkflowExecutionsByType(
 		true,
 		func(readLevel *visibilityPageToken) ([]sqlplugin.VisibilityRow, error) {
 			minStartTime := time.Unix(0, request.EarliestStartTime).UTC()
-			return s.db.SelectFromVisibility(ctx, sqlplugin.VisibilitySelectFilter{
+			return s.selectFromVisibility(ctx, sqlplugin.VisibilitySelectFilter{
 				NamespaceID:      request.NamespaceID,
 				MinTime:          &minStartTime,
 				MaxTime:          &readLevel.Time,
@@ -237,7 +240,7 @@ func (s *sqlVisibilityStore) ListOpenWorkflowExecutionsByWorkflowID(
 		false,
 		func(readLevel *visibilityPageToken) ([]sqlplugin.VisibilityRow, error) {
 			minStartTime := time.Unix(0, request.EarliestStartTime).UTC()
-			return s.db.SelectFromVisibility(ctx, sqlplugin.VisibilitySelectFilter{
+			return s.selectFromVisibility(ctx, sqlplugin.VisibilitySelectFilter{
 				NamespaceID: request.NamespaceID,
 				MinTime:     &minStartTime,
 				MaxTime:     &readLevel.Time,
@@ -261,7 +264,7 @@ func (s *sqlVisibilityStore) ListClosedWorkflowExecutionsByWorkflowID(
 		true,
 		func(readLevel *visibilityPageToken) ([]sqlplugin.VisibilityRow, error) {
 			minStartTime := time.Unix(0, request.EarliestStartTime).UTC()
-			return s.db.SelectFromVisibility(ctx, sqlplugin.VisibilitySelectFilter{
+			return s.selectFromVisibility(ctx, sqlplugin.VisibilitySelectFilter{
 				NamespaceID: request.NamespaceID,
 				MinTime:     &minStartTime,
 				MaxTime:     &readLevel.Time,
@@ -284,7 +287,7 @@ func (s *sqlVisibilityStore) ListClosedWorkflowExecutionsByStatus(
 		true,
 		func(readLevel *visibilityPageToken) ([]sqlplugin.VisibilityRow, error) {
 			minStartTime := time.Unix(0, request.EarliestStartTime).UTC()
-			return s.db.SelectFromVisibility(ctx, sqlplugin.VisibilitySelectFilter{
+			return s.selectFromVisibility(ctx, sqlplugin.VisibilitySelectFilter{
 				NamespaceID: request.NamespaceID,
 				MinTime:     &minStartTime,
 				MaxTime:     &readLevel.Time,
@@ -301,7 +304,7 @@ func (s *sqlVisibilityStore) GetClosedWorkflowExecution(
 	ctx, cancel := newVisibilityContext()
 	defer cancel()
 	execution := request.Execution
-	rows, err := s.db.SelectFromVisibility(ctx, sqlplugin.VisibilitySelectFilter{
+	rows, err := s.selectFromVisibility(ctx, sqlplugin.VisibilitySelectFilter{
 		NamespaceID: request.NamespaceID,
 		RunID:       &execution.RunId,
 	})
@@ -434,6 +437,19 @@ func (s *sqlVisibilityStore) listWorkflowExecutions(
 	}, nil
 }
 
+func (s *sqlVisibilityStore) selectFromVisibility(
+	ctx context.Context,
+	filter sqlplugin.VisibilitySelectFilter,
+) ([]sqlplugin.VisibilityRow, error) {
+	var rows []sqlplugin.VisibilityRow
+	err := s.readFromReplica(ctx, "SelectFromVisibility", func(ctx context.Context, db sqlplugin.DB) error {
+		var err error
+		rows, err = db.SelectFromVisibility(ctx, filter)
+		return err
+	})
+	return rows, err
+}
+
 func (s *sqlVisibilityStore) deserializePageToken(
 	data []byte,
 ) (*visibilityPageToken, error) {
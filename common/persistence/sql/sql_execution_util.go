@@ -875,6 +875,9 @@ func createReplicationTasks(
 			info.Version = task.GetVersion()
 			info.ScheduledId = task.(*p.SyncActivityTask).ScheduledID
 
+		case enumsspb.TASK_TYPE_REPLICATION_SYNC_WORKFLOW_STATE:
+			info.Version = task.GetVersion()
+
 		default:
 			return serviceerror.NewInternal(fmt.Sprintf("Unknown replication task: %v", task.GetType()))
 		}
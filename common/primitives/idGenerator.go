@@ -0,0 +1,106 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package primitives
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"time"
+)
+
+const (
+	// IDGeneratorUUID generates random version 4 UUIDs, this is the default
+	IDGeneratorUUID = "uuid"
+	// IDGeneratorUUIDv7 generates version 7 UUIDs. The first 48 bits are the creation time in milliseconds,
+	// so IDs generated in later milliseconds sort after earlier ones, both as strings and in stores which
+	// order UUIDs by version and then by their bytes. IDs generated within the same millisecond are unordered.
+	IDGeneratorUUIDv7 = "uuidv7"
+
+	uuidv7TimestampSize = 6
+)
+
+type (
+	// IDGenerator generates the run IDs, request IDs and query task IDs created by the server
+	IDGenerator interface {
+		NewID() string
+	}
+
+	uuidGenerator struct{}
+
+	uuidv7Generator struct {
+		now func() time.Time
+	}
+)
+
+// NewIDGenerator returns the ID generator with the given name, an empty name selects the default
+func NewIDGenerator(name string) (IDGenerator, error) {
+	switch name {
+	case "", IDGeneratorUUID:
+		return NewUUIDGenerator(), nil
+	case IDGeneratorUUIDv7:
+		return uuidv7Generator{now: time.Now}, nil
+	default:
+		return nil, fmt.Errorf("unknown id generator %v", name)
+	}
+}
+
+// NewUUIDGenerator returns the default ID generator, which generates random version 4 UUIDs
+func NewUUIDGenerator() IDGenerator {
+	return uuidGenerator{}
+}
+
+// UUIDv7Time returns the creation time encoded in a version 7 UUID
+func UUIDv7Time(id string) (time.Time, error) {
+	u, err := ParseUUID(id)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if len(u) != 16 || u[6]>>4 != 7 {
+		return time.Time{}, fmt.Errorf("%q is not a version 7 uuid", id)
+	}
+	var buf [8]byte
+	copy(buf[8-uuidv7TimestampSize:], u[:uuidv7TimestampSize])
+	ms := int64(binary.BigEndian.Uint64(buf[:]))
+	return time.Unix(0, ms*int64(time.Millisecond)).UTC(), nil
+}
+
+func (uuidGenerator) NewID() string {
+	return NewUUID().String()
+}
+
+func (g uuidv7Generator) NewID() string {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], uint64(g.now().UnixNano()/int64(time.Millisecond)))
+
+	u := make(UUID, 16)
+	copy(u[:uuidv7TimestampSize], buf[8-uuidv7TimestampSize:])
+	if _, err := rand.Read(u[uuidv7TimestampSize:]); err != nil {
+		panic(fmt.Sprintf("unable to read random bytes for uuid: %v", err))
+	}
+	u[6] = (u[6] & 0x0f) | 0x70 // version 7
+	u[8] = (u[8] & 0x3f) | 0x80 // RFC 4122 variant
+	return u.String()
+}
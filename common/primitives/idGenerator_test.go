@@ -0,0 +1,79 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package primitives
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type IDGeneratorSuite struct {
+	suite.Suite
+}
+
+func TestIDGeneratorSuite(t *testing.T) {
+	suite.Run(t, new(IDGeneratorSuite))
+}
+
+func (s *IDGeneratorSuite) TestNewIDGenerator() {
+	for _, name := range []string{"", IDGeneratorUUID, IDGeneratorUUIDv7} {
+		generator, err := NewIDGenerator(name)
+		s.NoError(err)
+		_, err = ParseUUID(generator.NewID())
+		s.NoError(err)
+	}
+
+	_, err := NewIDGenerator("unknown")
+	s.Error(err)
+}
+
+func (s *IDGeneratorSuite) TestUUIDv7() {
+	now := time.Date(2020, 11, 4, 10, 30, 0, 0, time.UTC)
+	generator := uuidv7Generator{now: func() time.Time { return now }}
+
+	var ids []string
+	for i := 0; i < 10; i++ {
+		ids = append(ids, generator.NewID())
+		now = now.Add(time.Millisecond)
+	}
+	s.True(sort.StringsAreSorted(ids))
+
+	for _, id := range ids {
+		u, err := ParseUUID(id)
+		s.NoError(err)
+		s.Equal(byte(7), u[6]>>4)
+		s.Equal(byte(0x80), u[8]&0xc0)
+	}
+
+	createTime, err := UUIDv7Time(ids[0])
+	s.NoError(err)
+	s.Equal(time.Date(2020, 11, 4, 10, 30, 0, 0, time.UTC), createTime)
+
+	_, err = UUIDv7Time(uuidGenerator{}.NewID())
+	s.Error(err)
+}
@@ -32,6 +32,7 @@ import (
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/provider"
 	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/claimcheck"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/elasticsearch"
 	"go.temporal.io/server/common/log"
@@ -39,6 +40,7 @@ import (
 	"go.temporal.io/server/common/messaging"
 	"go.temporal.io/server/common/metrics"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
+	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/resolver"
 	"go.temporal.io/server/common/service/config"
 	"go.temporal.io/server/common/service/dynamicconfig"
@@ -69,9 +71,13 @@ type (
 		PublicClient                 sdkclient.Client
 		ArchivalMetadata             archiver.ArchivalMetadata
 		ArchiverProvider             provider.ArchiverProvider
+		ClaimCheckStore              claimcheck.Store
+		IDGenerator                  primitives.IDGenerator
 		Authorizer                   authorization.Authorizer
+		OperatorAuthorizer           authorization.Authorizer
 		ClaimMapper                  authorization.ClaimMapper
 		PersistenceServiceResolver   resolver.ServiceResolver
+		NamespaceBootstrap           []config.NamespaceBootstrap
 	}
 
 	// MembershipMonitorFactory provides a bootstrapped membership monitor
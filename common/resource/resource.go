@@ -46,6 +46,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
+	"go.temporal.io/server/common/primitives"
 )
 
 type (
@@ -65,6 +66,7 @@ type (
 
 		GetNamespaceCache() cache.NamespaceCache
 		GetTimeSource() clock.TimeSource
+		GetIDGenerator() primitives.IDGenerator
 		GetPayloadSerializer() persistence.PayloadSerializer
 		GetMetricsClient() metrics.Client
 		GetArchiverProvider() provider.ArchiverProvider
@@ -54,6 +54,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
+	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/service/dynamicconfig"
 )
 
@@ -83,6 +84,7 @@ type (
 
 		namespaceCache    cache.NamespaceCache
 		timeSource        clock.TimeSource
+		idGenerator       primitives.IDGenerator
 		payloadSerializer persistence.PayloadSerializer
 		metricsClient     metrics.Client
 		messagingClient   messaging.Client
@@ -286,6 +288,11 @@ func New(
 		return nil, err
 	}
 
+	idGenerator := params.IDGenerator
+	if idGenerator == nil {
+		idGenerator = primitives.NewUUIDGenerator()
+	}
+
 	impl = &Impl{
 		status: common.DaemonStatusInitialized,
 
@@ -301,6 +308,7 @@ func New(
 
 		namespaceCache:    namespaceCache,
 		timeSource:        clock.NewRealTimeSource(),
+		idGenerator:       idGenerator,
 		payloadSerializer: persistence.NewPayloadSerializer(),
 		metricsClient:     params.MetricsClient,
 		messagingClient:   params.MessagingClient,
@@ -436,6 +444,11 @@ func (h *Impl) GetTimeSource() clock.TimeSource {
 	return h.timeSource
 }
 
+// GetIDGenerator return the generator of run IDs, request IDs and query task IDs
+func (h *Impl) GetIDGenerator() primitives.IDGenerator {
+	return h.idGenerator
+}
+
 // GetPayloadSerializer return binary payload serializer
 func (h *Impl) GetPayloadSerializer() persistence.PayloadSerializer {
 	return h.payloadSerializer
@@ -57,6 +57,7 @@ import (
 	"go.temporal.io/server/common/mocks"
 	"go.temporal.io/server/common/persistence"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
+	"go.temporal.io/server/common/primitives"
 )
 
 type (
@@ -69,6 +70,7 @@ type (
 
 		NamespaceCache    *cache.MockNamespaceCache
 		TimeSource        clock.TimeSource
+		IDGenerator       primitives.IDGenerator
 		PayloadSerializer persistence.PayloadSerializer
 		MetricsClient     metrics.Client
 		ArchivalMetadata  *archiver.MockArchivalMetadata
@@ -182,6 +184,7 @@ func NewTest(
 
 		NamespaceCache:    cache.NewMockNamespaceCache(controller),
 		TimeSource:        clock.NewRealTimeSource(),
+		IDGenerator:       primitives.NewUUIDGenerator(),
 		PayloadSerializer: persistence.NewPayloadSerializer(),
 		MetricsClient:     metrics.NewClient(scope, serviceMetricsIndex),
 		ArchivalMetadata:  archiver.NewMockArchivalMetadata(controller),
@@ -272,6 +275,11 @@ func (s *Test) GetTimeSource() clock.TimeSource {
 	return s.TimeSource
 }
 
+// GetIDGenerator for testing
+func (s *Test) GetIDGenerator() primitives.IDGenerator {
+	return s.IDGenerator
+}
+
 // GetPayloadSerializer for testing
 func (s *Test) GetPayloadSerializer() persistence.PayloadSerializer {
 	return s.PayloadSerializer
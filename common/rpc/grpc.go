@@ -34,6 +34,8 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/backoff"
 	"google.golang.org/grpc/credentials"
+	// register gzip compressor so that both clients and servers can use gzip compression
+	_ "google.golang.org/grpc/encoding/gzip"
 
 	"go.temporal.io/server/common/headers"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
@@ -47,9 +47,10 @@ type RPCFactory struct {
 	logger      log.Logger
 
 	sync.Mutex
-	grpcListener   net.Listener
-	ringpopChannel *tchannel.Channel
-	tlsFactory     encryption.TLSConfigProvider
+	grpcListener         net.Listener
+	operatorGRPCListener net.Listener
+	ringpopChannel       *tchannel.Channel
+	tlsFactory           encryption.TLSConfigProvider
 }
 
 // NewFactory builds a new RPCFactory
@@ -137,6 +138,33 @@ func (d *RPCFactory) GetGRPCListener() net.Listener {
 	return d.grpcListener
 }
 
+// GetOperatorGRPCListener returns the listener for operator APIs, or nil if no operator port is configured
+func (d *RPCFactory) GetOperatorGRPCListener() net.Listener {
+	if d.config.OperatorGRPCPort == 0 {
+		return nil
+	}
+	if d.operatorGRPCListener != nil {
+		return d.operatorGRPCListener
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	if d.operatorGRPCListener == nil {
+		hostAddress := fmt.Sprintf("%v:%v", getListenIP(d.config, d.logger), d.config.OperatorGRPCPort)
+		var err error
+		d.operatorGRPCListener, err = net.Listen("tcp", hostAddress)
+
+		if err != nil {
+			d.logger.Fatal("Failed to start operator gRPC listener", tag.Error(err), tag.Service(d.serviceName), tag.Address(hostAddress))
+		}
+
+		d.logger.Info("Created operator gRPC listener", tag.Service(d.serviceName), tag.Address(hostAddress))
+	}
+
+	return d.operatorGRPCListener
+}
+
 // GetRingpopChannel return a cached ringpop dispatcher
 func (d *RPCFactory) GetRingpopChannel() *tchannel.Channel {
 	if d.ringpopChannel != nil {
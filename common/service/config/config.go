@@ -58,6 +58,8 @@ type (
 		Kafka messaging.KafkaConfig `yaml:"kafka"`
 		// Archival is the config for archival
 		Archival Archival `yaml:"archival"`
+		// ClaimCheck is the config for offloading large payloads to blob storage
+		ClaimCheck ClaimCheck `yaml:"claimCheck"`
 		// PublicClient is config for connecting to temporal frontend
 		PublicClient PublicClient `yaml:"publicClient"`
 		// DynamicConfigClient is the config for setting up the file based dynamic config client
@@ -65,6 +67,8 @@ type (
 		DynamicConfigClient dynamicconfig.FileBasedClientConfig `yaml:"dynamicConfigClient"`
 		// NamespaceDefaults is the default config for every namespace
 		NamespaceDefaults NamespaceDefaults `yaml:"namespaceDefaults"`
+		// Namespaces is the list of namespaces which are created or updated by frontend at startup
+		Namespaces []NamespaceBootstrap `yaml:"namespaces"`
 	}
 
 	// Service contains the service specific config items
@@ -85,6 +89,11 @@ type (
 	RPC struct {
 		// GRPCPort is the port  on which gRPC will listen
 		GRPCPort int `yaml:"grpcPort"`
+		// OperatorGRPCPort is the port on which frontend serves operator (admin service) APIs.
+		// If set, admin service APIs are no longer served on GRPCPort. Only used by frontend.
+		// Clusters call admin service APIs through the operatorRpcAddress of the cluster information,
+		// which must then point to this port.
+		OperatorGRPCPort int `yaml:"operatorGrpcPort"`
 		// Port used for membership listener
 		MembershipPort int `yaml:"membershipPort"`
 		// BindOnLocalHost is true if localhost is the bind address
@@ -107,6 +116,10 @@ type (
 		Metrics *Metrics `yaml:"metrics"`
 		// Settings for authentication and authorization
 		Authorization Authorization `yaml:"authorization"`
+		// Secrets is the key provider config used to decrypt KMS encrypted config values
+		Secrets Secrets `yaml:"secrets"`
+		// IDGenerator is the generator of the run IDs, request IDs and query task IDs created by the server, uuid (default) or uuidv7
+		IDGenerator string `yaml:"idGenerator"`
 	}
 
 	// RootTLS contains all TLS settings for the Temporal server
@@ -216,6 +229,25 @@ type (
 		VisibilityConfig *VisibilityConfig `yaml:"-" json:"-"`
 		// TransactionSizeLimit is the largest allowed transaction size
 		TransactionSizeLimit dynamicconfig.IntPropertyFn `yaml:"-" json:"-"`
+		// EnableFaultInjection installs the persistence fault injection layer, used for chaos testing only.
+		// Faults are then injected while the persistenceFaultInjectionEnabled dynamic config is on
+		EnableFaultInjection bool `yaml:"enableFaultInjection"`
+		// FaultInjection is config for injecting persistence faults, only set if EnableFaultInjection is true
+		FaultInjection *FaultInjectionConfig `yaml:"-" json:"-"`
+		// StartupRetry is the policy for retrying datastore connectivity at startup, startup fails
+		// on the first error if it is not set
+		StartupRetry *StartupRetry `yaml:"startupRetry"`
+	}
+
+	// StartupRetry is the backoff policy for waiting on datastores to become available at startup. Only errors
+	// which indicate an unreachable datastore are retried, the frontend health check reports NOT_SERVING meanwhile
+	StartupRetry struct {
+		// InitialInterval is the backoff interval before the first retry, defaults to 1s
+		InitialInterval time.Duration `yaml:"initialInterval"`
+		// MaximumInterval is the upper bound of the backoff interval, defaults to 30s
+		MaximumInterval time.Duration `yaml:"maximumInterval"`
+		// MaximumWait is the total time to wait for datastores before startup fails
+		MaximumWait time.Duration `yaml:"maximumWait" validate:"nonzero"`
 	}
 
 	// DataStore is the configuration for a single datastore
@@ -251,6 +283,18 @@ type (
 		ESProcessorAckTimeout dynamicconfig.DurationPropertyFn `yaml:"-" json:"-"`
 	}
 
+	// FaultInjectionConfig is config for persistence fault injection
+	FaultInjectionConfig struct {
+		// Enabled turns fault injection on or off, checked on every persistence call
+		Enabled dynamicconfig.BoolPropertyFn `yaml:"-" json:"-"`
+		// ErrorRate is the probability in [0, 1] that a targeted call fails with an injected error
+		ErrorRate dynamicconfig.FloatPropertyFn `yaml:"-" json:"-"`
+		// Latency is the delay added before every targeted call
+		Latency dynamicconfig.DurationPropertyFn `yaml:"-" json:"-"`
+		// TargetAPIs is a comma separated list of persistence APIs to inject faults into, all APIs if empty
+		TargetAPIs dynamicconfig.StringPropertyFn `yaml:"-" json:"-"`
+	}
+
 	// Cassandra contains configuration to connect to Cassandra cluster
 	Cassandra struct {
 		// Hosts is a csv of cassandra endpoints
@@ -273,6 +317,25 @@ type (
 		TLS *auth.TLS `yaml:"tls"`
 		// Consistency configuration (defaults to LOCAL_QUORUM / LOCAL_SERIAL for all stores if this field not set)
 		Consistency *CassandraStoreConsistency `yaml:"consistency"`
+		// HostSelectionPolicy configuration (defaults to token aware round robin if this field not set)
+		HostSelectionPolicy *CassandraHostSelectionPolicy `yaml:"hostSelectionPolicy"`
+	}
+
+	// CassandraHostSelectionPolicy configures how the gocql client picks the coordinator host for a query
+	CassandraHostSelectionPolicy struct {
+		// Policy is the base policy used to order hosts, valid values are "roundRobin", "dcAwareRoundRobin"
+		// and "rackAwareRoundRobin" (defaults to roundRobin)
+		Policy string `yaml:"policy"`
+		// LocalDatacenter is the datacenter preferred by the dcAwareRoundRobin and rackAwareRoundRobin policies (defaults to Datacenter)
+		LocalDatacenter string `yaml:"localDatacenter"`
+		// LocalRack is the rack of LocalDatacenter preferred by the rackAwareRoundRobin policy
+		LocalRack string `yaml:"localRack"`
+		// DisableTokenAware disables routing queries to the replicas owning the partition key first
+		DisableTokenAware bool `yaml:"disableTokenAware"`
+		// ShuffleReplicas randomizes the order of the replicas picked by the token aware policy
+		ShuffleReplicas bool `yaml:"shuffleReplicas"`
+		// NonLocalReplicasFallback makes the token aware policy try replicas in remote datacenters before other local hosts
+		NonLocalReplicasFallback bool `yaml:"nonLocalReplicasFallback"`
 	}
 
 	// CassandraStoreConsistency enables you to set the consistency settings for each Cassandra Persistence Store for Temporal
@@ -302,6 +365,10 @@ type (
 		DatabaseName string `yaml:"databaseName" validate:"nonzero"`
 		// ConnectAddr is the remote addr of the database
 		ConnectAddr string `yaml:"connectAddr" validate:"nonzero"`
+		// ReadReplicaConnectAddr is the optional remote addr of a read replica of the database, when set
+		// visibility queries and history reads of closed workflows are served by the replica and fall back
+		// to ConnectAddr if the replica fails or has not caught up yet
+		ReadReplicaConnectAddr string `yaml:"readReplicaConnectAddr"`
 		// ConnectProtocol is the protocol that goes with the ConnectAddr ex - tcp, unix
 		ConnectProtocol string `yaml:"connectProtocol" validate:"nonzero"`
 		// ConnectAttributes is a set of key-value attributes to be sent as part of connect data_source_name url
@@ -364,6 +431,9 @@ type (
 		RPCName string `yaml:"rpcName"`
 		// Address indicate the remote service address(Host:Port). Host can be DNS name.
 		RPCAddress string `yaml:"rpcAddress"`
+		// OperatorRPCAddress indicate the remote address(Host:Port) serving admin service APIs,
+		// required if the remote frontend serves them on a separate operator port. Defaults to RPCAddress.
+		OperatorRPCAddress string `yaml:"operatorRpcAddress"`
 	}
 
 	// ReplicationTaskProcessorConfig is the config for replication task processor.
@@ -456,6 +526,31 @@ type (
 		DirMode  string `yaml:"dirMode"`
 	}
 
+	// ClaimCheck contains the config for offloading large payloads out of history into blob storage.
+	// The store must be reachable from every frontend host, and from the frontends of every cluster
+	// histories are replicated to, since references are resolved wherever the history is read.
+	ClaimCheck struct {
+		// Filestore keeps offloaded payloads on a file system, which must be shared by all frontend hosts
+		Filestore *FilestoreClaimCheck `yaml:"filestore"`
+		// S3store keeps offloaded payloads in an S3 bucket
+		S3store *S3ClaimCheck `yaml:"s3store"`
+	}
+
+	// FilestoreClaimCheck contains the config for the filestore claim check store
+	FilestoreClaimCheck struct {
+		Directory string `yaml:"directory"`
+		FileMode  string `yaml:"fileMode"`
+		DirMode   string `yaml:"dirMode"`
+	}
+
+	// S3ClaimCheck contains the config for the s3 claim check store
+	S3ClaimCheck struct {
+		Region           string  `yaml:"region"`
+		Endpoint         *string `yaml:"endpoint"`
+		S3ForcePathStyle bool    `yaml:"s3ForcePathStyle"`
+		Bucket           string  `yaml:"bucket"`
+	}
+
 	// GstorageArchiver contain the config for google storage archiver
 	GstorageArchiver struct {
 		CredentialsPath string `yaml:"credentialsPath"`
@@ -506,6 +601,28 @@ type (
 		URI string `yaml:"URI"`
 	}
 
+	// NamespaceBootstrap is the declarative config of a namespace which is registered at startup if it
+	// does not exist yet, or updated if its settings deviate from config. Empty fields are left untouched.
+	NamespaceBootstrap struct {
+		// Name is the name of the namespace
+		Name string `yaml:"name"`
+		// Description is the description of the namespace
+		Description string `yaml:"description"`
+		// OwnerEmail is the email of the namespace owner
+		OwnerEmail string `yaml:"ownerEmail"`
+		// Retention is the workflow execution retention period of the namespace
+		Retention time.Duration `yaml:"retention"`
+		// IsGlobalNamespace is whether the namespace is registered as a global namespace, it can't be changed once registered
+		IsGlobalNamespace bool `yaml:"isGlobalNamespace"`
+		// Data is the set of key-value pairs attached to the namespace, keys not listed here are preserved
+		Data map[string]string `yaml:"data"`
+		// Archival is the archival state and URI of the namespace
+		Archival ArchivalNamespaceDefaults `yaml:"archival"`
+		// SearchAttributes maps the search attributes used by the namespace to their type (Keyword, String, Int,
+		// Double, Bool or Datetime). Search attributes are cluster scoped, missing ones are added for every namespace.
+		SearchAttributes map[string]string `yaml:"searchAttributes"`
+	}
+
 	Authorization struct {
 		// Signing key provider for validating JWT tokens
 		JWTKeyProvider       JWTKeyProvider `yaml:"jwtKeyProvider"`
@@ -514,6 +631,9 @@ type (
 		Authorizer string `yaml:"authorizer"`
 		// Empty string for noopClaimMapper or "default" for defaultJWTClaimMapper
 		ClaimMapper string `yaml:"claimMapper"`
+		// Authorizer for operator (admin service) APIs, accepts the same values as Authorizer.
+		// Empty string means operator APIs are authorized by Authorizer.
+		OperatorAuthorizer string `yaml:"operatorAuthorizer"`
 	}
 
 	// @@@SNIPSTART temporal-common-service-config-jwtkeyprovider
@@ -535,6 +655,10 @@ func (c *Config) Validate() error {
 		return err
 	}
 
+	if err := validateNamespaceBootstrap(c.Namespaces); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -551,3 +675,11 @@ func (c *Config) String() string {
 func (r *GroupTLS) IsEnabled() bool {
 	return r.Server.KeyFile != "" || r.Server.KeyData != ""
 }
+
+// GetOperatorRPCAddress returns the address serving admin service APIs of the cluster
+func (c *ClusterInformation) GetOperatorRPCAddress() string {
+	if c.OperatorRPCAddress != "" {
+		return c.OperatorRPCAddress
+	}
+	return c.RPCAddress
+}
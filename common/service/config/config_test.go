@@ -36,3 +36,11 @@ func TestToString(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotEmpty(t, cfg.String())
 }
+
+func TestClusterInformation_GetOperatorRPCAddress(t *testing.T) {
+	info := ClusterInformation{RPCAddress: "127.0.0.1:7233"}
+	assert.Equal(t, "127.0.0.1:7233", info.GetOperatorRPCAddress())
+
+	info.OperatorRPCAddress = "127.0.0.1:7243"
+	assert.Equal(t, "127.0.0.1:7243", info.GetOperatorRPCAddress())
+}
@@ -113,6 +113,9 @@ func LoadConfig(env string, configDir string, zone string) (*Config, error) {
 	if err != nil {
 		return nil, fmt.Errorf("config file corrupted: %w", err)
 	}
+	if err := config.DecryptSecrets(); err != nil {
+		return nil, fmt.Errorf("unable to decrypt config secrets: %w", err)
+	}
 	return &config, nil
 }
 
@@ -0,0 +1,81 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	enumspb "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/server/common"
+)
+
+func validateNamespaceBootstrap(namespaces []NamespaceBootstrap) error {
+	names := make(map[string]struct{}, len(namespaces))
+	for _, ns := range namespaces {
+		if len(ns.Name) == 0 {
+			return errors.New("namespaces config: name must be set")
+		}
+		if _, ok := names[ns.Name]; ok {
+			return fmt.Errorf("namespaces config: namespace %v is defined more than once", ns.Name)
+		}
+		names[ns.Name] = struct{}{}
+
+		if ns.Retention < 0 {
+			return fmt.Errorf("namespaces config: namespace %v: retention must not be negative", ns.Name)
+		}
+		if !isNamespaceBootstrapArchivalStateValid(ns.Archival.History.State) {
+			return fmt.Errorf("namespaces config: namespace %v: invalid history archival state %v", ns.Name, ns.Archival.History.State)
+		}
+		if !isNamespaceBootstrapArchivalStateValid(ns.Archival.Visibility.State) {
+			return fmt.Errorf("namespaces config: namespace %v: invalid visibility archival state %v", ns.Name, ns.Archival.Visibility.State)
+		}
+		for name, valueType := range ns.SearchAttributes {
+			if !isNamespaceBootstrapSearchAttributeTypeValid(valueType) {
+				return fmt.Errorf("namespaces config: namespace %v: invalid type %v of search attribute %v", ns.Name, valueType, name)
+			}
+		}
+	}
+	return nil
+}
+
+func isNamespaceBootstrapSearchAttributeTypeValid(valueType string) bool {
+	for name, value := range enumspb.IndexedValueType_value {
+		if value != int32(enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED) && strings.EqualFold(name, strings.TrimSpace(valueType)) {
+			return true
+		}
+	}
+	return false
+}
+
+func isNamespaceBootstrapArchivalStateValid(state string) bool {
+	switch strings.TrimSpace(strings.ToLower(state)) {
+	case "", common.ArchivalEnabled, common.ArchivalDisabled:
+		return true
+	}
+	return false
+}
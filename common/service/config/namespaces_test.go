@@ -0,0 +1,63 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateNamespaceBootstrap(t *testing.T) {
+	valid := NamespaceBootstrap{
+		Name:      "samples",
+		Retention: 72 * time.Hour,
+		Archival: ArchivalNamespaceDefaults{
+			History: HistoryArchivalNamespaceDefaults{State: "enabled", URI: "file:///tmp/history"},
+		},
+		SearchAttributes: map[string]string{"OrderId": "Keyword", "Amount": "double"},
+	}
+	assert.NoError(t, validateNamespaceBootstrap(nil))
+	assert.NoError(t, validateNamespaceBootstrap([]NamespaceBootstrap{valid}))
+
+	assert.Error(t, validateNamespaceBootstrap([]NamespaceBootstrap{{}}))
+	assert.Error(t, validateNamespaceBootstrap([]NamespaceBootstrap{valid, valid}))
+	assert.Error(t, validateNamespaceBootstrap([]NamespaceBootstrap{{Name: "samples", Retention: -time.Hour}}))
+	assert.Error(t, validateNamespaceBootstrap([]NamespaceBootstrap{{
+		Name: "samples",
+		Archival: ArchivalNamespaceDefaults{
+			Visibility: VisibilityArchivalNamespaceDefaults{State: "paused"},
+		},
+	}}))
+	assert.Error(t, validateNamespaceBootstrap([]NamespaceBootstrap{{
+		Name:             "samples",
+		SearchAttributes: map[string]string{"OrderId": "Unspecified"},
+	}}))
+	assert.Error(t, validateNamespaceBootstrap([]NamespaceBootstrap{{
+		Name:             "samples",
+		SearchAttributes: map[string]string{"OrderId": "text"},
+	}}))
+}
@@ -47,6 +47,17 @@ func (c *Persistence) DefaultStoreType() string {
 	return StoreTypeNoSQL
 }
 
+const (
+	// CassandraRoundRobinHostPolicy picks hosts in a round robin fashion
+	CassandraRoundRobinHostPolicy = "roundRobin"
+	// CassandraDCAwareRoundRobinHostPolicy picks hosts in the local datacenter in a round robin fashion
+	// before falling back to hosts in remote datacenters
+	CassandraDCAwareRoundRobinHostPolicy = "dcAwareRoundRobin"
+	// CassandraRackAwareRoundRobinHostPolicy picks hosts in the local rack of the local datacenter in a round robin
+	// fashion before falling back to other hosts in the local datacenter and then to hosts in remote datacenters
+	CassandraRackAwareRoundRobinHostPolicy = "rackAwareRoundRobin"
+)
+
 // Validate validates the persistence config
 func (c *Persistence) Validate() error {
 	stores := []string{c.DefaultStore, c.VisibilityStore}
@@ -114,7 +125,34 @@ func ensureStoreConsistencyNotNil(c *CassandraStoreConsistency) *CassandraStoreC
 }
 
 func (c *Cassandra) validate() error {
-	return c.Consistency.validate()
+	if err := c.Consistency.validate(); err != nil {
+		return err
+	}
+	return c.HostSelectionPolicy.validate(c.Datacenter)
+}
+
+func (c *CassandraHostSelectionPolicy) validate(datacenter string) error {
+	if c == nil {
+		return nil
+	}
+
+	switch c.Policy {
+	case "", CassandraRoundRobinHostPolicy:
+	case CassandraDCAwareRoundRobinHostPolicy:
+		if c.LocalDatacenter == "" && datacenter == "" {
+			return fmt.Errorf("cassandra host selection policy %v requires localDatacenter or datacenter to be set", c.Policy)
+		}
+	case CassandraRackAwareRoundRobinHostPolicy:
+		if c.LocalDatacenter == "" && datacenter == "" {
+			return fmt.Errorf("cassandra host selection policy %v requires localDatacenter or datacenter to be set", c.Policy)
+		}
+		if c.LocalRack == "" {
+			return fmt.Errorf("cassandra host selection policy %v requires localRack to be set", c.Policy)
+		}
+	default:
+		return fmt.Errorf("bad cassandra host selection policy: %v", c.Policy)
+	}
+	return nil
 }
 
 func (c *CassandraStoreConsistency) validate() error {
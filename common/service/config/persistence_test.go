@@ -230,3 +230,88 @@ func TestCassandraStoreConsistency_validate(t *testing.T) {
 		})
 	}
 }
+
+func TestCassandraHostSelectionPolicy_validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		settings   *CassandraHostSelectionPolicy
+		datacenter string
+		wantErr    bool
+	}{
+		{
+			name:     "nil settings",
+			settings: nil,
+			wantErr:  false,
+		},
+		{
+			name:     "empty settings",
+			settings: &CassandraHostSelectionPolicy{},
+			wantErr:  false,
+		},
+		{
+			name: "dc aware with local datacenter",
+			settings: &CassandraHostSelectionPolicy{
+				Policy:          CassandraDCAwareRoundRobinHostPolicy,
+				LocalDatacenter: "dc1",
+			},
+			wantErr: false,
+		},
+		{
+			name: "dc aware with datacenter",
+			settings: &CassandraHostSelectionPolicy{
+				Policy: CassandraDCAwareRoundRobinHostPolicy,
+			},
+			datacenter: "dc1",
+			wantErr:    false,
+		},
+		{
+			name: "dc aware without datacenter",
+			settings: &CassandraHostSelectionPolicy{
+				Policy: CassandraDCAwareRoundRobinHostPolicy,
+			},
+			wantErr: true,
+		},
+		{
+			name: "rack aware with datacenter and rack",
+			settings: &CassandraHostSelectionPolicy{
+				Policy:    CassandraRackAwareRoundRobinHostPolicy,
+				LocalRack: "rack1",
+			},
+			datacenter: "dc1",
+			wantErr:    false,
+		},
+		{
+			name: "rack aware without rack",
+			settings: &CassandraHostSelectionPolicy{
+				Policy:          CassandraRackAwareRoundRobinHostPolicy,
+				LocalDatacenter: "dc1",
+			},
+			wantErr: true,
+		},
+		{
+			name: "rack aware without datacenter",
+			settings: &CassandraHostSelectionPolicy{
+				Policy:    CassandraRackAwareRoundRobinHostPolicy,
+				LocalRack: "rack1",
+			},
+			wantErr: true,
+		},
+		{
+			name: "bad policy",
+			settings: &CassandraHostSelectionPolicy{
+				Policy: "bad_value",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := tt.settings
+			if err := c.validate(tt.datacenter); (err != nil) != tt.wantErr {
+				t.Errorf("CassandraHostSelectionPolicy.validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
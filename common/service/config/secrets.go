@@ -0,0 +1,212 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/kms"
+)
+
+const (
+	// SecretPrefixKMS is the prefix of config values which are KMS envelope encrypted.
+	// The full format is kms:<base64 encrypted data key>:<base64 AES-GCM nonce and ciphertext>
+	SecretPrefixKMS = "kms:"
+
+	// SecretsProviderAWSKMS decrypts data keys with AWS KMS
+	SecretsProviderAWSKMS = "awskms"
+)
+
+type (
+	// Secrets contains the config of the key provider used to decrypt encrypted config values
+	Secrets struct {
+		// Provider is the name of the key provider, only awskms is supported
+		Provider string `yaml:"provider"`
+		// AWSKMS is the config of the AWS KMS key provider
+		AWSKMS *AWSKMSSecrets `yaml:"awskms"`
+	}
+
+	// AWSKMSSecrets contains the config to connect to AWS KMS
+	AWSKMSSecrets struct {
+		Region   string  `yaml:"region"`
+		Endpoint *string `yaml:"endpoint"`
+	}
+
+	// KeyProvider decrypts the data key of an envelope encrypted secret
+	KeyProvider interface {
+		DecryptDataKey(encryptedDataKey []byte) ([]byte, error)
+	}
+
+	awsKMSKeyProvider struct {
+		client *kms.KMS
+	}
+)
+
+var errSecretsProviderNotConfigured = errors.New("secrets config: encrypted value found but no secrets provider is configured")
+
+// NewKeyProvider creates the key provider described by the secrets config, or returns nil if none is configured
+func (s *Secrets) NewKeyProvider() (KeyProvider, error) {
+	switch s.Provider {
+	case "":
+		return nil, nil
+	case SecretsProviderAWSKMS:
+		if s.AWSKMS == nil || len(s.AWSKMS.Region) == 0 {
+			return nil, errors.New("secrets config: awskms region must be set")
+		}
+		sess, err := session.NewSession(&aws.Config{
+			Endpoint: s.AWSKMS.Endpoint,
+			Region:   aws.String(s.AWSKMS.Region),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &awsKMSKeyProvider{client: kms.New(sess)}, nil
+	default:
+		return nil, fmt.Errorf("secrets config: unknown provider %v", s.Provider)
+	}
+}
+
+func (p *awsKMSKeyProvider) DecryptDataKey(encryptedDataKey []byte) ([]byte, error) {
+	resp, err := p.client.Decrypt(&kms.DecryptInput{CiphertextBlob: encryptedDataKey})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Plaintext, nil
+}
+
+// DecryptSecrets replaces every config string prefixed with kms: by its decrypted value,
+// so plaintext secrets never need to appear in rendered config files.
+func (c *Config) DecryptSecrets() error {
+	keyProvider, err := c.Global.Secrets.NewKeyProvider()
+	if err != nil {
+		return err
+	}
+	return decryptSecrets(reflect.ValueOf(c), keyProvider)
+}
+
+func decryptSecrets(v reflect.Value, keyProvider KeyProvider) error {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return decryptSecrets(v.Elem(), keyProvider)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if !v.Field(i).CanSet() {
+				continue
+			}
+			if err := decryptSecrets(v.Field(i), keyProvider); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := decryptSecrets(v.Index(i), keyProvider); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		for _, key := range v.MapKeys() {
+			value := v.MapIndex(key)
+			if value.Kind() != reflect.String {
+				// copy the value since map values are not addressable
+				copied := reflect.New(value.Type()).Elem()
+				copied.Set(value)
+				if err := decryptSecrets(copied, keyProvider); err != nil {
+					return err
+				}
+				v.SetMapIndex(key, copied)
+				continue
+			}
+			plaintext, err := decryptSecret(value.String(), keyProvider)
+			if err != nil {
+				return err
+			}
+			v.SetMapIndex(key, reflect.ValueOf(plaintext).Convert(value.Type()))
+		}
+	case reflect.String:
+		if !v.CanSet() {
+			return nil
+		}
+		plaintext, err := decryptSecret(v.String(), keyProvider)
+		if err != nil {
+			return err
+		}
+		v.SetString(plaintext)
+	}
+	return nil
+}
+
+func decryptSecret(value string, keyProvider KeyProvider) (string, error) {
+	if !strings.HasPrefix(value, SecretPrefixKMS) {
+		return value, nil
+	}
+	if keyProvider == nil {
+		return "", errSecretsProviderNotConfigured
+	}
+
+	parts := strings.Split(strings.TrimPrefix(value, SecretPrefixKMS), ":")
+	if len(parts) != 2 {
+		return "", errors.New("secrets config: malformed encrypted value")
+	}
+	encryptedDataKey, err := base64.StdEncoding.DecodeString(parts[0])
+	if err != nil {
+		return "", fmt.Errorf("secrets config: malformed encrypted data key: %w", err)
+	}
+	sealed, err := base64.StdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", fmt.Errorf("secrets config: malformed ciphertext: %w", err)
+	}
+
+	dataKey, err := keyProvider.DecryptDataKey(encryptedDataKey)
+	if err != nil {
+		return "", fmt.Errorf("secrets config: unable to decrypt data key: %w", err)
+	}
+	block, err := aes.NewCipher(dataKey)
+	if err != nil {
+		return "", fmt.Errorf("secrets config: invalid data key: %w", err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return "", errors.New("secrets config: malformed ciphertext")
+	}
+	plaintext, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", fmt.Errorf("secrets config: unable to decrypt value: %w", err)
+	}
+	return string(plaintext), nil
+}
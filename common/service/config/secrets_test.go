@@ -0,0 +1,116 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testKeyProvider struct {
+	encryptedDataKey []byte
+	dataKey          []byte
+}
+
+func (p *testKeyProvider) DecryptDataKey(encryptedDataKey []byte) ([]byte, error) {
+	if string(encryptedDataKey) != string(p.encryptedDataKey) {
+		return nil, assert.AnError
+	}
+	return p.dataKey, nil
+}
+
+func newTestKeyProvider(t *testing.T) *testKeyProvider {
+	dataKey := make([]byte, 32)
+	_, err := rand.Read(dataKey)
+	require.NoError(t, err)
+	return &testKeyProvider{
+		encryptedDataKey: []byte("encrypted-data-key"),
+		dataKey:          dataKey,
+	}
+}
+
+func (p *testKeyProvider) encrypt(t *testing.T, plaintext string) string {
+	block, err := aes.NewCipher(p.dataKey)
+	require.NoError(t, err)
+	gcm, err := cipher.NewGCM(block)
+	require.NoError(t, err)
+	nonce := make([]byte, gcm.NonceSize())
+	_, err = rand.Read(nonce)
+	require.NoError(t, err)
+	sealed := gcm.Seal(nonce, nonce, []byte(plaintext), nil)
+	return SecretPrefixKMS + base64.StdEncoding.EncodeToString(p.encryptedDataKey) + ":" + base64.StdEncoding.EncodeToString(sealed)
+}
+
+func TestDecryptSecrets(t *testing.T) {
+	keyProvider := newTestKeyProvider(t)
+	cfg := &Config{
+		Persistence: Persistence{
+			DataStores: map[string]DataStore{
+				"default": {
+					SQL: &SQL{
+						User:              "temporal",
+						Password:          keyProvider.encrypt(t, "sql-password"),
+						ConnectAttributes: map[string]string{"token": keyProvider.encrypt(t, "sql-token")},
+					},
+				},
+				"visibility": {
+					Cassandra: &Cassandra{Password: keyProvider.encrypt(t, "cassandra-password")},
+				},
+			},
+		},
+	}
+
+	require.NoError(t, decryptSecrets(reflect.ValueOf(cfg), keyProvider))
+	assert.Equal(t, "temporal", cfg.Persistence.DataStores["default"].SQL.User)
+	assert.Equal(t, "sql-password", cfg.Persistence.DataStores["default"].SQL.Password)
+	assert.Equal(t, "sql-token", cfg.Persistence.DataStores["default"].SQL.ConnectAttributes["token"])
+	assert.Equal(t, "cassandra-password", cfg.Persistence.DataStores["visibility"].Cassandra.Password)
+}
+
+func TestDecryptSecrets_Errors(t *testing.T) {
+	keyProvider := newTestKeyProvider(t)
+	cfg := &Config{Log: Logger{OutputFile: keyProvider.encrypt(t, "secret")}}
+	assert.Equal(t, errSecretsProviderNotConfigured, decryptSecrets(reflect.ValueOf(cfg), nil))
+
+	cfg = &Config{Log: Logger{OutputFile: SecretPrefixKMS + "malformed"}}
+	assert.Error(t, decryptSecrets(reflect.ValueOf(cfg), keyProvider))
+
+	otherKeyProvider := newTestKeyProvider(t)
+	cfg = &Config{Log: Logger{OutputFile: otherKeyProvider.encrypt(t, "secret")}}
+	assert.Error(t, decryptSecrets(reflect.ValueOf(cfg), keyProvider))
+}
+
+func TestDecryptSecrets_NoProvider(t *testing.T) {
+	cfg := &Config{Log: Logger{OutputFile: "/tmp/temporal.log"}}
+	assert.NoError(t, cfg.DecryptSecrets())
+	assert.Equal(t, "/tmp/temporal.log", cfg.Log.OutputFile)
+}
@@ -49,3 +49,9 @@ type Client interface {
 	// UpdateValue takes value as map and updates by overriding. It doesn't support update with filters.
 	UpdateValue(name Key, value interface{}) error
 }
+
+// constrainedValueChecker is implemented by clients which can tell whether a value is configured for exactly
+// the given filters, so that Collection can pick the most specific of several filter combinations
+type constrainedValueChecker interface {
+	HasConstrainedValue(name Key, filters map[Filter]interface{}) bool
+}
@@ -60,6 +60,22 @@ type Collection struct {
 	errCount int64
 }
 
+// mostSpecificFilterMap returns the first of the filter maps, ordered from most to least specific, for which
+// a value is configured. Clients fall back to the value without constraints when no value matches the filters,
+// so a less specific lookup must only be done when the more specific ones have no value of their own.
+func (c *Collection) mostSpecificFilterMap(key Key, filterMaps ...map[Filter]interface{}) map[Filter]interface{} {
+	checker, ok := c.client.(constrainedValueChecker)
+	if !ok {
+		return filterMaps[0]
+	}
+	for _, filterMap := range filterMaps {
+		if checker.HasConstrainedValue(key, filterMap) {
+			return filterMap
+		}
+	}
+	return filterMaps[len(filterMaps)-1]
+}
+
 func (c *Collection) logError(key Key, err error) {
 	errCount := atomic.AddInt64(&c.errCount, 1)
 	if errCount%errCountLogThreshold == 0 {
@@ -119,6 +135,9 @@ type DurationPropertyFnWithNamespaceIDFilter func(namespaceID string) time.Durat
 // DurationPropertyFnWithTaskQueueInfoFilters is a wrapper to get duration property from dynamic config  with three filters: namespace, taskQueue, taskType
 type DurationPropertyFnWithTaskQueueInfoFilters func(namespace string, taskQueue string, taskType enumspb.TaskQueueType) time.Duration
 
+// DurationPropertyFnWithAPINameFilter is a wrapper to get duration property from dynamic config with two filters: namespace, apiName
+type DurationPropertyFnWithAPINameFilter func(namespace string, apiName string) time.Duration
+
 // DurationPropertyFnWithShardIDFilter is a wrapper to get duration property from dynamic config with shardID as filter
 type DurationPropertyFnWithShardIDFilter func(shardID int32) time.Duration
 
@@ -137,6 +156,9 @@ type StringPropertyFnWithNamespaceFilter func(namespace string) string
 // MapPropertyFnWithNamespaceFilter is a wrapper to get map property from dynamic config
 type MapPropertyFnWithNamespaceFilter func(namespace string) map[string]interface{}
 
+// MapPropertyFnWithActivityTypeFilter is a wrapper to get map property from dynamic config with two filters: namespace, activityType
+type MapPropertyFnWithActivityTypeFilter func(namespace string, activityType string) map[string]interface{}
+
 // BoolPropertyFnWithNamespaceFilter is a wrapper to get bool property from dynamic config
 type BoolPropertyFnWithNamespaceFilter func(namespace string) bool
 
@@ -376,6 +398,29 @@ func (c *Collection) GetDurationPropertyFilteredByTaskQueueInfo(key Key, default
 	}
 }
 
+// GetDurationPropertyFilteredByAPIName gets property with namespace and API name as filters and asserts that it's a duration,
+// a value constrained by both filters takes precedence over a value constrained by API name only, which takes precedence
+// over a value constrained by namespace only, which takes precedence over the value without constraints
+func (c *Collection) GetDurationPropertyFilteredByAPIName(key Key, defaultValue time.Duration) DurationPropertyFnWithAPINameFilter {
+	return func(namespace string, apiName string) time.Duration {
+		val, err := c.client.GetDurationValue(
+			key,
+			c.mostSpecificFilterMap(
+				key,
+				getFilterMap(NamespaceFilter(namespace), APINameFilter(apiName)),
+				getFilterMap(APINameFilter(apiName)),
+				getFilterMap(NamespaceFilter(namespace)),
+			),
+			defaultValue,
+		)
+		if err != nil {
+			c.logError(key, err)
+		}
+		c.logValue(key, val, defaultValue, durationCompareEquals)
+		return val
+	}
+}
+
 // GetDurationPropertyFilteredByShardID gets property with shardID id as filter and asserts that it's a duration
 func (c *Collection) GetDurationPropertyFilteredByShardID(key Key, defaultValue time.Duration) DurationPropertyFnWithShardIDFilter {
 	return func(shardID int32) time.Duration {
@@ -452,6 +497,27 @@ func (c *Collection) GetMapPropertyFnWithNamespaceFilter(key Key, defaultValue m
 	}
 }
 
+// GetMapPropertyFnWithActivityTypeFilter gets property with namespace and activity type filters and asserts that it's a map,
+// a value constrained by both filters takes precedence over a value constrained by namespace only
+func (c *Collection) GetMapPropertyFnWithActivityTypeFilter(key Key, defaultValue map[string]interface{}) MapPropertyFnWithActivityTypeFilter {
+	return func(namespace string, activityType string) map[string]interface{} {
+		val, err := c.client.GetMapValue(
+			key,
+			c.mostSpecificFilterMap(
+				key,
+				getFilterMap(NamespaceFilter(namespace), ActivityTypeFilter(activityType)),
+				getFilterMap(NamespaceFilter(namespace)),
+			),
+			defaultValue,
+		)
+		if err != nil {
+			c.logError(key, err)
+		}
+		c.logValue(key, val, defaultValue, reflect.DeepEqual)
+		return val
+	}
+}
+
 // GetBoolPropertyFnWithNamespaceFilter gets property with namespace filter and asserts that its namespace
 func (c *Collection) GetBoolPropertyFnWithNamespaceFilter(key Key, defaultValue bool) BoolPropertyFnWithNamespaceFilter {
 	return func(namespace string) bool {
@@ -62,6 +62,11 @@ func GetBoolPropertyFnFilteredByNamespace(value bool) func(namespace string) boo
 	return func(namespace string) bool { return value }
 }
 
+// GetBoolPropertyFnFilteredByTaskQueueInfo returns value as BoolPropertyFnWithTaskQueueInfoFilters
+func GetBoolPropertyFnFilteredByTaskQueueInfo(value bool) func(namespace string, taskQueue string, taskType enumspb.TaskQueueType) bool {
+	return func(namespace string, taskQueue string, taskType enumspb.TaskQueueType) bool { return value }
+}
+
 // GetDurationPropertyFnFilteredByNamespace returns value as DurationPropertyFnFilteredByNamespace
 func GetDurationPropertyFnFilteredByNamespace(value time.Duration) func(namespace string) time.Duration {
 	return func(namespace string) time.Duration { return value }
@@ -77,6 +82,11 @@ func GetDurationPropertyFnFilteredByTaskQueueInfo(value time.Duration) func(name
 	return func(namespace string, taskQueue string, taskType enumspb.TaskQueueType) time.Duration { return value }
 }
 
+// GetDurationPropertyFnFilteredByAPIName returns value as DurationPropertyFnWithAPINameFilter
+func GetDurationPropertyFnFilteredByAPIName(value time.Duration) func(namespace string, apiName string) time.Duration {
+	return func(namespace string, apiName string) time.Duration { return value }
+}
+
 // GetStringPropertyFn returns value as StringPropertyFn
 func GetStringPropertyFn(value string) func(opts ...FilterOption) string {
 	return func(...FilterOption) string { return value }
@@ -91,3 +101,8 @@ func GetMapPropertyFn(value map[string]interface{}) func(opts ...FilterOption) m
 func GetMapPropertyFnWithNamespaceFilter(value map[string]interface{}) func(namespace string) map[string]interface{} {
 	return func(namespace string) map[string]interface{} { return value }
 }
+
+// GetMapPropertyFnWithActivityTypeFilter returns value as MapPropertyFnWithActivityTypeFilter
+func GetMapPropertyFnWithActivityTypeFilter(value map[string]interface{}) func(namespace string, activityType string) map[string]interface{} {
+	return func(namespace string, activityType string) map[string]interface{} { return value }
+}
@@ -27,6 +27,7 @@ package dynamicconfig
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -279,6 +280,61 @@ func TestDynamicConfigKeyIsMapped(t *testing.T) {
 	}
 }
 
+func TestDynamicConfigKeyIsRegistered(t *testing.T) {
+	for i := unknownKey + 1; i < lastKeyForTest; i++ {
+		if strings.HasPrefix(keys[i], "test") {
+			continue
+		}
+		info, ok := GetKeyInfo(i)
+		require.True(t, ok, fmt.Sprintf("key %v is not registered, run make go-generate", i))
+		require.Equal(t, i, info.Key)
+	}
+
+	info, ok := GetKeyInfo(MatchingNumTaskqueueReadPartitions)
+	require.True(t, ok)
+	require.Equal(t, TypeInt, info.Type)
+	require.Equal(t, []string{"4"}, info.Defaults)
+	require.Equal(t, "1", info.Min)
+	require.Equal(t, []Filter{Namespace, TaskQueueName, TaskType}, info.Filters)
+	require.NotEmpty(t, info.Description)
+
+	info, ok = GetKeyInfo(ShardSyncTimerJitterCoefficient)
+	require.True(t, ok)
+	require.Equal(t, "history.shardSyncTimerJitterCoefficient", info.Key.String())
+	require.Equal(t, []string{"0.15"}, info.Defaults)
+	require.Equal(t, "0", info.Min)
+	require.Equal(t, "1", info.Max)
+
+	info, ok = GetKeyInfo(AdvancedVisibilityWritingMode)
+	require.True(t, ok)
+	require.True(t, info.ComputedDefault)
+	require.Equal(t, []string{"off", "on", "dual"}, info.AllowedValues)
+
+	_, ok = GetKeyInfo(testGetIntPropertyKey)
+	require.False(t, ok)
+}
+
+func TestListKeys(t *testing.T) {
+	infos := ListKeys("matching.")
+	require.NotEmpty(t, infos)
+	for i, info := range infos {
+		require.True(t, strings.HasPrefix(info.Key.String(), "matching."))
+		if i > 0 {
+			require.True(t, infos[i-1].Key.String() < info.Key.String())
+		}
+	}
+	require.Len(t, ListKeys(""), len(registry))
+}
+
+func TestKeyNamesAreUnique(t *testing.T) {
+	names := make(map[string]Key, len(keys))
+	for key, name := range keys {
+		other, ok := names[name]
+		require.False(t, ok, fmt.Sprintf("keys %d and %d have the same name %v", other, key, name))
+		names[name] = key
+	}
+}
+
 func TestDynamicConfigFilterTypeIsMapped(t *testing.T) {
 	require.Equal(t, int(lastFilterTypeForTest), len(filters))
 	for i := unknownFilter; i < lastFilterTypeForTest; i++ {
@@ -76,10 +76,17 @@ var keys = map[Key]string{
 	MinRetentionDays:                       "system.minRetentionDays",
 	DisallowQuery:                          "system.disallowQuery",
 	EnableBatcher:                          "worker.enableBatcher",
+	EnableLoadGenerator:                    "worker.enableLoadGenerator",
+	EnableCanary:                           "worker.enableCanary",
 	EnableParentClosePolicyWorker:          "system.enableParentClosePolicyWorker",
 	EnableStickyQuery:                      "system.enableStickyQuery",
 	EnablePriorityTaskProcessor:            "system.enablePriorityTaskProcessor",
 	EnableAuthorization:                    "system.enableAuthorization",
+	PersistenceFaultInjectionEnabled:       "system.persistenceFaultInjectionEnabled",
+	PersistenceFaultInjectionErrorRate:     "system.persistenceFaultInjectionErrorRate",
+	PersistenceFaultInjectionLatency:       "system.persistenceFaultInjectionLatency",
+	PersistenceFaultInjectionTargetAPIs:    "system.persistenceFaultInjectionTargetAPIs",
+	AllowMixedVersionFeatures:              "system.allowMixedVersionFeatures",
 
 	// size limit
 	BlobSizeLimitError:     "limit.blobSize.error",
@@ -97,6 +104,7 @@ var keys = map[Key]string{
 	FrontendVisibilityListMaxQPS:          "frontend.visibilityListMaxQPS",
 	FrontendESVisibilityListMaxQPS:        "frontend.esVisibilityListMaxQPS",
 	FrontendMaxBadBinaries:                "frontend.maxBadBinaries",
+	FrontendClaimCheckThreshold:           "frontend.claimCheckThreshold",
 	FrontendESIndexMaxResultWindow:        "frontend.esIndexMaxResultWindow",
 	FrontendHistoryMaxPageSize:            "frontend.historyMaxPageSize",
 	FrontendRPS:                           "frontend.rps",
@@ -104,6 +112,7 @@ var keys = map[Key]string{
 	FrontendGlobalNamespaceRPS:            "frontend.globalNamespacerps",
 	FrontendHistoryMgrNumConns:            "frontend.historyMgrNumConns",
 	FrontendShutdownDrainDuration:         "frontend.shutdownDrainDuration",
+	FrontendAPITimeout:                    "frontend.apiTimeout",
 	DisableListVisibilityByFilter:         "frontend.disableListVisibilityByFilter",
 	FrontendThrottledLogRPS:               "frontend.throttledLogRPS",
 	EnableClientVersionCheck:              "frontend.enableClientVersionCheck",
@@ -117,6 +126,11 @@ var keys = map[Key]string{
 	VisibilityArchivalQueryMaxQPS:         "frontend.visibilityArchivalQueryMaxQPS",
 	EnableServerVersionCheck:              "frontend.enableServerVersionCheck",
 	EnableTokenNamespaceEnforcement:       "frontend.enableTokenNamespaceEnforcement",
+	BatchDescribeMutableStateMaxBatchSize: "frontend.batchDescribeMutableStateMaxBatchSize",
+	BatchDescribeMutableStateConcurrency:  "frontend.batchDescribeMutableStateConcurrency",
+	BatchActivityTaskMaxBatchSize:         "frontend.batchActivityTaskMaxBatchSize",
+	BatchActivityTaskConcurrency:          "frontend.batchActivityTaskConcurrency",
+	BatchActivityTaskTimeout:              "frontend.batchActivityTaskTimeout",
 
 	// matching settings
 	MatchingRPS:                             "matching.rps",
@@ -125,7 +139,12 @@ var keys = map[Key]string{
 	MatchingMinTaskThrottlingBurstSize:      "matching.minTaskThrottlingBurstSize",
 	MatchingGetTasksBatchSize:               "matching.getTasksBatchSize",
 	MatchingLongPollExpirationInterval:      "matching.longPollExpirationInterval",
+	MatchingPollerHistoryTTL:                "matching.pollerHistoryTTL",
+	MatchingWorkflowTaskHolderTTL:           "matching.workflowTaskHolderTTL",
+	MatchingMaxTaskHoldersPerPartition:      "matching.maxTaskHoldersPerPartition",
 	MatchingEnableSyncMatch:                 "matching.enableSyncMatch",
+	MatchingEnableSyncMatchStats:            "matching.enableSyncMatchStats",
+	MatchingSyncMatchStatsWindow:            "matching.syncMatchStatsWindow",
 	MatchingUpdateAckInterval:               "matching.updateAckInterval",
 	MatchingIdleTaskqueueCheckInterval:      "matching.idleTaskqueueCheckInterval",
 	MaxTaskqueueIdleTime:                    "matching.maxTaskqueueIdleTime",
@@ -150,8 +169,11 @@ var keys = map[Key]string{
 	HistoryLongPollExpirationInterval:                    "history.longPollExpirationInterval",
 	HistoryCacheInitialSize:                              "history.cacheInitialSize",
 	HistoryMaxAutoResetPoints:                            "history.historyMaxAutoResetPoints",
+	WorkflowTagsNumberOfKeysLimit:                        "history.workflowTagsNumberOfKeysLimit",
+	WorkflowTagsTotalSizeLimit:                           "history.workflowTagsTotalSizeLimit",
 	HistoryCacheMaxSize:                                  "history.cacheMaxSize",
 	HistoryCacheTTL:                                      "history.cacheTTL",
+	WorkflowLockHoldWarnThreshold:                        "history.workflowLockHoldWarnThreshold",
 	HistoryShutdownDrainDuration:                         "history.shutdownDrainDuration",
 	EventsCacheInitialSize:                               "history.eventsCacheInitialSize",
 	EventsCacheMaxSize:                                   "history.eventsCacheMaxSize",
@@ -239,7 +261,7 @@ var keys = map[Key]string{
 	MaximumSignalsPerExecution:                             "history.maximumSignalsPerExecution",
 	ShardUpdateMinInterval:                                 "history.shardUpdateMinInterval",
 	ShardSyncMinInterval:                                   "history.shardSyncMinInterval",
-	ShardSyncTimerJitterCoefficient:                        "history.shardSyncMinInterval",
+	ShardSyncTimerJitterCoefficient:                        "history.shardSyncTimerJitterCoefficient",
 	DefaultEventEncoding:                                   "history.defaultEventEncoding",
 	EnableParentClosePolicy:                                "history.enableParentClosePolicy",
 	NumArchiveSystemWorkflows:                              "history.numArchiveSystemWorkflows",
@@ -255,6 +277,8 @@ var keys = map[Key]string{
 	ReplicationTaskFetcherAggregationInterval:              "history.ReplicationTaskFetcherAggregationInterval",
 	ReplicationTaskFetcherTimerJitterCoefficient:           "history.ReplicationTaskFetcherTimerJitterCoefficient",
 	ReplicationTaskFetcherErrorRetryWait:                   "history.ReplicationTaskFetcherErrorRetryWait",
+	ReplicationTaskFetcherMaxShardsPerRequest:              "history.ReplicationTaskFetcherMaxShardsPerRequest",
+	ReplicationTaskFetcherEnableCompression:                "history.ReplicationTaskFetcherEnableCompression",
 	ReplicationTaskProcessorErrorRetryWait:                 "history.ReplicationTaskProcessorErrorRetryWait",
 	ReplicationTaskProcessorErrorRetryBackoffCoefficient:   "history.ReplicationTaskProcessorErrorRetryBackoffCoefficient",
 	ReplicationTaskProcessorErrorRetryMaxInterval:          "history.ReplicationTaskProcessorErrorRetryMaxInterval",
@@ -277,8 +301,10 @@ var keys = map[Key]string{
 	SkipReapplicationByNamespaceId:                         "history.SkipReapplicationByNamespaceId",
 	DefaultActivityRetryPolicy:                             "history.defaultActivityRetryPolicy",
 	DefaultWorkflowRetryPolicy:                             "history.defaultWorkflowRetryPolicy",
+	ActivityRetryPolicyOverride:                            "history.activityRetryPolicyOverride",
 	VisibilityQueue:                                        "history.visibilityQueue",
 	VisibilityProcessorEnabled:                             "history.visibilityProcessorEnabled",
+	EnableCrossClusterOperations:                           "history.enableCrossClusterOperations",
 
 	WorkerPersistenceMaxQPS:                         "worker.persistenceMaxQPS",
 	WorkerPersistenceGlobalMaxQPS:                   "worker.persistenceGlobalMaxQPS",
@@ -310,6 +336,9 @@ var keys = map[Key]string{
 	TaskQueueScannerEnabled:                         "worker.taskQueueScannerEnabled",
 	HistoryScannerEnabled:                           "worker.historyScannerEnabled",
 	ExecutionsScannerEnabled:                        "worker.executionsScannerEnabled",
+	StorageUsageScannerEnabled:                      "worker.storageUsageScannerEnabled",
+	ClaimCheckScannerEnabled:                        "worker.claimCheckScannerEnabled",
+	ClaimCheckBlobLifetime:                          "worker.claimCheckBlobLifetime",
 }
 
 const (
@@ -338,6 +367,7 @@ const (
 	// EnableVisibilitySampling is key for enable visibility sampling
 	EnableVisibilitySampling
 	// AdvancedVisibilityWritingMode is key for how to write to advanced visibility
+	// Allowed values: off, on, dual
 	AdvancedVisibilityWritingMode
 	// EmitShardDiffLog whether emit the shard diff log
 	EmitShardDiffLog
@@ -346,10 +376,12 @@ const (
 	// DisableListVisibilityByFilter is config to disable list open/close workflow using filter
 	DisableListVisibilityByFilter
 	// HistoryArchivalState is key for the state of history archival
+	// Allowed values: enabled, disabled, paused
 	HistoryArchivalState
 	// EnableReadFromHistoryArchival is key for enabling reading history from archival store
 	EnableReadFromHistoryArchival
 	// VisibilityArchivalState is key for the state of visibility archival
+	// Allowed values: enabled, disabled, paused
 	VisibilityArchivalState
 	// EnableReadFromVisibilityArchival is key for enabling reading visibility from archival store
 	EnableReadFromVisibilityArchival
@@ -366,6 +398,19 @@ const (
 	EnablePriorityTaskProcessor
 	// EnableAuthorization is the key to enable authorization for a namespace
 	EnableAuthorization
+	// PersistenceFaultInjectionEnabled is the key to inject faults into persistence calls, checked on every call.
+	// It only takes effect if persistence.enableFaultInjection is set in static config
+	PersistenceFaultInjectionEnabled
+	// PersistenceFaultInjectionErrorRate is the probability that a persistence call fails with an injected error
+	PersistenceFaultInjectionErrorRate
+	// PersistenceFaultInjectionLatency is the latency injected before each persistence call
+	PersistenceFaultInjectionLatency
+	// PersistenceFaultInjectionTargetAPIs is a comma separated list of persistence APIs faults are injected into,
+	// faults are injected into all APIs if empty
+	PersistenceFaultInjectionTargetAPIs
+	// AllowMixedVersionFeatures allows activating features which older releases do not understand
+	// while members of the cluster run mixed server versions, e.g. during a rolling upgrade
+	AllowMixedVersionFeatures
 	// BlobSizeLimitError is the per event blob size limit
 	BlobSizeLimitError
 	// BlobSizeLimitWarn is the per event blob size limit for warning
@@ -411,11 +456,17 @@ const (
 	FrontendThrottledLogRPS
 	// FrontendShutdownDrainDuration is the duration of traffic drain during shutdown
 	FrontendShutdownDrainDuration
+	// FrontendAPITimeout is the server side timeout of frontend API calls, it can be set per API with the
+	// apiName filter and per namespace with the namespace filter, 0 means no server side timeout
+	FrontendAPITimeout
 	// EnableClientVersionCheck enables client version check for frontend
 	EnableClientVersionCheck
 
 	// FrontendMaxBadBinaries is the max number of bad binaries in namespace config
 	FrontendMaxBadBinaries
+	// FrontendClaimCheckThreshold is the payload size above which input and result payloads are offloaded
+	// to the claim check store and replaced by references, 0 disables offloading
+	FrontendClaimCheckThreshold
 	// ValidSearchAttributes is legal indexed keys that can be used in list APIs
 	ValidSearchAttributes
 	// SendRawWorkflowHistory is whether to enable raw history retrieving
@@ -436,6 +487,16 @@ const (
 	EnableServerVersionCheck
 	// EnableTokenNamespaceEnforcement enables enforcement that namespace in completion token matches namespace of the request
 	EnableTokenNamespaceEnforcement
+	// BatchDescribeMutableStateMaxBatchSize is the max number of executions accepted by a single BatchDescribeMutableState call
+	BatchDescribeMutableStateMaxBatchSize
+	// BatchDescribeMutableStateConcurrency is the max number of concurrent history calls issued by a single BatchDescribeMutableState call
+	BatchDescribeMutableStateConcurrency
+	// BatchActivityTaskMaxBatchSize is the max number of activity tasks accepted by a single BatchCompleteActivityTasksById or BatchFailActivityTasksById call
+	BatchActivityTaskMaxBatchSize
+	// BatchActivityTaskConcurrency is the max number of concurrent history calls issued by a single BatchCompleteActivityTasksById or BatchFailActivityTasksById call
+	BatchActivityTaskConcurrency
+	// BatchActivityTaskTimeout is the timeout of the history calls issued for each activity task of a BatchCompleteActivityTasksById or BatchFailActivityTasksById call
+	BatchActivityTaskTimeout
 
 	// key for matching
 
@@ -451,8 +512,21 @@ const (
 	MatchingGetTasksBatchSize
 	// MatchingLongPollExpirationInterval is the long poll expiration interval in the matching service
 	MatchingLongPollExpirationInterval
+	// MatchingPollerHistoryTTL is how long a poller is reported by DescribeTaskQueue after its last poll
+	MatchingPollerHistoryTTL
+	// MatchingWorkflowTaskHolderTTL is how long DescribeTaskQueue reports the worker a workflow task was dispatched to.
+	// Activity task holders are reported until the start to close timeout of the activity.
+	MatchingWorkflowTaskHolderTTL
+	// MatchingMaxTaskHoldersPerPartition is the max number of task holders a task queue partition keeps,
+	// the least recently dispatched tasks are dropped first
+	MatchingMaxTaskHoldersPerPartition
 	// MatchingEnableSyncMatch is to enable sync match
 	MatchingEnableSyncMatch
+	// MatchingEnableSyncMatchStats is to record whether each task was sync matched or spilled to backlog,
+	// and report the sync match ratio and spill latency of the task queue
+	MatchingEnableSyncMatchStats
+	// MatchingSyncMatchStatsWindow is the length of the window over which sync match stats are reported
+	MatchingSyncMatchStatsWindow
 	// MatchingUpdateAckInterval is the interval for update ack
 	MatchingUpdateAckInterval
 	// MatchingIdleTaskqueueCheckInterval is the IdleTaskqueueCheckInterval
@@ -468,8 +542,10 @@ const (
 	// MatchingThrottledLogRPS is the rate limit on number of log messages emitted per second for throttled logger
 	MatchingThrottledLogRPS
 	// MatchingNumTaskqueueWritePartitions is the number of write partitions for a task queue
+	// Min: 1
 	MatchingNumTaskqueueWritePartitions
 	// MatchingNumTaskqueueReadPartitions is the number of read partitions for a task queue
+	// Min: 1
 	MatchingNumTaskqueueReadPartitions
 	// MatchingForwarderMaxOutstandingPolls is the max number of inflight polls from the forwarder
 	MatchingForwarderMaxOutstandingPolls
@@ -502,6 +578,9 @@ const (
 	HistoryCacheMaxSize
 	// HistoryCacheTTL is TTL of history cache
 	HistoryCacheTTL
+	// WorkflowLockHoldWarnThreshold is the workflow execution lock hold time above which a warning with the
+	// workflow ID and the number of waiting requests is logged, 0 disables the warning
+	WorkflowLockHoldWarnThreshold
 	// HistoryShutdownDrainDuration is the duration of traffic drain during shutdown
 	HistoryShutdownDrainDuration
 	// EventsCacheInitialSize is initial size of events cache
@@ -524,7 +603,8 @@ const (
 	StandbyTaskMissingEventsDiscardDelay
 	// TaskProcessRPS is the task processing rate per second for each namespace
 	TaskProcessRPS
-	// TaskSchedulerType is the task scheduler type for priority task processor
+	// TaskSchedulerType is the task scheduler type for priority task processor, 1 for FIFO and 2 for weighted round robin
+	// Allowed values: 1, 2
 	TaskSchedulerType
 	// TaskSchedulerWorkerCount is the number of workers per shard in task scheduler
 	TaskSchedulerWorkerCount
@@ -547,6 +627,8 @@ const (
 	// TimerProcessorUpdateAckInterval is update interval for timer processor
 	TimerProcessorUpdateAckInterval
 	// TimerProcessorUpdateAckIntervalJitterCoefficient is the update interval jitter coefficient
+	// Min: 0
+	// Max: 1
 	TimerProcessorUpdateAckIntervalJitterCoefficient
 	// TimerProcessorCompleteTimerInterval is complete timer interval for timer processor
 	TimerProcessorCompleteTimerInterval
@@ -557,10 +639,14 @@ const (
 	// TimerProcessorMaxPollInterval is max poll interval for timer processor
 	TimerProcessorMaxPollInterval
 	// TimerProcessorMaxPollIntervalJitterCoefficient is the max poll interval jitter coefficient
+	// Min: 0
+	// Max: 1
 	TimerProcessorMaxPollIntervalJitterCoefficient
 	// TimerProcessorRedispatchInterval is the redispatch interval for timer processor
 	TimerProcessorRedispatchInterval
 	// TimerProcessorRedispatchIntervalJitterCoefficient is the redispatch interval jitter coefficient
+	// Min: 0
+	// Max: 1
 	TimerProcessorRedispatchIntervalJitterCoefficient
 	// TimerProcessorMaxRedispatchQueueSize is the threshold of the number of tasks in the redispatch queue for timer processor
 	TimerProcessorMaxRedispatchQueueSize
@@ -589,16 +675,22 @@ const (
 	// TransferProcessorMaxPollInterval max poll interval for transferQueueProcessor
 	TransferProcessorMaxPollInterval
 	// TransferProcessorMaxPollIntervalJitterCoefficient is the max poll interval jitter coefficient
+	// Min: 0
+	// Max: 1
 	TransferProcessorMaxPollIntervalJitterCoefficient
 	// TransferProcessorUpdateAckInterval is update interval for transferQueueProcessor
 	TransferProcessorUpdateAckInterval
 	// TransferProcessorUpdateAckIntervalJitterCoefficient is the update interval jitter coefficient
+	// Min: 0
+	// Max: 1
 	TransferProcessorUpdateAckIntervalJitterCoefficient
 	// TransferProcessorCompleteTransferInterval is complete timer interval for transferQueueProcessor
 	TransferProcessorCompleteTransferInterval
 	// TransferProcessorRedispatchInterval is the redispatch interval for transferQueueProcessor
 	TransferProcessorRedispatchInterval
 	// TransferProcessorRedispatchIntervalJitterCoefficient is the redispatch interval jitter coefficient
+	// Min: 0
+	// Max: 1
 	TransferProcessorRedispatchIntervalJitterCoefficient
 	// TransferProcessorMaxRedispatchQueueSize is the threshold of the number of tasks in the redispatch queue for transferQueueProcessor
 	TransferProcessorMaxRedispatchQueueSize
@@ -624,16 +716,22 @@ const (
 	// VisibilityProcessorMaxPollInterval max poll interval for visibilityQueueProcessor
 	VisibilityProcessorMaxPollInterval
 	// VisibilityProcessorMaxPollIntervalJitterCoefficient is the max poll interval jitter coefficient
+	// Min: 0
+	// Max: 1
 	VisibilityProcessorMaxPollIntervalJitterCoefficient
 	// VisibilityProcessorUpdateAckInterval is update interval for visibilityQueueProcessor
 	VisibilityProcessorUpdateAckInterval
 	// VisibilityProcessorUpdateAckIntervalJitterCoefficient is the update interval jitter coefficient
+	// Min: 0
+	// Max: 1
 	VisibilityProcessorUpdateAckIntervalJitterCoefficient
 	// VisibilityProcessorCompleteTaskInterval is complete timer interval for visibilityQueueProcessor
 	VisibilityProcessorCompleteTaskInterval
 	// VisibilityProcessorRedispatchInterval is the redispatch interval for visibilityQueueProcessor
 	VisibilityProcessorRedispatchInterval
 	// VisibilityProcessorRedispatchIntervalJitterCoefficient is the redispatch interval jitter coefficient
+	// Min: 0
+	// Max: 1
 	VisibilityProcessorRedispatchIntervalJitterCoefficient
 	// VisibilityProcessorMaxRedispatchQueueSize is the threshold of the number of tasks in the redispatch queue for visibilityQueueProcessor
 	VisibilityProcessorMaxRedispatchQueueSize
@@ -655,14 +753,20 @@ const (
 	// ReplicatorProcessorMaxPollInterval is max poll interval for ReplicatorProcessor
 	ReplicatorProcessorMaxPollInterval
 	// ReplicatorProcessorMaxPollIntervalJitterCoefficient is the max poll interval jitter coefficient
+	// Min: 0
+	// Max: 1
 	ReplicatorProcessorMaxPollIntervalJitterCoefficient
 	// ReplicatorProcessorUpdateAckInterval is update interval for ReplicatorProcessor
 	ReplicatorProcessorUpdateAckInterval
 	// ReplicatorProcessorUpdateAckIntervalJitterCoefficient is the update interval jitter coefficient
+	// Min: 0
+	// Max: 1
 	ReplicatorProcessorUpdateAckIntervalJitterCoefficient
 	// ReplicatorProcessorRedispatchInterval is the redispatch interval for ReplicatorProcessor
 	ReplicatorProcessorRedispatchInterval
 	// ReplicatorProcessorRedispatchIntervalJitterCoefficient is the redispatch interval jitter coefficient
+	// Min: 0
+	// Max: 1
 	ReplicatorProcessorRedispatchIntervalJitterCoefficient
 	// ReplicatorProcessorMaxRedispatchQueueSize is the threshold of the number of tasks in the redispatch queue for ReplicatorProcessor
 	ReplicatorProcessorMaxRedispatchQueueSize
@@ -677,6 +781,8 @@ const (
 	// ShardSyncMinInterval is the minimal time interval which the shard info should be sync to remote
 	ShardSyncMinInterval
 	// ShardSyncTimerJitterCoefficient is the sync shard jitter coefficient
+	// Min: 0
+	// Max: 1
 	ShardSyncTimerJitterCoefficient
 	// DefaultEventEncoding is the encoding type for history events
 	DefaultEventEncoding
@@ -690,9 +796,17 @@ const (
 	// DefaultWorkflowRetryPolicy represents the out-of-box retry policy for unset fields
 	// where the user has set an explicit RetryPolicy, but not specified all the fields
 	DefaultWorkflowRetryPolicy
+	// ActivityRetryPolicyOverride is the operator provided retry policy fields keyed by namespace and
+	// activity type which take precedence over the retry policy specified by the user. The value constrained
+	// by both namespace and activity type replaces the value constrained by namespace only.
+	ActivityRetryPolicyOverride
 
 	// HistoryMaxAutoResetPoints is the key for max number of auto reset points stored in mutableState
 	HistoryMaxAutoResetPoints
+	// WorkflowTagsNumberOfKeysLimit is the limit of number of non-indexed tags stored in mutableState
+	WorkflowTagsNumberOfKeysLimit
+	// WorkflowTagsTotalSizeLimit is the size limit of all non-indexed tags stored in mutableState
+	WorkflowTagsTotalSizeLimit
 
 	// EnableParentClosePolicy whether to  ParentClosePolicy
 	EnableParentClosePolicy
@@ -722,6 +836,10 @@ const (
 	// VisibilityProcessorEnabled is to indicate if visibility processor should be enabled or not.
 	VisibilityProcessorEnabled
 
+	// EnableCrossClusterOperations is to indicate if child workflows and signals of a namespace can target
+	// namespaces active in a remote cluster
+	EnableCrossClusterOperations
+
 	// key for worker
 
 	// WorkerPersistenceMaxQPS is the max qps worker host can query DB
@@ -785,8 +903,18 @@ const (
 	HistoryScannerEnabled
 	// ExecutionsScannerEnabled indicates if executions scanner should be started as part of worker.Scanner
 	ExecutionsScannerEnabled
+	// StorageUsageScannerEnabled indicates if storage usage scanner should be started as part of worker.Scanner
+	StorageUsageScannerEnabled
+	// ClaimCheckScannerEnabled indicates if claim check scanner should be started as part of worker.Scanner
+	ClaimCheckScannerEnabled
+	// ClaimCheckBlobLifetime is the minimum age of claim check blobs before the scanner deletes the blobs of closed workflows past retention, 0 keeps them forever
+	ClaimCheckBlobLifetime
 	// EnableBatcher decides whether start batcher in our worker
 	EnableBatcher
+	// EnableLoadGenerator decides whether start load generator in our worker
+	EnableLoadGenerator
+	// EnableCanary decides whether start canary in our worker
+	EnableCanary
 	// EnableParentClosePolicyWorker decides whether or not enable system workers for processing parent close policy task
 	EnableParentClosePolicyWorker
 	// EnableStickyQuery indicates if sticky query should be enabled per namespace
@@ -797,9 +925,15 @@ const (
 	// ReplicationTaskFetcherAggregationInterval determines how frequently the fetch requests are sent
 	ReplicationTaskFetcherAggregationInterval
 	// ReplicationTaskFetcherTimerJitterCoefficient is the jitter for fetcher timer
+	// Min: 0
+	// Max: 1
 	ReplicationTaskFetcherTimerJitterCoefficient
 	// ReplicationTaskFetcherErrorRetryWait is the wait time when fetcher encounters error
 	ReplicationTaskFetcherErrorRetryWait
+	// ReplicationTaskFetcherMaxShardsPerRequest is the max number of shards batched in one replication task fetch request, 0 means no limit
+	ReplicationTaskFetcherMaxShardsPerRequest
+	// ReplicationTaskFetcherEnableCompression indicates whether replication task fetch requests & responses are gzip compressed
+	ReplicationTaskFetcherEnableCompression
 	// ReplicationTaskProcessorErrorRetryWait is the initial retry wait when we see errors in applying replication tasks
 	ReplicationTaskProcessorErrorRetryWait
 	// ReplicationTaskProcessorErrorRetryBackoffCoefficient is the retry wait backoff time coefficient
@@ -815,10 +949,14 @@ const (
 	// ReplicationTaskProcessorCleanupInterval determines how frequently the cleanup replication queue
 	ReplicationTaskProcessorCleanupInterval
 	// ReplicationTaskProcessorCleanupJitterCoefficient is the jitter for cleanup timer
+	// Min: 0
+	// Max: 1
 	ReplicationTaskProcessorCleanupJitterCoefficient
 	// ReplicationTaskProcessorStartWait is the wait time before each task processing batch
 	ReplicationTaskProcessorStartWait
 	// ReplicationTaskProcessorStartWaitJitterCoefficient is the jitter for batch start wait timer
+	// Min: 0
+	// Max: 1
 	ReplicationTaskProcessorStartWaitJitterCoefficient
 	// ReplicationTaskProcessorHostQPS is the qps of task processing rate limiter on host level
 	ReplicationTaskProcessorHostQPS
@@ -847,7 +985,7 @@ const (
 type Filter int
 
 func (f Filter) String() string {
-	if f <= unknownFilter || f > TaskType {
+	if f <= unknownFilter || f > APIName {
 		return filters[unknownFilter]
 	}
 	return filters[f]
@@ -860,6 +998,8 @@ var filters = []string{
 	"taskQueueName",
 	"taskType",
 	"shardID",
+	"activityType",
+	"apiName",
 }
 
 const (
@@ -874,6 +1014,10 @@ const (
 	TaskType
 	// RangeHash is the shard id
 	ShardID
+	// ActivityType is the activity type name
+	ActivityType
+	// APIName is the name of the API method, e.g. QueryWorkflow
+	APIName
 
 	// lastFilterTypeForTest must be the last one in this const group for testing purpose
 	lastFilterTypeForTest
@@ -918,3 +1062,17 @@ func ShardIDFilter(shardID int32) FilterOption {
 		filterMap[ShardID] = shardID
 	}
 }
+
+// ActivityTypeFilter filters by activity type name
+func ActivityTypeFilter(name string) FilterOption {
+	return func(filterMap map[Filter]interface{}) {
+		filterMap[ActivityType] = name
+	}
+}
+
+// APINameFilter filters by API method name
+func APINameFilter(name string) FilterOption {
+	return func(filterMap map[Filter]interface{}) {
+		filterMap[APIName] = name
+	}
+}
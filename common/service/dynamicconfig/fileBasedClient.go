@@ -106,6 +106,17 @@ func (fc *fileBasedClient) GetValueWithFilters(name Key, filters map[Filter]inte
 	return fc.getValueWithFilters(name, filters, defaultValue)
 }
 
+// HasConstrainedValue returns true if a value is configured for exactly the given filters
+func (fc *fileBasedClient) HasConstrainedValue(name Key, filters map[Filter]interface{}) bool {
+	values := fc.values.Load().(map[string][]*constrainedValue)
+	for _, constrainedValue := range values[keys[name]] {
+		if len(constrainedValue.Constraints) != 0 && match(constrainedValue, filters) {
+			return true
+		}
+	}
+	return false
+}
+
 func (fc *fileBasedClient) GetIntValue(name Key, filters map[Filter]interface{}, defaultValue int) (int, error) {
 	val, err := fc.getValueWithFilters(name, filters, defaultValue)
 	if err != nil {
@@ -390,3 +390,18 @@ func (s *fileBasedClientSuite) TestUpdateConfig() {
 	err = client.UpdateValue(key, v)
 	s.NoError(err)
 }
+
+func (s *fileBasedClientSuite) TestGetMapPropertyFnWithActivityTypeFilter_MostSpecific() {
+	override := NewCollection(s.client, log.NewNoop()).GetMapPropertyFnWithActivityTypeFilter(ActivityRetryPolicyOverride, nil)
+	s.Equal(map[string]interface{}{"MaximumAttempts": 3}, override("samples-namespace", "ProcessPayment"))
+	s.Equal(map[string]interface{}{"MaximumAttempts": 5}, override("samples-namespace", "SendEmail"))
+	s.Equal(map[string]interface{}{"MaximumAttempts": 10}, override("other-namespace", "ProcessPayment"))
+}
+
+func (s *fileBasedClientSuite) TestGetDurationPropertyFilteredByAPIName_MostSpecific() {
+	timeout := NewCollection(s.client, log.NewNoop()).GetDurationPropertyFilteredByAPIName(FrontendAPITimeout, 0)
+	s.Equal(90*time.Second, timeout("samples-namespace", "PollWorkflowTaskQueue"))
+	s.Equal(70*time.Second, timeout("other-namespace", "PollWorkflowTaskQueue"))
+	s.Equal(10*time.Second, timeout("samples-namespace", "StartWorkflowExecution"))
+	s.Equal(30*time.Second, timeout("other-namespace", "StartWorkflowExecution"))
+}
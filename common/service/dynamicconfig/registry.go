@@ -0,0 +1,117 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate go run ../../../cmd/tools/dynamicconfig/keygen.go -rootDir ../../..
+
+package dynamicconfig
+
+import (
+	"sort"
+	"strings"
+)
+
+// ValueType is the type of the value of a dynamic config key
+type ValueType int
+
+const (
+	// TypeUnknown is the type of keys which are not read by the server
+	TypeUnknown ValueType = iota
+	// TypeAny is the type of keys read without type assertion
+	TypeAny
+	// TypeInt is the type of integer keys
+	TypeInt
+	// TypeFloat is the type of float keys
+	TypeFloat
+	// TypeDuration is the type of duration keys
+	TypeDuration
+	// TypeBool is the type of boolean keys
+	TypeBool
+	// TypeString is the type of string keys
+	TypeString
+	// TypeMap is the type of map keys
+	TypeMap
+)
+
+var valueTypes = []string{
+	"Unknown",
+	"Any",
+	"Int",
+	"Float",
+	"Duration",
+	"Bool",
+	"String",
+	"Map",
+}
+
+func (t ValueType) String() string {
+	if t < TypeUnknown || int(t) >= len(valueTypes) {
+		return valueTypes[TypeUnknown]
+	}
+	return valueTypes[t]
+}
+
+// KeyInfo describes a dynamic config key, it is generated from the key definitions
+// and the places the key is read by cmd/tools/dynamicconfig/keygen.go
+type KeyInfo struct {
+	Key  Key
+	Type ValueType
+	// Defaults are the default values formatted like values in dynamic config files,
+	// one per distinct default used by the places the key is read
+	Defaults []string
+	// ComputedDefault is true if a place the key is read computes its default at runtime,
+	// e.g. from the static config, so the default is not one of Defaults
+	ComputedDefault bool
+	// Min and Max are the bounds of the value, empty if unbounded
+	Min string
+	Max string
+	// AllowedValues are the only values the key can be set to, any value is allowed if empty
+	AllowedValues []string
+	// Filters are the filters the value can be constrained by, the value is global if empty
+	Filters     []Filter
+	Description string
+}
+
+// GetKeyInfo returns the description of a key
+func GetKeyInfo(key Key) (KeyInfo, bool) {
+	info, ok := registry[key]
+	return info, ok
+}
+
+// ListKeys returns the descriptions of the keys whose name starts with the prefix, sorted by name.
+// Keys have unique names, but the order doesn't depend on the map iteration order even if they don't.
+func ListKeys(prefix string) []KeyInfo {
+	result := make([]KeyInfo, 0, len(registry))
+	for key, info := range registry {
+		if strings.HasPrefix(key.String(), prefix) {
+			result = append(result, info)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Key.String() != result[j].Key.String() {
+			return result[i].Key.String() < result[j].Key.String()
+		}
+		return result[i].Key < result[j].Key
+	})
+	return result
+}
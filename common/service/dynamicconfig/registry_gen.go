@@ -0,0 +1,1734 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by cmd/tools/dynamicconfig/keygen.go. DO NOT EDIT.
+
+package dynamicconfig
+
+var registry = map[Key]KeyInfo{
+	AdminMatchingDispatchRate: {
+		Key:         AdminMatchingDispatchRate,
+		Type:        TypeFloat,
+		Defaults:    []string{"1000000"},
+		Filters:     []Filter{Namespace},
+		Description: "AdminMatchingDispatchRate is the max qps of any task queue for a given namespace",
+	},
+	AdminMatchingTaskqueueDispatchRate: {
+		Key:         AdminMatchingTaskqueueDispatchRate,
+		Type:        TypeFloat,
+		Defaults:    []string{"4000"},
+		Filters:     []Filter{Namespace, TaskQueueName, TaskType},
+		Description: "AdminMatchingTaskqueueDispatchRate is the max qps of a task queue for a given namespace",
+	},
+	FrontendAPITimeout: {
+		Key:         FrontendAPITimeout,
+		Type:        TypeDuration,
+		Defaults:    []string{"0s"},
+		Filters:     []Filter{Namespace, APIName},
+		Description: "FrontendAPITimeout is the server side timeout of frontend API calls, it can be set per API with the apiName filter and per namespace with the namespace filter, 0 means no server side timeout",
+	},
+	BatchActivityTaskConcurrency: {
+		Key:         BatchActivityTaskConcurrency,
+		Type:        TypeInt,
+		Defaults:    []string{"50"},
+		Description: "BatchActivityTaskConcurrency is the max number of concurrent history calls issued by a single BatchCompleteActivityTasksById or BatchFailActivityTasksById call",
+	},
+	BatchActivityTaskMaxBatchSize: {
+		Key:         BatchActivityTaskMaxBatchSize,
+		Type:        TypeInt,
+		Defaults:    []string{"1000"},
+		Description: "BatchActivityTaskMaxBatchSize is the max number of activity tasks accepted by a single BatchCompleteActivityTasksById or BatchFailActivityTasksById call",
+	},
+	BatchActivityTaskTimeout: {
+		Key:         BatchActivityTaskTimeout,
+		Type:        TypeDuration,
+		Defaults:    []string{"10s"},
+		Filters:     []Filter{Namespace},
+		Description: "BatchActivityTaskTimeout is the timeout of the history calls issued for each activity task of a BatchCompleteActivityTasksById or BatchFailActivityTasksById call",
+	},
+	BatchDescribeMutableStateConcurrency: {
+		Key:         BatchDescribeMutableStateConcurrency,
+		Type:        TypeInt,
+		Defaults:    []string{"50"},
+		Description: "BatchDescribeMutableStateConcurrency is the max number of concurrent history calls issued by a single BatchDescribeMutableState call",
+	},
+	BatchDescribeMutableStateMaxBatchSize: {
+		Key:         BatchDescribeMutableStateMaxBatchSize,
+		Type:        TypeInt,
+		Defaults:    []string{"1000"},
+		Description: "BatchDescribeMutableStateMaxBatchSize is the max number of executions accepted by a single BatchDescribeMutableState call",
+	},
+	FrontendClaimCheckThreshold: {
+		Key:         FrontendClaimCheckThreshold,
+		Type:        TypeInt,
+		Defaults:    []string{"0"},
+		Filters:     []Filter{Namespace},
+		Description: "FrontendClaimCheckThreshold is the payload size above which input and result payloads are offloaded to the claim check store and replaced by references, 0 disables offloading",
+	},
+	DisableListVisibilityByFilter: {
+		Key:         DisableListVisibilityByFilter,
+		Type:        TypeBool,
+		Defaults:    []string{"false"},
+		Filters:     []Filter{Namespace},
+		Description: "DisableListVisibilityByFilter is config to disable list open/close workflow using filter",
+	},
+	EnableClientVersionCheck: {
+		Key:         EnableClientVersionCheck,
+		Type:        TypeBool,
+		Defaults:    []string{"true"},
+		Description: "EnableClientVersionCheck enables client version check for frontend",
+	},
+	EnableServerVersionCheck: {
+		Key:             EnableServerVersionCheck,
+		Type:            TypeBool,
+		ComputedDefault: true,
+		Description:     "EnableServerVersionCheck is a flag that controls whether or not periodic version checking is enabled",
+	},
+	EnableTokenNamespaceEnforcement: {
+		Key:         EnableTokenNamespaceEnforcement,
+		Type:        TypeBool,
+		Defaults:    []string{"false"},
+		Description: "EnableTokenNamespaceEnforcement enables enforcement that namespace in completion token matches namespace of the request",
+	},
+	FrontendESIndexMaxResultWindow: {
+		Key:         FrontendESIndexMaxResultWindow,
+		Type:        TypeInt,
+		Defaults:    []string{"10000"},
+		Description: "FrontendESIndexMaxResultWindow is ElasticSearch index setting max_result_window",
+	},
+	FrontendESVisibilityListMaxQPS: {
+		Key:         FrontendESVisibilityListMaxQPS,
+		Type:        TypeInt,
+		Defaults:    []string{"10"},
+		Filters:     []Filter{Namespace},
+		Description: "FrontendESVisibilityListMaxQPS is max qps frontend can list open/close workflows from ElasticSearch",
+	},
+	FrontendGlobalNamespaceRPS: {
+		Key:         FrontendGlobalNamespaceRPS,
+		Type:        TypeInt,
+		Defaults:    []string{"0"},
+		Filters:     []Filter{Namespace},
+		Description: "FrontendGlobalNamespaceRPS is workflow namespace rate limit per second for the whole cluster",
+	},
+	FrontendHistoryMaxPageSize: {
+		Key:         FrontendHistoryMaxPageSize,
+		Type:        TypeInt,
+		Defaults:    []string{"256"},
+		Filters:     []Filter{Namespace},
+		Description: "FrontendHistoryMaxPageSize is default max size for GetWorkflowExecutionHistory in one page",
+	},
+	FrontendHistoryMgrNumConns: {
+		Key:         FrontendHistoryMgrNumConns,
+		Type:        TypeUnknown,
+		Description: "FrontendHistoryMgrNumConns is for persistence cluster.NumConns",
+	},
+	FrontendMaxBadBinaries: {
+		Key:         FrontendMaxBadBinaries,
+		Type:        TypeInt,
+		Defaults:    []string{"10"},
+		Filters:     []Filter{Namespace},
+		Description: "FrontendMaxBadBinaries is the max number of bad binaries in namespace config",
+	},
+	FrontendMaxNamespaceRPSPerInstance: {
+		Key:         FrontendMaxNamespaceRPSPerInstance,
+		Type:        TypeInt,
+		Defaults:    []string{"1200"},
+		Filters:     []Filter{Namespace},
+		Description: "FrontendMaxNamespaceRPSPerInstance is workflow namespace rate limit per second",
+	},
+	FrontendPersistenceGlobalMaxQPS: {
+		Key:         FrontendPersistenceGlobalMaxQPS,
+		Type:        TypeInt,
+		Defaults:    []string{"0"},
+		Description: "FrontendPersistenceGlobalMaxQPS is the max qps frontend cluster can query DB",
+	},
+	FrontendPersistenceMaxQPS: {
+		Key:         FrontendPersistenceMaxQPS,
+		Type:        TypeInt,
+		Defaults:    []string{"2000"},
+		Description: "FrontendPersistenceMaxQPS is the max qps frontend host can query DB",
+	},
+	FrontendRPS: {
+		Key:         FrontendRPS,
+		Type:        TypeInt,
+		Defaults:    []string{"1200"},
+		Description: "FrontendRPS is workflow rate limit per second",
+	},
+	SearchAttributesNumberOfKeysLimit: {
+		Key:         SearchAttributesNumberOfKeysLimit,
+		Type:        TypeInt,
+		Defaults:    []string{"100"},
+		Filters:     []Filter{Namespace},
+		Description: "SearchAttributesNumberOfKeysLimit is the limit of number of keys",
+	},
+	SearchAttributesSizeOfValueLimit: {
+		Key:         SearchAttributesSizeOfValueLimit,
+		Type:        TypeInt,
+		Defaults:    []string{"2048"},
+		Filters:     []Filter{Namespace},
+		Description: "SearchAttributesSizeOfValueLimit is the size limit of each value",
+	},
+	SearchAttributesTotalSizeLimit: {
+		Key:         SearchAttributesTotalSizeLimit,
+		Type:        TypeInt,
+		Defaults:    []string{"40960"},
+		Filters:     []Filter{Namespace},
+		Description: "SearchAttributesTotalSizeLimit is the size limit of the whole map",
+	},
+	SendRawWorkflowHistory: {
+		Key:         SendRawWorkflowHistory,
+		Type:        TypeBool,
+		Defaults:    []string{"false"},
+		Filters:     []Filter{Namespace},
+		Description: "SendRawWorkflowHistory is whether to enable raw history retrieving",
+	},
+	FrontendShutdownDrainDuration: {
+		Key:         FrontendShutdownDrainDuration,
+		Type:        TypeDuration,
+		Defaults:    []string{"0s"},
+		Description: "FrontendShutdownDrainDuration is the duration of traffic drain during shutdown",
+	},
+	FrontendThrottledLogRPS: {
+		Key:         FrontendThrottledLogRPS,
+		Type:        TypeInt,
+		Defaults:    []string{"20"},
+		Description: "FrontendThrottledLogRPS is the rate limit on number of log messages emitted per second for throttled logger",
+	},
+	ValidSearchAttributes: {
+		Key:             ValidSearchAttributes,
+		Type:            TypeMap,
+		ComputedDefault: true,
+		Description:     "ValidSearchAttributes is legal indexed keys that can be used in list APIs",
+	},
+	VisibilityArchivalQueryMaxPageSize: {
+		Key:         VisibilityArchivalQueryMaxPageSize,
+		Type:        TypeInt,
+		Defaults:    []string{"10000"},
+		Description: "VisibilityArchivalQueryMaxPageSize is the maximum page size for a visibility archival query",
+	},
+	VisibilityArchivalQueryMaxQPS: {
+		Key:         VisibilityArchivalQueryMaxQPS,
+		Type:        TypeUnknown,
+		Description: "VisibilityArchivalQueryMaxQPS is the timeout for a visibility archival query",
+	},
+	VisibilityArchivalQueryMaxRangeInDays: {
+		Key:         VisibilityArchivalQueryMaxRangeInDays,
+		Type:        TypeUnknown,
+		Description: "VisibilityArchivalQueryMaxRangeInDays is the maximum number of days for a visibility archival query",
+	},
+	FrontendVisibilityListMaxQPS: {
+		Key:         FrontendVisibilityListMaxQPS,
+		Type:        TypeInt,
+		Defaults:    []string{"30"},
+		Filters:     []Filter{Namespace},
+		Description: "FrontendVisibilityListMaxQPS is max qps frontend can list open/close workflows",
+	},
+	FrontendVisibilityMaxPageSize: {
+		Key:         FrontendVisibilityMaxPageSize,
+		Type:        TypeInt,
+		Defaults:    []string{"1000"},
+		Filters:     []Filter{Namespace},
+		Description: "FrontendVisibilityMaxPageSize is default max size for ListWorkflowExecutions in one page",
+	},
+	EnableDropStuckTaskByNamespaceID: {
+		Key:         EnableDropStuckTaskByNamespaceID,
+		Type:        TypeBool,
+		Defaults:    []string{"false"},
+		Filters:     []Filter{NamespaceID},
+		Description: "EnableDropStuckTaskByNamespaceID is whether stuck timer/transfer task should be dropped for a namespace",
+	},
+	MaxBufferedQueryCount: {
+		Key:         MaxBufferedQueryCount,
+		Type:        TypeInt,
+		Defaults:    []string{"1"},
+		Description: "EnableConsistentQuery indicates if consistent query is enabled for the cluster",
+	},
+	ReplicationEventsFromCurrentCluster: {
+		Key:         ReplicationEventsFromCurrentCluster,
+		Type:        TypeBool,
+		Defaults:    []string{"false"},
+		Filters:     []Filter{Namespace},
+		Description: "ReplicationEventsFromCurrentCluster is a feature flag to allow cross DC replicate events that generated from the current cluster",
+	},
+	ReplicationTaskFetcherAggregationInterval: {
+		Key:         ReplicationTaskFetcherAggregationInterval,
+		Type:        TypeDuration,
+		Defaults:    []string{"2s"},
+		Description: "ReplicationTaskFetcherAggregationInterval determines how frequently the fetch requests are sent",
+	},
+	ReplicationTaskFetcherEnableCompression: {
+		Key:         ReplicationTaskFetcherEnableCompression,
+		Type:        TypeBool,
+		Defaults:    []string{"false"},
+		Description: "ReplicationTaskFetcherEnableCompression indicates whether replication task fetch requests & responses are gzip compressed",
+	},
+	ReplicationTaskFetcherErrorRetryWait: {
+		Key:         ReplicationTaskFetcherErrorRetryWait,
+		Type:        TypeDuration,
+		Defaults:    []string{"1s"},
+		Description: "ReplicationTaskFetcherErrorRetryWait is the wait time when fetcher encounters error",
+	},
+	ReplicationTaskFetcherMaxShardsPerRequest: {
+		Key:         ReplicationTaskFetcherMaxShardsPerRequest,
+		Type:        TypeInt,
+		Defaults:    []string{"0"},
+		Description: "ReplicationTaskFetcherMaxShardsPerRequest is the max number of shards batched in one replication task fetch request, 0 means no limit",
+	},
+	ReplicationTaskFetcherParallelism: {
+		Key:         ReplicationTaskFetcherParallelism,
+		Type:        TypeInt,
+		Defaults:    []string{"4"},
+		Description: "ReplicationTaskFetcherParallelism determines how many go routines we spin up for fetching tasks",
+	},
+	ReplicationTaskFetcherTimerJitterCoefficient: {
+		Key:         ReplicationTaskFetcherTimerJitterCoefficient,
+		Type:        TypeFloat,
+		Defaults:    []string{"0.15"},
+		Min:         "0",
+		Max:         "1",
+		Description: "ReplicationTaskFetcherTimerJitterCoefficient is the jitter for fetcher timer",
+	},
+	ReplicationTaskProcessorCleanupInterval: {
+		Key:         ReplicationTaskProcessorCleanupInterval,
+		Type:        TypeDuration,
+		Defaults:    []string{"1m0s"},
+		Filters:     []Filter{ShardID},
+		Description: "ReplicationTaskProcessorCleanupInterval determines how frequently the cleanup replication queue",
+	},
+	ReplicationTaskProcessorCleanupJitterCoefficient: {
+		Key:         ReplicationTaskProcessorCleanupJitterCoefficient,
+		Type:        TypeFloat,
+		Defaults:    []string{"0.15"},
+		Min:         "0",
+		Max:         "1",
+		Filters:     []Filter{ShardID},
+		Description: "ReplicationTaskProcessorCleanupJitterCoefficient is the jitter for cleanup timer",
+	},
+	ReplicationTaskProcessorErrorRetryBackoffCoefficient: {
+		Key:         ReplicationTaskProcessorErrorRetryBackoffCoefficient,
+		Type:        TypeFloat,
+		Defaults:    []string{"1.2"},
+		Filters:     []Filter{ShardID},
+		Description: "ReplicationTaskProcessorErrorRetryBackoffCoefficient is the retry wait backoff time coefficient",
+	},
+	ReplicationTaskProcessorErrorRetryExpiration: {
+		Key:         ReplicationTaskProcessorErrorRetryExpiration,
+		Type:        TypeDuration,
+		Defaults:    []string{"5m0s"},
+		Filters:     []Filter{ShardID},
+		Description: "ReplicationTaskProcessorErrorRetryExpiration is the max retry duration for applying replication tasks",
+	},
+	ReplicationTaskProcessorErrorRetryMaxAttempts: {
+		Key:         ReplicationTaskProcessorErrorRetryMaxAttempts,
+		Type:        TypeInt,
+		Defaults:    []string{"80"},
+		Filters:     []Filter{ShardID},
+		Description: "ReplicationTaskProcessorErrorRetryMaxAttempts is the max retry attempts for applying replication tasks",
+	},
+	ReplicationTaskProcessorErrorRetryMaxInterval: {
+		Key:         ReplicationTaskProcessorErrorRetryMaxInterval,
+		Type:        TypeDuration,
+		Defaults:    []string{"5s"},
+		Filters:     []Filter{ShardID},
+		Description: "ReplicationTaskProcessorErrorRetryMaxInterval is the retry wait backoff max duration",
+	},
+	ReplicationTaskProcessorErrorRetryWait: {
+		Key:         ReplicationTaskProcessorErrorRetryWait,
+		Type:        TypeDuration,
+		Defaults:    []string{"1s"},
+		Filters:     []Filter{ShardID},
+		Description: "ReplicationTaskProcessorErrorRetryWait is the initial retry wait when we see errors in applying replication tasks",
+	},
+	ReplicationTaskProcessorHostQPS: {
+		Key:         ReplicationTaskProcessorHostQPS,
+		Type:        TypeFloat,
+		Defaults:    []string{"1500"},
+		Description: "ReplicationTaskProcessorHostQPS is the qps of task processing rate limiter on host level",
+	},
+	ReplicationTaskProcessorNoTaskInitialWait: {
+		Key:         ReplicationTaskProcessorNoTaskInitialWait,
+		Type:        TypeDuration,
+		Defaults:    []string{"2s"},
+		Filters:     []Filter{ShardID},
+		Description: "ReplicationTaskProcessorNoTaskInitialWait is the wait time when not ask is returned",
+	},
+	ReplicationTaskProcessorShardQPS: {
+		Key:         ReplicationTaskProcessorShardQPS,
+		Type:        TypeFloat,
+		Defaults:    []string{"30"},
+		Description: "ReplicationTaskProcessorShardQPS is the qps of task processing rate limiter on shard level",
+	},
+	ReplicationTaskProcessorStartWait: {
+		Key:         ReplicationTaskProcessorStartWait,
+		Type:        TypeDuration,
+		Defaults:    []string{"5s"},
+		Filters:     []Filter{ShardID},
+		Description: "ReplicationTaskProcessorStartWait is the wait time before each task processing batch",
+	},
+	ReplicationTaskProcessorStartWaitJitterCoefficient: {
+		Key:         ReplicationTaskProcessorStartWaitJitterCoefficient,
+		Type:        TypeFloat,
+		Defaults:    []string{"0.9"},
+		Min:         "0",
+		Max:         "1",
+		Filters:     []Filter{ShardID},
+		Description: "ReplicationTaskProcessorStartWaitJitterCoefficient is the jitter for batch start wait timer",
+	},
+	SkipReapplicationByNamespaceId: {
+		Key:         SkipReapplicationByNamespaceId,
+		Type:        TypeBool,
+		Defaults:    []string{"false"},
+		Filters:     []Filter{NamespaceID},
+		Description: "SkipReapplicationByNameSpaceId is whether skipping a event re-application for a namespace",
+	},
+	AcquireShardConcurrency: {
+		Key:         AcquireShardConcurrency,
+		Type:        TypeInt,
+		Defaults:    []string{"10"},
+		Description: "AcquireShardConcurrency is number of goroutines that can be used to acquire shards in the shard controller.",
+	},
+	AcquireShardInterval: {
+		Key:         AcquireShardInterval,
+		Type:        TypeDuration,
+		Defaults:    []string{"1m0s"},
+		Description: "AcquireShardInterval is interval that timer used to acquire shard",
+	},
+	ActivityRetryPolicyOverride: {
+		Key:             ActivityRetryPolicyOverride,
+		Type:            TypeMap,
+		ComputedDefault: true,
+		Filters:         []Filter{Namespace, ActivityType},
+		Description:     "ActivityRetryPolicyOverride is the operator provided retry policy fields keyed by namespace and activity type which take precedence over the retry policy specified by the user. The value constrained by both namespace and activity type replaces the value constrained by namespace only.",
+	},
+	ArchiveRequestRPS: {
+		Key:         ArchiveRequestRPS,
+		Type:        TypeInt,
+		Defaults:    []string{"300"},
+		Description: "ArchiveRequestRPS is the rate limit on the number of archive request per second",
+	},
+	HistoryCacheInitialSize: {
+		Key:         HistoryCacheInitialSize,
+		Type:        TypeInt,
+		Defaults:    []string{"128"},
+		Description: "HistoryCacheInitialSize is initial size of history cache",
+	},
+	HistoryCacheMaxSize: {
+		Key:         HistoryCacheMaxSize,
+		Type:        TypeInt,
+		Defaults:    []string{"512"},
+		Description: "HistoryCacheMaxSize is max size of history cache",
+	},
+	HistoryCacheTTL: {
+		Key:         HistoryCacheTTL,
+		Type:        TypeDuration,
+		Defaults:    []string{"1h0m0s"},
+		Description: "HistoryCacheTTL is TTL of history cache",
+	},
+	DefaultActivityRetryPolicy: {
+		Key:             DefaultActivityRetryPolicy,
+		Type:            TypeMap,
+		ComputedDefault: true,
+		Filters:         []Filter{Namespace},
+		Description:     "DefaultActivityRetryPolicy represents the out-of-box retry policy for activities where the user has not specified an explicit RetryPolicy",
+	},
+	DefaultEventEncoding: {
+		Key:             DefaultEventEncoding,
+		Type:            TypeString,
+		ComputedDefault: true,
+		Filters:         []Filter{Namespace},
+		Description:     "DefaultEventEncoding is the encoding type for history events",
+	},
+	DefaultWorkflowRetryPolicy: {
+		Key:             DefaultWorkflowRetryPolicy,
+		Type:            TypeMap,
+		ComputedDefault: true,
+		Filters:         []Filter{Namespace},
+		Description:     "DefaultWorkflowRetryPolicy represents the out-of-box retry policy for unset fields where the user has set an explicit RetryPolicy, but not specified all the fields",
+	},
+	DefaultWorkflowTaskTimeout: {
+		Key:         DefaultWorkflowTaskTimeout,
+		Type:        TypeDuration,
+		Defaults:    []string{"10s"},
+		Filters:     []Filter{Namespace},
+		Description: "DefaultWorkflowTaskTimeout for a workflow task",
+	},
+	EmitShardDiffLog: {
+		Key:         EmitShardDiffLog,
+		Type:        TypeBool,
+		Defaults:    []string{"false"},
+		Description: "EmitShardDiffLog whether emit the shard diff log",
+	},
+	EnableCrossClusterOperations: {
+		Key:         EnableCrossClusterOperations,
+		Type:        TypeBool,
+		Defaults:    []string{"false"},
+		Filters:     []Filter{Namespace},
+		Description: "EnableCrossClusterOperations is to indicate if child workflows and signals of a namespace can target namespaces active in a remote cluster",
+	},
+	EnableParentClosePolicy: {
+		Key:         EnableParentClosePolicy,
+		Type:        TypeBool,
+		Defaults:    []string{"true"},
+		Filters:     []Filter{Namespace},
+		Description: "EnableParentClosePolicy whether to ParentClosePolicy",
+	},
+	EventsCacheInitialSize: {
+		Key:         EventsCacheInitialSize,
+		Type:        TypeInt,
+		Defaults:    []string{"128"},
+		Description: "EventsCacheInitialSize is initial size of events cache",
+	},
+	EventsCacheMaxSize: {
+		Key:         EventsCacheMaxSize,
+		Type:        TypeInt,
+		Defaults:    []string{"512"},
+		Description: "EventsCacheMaxSize is max size of events cache",
+	},
+	EventsCacheTTL: {
+		Key:         EventsCacheTTL,
+		Type:        TypeDuration,
+		Defaults:    []string{"1h0m0s"},
+		Description: "EventsCacheTTL is TTL of events cache",
+	},
+	HistoryMaxAutoResetPoints: {
+		Key:         HistoryMaxAutoResetPoints,
+		Type:        TypeInt,
+		Defaults:    []string{"20"},
+		Filters:     []Filter{Namespace},
+		Description: "HistoryMaxAutoResetPoints is the key for max number of auto reset points stored in mutableState",
+	},
+	HistoryVisibilityClosedMaxQPS: {
+		Key:         HistoryVisibilityClosedMaxQPS,
+		Type:        TypeInt,
+		Defaults:    []string{"300"},
+		Filters:     []Filter{Namespace},
+		Description: "HistoryVisibilityClosedMaxQPS is max qps one history host can write visibility closed_executions",
+	},
+	HistoryVisibilityOpenMaxQPS: {
+		Key:         HistoryVisibilityOpenMaxQPS,
+		Type:        TypeInt,
+		Defaults:    []string{"300"},
+		Filters:     []Filter{Namespace},
+		Description: "HistoryVisibilityOpenMaxQPS is max qps one history host can write visibility open_executions",
+	},
+	HistoryLongPollExpirationInterval: {
+		Key:         HistoryLongPollExpirationInterval,
+		Type:        TypeDuration,
+		Defaults:    []string{"10s", "20s"},
+		Filters:     []Filter{Namespace},
+		Description: "HistoryLongPollExpirationInterval is the long poll expiration interval in the history service",
+	},
+	MaximumBufferedEventsBatch: {
+		Key:         MaximumBufferedEventsBatch,
+		Type:        TypeInt,
+		Defaults:    []string{"100"},
+		Description: "MaximumBufferedEventsBatch is max number of buffer event in mutable state",
+	},
+	MaximumSignalsPerExecution: {
+		Key:         MaximumSignalsPerExecution,
+		Type:        TypeInt,
+		Defaults:    []string{"0"},
+		Filters:     []Filter{Namespace},
+		Description: "MaximumSignalsPerExecution is max number of signals supported by single execution",
+	},
+	MutableStateChecksumGenProbability: {
+		Key:         MutableStateChecksumGenProbability,
+		Type:        TypeInt,
+		Defaults:    []string{"0"},
+		Filters:     []Filter{Namespace},
+		Description: "MutableStateChecksumGenProbability is the probability [0-100] that checksum will be generated for mutable state",
+	},
+	MutableStateChecksumInvalidateBefore: {
+		Key:         MutableStateChecksumInvalidateBefore,
+		Type:        TypeFloat,
+		Defaults:    []string{"0"},
+		Description: "MutableStateChecksumInvalidateBefore is the epoch timestamp before which all checksums are to be discarded",
+	},
+	MutableStateChecksumVerifyProbability: {
+		Key:         MutableStateChecksumVerifyProbability,
+		Type:        TypeInt,
+		Defaults:    []string{"0"},
+		Filters:     []Filter{Namespace},
+		Description: "MutableStateChecksumVerifyProbability is the probability [0-100] that checksum will be verified for mutable state",
+	},
+	NumArchiveSystemWorkflows: {
+		Key:         NumArchiveSystemWorkflows,
+		Type:        TypeInt,
+		Defaults:    []string{"1000"},
+		Description: "NumArchiveSystemWorkflows is key for number of archive system workflows running in total",
+	},
+	NumParentClosePolicySystemWorkflows: {
+		Key:         NumParentClosePolicySystemWorkflows,
+		Type:        TypeInt,
+		Defaults:    []string{"10"},
+		Description: "NumParentClosePolicySystemWorkflows is key for number of parentClosePolicy system workflows running in total",
+	},
+	ParentClosePolicyThreshold: {
+		Key:         ParentClosePolicyThreshold,
+		Type:        TypeInt,
+		Defaults:    []string{"10"},
+		Filters:     []Filter{Namespace},
+		Description: "ParentClosePolicyThreshold decides that parent close policy will be processed by sys workers(if enabled) if the number of children greater than or equal to this threshold",
+	},
+	HistoryPersistenceGlobalMaxQPS: {
+		Key:         HistoryPersistenceGlobalMaxQPS,
+		Type:        TypeInt,
+		Defaults:    []string{"0"},
+		Description: "HistoryPersistenceGlobalMaxQPS is the max qps history cluster can query DB",
+	},
+	HistoryPersistenceMaxQPS: {
+		Key:         HistoryPersistenceMaxQPS,
+		Type:        TypeInt,
+		Defaults:    []string{"3000", "9000"},
+		Description: "HistoryPersistenceMaxQPS is the max qps history host can query DB",
+	},
+	ReplicatorProcessorEnablePriorityTaskProcessor: {
+		Key:         ReplicatorProcessorEnablePriorityTaskProcessor,
+		Type:        TypeBool,
+		Defaults:    []string{"false"},
+		Description: "ReplicatorProcessorEnablePriorityTaskProcessor indicates whether priority task processor should be used for ReplicatorProcessor",
+	},
+	ReplicatorProcessorMaxPollInterval: {
+		Key:         ReplicatorProcessorMaxPollInterval,
+		Type:        TypeDuration,
+		Defaults:    []string{"1m0s"},
+		Description: "ReplicatorProcessorMaxPollInterval is max poll interval for ReplicatorProcessor",
+	},
+	ReplicatorProcessorMaxPollIntervalJitterCoefficient: {
+		Key:         ReplicatorProcessorMaxPollIntervalJitterCoefficient,
+		Type:        TypeFloat,
+		Defaults:    []string{"0.15"},
+		Min:         "0",
+		Max:         "1",
+		Description: "ReplicatorProcessorMaxPollIntervalJitterCoefficient is the max poll interval jitter coefficient",
+	},
+	ReplicatorProcessorMaxPollRPS: {
+		Key:         ReplicatorProcessorMaxPollRPS,
+		Type:        TypeInt,
+		Defaults:    []string{"20"},
+		Description: "ReplicatorProcessorMaxPollRPS is max poll rate per second for ReplicatorProcessor",
+	},
+	ReplicatorProcessorMaxRedispatchQueueSize: {
+		Key:         ReplicatorProcessorMaxRedispatchQueueSize,
+		Type:        TypeInt,
+		Defaults:    []string{"10000"},
+		Description: "ReplicatorProcessorMaxRedispatchQueueSize is the threshold of the number of tasks in the redispatch queue for ReplicatorProcessor",
+	},
+	ReplicatorProcessorRedispatchInterval: {
+		Key:         ReplicatorProcessorRedispatchInterval,
+		Type:        TypeDuration,
+		Defaults:    []string{"5s"},
+		Description: "ReplicatorProcessorRedispatchInterval is the redispatch interval for ReplicatorProcessor",
+	},
+	ReplicatorProcessorRedispatchIntervalJitterCoefficient: {
+		Key:         ReplicatorProcessorRedispatchIntervalJitterCoefficient,
+		Type:        TypeFloat,
+		Defaults:    []string{"0.15"},
+		Min:         "0",
+		Max:         "1",
+		Description: "ReplicatorProcessorRedispatchIntervalJitterCoefficient is the redispatch interval jitter coefficient",
+	},
+	ReplicatorProcessorUpdateAckInterval: {
+		Key:         ReplicatorProcessorUpdateAckInterval,
+		Type:        TypeDuration,
+		Defaults:    []string{"5s"},
+		Description: "ReplicatorProcessorUpdateAckInterval is update interval for ReplicatorProcessor",
+	},
+	ReplicatorProcessorUpdateAckIntervalJitterCoefficient: {
+		Key:         ReplicatorProcessorUpdateAckIntervalJitterCoefficient,
+		Type:        TypeFloat,
+		Defaults:    []string{"0.15"},
+		Min:         "0",
+		Max:         "1",
+		Description: "ReplicatorProcessorUpdateAckIntervalJitterCoefficient is the update interval jitter coefficient",
+	},
+	ReplicatorProcessorUpdateShardTaskCount: {
+		Key:         ReplicatorProcessorUpdateShardTaskCount,
+		Type:        TypeUnknown,
+		Description: "ReplicatorProcessorUpdateShardTaskCount is update shard count for ReplicatorProcessor",
+	},
+	ReplicatorTaskBatchSize: {
+		Key:         ReplicatorTaskBatchSize,
+		Type:        TypeInt,
+		Defaults:    []string{"100", "25"},
+		Description: "ReplicatorTaskBatchSize is batch size for ReplicatorProcessor",
+	},
+	ReplicatorTaskMaxRetryCount: {
+		Key:         ReplicatorTaskMaxRetryCount,
+		Type:        TypeInt,
+		Defaults:    []string{"100"},
+		Description: "ReplicatorTaskMaxRetryCount is max times of retry for ReplicatorProcessor",
+	},
+	ReplicatorTaskWorkerCount: {
+		Key:         ReplicatorTaskWorkerCount,
+		Type:        TypeInt,
+		Defaults:    []string{"10"},
+		Description: "ReplicatorTaskWorkerCount is number of worker for ReplicatorProcessor",
+	},
+	HistoryRPS: {
+		Key:         HistoryRPS,
+		Type:        TypeInt,
+		Defaults:    []string{"3000"},
+		Description: "HistoryRPS is request rate per second for each history host",
+	},
+	ShardSyncMinInterval: {
+		Key:         ShardSyncMinInterval,
+		Type:        TypeDuration,
+		Defaults:    []string{"5m0s"},
+		Description: "ShardSyncMinInterval is the minimal time interval which the shard info should be sync to remote",
+	},
+	ShardSyncTimerJitterCoefficient: {
+		Key:         ShardSyncTimerJitterCoefficient,
+		Type:        TypeFloat,
+		Defaults:    []string{"0.15"},
+		Min:         "0",
+		Max:         "1",
+		Description: "ShardSyncTimerJitterCoefficient is the sync shard jitter coefficient",
+	},
+	ShardUpdateMinInterval: {
+		Key:         ShardUpdateMinInterval,
+		Type:        TypeDuration,
+		Defaults:    []string{"5m0s"},
+		Description: "ShardUpdateMinInterval is the minimal time interval which the shard info can be updated",
+	},
+	HistoryShutdownDrainDuration: {
+		Key:         HistoryShutdownDrainDuration,
+		Type:        TypeDuration,
+		Defaults:    []string{"0s"},
+		Description: "HistoryShutdownDrainDuration is the duration of traffic drain during shutdown",
+	},
+	StandbyClusterDelay: {
+		Key:         StandbyClusterDelay,
+		Type:        TypeDuration,
+		Defaults:    []string{"5m0s"},
+		Description: "StandbyClusterDelay is the artificial delay added to standby cluster's view of active cluster's time",
+	},
+	StandbyTaskMissingEventsDiscardDelay: {
+		Key:         StandbyTaskMissingEventsDiscardDelay,
+		Type:        TypeDuration,
+		Defaults:    []string{"15m0s"},
+		Description: "StandbyTaskMissingEventsDiscardDelay is the amount of time standby cluster's will wait (if events are missing) before discarding the task",
+	},
+	StandbyTaskMissingEventsResendDelay: {
+		Key:         StandbyTaskMissingEventsResendDelay,
+		Type:        TypeDuration,
+		Defaults:    []string{"10m0s"},
+		Description: "StandbyTaskMissingEventsResendDelay is the amount of time standby cluster's will wait (if events are missing) before calling remote for missing events",
+	},
+	StandbyTaskReReplicationContextTimeout: {
+		Key:         StandbyTaskReReplicationContextTimeout,
+		Type:        TypeDuration,
+		Defaults:    []string{"3m0s"},
+		Filters:     []Filter{NamespaceID},
+		Description: "StandbyTaskReReplicationContextTimeout is the context timeout for standby task re-replication",
+	},
+	StickyTTL: {
+		Key:         StickyTTL,
+		Type:        TypeDuration,
+		Defaults:    []string{"8760h0m0s"},
+		Filters:     []Filter{Namespace},
+		Description: "StickyTTL is to expire a sticky taskqueue if no update more than this duration",
+	},
+	TaskProcessRPS: {
+		Key:         TaskProcessRPS,
+		Type:        TypeInt,
+		Defaults:    []string{"1000"},
+		Filters:     []Filter{Namespace},
+		Description: "TaskProcessRPS is the task processing rate per second for each namespace",
+	},
+	TaskSchedulerQueueSize: {
+		Key:         TaskSchedulerQueueSize,
+		Type:        TypeInt,
+		Defaults:    []string{"2000"},
+		Description: "TaskSchedulerQueueSize is the size of task channel size in task scheduler",
+	},
+	TaskSchedulerRoundRobinWeights: {
+		Key:             TaskSchedulerRoundRobinWeights,
+		Type:            TypeMap,
+		ComputedDefault: true,
+		Description:     "TaskSchedulerRoundRobinWeights is the priority weight for weighted round robin task scheduler",
+	},
+	TaskSchedulerType: {
+		Key:           TaskSchedulerType,
+		Type:          TypeInt,
+		Defaults:      []string{"2"},
+		AllowedValues: []string{"1", "2"},
+		Description:   "TaskSchedulerType is the task scheduler type for priority task processor, 1 for FIFO and 2 for weighted round robin",
+	},
+	TaskSchedulerWorkerCount: {
+		Key:         TaskSchedulerWorkerCount,
+		Type:        TypeInt,
+		Defaults:    []string{"20"},
+		Description: "TaskSchedulerWorkerCount is the number of workers per shard in task scheduler",
+	},
+	HistoryThrottledLogRPS: {
+		Key:         HistoryThrottledLogRPS,
+		Type:        TypeInt,
+		Defaults:    []string{"4"},
+		Description: "HistoryThrottledLogRPS is the rate limit on number of log messages emitted per second for throttled logger",
+	},
+	TimerProcessorArchivalTimeLimit: {
+		Key:         TimerProcessorArchivalTimeLimit,
+		Type:        TypeDuration,
+		Defaults:    []string{"1s"},
+		Description: "TimerProcessorArchivalTimeLimit is the upper time limit for inline history archival",
+	},
+	TimerProcessorCompleteTimerFailureRetryCount: {
+		Key:         TimerProcessorCompleteTimerFailureRetryCount,
+		Type:        TypeInt,
+		Defaults:    []string{"10"},
+		Description: "TimerProcessorCompleteTimerFailureRetryCount is retry count for timer processor complete timer operation",
+	},
+	TimerProcessorCompleteTimerInterval: {
+		Key:         TimerProcessorCompleteTimerInterval,
+		Type:        TypeDuration,
+		Defaults:    []string{"1m0s"},
+		Description: "TimerProcessorCompleteTimerInterval is complete timer interval for timer processor",
+	},
+	TimerProcessorEnablePriorityTaskProcessor: {
+		Key:         TimerProcessorEnablePriorityTaskProcessor,
+		Type:        TypeBool,
+		Defaults:    []string{"false"},
+		Description: "TimerProcessorEnablePriorityTaskProcessor indicates whether priority task processor should be used for timer processor",
+	},
+	TimerProcessorFailoverMaxPollRPS: {
+		Key:         TimerProcessorFailoverMaxPollRPS,
+		Type:        TypeInt,
+		Defaults:    []string{"1"},
+		Description: "TimerProcessorFailoverMaxPollRPS is max poll rate per second for timer processor",
+	},
+	TimerProcessorGetFailureRetryCount: {
+		Key:         TimerProcessorGetFailureRetryCount,
+		Type:        TypeUnknown,
+		Description: "TimerProcessorGetFailureRetryCount is retry count for timer processor get failure operation",
+	},
+	TimerProcessorHistoryArchivalSizeLimit: {
+		Key:         TimerProcessorHistoryArchivalSizeLimit,
+		Type:        TypeInt,
+		Defaults:    []string{"512000"},
+		Description: "TimerProcessorHistoryArchivalSizeLimit is the max history size for inline archival",
+	},
+	TimerProcessorMaxPollInterval: {
+		Key:         TimerProcessorMaxPollInterval,
+		Type:        TypeDuration,
+		Defaults:    []string{"5m0s"},
+		Description: "TimerProcessorMaxPollInterval is max poll interval for timer processor",
+	},
+	TimerProcessorMaxPollIntervalJitterCoefficient: {
+		Key:         TimerProcessorMaxPollIntervalJitterCoefficient,
+		Type:        TypeFloat,
+		Defaults:    []string{"0.15"},
+		Min:         "0",
+		Max:         "1",
+		Description: "TimerProcessorMaxPollIntervalJitterCoefficient is the max poll interval jitter coefficient",
+	},
+	TimerProcessorMaxPollRPS: {
+		Key:         TimerProcessorMaxPollRPS,
+		Type:        TypeInt,
+		Defaults:    []string{"20"},
+		Description: "TimerProcessorMaxPollRPS is max poll rate per second for timer processor",
+	},
+	TimerProcessorMaxRedispatchQueueSize: {
+		Key:         TimerProcessorMaxRedispatchQueueSize,
+		Type:        TypeInt,
+		Defaults:    []string{"10000"},
+		Description: "TimerProcessorMaxRedispatchQueueSize is the threshold of the number of tasks in the redispatch queue for timer processor",
+	},
+	TimerProcessorMaxTimeShift: {
+		Key:         TimerProcessorMaxTimeShift,
+		Type:        TypeDuration,
+		Defaults:    []string{"1s"},
+		Description: "TimerProcessorMaxTimeShift is the max shift timer processor can have",
+	},
+	TimerProcessorRedispatchInterval: {
+		Key:         TimerProcessorRedispatchInterval,
+		Type:        TypeDuration,
+		Defaults:    []string{"5s"},
+		Description: "TimerProcessorRedispatchInterval is the redispatch interval for timer processor",
+	},
+	TimerProcessorRedispatchIntervalJitterCoefficient: {
+		Key:         TimerProcessorRedispatchIntervalJitterCoefficient,
+		Type:        TypeFloat,
+		Defaults:    []string{"0.15"},
+		Min:         "0",
+		Max:         "1",
+		Description: "TimerProcessorRedispatchIntervalJitterCoefficient is the redispatch interval jitter coefficient",
+	},
+	TimerProcessorUpdateAckInterval: {
+		Key:         TimerProcessorUpdateAckInterval,
+		Type:        TypeDuration,
+		Defaults:    []string{"30s"},
+		Description: "TimerProcessorUpdateAckInterval is update interval for timer processor",
+	},
+	TimerProcessorUpdateAckIntervalJitterCoefficient: {
+		Key:         TimerProcessorUpdateAckIntervalJitterCoefficient,
+		Type:        TypeFloat,
+		Defaults:    []string{"0.15"},
+		Min:         "0",
+		Max:         "1",
+		Description: "TimerProcessorUpdateAckIntervalJitterCoefficient is the update interval jitter coefficient",
+	},
+	TimerProcessorUpdateShardTaskCount: {
+		Key:         TimerProcessorUpdateShardTaskCount,
+		Type:        TypeUnknown,
+		Description: "TimerProcessorUpdateShardTaskCount is update shard count for timer processor",
+	},
+	TimerTaskBatchSize: {
+		Key:         TimerTaskBatchSize,
+		Type:        TypeInt,
+		Defaults:    []string{"100"},
+		Description: "TimerTaskBatchSize is batch size for timer processor to process tasks",
+	},
+	TimerTaskMaxRetryCount: {
+		Key:         TimerTaskMaxRetryCount,
+		Type:        TypeInt,
+		Defaults:    []string{"100"},
+		Description: "TimerTaskMaxRetryCount is max retry count for timer processor",
+	},
+	TimerTaskWorkerCount: {
+		Key:         TimerTaskWorkerCount,
+		Type:        TypeInt,
+		Defaults:    []string{"10"},
+		Description: "TimerTaskWorkerCount is number of task workers for timer processor",
+	},
+	TransferProcessorCompleteTransferFailureRetryCount: {
+		Key:         TransferProcessorCompleteTransferFailureRetryCount,
+		Type:        TypeInt,
+		Defaults:    []string{"10"},
+		Description: "TransferProcessorCompleteTransferFailureRetryCount is times of retry for failure",
+	},
+	TransferProcessorCompleteTransferInterval: {
+		Key:         TransferProcessorCompleteTransferInterval,
+		Type:        TypeDuration,
+		Defaults:    []string{"1m0s"},
+		Description: "TransferProcessorCompleteTransferInterval is complete timer interval for transferQueueProcessor",
+	},
+	TransferProcessorEnablePriorityTaskProcessor: {
+		Key:         TransferProcessorEnablePriorityTaskProcessor,
+		Type:        TypeBool,
+		Defaults:    []string{"false"},
+		Description: "TransferProcessorEnablePriorityTaskProcessor indicates whether priority task processor should be used for transferQueueProcessor",
+	},
+	TransferProcessorFailoverMaxPollRPS: {
+		Key:         TransferProcessorFailoverMaxPollRPS,
+		Type:        TypeInt,
+		Defaults:    []string{"1"},
+		Description: "TransferProcessorFailoverMaxPollRPS is max poll rate per second for transferQueueProcessor",
+	},
+	TransferProcessorMaxPollInterval: {
+		Key:         TransferProcessorMaxPollInterval,
+		Type:        TypeDuration,
+		Defaults:    []string{"1m0s"},
+		Description: "TransferProcessorMaxPollInterval max poll interval for transferQueueProcessor",
+	},
+	TransferProcessorMaxPollIntervalJitterCoefficient: {
+		Key:         TransferProcessorMaxPollIntervalJitterCoefficient,
+		Type:        TypeFloat,
+		Defaults:    []string{"0.15"},
+		Min:         "0",
+		Max:         "1",
+		Description: "TransferProcessorMaxPollIntervalJitterCoefficient is the max poll interval jitter coefficient",
+	},
+	TransferProcessorMaxPollRPS: {
+		Key:         TransferProcessorMaxPollRPS,
+		Type:        TypeInt,
+		Defaults:    []string{"20"},
+		Description: "TransferProcessorMaxPollRPS is max poll rate per second for transferQueueProcessor",
+	},
+	TransferProcessorMaxRedispatchQueueSize: {
+		Key:         TransferProcessorMaxRedispatchQueueSize,
+		Type:        TypeInt,
+		Defaults:    []string{"10000"},
+		Description: "TransferProcessorMaxRedispatchQueueSize is the threshold of the number of tasks in the redispatch queue for transferQueueProcessor",
+	},
+	TransferProcessorRedispatchInterval: {
+		Key:         TransferProcessorRedispatchInterval,
+		Type:        TypeDuration,
+		Defaults:    []string{"5s"},
+		Description: "TransferProcessorRedispatchInterval is the redispatch interval for transferQueueProcessor",
+	},
+	TransferProcessorRedispatchIntervalJitterCoefficient: {
+		Key:         TransferProcessorRedispatchIntervalJitterCoefficient,
+		Type:        TypeFloat,
+		Defaults:    []string{"0.15"},
+		Min:         "0",
+		Max:         "1",
+		Description: "TransferProcessorRedispatchIntervalJitterCoefficient is the redispatch interval jitter coefficient",
+	},
+	TransferProcessorUpdateAckInterval: {
+		Key:         TransferProcessorUpdateAckInterval,
+		Type:        TypeDuration,
+		Defaults:    []string{"30s"},
+		Description: "TransferProcessorUpdateAckInterval is update interval for transferQueueProcessor",
+	},
+	TransferProcessorUpdateAckIntervalJitterCoefficient: {
+		Key:         TransferProcessorUpdateAckIntervalJitterCoefficient,
+		Type:        TypeFloat,
+		Defaults:    []string{"0.15"},
+		Min:         "0",
+		Max:         "1",
+		Description: "TransferProcessorUpdateAckIntervalJitterCoefficient is the update interval jitter coefficient",
+	},
+	TransferProcessorUpdateShardTaskCount: {
+		Key:         TransferProcessorUpdateShardTaskCount,
+		Type:        TypeUnknown,
+		Description: "TransferProcessorUpdateShardTaskCount is update shard count for transferQueueProcessor",
+	},
+	TransferProcessorVisibilityArchivalTimeLimit: {
+		Key:         TransferProcessorVisibilityArchivalTimeLimit,
+		Type:        TypeDuration,
+		Defaults:    []string{"200ms"},
+		Description: "TransferProcessorVisibilityArchivalTimeLimit is the upper time limit for archiving visibility records",
+	},
+	TransferTaskBatchSize: {
+		Key:         TransferTaskBatchSize,
+		Type:        TypeInt,
+		Defaults:    []string{"100"},
+		Description: "TransferTaskBatchSize is batch size for transferQueueProcessor",
+	},
+	TransferTaskMaxRetryCount: {
+		Key:         TransferTaskMaxRetryCount,
+		Type:        TypeInt,
+		Defaults:    []string{"100"},
+		Description: "TransferTaskMaxRetryCount is max times of retry for transferQueueProcessor",
+	},
+	TransferTaskWorkerCount: {
+		Key:         TransferTaskWorkerCount,
+		Type:        TypeInt,
+		Defaults:    []string{"10"},
+		Description: "TransferTaskWorkerCount is number of worker for transferQueueProcessor",
+	},
+	VisibilityProcessorCompleteTaskFailureRetryCount: {
+		Key:         VisibilityProcessorCompleteTaskFailureRetryCount,
+		Type:        TypeInt,
+		Defaults:    []string{"10"},
+		Description: "VisibilityProcessorCompleteTaskFailureRetryCount is times of retry for failure",
+	},
+	VisibilityProcessorCompleteTaskInterval: {
+		Key:         VisibilityProcessorCompleteTaskInterval,
+		Type:        TypeDuration,
+		Defaults:    []string{"1m0s"},
+		Description: "VisibilityProcessorCompleteTaskInterval is complete timer interval for visibilityQueueProcessor",
+	},
+	VisibilityProcessorEnablePriorityTaskProcessor: {
+		Key:         VisibilityProcessorEnablePriorityTaskProcessor,
+		Type:        TypeBool,
+		Defaults:    []string{"false"},
+		Description: "VisibilityProcessorEnablePriorityTaskProcessor indicates whether priority task processor should be used for visibilityQueueProcessor",
+	},
+	VisibilityProcessorEnabled: {
+		Key:         VisibilityProcessorEnabled,
+		Type:        TypeBool,
+		Defaults:    []string{"true"},
+		Description: "VisibilityProcessorEnabled is to indicate if visibility processor should be enabled or not.",
+	},
+	VisibilityProcessorFailoverMaxPollRPS: {
+		Key:         VisibilityProcessorFailoverMaxPollRPS,
+		Type:        TypeInt,
+		Defaults:    []string{"1"},
+		Description: "VisibilityProcessorFailoverMaxPollRPS is max poll rate per second for visibilityQueueProcessor",
+	},
+	VisibilityProcessorMaxPollInterval: {
+		Key:         VisibilityProcessorMaxPollInterval,
+		Type:        TypeDuration,
+		Defaults:    []string{"1m0s"},
+		Description: "VisibilityProcessorMaxPollInterval max poll interval for visibilityQueueProcessor",
+	},
+	VisibilityProcessorMaxPollIntervalJitterCoefficient: {
+		Key:         VisibilityProcessorMaxPollIntervalJitterCoefficient,
+		Type:        TypeFloat,
+		Defaults:    []string{"0.15"},
+		Min:         "0",
+		Max:         "1",
+		Description: "VisibilityProcessorMaxPollIntervalJitterCoefficient is the max poll interval jitter coefficient",
+	},
+	VisibilityProcessorMaxPollRPS: {
+		Key:         VisibilityProcessorMaxPollRPS,
+		Type:        TypeInt,
+		Defaults:    []string{"20"},
+		Description: "VisibilityProcessorMaxPollRPS is max poll rate per second for visibilityQueueProcessor",
+	},
+	VisibilityProcessorMaxRedispatchQueueSize: {
+		Key:         VisibilityProcessorMaxRedispatchQueueSize,
+		Type:        TypeInt,
+		Defaults:    []string{"10000"},
+		Description: "VisibilityProcessorMaxRedispatchQueueSize is the threshold of the number of tasks in the redispatch queue for visibilityQueueProcessor",
+	},
+	VisibilityProcessorRedispatchInterval: {
+		Key:         VisibilityProcessorRedispatchInterval,
+		Type:        TypeDuration,
+		Defaults:    []string{"5s"},
+		Description: "VisibilityProcessorRedispatchInterval is the redispatch interval for visibilityQueueProcessor",
+	},
+	VisibilityProcessorRedispatchIntervalJitterCoefficient: {
+		Key:         VisibilityProcessorRedispatchIntervalJitterCoefficient,
+		Type:        TypeFloat,
+		Defaults:    []string{"0.15"},
+		Min:         "0",
+		Max:         "1",
+		Description: "VisibilityProcessorRedispatchIntervalJitterCoefficient is the redispatch interval jitter coefficient",
+	},
+	VisibilityProcessorUpdateAckInterval: {
+		Key:         VisibilityProcessorUpdateAckInterval,
+		Type:        TypeDuration,
+		Defaults:    []string{"30s"},
+		Description: "VisibilityProcessorUpdateAckInterval is update interval for visibilityQueueProcessor",
+	},
+	VisibilityProcessorUpdateAckIntervalJitterCoefficient: {
+		Key:         VisibilityProcessorUpdateAckIntervalJitterCoefficient,
+		Type:        TypeFloat,
+		Defaults:    []string{"0.15"},
+		Min:         "0",
+		Max:         "1",
+		Description: "VisibilityProcessorUpdateAckIntervalJitterCoefficient is the update interval jitter coefficient",
+	},
+	VisibilityProcessorUpdateShardTaskCount: {
+		Key:         VisibilityProcessorUpdateShardTaskCount,
+		Type:        TypeUnknown,
+		Description: "VisibilityProcessorUpdateShardTaskCount is update shard count for visibilityQueueProcessor",
+	},
+	VisibilityProcessorVisibilityArchivalTimeLimit: {
+		Key:         VisibilityProcessorVisibilityArchivalTimeLimit,
+		Type:        TypeDuration,
+		Defaults:    []string{"200ms"},
+		Description: "VisibilityProcessorVisibilityArchivalTimeLimit is the upper time limit for archiving visibility records",
+	},
+	VisibilityQueue: {
+		Key:         VisibilityQueue,
+		Type:        TypeString,
+		Defaults:    []string{"internal"},
+		Description: "VisibilityQueue is to indicate which visibility queue to use: \"Kafka\", \"InternalWithDualProcessor\", \"Internal\".",
+	},
+	VisibilityTaskBatchSize: {
+		Key:         VisibilityTaskBatchSize,
+		Type:        TypeInt,
+		Defaults:    []string{"100"},
+		Description: "VisibilityTaskBatchSize is batch size for visibilityQueueProcessor",
+	},
+	VisibilityTaskMaxRetryCount: {
+		Key:         VisibilityTaskMaxRetryCount,
+		Type:        TypeInt,
+		Defaults:    []string{"100"},
+		Description: "VisibilityTaskMaxRetryCount is max times of retry for visibilityQueueProcessor",
+	},
+	VisibilityTaskWorkerCount: {
+		Key:         VisibilityTaskWorkerCount,
+		Type:        TypeInt,
+		Defaults:    []string{"10"},
+		Description: "VisibilityTaskWorkerCount is number of worker for visibilityQueueProcessor",
+	},
+	WorkflowLockHoldWarnThreshold: {
+		Key:         WorkflowLockHoldWarnThreshold,
+		Type:        TypeDuration,
+		Defaults:    []string{"5s"},
+		Description: "WorkflowLockHoldWarnThreshold is the workflow execution lock hold time above which a warning with the workflow ID and the number of waiting requests is logged, 0 disables the warning",
+	},
+	WorkflowTagsNumberOfKeysLimit: {
+		Key:         WorkflowTagsNumberOfKeysLimit,
+		Type:        TypeInt,
+		Defaults:    []string{"64"},
+		Filters:     []Filter{Namespace},
+		Description: "WorkflowTagsNumberOfKeysLimit is the limit of number of non-indexed tags stored in mutableState",
+	},
+	WorkflowTagsTotalSizeLimit: {
+		Key:         WorkflowTagsTotalSizeLimit,
+		Type:        TypeInt,
+		Defaults:    []string{"16384"},
+		Filters:     []Filter{Namespace},
+		Description: "WorkflowTagsTotalSizeLimit is the size limit of all non-indexed tags stored in mutableState",
+	},
+	WorkflowTaskHeartbeatTimeout: {
+		Key:         WorkflowTaskHeartbeatTimeout,
+		Type:        TypeDuration,
+		Defaults:    []string{"30m0s"},
+		Filters:     []Filter{Namespace},
+		Description: "WorkflowTaskHeartbeatTimeout for workflow task heartbeat",
+	},
+	BlobSizeLimitError: {
+		Key:         BlobSizeLimitError,
+		Type:        TypeInt,
+		Defaults:    []string{"2097152"},
+		Filters:     []Filter{Namespace},
+		Description: "BlobSizeLimitError is the per event blob size limit",
+	},
+	BlobSizeLimitWarn: {
+		Key:         BlobSizeLimitWarn,
+		Type:        TypeInt,
+		Defaults:    []string{"262144", "524288"},
+		Filters:     []Filter{Namespace},
+		Description: "BlobSizeLimitWarn is the per event blob size limit for warning",
+	},
+	HistoryCountLimitError: {
+		Key:         HistoryCountLimitError,
+		Type:        TypeInt,
+		Defaults:    []string{"51200"},
+		Filters:     []Filter{Namespace},
+		Description: "HistoryCountLimitError is the per workflow execution history event count limit",
+	},
+	HistoryCountLimitWarn: {
+		Key:         HistoryCountLimitWarn,
+		Type:        TypeInt,
+		Defaults:    []string{"10240"},
+		Filters:     []Filter{Namespace},
+		Description: "HistoryCountLimitWarn is the per workflow execution history event count limit for warning",
+	},
+	HistorySizeLimitError: {
+		Key:         HistorySizeLimitError,
+		Type:        TypeInt,
+		Defaults:    []string{"52428800"},
+		Filters:     []Filter{Namespace},
+		Description: "HistorySizeLimitError is the per workflow execution history size limit",
+	},
+	HistorySizeLimitWarn: {
+		Key:         HistorySizeLimitWarn,
+		Type:        TypeInt,
+		Defaults:    []string{"10485760"},
+		Filters:     []Filter{Namespace},
+		Description: "HistorySizeLimitWarn is the per workflow execution history size limit for warning",
+	},
+	MaxIDLengthLimit: {
+		Key:         MaxIDLengthLimit,
+		Type:        TypeInt,
+		Defaults:    []string{"1000"},
+		Description: "MaxIDLengthLimit is the length limit for various IDs, including: Namespace, TaskQueue, WorkflowID, ActivityID, TimerID, WorkflowType, ActivityType, SignalName, MarkerName, ErrorReason/FailureReason/CancelCause, Identity, RequestID",
+	},
+	MatchingEnableSyncMatch: {
+		Key:         MatchingEnableSyncMatch,
+		Type:        TypeBool,
+		Defaults:    []string{"true"},
+		Filters:     []Filter{Namespace, TaskQueueName, TaskType},
+		Description: "MatchingEnableSyncMatch is to enable sync match",
+	},
+	MatchingEnableSyncMatchStats: {
+		Key:         MatchingEnableSyncMatchStats,
+		Type:        TypeBool,
+		Defaults:    []string{"false"},
+		Filters:     []Filter{Namespace, TaskQueueName, TaskType},
+		Description: "MatchingEnableSyncMatchStats is to record whether each task was sync matched or spilled to backlog, and report the sync match ratio and spill latency of the task queue",
+	},
+	MatchingForwarderMaxChildrenPerNode: {
+		Key:         MatchingForwarderMaxChildrenPerNode,
+		Type:        TypeInt,
+		Defaults:    []string{"20"},
+		Filters:     []Filter{Namespace, TaskQueueName, TaskType},
+		Description: "MatchingForwarderMaxChildrenPerNode is the max number of children per node in the task queue partition tree",
+	},
+	MatchingForwarderMaxOutstandingPolls: {
+		Key:         MatchingForwarderMaxOutstandingPolls,
+		Type:        TypeInt,
+		Defaults:    []string{"1"},
+		Filters:     []Filter{Namespace, TaskQueueName, TaskType},
+		Description: "MatchingForwarderMaxOutstandingPolls is the max number of inflight polls from the forwarder",
+	},
+	MatchingForwarderMaxOutstandingTasks: {
+		Key:         MatchingForwarderMaxOutstandingTasks,
+		Type:        TypeInt,
+		Defaults:    []string{"1"},
+		Filters:     []Filter{Namespace, TaskQueueName, TaskType},
+		Description: "MatchingForwarderMaxOutstandingTasks is the max number of inflight addTask/queryTask from the forwarder",
+	},
+	MatchingForwarderMaxRatePerSecond: {
+		Key:         MatchingForwarderMaxRatePerSecond,
+		Type:        TypeInt,
+		Defaults:    []string{"10"},
+		Filters:     []Filter{Namespace, TaskQueueName, TaskType},
+		Description: "MatchingForwarderMaxRatePerSecond is the max rate at which add/query can be forwarded",
+	},
+	MatchingGetTasksBatchSize: {
+		Key:         MatchingGetTasksBatchSize,
+		Type:        TypeInt,
+		Defaults:    []string{"1000"},
+		Filters:     []Filter{Namespace, TaskQueueName, TaskType},
+		Description: "MatchingGetTasksBatchSize is the maximum batch size to fetch from the task buffer",
+	},
+	MatchingIdleTaskqueueCheckInterval: {
+		Key:         MatchingIdleTaskqueueCheckInterval,
+		Type:        TypeDuration,
+		Defaults:    []string{"5m0s"},
+		Filters:     []Filter{Namespace, TaskQueueName, TaskType},
+		Description: "MatchingIdleTaskqueueCheckInterval is the IdleTaskqueueCheckInterval",
+	},
+	MatchingLongPollExpirationInterval: {
+		Key:         MatchingLongPollExpirationInterval,
+		Type:        TypeDuration,
+		Defaults:    []string{"1m0s"},
+		Filters:     []Filter{Namespace, TaskQueueName, TaskType},
+		Description: "MatchingLongPollExpirationInterval is the long poll expiration interval in the matching service",
+	},
+	MatchingMaxTaskBatchSize: {
+		Key:         MatchingMaxTaskBatchSize,
+		Type:        TypeInt,
+		Defaults:    []string{"100"},
+		Filters:     []Filter{Namespace, TaskQueueName, TaskType},
+		Description: "MatchingMaxTaskBatchSize is max batch size for task writer",
+	},
+	MatchingMaxTaskDeleteBatchSize: {
+		Key:         MatchingMaxTaskDeleteBatchSize,
+		Type:        TypeInt,
+		Defaults:    []string{"100"},
+		Filters:     []Filter{Namespace, TaskQueueName, TaskType},
+		Description: "MatchingMaxTaskDeleteBatchSize is the max batch size for range deletion of tasks",
+	},
+	MatchingMaxTaskHoldersPerPartition: {
+		Key:         MatchingMaxTaskHoldersPerPartition,
+		Type:        TypeInt,
+		Defaults:    []string{"1000"},
+		Filters:     []Filter{Namespace, TaskQueueName, TaskType},
+		Description: "MatchingMaxTaskHoldersPerPartition is the max number of task holders a task queue partition keeps, the least recently dispatched tasks are dropped first",
+	},
+	MaxTaskqueueIdleTime: {
+		Key:         MaxTaskqueueIdleTime,
+		Type:        TypeDuration,
+		Defaults:    []string{"5m0s"},
+		Filters:     []Filter{Namespace, TaskQueueName, TaskType},
+		Description: "MaxTaskqueueIdleTime is the max time taskqueue being idle",
+	},
+	MatchingMinTaskThrottlingBurstSize: {
+		Key:         MatchingMinTaskThrottlingBurstSize,
+		Type:        TypeInt,
+		Defaults:    []string{"1"},
+		Filters:     []Filter{Namespace, TaskQueueName, TaskType},
+		Description: "MatchingMinTaskThrottlingBurstSize is the minimum burst size for task queue throttling",
+	},
+	MatchingNumTaskqueueReadPartitions: {
+		Key:         MatchingNumTaskqueueReadPartitions,
+		Type:        TypeInt,
+		Defaults:    []string{"4"},
+		Min:         "1",
+		Filters:     []Filter{Namespace, TaskQueueName, TaskType},
+		Description: "MatchingNumTaskqueueReadPartitions is the number of read partitions for a task queue",
+	},
+	MatchingNumTaskqueueWritePartitions: {
+		Key:         MatchingNumTaskqueueWritePartitions,
+		Type:        TypeInt,
+		Defaults:    []string{"4"},
+		Min:         "1",
+		Filters:     []Filter{Namespace, TaskQueueName, TaskType},
+		Description: "MatchingNumTaskqueueWritePartitions is the number of write partitions for a task queue",
+	},
+	MatchingOutstandingTaskAppendsThreshold: {
+		Key:         MatchingOutstandingTaskAppendsThreshold,
+		Type:        TypeInt,
+		Defaults:    []string{"250"},
+		Filters:     []Filter{Namespace, TaskQueueName, TaskType},
+		Description: "MatchingOutstandingTaskAppendsThreshold is the threshold for outstanding task appends",
+	},
+	MatchingPersistenceGlobalMaxQPS: {
+		Key:         MatchingPersistenceGlobalMaxQPS,
+		Type:        TypeInt,
+		Defaults:    []string{"0"},
+		Description: "MatchingPersistenceGlobalMaxQPS is the max qps matching cluster can query DB",
+	},
+	MatchingPersistenceMaxQPS: {
+		Key:         MatchingPersistenceMaxQPS,
+		Type:        TypeInt,
+		Defaults:    []string{"3000"},
+		Description: "MatchingPersistenceMaxQPS is the max qps matching host can query DB",
+	},
+	MatchingPollerHistoryTTL: {
+		Key:         MatchingPollerHistoryTTL,
+		Type:        TypeDuration,
+		Defaults:    []string{"5m0s"},
+		Filters:     []Filter{Namespace, TaskQueueName, TaskType},
+		Description: "MatchingPollerHistoryTTL is how long a poller is reported by DescribeTaskQueue after its last poll",
+	},
+	MatchingRPS: {
+		Key:         MatchingRPS,
+		Type:        TypeInt,
+		Defaults:    []string{"1200"},
+		Description: "MatchingRPS is request rate per second for each matching host",
+	},
+	MatchingShutdownDrainDuration: {
+		Key:         MatchingShutdownDrainDuration,
+		Type:        TypeDuration,
+		Defaults:    []string{"0s"},
+		Description: "MatchingShutdownDrainDuration is the duration of traffic drain during shutdown",
+	},
+	MatchingSyncMatchStatsWindow: {
+		Key:         MatchingSyncMatchStatsWindow,
+		Type:        TypeDuration,
+		Defaults:    []string{"5m0s"},
+		Filters:     []Filter{Namespace, TaskQueueName, TaskType},
+		Description: "MatchingSyncMatchStatsWindow is the length of the window over which sync match stats are reported",
+	},
+	MatchingThrottledLogRPS: {
+		Key:         MatchingThrottledLogRPS,
+		Type:        TypeInt,
+		Defaults:    []string{"20"},
+		Description: "MatchingThrottledLogRPS is the rate limit on number of log messages emitted per second for throttled logger",
+	},
+	MatchingUpdateAckInterval: {
+		Key:         MatchingUpdateAckInterval,
+		Type:        TypeDuration,
+		Defaults:    []string{"1m0s"},
+		Filters:     []Filter{Namespace, TaskQueueName, TaskType},
+		Description: "MatchingUpdateAckInterval is the interval for update ack",
+	},
+	MatchingWorkflowTaskHolderTTL: {
+		Key:         MatchingWorkflowTaskHolderTTL,
+		Type:        TypeDuration,
+		Defaults:    []string{"1m0s"},
+		Filters:     []Filter{Namespace, TaskQueueName, TaskType},
+		Description: "MatchingWorkflowTaskHolderTTL is how long DescribeTaskQueue reports the worker a workflow task was dispatched to. Activity task holders are reported until the start to close timeout of the activity.",
+	},
+	AdvancedVisibilityWritingMode: {
+		Key:             AdvancedVisibilityWritingMode,
+		Type:            TypeString,
+		ComputedDefault: true,
+		AllowedValues:   []string{"off", "on", "dual"},
+		Description:     "AdvancedVisibilityWritingMode is key for how to write to advanced visibility",
+	},
+	AllowMixedVersionFeatures: {
+		Key:         AllowMixedVersionFeatures,
+		Type:        TypeBool,
+		Defaults:    []string{"false"},
+		Description: "AllowMixedVersionFeatures allows activating features which older releases do not understand while members of the cluster run mixed server versions, e.g. during a rolling upgrade",
+	},
+	DisallowQuery: {
+		Key:         DisallowQuery,
+		Type:        TypeBool,
+		Defaults:    []string{"false"},
+		Filters:     []Filter{Namespace},
+		Description: "DisallowQuery is the key to disallow query for a namespace",
+	},
+	EnableAuthorization: {
+		Key:         EnableAuthorization,
+		Type:        TypeUnknown,
+		Description: "EnableAuthorization is the key to enable authorization for a namespace",
+	},
+	EnableNamespaceNotActiveAutoForwarding: {
+		Key:         EnableNamespaceNotActiveAutoForwarding,
+		Type:        TypeBool,
+		Defaults:    []string{"true"},
+		Filters:     []Filter{Namespace},
+		Description: "EnableNamespaceNotActiveAutoForwarding whether enabling DC auto forwarding to active cluster for signal / start / signal with start API if namespace is not active",
+	},
+	EnableParentClosePolicyWorker: {
+		Key:         EnableParentClosePolicyWorker,
+		Type:        TypeBool,
+		Defaults:    []string{"true"},
+		Description: "EnableParentClosePolicyWorker decides whether or not enable system workers for processing parent close policy task",
+	},
+	EnablePriorityTaskProcessor: {
+		Key:         EnablePriorityTaskProcessor,
+		Type:        TypeBool,
+		Defaults:    []string{"false"},
+		Description: "EnablePriorityTaskProcessor is the key for enabling priority task processor",
+	},
+	EnableReadFromHistoryArchival: {
+		Key:             EnableReadFromHistoryArchival,
+		Type:            TypeBool,
+		ComputedDefault: true,
+		Description:     "EnableReadFromHistoryArchival is key for enabling reading history from archival store",
+	},
+	EnableReadFromVisibilityArchival: {
+		Key:             EnableReadFromVisibilityArchival,
+		Type:            TypeBool,
+		ComputedDefault: true,
+		Description:     "EnableReadFromVisibilityArchival is key for enabling reading visibility from archival store",
+	},
+	EnableReadVisibilityFromES: {
+		Key:             EnableReadVisibilityFromES,
+		Type:            TypeBool,
+		ComputedDefault: true,
+		Filters:         []Filter{Namespace},
+		Description:     "EnableReadVisibilityFromES is key for enable read from elastic search",
+	},
+	EnableStickyQuery: {
+		Key:         EnableStickyQuery,
+		Type:        TypeBool,
+		Defaults:    []string{"true"},
+		Filters:     []Filter{Namespace},
+		Description: "EnableStickyQuery indicates if sticky query should be enabled per namespace",
+	},
+	EnableVisibilitySampling: {
+		Key:         EnableVisibilitySampling,
+		Type:        TypeBool,
+		Defaults:    []string{"true"},
+		Description: "EnableVisibilitySampling is key for enable visibility sampling",
+	},
+	HistoryArchivalState: {
+		Key:             HistoryArchivalState,
+		Type:            TypeString,
+		ComputedDefault: true,
+		AllowedValues:   []string{"enabled", "disabled", "paused"},
+		Description:     "HistoryArchivalState is key for the state of history archival",
+	},
+	MinRetentionDays: {
+		Key:         MinRetentionDays,
+		Type:        TypeInt,
+		Defaults:    []string{"1"},
+		Description: "MinRetentionDays is the minimal allowed retention days for namespace",
+	},
+	PersistenceFaultInjectionEnabled: {
+		Key:         PersistenceFaultInjectionEnabled,
+		Type:        TypeBool,
+		Defaults:    []string{"false"},
+		Description: "PersistenceFaultInjectionEnabled is the key to inject faults into persistence calls, checked on every call. It only takes effect if persistence.enableFaultInjection is set in static config",
+	},
+	PersistenceFaultInjectionErrorRate: {
+		Key:         PersistenceFaultInjectionErrorRate,
+		Type:        TypeFloat,
+		Defaults:    []string{"0"},
+		Description: "PersistenceFaultInjectionErrorRate is the probability that a persistence call fails with an injected error",
+	},
+	PersistenceFaultInjectionLatency: {
+		Key:         PersistenceFaultInjectionLatency,
+		Type:        TypeDuration,
+		Defaults:    []string{"0s"},
+		Description: "PersistenceFaultInjectionLatency is the latency injected before each persistence call",
+	},
+	PersistenceFaultInjectionTargetAPIs: {
+		Key:         PersistenceFaultInjectionTargetAPIs,
+		Type:        TypeString,
+		Defaults:    []string{""},
+		Description: "PersistenceFaultInjectionTargetAPIs is a comma separated list of persistence APIs faults are injected into, faults are injected into all APIs if empty",
+	},
+	TransactionSizeLimit: {
+		Key:         TransactionSizeLimit,
+		Type:        TypeInt,
+		Defaults:    []string{"14680064"},
+		Description: "TransactionSizeLimit is the largest allowed transaction size to persistence",
+	},
+	VisibilityArchivalState: {
+		Key:             VisibilityArchivalState,
+		Type:            TypeString,
+		ComputedDefault: true,
+		AllowedValues:   []string{"enabled", "disabled", "paused"},
+		Description:     "VisibilityArchivalState is key for the state of visibility archival",
+	},
+	WorkerArchivalsPerIteration: {
+		Key:         WorkerArchivalsPerIteration,
+		Type:        TypeInt,
+		Defaults:    []string{"1000"},
+		Description: "WorkerArchivalsPerIteration controls the number of archivals handled in each iteration of archival workflow",
+	},
+	WorkerArchiverConcurrency: {
+		Key:         WorkerArchiverConcurrency,
+		Type:        TypeInt,
+		Defaults:    []string{"50"},
+		Description: "WorkerArchiverConcurrency controls the number of coroutines handling archival work per archival workflow",
+	},
+	WorkerBlobIntegrityCheckProbability: {
+		Key:         WorkerBlobIntegrityCheckProbability,
+		Type:        TypeUnknown,
+		Description: "WorkerBlobIntegrityCheckProbability controls the probability of running an integrity check for any given archival",
+	},
+	WorkerDeterministicConstructionCheckProbability: {
+		Key:         WorkerDeterministicConstructionCheckProbability,
+		Type:        TypeUnknown,
+		Description: "WorkerDeterministicConstructionCheckProbability controls the probability of running a deterministic construction check for any given archival",
+	},
+	WorkerESProcessorAckTimeout: {
+		Key:         WorkerESProcessorAckTimeout,
+		Type:        TypeDuration,
+		Defaults:    []string{"1m0s"},
+		Description: "WorkerESProcessorAckTimeout is the timeout that store will wait to get ack signal from ES processor. Should be at least WorkerESProcessorFlushInterval+<time to process request>.",
+	},
+	WorkerESProcessorBulkActions: {
+		Key:         WorkerESProcessorBulkActions,
+		Type:        TypeInt,
+		Defaults:    []string{"1000"},
+		Description: "WorkerESProcessorBulkActions is max number of requests in bulk for esProcessor",
+	},
+	WorkerESProcessorBulkSize: {
+		Key:         WorkerESProcessorBulkSize,
+		Type:        TypeInt,
+		Defaults:    []string{"33554432"},
+		Description: "WorkerESProcessorBulkSize is max total size of bulk in bytes for esProcessor",
+	},
+	WorkerESProcessorFlushInterval: {
+		Key:         WorkerESProcessorFlushInterval,
+		Type:        TypeDuration,
+		Defaults:    []string{"1s"},
+		Description: "WorkerESProcessorFlushInterval is flush interval for esProcessor",
+	},
+	WorkerESProcessorNumOfWorkers: {
+		Key:         WorkerESProcessorNumOfWorkers,
+		Type:        TypeInt,
+		Defaults:    []string{"1"},
+		Description: "WorkerESProcessorNumOfWorkers is num of workers for esProcessor",
+	},
+	EnableArchivalCompression: {
+		Key:         EnableArchivalCompression,
+		Type:        TypeUnknown,
+		Description: "EnableArchivalCompression indicates whether blobs are compressed before they are archived",
+	},
+	WorkerTimeLimitPerArchivalIteration: {
+		Key:             WorkerTimeLimitPerArchivalIteration,
+		Type:            TypeDuration,
+		ComputedDefault: true,
+		Description:     "WorkerTimeLimitPerArchivalIteration controls the time limit of each iteration of archival workflow",
+	},
+	WorkerHistoryPageSize: {
+		Key:         WorkerHistoryPageSize,
+		Type:        TypeUnknown,
+		Description: "WorkerHistoryPageSize indicates the page size of history fetched from persistence for archival",
+	},
+	WorkerTargetArchivalBlobSize: {
+		Key:         WorkerTargetArchivalBlobSize,
+		Type:        TypeUnknown,
+		Description: "WorkerTargetArchivalBlobSize indicates the target blob size in bytes for archival, actual blob size may vary",
+	},
+	ClaimCheckBlobLifetime: {
+		Key:         ClaimCheckBlobLifetime,
+		Type:        TypeDuration,
+		Defaults:    []string{"0s"},
+		Filters:     []Filter{Namespace},
+		Description: "ClaimCheckBlobLifetime is the minimum age of claim check blobs before the scanner deletes the blobs of closed workflows past retention, 0 keeps them forever",
+	},
+	ClaimCheckScannerEnabled: {
+		Key:         ClaimCheckScannerEnabled,
+		Type:        TypeBool,
+		Defaults:    []string{"false"},
+		Description: "ClaimCheckScannerEnabled indicates if claim check scanner should be started as part of worker.Scanner",
+	},
+	EnableBatcher: {
+		Key:         EnableBatcher,
+		Type:        TypeBool,
+		Defaults:    []string{"true"},
+		Description: "EnableBatcher decides whether start batcher in our worker",
+	},
+	EnableCanary: {
+		Key:         EnableCanary,
+		Type:        TypeBool,
+		Defaults:    []string{"false"},
+		Description: "EnableCanary decides whether start canary in our worker",
+	},
+	EnableLoadGenerator: {
+		Key:         EnableLoadGenerator,
+		Type:        TypeBool,
+		Defaults:    []string{"false"},
+		Description: "EnableLoadGenerator decides whether start load generator in our worker",
+	},
+	ExecutionsScannerEnabled: {
+		Key:         ExecutionsScannerEnabled,
+		Type:        TypeBool,
+		Defaults:    []string{"false"},
+		Description: "ExecutionsScannerEnabled indicates if executions scanner should be started as part of worker.Scanner",
+	},
+	HistoryScannerEnabled: {
+		Key:         HistoryScannerEnabled,
+		Type:        TypeBool,
+		Defaults:    []string{"true"},
+		Description: "HistoryScannerEnabled indicates if history scanner should be started as part of worker.Scanner",
+	},
+	WorkerIndexerConcurrency: {
+		Key:         WorkerIndexerConcurrency,
+		Type:        TypeInt,
+		Defaults:    []string{"100"},
+		Description: "WorkerIndexerConcurrency is the max concurrent messages to be processed at any given time",
+	},
+	WorkerPersistenceGlobalMaxQPS: {
+		Key:         WorkerPersistenceGlobalMaxQPS,
+		Type:        TypeInt,
+		Defaults:    []string{"0"},
+		Description: "WorkerPersistenceGlobalMaxQPS is the max qps worker cluster can query DB",
+	},
+	WorkerPersistenceMaxQPS: {
+		Key:         WorkerPersistenceMaxQPS,
+		Type:        TypeInt,
+		Defaults:    []string{"500"},
+		Description: "WorkerPersistenceMaxQPS is the max qps worker host can query DB",
+	},
+	WorkerReplicationTaskContextDuration: {
+		Key:         WorkerReplicationTaskContextDuration,
+		Type:        TypeDuration,
+		Defaults:    []string{"30s"},
+		Description: "WorkerReplicationTaskContextDuration is the context timeout for apply replication tasks",
+	},
+	WorkerReplicationTaskMaxRetryCount: {
+		Key:         WorkerReplicationTaskMaxRetryCount,
+		Type:        TypeInt,
+		Defaults:    []string{"400"},
+		Description: "WorkerReplicationTaskMaxRetryCount is the max retry count for any task",
+	},
+	WorkerReplicationTaskMaxRetryDuration: {
+		Key:         WorkerReplicationTaskMaxRetryDuration,
+		Type:        TypeDuration,
+		Defaults:    []string{"15m0s"},
+		Description: "WorkerReplicationTaskMaxRetryDuration is the max retry duration for any task",
+	},
+	WorkerReplicatorActivityBufferRetryCount: {
+		Key:         WorkerReplicatorActivityBufferRetryCount,
+		Type:        TypeInt,
+		Defaults:    []string{"8"},
+		Description: "WorkerReplicatorActivityBufferRetryCount is the retry attempt when encounter retry error on activity",
+	},
+	WorkerReplicatorHistoryBufferRetryCount: {
+		Key:         WorkerReplicatorHistoryBufferRetryCount,
+		Type:        TypeInt,
+		Defaults:    []string{"8"},
+		Description: "WorkerReplicatorHistoryBufferRetryCount is the retry attempt when encounter retry error on history",
+	},
+	WorkerReplicatorMessageConcurrency: {
+		Key:         WorkerReplicatorMessageConcurrency,
+		Type:        TypeInt,
+		Defaults:    []string{"2048"},
+		Description: "WorkerReplicatorMessageConcurrency is the max concurrent tasks provided by messaging client",
+	},
+	WorkerReplicatorMetaTaskConcurrency: {
+		Key:         WorkerReplicatorMetaTaskConcurrency,
+		Type:        TypeInt,
+		Defaults:    []string{"64"},
+		Description: "WorkerReplicatorMetaTaskConcurrency is the number of coroutine handling metadata related tasks",
+	},
+	WorkerReplicatorTaskConcurrency: {
+		Key:         WorkerReplicatorTaskConcurrency,
+		Type:        TypeInt,
+		Defaults:    []string{"256"},
+		Description: "WorkerReplicatorTaskConcurrency is the number of coroutine handling non metadata related tasks",
+	},
+	ScannerPersistenceMaxQPS: {
+		Key:         ScannerPersistenceMaxQPS,
+		Type:        TypeInt,
+		Defaults:    []string{"100"},
+		Description: "ScannerPersistenceMaxQPS is the maximum rate of persistence calls from worker.Scanner",
+	},
+	StorageUsageScannerEnabled: {
+		Key:         StorageUsageScannerEnabled,
+		Type:        TypeBool,
+		Defaults:    []string{"false"},
+		Description: "StorageUsageScannerEnabled indicates if storage usage scanner should be started as part of worker.Scanner",
+	},
+	TaskQueueScannerEnabled: {
+		Key:         TaskQueueScannerEnabled,
+		Type:        TypeBool,
+		Defaults:    []string{"true"},
+		Description: "TaskQueueScannerEnabled indicates if task queue scanner should be started as part of worker.Scanner",
+	},
+	WorkerThrottledLogRPS: {
+		Key:         WorkerThrottledLogRPS,
+		Type:        TypeInt,
+		Defaults:    []string{"20"},
+		Description: "WorkerThrottledLogRPS is the rate limit on number of log messages emitted per second for throttled logger",
+	},
+	WorkerReReplicationContextTimeout: {
+		Key:         WorkerReReplicationContextTimeout,
+		Type:        TypeDuration,
+		Defaults:    []string{"0s"},
+		Filters:     []Filter{NamespaceID},
+		Description: "WorkerReReplicationContextTimeout is the context timeout for end to end re-replication process",
+	},
+}
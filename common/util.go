@@ -100,6 +100,8 @@ const (
 	maximumIntervalCoefficientConfigKey = "MaximumIntervalCoefficient"
 	backoffCoefficientConfigKey         = "BackoffCoefficient"
 	maximumAttemptsConfigKey            = "MaximumAttempts"
+	maximumIntervalInSecondsConfigKey   = "MaximumIntervalInSeconds"
+	nonRetryableErrorTypesConfigKey     = "NonRetryableErrorTypes"
 
 	contextExpireThreshold = 10 * time.Millisecond
 
@@ -535,6 +537,46 @@ func FromConfigToDefaultRetrySettings(options map[string]interface{}) DefaultRet
 	return defaultSettings
 }
 
+// ApplyRetryPolicyOverrides overwrites the policy subfields with the operator specified overrides,
+// regardless of the values set by the user. Only maximum attempts, maximum interval and
+// non-retryable error types can be overridden.
+func ApplyRetryPolicyOverrides(policy *commonpb.RetryPolicy, overrides map[string]interface{}) {
+	if policy == nil || len(overrides) == 0 {
+		return
+	}
+
+	if maximumAttempts, ok := overrides[maximumAttemptsConfigKey].(int); ok {
+		policy.MaximumAttempts = int32(maximumAttempts)
+	}
+
+	if maximumIntervalInSeconds, ok := overrides[maximumIntervalInSecondsConfigKey].(int); ok {
+		maximumInterval := time.Duration(maximumIntervalInSeconds) * time.Second
+		policy.MaximumInterval = timestamp.DurationPtr(maximumInterval)
+		if timestamp.DurationValue(policy.GetInitialInterval()) > maximumInterval {
+			policy.InitialInterval = timestamp.DurationPtr(maximumInterval)
+		}
+	}
+
+	if nonRetryableErrorTypes, ok := overrides[nonRetryableErrorTypesConfigKey].([]interface{}); ok {
+		for _, errorType := range nonRetryableErrorTypes {
+			errorTypeStr, ok := errorType.(string)
+			if !ok || errorTypeStr == "" {
+				continue
+			}
+			found := false
+			for _, existing := range policy.NonRetryableErrorTypes {
+				if existing == errorTypeStr {
+					found = true
+					break
+				}
+			}
+			if !found {
+				policy.NonRetryableErrorTypes = append(policy.NonRetryableErrorTypes, errorTypeStr)
+			}
+		}
+	}
+}
+
 // CreateHistoryStartWorkflowRequest create a start workflow request for history
 func CreateHistoryStartWorkflowRequest(
 	namespaceID string,
@@ -231,6 +231,30 @@ func Test_FromConfigToRetryPolicy(t *testing.T) {
 	assert.Equal(t, int32(5), defaultSettings.MaximumAttempts)
 }
 
+func Test_ApplyRetryPolicyOverrides(t *testing.T) {
+	policy := &commonpb.RetryPolicy{
+		InitialInterval:        timestamp.DurationPtr(10 * time.Second),
+		MaximumInterval:        timestamp.DurationPtr(100 * time.Second),
+		BackoffCoefficient:     2.0,
+		MaximumAttempts:        0,
+		NonRetryableErrorTypes: []string{"testFailureType"},
+	}
+	overrides := map[string]interface{}{
+		maximumAttemptsConfigKey:          3,
+		maximumIntervalInSecondsConfigKey: 5,
+		nonRetryableErrorTypesConfigKey:   []interface{}{"testFailureType", "DependencyDown"},
+	}
+
+	ApplyRetryPolicyOverrides(policy, overrides)
+	assert.Equal(t, &commonpb.RetryPolicy{
+		InitialInterval:        timestamp.DurationPtr(5 * time.Second),
+		MaximumInterval:        timestamp.DurationPtr(5 * time.Second),
+		BackoffCoefficient:     2.0,
+		MaximumAttempts:        3,
+		NonRetryableErrorTypes: []string{"testFailureType", "DependencyDown"},
+	}, policy)
+}
+
 func TestIsContextDeadlineExceededErr(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
 	defer cancel()
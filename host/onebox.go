@@ -390,6 +390,7 @@ func (c *temporalImpl) startFrontend(hosts map[string][]string, startWG *sync.Wa
 	params.ESConfig = c.esConfig
 	params.ESClient = c.esClient
 	params.Authorizer = authorization.NewNoopAuthorizer()
+	params.OperatorAuthorizer = authorization.NewNoopAuthorizer()
 
 	var err error
 	params.PersistenceConfig, err = copyPersistenceConfig(c.persistenceConfig)
@@ -819,6 +820,10 @@ func (c *rpcFactoryImpl) GetGRPCListener() net.Listener {
 	return c.listener
 }
 
+func (c *rpcFactoryImpl) GetOperatorGRPCListener() net.Listener {
+	return nil
+}
+
 func (c *rpcFactoryImpl) GetRingpopChannel() *tchannel.Channel {
 	if c.ringpopChannel != nil {
 		return c.ringpopChannel
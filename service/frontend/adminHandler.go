@@ -27,6 +27,8 @@ package frontend
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sync"
 	"sync/atomic"
 
 	historyspb "go.temporal.io/server/api/history/v1"
@@ -36,12 +38,16 @@ import (
 	"github.com/pborman/uuid"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
 
 	"go.temporal.io/server/api/adminservice/v1"
 	clusterspb "go.temporal.io/server/api/cluster/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
 	tokenspb "go.temporal.io/server/api/token/v1"
 	"go.temporal.io/server/common"
@@ -50,6 +56,7 @@ import (
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
@@ -61,6 +68,9 @@ import (
 const (
 	getNamespaceReplicationMessageBatchSize = 100
 	defaultLastMessageID                    = -1
+	maxWorkflowExecutionChainPageSize       = 100
+	maxWorkflowExecutionEventsRangeSize     = 1000
+	workflowExecutionEventsInitialLookback  = 32
 )
 
 type (
@@ -74,6 +84,15 @@ type (
 		config                *Config
 		namespaceDLQHandler   namespace.DLQMessageHandler
 		eventSerializder      persistence.PayloadSerializer
+
+		activityTaskBatchHandler activityTaskBatchHandler
+	}
+
+	// activityTaskBatchHandler responds to activity tasks in batches like RespondActivityTask*ById,
+	// it is implemented by WorkflowHandler
+	activityTaskBatchHandler interface {
+		BatchCompleteActivityTasksById(context.Context, *adminservice.BatchCompleteActivityTasksByIdRequest) (*adminservice.BatchCompleteActivityTasksByIdResponse, error)
+		BatchFailActivityTasksById(context.Context, *adminservice.BatchFailActivityTasksByIdRequest) (*adminservice.BatchFailActivityTasksByIdResponse, error)
 	}
 )
 
@@ -89,6 +108,7 @@ func NewAdminHandler(
 	resource resource.Resource,
 	params *resource.BootstrapParams,
 	config *Config,
+	activityTaskBatchHandler activityTaskBatchHandler,
 ) *AdminHandler {
 
 	namespaceReplicationTaskExecutor := namespace.NewReplicationTaskExecutor(
@@ -106,7 +126,8 @@ func NewAdminHandler(
 			resource.GetNamespaceReplicationQueue(),
 			resource.GetLogger(),
 		),
-		eventSerializder: persistence.NewPayloadSerializer(),
+		eventSerializder:         persistence.NewPayloadSerializer(),
+		activityTaskBatchHandler: activityTaskBatchHandler,
 	}
 }
 
@@ -158,18 +179,26 @@ func (adh *AdminHandler) AddSearchAttribute(ctx context.Context, request *admins
 		return nil, adh.error(errAdvancedVisibilityStoreIsNotConfigured, scope)
 	}
 
-	searchAttr := request.GetSearchAttribute()
+	searchAttr := make(map[string]enumspb.IndexedValueType)
 	currentValidAttr, _ := adh.params.DynamicConfig.GetMapValue(
 		dynamicconfig.ValidSearchAttributes, nil, definition.GetDefaultIndexedKeys())
-	for k, v := range searchAttr {
+	for k, v := range request.GetSearchAttribute() {
 		if definition.IsSystemIndexedKey(k) {
 			return nil, adh.error(errKeyIsReservedBySystem.MessageArgs(k), scope)
 		}
-		if _, exist := currentValidAttr[k]; exist {
+		if currentType, exist := currentValidAttr[k]; exist {
+			// adding a key again with the same type is a no-op, so concurrent adds of the same key succeed
+			if common.ConvertIndexedValueTypeToProtoType(currentType, adh.GetLogger()) == v {
+				continue
+			}
 			return nil, adh.error(errKeyIsAlreadyWhitelisted.MessageArgs(k), scope)
 		}
 
 		currentValidAttr[k] = int(v)
+		searchAttr[k] = v
+	}
+	if len(searchAttr) == 0 {
+		return &adminservice.AddSearchAttributeResponse{}, nil
 	}
 
 	// update dynamic config
@@ -236,6 +265,62 @@ func (adh *AdminHandler) DescribeMutableState(ctx context.Context, request *admi
 	}, err
 }
 
+// BatchDescribeMutableState returns summarized mutable state of the specified workflow executions.
+func (adh *AdminHandler) BatchDescribeMutableState(ctx context.Context, request *adminservice.BatchDescribeMutableStateRequest) (_ *adminservice.BatchDescribeMutableStateResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope, sw := adh.startRequestProfile(metrics.AdminBatchDescribeMutableStateScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	if len(request.GetExecutions()) == 0 {
+		return nil, adh.error(errExecutionNotSet, scope)
+	}
+
+	if maxBatchSize := adh.config.BatchDescribeMutableStateMaxBatchSize(); len(request.GetExecutions()) > maxBatchSize {
+		return nil, adh.error(errBatchSizeTooBig.MessageArgs(maxBatchSize), scope)
+	}
+
+	for _, execution := range request.GetExecutions() {
+		if err := validateExecution(execution); err != nil {
+			return nil, adh.error(err, scope)
+		}
+	}
+
+	namespaceID, err := adh.GetNamespaceCache().GetNamespaceID(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	concurrency := adh.config.BatchDescribeMutableStateConcurrency()
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	summaries := make([]*adminservice.MutableStateSummary, len(request.GetExecutions()))
+	semaphore := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, execution := range request.GetExecutions() {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(i int, execution *commonpb.WorkflowExecution) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+			summaries[i] = adh.describeMutableStateSummary(ctx, namespaceID, execution)
+		}(i, execution)
+	}
+	wg.Wait()
+
+	return &adminservice.BatchDescribeMutableStateResponse{
+		Summaries: summaries,
+	}, nil
+}
+
 // RemoveTask returns information about the internal states of a history host
 func (adh *AdminHandler) RemoveTask(ctx context.Context, request *adminservice.RemoveTaskRequest) (_ *adminservice.RemoveTaskResponse, retError error) {
 	defer log.CapturePanic(adh.GetLogger(), &retError)
@@ -469,7 +554,8 @@ func (adh *AdminHandler) DescribeCluster(ctx context.Context, _ *adminservice.De
 		}
 
 		membershipInfo.CurrentHost = &clusterspb.HostInfo{
-			Identity: currentHost.Identity(),
+			Identity:      currentHost.Identity(),
+			ServerVersion: headers.ServerVersion,
 		}
 
 		members, err := monitor.GetReachableMembers()
@@ -488,8 +574,10 @@ func (adh *AdminHandler) DescribeCluster(ctx context.Context, _ *adminservice.De
 
 			var servers []*clusterspb.HostInfo
 			for _, server := range resolver.Members() {
+				serverVersion, _ := server.Label(membership.RoleVersion)
 				servers = append(servers, &clusterspb.HostInfo{
-					Identity: server.Identity(),
+					Identity:      server.Identity(),
+					ServerVersion: serverVersion,
 				})
 			}
 
@@ -860,6 +948,597 @@ func (adh *AdminHandler) RefreshWorkflowTasks(
 	return &adminservice.RefreshWorkflowTasksResponse{}, nil
 }
 
+// UpdateWorkflowExecutionTags upserts and removes non-indexed tags of a running workflow
+func (adh *AdminHandler) UpdateWorkflowExecutionTags(
+	ctx context.Context,
+	request *adminservice.UpdateWorkflowExecutionTagsRequest,
+) (_ *adminservice.UpdateWorkflowExecutionTagsResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminUpdateWorkflowExecutionTagsScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	namespaceEntry, err := adh.GetNamespaceCache().GetNamespace(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	_, err = adh.GetHistoryClient().UpdateWorkflowExecutionTags(ctx, &historyservice.UpdateWorkflowExecutionTagsRequest{
+		NamespaceId: namespaceEntry.GetInfo().Id,
+		Request:     request,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.UpdateWorkflowExecutionTagsResponse{}, nil
+}
+
+// PauseWorkflowExecution stops dispatching workflow tasks of a running workflow to workers
+func (adh *AdminHandler) PauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.PauseWorkflowExecutionRequest,
+) (_ *adminservice.PauseWorkflowExecutionResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminPauseWorkflowExecutionScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	namespaceEntry, err := adh.GetNamespaceCache().GetNamespace(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	_, err = adh.GetHistoryClient().PauseWorkflowExecution(ctx, &historyservice.PauseWorkflowExecutionRequest{
+		NamespaceId: namespaceEntry.GetInfo().Id,
+		Request:     request,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.PauseWorkflowExecutionResponse{}, nil
+}
+
+// UnpauseWorkflowExecution resumes dispatching workflow tasks of a paused workflow
+func (adh *AdminHandler) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.UnpauseWorkflowExecutionRequest,
+) (_ *adminservice.UnpauseWorkflowExecutionResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminUnpauseWorkflowExecutionScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	namespaceEntry, err := adh.GetNamespaceCache().GetNamespace(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	_, err = adh.GetHistoryClient().UnpauseWorkflowExecution(ctx, &historyservice.UnpauseWorkflowExecutionRequest{
+		NamespaceId: namespaceEntry.GetInfo().Id,
+		Request:     request,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.UnpauseWorkflowExecutionResponse{}, nil
+}
+
+// ShutdownWorker removes pollers of a gracefully shut down worker from task queue bookkeeping
+func (adh *AdminHandler) ShutdownWorker(
+	ctx context.Context,
+	request *adminservice.ShutdownWorkerRequest,
+) (_ *adminservice.ShutdownWorkerResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminShutdownWorkerScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetTaskQueue().GetName() == "" {
+		return nil, adh.error(errTaskQueueNotSet, scope)
+	}
+	if request.GetIdentity() == "" {
+		return nil, adh.error(errIdentityNotSet, scope)
+	}
+	namespaceID, err := adh.GetNamespaceCache().GetNamespaceID(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	taskQueue := &taskqueuepb.TaskQueue{
+		Name: request.GetTaskQueue().GetName(),
+		Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
+	}
+	for _, taskQueueType := range []enumspb.TaskQueueType{enumspb.TASK_QUEUE_TYPE_WORKFLOW, enumspb.TASK_QUEUE_TYPE_ACTIVITY} {
+		if _, err := adh.GetMatchingClient().RemovePoller(ctx, &matchingservice.RemovePollerRequest{
+			NamespaceId:   namespaceID,
+			TaskQueueType: taskQueueType,
+			TaskQueue:     taskQueue,
+			Identity:      request.GetIdentity(),
+		}); err != nil {
+			return nil, adh.error(err, scope)
+		}
+	}
+
+	if request.GetStickyTaskQueue() != "" {
+		if _, err := adh.GetMatchingClient().RemovePoller(ctx, &matchingservice.RemovePollerRequest{
+			NamespaceId:   namespaceID,
+			TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+			TaskQueue: &taskqueuepb.TaskQueue{
+				Name: request.GetStickyTaskQueue(),
+				Kind: enumspb.TASK_QUEUE_KIND_STICKY,
+			},
+			Identity: request.GetIdentity(),
+		}); err != nil {
+			return nil, adh.error(err, scope)
+		}
+	}
+	return &adminservice.ShutdownWorkerResponse{}, nil
+}
+
+// DescribeNamespaceConfig returns the effective configuration of a namespace, so that namespace users
+// can see which limits apply to them
+func (adh *AdminHandler) DescribeNamespaceConfig(
+	ctx context.Context,
+	request *adminservice.DescribeNamespaceConfigRequest,
+) (_ *adminservice.DescribeNamespaceConfigResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminDescribeNamespaceConfigScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetNamespace() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
+	}
+	entry, err := adh.GetNamespaceCache().GetNamespace(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	return &adminservice.DescribeNamespaceConfigResponse{
+		Namespace:                     entry.GetInfo().GetName(),
+		NamespaceId:                   entry.GetInfo().GetId(),
+		WorkflowExecutionRetentionTtl: entry.GetConfig().GetRetention(),
+		ActiveClusterName:             entry.GetReplicationConfig().GetActiveClusterName(),
+		HistoryArchivalState:          entry.GetConfig().GetHistoryArchivalState(),
+		VisibilityArchivalState:       entry.GetConfig().GetVisibilityArchivalState(),
+		DynamicConfig:                 adh.getNamespaceDynamicConfig(entry.GetInfo().GetName()),
+	}, nil
+}
+
+// getNamespaceDynamicConfig returns the effective values of namespace filtered dynamic config properties
+func (adh *AdminHandler) getNamespaceDynamicConfig(namespace string) map[string]string {
+	values := map[dynamicconfig.Key]interface{}{
+		dynamicconfig.FrontendMaxNamespaceRPSPerInstance:     adh.config.MaxNamespaceRPSPerInstance(namespace),
+		dynamicconfig.FrontendGlobalNamespaceRPS:             adh.config.GlobalNamespaceRPS(namespace),
+		dynamicconfig.FrontendVisibilityListMaxQPS:           adh.config.VisibilityListMaxQPS(namespace),
+		dynamicconfig.FrontendESVisibilityListMaxQPS:         adh.config.ESVisibilityListMaxQPS(namespace),
+		dynamicconfig.FrontendVisibilityMaxPageSize:          adh.config.VisibilityMaxPageSize(namespace),
+		dynamicconfig.FrontendHistoryMaxPageSize:             adh.config.HistoryMaxPageSize(namespace),
+		dynamicconfig.BlobSizeLimitError:                     adh.config.HistoryBlobSizeLimitError(namespace),
+		dynamicconfig.BlobSizeLimitWarn:                      adh.config.HistoryBlobSizeLimitWarn(namespace),
+		dynamicconfig.HistorySizeLimitError:                  adh.config.HistorySizeLimitError(namespace),
+		dynamicconfig.HistoryCountLimitError:                 adh.config.HistoryCountLimitError(namespace),
+		dynamicconfig.SearchAttributesNumberOfKeysLimit:      adh.config.SearchAttributesNumberOfKeysLimit(namespace),
+		dynamicconfig.SearchAttributesSizeOfValueLimit:       adh.config.SearchAttributesSizeOfValueLimit(namespace),
+		dynamicconfig.SearchAttributesTotalSizeLimit:         adh.config.SearchAttributesTotalSizeLimit(namespace),
+		dynamicconfig.DefaultWorkflowTaskTimeout:             adh.config.DefaultWorkflowTaskTimeout(namespace),
+		dynamicconfig.EnableNamespaceNotActiveAutoForwarding: adh.config.EnableNamespaceNotActiveAutoForwarding(namespace),
+		dynamicconfig.DisallowQuery:                          adh.config.DisallowQuery(namespace),
+	}
+
+	result := make(map[string]string, len(values))
+	for key, value := range values {
+		result[key.String()] = fmt.Sprintf("%v", value)
+	}
+	return result
+}
+
+// ListDynamicConfigKeys returns the dynamic config keys known to the server, with their value types, defaults,
+// constraints, filters and descriptions
+func (adh *AdminHandler) ListDynamicConfigKeys(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigKeysRequest,
+) (_ *adminservice.ListDynamicConfigKeysResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminListDynamicConfigKeysScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	infos := dynamicconfig.ListKeys(request.GetPrefix())
+	keys := make([]*adminservice.DynamicConfigKeyInfo, 0, len(infos))
+	for _, info := range infos {
+		filters := make([]string, 0, len(info.Filters))
+		for _, filter := range info.Filters {
+			filters = append(filters, filter.String())
+		}
+		keys = append(keys, &adminservice.DynamicConfigKeyInfo{
+			Name:            info.Key.String(),
+			Type:            info.Type.String(),
+			DefaultValues:   info.Defaults,
+			Filters:         filters,
+			Description:     info.Description,
+			ComputedDefault: info.ComputedDefault,
+			MinValue:        info.Min,
+			MaxValue:        info.Max,
+			AllowedValues:   info.AllowedValues,
+		})
+	}
+	return &adminservice.ListDynamicConfigKeysResponse{
+		Keys: keys,
+	}, nil
+}
+
+// DescribeWorkflowLocks returns hold times and queue lengths of the contended workflow execution locks
+// in the history cache of a shard, to find workflows suffering from lock contention
+func (adh *AdminHandler) DescribeWorkflowLocks(
+	ctx context.Context,
+	request *adminservice.DescribeWorkflowLocksRequest,
+) (_ *adminservice.DescribeWorkflowLocksResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminDescribeWorkflowLocksScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetShardId() <= 0 {
+		return nil, adh.error(errInvalidShardID, scope)
+	}
+
+	resp, err := adh.GetHistoryClient().DescribeWorkflowLocks(ctx, &historyservice.DescribeWorkflowLocksRequest{
+		ShardId: request.GetShardId(),
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.DescribeWorkflowLocksResponse{
+		Locks: resp.GetLocks(),
+	}, nil
+}
+
+// ListWorkflowExecutionChain returns the runs of the continue-as-new, retry and cron chain of a workflow, latest run first.
+// The chain is first walked forward from the requested run to the latest run, then back to the first run.
+// Pages only count the runs visited, so a page may have no runs while the latest run is being located.
+func (adh *AdminHandler) ListWorkflowExecutionChain(
+	ctx context.Context,
+	request *adminservice.ListWorkflowExecutionChainRequest,
+) (_ *adminservice.ListWorkflowExecutionChainResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminListWorkflowExecutionChainScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	// the current run is the latest run of its chain, only an explicitly requested run may have later runs
+	token := &tokenspb.WorkflowExecutionChainContinuation{
+		RunId:       request.Execution.GetRunId(),
+		ForwardWalk: request.Execution.GetRunId() != "",
+	}
+	if len(request.NextPageToken) > 0 {
+		token, err = deserializeWorkflowExecutionChainToken(request.NextPageToken)
+		if err != nil || token.GetRunId() == "" {
+			return nil, adh.error(errInvalidNextPageToken, scope)
+		}
+	}
+	namespaceID, err := adh.GetNamespaceCache().GetNamespaceID(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	scope = scope.Tagged(metrics.NamespaceTag(request.GetNamespace()))
+
+	pageSize := int(request.GetMaximumPageSize())
+	if pageSize <= 0 || pageSize > maxWorkflowExecutionChainPageSize {
+		pageSize = maxWorkflowExecutionChainPageSize
+	}
+
+	resp := &adminservice.ListWorkflowExecutionChainResponse{}
+	for visited := 0; ; visited++ {
+		if visited >= pageSize {
+			if resp.NextPageToken, err = serializeWorkflowExecutionChainToken(token); err != nil {
+				return nil, adh.error(err, scope)
+			}
+			return resp, nil
+		}
+
+		execution := &commonpb.WorkflowExecution{
+			WorkflowId: request.Execution.GetWorkflowId(),
+			RunId:      token.GetRunId(),
+		}
+		run, firstExecutionRunID, newExecutionRunID, err := adh.describeWorkflowExecutionChainRun(ctx, namespaceID, execution, token.GetForwardWalk())
+		if err != nil {
+			// earlier runs of the chain may already be deleted after retention,
+			// the chain ends at the earliest run which still exists
+			if _, ok := err.(*serviceerror.NotFound); ok && !token.GetForwardWalk() && (len(resp.Runs) > 0 || len(request.NextPageToken) > 0) {
+				return resp, nil
+			}
+			return nil, adh.error(err, scope)
+		}
+		if token.GetForwardWalk() {
+			if newExecutionRunID != "" {
+				token.RunId = newExecutionRunID
+				continue
+			}
+			token.ForwardWalk = false
+		}
+
+		resp.Runs = append(resp.Runs, run)
+		resp.FirstExecutionRunId = firstExecutionRunID
+		if run.GetContinuedExecutionRunId() == "" {
+			return resp, nil
+		}
+		token.RunId = run.GetContinuedExecutionRunId()
+	}
+}
+
+// GetWorkflowExecutionEvents returns a single event or a contiguous event ID range from the current branch of a workflow run
+func (adh *AdminHandler) GetWorkflowExecutionEvents(
+	ctx context.Context,
+	request *adminservice.GetWorkflowExecutionEventsRequest,
+) (_ *adminservice.GetWorkflowExecutionEventsResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminGetWorkflowExecutionEventsScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	firstEventID := request.GetFirstEventId()
+	lastEventID := request.GetLastEventId()
+	if lastEventID == 0 {
+		lastEventID = firstEventID
+	}
+	if firstEventID < common.FirstEventID || lastEventID < firstEventID {
+		return nil, adh.error(errInvalidEventQueryRange, scope)
+	}
+	if lastEventID-firstEventID+1 > maxWorkflowExecutionEventsRangeSize {
+		return nil, adh.error(serviceerror.NewInvalidArgument(fmt.Sprintf("Event range exceeds limit of %v events.", maxWorkflowExecutionEventsRangeSize)), scope)
+	}
+	namespaceID, err := adh.GetNamespaceCache().GetNamespaceID(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	scope = scope.Tagged(metrics.NamespaceTag(request.GetNamespace()))
+
+	mutableState, err := adh.GetHistoryClient().GetMutableState(ctx, &historyservice.GetMutableStateRequest{
+		NamespaceId: namespaceID,
+		Execution:   request.Execution,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if firstEventID >= mutableState.GetNextEventId() {
+		return nil, adh.error(serviceerror.NewNotFound(fmt.Sprintf("Event %v not found.", firstEventID)), scope)
+	}
+	if lastEventID >= mutableState.GetNextEventId() {
+		lastEventID = mutableState.GetNextEventId() - 1
+	}
+
+	shardID := common.WorkflowIDToHistoryShard(namespaceID, request.Execution.GetWorkflowId(), adh.numberOfHistoryShards)
+	events, err := adh.readHistoryEventRange(mutableState.GetCurrentBranchToken(), shardID, firstEventID, lastEventID)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.GetWorkflowExecutionEventsResponse{
+		Events: events,
+	}, nil
+}
+
+// DescribeTaskQueue returns the pollers, status and sync match stats of the root partition of a task queue
+func (adh *AdminHandler) DescribeTaskQueue(
+	ctx context.Context,
+	request *adminservice.DescribeTaskQueueRequest,
+) (_ *adminservice.DescribeTaskQueueResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminDescribeTaskQueueScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetTaskQueue().GetName() == "" {
+		return nil, adh.error(errTaskQueueNotSet, scope)
+	}
+	namespaceID, err := adh.GetNamespaceCache().GetNamespaceID(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err := adh.GetMatchingClient().DescribeTaskQueue(ctx, &matchingservice.DescribeTaskQueueRequest{
+		NamespaceId: namespaceID,
+		DescRequest: &workflowservice.DescribeTaskQueueRequest{
+			Namespace:              request.GetNamespace(),
+			TaskQueue:              request.GetTaskQueue(),
+			TaskQueueType:          request.GetTaskQueueType(),
+			IncludeTaskQueueStatus: true,
+		},
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.DescribeTaskQueueResponse{
+		Pollers:         resp.GetPollers(),
+		TaskQueueStatus: resp.GetTaskQueueStatus(),
+		SyncMatchStats:  resp.GetSyncMatchStats(),
+		TaskHolders:     resp.GetTaskHolders(),
+	}, nil
+}
+
+// BatchCompleteActivityTasksById completes multiple activity tasks of a namespace by workflow and activity ID,
+// it is served by WorkflowHandler so that activity tasks are completed like RespondActivityTaskCompletedById
+func (adh *AdminHandler) BatchCompleteActivityTasksById(
+	ctx context.Context,
+	request *adminservice.BatchCompleteActivityTasksByIdRequest,
+) (*adminservice.BatchCompleteActivityTasksByIdResponse, error) {
+	return adh.activityTaskBatchHandler.BatchCompleteActivityTasksById(ctx, request)
+}
+
+// BatchFailActivityTasksById fails multiple activity tasks of a namespace by workflow and activity ID,
+// it is served by WorkflowHandler so that activity tasks are failed like RespondActivityTaskFailedById
+func (adh *AdminHandler) BatchFailActivityTasksById(
+	ctx context.Context,
+	request *adminservice.BatchFailActivityTasksByIdRequest,
+) (*adminservice.BatchFailActivityTasksByIdResponse, error) {
+	return adh.activityTaskBatchHandler.BatchFailActivityTasksById(ctx, request)
+}
+
+// ExecuteCrossClusterTask applies a task sent by a remote cluster to a workflow of a namespace active in the current cluster
+func (adh *AdminHandler) ExecuteCrossClusterTask(
+	ctx context.Context,
+	request *adminservice.ExecuteCrossClusterTaskRequest,
+) (_ *adminservice.ExecuteCrossClusterTaskResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminExecuteCrossClusterTaskScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetSourceCluster() == "" {
+		return nil, adh.error(errClusterNameNotSet, scope)
+	}
+	if err := adh.validateCrossClusterTask(request); err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	historyClient := adh.GetHistoryClient()
+	switch attributes := request.GetAttributes().(type) {
+	case *adminservice.ExecuteCrossClusterTaskRequest_StartWorkflowExecutionTaskAttributes:
+		task := attributes.StartWorkflowExecutionTaskAttributes
+		resp, err := historyClient.StartWorkflowExecution(ctx, &historyservice.StartWorkflowExecutionRequest{
+			NamespaceId:                     task.GetNamespaceId(),
+			StartRequest:                    task.GetStartRequest(),
+			ParentExecutionInfo:             task.GetParentExecutionInfo(),
+			Attempt:                         task.GetAttempt(),
+			WorkflowExecutionExpirationTime: task.GetWorkflowExecutionExpirationTime(),
+			ContinueAsNewInitiator:          task.GetContinueAsNewInitiator(),
+			FirstWorkflowTaskBackoff:        task.GetFirstWorkflowTaskBackoff(),
+		})
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+		return &adminservice.ExecuteCrossClusterTaskResponse{
+			RunId: resp.GetRunId(),
+		}, nil
+
+	case *adminservice.ExecuteCrossClusterTaskRequest_ScheduleWorkflowTaskAttributes:
+		task := attributes.ScheduleWorkflowTaskAttributes
+		_, err = historyClient.ScheduleWorkflowTask(ctx, &historyservice.ScheduleWorkflowTaskRequest{
+			NamespaceId:         task.GetNamespaceId(),
+			WorkflowExecution:   task.GetWorkflowExecution(),
+			IsFirstWorkflowTask: task.GetIsFirstWorkflowTask(),
+		})
+
+	case *adminservice.ExecuteCrossClusterTaskRequest_SignalWorkflowExecutionTaskAttributes:
+		task := attributes.SignalWorkflowExecutionTaskAttributes
+		_, err = historyClient.SignalWorkflowExecution(ctx, &historyservice.SignalWorkflowExecutionRequest{
+			NamespaceId:               task.GetNamespaceId(),
+			SignalRequest:             task.GetSignalRequest(),
+			ExternalWorkflowExecution: task.GetExternalWorkflowExecution(),
+			ChildWorkflowOnly:         task.GetChildWorkflowOnly(),
+		})
+
+	case *adminservice.ExecuteCrossClusterTaskRequest_RemoveSignalMutableStateTaskAttributes:
+		task := attributes.RemoveSignalMutableStateTaskAttributes
+		_, err = historyClient.RemoveSignalMutableState(ctx, &historyservice.RemoveSignalMutableStateRequest{
+			NamespaceId:       task.GetNamespaceId(),
+			WorkflowExecution: task.GetWorkflowExecution(),
+			RequestId:         task.GetRequestId(),
+		})
+
+	case *adminservice.ExecuteCrossClusterTaskRequest_RecordChildExecutionCompletedTaskAttributes:
+		task := attributes.RecordChildExecutionCompletedTaskAttributes
+		_, err = historyClient.RecordChildExecutionCompleted(ctx, &historyservice.RecordChildExecutionCompletedRequest{
+			NamespaceId:        task.GetNamespaceId(),
+			WorkflowExecution:  task.GetWorkflowExecution(),
+			InitiatedId:        task.GetInitiatedId(),
+			CompletedExecution: task.GetCompletedExecution(),
+			CompletionEvent:    task.GetCompletionEvent(),
+		})
+
+	default:
+		return nil, adh.error(errUnknownCrossClusterTask, scope)
+	}
+
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.ExecuteCrossClusterTaskResponse{}, nil
+}
+
+// validateCrossClusterTask checks that a cross cluster task is sent by a remote cluster of this deployment
+// and targets a global namespace which is active in the current cluster
+func (adh *AdminHandler) validateCrossClusterTask(request *adminservice.ExecuteCrossClusterTaskRequest) error {
+	clusterMetadata := adh.GetClusterMetadata()
+	sourceClusterInfo, ok := clusterMetadata.GetAllClusterInfo()[request.GetSourceCluster()]
+	if !ok || !sourceClusterInfo.Enabled || request.GetSourceCluster() == clusterMetadata.GetCurrentClusterName() {
+		return errInvalidSourceCluster
+	}
+
+	namespaceID, err := getCrossClusterTaskNamespaceID(request)
+	if err != nil {
+		return err
+	}
+	namespaceEntry, err := adh.GetNamespaceCache().GetNamespaceByID(namespaceID)
+	if err != nil {
+		// the namespace may not have been replicated to this cluster's namespace cache yet,
+		// the sender treats NotFound as permanent so a retryable error is returned instead
+		if _, ok := err.(*serviceerror.NotFound); ok {
+			return errCrossClusterTaskNamespaceNotFound
+		}
+		return err
+	}
+	if !namespaceEntry.IsGlobalNamespace() {
+		return errCrossClusterTaskNotGlobalNamespace
+	}
+	return namespaceEntry.GetNamespaceNotActiveErr()
+}
+
+func getCrossClusterTaskNamespaceID(request *adminservice.ExecuteCrossClusterTaskRequest) (string, error) {
+	switch attributes := request.GetAttributes().(type) {
+	case *adminservice.ExecuteCrossClusterTaskRequest_StartWorkflowExecutionTaskAttributes:
+		return attributes.StartWorkflowExecutionTaskAttributes.GetNamespaceId(), nil
+	case *adminservice.ExecuteCrossClusterTaskRequest_ScheduleWorkflowTaskAttributes:
+		return attributes.ScheduleWorkflowTaskAttributes.GetNamespaceId(), nil
+	case *adminservice.ExecuteCrossClusterTaskRequest_SignalWorkflowExecutionTaskAttributes:
+		return attributes.SignalWorkflowExecutionTaskAttributes.GetNamespaceId(), nil
+	case *adminservice.ExecuteCrossClusterTaskRequest_RemoveSignalMutableStateTaskAttributes:
+		return attributes.RemoveSignalMutableStateTaskAttributes.GetNamespaceId(), nil
+	case *adminservice.ExecuteCrossClusterTaskRequest_RecordChildExecutionCompletedTaskAttributes:
+		return attributes.RecordChildExecutionCompletedTaskAttributes.GetNamespaceId(), nil
+	default:
+		return "", errUnknownCrossClusterTask
+	}
+}
+
 // ResendReplicationTasks requests replication task from remote cluster
 func (adh *AdminHandler) ResendReplicationTasks(
 	ctx context.Context,
@@ -937,6 +1616,163 @@ func (adh *AdminHandler) validateGetWorkflowExecutionRawHistoryV2Request(
 	return nil
 }
 
+func (adh *AdminHandler) describeMutableStateSummary(
+	ctx context.Context,
+	namespaceID string,
+	execution *commonpb.WorkflowExecution,
+) *adminservice.MutableStateSummary {
+
+	shardID := common.WorkflowIDToHistoryShard(namespaceID, execution.GetWorkflowId(), adh.numberOfHistoryShards)
+	summary := &adminservice.MutableStateSummary{
+		Execution: execution,
+		ShardId:   convert.Int32ToString(shardID),
+	}
+
+	resp, err := adh.GetHistoryClient().DescribeMutableState(ctx, &historyservice.DescribeMutableStateRequest{
+		NamespaceId: namespaceID,
+		Execution:   execution,
+	})
+	if err != nil {
+		summary.Error = err.Error()
+		return summary
+	}
+
+	// prefer the cached copy since it reflects in-flight updates not yet persisted
+	mutableState := resp.GetCacheMutableState()
+	if mutableState == nil {
+		mutableState = resp.GetDatabaseMutableState()
+	}
+
+	summary.Execution = &commonpb.WorkflowExecution{
+		WorkflowId: execution.GetWorkflowId(),
+		RunId:      mutableState.GetExecutionState().GetRunId(),
+	}
+	summary.Status = mutableState.GetExecutionState().GetStatus()
+	summary.NextEventId = mutableState.GetNextEventId()
+	summary.PendingActivityCount = int32(len(mutableState.GetActivityInfos()))
+	summary.PendingTimerCount = int32(len(mutableState.GetTimerInfos()))
+	summary.PendingChildExecutionCount = int32(len(mutableState.GetChildExecutionInfos()))
+	summary.PendingRequestCancelCount = int32(len(mutableState.GetRequestCancelInfos()))
+	summary.PendingSignalCount = int32(len(mutableState.GetSignalInfos()))
+	summary.BufferedEventCount = int32(len(mutableState.GetBufferedEvents()))
+	summary.LastUpdateTime = mutableState.GetExecutionInfo().GetLastUpdateTime()
+	return summary
+}
+
+// describeWorkflowExecutionChainRun returns a run of a workflow execution chain along with the
+// first run ID of the chain, both read from the mutable state and the start event of the run
+func (adh *AdminHandler) describeWorkflowExecutionChainRun(
+	ctx context.Context,
+	namespaceID string,
+	execution *commonpb.WorkflowExecution,
+	withNewExecutionRunID bool,
+) (*adminservice.WorkflowExecutionChainRun, string, string, error) {
+
+	mutableState, err := adh.GetHistoryClient().GetMutableState(ctx, &historyservice.GetMutableStateRequest{
+		NamespaceId: namespaceID,
+		Execution:   execution,
+	})
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	shardID := common.WorkflowIDToHistoryShard(namespaceID, execution.GetWorkflowId(), adh.numberOfHistoryShards)
+	historyResp, err := adh.GetHistoryManager().ReadHistoryBranch(&persistence.ReadHistoryBranchRequest{
+		BranchToken: mutableState.GetCurrentBranchToken(),
+		MinEventID:  common.FirstEventID,
+		MaxEventID:  common.FirstEventID + 1,
+		PageSize:    1,
+		ShardID:     &shardID,
+	})
+	if err != nil {
+		return nil, "", "", err
+	}
+	if len(historyResp.HistoryEvents) == 0 {
+		return nil, "", "", serviceerror.NewInternal(fmt.Sprintf("Start event of workflow run %v not found.", mutableState.GetExecution().GetRunId()))
+	}
+
+	// continue-as-new, retry and cron all close the run with a continued as new event, which links the next run
+	newExecutionRunID := ""
+	if withNewExecutionRunID && mutableState.GetWorkflowStatus() == enumspb.WORKFLOW_EXECUTION_STATUS_CONTINUED_AS_NEW {
+		closeEventID := mutableState.GetNextEventId() - 1
+		events, err := adh.readHistoryEventRange(mutableState.GetCurrentBranchToken(), shardID, closeEventID, closeEventID)
+		if err != nil {
+			return nil, "", "", err
+		}
+		if len(events) == 0 {
+			return nil, "", "", serviceerror.NewInternal(fmt.Sprintf("Close event of workflow run %v not found.", mutableState.GetExecution().GetRunId()))
+		}
+		newExecutionRunID = events[len(events)-1].GetWorkflowExecutionContinuedAsNewEventAttributes().GetNewExecutionRunId()
+	}
+
+	startEvent := historyResp.HistoryEvents[0]
+	attributes := startEvent.GetWorkflowExecutionStartedEventAttributes()
+	return &adminservice.WorkflowExecutionChainRun{
+		Execution:               mutableState.GetExecution(),
+		Status:                  mutableState.GetWorkflowStatus(),
+		StartTime:               startEvent.GetEventTime(),
+		Initiator:               attributes.GetInitiator(),
+		ContinuedExecutionRunId: attributes.GetContinuedExecutionRunId(),
+	}, attributes.GetFirstExecutionRunId(), newExecutionRunID, nil
+}
+
+// readHistoryEventRange reads the events from firstEventID to lastEventID, both inclusive, from a history branch.
+// Events are stored in batches keyed by the ID of the first event of the batch, and a batch is only read
+// when its key is within the read range. The read therefore starts a little before firstEventID and moves
+// further back until the batch containing firstEventID is covered, instead of reading from the first event.
+func (adh *AdminHandler) readHistoryEventRange(
+	branchToken []byte,
+	shardID int32,
+	firstEventID int64,
+	lastEventID int64,
+) ([]*historypb.HistoryEvent, error) {
+
+	lookback := int64(0)
+	for {
+		minEventID := firstEventID - lookback
+		if minEventID < common.FirstEventID {
+			minEventID = common.FirstEventID
+		}
+
+		var events []*historypb.HistoryEvent
+		var nextPageToken []byte
+		for {
+			historyResp, err := adh.GetHistoryManager().ReadHistoryBranch(&persistence.ReadHistoryBranchRequest{
+				BranchToken:   branchToken,
+				MinEventID:    minEventID,
+				MaxEventID:    lastEventID + 1,
+				PageSize:      maxWorkflowExecutionEventsRangeSize,
+				NextPageToken: nextPageToken,
+				ShardID:       &shardID,
+			})
+			if err != nil {
+				return nil, err
+			}
+			events = append(events, historyResp.HistoryEvents...)
+			nextPageToken = historyResp.NextPageToken
+			if len(nextPageToken) == 0 {
+				break
+			}
+		}
+
+		if (len(events) > 0 && events[0].GetEventId() <= firstEventID) || minEventID == common.FirstEventID {
+			result := make([]*historypb.HistoryEvent, 0, lastEventID-firstEventID+1)
+			for _, event := range events {
+				if event.GetEventId() >= firstEventID && event.GetEventId() <= lastEventID {
+					result = append(result, event)
+				}
+			}
+			return result, nil
+		}
+
+		if lookback == 0 {
+			lookback = workflowExecutionEventsInitialLookback
+		} else {
+			lookback *= 2
+		}
+	}
+}
+
 func (adh *AdminHandler) validateConfigForAdvanceVisibility() error {
 	if adh.params.ESConfig == nil || adh.params.ESClient == nil {
 		return errors.New("ES related config not found")
@@ -28,7 +28,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	enumspb "go.temporal.io/api/enums/v1"
 
@@ -40,17 +42,22 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	commonpb "go.temporal.io/api/common/v1"
+	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
 
 	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	tokenspb "go.temporal.io/server/api/token/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/elasticsearch"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/service/config"
 	"go.temporal.io/server/common/service/dynamicconfig"
@@ -98,7 +105,7 @@ func (s *adminHandlerSuite) SetupTest() {
 		},
 	}
 	config := &Config{}
-	s.handler = NewAdminHandler(s.mockResource, params, config)
+	s.handler = NewAdminHandler(s.mockResource, params, config, nil)
 	s.handler.Start()
 }
 
@@ -510,6 +517,15 @@ func (s *adminHandlerSuite) Test_AddSearchAttribute_Validate() {
 		s.Nil(resp)
 	}
 
+	// adding an existing key with the same type succeeds without updating dynamic config or ES
+	resp, err := handler.AddSearchAttribute(ctx, &adminservice.AddSearchAttributeRequest{
+		SearchAttribute: map[string]enumspb.IndexedValueType{
+			"testkey": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		},
+	})
+	s.NoError(err)
+	s.NotNil(resp)
+
 	dcUpdateTest := test{
 		Name: "dynamic config update failed",
 		Request: &adminservice.AddSearchAttributeRequest{
@@ -524,7 +540,7 @@ func (s *adminHandlerSuite) Test_AddSearchAttribute_Validate() {
 		"testkey2": 1,
 	}).Return(errors.New("error"))
 
-	resp, err := handler.AddSearchAttribute(ctx, dcUpdateTest.Request)
+	resp, err = handler.AddSearchAttribute(ctx, dcUpdateTest.Request)
 	s.Equal(dcUpdateTest.Expected, err)
 	s.Nil(resp)
 
@@ -559,3 +575,525 @@ func (s *adminHandlerSuite) Test_AddSearchAttribute_Validate() {
 	s.Equal(esErrorTest.Expected, err)
 	s.Nil(resp)
 }
+
+func (s *adminHandlerSuite) Test_BatchDescribeMutableState() {
+	ctx := context.Background()
+	s.handler.config.BatchDescribeMutableStateMaxBatchSize = dynamicconfig.GetIntPropertyFn(2)
+	s.handler.config.BatchDescribeMutableStateConcurrency = dynamicconfig.GetIntPropertyFn(2)
+
+	runningExecution := &commonpb.WorkflowExecution{WorkflowId: "running-workflow", RunId: uuid.New()}
+	missingExecution := &commonpb.WorkflowExecution{WorkflowId: "missing-workflow", RunId: uuid.New()}
+
+	_, err := s.handler.BatchDescribeMutableState(ctx, &adminservice.BatchDescribeMutableStateRequest{
+		Namespace:  s.namespace,
+		Executions: []*commonpb.WorkflowExecution{runningExecution, missingExecution, runningExecution},
+	})
+	s.Equal(&serviceerror.InvalidArgument{Message: "Number of executions is larger than allowed 2."}, err)
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), &historyservice.DescribeMutableStateRequest{
+		NamespaceId: s.namespaceID,
+		Execution:   runningExecution,
+	}).Return(&historyservice.DescribeMutableStateResponse{
+		DatabaseMutableState: &persistencespb.WorkflowMutableState{
+			ExecutionState: &persistencespb.WorkflowExecutionState{
+				RunId:  runningExecution.GetRunId(),
+				Status: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+			},
+			ExecutionInfo: &persistencespb.WorkflowExecutionInfo{},
+			ActivityInfos: map[int64]*persistencespb.ActivityInfo{5: {}, 6: {}},
+			TimerInfos:    map[string]*persistencespb.TimerInfo{"timer": {}},
+			NextEventId:   10,
+		},
+	}, nil)
+	s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), &historyservice.DescribeMutableStateRequest{
+		NamespaceId: s.namespaceID,
+		Execution:   missingExecution,
+	}).Return(nil, serviceerror.NewNotFound("workflow not found"))
+
+	resp, err := s.handler.BatchDescribeMutableState(ctx, &adminservice.BatchDescribeMutableStateRequest{
+		Namespace:  s.namespace,
+		Executions: []*commonpb.WorkflowExecution{runningExecution, missingExecution},
+	})
+	s.NoError(err)
+	s.Len(resp.GetSummaries(), 2)
+
+	running := resp.GetSummaries()[0]
+	s.Equal(runningExecution.GetRunId(), running.GetExecution().GetRunId())
+	s.Equal(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, running.GetStatus())
+	s.Equal(int64(10), running.GetNextEventId())
+	s.Equal(int32(2), running.GetPendingActivityCount())
+	s.Equal(int32(1), running.GetPendingTimerCount())
+	s.Empty(running.GetError())
+
+	missing := resp.GetSummaries()[1]
+	s.Equal(missingExecution.GetWorkflowId(), missing.GetExecution().GetWorkflowId())
+	s.Equal("workflow not found", missing.GetError())
+}
+
+func (s *adminHandlerSuite) Test_DescribeNamespaceConfig() {
+	s.handler.config = NewConfig(dynamicconfig.NewCollection(dynamicconfig.NewNopClient(), s.mockResource.GetLogger()), 1, false)
+
+	_, err := s.handler.DescribeNamespaceConfig(context.Background(), &adminservice.DescribeNamespaceConfigRequest{})
+	s.Equal(errNamespaceNotSet, err)
+
+	namespaceEntry := cache.NewGlobalNamespaceCacheEntryForTest(
+		&persistencespb.NamespaceInfo{Id: s.namespaceID, Name: s.namespace},
+		&persistencespb.NamespaceConfig{
+			Retention:               timestamp.DurationFromDays(3),
+			HistoryArchivalState:    enumspb.ARCHIVAL_STATE_ENABLED,
+			VisibilityArchivalState: enumspb.ARCHIVAL_STATE_DISABLED,
+		},
+		&persistencespb.NamespaceReplicationConfig{
+			ActiveClusterName: cluster.TestAlternativeClusterName,
+			Clusters:          []string{cluster.TestCurrentClusterName, cluster.TestAlternativeClusterName},
+		},
+		1234,
+		nil,
+	)
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(namespaceEntry, nil)
+
+	resp, err := s.handler.DescribeNamespaceConfig(context.Background(), &adminservice.DescribeNamespaceConfigRequest{
+		Namespace: s.namespace,
+	})
+	s.NoError(err)
+	s.Equal(s.namespace, resp.GetNamespace())
+	s.Equal(s.namespaceID, resp.GetNamespaceId())
+	s.Equal(timestamp.DurationFromDays(3), resp.GetWorkflowExecutionRetentionTtl())
+	s.Equal(cluster.TestAlternativeClusterName, resp.GetActiveClusterName())
+	s.Equal(enumspb.ARCHIVAL_STATE_ENABLED, resp.GetHistoryArchivalState())
+	s.Equal(enumspb.ARCHIVAL_STATE_DISABLED, resp.GetVisibilityArchivalState())
+	s.Equal("1200", resp.GetDynamicConfig()[dynamicconfig.FrontendMaxNamespaceRPSPerInstance.String()])
+	s.Equal("2097152", resp.GetDynamicConfig()[dynamicconfig.BlobSizeLimitError.String()])
+	s.Equal("524288", resp.GetDynamicConfig()[dynamicconfig.BlobSizeLimitWarn.String()])
+	s.Equal("51200", resp.GetDynamicConfig()[dynamicconfig.HistoryCountLimitError.String()])
+	s.Equal("false", resp.GetDynamicConfig()[dynamicconfig.DisallowQuery.String()])
+}
+
+func (s *adminHandlerSuite) Test_DescribeWorkflowLocks() {
+	_, err := s.handler.DescribeWorkflowLocks(context.Background(), &adminservice.DescribeWorkflowLocksRequest{})
+	s.Equal(errInvalidShardID, err)
+
+	locks := []*adminservice.WorkflowLockInfo{
+		{
+			NamespaceId:  s.namespaceID,
+			WorkflowId:   "workflowID",
+			RunId:        uuid.New(),
+			Held:         true,
+			HoldDuration: timestamp.DurationPtr(time.Second),
+			Waiters:      3,
+		},
+	}
+	s.mockHistoryClient.EXPECT().DescribeWorkflowLocks(gomock.Any(), &historyservice.DescribeWorkflowLocksRequest{
+		ShardId: 1,
+	}).Return(&historyservice.DescribeWorkflowLocksResponse{Locks: locks}, nil)
+
+	resp, err := s.handler.DescribeWorkflowLocks(context.Background(), &adminservice.DescribeWorkflowLocksRequest{
+		ShardId: 1,
+	})
+	s.NoError(err)
+	s.Equal(locks, resp.GetLocks())
+}
+
+func (s *adminHandlerSuite) Test_ListWorkflowExecutionChain() {
+	workflowID := "workflowID"
+	runIDs := []string{uuid.New(), uuid.New(), uuid.New()}
+	initiators := []enumspb.ContinueAsNewInitiator{
+		enumspb.CONTINUE_AS_NEW_INITIATOR_UNSPECIFIED,
+		enumspb.CONTINUE_AS_NEW_INITIATOR_RETRY,
+		enumspb.CONTINUE_AS_NEW_INITIATOR_WORKFLOW,
+	}
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
+	for i, runID := range []string{"", runIDs[1], runIDs[0]} {
+		status := enumspb.WORKFLOW_EXECUTION_STATUS_CONTINUED_AS_NEW
+		if runID == "" {
+			status = enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING
+		}
+		resolvedRunID := runIDs[len(runIDs)-1-i]
+		s.mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), &historyservice.GetMutableStateRequest{
+			NamespaceId: s.namespaceID,
+			Execution:   &commonpb.WorkflowExecution{WorkflowId: workflowID, RunId: runID},
+		}).Return(&historyservice.GetMutableStateResponse{
+			Execution:          &commonpb.WorkflowExecution{WorkflowId: workflowID, RunId: resolvedRunID},
+			WorkflowStatus:     status,
+			CurrentBranchToken: []byte(resolvedRunID),
+		}, nil)
+	}
+	s.mockHistoryMgr.EXPECT().ReadHistoryBranch(gomock.Any()).DoAndReturn(
+		func(request *persistence.ReadHistoryBranchRequest) (*persistence.ReadHistoryBranchResponse, error) {
+			s.Equal(common.FirstEventID, request.MinEventID)
+			for i, runID := range runIDs {
+				if runID != string(request.BranchToken) {
+					continue
+				}
+				attributes := &historypb.WorkflowExecutionStartedEventAttributes{
+					FirstExecutionRunId: runIDs[0],
+					Initiator:           initiators[i],
+				}
+				if i > 0 {
+					attributes.ContinuedExecutionRunId = runIDs[i-1]
+				}
+				return &persistence.ReadHistoryBranchResponse{
+					HistoryEvents: []*historypb.HistoryEvent{{
+						EventId:   common.FirstEventID,
+						EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+						Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{
+							WorkflowExecutionStartedEventAttributes: attributes,
+						},
+					}},
+				}, nil
+			}
+			return nil, serviceerror.NewNotFound("branch not found")
+		}).Times(3)
+
+	request := &adminservice.ListWorkflowExecutionChainRequest{
+		Namespace:       s.namespace,
+		Execution:       &commonpb.WorkflowExecution{WorkflowId: workflowID},
+		MaximumPageSize: 2,
+	}
+	resp, err := s.handler.ListWorkflowExecutionChain(context.Background(), request)
+	s.NoError(err)
+	s.Equal(runIDs[0], resp.GetFirstExecutionRunId())
+	s.Len(resp.GetRuns(), 2)
+	s.Equal(runIDs[2], resp.Runs[0].GetExecution().GetRunId())
+	s.Equal(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, resp.Runs[0].GetStatus())
+	s.Equal(enumspb.CONTINUE_AS_NEW_INITIATOR_WORKFLOW, resp.Runs[0].GetInitiator())
+	s.Equal(runIDs[1], resp.Runs[1].GetExecution().GetRunId())
+	s.Equal(enumspb.CONTINUE_AS_NEW_INITIATOR_RETRY, resp.Runs[1].GetInitiator())
+	token, err := deserializeWorkflowExecutionChainToken(resp.GetNextPageToken())
+	s.NoError(err)
+	s.Equal(&tokenspb.WorkflowExecutionChainContinuation{RunId: runIDs[0]}, token)
+
+	request.NextPageToken = resp.GetNextPageToken()
+	resp, err = s.handler.ListWorkflowExecutionChain(context.Background(), request)
+	s.NoError(err)
+	s.Len(resp.GetRuns(), 1)
+	s.Equal(runIDs[0], resp.Runs[0].GetExecution().GetRunId())
+	s.Empty(resp.Runs[0].GetContinuedExecutionRunId())
+	s.Nil(resp.GetNextPageToken())
+}
+
+func (s *adminHandlerSuite) Test_ListWorkflowExecutionChain_ForwardWalk() {
+	workflowID := "workflowID"
+	runIDs := []string{uuid.New(), uuid.New(), uuid.New()}
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
+	for i, runID := range runIDs {
+		// earlier runs are visited walking forward and again walking back
+		status := enumspb.WORKFLOW_EXECUTION_STATUS_CONTINUED_AS_NEW
+		times := 2
+		if i == len(runIDs)-1 {
+			status = enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING
+			times = 1
+		}
+		s.mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), &historyservice.GetMutableStateRequest{
+			NamespaceId: s.namespaceID,
+			Execution:   &commonpb.WorkflowExecution{WorkflowId: workflowID, RunId: runID},
+		}).Return(&historyservice.GetMutableStateResponse{
+			Execution:          &commonpb.WorkflowExecution{WorkflowId: workflowID, RunId: runID},
+			WorkflowStatus:     status,
+			NextEventId:        5,
+			CurrentBranchToken: []byte(runID),
+		}, nil).Times(times)
+	}
+	s.mockHistoryMgr.EXPECT().ReadHistoryBranch(gomock.Any()).DoAndReturn(
+		func(request *persistence.ReadHistoryBranchRequest) (*persistence.ReadHistoryBranchResponse, error) {
+			for i, runID := range runIDs {
+				if runID != string(request.BranchToken) {
+					continue
+				}
+				if request.MinEventID == common.FirstEventID {
+					attributes := &historypb.WorkflowExecutionStartedEventAttributes{
+						FirstExecutionRunId: runIDs[0],
+					}
+					if i > 0 {
+						attributes.ContinuedExecutionRunId = runIDs[i-1]
+						attributes.Initiator = enumspb.CONTINUE_AS_NEW_INITIATOR_CRON_SCHEDULE
+					}
+					return &persistence.ReadHistoryBranchResponse{
+						HistoryEvents: []*historypb.HistoryEvent{{
+							EventId:   common.FirstEventID,
+							EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+							Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{
+								WorkflowExecutionStartedEventAttributes: attributes,
+							},
+						}},
+					}, nil
+				}
+				s.Equal(int64(4), request.MinEventID)
+				return &persistence.ReadHistoryBranchResponse{
+					HistoryEvents: []*historypb.HistoryEvent{{
+						EventId:   4,
+						EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_CONTINUED_AS_NEW,
+						Attributes: &historypb.HistoryEvent_WorkflowExecutionContinuedAsNewEventAttributes{
+							WorkflowExecutionContinuedAsNewEventAttributes: &historypb.WorkflowExecutionContinuedAsNewEventAttributes{
+								NewExecutionRunId: runIDs[i+1],
+							},
+						},
+					}},
+				}, nil
+			}
+			return nil, serviceerror.NewNotFound("branch not found")
+		}).Times(7)
+
+	request := &adminservice.ListWorkflowExecutionChainRequest{
+		Namespace:       s.namespace,
+		Execution:       &commonpb.WorkflowExecution{WorkflowId: workflowID, RunId: runIDs[0]},
+		MaximumPageSize: 2,
+	}
+	// the first page only walks forward from the requested run
+	resp, err := s.handler.ListWorkflowExecutionChain(context.Background(), request)
+	s.NoError(err)
+	s.Empty(resp.GetRuns())
+	s.NotEmpty(resp.GetNextPageToken())
+
+	request.NextPageToken = resp.GetNextPageToken()
+	resp, err = s.handler.ListWorkflowExecutionChain(context.Background(), request)
+	s.NoError(err)
+	s.Equal(runIDs[0], resp.GetFirstExecutionRunId())
+	s.Len(resp.GetRuns(), 2)
+	s.Equal(runIDs[2], resp.Runs[0].GetExecution().GetRunId())
+	s.Equal(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, resp.Runs[0].GetStatus())
+	s.Equal(runIDs[1], resp.Runs[1].GetExecution().GetRunId())
+	s.NotEmpty(resp.GetNextPageToken())
+
+	request.NextPageToken = resp.GetNextPageToken()
+	resp, err = s.handler.ListWorkflowExecutionChain(context.Background(), request)
+	s.NoError(err)
+	s.Len(resp.GetRuns(), 1)
+	s.Equal(runIDs[0], resp.Runs[0].GetExecution().GetRunId())
+	s.Nil(resp.GetNextPageToken())
+}
+
+func (s *adminHandlerSuite) Test_ListWorkflowExecutionChain_InvalidNextPageToken() {
+	_, err := s.handler.ListWorkflowExecutionChain(context.Background(), &adminservice.ListWorkflowExecutionChainRequest{
+		Namespace:     s.namespace,
+		Execution:     &commonpb.WorkflowExecution{WorkflowId: "workflowID"},
+		NextPageToken: []byte("invalid"),
+	})
+	s.Equal(errInvalidNextPageToken, err)
+}
+
+func (s *adminHandlerSuite) Test_GetWorkflowExecutionEvents() {
+	execution := &commonpb.WorkflowExecution{WorkflowId: "workflowID", RunId: uuid.New()}
+	branchToken := []byte("branchToken")
+	batchFirstEventIDs := []int64{1, 4, 9}
+	nextEventID := int64(11)
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), &historyservice.GetMutableStateRequest{
+		NamespaceId: s.namespaceID,
+		Execution:   execution,
+	}).Return(&historyservice.GetMutableStateResponse{
+		Execution:          execution,
+		NextEventId:        nextEventID,
+		CurrentBranchToken: branchToken,
+	}, nil)
+	var minEventIDs []int64
+	s.mockHistoryMgr.EXPECT().ReadHistoryBranch(gomock.Any()).DoAndReturn(
+		func(request *persistence.ReadHistoryBranchRequest) (*persistence.ReadHistoryBranchResponse, error) {
+			s.Equal(branchToken, request.BranchToken)
+			minEventIDs = append(minEventIDs, request.MinEventID)
+			// only batches keyed within the read range are returned, like the persistence layer does
+			var events []*historypb.HistoryEvent
+			for i, batchFirstEventID := range batchFirstEventIDs {
+				if batchFirstEventID < request.MinEventID || batchFirstEventID >= request.MaxEventID {
+					continue
+				}
+				batchNextEventID := nextEventID
+				if i+1 < len(batchFirstEventIDs) {
+					batchNextEventID = batchFirstEventIDs[i+1]
+				}
+				for eventID := batchFirstEventID; eventID < batchNextEventID; eventID++ {
+					events = append(events, &historypb.HistoryEvent{EventId: eventID})
+				}
+			}
+			return &persistence.ReadHistoryBranchResponse{HistoryEvents: events}, nil
+		}).Times(2)
+
+	resp, err := s.handler.GetWorkflowExecutionEvents(context.Background(), &adminservice.GetWorkflowExecutionEventsRequest{
+		Namespace:    s.namespace,
+		Execution:    execution,
+		FirstEventId: 6,
+		LastEventId:  9,
+	})
+	s.NoError(err)
+	s.Equal([]int64{6, common.FirstEventID}, minEventIDs)
+	s.Len(resp.GetEvents(), 4)
+	for i, event := range resp.GetEvents() {
+		s.Equal(int64(6+i), event.GetEventId())
+	}
+}
+
+func (s *adminHandlerSuite) Test_GetWorkflowExecutionEvents_InvalidRange() {
+	execution := &commonpb.WorkflowExecution{WorkflowId: "workflowID", RunId: uuid.New()}
+	_, err := s.handler.GetWorkflowExecutionEvents(context.Background(), &adminservice.GetWorkflowExecutionEventsRequest{
+		Namespace: s.namespace,
+		Execution: execution,
+	})
+	s.Equal(errInvalidEventQueryRange, err)
+
+	_, err = s.handler.GetWorkflowExecutionEvents(context.Background(), &adminservice.GetWorkflowExecutionEventsRequest{
+		Namespace:    s.namespace,
+		Execution:    execution,
+		FirstEventId: 5,
+		LastEventId:  3,
+	})
+	s.Equal(errInvalidEventQueryRange, err)
+
+	_, err = s.handler.GetWorkflowExecutionEvents(context.Background(), &adminservice.GetWorkflowExecutionEventsRequest{
+		Namespace:    s.namespace,
+		Execution:    execution,
+		FirstEventId: 1,
+		LastEventId:  maxWorkflowExecutionEventsRangeSize + 1,
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
+func (s *adminHandlerSuite) Test_ExecuteCrossClusterTask() {
+	namespaceID := uuid.New()
+	execution := &commonpb.WorkflowExecution{WorkflowId: "workflowID", RunId: uuid.New()}
+	s.mockResource.ClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestAllClusterInfo).AnyTimes()
+	s.mockResource.ClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	namespaceEntry := cache.NewGlobalNamespaceCacheEntryForTest(
+		&persistencespb.NamespaceInfo{Id: namespaceID, Name: s.namespace},
+		&persistencespb.NamespaceConfig{},
+		&persistencespb.NamespaceReplicationConfig{
+			ActiveClusterName: cluster.TestCurrentClusterName,
+			Clusters:          []string{cluster.TestCurrentClusterName, cluster.TestAlternativeClusterName},
+		},
+		1,
+		s.mockResource.ClusterMetadata,
+	)
+	s.mockNamespaceCache.EXPECT().GetNamespaceByID(namespaceID).Return(namespaceEntry, nil).AnyTimes()
+
+	_, err := s.handler.ExecuteCrossClusterTask(context.Background(), &adminservice.ExecuteCrossClusterTaskRequest{})
+	s.Equal(errClusterNameNotSet, err)
+
+	_, err = s.handler.ExecuteCrossClusterTask(context.Background(), &adminservice.ExecuteCrossClusterTaskRequest{
+		SourceCluster: cluster.TestAlternativeClusterName,
+	})
+	s.Equal(errUnknownCrossClusterTask, err)
+
+	for _, sourceCluster := range []string{cluster.TestCurrentClusterName, "unknownCluster"} {
+		_, err = s.handler.ExecuteCrossClusterTask(context.Background(), &adminservice.ExecuteCrossClusterTaskRequest{
+			SourceCluster: sourceCluster,
+			Attributes: &adminservice.ExecuteCrossClusterTaskRequest_SignalWorkflowExecutionTaskAttributes{
+				SignalWorkflowExecutionTaskAttributes: &adminservice.SignalWorkflowExecutionTaskAttributes{
+					NamespaceId: namespaceID,
+				},
+			},
+		})
+		s.Equal(errInvalidSourceCluster, err)
+	}
+
+	s.mockHistoryClient.EXPECT().RemoveSignalMutableState(gomock.Any(), &historyservice.RemoveSignalMutableStateRequest{
+		NamespaceId:       namespaceID,
+		WorkflowExecution: execution,
+		RequestId:         "requestID",
+	}).Return(&historyservice.RemoveSignalMutableStateResponse{}, nil)
+	resp, err := s.handler.ExecuteCrossClusterTask(context.Background(), &adminservice.ExecuteCrossClusterTaskRequest{
+		SourceCluster: cluster.TestAlternativeClusterName,
+		Attributes: &adminservice.ExecuteCrossClusterTaskRequest_RemoveSignalMutableStateTaskAttributes{
+			RemoveSignalMutableStateTaskAttributes: &adminservice.RemoveSignalMutableStateTaskAttributes{
+				NamespaceId:       namespaceID,
+				WorkflowExecution: execution,
+				RequestId:         "requestID",
+			},
+		},
+	})
+	s.NoError(err)
+	s.Empty(resp.GetRunId())
+
+	s.mockHistoryClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(&historyservice.StartWorkflowExecutionResponse{
+		RunId: execution.GetRunId(),
+	}, nil)
+	resp, err = s.handler.ExecuteCrossClusterTask(context.Background(), &adminservice.ExecuteCrossClusterTaskRequest{
+		SourceCluster: cluster.TestAlternativeClusterName,
+		Attributes: &adminservice.ExecuteCrossClusterTaskRequest_StartWorkflowExecutionTaskAttributes{
+			StartWorkflowExecutionTaskAttributes: &adminservice.StartWorkflowExecutionTaskAttributes{
+				NamespaceId: namespaceID,
+				Attempt:     1,
+			},
+		},
+	})
+	s.NoError(err)
+	s.Equal(execution.GetRunId(), resp.GetRunId())
+
+	namespaceNotActiveErr := serviceerror.NewNamespaceNotActive(s.namespace, cluster.TestCurrentClusterName, cluster.TestAlternativeClusterName)
+	s.mockHistoryClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, namespaceNotActiveErr)
+	_, err = s.handler.ExecuteCrossClusterTask(context.Background(), &adminservice.ExecuteCrossClusterTaskRequest{
+		SourceCluster: cluster.TestAlternativeClusterName,
+		Attributes: &adminservice.ExecuteCrossClusterTaskRequest_SignalWorkflowExecutionTaskAttributes{
+			SignalWorkflowExecutionTaskAttributes: &adminservice.SignalWorkflowExecutionTaskAttributes{
+				NamespaceId: namespaceID,
+			},
+		},
+	})
+	s.Equal(namespaceNotActiveErr, err)
+}
+
+func (s *adminHandlerSuite) Test_ExecuteCrossClusterTask_NamespaceNotActive() {
+	namespaceID := uuid.New()
+	s.mockResource.ClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestAllClusterInfo).AnyTimes()
+	s.mockResource.ClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	namespaceEntry := cache.NewGlobalNamespaceCacheEntryForTest(
+		&persistencespb.NamespaceInfo{Id: namespaceID, Name: s.namespace},
+		&persistencespb.NamespaceConfig{},
+		&persistencespb.NamespaceReplicationConfig{
+			ActiveClusterName: cluster.TestAlternativeClusterName,
+			Clusters:          []string{cluster.TestCurrentClusterName, cluster.TestAlternativeClusterName},
+		},
+		1,
+		s.mockResource.ClusterMetadata,
+	)
+	s.mockNamespaceCache.EXPECT().GetNamespaceByID(namespaceID).Return(namespaceEntry, nil)
+
+	_, err := s.handler.ExecuteCrossClusterTask(context.Background(), &adminservice.ExecuteCrossClusterTaskRequest{
+		SourceCluster: cluster.TestAlternativeClusterName,
+		Attributes: &adminservice.ExecuteCrossClusterTaskRequest_SignalWorkflowExecutionTaskAttributes{
+			SignalWorkflowExecutionTaskAttributes: &adminservice.SignalWorkflowExecutionTaskAttributes{
+				NamespaceId: namespaceID,
+			},
+		},
+	})
+	s.IsType(&serviceerror.NamespaceNotActive{}, err)
+}
+
+func (s *adminHandlerSuite) Test_ExecuteCrossClusterTask_NamespaceNotFound() {
+	namespaceID := uuid.New()
+	s.mockResource.ClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestAllClusterInfo).AnyTimes()
+	s.mockResource.ClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockNamespaceCache.EXPECT().GetNamespaceByID(namespaceID).Return(nil, serviceerror.NewNotFound("namespace not found"))
+
+	_, err := s.handler.ExecuteCrossClusterTask(context.Background(), &adminservice.ExecuteCrossClusterTaskRequest{
+		SourceCluster: cluster.TestAlternativeClusterName,
+		Attributes: &adminservice.ExecuteCrossClusterTaskRequest_SignalWorkflowExecutionTaskAttributes{
+			SignalWorkflowExecutionTaskAttributes: &adminservice.SignalWorkflowExecutionTaskAttributes{
+				NamespaceId: namespaceID,
+			},
+		},
+	})
+	s.Equal(errCrossClusterTaskNamespaceNotFound, err)
+}
+
+func (s *adminHandlerSuite) Test_ListDynamicConfigKeys() {
+	resp, err := s.handler.ListDynamicConfigKeys(context.Background(), &adminservice.ListDynamicConfigKeysRequest{
+		Prefix: "matching.",
+	})
+	s.NoError(err)
+	s.NotEmpty(resp.GetKeys())
+
+	var found *adminservice.DynamicConfigKeyInfo
+	for _, key := range resp.GetKeys() {
+		s.True(strings.HasPrefix(key.GetName(), "matching."))
+		if key.GetName() == dynamicconfig.MatchingNumTaskqueueReadPartitions.String() {
+			found = key
+		}
+	}
+	s.NotNil(found)
+	s.Equal(dynamicconfig.TypeInt.String(), found.GetType())
+	s.Equal([]string{"namespace", "taskQueueName", "taskType"}, found.GetFilters())
+	s.Equal([]string{"4"}, found.GetDefaultValues())
+	s.Equal("1", found.GetMinValue())
+	s.NotEmpty(found.GetDescription())
+}
@@ -0,0 +1,98 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	"google.golang.org/grpc"
+
+	"go.temporal.io/server/common/service/dynamicconfig"
+)
+
+type (
+	// apiTimeoutInterceptor caps the deadline of API calls at the server side timeout configured for the
+	// namespace and API, protecting the cluster from clients setting enormous deadlines
+	apiTimeoutInterceptor struct {
+		timeout dynamicconfig.DurationPropertyFnWithAPINameFilter
+	}
+
+	requestWithNamespace interface {
+		GetNamespace() string
+	}
+)
+
+func newAPITimeoutInterceptor(
+	timeout dynamicconfig.DurationPropertyFnWithAPINameFilter,
+) *apiTimeoutInterceptor {
+
+	return &apiTimeoutInterceptor{
+		timeout: timeout,
+	}
+}
+
+// Interceptor is the grpc unary server interceptor
+func (i *apiTimeoutInterceptor) Interceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+
+	var namespace string
+	if request, ok := req.(requestWithNamespace); ok {
+		namespace = request.GetNamespace()
+	}
+	apiName := apiNameFromFullMethod(info.FullMethod)
+
+	timeout := i.timeout(namespace, apiName)
+	if timeout <= 0 {
+		return handler(ctx, req)
+	}
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) <= timeout {
+		return handler(ctx, req)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	resp, err := handler(ctx, req)
+	if err != nil && ctx.Err() == context.DeadlineExceeded {
+		return nil, serviceerror.NewDeadlineExceeded(
+			fmt.Sprintf("%v exceeded server side timeout of %v.", apiName, timeout),
+		)
+	}
+	return resp, err
+}
+
+// apiNameFromFullMethod returns the method name of a full grpc method name,
+// e.g. QueryWorkflow for /temporal.api.workflowservice.v1.WorkflowService/QueryWorkflow
+func apiNameFromFullMethod(fullMethod string) string {
+	return fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+}
@@ -0,0 +1,115 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+)
+
+type (
+	apiTimeoutInterceptorSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		info *grpc.UnaryServerInfo
+	}
+)
+
+func TestAPITimeoutInterceptorSuite(t *testing.T) {
+	s := new(apiTimeoutInterceptorSuite)
+	suite.Run(t, s)
+}
+
+func (s *apiTimeoutInterceptorSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.info = &grpc.UnaryServerInfo{FullMethod: "/temporal.api.workflowservice.v1.WorkflowService/QueryWorkflow"}
+}
+
+func (s *apiTimeoutInterceptorSuite) TestTimeoutFilteredByNamespaceAndAPIName() {
+	var namespace, apiName string
+	interceptor := newAPITimeoutInterceptor(func(ns string, api string) time.Duration {
+		namespace, apiName = ns, api
+		return 0
+	})
+	_, err := interceptor.Interceptor(context.Background(), &workflowservice.QueryWorkflowRequest{Namespace: "test-namespace"}, s.info,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			_, ok := ctx.Deadline()
+			s.False(ok)
+			return nil, nil
+		})
+	s.NoError(err)
+	s.Equal("test-namespace", namespace)
+	s.Equal("QueryWorkflow", apiName)
+}
+
+func (s *apiTimeoutInterceptorSuite) TestDeadlineCapped() {
+	interceptor := newAPITimeoutInterceptor(func(string, string) time.Duration { return time.Second })
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+
+	_, err := interceptor.Interceptor(ctx, &workflowservice.QueryWorkflowRequest{}, s.info,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			deadline, ok := ctx.Deadline()
+			s.True(ok)
+			s.True(time.Until(deadline) <= time.Second)
+			return nil, nil
+		})
+	s.NoError(err)
+}
+
+func (s *apiTimeoutInterceptorSuite) TestShorterClientDeadlineKept() {
+	interceptor := newAPITimeoutInterceptor(func(string, string) time.Duration { return time.Hour })
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	clientDeadline, _ := ctx.Deadline()
+
+	_, err := interceptor.Interceptor(ctx, &workflowservice.QueryWorkflowRequest{}, s.info,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			deadline, ok := ctx.Deadline()
+			s.True(ok)
+			s.Equal(clientDeadline, deadline)
+			return nil, nil
+		})
+	s.NoError(err)
+}
+
+func (s *apiTimeoutInterceptorSuite) TestDeadlineExceeded() {
+	interceptor := newAPITimeoutInterceptor(func(string, string) time.Duration { return time.Millisecond })
+
+	_, err := interceptor.Interceptor(context.Background(), &workflowservice.QueryWorkflowRequest{}, s.info,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		})
+	s.IsType(&serviceerror.DeadlineExceeded{}, err)
+}
@@ -0,0 +1,176 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/client/history"
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/claimcheck"
+	"go.temporal.io/server/common/failure"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+type (
+	// claimCheckInterceptor resolves the references to payloads offloaded by the claimCheckOffloader
+	// in history and tasks returned to callers
+	claimCheckInterceptor struct {
+		processor      *claimcheck.Processor
+		namespaceCache cache.NamespaceCache
+		historyClient  history.Client
+		logger         log.Logger
+	}
+)
+
+var (
+	errClaimCheckResolveFailed         = serviceerror.NewInternal("Failed to resolve payload from claim check store.")
+	claimCheckResolveTaskFailedMessage = "Failed to resolve payload from claim check store."
+)
+
+// newClaimCheckInterceptor creates a new claim check interceptor, store may be nil if claim check is not configured
+func newClaimCheckInterceptor(
+	store claimcheck.Store,
+	namespaceCache cache.NamespaceCache,
+	historyClient history.Client,
+	logger log.Logger,
+) *claimCheckInterceptor {
+
+	var processor *claimcheck.Processor
+	if store != nil {
+		processor = claimcheck.NewProcessor(store)
+	}
+	return &claimCheckInterceptor{
+		processor:      processor,
+		namespaceCache: namespaceCache,
+		historyClient:  historyClient,
+		logger:         logger,
+	}
+}
+
+// Interceptor is the grpc unary server interceptor
+func (i *claimCheckInterceptor) Interceptor(
+	ctx context.Context,
+	req interface{},
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+
+	if i.processor == nil {
+		return handler(ctx, req)
+	}
+
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+
+	if err := i.resolveResponse(ctx, resp); err != nil {
+		i.logger.Error("Unable to resolve payload from claim check store.", tag.Error(err))
+		return i.failPolledTask(ctx, req, resp)
+	}
+	return resp, nil
+}
+
+func (i *claimCheckInterceptor) resolveResponse(
+	ctx context.Context,
+	resp interface{},
+) error {
+
+	switch response := resp.(type) {
+	case *workflowservice.GetWorkflowExecutionHistoryResponse:
+		return i.processor.ResolveHistory(ctx, response.GetHistory())
+	case *workflowservice.PollWorkflowTaskQueueResponse:
+		return i.processor.ResolveHistory(ctx, response.GetHistory())
+	case *workflowservice.RespondWorkflowTaskCompletedResponse:
+		return i.processor.ResolveHistory(ctx, response.GetWorkflowTask().GetHistory())
+	case *workflowservice.PollActivityTaskQueueResponse:
+		return i.processor.ResolvePayloads(ctx, response.GetInput())
+	default:
+		return nil
+	}
+}
+
+// failPolledTask fails a task which was already started by matching when its payloads can't be resolved,
+// so that it is retried right away instead of being stuck until it times out. The caller gets an empty
+// response, the same as when the poll times out, and keeps polling.
+func (i *claimCheckInterceptor) failPolledTask(
+	ctx context.Context,
+	req interface{},
+	resp interface{},
+) (interface{}, error) {
+
+	switch response := resp.(type) {
+	case *workflowservice.PollWorkflowTaskQueueResponse:
+		request := req.(*workflowservice.PollWorkflowTaskQueueRequest)
+		namespaceID := i.getNamespaceID(request.GetNamespace())
+		if _, err := i.historyClient.RespondWorkflowTaskFailed(ctx, &historyservice.RespondWorkflowTaskFailedRequest{
+			NamespaceId: namespaceID,
+			FailedRequest: &workflowservice.RespondWorkflowTaskFailedRequest{
+				TaskToken: response.GetTaskToken(),
+				Failure:   failure.NewServerFailure(claimCheckResolveTaskFailedMessage, false),
+				Identity:  request.GetIdentity(),
+				Namespace: request.GetNamespace(),
+			},
+		}); err != nil {
+			i.logger.Warn("Unable to fail workflow task with unresolved payloads.", tag.Error(err))
+		}
+		return &workflowservice.PollWorkflowTaskQueueResponse{}, nil
+
+	case *workflowservice.PollActivityTaskQueueResponse:
+		request := req.(*workflowservice.PollActivityTaskQueueRequest)
+		namespaceID := i.getNamespaceID(request.GetNamespace())
+		if _, err := i.historyClient.RespondActivityTaskFailed(ctx, &historyservice.RespondActivityTaskFailedRequest{
+			NamespaceId: namespaceID,
+			FailedRequest: &workflowservice.RespondActivityTaskFailedRequest{
+				TaskToken: response.GetTaskToken(),
+				Failure:   failure.NewServerFailure(claimCheckResolveTaskFailedMessage, false),
+				Identity:  request.GetIdentity(),
+				Namespace: request.GetNamespace(),
+			},
+		}); err != nil {
+			i.logger.Warn("Unable to fail activity task with unresolved payloads.", tag.Error(err))
+		}
+		return &workflowservice.PollActivityTaskQueueResponse{}, nil
+
+	default:
+		return nil, errClaimCheckResolveFailed
+	}
+}
+
+// getNamespaceID returns the ID of the namespace, or empty string if the namespace cannot be found
+func (i *claimCheckInterceptor) getNamespaceID(name string) string {
+	namespaceID, err := i.namespaceCache.GetNamespaceID(name)
+	if err != nil {
+		return ""
+	}
+	return namespaceID
+}
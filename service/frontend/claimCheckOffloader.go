@@ -0,0 +1,271 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+
+	commandpb "go.temporal.io/api/command/v1"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/client/history"
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/claimcheck"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/service/dynamicconfig"
+)
+
+type (
+	// claimCheckOffloader offloads large input and result payloads of requests to the claim check store,
+	// so that large payloads are never written to history storage. It is called by the handler once a
+	// request has passed rate limiting and validation, so that rejected requests don't leave blobs behind.
+	// The workflows which will have the payloads in their history are recorded as the owners of the blobs.
+	// Payloads are not offloaded while the cluster runs mixed versions, since hosts of older releases
+	// cannot resolve the references.
+	claimCheckOffloader struct {
+		processor      *claimcheck.Processor
+		namespaceCache cache.NamespaceCache
+		historyClient  history.Client
+		threshold      dynamicconfig.IntPropertyFnWithNamespaceFilter
+		versionGuard   *membership.VersionGuard
+		logger         log.Logger
+	}
+)
+
+var (
+	errClaimCheckOffloadFailed       = serviceerror.NewInternal("Failed to offload payload to claim check store.")
+	errClaimCheckReferenceNotAllowed = serviceerror.NewInvalidArgument("Payloads must not be claim check references.")
+)
+
+// newClaimCheckOffloader creates a new claim check offloader, store may be nil if claim check is not configured
+func newClaimCheckOffloader(
+	store claimcheck.Store,
+	namespaceCache cache.NamespaceCache,
+	historyClient history.Client,
+	threshold dynamicconfig.IntPropertyFnWithNamespaceFilter,
+	versionGuard *membership.VersionGuard,
+	logger log.Logger,
+) *claimCheckOffloader {
+
+	var processor *claimcheck.Processor
+	if store != nil {
+		processor = claimcheck.NewProcessor(store)
+	}
+	return &claimCheckOffloader{
+		processor:      processor,
+		namespaceCache: namespaceCache,
+		historyClient:  historyClient,
+		threshold:      threshold,
+		versionGuard:   versionGuard,
+		logger:         logger,
+	}
+}
+
+// offloadPayloads offloads the large payloads sent to workflowID, which is their only owner
+func (o *claimCheckOffloader) offloadPayloads(
+	ctx context.Context,
+	namespace string,
+	namespaceID string,
+	workflowID string,
+	payloads ...*commonpb.Payloads,
+) error {
+
+	threshold, ok, err := o.getThreshold(namespace, payloads...)
+	if err != nil || !ok {
+		return err
+	}
+
+	owners := []claimcheck.Owner{{NamespaceID: namespaceID, WorkflowID: workflowID}}
+	for _, p := range payloads {
+		if err := o.offload(ctx, namespaceID, owners, threshold, p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// offloadCommands offloads the large payloads of the commands completing a workflow task of execution.
+// Payloads of commands targeting another workflow are owned by both workflows and kept in the namespace
+// of the target, the result of a child workflow is owned by its parent as well.
+func (o *claimCheckOffloader) offloadCommands(
+	ctx context.Context,
+	namespace string,
+	namespaceID string,
+	execution *commonpb.WorkflowExecution,
+	commands []*commandpb.Command,
+) error {
+
+	payloads := make([]*commonpb.Payloads, 0, len(commands))
+	for _, command := range commands {
+		_, _, commandPayloads := commandPayloads(command)
+		payloads = append(payloads, commandPayloads)
+	}
+	threshold, ok, err := o.getThreshold(namespace, payloads...)
+	if err != nil || !ok {
+		return err
+	}
+
+	owner := claimcheck.Owner{NamespaceID: namespaceID, WorkflowID: execution.GetWorkflowId()}
+	for _, command := range commands {
+		targetNamespace, targetWorkflowID, commandPayloads := commandPayloads(command)
+		if !claimcheck.NeedsOffload(threshold, commandPayloads) {
+			continue
+		}
+
+		blobNamespaceID := namespaceID
+		owners := []claimcheck.Owner{owner}
+		switch {
+		case targetWorkflowID != "":
+			if targetNamespace != "" && targetNamespace != namespace {
+				// invalid target namespaces are left to be rejected by history, which fails the command
+				targetNamespaceID, err := o.namespaceCache.GetNamespaceID(targetNamespace)
+				if err != nil {
+					continue
+				}
+				blobNamespaceID = targetNamespaceID
+			}
+			owners = append(owners, claimcheck.Owner{NamespaceID: blobNamespaceID, WorkflowID: targetWorkflowID})
+		case command.GetCommandType() == enumspb.COMMAND_TYPE_COMPLETE_WORKFLOW_EXECUTION:
+			parent, err := o.getParent(ctx, namespace, namespaceID, execution)
+			if err != nil {
+				o.logger.Error("Unable to get parent of workflow offloading its result.", tag.Error(err))
+				return errClaimCheckOffloadFailed
+			}
+			if parent != nil {
+				owners = append(owners, *parent)
+			}
+		}
+
+		if err := o.offload(ctx, blobNamespaceID, owners, threshold, commandPayloads); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getThreshold returns the size above which payloads of the namespace are offloaded,
+// false is returned if no payload needs to be offloaded
+func (o *claimCheckOffloader) getThreshold(
+	namespace string,
+	payloads ...*commonpb.Payloads,
+) (int, bool, error) {
+
+	if o.processor == nil {
+		return 0, false, nil
+	}
+	for _, p := range payloads {
+		if claimcheck.HasReference(p) {
+			return 0, false, errClaimCheckReferenceNotAllowed
+		}
+	}
+
+	threshold := o.threshold(namespace)
+	if threshold <= 0 {
+		return 0, false, nil
+	}
+	needsOffload := false
+	for _, p := range payloads {
+		if claimcheck.NeedsOffload(threshold, p) {
+			needsOffload = true
+			break
+		}
+	}
+	if !needsOffload || !o.versionGuard.AllowFeature() {
+		return 0, false, nil
+	}
+	return threshold, true, nil
+}
+
+func (o *claimCheckOffloader) offload(
+	ctx context.Context,
+	namespaceID string,
+	owners []claimcheck.Owner,
+	threshold int,
+	payloads *commonpb.Payloads,
+) error {
+
+	if err := o.processor.OffloadPayloads(ctx, namespaceID, owners, threshold, payloads); err != nil {
+		o.logger.Error("Unable to offload payload to claim check store.", tag.Error(err))
+		return errClaimCheckOffloadFailed
+	}
+	return nil
+}
+
+// getParent returns the parent workflow of execution, nil is returned if it has no parent
+func (o *claimCheckOffloader) getParent(
+	ctx context.Context,
+	namespace string,
+	namespaceID string,
+	execution *commonpb.WorkflowExecution,
+) (*claimcheck.Owner, error) {
+
+	resp, err := o.historyClient.DescribeWorkflowExecution(ctx, &historyservice.DescribeWorkflowExecutionRequest{
+		NamespaceId: namespaceID,
+		Request: &workflowservice.DescribeWorkflowExecutionRequest{
+			Namespace: namespace,
+			Execution: execution,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	info := resp.GetWorkflowExecutionInfo()
+	if info.GetParentExecution().GetWorkflowId() == "" {
+		return nil, nil
+	}
+	return &claimcheck.Owner{
+		NamespaceID: info.GetParentNamespaceId(),
+		WorkflowID:  info.GetParentExecution().GetWorkflowId(),
+	}, nil
+}
+
+// commandPayloads returns the payloads of a command, along with the namespace and ID of the workflow
+// they are sent to if it is another workflow, the namespace is empty for the namespace of the command
+func commandPayloads(command *commandpb.Command) (string, string, *commonpb.Payloads) {
+	switch attributes := command.GetAttributes().(type) {
+	case *commandpb.Command_ScheduleActivityTaskCommandAttributes:
+		return "", "", attributes.ScheduleActivityTaskCommandAttributes.GetInput()
+	case *commandpb.Command_CompleteWorkflowExecutionCommandAttributes:
+		return "", "", attributes.CompleteWorkflowExecutionCommandAttributes.GetResult()
+	case *commandpb.Command_ContinueAsNewWorkflowExecutionCommandAttributes:
+		return "", "", attributes.ContinueAsNewWorkflowExecutionCommandAttributes.GetInput()
+	case *commandpb.Command_StartChildWorkflowExecutionCommandAttributes:
+		return attributes.StartChildWorkflowExecutionCommandAttributes.GetNamespace(),
+			attributes.StartChildWorkflowExecutionCommandAttributes.GetWorkflowId(),
+			attributes.StartChildWorkflowExecutionCommandAttributes.GetInput()
+	case *commandpb.Command_SignalExternalWorkflowExecutionCommandAttributes:
+		return attributes.SignalExternalWorkflowExecutionCommandAttributes.GetNamespace(),
+			attributes.SignalExternalWorkflowExecutionCommandAttributes.GetExecution().GetWorkflowId(),
+			attributes.SignalExternalWorkflowExecutionCommandAttributes.GetInput()
+	default:
+		return "", "", nil
+	}
+}
@@ -0,0 +1,232 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commandpb "go.temporal.io/api/command/v1"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/historyservicemock/v1"
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/claimcheck"
+	"go.temporal.io/server/common/log/loggerimpl"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/service/dynamicconfig"
+)
+
+type (
+	claimCheckOffloaderSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller         *gomock.Controller
+		mockNamespaceCache *cache.MockNamespaceCache
+		mockHistoryClient  *historyservicemock.MockHistoryServiceClient
+		store              *testClaimCheckStore
+		threshold          int
+		offloader          *claimCheckOffloader
+	}
+
+	// testClaimCheckStore keeps blobs in memory
+	testClaimCheckStore struct {
+		blobs map[string][]byte
+	}
+)
+
+const (
+	testClaimCheckNamespace   = "test-namespace"
+	testClaimCheckNamespaceID = "c3b1a1d6-6a40-4c3f-9bd0-6f1b0e0b1a01"
+	testClaimCheckTargetID    = "c3b1a1d6-6a40-4c3f-9bd0-6f1b0e0b1a02"
+)
+
+func TestClaimCheckOffloaderSuite(t *testing.T) {
+	s := new(claimCheckOffloaderSuite)
+	suite.Run(t, s)
+}
+
+func (s *claimCheckOffloaderSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.mockNamespaceCache = cache.NewMockNamespaceCache(s.controller)
+	s.mockHistoryClient = historyservicemock.NewMockHistoryServiceClient(s.controller)
+	s.store = &testClaimCheckStore{blobs: make(map[string][]byte)}
+	s.threshold = 100
+	logger := loggerimpl.NewNopLogger()
+	s.offloader = newClaimCheckOffloader(
+		s.store,
+		s.mockNamespaceCache,
+		s.mockHistoryClient,
+		func(string) int { return s.threshold },
+		membership.NewVersionGuard(nil, dynamicconfig.GetBoolPropertyFn(true), logger),
+		logger,
+	)
+}
+
+func (s *claimCheckOffloaderSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *claimCheckOffloaderSuite) TestOffloadPayloads() {
+	small := newClaimCheckTestPayloads(10)
+	large := newClaimCheckTestPayloads(1024)
+
+	s.NoError(s.offloader.offloadPayloads(context.Background(), testClaimCheckNamespace, testClaimCheckNamespaceID, "wid", small, large))
+	s.False(claimcheck.HasReference(small))
+	s.True(claimcheck.HasReference(large))
+	s.Equal([][]claimcheck.Owner{
+		{{NamespaceID: testClaimCheckNamespaceID, WorkflowID: "wid"}},
+	}, s.blobOwners(testClaimCheckNamespaceID))
+}
+
+func (s *claimCheckOffloaderSuite) TestOffloadPayloads_ReferenceNotAllowed() {
+	large := newClaimCheckTestPayloads(1024)
+	s.NoError(s.offloader.offloadPayloads(context.Background(), testClaimCheckNamespace, testClaimCheckNamespaceID, "wid", large))
+
+	s.Equal(errClaimCheckReferenceNotAllowed, s.offloader.offloadPayloads(context.Background(), testClaimCheckNamespace, testClaimCheckNamespaceID, "wid", large))
+}
+
+func (s *claimCheckOffloaderSuite) TestOffloadPayloads_Disabled() {
+	s.threshold = 0
+	large := newClaimCheckTestPayloads(1024)
+
+	s.NoError(s.offloader.offloadPayloads(context.Background(), testClaimCheckNamespace, testClaimCheckNamespaceID, "wid", large))
+	s.False(claimcheck.HasReference(large))
+	s.Empty(s.store.blobs)
+}
+
+func (s *claimCheckOffloaderSuite) TestOffloadCommands() {
+	execution := &commonpb.WorkflowExecution{WorkflowId: "wid", RunId: "rid"}
+	signalInput := newClaimCheckTestPayloads(1024)
+	childInput := newClaimCheckTestPayloads(1024)
+	result := newClaimCheckTestPayloads(1024)
+	commands := []*commandpb.Command{
+		{
+			CommandType: enumspb.COMMAND_TYPE_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION,
+			Attributes: &commandpb.Command_SignalExternalWorkflowExecutionCommandAttributes{
+				SignalExternalWorkflowExecutionCommandAttributes: &commandpb.SignalExternalWorkflowExecutionCommandAttributes{
+					Namespace: "target-namespace",
+					Execution: &commonpb.WorkflowExecution{WorkflowId: "signaled-wid"},
+					Input:     signalInput,
+				},
+			},
+		},
+		{
+			CommandType: enumspb.COMMAND_TYPE_START_CHILD_WORKFLOW_EXECUTION,
+			Attributes: &commandpb.Command_StartChildWorkflowExecutionCommandAttributes{
+				StartChildWorkflowExecutionCommandAttributes: &commandpb.StartChildWorkflowExecutionCommandAttributes{
+					WorkflowId: "child-wid",
+					Input:      childInput,
+				},
+			},
+		},
+		{
+			CommandType: enumspb.COMMAND_TYPE_COMPLETE_WORKFLOW_EXECUTION,
+			Attributes: &commandpb.Command_CompleteWorkflowExecutionCommandAttributes{
+				CompleteWorkflowExecutionCommandAttributes: &commandpb.CompleteWorkflowExecutionCommandAttributes{
+					Result: result,
+				},
+			},
+		},
+	}
+	s.mockNamespaceCache.EXPECT().GetNamespaceID("target-namespace").Return(testClaimCheckTargetID, nil)
+	s.mockHistoryClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), &historyservice.DescribeWorkflowExecutionRequest{
+		NamespaceId: testClaimCheckNamespaceID,
+		Request: &workflowservice.DescribeWorkflowExecutionRequest{
+			Namespace: testClaimCheckNamespace,
+			Execution: execution,
+		},
+	}).Return(&historyservice.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+			ParentNamespaceId: testClaimCheckTargetID,
+			ParentExecution:   &commonpb.WorkflowExecution{WorkflowId: "parent-wid"},
+		},
+	}, nil)
+
+	s.NoError(s.offloader.offloadCommands(context.Background(), testClaimCheckNamespace, testClaimCheckNamespaceID, execution, commands))
+	s.True(claimcheck.HasReference(signalInput))
+	s.True(claimcheck.HasReference(childInput))
+	s.True(claimcheck.HasReference(result))
+
+	owner := claimcheck.Owner{NamespaceID: testClaimCheckNamespaceID, WorkflowID: "wid"}
+	s.ElementsMatch([][]claimcheck.Owner{
+		{owner, {NamespaceID: testClaimCheckNamespaceID, WorkflowID: "child-wid"}},
+		{owner, {NamespaceID: testClaimCheckTargetID, WorkflowID: "parent-wid"}},
+	}, s.blobOwners(testClaimCheckNamespaceID))
+	s.Equal([][]claimcheck.Owner{
+		{owner, {NamespaceID: testClaimCheckTargetID, WorkflowID: "signaled-wid"}},
+	}, s.blobOwners(testClaimCheckTargetID))
+}
+
+// blobOwners returns the owners of every blob of namespaceID
+func (s *claimCheckOffloaderSuite) blobOwners(namespaceID string) [][]claimcheck.Owner {
+	var blobOwners [][]claimcheck.Owner
+	_, err := claimcheck.NewProcessor(s.store).DeleteExpired(context.Background(), namespaceID, time.Now().Add(time.Minute), func(owners []claimcheck.Owner) (bool, error) {
+		blobOwners = append(blobOwners, owners)
+		return false, nil
+	})
+	s.NoError(err)
+	return blobOwners
+}
+
+func newClaimCheckTestPayloads(size int) *commonpb.Payloads {
+	return &commonpb.Payloads{Payloads: []*commonpb.Payload{{Data: make([]byte, size)}}}
+}
+
+func (s *testClaimCheckStore) Put(_ context.Context, key string, data []byte) error {
+	s.blobs[key] = data
+	return nil
+}
+
+func (s *testClaimCheckStore) Get(_ context.Context, key string) ([]byte, error) {
+	return s.blobs[key], nil
+}
+
+func (s *testClaimCheckStore) List(_ context.Context, namespaceID string, _ time.Time, fn func(key string) error) error {
+	for key := range s.blobs {
+		if strings.HasPrefix(key, namespaceID+"/") {
+			if err := fn(key); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *testClaimCheckStore) Delete(_ context.Context, key string) error {
+	delete(s.blobs, key)
+	return nil
+}
@@ -47,6 +47,10 @@ const (
 	// 4. RequestCancelWorkflowExecution
 	// 5. TerminateWorkflowExecution
 	// 6. QueryWorkflow
+	// 7. PauseWorkflowExecution
+	// 8. UnpauseWorkflowExecution
+	// 9. BatchCompleteActivityTasksById
+	// 10. BatchFailActivityTasksById
 	// please also reference selectedAPIsForwardingRedirectionPolicyWhitelistedAPIs
 	DCRedirectionPolicySelectedAPIsForwarding = "selected-apis-forwarding"
 )
@@ -80,6 +84,10 @@ var selectedAPIsForwardingRedirectionPolicyWhitelistedAPIs = map[string]struct{}
 	"RequestCancelWorkflowExecution":   {},
 	"TerminateWorkflowExecution":       {},
 	"QueryWorkflow":                    {},
+	"PauseWorkflowExecution":           {},
+	"UnpauseWorkflowExecution":         {},
+	"BatchCompleteActivityTasksById":   {},
+	"BatchFailActivityTasksById":       {},
 }
 
 // RedirectionPolicyGenerator generate corresponding redirection policy
@@ -58,8 +58,12 @@ var (
 	errTaskQueueTooLong                                   = serviceerror.NewInvalidArgument("TaskQueue length exceeds limit.")
 	errRequestIDTooLong                                   = serviceerror.NewInvalidArgument("RequestId length exceeds limit.")
 	errIdentityTooLong                                    = serviceerror.NewInvalidArgument("Identity length exceeds limit.")
+	errIdentityNotSet                                     = serviceerror.NewInvalidArgument("Identity is not set on request.")
 	errEarliestTimeIsGreaterThanLatestTime                = serviceerror.NewInvalidArgument("EarliestTime in StartTimeFilter should not be larger than LatestTime.")
 	errPageSizeTooBig                                     = serviceerror.NewInvalidArgument("PageSize is larger than allowed %d.")
+	errBatchSizeTooBig                                    = serviceerror.NewInvalidArgument("Number of executions is larger than allowed %d.")
+	errActivityTaskBatchSizeTooBig                        = serviceerror.NewInvalidArgument("Number of activity tasks is larger than allowed %d.")
+	errActivityTasksNotSet                                = serviceerror.NewInvalidArgument("ActivityTasks are not set on request.")
 	errClusterIsNotConfiguredForVisibilityArchival        = serviceerror.NewInvalidArgument("Cluster is not configured for visibility archival.")
 	errClusterIsNotConfiguredForReadingArchivalVisibility = serviceerror.NewInvalidArgument("Cluster is not configured for reading archived visibility records.")
 	errNamespaceIsNotConfiguredForVisibilityArchival      = serviceerror.NewInvalidArgument("Namespace is not configured for visibility archival.")
@@ -74,8 +78,11 @@ var (
 	errInvalidEndEventCombination                         = serviceerror.NewInvalidArgument("Invalid EndEventId and EndEventVersion combination.")
 	errInvalidVersionHistories                            = serviceerror.NewInvalidArgument("Invalid version histories.")
 	errInvalidEventQueryRange                             = serviceerror.NewInvalidArgument("Invalid event query range.")
+	errInvalidShardID                                     = serviceerror.NewInvalidArgument("Invalid ShardId.")
 	errUnknownValueType                                   = serviceerror.NewInvalidArgument("Unknown value type, %v.")
 	errDLQTypeIsNotSupported                              = serviceerror.NewInvalidArgument("The DLQ type is not supported.")
+	errUnknownCrossClusterTask                            = serviceerror.NewInvalidArgument("Unknown cross cluster task.")
+	errCrossClusterTaskNotGlobalNamespace                 = serviceerror.NewInvalidArgument("Cross cluster tasks are only allowed for global namespaces.")
 	errFailureMustHaveApplicationFailureInfo              = serviceerror.NewInvalidArgument("Failure must have ApplicationFailureInfo.")
 	errStatusFilterMustBeNotRunning                       = serviceerror.NewInvalidArgument("StatusFilter must be specified and must be not Running.")
 	errTokenNamespaceMismatch                             = serviceerror.NewInvalidArgument("Operation requested with a token from a different namespace.")
@@ -85,8 +92,13 @@ var (
 	errFailedToCreateESIndex     = serviceerror.NewInternal("Failed to create ES index, err: %v.")
 	errFailedToUpdateESMapping   = serviceerror.NewInternal("Failed to update ES mapping, err: %v.")
 
-	errNoPermission = serviceerror.NewPermissionDenied("No permission to do this operation.")
-	errUnauthorized = serviceerror.NewPermissionDenied("Request unauthorized.")
+	errNoPermission         = serviceerror.NewPermissionDenied("No permission to do this operation.")
+	errUnauthorized         = serviceerror.NewPermissionDenied("Request unauthorized.")
+	errInvalidSourceCluster = serviceerror.NewPermissionDenied("Source cluster is not a remote cluster of the current cluster.")
 
 	errServiceBusy = serviceerror.NewResourceExhausted("Too many outstanding requests to the service.")
+
+	errCrossClusterTaskNamespaceNotFound = serviceerror.NewUnavailable("Namespace of cross cluster task is not yet known to the current cluster.")
+
+	errOperatorAPINotServed = serviceerror.NewUnimplemented("Operator APIs are served on the operator listener.")
 )
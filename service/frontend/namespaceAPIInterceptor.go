@@ -0,0 +1,152 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/client"
+	"go.temporal.io/server/common/authorization"
+)
+
+type (
+	// namespaceAPIInterceptor routes the admin service calls of a listener. Namespace admin APIs, which only
+	// access data of a single namespace (see authorization.IsNamespaceAdminAPI), are served on every listener
+	// and rate limited per namespace like workflow service APIs. All other admin APIs are operator APIs, they
+	// are rejected unless the listener serves operator APIs.
+	namespaceAPIInterceptor struct {
+		serveOperatorAPIs  bool
+		allow              func(namespace string) bool
+		redirectionPolicy  DCRedirectionPolicy
+		currentClusterName string
+		clientBean         client.Bean
+	}
+
+	// namespaceAPIRoute describes how a namespace admin API is served if that differs from the default,
+	// which is charging the call against the namespace rate limit and serving it by the local handler
+	namespaceAPIRoute struct {
+		// remoteCall calls the API on the admin service of another cluster. It is set for APIs mutating a
+		// workflow, which are redirected to the active cluster of the namespace.
+		remoteCall func(ctx context.Context, client adminservice.AdminServiceClient, request interface{}) (interface{}, error)
+		// rateLimitedByHandler is set for batch APIs, whose handler charges every item of the request
+		// against the namespace rate limit instead
+		rateLimitedByHandler bool
+	}
+)
+
+var namespaceAPIRoutes = map[string]namespaceAPIRoute{
+	"PauseWorkflowExecution": {
+		remoteCall: func(ctx context.Context, client adminservice.AdminServiceClient, request interface{}) (interface{}, error) {
+			return client.PauseWorkflowExecution(ctx, request.(*adminservice.PauseWorkflowExecutionRequest))
+		},
+	},
+	"UnpauseWorkflowExecution": {
+		remoteCall: func(ctx context.Context, client adminservice.AdminServiceClient, request interface{}) (interface{}, error) {
+			return client.UnpauseWorkflowExecution(ctx, request.(*adminservice.UnpauseWorkflowExecutionRequest))
+		},
+	},
+	"BatchCompleteActivityTasksById": {
+		remoteCall: func(ctx context.Context, client adminservice.AdminServiceClient, request interface{}) (interface{}, error) {
+			return client.BatchCompleteActivityTasksById(ctx, request.(*adminservice.BatchCompleteActivityTasksByIdRequest))
+		},
+		rateLimitedByHandler: true,
+	},
+	"BatchFailActivityTasksById": {
+		remoteCall: func(ctx context.Context, client adminservice.AdminServiceClient, request interface{}) (interface{}, error) {
+			return client.BatchFailActivityTasksById(ctx, request.(*adminservice.BatchFailActivityTasksByIdRequest))
+		},
+		rateLimitedByHandler: true,
+	},
+}
+
+// newNamespaceAPIInterceptor creates the namespace API interceptor of a listener, serveOperatorAPIs is false
+// for the frontend listener if operator APIs are served on a separate operator listener
+func newNamespaceAPIInterceptor(
+	serveOperatorAPIs bool,
+	allow func(namespace string) bool,
+	redirectionPolicy DCRedirectionPolicy,
+	currentClusterName string,
+	clientBean client.Bean,
+) *namespaceAPIInterceptor {
+
+	return &namespaceAPIInterceptor{
+		serveOperatorAPIs:  serveOperatorAPIs,
+		allow:              allow,
+		redirectionPolicy:  redirectionPolicy,
+		currentClusterName: currentClusterName,
+		clientBean:         clientBean,
+	}
+}
+
+// Interceptor is the grpc unary server interceptor
+func (i *namespaceAPIInterceptor) Interceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+
+	if !authorization.IsAdminAPI(info.FullMethod) {
+		return handler(ctx, req)
+	}
+	if !authorization.IsNamespaceAdminAPI(info.FullMethod) {
+		if !i.serveOperatorAPIs {
+			return nil, errOperatorAPINotServed
+		}
+		return handler(ctx, req)
+	}
+
+	var namespace string
+	if request, ok := req.(requestWithNamespace); ok {
+		namespace = request.GetNamespace()
+	}
+	apiName := apiNameFromFullMethod(info.FullMethod)
+	route := namespaceAPIRoutes[apiName]
+
+	if !route.rateLimitedByHandler {
+		if ok := i.allow(namespace); !ok {
+			return nil, errServiceBusy
+		}
+	}
+	if route.remoteCall == nil {
+		return handler(ctx, req)
+	}
+
+	var resp interface{}
+	err := i.redirectionPolicy.WithNamespaceRedirect(ctx, namespace, apiName, func(targetDC string) error {
+		var err error
+		switch {
+		case targetDC == i.currentClusterName:
+			resp, err = handler(ctx, req)
+		default:
+			resp, err = route.remoteCall(ctx, i.clientBean.GetRemoteAdminClient(targetDC), req)
+		}
+		return err
+	})
+	return resp, err
+}
@@ -0,0 +1,168 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/adminservicemock/v1"
+	"go.temporal.io/server/client"
+	"go.temporal.io/server/common/authorization"
+)
+
+const (
+	adminServiceFullMethodPrefix = "/temporal.server.api.adminservice.v1.AdminService/"
+)
+
+type (
+	namespaceAPIInterceptorSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller            *gomock.Controller
+		mockClientBean        *client.MockBean
+		mockRemoteAdminClient *adminservicemock.MockAdminServiceClient
+
+		allowed     bool
+		handled     bool
+		interceptor *namespaceAPIInterceptor
+	}
+)
+
+func TestNamespaceAPIInterceptorSuite(t *testing.T) {
+	s := new(namespaceAPIInterceptorSuite)
+	suite.Run(t, s)
+}
+
+func (s *namespaceAPIInterceptorSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.mockClientBean = client.NewMockBean(s.controller)
+	s.mockRemoteAdminClient = adminservicemock.NewMockAdminServiceClient(s.controller)
+
+	s.allowed = true
+	s.handled = false
+	s.interceptor = newNamespaceAPIInterceptor(
+		false,
+		func(namespace string) bool { return s.allowed },
+		NewNoopRedirectionPolicy("active"),
+		"active",
+		s.mockClientBean,
+	)
+}
+
+func (s *namespaceAPIInterceptorSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *namespaceAPIInterceptorSuite) TestNamespaceAPIRoutes() {
+	for apiName := range namespaceAPIRoutes {
+		s.True(authorization.IsNamespaceAdminAPI(adminServiceFullMethodPrefix+apiName), apiName)
+	}
+}
+
+func (s *namespaceAPIInterceptorSuite) TestWorkflowServiceAPI() {
+	s.allowed = false
+
+	_, err := s.intercept("/temporal.api.workflowservice.v1.WorkflowService/QueryWorkflow", &workflowservice.QueryWorkflowRequest{Namespace: "test-namespace"})
+	s.NoError(err)
+	s.True(s.handled)
+}
+
+func (s *namespaceAPIInterceptorSuite) TestOperatorAPI() {
+	_, err := s.intercept(adminServiceFullMethodPrefix+"CloseShard", &adminservice.CloseShardRequest{})
+	s.Equal(errOperatorAPINotServed, err)
+	s.False(s.handled)
+
+	s.interceptor.serveOperatorAPIs = true
+	_, err = s.intercept(adminServiceFullMethodPrefix+"CloseShard", &adminservice.CloseShardRequest{})
+	s.NoError(err)
+	s.True(s.handled)
+}
+
+func (s *namespaceAPIInterceptorSuite) TestNamespaceAPI() {
+	_, err := s.intercept(adminServiceFullMethodPrefix+"DescribeNamespaceConfig", &adminservice.DescribeNamespaceConfigRequest{Namespace: "test-namespace"})
+	s.NoError(err)
+	s.True(s.handled)
+}
+
+func (s *namespaceAPIInterceptorSuite) TestNamespaceAPI_RateLimited() {
+	s.allowed = false
+
+	_, err := s.intercept(adminServiceFullMethodPrefix+"GetWorkflowExecutionRawHistoryV2", &adminservice.GetWorkflowExecutionRawHistoryV2Request{Namespace: "test-namespace"})
+	s.Equal(errServiceBusy, err)
+	s.False(s.handled)
+}
+
+func (s *namespaceAPIInterceptorSuite) TestNamespaceAPI_RateLimitedByHandler() {
+	s.allowed = false
+
+	_, err := s.intercept(adminServiceFullMethodPrefix+"BatchCompleteActivityTasksById", &adminservice.BatchCompleteActivityTasksByIdRequest{Namespace: "test-namespace"})
+	s.NoError(err)
+	s.True(s.handled)
+}
+
+func (s *namespaceAPIInterceptorSuite) TestNamespaceAPI_Redirected() {
+	s.interceptor.redirectionPolicy = NewNoopRedirectionPolicy("standby")
+	request := &adminservice.PauseWorkflowExecutionRequest{Namespace: "test-namespace"}
+	response := &adminservice.PauseWorkflowExecutionResponse{}
+	s.mockClientBean.EXPECT().GetRemoteAdminClient("standby").Return(s.mockRemoteAdminClient)
+	s.mockRemoteAdminClient.EXPECT().PauseWorkflowExecution(gomock.Any(), request).Return(response, nil)
+
+	resp, err := s.intercept(adminServiceFullMethodPrefix+"PauseWorkflowExecution", request)
+	s.NoError(err)
+	s.Equal(response, resp)
+	s.False(s.handled)
+}
+
+func (s *namespaceAPIInterceptorSuite) TestNamespaceAPI_RedirectedBatch() {
+	s.interceptor.redirectionPolicy = NewNoopRedirectionPolicy("standby")
+	request := &adminservice.BatchFailActivityTasksByIdRequest{Namespace: "test-namespace"}
+	response := &adminservice.BatchFailActivityTasksByIdResponse{}
+	s.mockClientBean.EXPECT().GetRemoteAdminClient("standby").Return(s.mockRemoteAdminClient)
+	s.mockRemoteAdminClient.EXPECT().BatchFailActivityTasksById(gomock.Any(), request).Return(response, nil)
+
+	resp, err := s.intercept(adminServiceFullMethodPrefix+"BatchFailActivityTasksById", request)
+	s.NoError(err)
+	s.Equal(response, resp)
+	s.False(s.handled)
+}
+
+func (s *namespaceAPIInterceptorSuite) intercept(fullMethod string, request interface{}) (interface{}, error) {
+	return s.interceptor.Interceptor(context.Background(), request, &grpc.UnaryServerInfo{FullMethod: fullMethod},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			s.handled = true
+			return nil, nil
+		})
+}
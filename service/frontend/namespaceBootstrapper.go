@@ -0,0 +1,351 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	namespacepb "go.temporal.io/api/namespace/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/service/config"
+	"go.temporal.io/server/common/service/dynamicconfig"
+)
+
+const (
+	namespaceBootstrapTimeout = 10 * time.Second
+
+	namespaceBootstrapInitialInterval    = time.Second
+	namespaceBootstrapMaxInterval        = time.Minute
+	namespaceBootstrapExpirationInterval = 30 * time.Minute
+)
+
+type (
+	// namespaceBootstrapper registers or updates the namespaces declared in static config
+	// and adds the search attributes they declare
+	namespaceBootstrapper struct {
+		namespaceHandler      namespace.Handler
+		adminHandler          adminservice.AdminServiceServer
+		validSearchAttributes dynamicconfig.MapPropertyFn
+		namespaces            []config.NamespaceBootstrap
+		logger                log.Logger
+		retryPolicy           backoff.RetryPolicy
+		status                int32
+		shutdownCh            chan struct{}
+	}
+)
+
+func newNamespaceBootstrapper(
+	namespaceHandler namespace.Handler,
+	adminHandler adminservice.AdminServiceServer,
+	validSearchAttributes dynamicconfig.MapPropertyFn,
+	namespaces []config.NamespaceBootstrap,
+	logger log.Logger,
+) *namespaceBootstrapper {
+	retryPolicy := backoff.NewExponentialRetryPolicy(namespaceBootstrapInitialInterval)
+	retryPolicy.SetMaximumInterval(namespaceBootstrapMaxInterval)
+	retryPolicy.SetExpirationInterval(namespaceBootstrapExpirationInterval)
+
+	return &namespaceBootstrapper{
+		namespaceHandler:      namespaceHandler,
+		adminHandler:          adminHandler,
+		validSearchAttributes: validSearchAttributes,
+		namespaces:            namespaces,
+		logger:                logger,
+		retryPolicy:           retryPolicy,
+		status:                common.DaemonStatusInitialized,
+		shutdownCh:            make(chan struct{}),
+	}
+}
+
+// start bootstraps the configured namespaces in background, so a slow persistence layer doesn't block startup
+func (b *namespaceBootstrapper) start() {
+	if !atomic.CompareAndSwapInt32(&b.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+	if len(b.namespaces) == 0 {
+		return
+	}
+	go b.bootstrap()
+}
+
+// stop abandons the bootstrap if it is still in progress
+func (b *namespaceBootstrapper) stop() {
+	if !atomic.CompareAndSwapInt32(&b.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	close(b.shutdownCh)
+}
+
+// bootstrap adds the declared search attributes which do not exist yet, registers the configured
+// namespaces which do not exist yet and updates the ones whose settings deviate from config.
+// Transient errors are retried. It is idempotent and safe to run concurrently from multiple frontend hosts.
+func (b *namespaceBootstrapper) bootstrap() {
+	if err := b.retry(b.bootstrapSearchAttributes); err != nil {
+		b.logger.Error("Unable to bootstrap search attributes.", tag.Error(err))
+	}
+
+	for _, ns := range b.namespaces {
+		ns := ns
+		err := b.retry(func(ctx context.Context) error {
+			return b.bootstrapNamespace(ctx, ns)
+		})
+		if err != nil {
+			b.logger.Error("Unable to bootstrap namespace.", tag.WorkflowNamespace(ns.Name), tag.Error(err))
+		}
+	}
+}
+
+func (b *namespaceBootstrapper) retry(
+	operation func(ctx context.Context) error,
+) error {
+
+	retrier := backoff.NewRetrier(b.retryPolicy, backoff.SystemClock)
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), namespaceBootstrapTimeout)
+		err := operation(ctx)
+		cancel()
+		if err == nil || !isNamespaceBootstrapTransientError(err) {
+			return err
+		}
+
+		next := retrier.NextBackOff()
+		if next == backoff.NoBackoff {
+			return err
+		}
+		b.logger.Warn("Namespace bootstrap failed, will retry.", tag.Error(err))
+		select {
+		case <-b.shutdownCh:
+			return err
+		case <-time.After(next):
+		}
+	}
+}
+
+// bootstrapSearchAttributes adds the search attributes declared by the configured namespaces.
+// Search attributes are cluster scoped, so they are added for every namespace.
+func (b *namespaceBootstrapper) bootstrapSearchAttributes(
+	ctx context.Context,
+) error {
+
+	current := b.validSearchAttributes()
+	missing := make(map[string]enumspb.IndexedValueType)
+	for _, ns := range b.namespaces {
+		for name, valueType := range ns.SearchAttributes {
+			indexedValueType := toIndexedValueType(valueType)
+			if currentType, ok := current[name]; ok {
+				if common.ConvertIndexedValueTypeToProtoType(currentType, b.logger) != indexedValueType {
+					b.logger.Error("Bootstrapped search attribute type differs from config and can't be changed.",
+						tag.WorkflowNamespace(ns.Name), tag.ESKey(name))
+				}
+				continue
+			}
+			missing[name] = indexedValueType
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	if _, err := b.adminHandler.AddSearchAttribute(ctx, &adminservice.AddSearchAttributeRequest{
+		SearchAttribute: missing,
+	}); err != nil {
+		return err
+	}
+	b.logger.Info("Bootstrapped search attributes added.", tag.Number(int64(len(missing))))
+	return nil
+}
+
+func (b *namespaceBootstrapper) bootstrapNamespace(
+	ctx context.Context,
+	ns config.NamespaceBootstrap,
+) error {
+
+	describeResponse, err := b.namespaceHandler.DescribeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{
+		Namespace: ns.Name,
+	})
+	switch err.(type) {
+	case nil:
+		// namespace exists, update it if needed
+	case *serviceerror.NotFound:
+		_, err = b.namespaceHandler.RegisterNamespace(ctx, toRegisterNamespaceRequest(ns))
+		switch err.(type) {
+		case nil:
+			b.logger.Info("Bootstrapped namespace registered.", tag.WorkflowNamespace(ns.Name))
+			return nil
+		case *serviceerror.NamespaceAlreadyExists:
+			// registered concurrently by another host, make sure it matches config
+			describeResponse, err = b.namespaceHandler.DescribeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{
+				Namespace: ns.Name,
+			})
+			if err != nil {
+				return err
+			}
+		default:
+			return err
+		}
+	default:
+		return err
+	}
+
+	if describeResponse.GetIsGlobalNamespace() != ns.IsGlobalNamespace {
+		b.logger.Warn("Bootstrapped namespace global flag differs from config and can't be changed.",
+			tag.WorkflowNamespace(ns.Name))
+	}
+
+	updateRequest := toUpdateNamespaceRequest(ns, describeResponse)
+	if updateRequest == nil {
+		return nil
+	}
+	if _, err := b.namespaceHandler.UpdateNamespace(ctx, updateRequest); err != nil {
+		return err
+	}
+	b.logger.Info("Bootstrapped namespace updated.", tag.WorkflowNamespace(ns.Name))
+	return nil
+}
+
+func toRegisterNamespaceRequest(ns config.NamespaceBootstrap) *workflowservice.RegisterNamespaceRequest {
+	request := &workflowservice.RegisterNamespaceRequest{
+		Namespace:               ns.Name,
+		Description:             ns.Description,
+		OwnerEmail:              ns.OwnerEmail,
+		Data:                    ns.Data,
+		IsGlobalNamespace:       ns.IsGlobalNamespace,
+		HistoryArchivalState:    toArchivalState(ns.Archival.History.State),
+		HistoryArchivalUri:      ns.Archival.History.URI,
+		VisibilityArchivalState: toArchivalState(ns.Archival.Visibility.State),
+		VisibilityArchivalUri:   ns.Archival.Visibility.URI,
+	}
+	if ns.Retention != 0 {
+		request.WorkflowExecutionRetentionPeriod = timestamp.DurationPtr(ns.Retention)
+	}
+	return request
+}
+
+// toUpdateNamespaceRequest returns the request to converge the current namespace with config,
+// or nil if the namespace already matches config.
+func toUpdateNamespaceRequest(
+	ns config.NamespaceBootstrap,
+	current *workflowservice.DescribeNamespaceResponse,
+) *workflowservice.UpdateNamespaceRequest {
+
+	changed := false
+	info := &namespacepb.UpdateNamespaceInfo{}
+	if ns.Description != "" && ns.Description != current.GetNamespaceInfo().GetDescription() {
+		info.Description = ns.Description
+		changed = true
+	}
+	if ns.OwnerEmail != "" && ns.OwnerEmail != current.GetNamespaceInfo().GetOwnerEmail() {
+		info.OwnerEmail = ns.OwnerEmail
+		changed = true
+	}
+	for k, v := range ns.Data {
+		if currentValue, ok := current.GetNamespaceInfo().GetData()[k]; !ok || currentValue != v {
+			if info.Data == nil {
+				info.Data = make(map[string]string)
+			}
+			info.Data[k] = v
+			changed = true
+		}
+	}
+
+	nsConfig := &namespacepb.NamespaceConfig{}
+	currentConfig := current.GetConfig()
+	if ns.Retention != 0 && ns.Retention != timestamp.DurationValue(currentConfig.GetWorkflowExecutionRetentionTtl()) {
+		nsConfig.WorkflowExecutionRetentionTtl = timestamp.DurationPtr(ns.Retention)
+		changed = true
+	}
+	if state := toArchivalState(ns.Archival.History.State); isArchivalChanged(
+		state, ns.Archival.History.URI, currentConfig.GetHistoryArchivalState(), currentConfig.GetHistoryArchivalUri(),
+	) {
+		nsConfig.HistoryArchivalState = state
+		nsConfig.HistoryArchivalUri = ns.Archival.History.URI
+		changed = true
+	}
+	if state := toArchivalState(ns.Archival.Visibility.State); isArchivalChanged(
+		state, ns.Archival.Visibility.URI, currentConfig.GetVisibilityArchivalState(), currentConfig.GetVisibilityArchivalUri(),
+	) {
+		nsConfig.VisibilityArchivalState = state
+		nsConfig.VisibilityArchivalUri = ns.Archival.Visibility.URI
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+	return &workflowservice.UpdateNamespaceRequest{
+		Namespace:  ns.Name,
+		UpdateInfo: info,
+		Config:     nsConfig,
+	}
+}
+
+func isArchivalChanged(
+	state enumspb.ArchivalState,
+	URI string,
+	currentState enumspb.ArchivalState,
+	currentURI string,
+) bool {
+	if state != enumspb.ARCHIVAL_STATE_UNSPECIFIED && state != currentState {
+		return true
+	}
+	return URI != "" && URI != currentURI
+}
+
+func toIndexedValueType(valueType string) enumspb.IndexedValueType {
+	for name, value := range enumspb.IndexedValueType_value {
+		if strings.EqualFold(name, strings.TrimSpace(valueType)) {
+			return enumspb.IndexedValueType(value)
+		}
+	}
+	return enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED
+}
+
+func isNamespaceBootstrapTransientError(err error) bool {
+	return common.IsWhitelistServiceTransientError(err) || common.IsContextDeadlineExceededErr(err)
+}
+
+func toArchivalState(state string) enumspb.ArchivalState {
+	switch strings.TrimSpace(strings.ToLower(state)) {
+	case common.ArchivalEnabled:
+		return enumspb.ARCHIVAL_STATE_ENABLED
+	case common.ArchivalDisabled:
+		return enumspb.ARCHIVAL_STATE_DISABLED
+	default:
+		return enumspb.ARCHIVAL_STATE_UNSPECIFIED
+	}
+}
@@ -0,0 +1,233 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	namespacepb "go.temporal.io/api/namespace/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/adminservicemock/v1"
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/log/loggerimpl"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/service/config"
+	"go.temporal.io/server/common/service/dynamicconfig"
+)
+
+type (
+	namespaceBootstrapperSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller            *gomock.Controller
+		namespaceHandler      *namespace.MockHandler
+		adminHandler          *adminservicemock.MockAdminServiceServer
+		validSearchAttributes map[string]interface{}
+		bootstrapper          *namespaceBootstrapper
+	}
+)
+
+func TestNamespaceBootstrapperSuite(t *testing.T) {
+	s := new(namespaceBootstrapperSuite)
+	suite.Run(t, s)
+}
+
+func (s *namespaceBootstrapperSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.namespaceHandler = namespace.NewMockHandler(s.controller)
+	s.adminHandler = adminservicemock.NewMockAdminServiceServer(s.controller)
+	s.validSearchAttributes = map[string]interface{}{"CustomKeywordField": 2}
+	s.bootstrapper = newNamespaceBootstrapper(
+		s.namespaceHandler,
+		s.adminHandler,
+		func(opts ...dynamicconfig.FilterOption) map[string]interface{} { return s.validSearchAttributes },
+		[]config.NamespaceBootstrap{{
+			Name:        "samples",
+			Description: "samples namespace",
+			Retention:   72 * time.Hour,
+			Data:        map[string]string{"team": "infra"},
+		}},
+		loggerimpl.NewDevelopmentForTest(s.Suite),
+	)
+	retryPolicy := backoff.NewExponentialRetryPolicy(time.Millisecond)
+	retryPolicy.SetMaximumAttempts(3)
+	s.bootstrapper.retryPolicy = retryPolicy
+}
+
+func (s *namespaceBootstrapperSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *namespaceBootstrapperSuite) TestBootstrap_Register() {
+	s.namespaceHandler.EXPECT().DescribeNamespace(gomock.Any(), &workflowservice.DescribeNamespaceRequest{Namespace: "samples"}).
+		Return(nil, serviceerror.NewNotFound("namespace not found"))
+	s.namespaceHandler.EXPECT().RegisterNamespace(gomock.Any(), &workflowservice.RegisterNamespaceRequest{
+		Namespace:                        "samples",
+		Description:                      "samples namespace",
+		Data:                             map[string]string{"team": "infra"},
+		WorkflowExecutionRetentionPeriod: timestamp.DurationPtr(72 * time.Hour),
+	}).Return(&workflowservice.RegisterNamespaceResponse{}, nil)
+
+	s.bootstrapper.bootstrap()
+}
+
+func (s *namespaceBootstrapperSuite) TestBootstrap_UpToDate() {
+	s.namespaceHandler.EXPECT().DescribeNamespace(gomock.Any(), &workflowservice.DescribeNamespaceRequest{Namespace: "samples"}).
+		Return(&workflowservice.DescribeNamespaceResponse{
+			NamespaceInfo: &namespacepb.NamespaceInfo{
+				Name:        "samples",
+				Description: "samples namespace",
+				Data:        map[string]string{"team": "infra", "other": "value"},
+			},
+			Config: &namespacepb.NamespaceConfig{
+				WorkflowExecutionRetentionTtl: timestamp.DurationPtr(72 * time.Hour),
+				HistoryArchivalState:          enumspb.ARCHIVAL_STATE_DISABLED,
+				VisibilityArchivalState:       enumspb.ARCHIVAL_STATE_DISABLED,
+			},
+		}, nil)
+
+	s.bootstrapper.bootstrap()
+}
+
+func (s *namespaceBootstrapperSuite) TestBootstrap_Update() {
+	s.namespaceHandler.EXPECT().DescribeNamespace(gomock.Any(), &workflowservice.DescribeNamespaceRequest{Namespace: "samples"}).
+		Return(&workflowservice.DescribeNamespaceResponse{
+			NamespaceInfo: &namespacepb.NamespaceInfo{
+				Name:        "samples",
+				Description: "samples namespace",
+			},
+			Config: &namespacepb.NamespaceConfig{
+				WorkflowExecutionRetentionTtl: timestamp.DurationPtr(24 * time.Hour),
+			},
+		}, nil)
+	s.namespaceHandler.EXPECT().UpdateNamespace(gomock.Any(), &workflowservice.UpdateNamespaceRequest{
+		Namespace: "samples",
+		UpdateInfo: &namespacepb.UpdateNamespaceInfo{
+			Data: map[string]string{"team": "infra"},
+		},
+		Config: &namespacepb.NamespaceConfig{
+			WorkflowExecutionRetentionTtl: timestamp.DurationPtr(72 * time.Hour),
+		},
+	}).Return(&workflowservice.UpdateNamespaceResponse{}, nil)
+
+	s.bootstrapper.bootstrap()
+}
+
+func (s *namespaceBootstrapperSuite) TestBootstrap_RegisteredConcurrently() {
+	gomock.InOrder(
+		s.namespaceHandler.EXPECT().DescribeNamespace(gomock.Any(), gomock.Any()).
+			Return(nil, serviceerror.NewNotFound("namespace not found")),
+		s.namespaceHandler.EXPECT().RegisterNamespace(gomock.Any(), gomock.Any()).
+			Return(nil, serviceerror.NewNamespaceAlreadyExists("namespace already exists")),
+		s.namespaceHandler.EXPECT().DescribeNamespace(gomock.Any(), gomock.Any()).
+			Return(&workflowservice.DescribeNamespaceResponse{
+				NamespaceInfo: &namespacepb.NamespaceInfo{
+					Name:        "samples",
+					Description: "samples namespace",
+					Data:        map[string]string{"team": "infra"},
+				},
+				Config: &namespacepb.NamespaceConfig{
+					WorkflowExecutionRetentionTtl: timestamp.DurationPtr(72 * time.Hour),
+				},
+			}, nil),
+	)
+
+	s.bootstrapper.bootstrap()
+}
+
+func (s *namespaceBootstrapperSuite) TestBootstrap_RetryTransientError() {
+	gomock.InOrder(
+		s.namespaceHandler.EXPECT().DescribeNamespace(gomock.Any(), gomock.Any()).
+			Return(nil, serviceerror.NewUnavailable("persistence unavailable")),
+		s.namespaceHandler.EXPECT().DescribeNamespace(gomock.Any(), gomock.Any()).
+			Return(nil, serviceerror.NewNotFound("namespace not found")),
+		s.namespaceHandler.EXPECT().RegisterNamespace(gomock.Any(), gomock.Any()).
+			Return(&workflowservice.RegisterNamespaceResponse{}, nil),
+	)
+
+	s.bootstrapper.bootstrap()
+}
+
+func (s *namespaceBootstrapperSuite) TestBootstrap_NonRetryableError() {
+	s.namespaceHandler.EXPECT().DescribeNamespace(gomock.Any(), gomock.Any()).
+		Return(nil, serviceerror.NewNotFound("namespace not found"))
+	s.namespaceHandler.EXPECT().RegisterNamespace(gomock.Any(), gomock.Any()).
+		Return(nil, serviceerror.NewInvalidArgument("invalid retention"))
+
+	s.bootstrapper.bootstrap()
+}
+
+func (s *namespaceBootstrapperSuite) TestBootstrap_SearchAttributes() {
+	s.bootstrapper.namespaces[0].SearchAttributes = map[string]string{
+		"CustomKeywordField": "Keyword",
+		"OrderId":            "keyword",
+		"Amount":             "Double",
+	}
+	s.adminHandler.EXPECT().AddSearchAttribute(gomock.Any(), &adminservice.AddSearchAttributeRequest{
+		SearchAttribute: map[string]enumspb.IndexedValueType{
+			"OrderId": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+			"Amount":  enumspb.INDEXED_VALUE_TYPE_DOUBLE,
+		},
+	}).Return(&adminservice.AddSearchAttributeResponse{}, nil)
+	s.namespaceHandler.EXPECT().DescribeNamespace(gomock.Any(), gomock.Any()).
+		Return(nil, serviceerror.NewNotFound("namespace not found"))
+	s.namespaceHandler.EXPECT().RegisterNamespace(gomock.Any(), gomock.Any()).
+		Return(&workflowservice.RegisterNamespaceResponse{}, nil)
+
+	s.bootstrapper.bootstrap()
+}
+
+func (s *namespaceBootstrapperSuite) TestBootstrap_SearchAttributesExist() {
+	s.bootstrapper.namespaces[0].SearchAttributes = map[string]string{
+		"CustomKeywordField": "Keyword",
+	}
+	s.namespaceHandler.EXPECT().DescribeNamespace(gomock.Any(), gomock.Any()).
+		Return(nil, serviceerror.NewNotFound("namespace not found"))
+	s.namespaceHandler.EXPECT().RegisterNamespace(gomock.Any(), gomock.Any()).
+		Return(&workflowservice.RegisterNamespaceResponse{}, nil)
+
+	s.bootstrapper.bootstrap()
+}
+
+func (s *namespaceBootstrapperSuite) TestStop_Idempotent() {
+	// stop before start is a no-op
+	s.bootstrapper.stop()
+
+	s.bootstrapper.namespaces = nil
+	s.bootstrapper.start()
+	s.bootstrapper.stop()
+	s.bootstrapper.stop()
+}
@@ -81,6 +81,22 @@ type Config struct {
 	BlobSizeLimitError dynamicconfig.IntPropertyFnWithNamespaceFilter
 	BlobSizeLimitWarn  dynamicconfig.IntPropertyFnWithNamespaceFilter
 
+	// ClaimCheckPayloadSizeThreshold is the payload size above which payloads are offloaded to the claim check store
+	ClaimCheckPayloadSizeThreshold dynamicconfig.IntPropertyFnWithNamespaceFilter
+
+	// AllowMixedVersionFeatures allows activating features which older releases do not understand during rolling upgrades
+	AllowMixedVersionFeatures dynamicconfig.BoolPropertyFn
+
+	// APITimeout is the server side timeout of API calls by namespace and API name, 0 means no server side timeout
+	APITimeout dynamicconfig.DurationPropertyFnWithAPINameFilter
+
+	// history limits are enforced by history service, they share its keys and defaults and are only used to
+	// report effective namespace config
+	HistorySizeLimitError     dynamicconfig.IntPropertyFnWithNamespaceFilter
+	HistoryCountLimitError    dynamicconfig.IntPropertyFnWithNamespaceFilter
+	HistoryBlobSizeLimitError dynamicconfig.IntPropertyFnWithNamespaceFilter
+	HistoryBlobSizeLimitWarn  dynamicconfig.IntPropertyFnWithNamespaceFilter
+
 	ThrottledLogRPS dynamicconfig.IntPropertyFn
 
 	// Namespace specific config
@@ -109,6 +125,15 @@ type Config struct {
 
 	// EnableTokenNamespaceEnforcement enables enforcement that namespace in completion token matches namespace of the request
 	EnableTokenNamespaceEnforcement dynamicconfig.BoolPropertyFn
+
+	// BatchDescribeMutableState system protection
+	BatchDescribeMutableStateMaxBatchSize dynamicconfig.IntPropertyFn
+	BatchDescribeMutableStateConcurrency  dynamicconfig.IntPropertyFn
+
+	// BatchCompleteActivityTasksById and BatchFailActivityTasksById system protection
+	BatchActivityTaskMaxBatchSize dynamicconfig.IntPropertyFn
+	BatchActivityTaskConcurrency  dynamicconfig.IntPropertyFn
+	BatchActivityTaskTimeout      dynamicconfig.DurationPropertyFnWithNamespaceFilter
 }
 
 // NewConfig returns new service config with default values
@@ -132,8 +157,15 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int32, enableReadF
 		DisableListVisibilityByFilter:          dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.DisableListVisibilityByFilter, false),
 		BlobSizeLimitError:                     dc.GetIntPropertyFilteredByNamespace(dynamicconfig.BlobSizeLimitError, 2*1024*1024),
 		BlobSizeLimitWarn:                      dc.GetIntPropertyFilteredByNamespace(dynamicconfig.BlobSizeLimitWarn, 256*1024),
+		ClaimCheckPayloadSizeThreshold:         dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendClaimCheckThreshold, 0),
+		HistorySizeLimitError:                  dc.GetIntPropertyFilteredByNamespace(dynamicconfig.HistorySizeLimitError, common.DefaultHistorySizeLimitError),
+		HistoryCountLimitError:                 dc.GetIntPropertyFilteredByNamespace(dynamicconfig.HistoryCountLimitError, common.DefaultHistoryCountLimitError),
+		HistoryBlobSizeLimitError:              dc.GetIntPropertyFilteredByNamespace(dynamicconfig.BlobSizeLimitError, common.DefaultHistoryBlobSizeLimitError),
+		HistoryBlobSizeLimitWarn:               dc.GetIntPropertyFilteredByNamespace(dynamicconfig.BlobSizeLimitWarn, common.DefaultHistoryBlobSizeLimitWarn),
 		ThrottledLogRPS:                        dc.GetIntProperty(dynamicconfig.FrontendThrottledLogRPS, 20),
 		ShutdownDrainDuration:                  dc.GetDurationProperty(dynamicconfig.FrontendShutdownDrainDuration, 0),
+		APITimeout:                             dc.GetDurationPropertyFilteredByAPIName(dynamicconfig.FrontendAPITimeout, 0),
+		AllowMixedVersionFeatures:              dc.GetBoolProperty(dynamicconfig.AllowMixedVersionFeatures, false),
 		EnableNamespaceNotActiveAutoForwarding: dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.EnableNamespaceNotActiveAutoForwarding, true),
 		EnableClientVersionCheck:               dc.GetBoolProperty(dynamicconfig.EnableClientVersionCheck, true),
 		ValidSearchAttributes:                  dc.GetMapProperty(dynamicconfig.ValidSearchAttributes, definition.GetDefaultIndexedKeys()),
@@ -148,6 +180,11 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int32, enableReadF
 		DefaultWorkflowTaskTimeout:             dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.DefaultWorkflowTaskTimeout, common.DefaultWorkflowTaskTimeout),
 		EnableServerVersionCheck:               dc.GetBoolProperty(dynamicconfig.EnableServerVersionCheck, os.Getenv("TEMPORAL_VERSION_CHECK_DISABLED") == ""),
 		EnableTokenNamespaceEnforcement:        dc.GetBoolProperty(dynamicconfig.EnableTokenNamespaceEnforcement, false),
+		BatchDescribeMutableStateMaxBatchSize:  dc.GetIntProperty(dynamicconfig.BatchDescribeMutableStateMaxBatchSize, 1000),
+		BatchDescribeMutableStateConcurrency:   dc.GetIntProperty(dynamicconfig.BatchDescribeMutableStateConcurrency, 50),
+		BatchActivityTaskMaxBatchSize:          dc.GetIntProperty(dynamicconfig.BatchActivityTaskMaxBatchSize, 1000),
+		BatchActivityTaskConcurrency:           dc.GetIntProperty(dynamicconfig.BatchActivityTaskConcurrency, 50),
+		BatchActivityTaskTimeout:               dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.BatchActivityTaskTimeout, 10*time.Second),
 	}
 }
 
@@ -159,10 +196,12 @@ type Service struct {
 	config *Config
 	params *resource.BootstrapParams
 
-	handler        Handler
-	adminHandler   *AdminHandler
-	versionChecker *VersionChecker
-	server         *grpc.Server
+	handler               Handler
+	adminHandler          *AdminHandler
+	versionChecker        *VersionChecker
+	namespaceBootstrapper *namespaceBootstrapper
+	server                *grpc.Server
+	operatorServer        *grpc.Server
 }
 
 // NewService builds a new frontend service
@@ -240,31 +279,45 @@ func (s *Service) Start() {
 		replicationMessageSink = s.GetNamespaceReplicationQueue()
 	}
 
-	opts, err := s.params.RPCFactory.GetFrontendGRPCServerOptions()
-	if err != nil {
-		logger.Fatal("creating grpc server options failed", tag.Error(err))
+	wfHandler := NewWorkflowHandler(s, s.config, replicationMessageSink, s.params.ClaimCheckStore)
+	s.handler = NewDCRedirectionHandler(wfHandler, s.params.DCRedirectionPolicy)
+	s.adminHandler = NewAdminHandler(s, s.params, s.config, wfHandler.(*WorkflowHandler))
+
+	redirectionPolicy := RedirectionPolicyGenerator(clusterMetadata, s.config, s.GetNamespaceCache(), s.params.DCRedirectionPolicy)
+	namespaceInterceptor := func(serveOperatorAPIs bool) *namespaceAPIInterceptor {
+		return newNamespaceAPIInterceptor(
+			serveOperatorAPIs,
+			wfHandler.(*WorkflowHandler).allow,
+			redirectionPolicy,
+			clusterMetadata.GetCurrentClusterName(),
+			s.GetClientBean(),
+		)
 	}
-	opts = append(
-		opts,
-		grpc.ChainUnaryInterceptor(
-			rpc.ServiceErrorInterceptor,
-			authorization.NewAuthorizationInterceptor(
-				s.params.ClaimMapper,
-				s.params.Authorizer,
-				s.Resource.GetMetricsClient(),
-				s.GetLogger())))
-	s.server = grpc.NewServer(opts...)
 
-	wfHandler := NewWorkflowHandler(s, s.config, replicationMessageSink)
-	s.handler = NewDCRedirectionHandler(wfHandler, s.params.DCRedirectionPolicy)
+	// operator APIs are served on a separate listener if it is configured, otherwise they share the
+	// frontend listener with data plane APIs. Namespace admin APIs are served on both listeners, remote
+	// clusters call them through the operator listener.
+	operatorListener := s.params.RPCFactory.GetOperatorGRPCListener()
+	if operatorListener != nil {
+		s.server = s.newGRPCServer(s.params.Authorizer, namespaceInterceptor(false))
+		s.operatorServer = s.newGRPCServer(s.params.OperatorAuthorizer, namespaceInterceptor(true))
+	} else {
+		s.server = s.newGRPCServer(
+			authorization.NewOperatorRoutingAuthorizer(s.params.Authorizer, s.params.OperatorAuthorizer),
+			namespaceInterceptor(true),
+		)
+		s.operatorServer = s.server
+	}
 
 	workflowservice.RegisterWorkflowServiceServer(s.server, s.handler)
-	healthpb.RegisterHealthServer(s.server, s.handler)
-
-	s.adminHandler = NewAdminHandler(s, s.params, s.config)
 	adminservice.RegisterAdminServiceServer(s.server, s.adminHandler)
-
+	healthpb.RegisterHealthServer(s.server, s.handler)
 	reflection.Register(s.server)
+	if s.operatorServer != s.server {
+		adminservice.RegisterAdminServiceServer(s.operatorServer, s.adminHandler)
+		healthpb.RegisterHealthServer(s.operatorServer, s.handler)
+		reflection.Register(s.operatorServer)
+	}
 
 	s.versionChecker = NewVersionChecker(s, s.params, s.config)
 
@@ -273,6 +326,24 @@ func (s *Service) Start() {
 	s.adminHandler.Start()
 	s.versionChecker.Start()
 
+	s.namespaceBootstrapper = newNamespaceBootstrapper(
+		wfHandler.(*WorkflowHandler).namespaceHandler,
+		s.adminHandler,
+		s.config.ValidSearchAttributes,
+		s.params.NamespaceBootstrap,
+		logger,
+	)
+	s.namespaceBootstrapper.start()
+
+	if s.operatorServer != s.server {
+		go func() {
+			logger.Info("Starting to serve on operator listener")
+			if err := s.operatorServer.Serve(operatorListener); err != nil {
+				logger.Fatal("Failed to serve on operator listener", tag.Error(err))
+			}
+		}()
+	}
+
 	listener := s.GetGRPCListener()
 	logger.Info("Starting to serve on frontend listener")
 	if err := s.server.Serve(listener); err != nil {
@@ -280,6 +351,33 @@ func (s *Service) Start() {
 	}
 }
 
+func (s *Service) newGRPCServer(
+	authorizer authorization.Authorizer,
+	namespaceInterceptor *namespaceAPIInterceptor,
+) *grpc.Server {
+	opts, err := s.params.RPCFactory.GetFrontendGRPCServerOptions()
+	if err != nil {
+		s.GetLogger().Fatal("creating grpc server options failed", tag.Error(err))
+	}
+	opts = append(
+		opts,
+		grpc.ChainUnaryInterceptor(
+			rpc.ServiceErrorInterceptor,
+			authorization.NewAuthorizationInterceptor(
+				s.params.ClaimMapper,
+				authorizer,
+				s.Resource.GetMetricsClient(),
+				s.GetLogger()),
+			newAPITimeoutInterceptor(s.config.APITimeout).Interceptor,
+			namespaceInterceptor.Interceptor,
+			newClaimCheckInterceptor(
+				s.params.ClaimCheckStore,
+				s.GetNamespaceCache(),
+				s.GetHistoryClient(),
+				s.GetLogger()).Interceptor))
+	return grpc.NewServer(opts...)
+}
+
 // Stop stops the service
 func (s *Service) Stop() {
 	if !atomic.CompareAndSwapInt32(&s.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
@@ -302,6 +400,9 @@ func (s *Service) Stop() {
 	s.GetLogger().Info("ShutdownHandler: Waiting for others to discover I am unhealthy")
 	time.Sleep(failureDetectionTime)
 
+	if s.namespaceBootstrapper != nil {
+		s.namespaceBootstrapper.stop()
+	}
 	s.adminHandler.Stop()
 	s.versionChecker.Stop()
 
@@ -309,6 +410,9 @@ func (s *Service) Stop() {
 	time.Sleep(requestDrainTime)
 
 	// TODO: Change this to GracefulStop when integration tests are refactored.
+	if s.operatorServer != s.server {
+		s.operatorServer.Stop()
+	}
 	s.server.Stop()
 	s.Resource.Stop()
 	s.params.Logger.Info("frontend stopped")
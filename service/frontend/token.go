@@ -94,3 +94,17 @@ func deserializeHistoryToken(bytes []byte) (*tokenspb.HistoryContinuation, error
 	err := token.Unmarshal(bytes)
 	return token, err
 }
+
+func serializeWorkflowExecutionChainToken(token *tokenspb.WorkflowExecutionChainContinuation) ([]byte, error) {
+	if token == nil {
+		return nil, nil
+	}
+
+	return token.Marshal()
+}
+
+func deserializeWorkflowExecutionChainToken(bytes []byte) (*tokenspb.WorkflowExecutionChainContinuation, error) {
+	token := &tokenspb.WorkflowExecutionChainContinuation{}
+	err := token.Unmarshal(bytes)
+	return token, err
+}
@@ -41,3 +41,16 @@ func validateExecution(w *commonpb.WorkflowExecution) error {
 	}
 	return nil
 }
+
+func validateActivityTaskID(workflowID string, runID string, activityID string) error {
+	if workflowID == "" {
+		return errWorkflowIDNotSet
+	}
+	if runID != "" && uuid.Parse(runID) == nil {
+		return errInvalidRunID
+	}
+	if activityID == "" {
+		return errActivityIDNotSet
+	}
+	return nil
+}
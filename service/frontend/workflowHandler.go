@@ -27,12 +27,14 @@ package frontend
 import (
 	"context"
 	"fmt"
+	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/pborman/uuid"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+	failurepb "go.temporal.io/api/failure/v1"
 	filterpb "go.temporal.io/api/filter/v1"
 	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
@@ -40,6 +42,7 @@ import (
 	"go.temporal.io/api/workflowservice/v1"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
+	"go.temporal.io/server/api/adminservice/v1"
 	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
@@ -48,6 +51,7 @@ import (
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/claimcheck"
 	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/elasticsearch/validator"
 	"go.temporal.io/server/common/enums"
@@ -55,6 +59,7 @@ import (
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/messaging"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
@@ -62,6 +67,7 @@ import (
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/common/resource"
+	"go.temporal.io/server/common/rpc"
 	"go.temporal.io/server/common/service/dynamicconfig"
 )
 
@@ -96,10 +102,19 @@ type (
 		namespaceHandler                namespace.Handler
 		visibilityQueryValidator        *validator.VisibilityQueryValidator
 		getDefaultWorkflowRetrySettings dynamicconfig.MapPropertyFnWithNamespaceFilter
+		claimCheckOffloader             *claimCheckOffloader
 	}
 
 	// HealthStatus is an enum that refers to the rpc handler health status
 	HealthStatus int32
+
+	// activityTaskBatchItem identifies one activity task of a BatchCompleteActivityTasksById
+	// or BatchFailActivityTasksById request
+	activityTaskBatchItem interface {
+		GetWorkflowId() string
+		GetRunId() string
+		GetActivityId() string
+	}
 )
 
 var (
@@ -111,6 +126,7 @@ func NewWorkflowHandler(
 	resource resource.Resource,
 	config *Config,
 	replicationMessageSink messaging.Producer,
+	claimCheckStore claimcheck.Store,
 ) Handler {
 
 	handler := &WorkflowHandler{
@@ -132,6 +148,14 @@ func NewWorkflowHandler(
 		),
 		visibilityQueryValidator:        validator.NewQueryValidator(config.ValidSearchAttributes),
 		getDefaultWorkflowRetrySettings: config.DefaultWorkflowRetryPolicy,
+		claimCheckOffloader: newClaimCheckOffloader(
+			claimCheckStore,
+			resource.GetNamespaceCache(),
+			resource.GetHistoryClient(),
+			config.ClaimCheckPayloadSizeThreshold,
+			membership.NewVersionGuard(resource.GetMembershipMonitor(), config.AllowMixedVersionFeatures, resource.GetLogger()),
+			resource.GetLogger(),
+		),
 	}
 
 	handler.rateLimiter = quotas.NewNamespaceMultiStageRateLimiter(
@@ -458,6 +482,10 @@ func (wh *WorkflowHandler) StartWorkflowExecution(ctx context.Context, request *
 	// add namespace tag to scope, so further metrics will have the namespace tag
 	scope = scope.Tagged(metrics.NamespaceTag(namespace))
 
+	if err := wh.claimCheckOffloader.offloadPayloads(ctx, namespace, namespaceID, request.GetWorkflowId(), request.GetInput()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
 	sizeLimitError := wh.config.BlobSizeLimitError(namespace)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespace)
 
@@ -671,6 +699,7 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(ctx context.Context, requ
 					nil,
 					continuationToken.TransientWorkflowTask,
 					continuationToken.BranchToken,
+					true,
 				)
 				if err != nil {
 					return nil, wh.error(err, scope)
@@ -717,6 +746,7 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(ctx context.Context, requ
 					continuationToken.PersistenceToken,
 					continuationToken.TransientWorkflowTask,
 					continuationToken.BranchToken,
+					!continuationToken.IsWorkflowRunning,
 				)
 			}
 
@@ -901,6 +931,13 @@ func (wh *WorkflowHandler) RespondWorkflowTaskCompleted(
 		return nil, err
 	}
 
+	if err := wh.claimCheckOffloader.offloadCommands(ctx, namespaceName, namespaceId, &commonpb.WorkflowExecution{
+		WorkflowId: taskToken.GetWorkflowId(),
+		RunId:      taskToken.GetRunId(),
+	}, request.GetCommands()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
 	histResp, err := wh.GetHistoryClient().RespondWorkflowTaskCompleted(ctx, &historyservice.RespondWorkflowTaskCompletedRequest{
 		NamespaceId:     namespaceId,
 		CompleteRequest: request},
@@ -1389,6 +1426,10 @@ func (wh *WorkflowHandler) RespondActivityTaskCompleted(
 		return nil, err
 	}
 
+	if err := wh.claimCheckOffloader.offloadPayloads(ctx, namespaceName, namespaceId, taskToken.GetWorkflowId(), request.GetResult()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
 	sizeLimitError := wh.config.BlobSizeLimitError(namespaceEntry.GetInfo().Name)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespaceEntry.GetInfo().Name)
 
@@ -1477,19 +1518,6 @@ func (wh *WorkflowHandler) RespondActivityTaskCompletedById(ctx context.Context,
 		return nil, wh.error(errIdentityTooLong, scope)
 	}
 
-	taskToken := &tokenspb.Task{
-		NamespaceId:     namespaceID,
-		RunId:           runID,
-		WorkflowId:      workflowID,
-		ScheduleId:      common.EmptyEventID,
-		ActivityId:      activityID,
-		ScheduleAttempt: 1,
-	}
-	token, err := wh.tokenSerializer.Serialize(taskToken)
-	if err != nil {
-		return nil, wh.error(err, scope)
-	}
-
 	namespaceEntry, err := wh.GetNamespaceCache().GetNamespaceByID(namespaceID)
 	if err != nil {
 		return nil, wh.error(err, scope)
@@ -1498,49 +1526,18 @@ func (wh *WorkflowHandler) RespondActivityTaskCompletedById(ctx context.Context,
 	// add namespace tag to scope, so further metrics will have the namespace tag
 	scope = scope.Tagged(metrics.NamespaceTag(namespaceEntry.GetInfo().Name))
 
-	sizeLimitError := wh.config.BlobSizeLimitError(namespaceEntry.GetInfo().Name)
-	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespaceEntry.GetInfo().Name)
-
-	if err := common.CheckEventBlobSizeLimit(
-		request.GetResult().Size(),
-		sizeLimitWarn,
-		sizeLimitError,
-		namespaceID,
-		taskToken.GetWorkflowId(),
+	if err := wh.respondActivityTaskCompletedByID(
+		ctx,
+		scope,
+		namespaceEntry,
+		workflowID,
 		runID,
-		scope.Tagged(metrics.CommandTypeTag(enumspb.COMMAND_TYPE_UNSPECIFIED.String())),
-		wh.GetThrottledLogger(),
-		tag.BlobSizeViolationOperation("RespondActivityTaskCompletedById"),
+		activityID,
+		request.GetResult(),
+		request.GetIdentity(),
 	); err != nil {
-		// result exceeds blob size limit, we would record it as failure
-		failRequest := &workflowservice.RespondActivityTaskFailedRequest{
-			TaskToken: token,
-			Failure:   failure.NewServerFailure(common.FailureReasonCompleteResultExceedsLimit, true),
-			Identity:  request.Identity,
-		}
-		_, err = wh.GetHistoryClient().RespondActivityTaskFailed(ctx, &historyservice.RespondActivityTaskFailedRequest{
-			NamespaceId:   namespaceID,
-			FailedRequest: failRequest,
-		})
-		if err != nil {
-			return nil, wh.error(err, scope)
-		}
-	} else {
-		req := &workflowservice.RespondActivityTaskCompletedRequest{
-			TaskToken: token,
-			Result:    request.Result,
-			Identity:  request.Identity,
-		}
-
-		_, err = wh.GetHistoryClient().RespondActivityTaskCompleted(ctx, &historyservice.RespondActivityTaskCompletedRequest{
-			NamespaceId:     namespaceID,
-			CompleteRequest: req,
-		})
-		if err != nil {
-			return nil, wh.error(err, scope)
-		}
+		return nil, wh.error(err, scope)
 	}
-
 	return &workflowservice.RespondActivityTaskCompletedByIdResponse{}, nil
 }
 
@@ -1684,19 +1681,6 @@ func (wh *WorkflowHandler) RespondActivityTaskFailedById(ctx context.Context, re
 		return nil, wh.error(errIdentityTooLong, scope)
 	}
 
-	taskToken := &tokenspb.Task{
-		NamespaceId:     namespaceID,
-		RunId:           runID,
-		WorkflowId:      workflowID,
-		ScheduleId:      common.EmptyEventID,
-		ActivityId:      activityID,
-		ScheduleAttempt: 1,
-	}
-	token, err := wh.tokenSerializer.Serialize(taskToken)
-	if err != nil {
-		return nil, wh.error(err, scope)
-	}
-
 	namespaceEntry, err := wh.GetNamespaceCache().GetNamespaceByID(namespaceID)
 	if err != nil {
 		return nil, wh.error(err, scope)
@@ -1705,39 +1689,117 @@ func (wh *WorkflowHandler) RespondActivityTaskFailedById(ctx context.Context, re
 	// add namespace tag to scope, so further metrics will have the namespace tag
 	scope = scope.Tagged(metrics.NamespaceTag(namespaceEntry.GetInfo().Name))
 
-	sizeLimitError := wh.config.BlobSizeLimitError(namespaceEntry.GetInfo().Name)
-	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespaceEntry.GetInfo().Name)
-
-	if err := common.CheckEventBlobSizeLimit(
-		request.GetFailure().Size(),
-		sizeLimitWarn,
-		sizeLimitError,
-		namespaceID,
-		taskToken.GetWorkflowId(),
+	if err := wh.respondActivityTaskFailedByID(
+		ctx,
+		scope,
+		namespaceEntry,
+		workflowID,
 		runID,
-		scope.Tagged(metrics.CommandTypeTag(enumspb.COMMAND_TYPE_UNSPECIFIED.String())),
-		wh.GetThrottledLogger(),
-		tag.BlobSizeViolationOperation("RespondActivityTaskFailedById"),
+		activityID,
+		request.GetFailure(),
+		request.GetIdentity(),
 	); err != nil {
-		serverFailure := failure.NewServerFailure(common.FailureReasonFailureExceedsLimit, false)
-		serverFailure.Cause = failure.Truncate(request.Failure, sizeLimitWarn)
-		request.Failure = serverFailure
+		return nil, wh.error(err, scope)
 	}
+	return &workflowservice.RespondActivityTaskFailedByIdResponse{}, nil
+}
 
-	req := &workflowservice.RespondActivityTaskFailedRequest{
-		TaskToken: token,
-		Failure:   request.GetFailure(),
-		Identity:  request.Identity,
+// BatchCompleteActivityTasksById completes multiple activity tasks of a namespace by workflow and activity ID.
+// Every activity task is charged against the namespace rate limit and completed like RespondActivityTaskCompletedById,
+// per activity task errors are returned in the response in request order and do not fail the call.
+func (wh *WorkflowHandler) BatchCompleteActivityTasksById(ctx context.Context, request *adminservice.BatchCompleteActivityTasksByIdRequest) (_ *adminservice.BatchCompleteActivityTasksByIdResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithNamespace(metrics.FrontendBatchCompleteActivityTasksByIdScope, request.GetNamespace())
+	defer sw.Stop()
+
+	if wh.isStopped() {
+		return nil, errShuttingDown
 	}
 
-	_, err = wh.GetHistoryClient().RespondActivityTaskFailed(ctx, &historyservice.RespondActivityTaskFailedRequest{
-		NamespaceId:   namespaceID,
-		FailedRequest: req,
-	})
+	if request == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	completions := request.GetCompletions()
+	items := make([]activityTaskBatchItem, len(completions))
+	for i, completion := range completions {
+		items[i] = completion
+	}
+	results, err := wh.respondActivityTaskBatch(
+		scope,
+		request.GetNamespace(),
+		request.GetIdentity(),
+		items,
+		func(ctx context.Context, namespaceEntry *cache.NamespaceCacheEntry, i int) error {
+			completion := completions[i]
+			return wh.respondActivityTaskCompletedByID(
+				ctx,
+				scope,
+				namespaceEntry,
+				completion.GetWorkflowId(),
+				completion.GetRunId(),
+				completion.GetActivityId(),
+				completion.GetResult(),
+				request.GetIdentity(),
+			)
+		},
+	)
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
-	return &workflowservice.RespondActivityTaskFailedByIdResponse{}, nil
+	return &adminservice.BatchCompleteActivityTasksByIdResponse{
+		Results: results,
+	}, nil
+}
+
+// BatchFailActivityTasksById fails multiple activity tasks of a namespace by workflow and activity ID.
+// Every activity task is charged against the namespace rate limit and failed like RespondActivityTaskFailedById,
+// per activity task errors are returned in the response in request order and do not fail the call.
+func (wh *WorkflowHandler) BatchFailActivityTasksById(ctx context.Context, request *adminservice.BatchFailActivityTasksByIdRequest) (_ *adminservice.BatchFailActivityTasksByIdResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithNamespace(metrics.FrontendBatchFailActivityTasksByIdScope, request.GetNamespace())
+	defer sw.Stop()
+
+	if wh.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	if request == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	failures := request.GetFailures()
+	items := make([]activityTaskBatchItem, len(failures))
+	for i, activityTaskFailure := range failures {
+		items[i] = activityTaskFailure
+	}
+	results, err := wh.respondActivityTaskBatch(
+		scope,
+		request.GetNamespace(),
+		request.GetIdentity(),
+		items,
+		func(ctx context.Context, namespaceEntry *cache.NamespaceCacheEntry, i int) error {
+			activityTaskFailure := failures[i]
+			return wh.respondActivityTaskFailedByID(
+				ctx,
+				scope,
+				namespaceEntry,
+				activityTaskFailure.GetWorkflowId(),
+				activityTaskFailure.GetRunId(),
+				activityTaskFailure.GetActivityId(),
+				activityTaskFailure.GetFailure(),
+				request.GetIdentity(),
+			)
+		},
+	)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+	return &adminservice.BatchFailActivityTasksByIdResponse{
+		Results: results,
+	}, nil
 }
 
 // RespondActivityTaskCanceled is called by application worker when it is successfully canceled an ActivityTask.  It will
@@ -2055,6 +2117,10 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(ctx context.Context, request
 		return nil, wh.error(err, scope)
 	}
 
+	if err := wh.claimCheckOffloader.offloadPayloads(ctx, request.GetNamespace(), namespaceID, request.GetWorkflowExecution().GetWorkflowId(), request.GetInput()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
 	sizeLimitError := wh.config.BlobSizeLimitError(request.GetNamespace())
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(request.GetNamespace())
 	if err := common.CheckEventBlobSizeLimit(
@@ -2169,6 +2235,10 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 		return nil, wh.error(err, scope)
 	}
 
+	if err := wh.claimCheckOffloader.offloadPayloads(ctx, namespace, namespaceID, request.GetWorkflowId(), request.GetInput(), request.GetSignalInput()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
 	sizeLimitError := wh.config.BlobSizeLimitError(namespace)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespace)
 	if err := common.CheckEventBlobSizeLimit(
@@ -2199,14 +2269,13 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 		return nil, wh.error(err, scope)
 	}
 
-	var runId string
+	var resp *historyservice.SignalWithStartWorkflowExecutionResponse
 	op := func() error {
 		var err error
-		resp, err := wh.GetHistoryClient().SignalWithStartWorkflowExecution(ctx, &historyservice.SignalWithStartWorkflowExecutionRequest{
+		resp, err = wh.GetHistoryClient().SignalWithStartWorkflowExecution(ctx, &historyservice.SignalWithStartWorkflowExecutionRequest{
 			NamespaceId:            namespaceID,
 			SignalWithStartRequest: request,
 		})
-		runId = resp.GetRunId()
 		return err
 	}
 
@@ -2215,7 +2284,7 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 		return nil, wh.error(err, scope)
 	}
 
-	return &workflowservice.SignalWithStartWorkflowExecutionResponse{RunId: runId}, nil
+	return &workflowservice.SignalWithStartWorkflowExecutionResponse{RunId: resp.GetRunId()}, nil
 }
 
 // ResetWorkflowExecution reset an existing workflow execution to WorkflowTaskCompleted event(exclusive).
@@ -3277,6 +3346,7 @@ func (wh *WorkflowHandler) getHistory(
 	nextPageToken []byte,
 	transientWorkflowTaskInfo *historyspb.TransientWorkflowTaskInfo,
 	branchToken []byte,
+	readFromReplica bool,
 ) (*historypb.History, []byte, error) {
 
 	var size int
@@ -3286,12 +3356,13 @@ func (wh *WorkflowHandler) getHistory(
 	var err error
 	var historyEvents []*historypb.HistoryEvent
 	historyEvents, size, nextPageToken, err = persistence.ReadFullPageV2Events(wh.GetHistoryManager(), &persistence.ReadHistoryBranchRequest{
-		BranchToken:   branchToken,
-		MinEventID:    firstEventID,
-		MaxEventID:    nextEventID,
-		PageSize:      int(pageSize),
-		NextPageToken: nextPageToken,
-		ShardID:       convert.Int32Ptr(shardID),
+		BranchToken:     branchToken,
+		MinEventID:      firstEventID,
+		MaxEventID:      nextEventID,
+		PageSize:        int(pageSize),
+		NextPageToken:   nextPageToken,
+		ShardID:         convert.Int32Ptr(shardID),
+		ReadFromReplica: readFromReplica,
 	})
 	if err != nil {
 		return nil, nil, err
@@ -3498,6 +3569,7 @@ func (wh *WorkflowHandler) createPollWorkflowTaskQueueResponse(
 			nil,
 			matchingResp.GetWorkflowTaskInfo(),
 			branchToken,
+			false,
 		)
 		if err != nil {
 			return nil, err
@@ -3687,6 +3759,204 @@ func (wh *WorkflowHandler) allow(namespace string) bool {
 	return wh.rateLimiter.Allow(namespace)
 }
 
+func (wh *WorkflowHandler) validateActivityTaskBatch(
+	batchSize int,
+	identity string,
+) error {
+
+	if batchSize == 0 {
+		return errActivityTasksNotSet
+	}
+	if maxBatchSize := wh.config.BatchActivityTaskMaxBatchSize(); batchSize > maxBatchSize {
+		return errActivityTaskBatchSizeTooBig.MessageArgs(maxBatchSize)
+	}
+	if len(identity) > wh.config.MaxIDLengthLimit() {
+		return errIdentityTooLong
+	}
+	return nil
+}
+
+// respondActivityTaskBatch validates a batch of activity tasks and calls respond for each of them,
+// with at most BatchActivityTaskConcurrency calls in flight. Every activity task is charged once against
+// the namespace rate limit and gets its own BatchActivityTaskTimeout, so a long batch is not cut short
+// by the timeout of the batch request.
+func (wh *WorkflowHandler) respondActivityTaskBatch(
+	scope metrics.Scope,
+	namespace string,
+	identity string,
+	items []activityTaskBatchItem,
+	respond func(ctx context.Context, namespaceEntry *cache.NamespaceCacheEntry, i int) error,
+) ([]*adminservice.ActivityTaskResult, error) {
+
+	if err := wh.validateActivityTaskBatch(len(items), identity); err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		if err := validateActivityTaskID(item.GetWorkflowId(), item.GetRunId(), item.GetActivityId()); err != nil {
+			return nil, err
+		}
+	}
+	namespaceEntry, err := wh.GetNamespaceCache().GetNamespace(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	concurrency := wh.config.BatchActivityTaskConcurrency()
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	timeout := wh.config.BatchActivityTaskTimeout(namespace)
+
+	results := make([]*adminservice.ActivityTaskResult, len(items))
+	semaphore := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i := range items {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(i int) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+
+			results[i] = &adminservice.ActivityTaskResult{
+				WorkflowId: items[i].GetWorkflowId(),
+				RunId:      items[i].GetRunId(),
+				ActivityId: items[i].GetActivityId(),
+			}
+			if ok := wh.allow(namespace); !ok {
+				scope.IncCounter(metrics.ServiceErrResourceExhaustedCounter)
+				results[i].Error = errServiceBusy.Error()
+				return
+			}
+
+			ctx, cancel := rpc.NewContextWithTimeoutAndHeaders(timeout)
+			defer cancel()
+			if err := respond(ctx, namespaceEntry, i); err != nil {
+				results[i].Error = err.Error()
+			}
+		}(i)
+	}
+	wg.Wait()
+	return results, nil
+}
+
+func (wh *WorkflowHandler) respondActivityTaskCompletedByID(
+	ctx context.Context,
+	scope metrics.Scope,
+	namespaceEntry *cache.NamespaceCacheEntry,
+	workflowID string,
+	runID string,
+	activityID string,
+	result *commonpb.Payloads,
+	identity string,
+) error {
+
+	namespace := namespaceEntry.GetInfo().Name
+	namespaceID := namespaceEntry.GetInfo().Id
+	token, err := wh.tokenSerializer.Serialize(&tokenspb.Task{
+		NamespaceId:     namespaceID,
+		RunId:           runID,
+		WorkflowId:      workflowID,
+		ScheduleId:      common.EmptyEventID,
+		ActivityId:      activityID,
+		ScheduleAttempt: 1,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := wh.claimCheckOffloader.offloadPayloads(ctx, namespace, namespaceID, workflowID, result); err != nil {
+		return err
+	}
+
+	if err := common.CheckEventBlobSizeLimit(
+		result.Size(),
+		wh.config.BlobSizeLimitWarn(namespace),
+		wh.config.BlobSizeLimitError(namespace),
+		namespaceID,
+		workflowID,
+		runID,
+		scope.Tagged(metrics.CommandTypeTag(enumspb.COMMAND_TYPE_UNSPECIFIED.String())),
+		wh.GetThrottledLogger(),
+		tag.BlobSizeViolationOperation("RespondActivityTaskCompletedById"),
+	); err != nil {
+		// result exceeds blob size limit, we would record it as failure
+		_, err = wh.GetHistoryClient().RespondActivityTaskFailed(ctx, &historyservice.RespondActivityTaskFailedRequest{
+			NamespaceId: namespaceID,
+			FailedRequest: &workflowservice.RespondActivityTaskFailedRequest{
+				TaskToken: token,
+				Failure:   failure.NewServerFailure(common.FailureReasonCompleteResultExceedsLimit, true),
+				Identity:  identity,
+			},
+		})
+		return err
+	}
+
+	_, err = wh.GetHistoryClient().RespondActivityTaskCompleted(ctx, &historyservice.RespondActivityTaskCompletedRequest{
+		NamespaceId: namespaceID,
+		CompleteRequest: &workflowservice.RespondActivityTaskCompletedRequest{
+			TaskToken: token,
+			Result:    result,
+			Identity:  identity,
+		},
+	})
+	return err
+}
+
+func (wh *WorkflowHandler) respondActivityTaskFailedByID(
+	ctx context.Context,
+	scope metrics.Scope,
+	namespaceEntry *cache.NamespaceCacheEntry,
+	workflowID string,
+	runID string,
+	activityID string,
+	activityFailure *failurepb.Failure,
+	identity string,
+) error {
+
+	namespace := namespaceEntry.GetInfo().Name
+	namespaceID := namespaceEntry.GetInfo().Id
+	token, err := wh.tokenSerializer.Serialize(&tokenspb.Task{
+		NamespaceId:     namespaceID,
+		RunId:           runID,
+		WorkflowId:      workflowID,
+		ScheduleId:      common.EmptyEventID,
+		ActivityId:      activityID,
+		ScheduleAttempt: 1,
+	})
+	if err != nil {
+		return err
+	}
+
+	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespace)
+	if err := common.CheckEventBlobSizeLimit(
+		activityFailure.Size(),
+		sizeLimitWarn,
+		wh.config.BlobSizeLimitError(namespace),
+		namespaceID,
+		workflowID,
+		runID,
+		scope.Tagged(metrics.CommandTypeTag(enumspb.COMMAND_TYPE_UNSPECIFIED.String())),
+		wh.GetThrottledLogger(),
+		tag.BlobSizeViolationOperation("RespondActivityTaskFailedById"),
+	); err != nil {
+		serverFailure := failure.NewServerFailure(common.FailureReasonFailureExceedsLimit, false)
+		serverFailure.Cause = failure.Truncate(activityFailure, sizeLimitWarn)
+		activityFailure = serverFailure
+	}
+
+	_, err = wh.GetHistoryClient().RespondActivityTaskFailed(ctx, &historyservice.RespondActivityTaskFailedRequest{
+		NamespaceId: namespaceID,
+		FailedRequest: &workflowservice.RespondActivityTaskFailedRequest{
+			TaskToken: token,
+			Failure:   activityFailure,
+			Identity:  identity,
+		},
+	})
+	return err
+}
+
 func (wh *WorkflowHandler) initNamespaceRateLimiter(namespace string) quotas.RateLimiter {
 	monitor := wh.GetMembershipMonitor()
 	if monitor == nil || wh.config.GlobalNamespaceRPS(namespace) == 0 {
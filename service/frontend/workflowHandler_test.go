@@ -45,6 +45,8 @@ import (
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/api/workflowservice/v1"
 
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
 	"go.temporal.io/server/api/matchingservicemock/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
@@ -55,9 +57,11 @@ import (
 	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/failure"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/mocks"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -149,7 +153,7 @@ func (s *workflowHandlerSuite) TearDownTest() {
 }
 
 func (s *workflowHandlerSuite) getWorkflowHandler(config *Config) *WorkflowHandler {
-	return NewWorkflowHandler(s.mockResource, config, s.mockProducer).(*WorkflowHandler)
+	return NewWorkflowHandler(s.mockResource, config, s.mockProducer, nil).(*WorkflowHandler)
 }
 
 func (s *workflowHandlerSuite) TestDisableListVisibilityByFilter() {
@@ -1060,12 +1064,13 @@ func (s *workflowHandlerSuite) TestGetHistory() {
 	}
 	shardID := common.WorkflowIDToHistoryShard(namespaceID, we.WorkflowId, numHistoryShards)
 	req := &persistence.ReadHistoryBranchRequest{
-		BranchToken:   branchToken,
-		MinEventID:    firstEventID,
-		MaxEventID:    nextEventID,
-		PageSize:      0,
-		NextPageToken: []byte{},
-		ShardID:       convert.Int32Ptr(shardID),
+		BranchToken:     branchToken,
+		MinEventID:      firstEventID,
+		MaxEventID:      nextEventID,
+		PageSize:        0,
+		NextPageToken:   []byte{},
+		ShardID:         convert.Int32Ptr(shardID),
+		ReadFromReplica: true,
 	}
 	s.mockHistoryMgr.EXPECT().ReadHistoryBranch(req).Return(&persistence.ReadHistoryBranchResponse{
 		HistoryEvents: []*historypb.HistoryEvent{
@@ -1081,7 +1086,7 @@ func (s *workflowHandlerSuite) TestGetHistory() {
 	wh := s.getWorkflowHandler(s.newConfig())
 
 	scope := metrics.NoopScope(metrics.Frontend)
-	history, token, err := wh.getHistory(scope, namespaceID, we, firstEventID, nextEventID, 0, []byte{}, nil, branchToken)
+	history, token, err := wh.getHistory(scope, namespaceID, we, firstEventID, nextEventID, 0, []byte{}, nil, branchToken, true)
 	s.NoError(err)
 	s.NotNil(history)
 	s.Equal([]byte{}, token)
@@ -1439,6 +1444,139 @@ func (s *workflowHandlerSuite) checkResponse(err error, response interface{},
 	}
 }
 
+func (s *workflowHandlerSuite) TestBatchCompleteActivityTasksById() {
+	ctx := context.Background()
+	config := s.newConfig()
+	config.BatchActivityTaskMaxBatchSize = dc.GetIntPropertyFn(2)
+	config.BatchActivityTaskConcurrency = dc.GetIntPropertyFn(2)
+	wh := s.getWorkflowHandler(config)
+
+	completedActivity := &adminservice.ActivityTaskCompletion{WorkflowId: "workflowID", ActivityId: "completed-activity", Result: payloads.EncodeString("result")}
+	missingActivity := &adminservice.ActivityTaskCompletion{WorkflowId: "workflowID", RunId: uuid.New(), ActivityId: "missing-activity"}
+
+	_, err := wh.BatchCompleteActivityTasksById(ctx, &adminservice.BatchCompleteActivityTasksByIdRequest{
+		Namespace: s.testNamespace,
+	})
+	s.Equal(errActivityTasksNotSet, err)
+
+	_, err = wh.BatchCompleteActivityTasksById(ctx, &adminservice.BatchCompleteActivityTasksByIdRequest{
+		Namespace:   s.testNamespace,
+		Completions: []*adminservice.ActivityTaskCompletion{completedActivity, missingActivity, completedActivity},
+	})
+	s.Equal(errActivityTaskBatchSizeTooBig.MessageArgs(2), err)
+
+	_, err = wh.BatchCompleteActivityTasksById(ctx, &adminservice.BatchCompleteActivityTasksByIdRequest{
+		Namespace:   s.testNamespace,
+		Completions: []*adminservice.ActivityTaskCompletion{completedActivity, {WorkflowId: "workflowID"}},
+	})
+	s.Equal(errActivityIDNotSet, err)
+
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.testNamespace).Return(cache.NewLocalNamespaceCacheEntryForTest(
+		&persistencespb.NamespaceInfo{Id: s.testNamespaceID, Name: s.testNamespace},
+		&persistencespb.NamespaceConfig{},
+		cluster.TestCurrentClusterName,
+		nil,
+	), nil)
+	s.mockHistoryClient.EXPECT().RespondActivityTaskCompleted(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *historyservice.RespondActivityTaskCompletedRequest, _ ...interface{}) (*historyservice.RespondActivityTaskCompletedResponse, error) {
+			_, ok := ctx.Deadline()
+			s.True(ok)
+			s.Equal(s.testNamespaceID, request.GetNamespaceId())
+			s.Equal("identity", request.GetCompleteRequest().GetIdentity())
+			taskToken, err := s.tokenSerializer.Deserialize(request.GetCompleteRequest().GetTaskToken())
+			s.NoError(err)
+			s.Equal(s.testNamespaceID, taskToken.GetNamespaceId())
+			s.Equal(common.EmptyEventID, taskToken.GetScheduleId())
+			if taskToken.GetActivityId() == missingActivity.GetActivityId() {
+				s.Equal(missingActivity.GetRunId(), taskToken.GetRunId())
+				return nil, serviceerror.NewNotFound("activity not found")
+			}
+			s.Equal(completedActivity.GetResult(), request.GetCompleteRequest().GetResult())
+			return &historyservice.RespondActivityTaskCompletedResponse{}, nil
+		}).Times(2)
+
+	resp, err := wh.BatchCompleteActivityTasksById(ctx, &adminservice.BatchCompleteActivityTasksByIdRequest{
+		Namespace:   s.testNamespace,
+		Identity:    "identity",
+		Completions: []*adminservice.ActivityTaskCompletion{completedActivity, missingActivity},
+	})
+	s.NoError(err)
+	s.Len(resp.GetResults(), 2)
+	s.Equal(completedActivity.GetActivityId(), resp.GetResults()[0].GetActivityId())
+	s.Empty(resp.GetResults()[0].GetError())
+	s.Equal(missingActivity.GetActivityId(), resp.GetResults()[1].GetActivityId())
+	s.Equal(missingActivity.GetRunId(), resp.GetResults()[1].GetRunId())
+	s.Equal("activity not found", resp.GetResults()[1].GetError())
+}
+
+func (s *workflowHandlerSuite) TestBatchCompleteActivityTasksById_RateLimited() {
+	config := s.newConfig()
+	config.MaxNamespaceRPSPerInstance = dc.GetIntPropertyFilteredByNamespace(0)
+	wh := s.getWorkflowHandler(config)
+
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.testNamespace).Return(cache.NewLocalNamespaceCacheEntryForTest(
+		&persistencespb.NamespaceInfo{Id: s.testNamespaceID, Name: s.testNamespace},
+		&persistencespb.NamespaceConfig{},
+		cluster.TestCurrentClusterName,
+		nil,
+	), nil)
+
+	resp, err := wh.BatchCompleteActivityTasksById(context.Background(), &adminservice.BatchCompleteActivityTasksByIdRequest{
+		Namespace: s.testNamespace,
+		Completions: []*adminservice.ActivityTaskCompletion{
+			{WorkflowId: "workflowID", ActivityId: "activity-1"},
+			{WorkflowId: "workflowID", ActivityId: "activity-2"},
+		},
+	})
+	s.NoError(err)
+	s.Len(resp.GetResults(), 2)
+	for _, result := range resp.GetResults() {
+		s.Equal(errServiceBusy.Error(), result.GetError())
+	}
+}
+
+func (s *workflowHandlerSuite) TestBatchFailActivityTasksById() {
+	ctx := context.Background()
+	wh := s.getWorkflowHandler(s.newConfig())
+
+	activityTaskFailure := &adminservice.ActivityTaskFailure{
+		WorkflowId: "workflowID",
+		ActivityId: "activityID",
+		Failure:    failure.NewServerFailure("activity failed", true),
+	}
+
+	_, err := wh.BatchFailActivityTasksById(ctx, &adminservice.BatchFailActivityTasksByIdRequest{
+		Namespace: s.testNamespace,
+		Failures:  []*adminservice.ActivityTaskFailure{{WorkflowId: "workflowID", RunId: "invalid", ActivityId: "activityID"}},
+	})
+	s.Equal(errInvalidRunID, err)
+
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.testNamespace).Return(cache.NewLocalNamespaceCacheEntryForTest(
+		&persistencespb.NamespaceInfo{Id: s.testNamespaceID, Name: s.testNamespace},
+		&persistencespb.NamespaceConfig{},
+		cluster.TestCurrentClusterName,
+		nil,
+	), nil)
+	s.mockHistoryClient.EXPECT().RespondActivityTaskFailed(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.RespondActivityTaskFailedRequest, _ ...interface{}) (*historyservice.RespondActivityTaskFailedResponse, error) {
+			s.Equal(s.testNamespaceID, request.GetNamespaceId())
+			s.Equal(activityTaskFailure.GetFailure(), request.GetFailedRequest().GetFailure())
+			taskToken, err := s.tokenSerializer.Deserialize(request.GetFailedRequest().GetTaskToken())
+			s.NoError(err)
+			s.Equal(activityTaskFailure.GetWorkflowId(), taskToken.GetWorkflowId())
+			s.Equal(activityTaskFailure.GetActivityId(), taskToken.GetActivityId())
+			return &historyservice.RespondActivityTaskFailedResponse{}, nil
+		})
+
+	resp, err := wh.BatchFailActivityTasksById(ctx, &adminservice.BatchFailActivityTasksByIdRequest{
+		Namespace: s.testNamespace,
+		Failures:  []*adminservice.ActivityTaskFailure{activityTaskFailure},
+	})
+	s.NoError(err)
+	s.Len(resp.GetResults(), 1)
+	s.Empty(resp.GetResults()[0].GetError())
+}
+
 func (s *workflowHandlerSuite) newConfig() *Config {
 	return NewConfig(dc.NewCollection(dc.NewNopClient(), s.mockResource.GetLogger()), numHistoryShards, false)
 }
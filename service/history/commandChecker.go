@@ -58,6 +58,7 @@ type (
 		searchAttributesValidator       *validator.SearchAttributesValidator
 		getDefaultActivityRetrySettings dynamicconfig.MapPropertyFnWithNamespaceFilter
 		getDefaultWorkflowRetrySettings dynamicconfig.MapPropertyFnWithNamespaceFilter
+		getActivityRetryPolicyOverride  dynamicconfig.MapPropertyFnWithActivityTypeFilter
 	}
 
 	workflowSizeChecker struct {
@@ -94,6 +95,7 @@ func newCommandAttrValidator(
 		searchAttributesValidator:       searchAttributesValidator,
 		getDefaultActivityRetrySettings: config.DefaultActivityRetryPolicy,
 		getDefaultWorkflowRetrySettings: config.DefaultWorkflowRetryPolicy,
+		getActivityRetryPolicyOverride:  config.ActivityRetryPolicyOverride,
 	}
 }
 
@@ -639,6 +641,12 @@ func (v *commandAttrValidator) validateActivityRetryPolicy(
 
 	defaultActivityRetrySettings := common.FromConfigToDefaultRetrySettings(v.getDefaultActivityRetrySettings(attributes.GetNamespace()))
 	common.EnsureRetryPolicyDefaults(attributes.RetryPolicy, defaultActivityRetrySettings)
+
+	// operator overrides take precedence over the user specified retry policy
+	common.ApplyRetryPolicyOverrides(
+		attributes.RetryPolicy,
+		v.getActivityRetryPolicyOverride(attributes.GetNamespace(), attributes.ActivityType.GetName()),
+	)
 	return common.ValidateRetryPolicy(attributes.RetryPolicy)
 }
 
@@ -93,6 +93,7 @@ func (s *commandAttrValidatorSuite) SetupTest() {
 		SearchAttributesTotalSizeLimit:    dynamicconfig.GetIntPropertyFilteredByNamespace(40 * 1024),
 		DefaultActivityRetryPolicy:        dynamicconfig.GetMapPropertyFnWithNamespaceFilter(common.GetDefaultRetryPolicyConfigOptions()),
 		DefaultWorkflowRetryPolicy:        dynamicconfig.GetMapPropertyFnWithNamespaceFilter(common.GetDefaultRetryPolicyConfigOptions()),
+		ActivityRetryPolicyOverride:       dynamicconfig.GetMapPropertyFnWithActivityTypeFilter(map[string]interface{}{}),
 	}
 	s.validator = newCommandAttrValidator(
 		s.mockNamespaceCache,
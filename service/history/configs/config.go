@@ -49,6 +49,8 @@ type Config struct {
 	AdvancedVisibilityWritingMode dynamicconfig.StringPropertyFn
 	EmitShardDiffLog              dynamicconfig.BoolPropertyFn
 	MaxAutoResetPoints            dynamicconfig.IntPropertyFnWithNamespaceFilter
+	WorkflowTagsNumberOfKeysLimit dynamicconfig.IntPropertyFnWithNamespaceFilter
+	WorkflowTagsTotalSizeLimit    dynamicconfig.IntPropertyFnWithNamespaceFilter
 	ThrottledLogRPS               dynamicconfig.IntPropertyFn
 	EnableStickyQuery             dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	ShutdownDrainDuration         dynamicconfig.DurationPropertyFn
@@ -59,6 +61,8 @@ type Config struct {
 	HistoryCacheMaxSize     dynamicconfig.IntPropertyFn
 	HistoryCacheTTL         dynamicconfig.DurationPropertyFn
 
+	WorkflowLockHoldWarnThreshold dynamicconfig.DurationPropertyFn
+
 	// EventsCache settings
 	// Change of these configs require shard restart
 	EventsCacheInitialSize dynamicconfig.IntPropertyFn
@@ -161,6 +165,9 @@ type Config struct {
 	ParentClosePolicyThreshold dynamicconfig.IntPropertyFnWithNamespaceFilter
 	// total number of parentClosePolicy system workflows
 	NumParentClosePolicySystemWorkflows dynamicconfig.IntPropertyFn
+	// whether or not child workflows and signals can target namespaces active in a remote cluster,
+	// through the admin service of the remote cluster
+	EnableCrossClusterOperations dynamicconfig.BoolPropertyFnWithNamespaceFilter
 
 	// Archival settings
 	NumArchiveSystemWorkflows dynamicconfig.IntPropertyFn
@@ -182,6 +189,10 @@ type Config struct {
 	// any unset fields on a RetryPolicy configured on a Workflow
 	DefaultWorkflowRetryPolicy dynamicconfig.MapPropertyFnWithNamespaceFilter
 
+	// ActivityRetryPolicyOverride specifies the operator provided retry policy fields
+	// which take precedence over the RetryPolicy configured on an Activity by the user
+	ActivityRetryPolicyOverride dynamicconfig.MapPropertyFnWithActivityTypeFilter
+
 	// Workflow task settings
 	// StickyTTL is to expire a sticky taskqueue if no update more than this duration
 	// TODO https://go.temporal.io/server/issues/2357
@@ -197,6 +208,8 @@ type Config struct {
 	ReplicationTaskFetcherAggregationInterval            dynamicconfig.DurationPropertyFn
 	ReplicationTaskFetcherTimerJitterCoefficient         dynamicconfig.FloatPropertyFn
 	ReplicationTaskFetcherErrorRetryWait                 dynamicconfig.DurationPropertyFn
+	ReplicationTaskFetcherMaxShardsPerRequest            dynamicconfig.IntPropertyFn
+	ReplicationTaskFetcherEnableCompression              dynamicconfig.BoolPropertyFn
 	ReplicationTaskProcessorErrorRetryWait               dynamicconfig.DurationPropertyFnWithShardIDFilter
 	ReplicationTaskProcessorErrorRetryBackoffCoefficient dynamicconfig.FloatPropertyFnWithShardIDFilter
 	ReplicationTaskProcessorErrorRetryMaxInterval        dynamicconfig.DurationPropertyFnWithShardIDFilter
@@ -279,12 +292,15 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int32, isAdvancedVis
 		VisibilityOpenMaxQPS:                 dc.GetIntPropertyFilteredByNamespace(dynamicconfig.HistoryVisibilityOpenMaxQPS, 300),
 		VisibilityClosedMaxQPS:               dc.GetIntPropertyFilteredByNamespace(dynamicconfig.HistoryVisibilityClosedMaxQPS, 300),
 		MaxAutoResetPoints:                   dc.GetIntPropertyFilteredByNamespace(dynamicconfig.HistoryMaxAutoResetPoints, DefaultHistoryMaxAutoResetPoints),
+		WorkflowTagsNumberOfKeysLimit:        dc.GetIntPropertyFilteredByNamespace(dynamicconfig.WorkflowTagsNumberOfKeysLimit, 64),
+		WorkflowTagsTotalSizeLimit:           dc.GetIntPropertyFilteredByNamespace(dynamicconfig.WorkflowTagsTotalSizeLimit, 16*1024),
 		DefaultWorkflowTaskTimeout:           dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.DefaultWorkflowTaskTimeout, common.DefaultWorkflowTaskTimeout),
 		AdvancedVisibilityWritingMode:        dc.GetStringProperty(dynamicconfig.AdvancedVisibilityWritingMode, common.GetDefaultAdvancedVisibilityWritingMode(isAdvancedVisConfigExist)),
 		EmitShardDiffLog:                     dc.GetBoolProperty(dynamicconfig.EmitShardDiffLog, false),
 		HistoryCacheInitialSize:              dc.GetIntProperty(dynamicconfig.HistoryCacheInitialSize, 128),
 		HistoryCacheMaxSize:                  dc.GetIntProperty(dynamicconfig.HistoryCacheMaxSize, 512),
 		HistoryCacheTTL:                      dc.GetDurationProperty(dynamicconfig.HistoryCacheTTL, time.Hour),
+		WorkflowLockHoldWarnThreshold:        dc.GetDurationProperty(dynamicconfig.WorkflowLockHoldWarnThreshold, 5*time.Second),
 		EventsCacheInitialSize:               dc.GetIntProperty(dynamicconfig.EventsCacheInitialSize, 128),
 		EventsCacheMaxSize:                   dc.GetIntProperty(dynamicconfig.EventsCacheMaxSize, 512),
 		EventsCacheTTL:                       dc.GetDurationProperty(dynamicconfig.EventsCacheTTL, time.Hour),
@@ -361,7 +377,7 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int32, isAdvancedVis
 		MaximumSignalsPerExecution:      dc.GetIntPropertyFilteredByNamespace(dynamicconfig.MaximumSignalsPerExecution, 0),
 		ShardUpdateMinInterval:          dc.GetDurationProperty(dynamicconfig.ShardUpdateMinInterval, 5*time.Minute),
 		ShardSyncMinInterval:            dc.GetDurationProperty(dynamicconfig.ShardSyncMinInterval, 5*time.Minute),
-		ShardSyncTimerJitterCoefficient: dc.GetFloat64Property(dynamicconfig.TransferProcessorMaxPollIntervalJitterCoefficient, 0.15),
+		ShardSyncTimerJitterCoefficient: dc.GetFloat64Property(dynamicconfig.ShardSyncTimerJitterCoefficient, 0.15),
 
 		// history client: client/history/client.go set the client timeout 30s
 		// TODO: Return this value to the client: go.temporal.io/server/issues/294
@@ -371,21 +387,23 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int32, isAdvancedVis
 		NumParentClosePolicySystemWorkflows: dc.GetIntProperty(dynamicconfig.NumParentClosePolicySystemWorkflows, 10),
 		EnableParentClosePolicyWorker:       dc.GetBoolProperty(dynamicconfig.EnableParentClosePolicyWorker, true),
 		ParentClosePolicyThreshold:          dc.GetIntPropertyFilteredByNamespace(dynamicconfig.ParentClosePolicyThreshold, 10),
+		EnableCrossClusterOperations:        dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.EnableCrossClusterOperations, false),
 
 		NumArchiveSystemWorkflows: dc.GetIntProperty(dynamicconfig.NumArchiveSystemWorkflows, 1000),
 		ArchiveRequestRPS:         dc.GetIntProperty(dynamicconfig.ArchiveRequestRPS, 300), // should be much smaller than frontend RPS
 
-		BlobSizeLimitError:     dc.GetIntPropertyFilteredByNamespace(dynamicconfig.BlobSizeLimitError, 2*1024*1024),
-		BlobSizeLimitWarn:      dc.GetIntPropertyFilteredByNamespace(dynamicconfig.BlobSizeLimitWarn, 512*1024),
-		HistorySizeLimitError:  dc.GetIntPropertyFilteredByNamespace(dynamicconfig.HistorySizeLimitError, 50*1024*1024),
+		BlobSizeLimitError:     dc.GetIntPropertyFilteredByNamespace(dynamicconfig.BlobSizeLimitError, common.DefaultHistoryBlobSizeLimitError),
+		BlobSizeLimitWarn:      dc.GetIntPropertyFilteredByNamespace(dynamicconfig.BlobSizeLimitWarn, common.DefaultHistoryBlobSizeLimitWarn),
+		HistorySizeLimitError:  dc.GetIntPropertyFilteredByNamespace(dynamicconfig.HistorySizeLimitError, common.DefaultHistorySizeLimitError),
 		HistorySizeLimitWarn:   dc.GetIntPropertyFilteredByNamespace(dynamicconfig.HistorySizeLimitWarn, 10*1024*1024),
-		HistoryCountLimitError: dc.GetIntPropertyFilteredByNamespace(dynamicconfig.HistoryCountLimitError, 50*1024),
+		HistoryCountLimitError: dc.GetIntPropertyFilteredByNamespace(dynamicconfig.HistoryCountLimitError, common.DefaultHistoryCountLimitError),
 		HistoryCountLimitWarn:  dc.GetIntPropertyFilteredByNamespace(dynamicconfig.HistoryCountLimitWarn, 10*1024),
 
 		ThrottledLogRPS:   dc.GetIntProperty(dynamicconfig.HistoryThrottledLogRPS, 4),
 		EnableStickyQuery: dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.EnableStickyQuery, true),
 
 		DefaultActivityRetryPolicy:   dc.GetMapPropertyFnWithNamespaceFilter(dynamicconfig.DefaultActivityRetryPolicy, common.GetDefaultRetryPolicyConfigOptions()),
+		ActivityRetryPolicyOverride:  dc.GetMapPropertyFnWithActivityTypeFilter(dynamicconfig.ActivityRetryPolicyOverride, map[string]interface{}{}),
 		DefaultWorkflowRetryPolicy:   dc.GetMapPropertyFnWithNamespaceFilter(dynamicconfig.DefaultWorkflowRetryPolicy, common.GetDefaultRetryPolicyConfigOptions()),
 		StickyTTL:                    dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.StickyTTL, time.Hour*24*365),
 		WorkflowTaskHeartbeatTimeout: dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.WorkflowTaskHeartbeatTimeout, time.Minute*30),
@@ -394,6 +412,8 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int32, isAdvancedVis
 		ReplicationTaskFetcherAggregationInterval:    dc.GetDurationProperty(dynamicconfig.ReplicationTaskFetcherAggregationInterval, 2*time.Second),
 		ReplicationTaskFetcherTimerJitterCoefficient: dc.GetFloat64Property(dynamicconfig.ReplicationTaskFetcherTimerJitterCoefficient, 0.15),
 		ReplicationTaskFetcherErrorRetryWait:         dc.GetDurationProperty(dynamicconfig.ReplicationTaskFetcherErrorRetryWait, time.Second),
+		ReplicationTaskFetcherMaxShardsPerRequest:    dc.GetIntProperty(dynamicconfig.ReplicationTaskFetcherMaxShardsPerRequest, 0),
+		ReplicationTaskFetcherEnableCompression:      dc.GetBoolProperty(dynamicconfig.ReplicationTaskFetcherEnableCompression, false),
 
 		ReplicationTaskProcessorErrorRetryWait:               dc.GetDurationPropertyFilteredByShardID(dynamicconfig.ReplicationTaskProcessorErrorRetryWait, 1*time.Second),
 		ReplicationTaskProcessorErrorRetryBackoffCoefficient: dc.GetFloat64PropertyFilteredByShardID(dynamicconfig.ReplicationTaskProcessorErrorRetryBackoffCoefficient, 1.2),
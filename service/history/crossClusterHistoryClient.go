@@ -0,0 +1,198 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+
+	"go.temporal.io/api/serviceerror"
+	"google.golang.org/grpc"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/client/admin"
+)
+
+type (
+	// targetHistoryClient is the subset of history service calls made by active transfer tasks
+	// to the workflows of the target namespace of the task
+	targetHistoryClient interface {
+		StartWorkflowExecution(ctx context.Context, request *historyservice.StartWorkflowExecutionRequest, opts ...grpc.CallOption) (*historyservice.StartWorkflowExecutionResponse, error)
+		ScheduleWorkflowTask(ctx context.Context, request *historyservice.ScheduleWorkflowTaskRequest, opts ...grpc.CallOption) (*historyservice.ScheduleWorkflowTaskResponse, error)
+		SignalWorkflowExecution(ctx context.Context, request *historyservice.SignalWorkflowExecutionRequest, opts ...grpc.CallOption) (*historyservice.SignalWorkflowExecutionResponse, error)
+		RemoveSignalMutableState(ctx context.Context, request *historyservice.RemoveSignalMutableStateRequest, opts ...grpc.CallOption) (*historyservice.RemoveSignalMutableStateResponse, error)
+		RecordChildExecutionCompleted(ctx context.Context, request *historyservice.RecordChildExecutionCompletedRequest, opts ...grpc.CallOption) (*historyservice.RecordChildExecutionCompletedResponse, error)
+	}
+
+	// crossClusterHistoryClient makes the calls of active transfer tasks to the workflows of a namespace
+	// active in a remote cluster, as cross cluster tasks executed by the admin service of the remote cluster
+	crossClusterHistoryClient struct {
+		sourceCluster string
+		adminClient   admin.Client
+	}
+)
+
+var _ targetHistoryClient = (*crossClusterHistoryClient)(nil)
+
+func newCrossClusterHistoryClient(
+	sourceCluster string,
+	adminClient admin.Client,
+) *crossClusterHistoryClient {
+
+	return &crossClusterHistoryClient{
+		sourceCluster: sourceCluster,
+		adminClient:   adminClient,
+	}
+}
+
+func (c *crossClusterHistoryClient) StartWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.StartWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.StartWorkflowExecutionResponse, error) {
+
+	resp, err := c.adminClient.ExecuteCrossClusterTask(ctx, &adminservice.ExecuteCrossClusterTaskRequest{
+		SourceCluster: c.sourceCluster,
+		Attributes: &adminservice.ExecuteCrossClusterTaskRequest_StartWorkflowExecutionTaskAttributes{
+			StartWorkflowExecutionTaskAttributes: &adminservice.StartWorkflowExecutionTaskAttributes{
+				NamespaceId:                     request.GetNamespaceId(),
+				StartRequest:                    request.GetStartRequest(),
+				ParentExecutionInfo:             request.GetParentExecutionInfo(),
+				Attempt:                         request.GetAttempt(),
+				WorkflowExecutionExpirationTime: request.GetWorkflowExecutionExpirationTime(),
+				ContinueAsNewInitiator:          request.GetContinueAsNewInitiator(),
+				FirstWorkflowTaskBackoff:        request.GetFirstWorkflowTaskBackoff(),
+			},
+		},
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &historyservice.StartWorkflowExecutionResponse{
+		RunId: resp.GetRunId(),
+	}, nil
+}
+
+func (c *crossClusterHistoryClient) ScheduleWorkflowTask(
+	ctx context.Context,
+	request *historyservice.ScheduleWorkflowTaskRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ScheduleWorkflowTaskResponse, error) {
+
+	_, err := c.adminClient.ExecuteCrossClusterTask(ctx, &adminservice.ExecuteCrossClusterTaskRequest{
+		SourceCluster: c.sourceCluster,
+		Attributes: &adminservice.ExecuteCrossClusterTaskRequest_ScheduleWorkflowTaskAttributes{
+			ScheduleWorkflowTaskAttributes: &adminservice.ScheduleWorkflowTaskAttributes{
+				NamespaceId:         request.GetNamespaceId(),
+				WorkflowExecution:   request.GetWorkflowExecution(),
+				IsFirstWorkflowTask: request.GetIsFirstWorkflowTask(),
+			},
+		},
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &historyservice.ScheduleWorkflowTaskResponse{}, nil
+}
+
+func (c *crossClusterHistoryClient) SignalWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.SignalWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.SignalWorkflowExecutionResponse, error) {
+
+	_, err := c.adminClient.ExecuteCrossClusterTask(ctx, &adminservice.ExecuteCrossClusterTaskRequest{
+		SourceCluster: c.sourceCluster,
+		Attributes: &adminservice.ExecuteCrossClusterTaskRequest_SignalWorkflowExecutionTaskAttributes{
+			SignalWorkflowExecutionTaskAttributes: &adminservice.SignalWorkflowExecutionTaskAttributes{
+				NamespaceId:               request.GetNamespaceId(),
+				SignalRequest:             request.GetSignalRequest(),
+				ExternalWorkflowExecution: request.GetExternalWorkflowExecution(),
+				ChildWorkflowOnly:         request.GetChildWorkflowOnly(),
+			},
+		},
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &historyservice.SignalWorkflowExecutionResponse{}, nil
+}
+
+func (c *crossClusterHistoryClient) RemoveSignalMutableState(
+	ctx context.Context,
+	request *historyservice.RemoveSignalMutableStateRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.RemoveSignalMutableStateResponse, error) {
+
+	_, err := c.adminClient.ExecuteCrossClusterTask(ctx, &adminservice.ExecuteCrossClusterTaskRequest{
+		SourceCluster: c.sourceCluster,
+		Attributes: &adminservice.ExecuteCrossClusterTaskRequest_RemoveSignalMutableStateTaskAttributes{
+			RemoveSignalMutableStateTaskAttributes: &adminservice.RemoveSignalMutableStateTaskAttributes{
+				NamespaceId:       request.GetNamespaceId(),
+				WorkflowExecution: request.GetWorkflowExecution(),
+				RequestId:         request.GetRequestId(),
+			},
+		},
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &historyservice.RemoveSignalMutableStateResponse{}, nil
+}
+
+func (c *crossClusterHistoryClient) RecordChildExecutionCompleted(
+	ctx context.Context,
+	request *historyservice.RecordChildExecutionCompletedRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.RecordChildExecutionCompletedResponse, error) {
+
+	_, err := c.adminClient.ExecuteCrossClusterTask(ctx, &adminservice.ExecuteCrossClusterTaskRequest{
+		SourceCluster: c.sourceCluster,
+		Attributes: &adminservice.ExecuteCrossClusterTaskRequest_RecordChildExecutionCompletedTaskAttributes{
+			RecordChildExecutionCompletedTaskAttributes: &adminservice.RecordChildExecutionCompletedTaskAttributes{
+				NamespaceId:        request.GetNamespaceId(),
+				WorkflowExecution:  request.GetWorkflowExecution(),
+				InitiatedId:        request.GetInitiatedId(),
+				CompletedExecution: request.GetCompletedExecution(),
+				CompletionEvent:    request.GetCompletionEvent(),
+			},
+		},
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &historyservice.RecordChildExecutionCompletedResponse{}, nil
+}
+
+// isCrossClusterTaskPermanentError returns whether a cross cluster task failed regardless of retries and of the
+// cluster the target namespace is active in, other errors such as serviceerror.NamespaceNotActive are retried
+func isCrossClusterTaskPermanentError(err error) bool {
+	switch err.(type) {
+	case *serviceerror.NotFound,
+		*serviceerror.InvalidArgument:
+		return true
+	}
+	return false
+}
@@ -1726,6 +1726,132 @@ func (h *Handler) RefreshWorkflowTasks(ctx context.Context, request *historyserv
 	return &historyservice.RefreshWorkflowTasksResponse{}, nil
 }
 
+// UpdateWorkflowExecutionTags upserts and removes non-indexed tags of a running workflow.
+func (h *Handler) UpdateWorkflowExecutionTags(ctx context.Context, request *historyservice.UpdateWorkflowExecutionTagsRequest) (_ *historyservice.UpdateWorkflowExecutionTagsResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+
+	h.startWG.Wait()
+
+	scope := metrics.HistoryUpdateWorkflowExecutionTagsScope
+	h.GetMetricsClient().IncCounter(scope, metrics.ServiceRequests)
+	sw := h.GetMetricsClient().StartTimer(scope, metrics.ServiceLatency)
+	defer sw.Stop()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := request.GetNamespaceId()
+	workflowID := request.GetRequest().GetExecution().GetWorkflowId()
+	engine, err := h.controller.GetEngine(namespaceID, workflowID)
+	if err != nil {
+		err = h.error(err, scope, namespaceID, workflowID)
+		return nil, err
+	}
+
+	err = engine.UpdateWorkflowExecutionTags(ctx, request)
+	if err != nil {
+		err = h.error(err, scope, namespaceID, workflowID)
+		return nil, err
+	}
+
+	return &historyservice.UpdateWorkflowExecutionTagsResponse{}, nil
+}
+
+// PauseWorkflowExecution stops dispatching workflow tasks of a running workflow to workers.
+func (h *Handler) PauseWorkflowExecution(ctx context.Context, request *historyservice.PauseWorkflowExecutionRequest) (_ *historyservice.PauseWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+
+	h.startWG.Wait()
+
+	scope := metrics.HistoryPauseWorkflowExecutionScope
+	h.GetMetricsClient().IncCounter(scope, metrics.ServiceRequests)
+	sw := h.GetMetricsClient().StartTimer(scope, metrics.ServiceLatency)
+	defer sw.Stop()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := request.GetNamespaceId()
+	workflowID := request.GetRequest().GetExecution().GetWorkflowId()
+	engine, err := h.controller.GetEngine(namespaceID, workflowID)
+	if err != nil {
+		err = h.error(err, scope, namespaceID, workflowID)
+		return nil, err
+	}
+
+	err = engine.PauseWorkflowExecution(ctx, request)
+	if err != nil {
+		err = h.error(err, scope, namespaceID, workflowID)
+		return nil, err
+	}
+
+	return &historyservice.PauseWorkflowExecutionResponse{}, nil
+}
+
+// UnpauseWorkflowExecution resumes dispatching workflow tasks of a paused workflow.
+func (h *Handler) UnpauseWorkflowExecution(ctx context.Context, request *historyservice.UnpauseWorkflowExecutionRequest) (_ *historyservice.UnpauseWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+
+	h.startWG.Wait()
+
+	scope := metrics.HistoryUnpauseWorkflowExecutionScope
+	h.GetMetricsClient().IncCounter(scope, metrics.ServiceRequests)
+	sw := h.GetMetricsClient().StartTimer(scope, metrics.ServiceLatency)
+	defer sw.Stop()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := request.GetNamespaceId()
+	workflowID := request.GetRequest().GetExecution().GetWorkflowId()
+	engine, err := h.controller.GetEngine(namespaceID, workflowID)
+	if err != nil {
+		err = h.error(err, scope, namespaceID, workflowID)
+		return nil, err
+	}
+
+	err = engine.UnpauseWorkflowExecution(ctx, request)
+	if err != nil {
+		err = h.error(err, scope, namespaceID, workflowID)
+		return nil, err
+	}
+
+	return &historyservice.UnpauseWorkflowExecutionResponse{}, nil
+}
+
+// DescribeWorkflowLocks returns the contended workflow execution locks in the history cache of a shard
+func (h *Handler) DescribeWorkflowLocks(ctx context.Context, request *historyservice.DescribeWorkflowLocksRequest) (_ *historyservice.DescribeWorkflowLocksResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+
+	h.startWG.Wait()
+
+	scope := metrics.HistoryDescribeWorkflowLocksScope
+	h.GetMetricsClient().IncCounter(scope, metrics.ServiceRequests)
+	sw := h.GetMetricsClient().StartTimer(scope, metrics.ServiceLatency)
+	defer sw.Stop()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	engine, err := h.controller.GetEngineForShard(request.GetShardId())
+	if err != nil {
+		err = h.error(err, scope, "", "")
+		return nil, err
+	}
+
+	resp, err := engine.DescribeWorkflowLocks(ctx, request)
+	if err != nil {
+		err = h.error(err, scope, "", "")
+		return nil, err
+	}
+
+	return resp, nil
+}
+
 // convertError is a helper method to convert ShardOwnershipLostError from persistence layer returned by various
 // HistoryEngine API calls to ShardOwnershipLost error return by HistoryService for client to be redirected to the
 // correct shard.
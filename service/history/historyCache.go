@@ -26,6 +26,7 @@ package history
 
 import (
 	"context"
+	"sort"
 	"sync/atomic"
 
 	"github.com/pborman/uuid"
@@ -277,6 +278,31 @@ func (c *historyCache) makeReleaseFunc(
 	}
 }
 
+// describeLocks returns the locks of cached workflow executions which are held or waited on,
+// ordered by number of waiters and then by hold duration
+func (c *historyCache) describeLocks() []workflowLockInfo {
+	var locks []workflowLockInfo
+	it := c.Iterator()
+	for it.HasNext() {
+		workflowCtx, ok := it.Next().Value().(workflowExecutionContext)
+		if !ok {
+			continue
+		}
+		if lockInfo := workflowCtx.describeLock(); lockInfo.held || lockInfo.waiters > 0 {
+			locks = append(locks, lockInfo)
+		}
+	}
+	it.Close()
+
+	sort.Slice(locks, func(i, j int) bool {
+		if locks[i].waiters != locks[j].waiters {
+			return locks[i].waiters > locks[j].waiters
+		}
+		return locks[i].holdDuration > locks[j].holdDuration
+	})
+	return locks
+}
+
 func (c *historyCache) getCurrentExecutionWithRetry(
 	request *persistence.GetCurrentExecutionRequest,
 ) (*persistence.GetCurrentExecutionResponse, error) {
@@ -28,6 +28,7 @@ import (
 	"errors"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/pborman/uuid"
@@ -221,3 +222,57 @@ func (s *historyCacheSuite) TestHistoryCacheConcurrentAccess() {
 	s.Nil(context.(*workflowExecutionContextImpl).mutableState)
 	release(nil)
 }
+
+func (s *historyCacheSuite) TestHistoryCacheDescribeLocks() {
+	s.cache = newHistoryCache(s.mockShard)
+	namespaceID := "test_namespace_id"
+	contendedExecution := commonpb.WorkflowExecution{
+		WorkflowId: "wf-cache-test-contended",
+		RunId:      uuid.New(),
+	}
+	heldExecution := commonpb.WorkflowExecution{
+		WorkflowId: "wf-cache-test-held",
+		RunId:      uuid.New(),
+	}
+	releasedExecution := commonpb.WorkflowExecution{
+		WorkflowId: "wf-cache-test-released",
+		RunId:      uuid.New(),
+	}
+
+	_, release, err := s.cache.getOrCreateWorkflowExecutionForBackground(namespaceID, releasedExecution)
+	s.NoError(err)
+	release(nil)
+	_, releaseHeld, err := s.cache.getOrCreateWorkflowExecutionForBackground(namespaceID, heldExecution)
+	s.NoError(err)
+	_, releaseContended, err := s.cache.getOrCreateWorkflowExecutionForBackground(namespaceID, contendedExecution)
+	s.NoError(err)
+
+	waiterDone := make(chan struct{})
+	go func() {
+		defer close(waiterDone)
+		_, release, err := s.cache.getOrCreateWorkflowExecutionForBackground(namespaceID, contendedExecution)
+		if err == nil {
+			release(nil)
+		}
+	}()
+
+	s.Eventually(func() bool {
+		locks := s.cache.describeLocks()
+		return len(locks) > 0 && locks[0].waiters == 1
+	}, 10*time.Second, 10*time.Millisecond)
+
+	locks := s.cache.describeLocks()
+	s.Len(locks, 2)
+	s.Equal(namespaceID, locks[0].namespaceID)
+	s.Equal(contendedExecution.GetWorkflowId(), locks[0].workflowID)
+	s.Equal(contendedExecution.GetRunId(), locks[0].runID)
+	s.True(locks[0].held)
+	s.Equal(heldExecution.GetWorkflowId(), locks[1].workflowID)
+	s.True(locks[1].held)
+	s.Equal(int32(0), locks[1].waiters)
+
+	releaseContended(nil)
+	<-waiterDone
+	releaseHeld(nil)
+	s.Empty(s.cache.describeLocks())
+}
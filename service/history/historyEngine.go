@@ -43,6 +43,7 @@ import (
 	"go.temporal.io/api/workflowservice/v1"
 	sdkclient "go.temporal.io/sdk/client"
 
+	"go.temporal.io/server/api/adminservice/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
@@ -85,38 +86,39 @@ const (
 
 type (
 	historyEngineImpl struct {
-		status                    int32
-		currentClusterName        string
-		shard                     shard.Context
-		timeSource                clock.TimeSource
-		workflowTaskHandler       workflowTaskHandlerCallbacks
-		clusterMetadata           cluster.Metadata
-		historyV2Mgr              persistence.HistoryManager
-		executionManager          persistence.ExecutionManager
-		visibilityMgr             persistence.VisibilityManager
-		txProcessor               transferQueueProcessor
-		timerProcessor            timerQueueProcessor
-		visibilityProcessor       visibilityQueueProcessor
-		nDCReplicator             nDCHistoryReplicator
-		nDCActivityReplicator     nDCActivityReplicator
-		replicatorProcessor       *replicatorQueueProcessorImpl
-		eventNotifier             events.Notifier
-		tokenSerializer           common.TaskTokenSerializer
-		historyCache              *historyCache
-		metricsClient             metrics.Client
-		logger                    log.Logger
-		throttledLogger           log.Logger
-		config                    *configs.Config
-		archivalClient            archiver.Client
-		workflowResetter          workflowResetter
-		queueTaskProcessor        queueTaskProcessor
-		replicationTaskProcessors []ReplicationTaskProcessor
-		publicClient              sdkclient.Client
-		eventsReapplier           nDCEventsReapplier
-		matchingClient            matching.Client
-		rawMatchingClient         matching.Client
-		replicationDLQHandler     replicationDLQHandler
-		searchAttributesValidator *validator.SearchAttributesValidator
+		status                     int32
+		currentClusterName         string
+		shard                      shard.Context
+		timeSource                 clock.TimeSource
+		workflowTaskHandler        workflowTaskHandlerCallbacks
+		clusterMetadata            cluster.Metadata
+		historyV2Mgr               persistence.HistoryManager
+		executionManager           persistence.ExecutionManager
+		visibilityMgr              persistence.VisibilityManager
+		txProcessor                transferQueueProcessor
+		timerProcessor             timerQueueProcessor
+		visibilityProcessor        visibilityQueueProcessor
+		nDCReplicator              nDCHistoryReplicator
+		nDCActivityReplicator      nDCActivityReplicator
+		nDCWorkflowStateReplicator nDCWorkflowStateReplicator
+		replicatorProcessor        *replicatorQueueProcessorImpl
+		eventNotifier              events.Notifier
+		tokenSerializer            common.TaskTokenSerializer
+		historyCache               *historyCache
+		metricsClient              metrics.Client
+		logger                     log.Logger
+		throttledLogger            log.Logger
+		config                     *configs.Config
+		archivalClient             archiver.Client
+		workflowResetter           workflowResetter
+		queueTaskProcessor         queueTaskProcessor
+		replicationTaskProcessors  []ReplicationTaskProcessor
+		publicClient               sdkclient.Client
+		eventsReapplier            nDCEventsReapplier
+		matchingClient             matching.Client
+		rawMatchingClient          matching.Client
+		replicationDLQHandler      replicationDLQHandler
+		searchAttributesValidator  *validator.SearchAttributesValidator
 	}
 )
 
@@ -159,6 +161,13 @@ var (
 	ErrSizeExceedsLimit = serviceerror.NewResourceExhausted(common.FailureReasonSizeExceedsLimit)
 	// ErrUnknownCluster is error indicating unknown cluster
 	ErrUnknownCluster = serviceerror.NewInvalidArgument("unknown cluster")
+	// ErrWorkflowPaused is the error to indicate workflow task is not started because workflow execution is paused,
+	// matching drops the task on this error
+	ErrWorkflowPaused = serviceerror.NewNotFound("workflow execution is paused")
+	// ErrWorkflowAlreadyPaused is the error to indicate workflow execution is already paused
+	ErrWorkflowAlreadyPaused = serviceerror.NewInvalidArgument("workflow execution is already paused")
+	// ErrWorkflowNotPaused is the error to indicate workflow execution is not paused
+	ErrWorkflowNotPaused = serviceerror.NewInvalidArgument("workflow execution is not paused")
 
 	// FailedWorkflowStatuses is a set of failed workflow close states, used for start workflow policy
 	// for start workflow execution API
@@ -246,6 +255,11 @@ func NewEngineWithShardContext(
 			historyCache,
 			logger,
 		)
+		historyEngImpl.nDCWorkflowStateReplicator = newNDCWorkflowStateReplicator(
+			shard,
+			historyCache,
+			logger,
+		)
 	}
 	historyEngImpl.workflowResetter = newWorkflowResetter(
 		shard,
@@ -523,6 +537,9 @@ func (e *historyEngineImpl) StartWorkflowExecution(
 	if err != nil {
 		return nil, err
 	}
+	if err := e.validateWorkflowTags(startRequest.GetTags(), namespaceEntry.GetInfo().GetName()); err != nil {
+		return nil, err
+	}
 
 	workflowID := request.GetWorkflowId()
 	// grab the current context as a lock, nothing more
@@ -538,7 +555,7 @@ func (e *historyEngineImpl) StartWorkflowExecution(
 
 	execution := commonpb.WorkflowExecution{
 		WorkflowId: workflowID,
-		RunId:      uuid.New(),
+		RunId:      e.shard.GetIDGenerator().NewID(),
 	}
 	clusterMetadata := e.shard.GetService().GetClusterMetadata()
 	mutableState, err := e.createMutableState(clusterMetadata, namespaceEntry, execution.GetRunId())
@@ -589,10 +606,11 @@ func (e *historyEngineImpl) StartWorkflowExecution(
 	if err != nil {
 		if t, ok := err.(*persistence.WorkflowExecutionAlreadyStartedError); ok {
 			if t.StartRequestID == request.GetRequestId() {
-				return &historyservice.StartWorkflowExecutionResponse{
-					RunId: t.RunID,
-				}, nil
 				// delete history is expected here because duplicate start request will create history with different rid
+				return e.getStartedRunResponse(ctx, namespaceID, commonpb.WorkflowExecution{
+					WorkflowId: workflowID,
+					RunId:      t.RunID,
+				}), nil
 			}
 
 			if mutableState.GetCurrentVersion() < t.LastWriteVersion {
@@ -628,9 +646,100 @@ func (e *historyEngineImpl) StartWorkflowExecution(
 	if err != nil {
 		return nil, err
 	}
-	return &historyservice.StartWorkflowExecutionResponse{
-		RunId: execution.GetRunId(),
-	}, nil
+	return newStartWorkflowExecutionResponse(execution.GetRunId(), startEvent, mutableState), nil
+}
+
+// newStartWorkflowExecutionResponse returns the authoritative metadata of a run,
+// so callers don't need a follow-up describe call to learn what the server assigned.
+func newStartWorkflowExecutionResponse(
+	runID string,
+	startEvent *historypb.HistoryEvent,
+	mutableState mutableState,
+) *historyservice.StartWorkflowExecutionResponse {
+
+	attributes := startEvent.GetWorkflowExecutionStartedEventAttributes()
+	response := &historyservice.StartWorkflowExecutionResponse{
+		RunId:                    runID,
+		StartTime:                startEvent.GetEventTime(),
+		WorkflowExecutionTimeout: attributes.GetWorkflowExecutionTimeout(),
+		WorkflowRunTimeout:       attributes.GetWorkflowRunTimeout(),
+		WorkflowTaskTimeout:      attributes.GetWorkflowTaskTimeout(),
+		RetryPolicy:              attributes.GetRetryPolicy(),
+	}
+	// the pending workflow task is the first one only if no workflow task was processed yet
+	if workflowTask, ok := mutableState.GetPendingWorkflowTask(); ok &&
+		mutableState.GetExecutionInfo().LastProcessedEvent == common.EmptyEventID {
+		response.FirstWorkflowTaskScheduledTime = workflowTask.ScheduledTime
+	}
+	return response
+}
+
+// getStartedRunResponse returns the metadata of an existing run, for start requests deduplicated
+// by request ID. The run is already started, so only the run ID is returned if its state can't be loaded.
+func (e *historyEngineImpl) getStartedRunResponse(
+	ctx context.Context,
+	namespaceID string,
+	execution commonpb.WorkflowExecution,
+) *historyservice.StartWorkflowExecutionResponse {
+
+	response, err := e.loadStartedRunResponse(ctx, namespaceID, execution)
+	if err != nil {
+		e.logger.Warn("Unable to load metadata of started workflow execution.",
+			tag.WorkflowNamespaceID(namespaceID),
+			tag.WorkflowID(execution.GetWorkflowId()),
+			tag.WorkflowRunID(execution.GetRunId()),
+			tag.Error(err))
+		return &historyservice.StartWorkflowExecutionResponse{RunId: execution.GetRunId()}
+	}
+	return response
+}
+
+func (e *historyEngineImpl) loadStartedRunResponse(
+	ctx context.Context,
+	namespaceID string,
+	execution commonpb.WorkflowExecution,
+) (_ *historyservice.StartWorkflowExecutionResponse, retError error) {
+
+	context, release, err := e.historyCache.getOrCreateWorkflowExecution(ctx, namespaceID, execution)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := context.loadWorkflowExecution()
+	if err != nil {
+		return nil, err
+	}
+	return newStartedRunResponse(execution.GetRunId(), mutableState)
+}
+
+// newStartedRunResponse returns the metadata of a run loaded by the caller
+func newStartedRunResponse(
+	runID string,
+	mutableState mutableState,
+) (*historyservice.StartWorkflowExecutionResponse, error) {
+
+	startEvent, err := mutableState.GetStartEvent()
+	if err != nil {
+		return nil, err
+	}
+	return newStartWorkflowExecutionResponse(runID, startEvent, mutableState), nil
+}
+
+// newSignalWithStartWorkflowExecutionResponse returns the metadata of the signaled run
+func newSignalWithStartWorkflowExecutionResponse(
+	startResponse *historyservice.StartWorkflowExecutionResponse,
+) *historyservice.SignalWithStartWorkflowExecutionResponse {
+
+	return &historyservice.SignalWithStartWorkflowExecutionResponse{
+		RunId:                          startResponse.GetRunId(),
+		StartTime:                      startResponse.GetStartTime(),
+		FirstWorkflowTaskScheduledTime: startResponse.GetFirstWorkflowTaskScheduledTime(),
+		WorkflowExecutionTimeout:       startResponse.GetWorkflowExecutionTimeout(),
+		WorkflowRunTimeout:             startResponse.GetWorkflowRunTimeout(),
+		WorkflowTaskTimeout:            startResponse.GetWorkflowTaskTimeout(),
+		RetryPolicy:                    startResponse.GetRetryPolicy(),
+	}
 }
 
 // GetMutableState retrieves the mutable state of the workflow execution
@@ -1174,6 +1283,8 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(
 			SearchAttributes: &commonpb.SearchAttributes{IndexedFields: executionInfo.SearchAttributes},
 			Status:           executionState.Status,
 		},
+		Tags:      executionInfo.Tags,
+		PauseInfo: executionInfo.PauseInfo,
 	}
 
 	// TODO: we need to consider adding execution time to mutable state
@@ -1215,6 +1326,10 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(
 			} else {
 				p.State = enumspb.PENDING_ACTIVITY_STATE_SCHEDULED
 			}
+			if ai.StartedId != common.EmptyEventID {
+				// identity of the worker currently holding the activity task
+				p.LastWorkerIdentity = ai.StartedIdentity
+			}
 			if !timestamp.TimeValue(ai.LastHeartbeatUpdateTime).IsZero() {
 				p.LastHeartbeatTime = ai.LastHeartbeatUpdateTime
 				p.HeartbeatDetails = ai.LastHeartbeatDetails
@@ -1239,7 +1354,7 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(
 				if ai.RetryLastFailure != nil {
 					p.LastFailure = ai.RetryLastFailure
 				}
-				if ai.RetryLastWorkerIdentity != "" {
+				if ai.RetryLastWorkerIdentity != "" && p.LastWorkerIdentity == "" {
 					p.LastWorkerIdentity = ai.RetryLastWorkerIdentity
 				}
 			} else {
@@ -1262,6 +1377,19 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(
 		}
 	}
 
+	if workflowTask, ok := mutableState.GetPendingWorkflowTask(); ok {
+		result.PendingWorkflowTask = &workflowspb.PendingWorkflowTaskInfo{
+			ScheduleId:    workflowTask.ScheduleID,
+			StartedId:     workflowTask.StartedID,
+			Attempt:       workflowTask.Attempt,
+			ScheduledTime: workflowTask.ScheduledTime,
+			Identity:      workflowTask.StartedIdentity,
+		}
+		if workflowTask.StartedID != common.EmptyEventID {
+			result.PendingWorkflowTask.StartedTime = workflowTask.StartedTime
+		}
+	}
+
 	return result, nil
 }
 
@@ -1911,7 +2039,12 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 				}
 				return nil, err
 			}
-			return &historyservice.SignalWithStartWorkflowExecutionResponse{RunId: context.getExecution().RunId}, nil
+			startResponse, err := newStartedRunResponse(context.getExecution().RunId, mutableState)
+			if err != nil {
+				// the signal is already recorded, so the response must not fail because of metadata
+				return &historyservice.SignalWithStartWorkflowExecutionResponse{RunId: context.getExecution().RunId}, nil
+			}
+			return newSignalWithStartWorkflowExecutionResponse(startResponse), nil
 		} // end for Just_Signal_Loop
 		if attempt == conditionalRetryCount+1 {
 			return nil, ErrMaxAttemptsExceeded
@@ -1946,7 +2079,7 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 
 	execution = commonpb.WorkflowExecution{
 		WorkflowId: workflowID,
-		RunId:      uuid.New(),
+		RunId:      e.shard.GetIDGenerator().NewID(),
 	}
 
 	clusterMetadata := e.shard.GetService().GetClusterMetadata()
@@ -2032,10 +2165,21 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 
 	if t, ok := err.(*persistence.WorkflowExecutionAlreadyStartedError); ok {
 		if t.StartRequestID == request.GetRequestId() {
-			return &historyservice.SignalWithStartWorkflowExecutionResponse{
-				RunId: t.RunID,
-			}, nil
 			// delete history is expected here because duplicate start request will create history with different rid
+			if prevMutableState != nil && prevMutableState.GetExecutionState().GetRunId() == t.RunID {
+				// the run is still locked by this request, so use the already loaded state
+				startResponse, err := newStartedRunResponse(t.RunID, prevMutableState)
+				if err != nil {
+					return &historyservice.SignalWithStartWorkflowExecutionResponse{RunId: t.RunID}, nil
+				}
+				return newSignalWithStartWorkflowExecutionResponse(startResponse), nil
+			}
+			return newSignalWithStartWorkflowExecutionResponse(
+				e.getStartedRunResponse(ctx, namespaceID, commonpb.WorkflowExecution{
+					WorkflowId: workflowID,
+					RunId:      t.RunID,
+				}),
+			), nil
 		}
 		return nil, err
 	}
@@ -2043,9 +2187,9 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 	if err != nil {
 		return nil, err
 	}
-	return &historyservice.SignalWithStartWorkflowExecutionResponse{
-		RunId: execution.RunId,
-	}, nil
+	return newSignalWithStartWorkflowExecutionResponse(
+		newStartWorkflowExecutionResponse(execution.GetRunId(), startEvent, mutableState),
+	), nil
 }
 
 // RemoveSignalMutableState remove the signal request id in signal_requested for deduplicate
@@ -2220,6 +2364,14 @@ func (e *historyEngineImpl) SyncActivity(
 	return e.nDCActivityReplicator.SyncActivity(ctx, request)
 }
 
+func (e *historyEngineImpl) SyncWorkflowState(
+	ctx context.Context,
+	attributes *replicationspb.SyncWorkflowStateTaskAttributes,
+) (retError error) {
+
+	return e.nDCWorkflowStateReplicator.SyncWorkflowState(ctx, attributes)
+}
+
 func (e *historyEngineImpl) ResetWorkflowExecution(
 	ctx context.Context,
 	resetRequest *historyservice.ResetWorkflowExecutionRequest,
@@ -2301,7 +2453,7 @@ func (e *historyEngineImpl) ResetWorkflowExecution(
 		}, nil
 	}
 
-	resetRunID := uuid.New()
+	resetRunID := e.shard.GetIDGenerator().NewID()
 	baseRebuildLastEventID := request.GetWorkflowTaskFinishEventId() - 1
 	baseVersionHistories := baseMutableState.GetExecutionInfo().GetVersionHistories()
 	baseCurrentVersionHistory, err := versionhistory.GetCurrentVersionHistory(baseVersionHistories)
@@ -2586,6 +2738,28 @@ func (e *historyEngineImpl) validateStartWorkflowExecutionRequest(
 	return nil
 }
 
+func (e *historyEngineImpl) validateWorkflowTags(
+	tags map[string]string,
+	namespace string,
+) error {
+
+	if len(tags) > e.config.WorkflowTagsNumberOfKeysLimit(namespace) {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf("Number of workflow tags %d exceeds limit %d.", len(tags), e.config.WorkflowTagsNumberOfKeysLimit(namespace)))
+	}
+
+	totalSize := 0
+	for key, value := range tags {
+		if key == "" {
+			return serviceerror.NewInvalidArgument("Workflow tag key is not set.")
+		}
+		totalSize += len(key) + len(value)
+	}
+	if totalSize > e.config.WorkflowTagsTotalSizeLimit(namespace) {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf("Total size of workflow tags %d exceeds limit %d.", totalSize, e.config.WorkflowTagsTotalSizeLimit(namespace)))
+	}
+	return nil
+}
+
 func (e *historyEngineImpl) overrideStartWorkflowExecutionRequest(
 	namespaceEntry *cache.NamespaceCacheEntry,
 	request *workflowservice.StartWorkflowExecutionRequest,
@@ -2905,7 +3079,7 @@ func (e *historyEngineImpl) ReapplyEvents(
 				// need to reset target workflow (which is also the current workflow)
 				// to accept events to be reapplied
 				baseRunID := mutableState.GetExecutionState().GetRunId()
-				resetRunID := uuid.New()
+				resetRunID := e.shard.GetIDGenerator().NewID()
 				baseRebuildLastEventID := mutableState.GetPreviousStartedEventID()
 
 				// TODO when https://github.com/uber/cadence/issues/2420 is finished, remove this block,
@@ -2941,7 +3115,7 @@ func (e *historyEngineImpl) ReapplyEvents(
 					baseRebuildLastEventVersion,
 					baseNextEventID,
 					resetRunID,
-					uuid.New(),
+					e.shard.GetIDGenerator().NewID(),
 					newNDCWorkflow(
 						ctx,
 						e.shard.GetNamespaceCache(),
@@ -3102,6 +3276,158 @@ func (e *historyEngineImpl) RefreshWorkflowTasks(
 	return nil
 }
 
+func (e *historyEngineImpl) UpdateWorkflowExecutionTags(
+	ctx context.Context,
+	request *historyservice.UpdateWorkflowExecutionTagsRequest,
+) error {
+
+	namespaceEntry, err := e.getActiveNamespaceEntry(request.GetNamespaceId())
+	if err != nil {
+		return err
+	}
+	namespaceID := namespaceEntry.GetInfo().Id
+	namespace := namespaceEntry.GetInfo().Name
+
+	updateRequest := request.GetRequest()
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: updateRequest.GetExecution().GetWorkflowId(),
+		RunId:      updateRequest.GetExecution().GetRunId(),
+	}
+
+	return e.updateWorkflowExecution(ctx, namespaceID, execution, false,
+		func(context workflowExecutionContext, mutableState mutableState) error {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return ErrWorkflowCompleted
+			}
+
+			executionInfo := mutableState.GetExecutionInfo()
+			tags := make(map[string]string, len(executionInfo.Tags)+len(updateRequest.GetUpsertTags()))
+			for key, value := range executionInfo.Tags {
+				tags[key] = value
+			}
+			for key, value := range updateRequest.GetUpsertTags() {
+				tags[key] = value
+			}
+			for _, key := range updateRequest.GetRemoveTagKeys() {
+				delete(tags, key)
+			}
+
+			if err := e.validateWorkflowTags(tags, namespace); err != nil {
+				return err
+			}
+			return mutableState.UpdateWorkflowExecutionTags(tags)
+		},
+	)
+}
+
+func (e *historyEngineImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.PauseWorkflowExecutionRequest,
+) error {
+
+	namespaceEntry, err := e.getActiveNamespaceEntry(request.GetNamespaceId())
+	if err != nil {
+		return err
+	}
+	namespaceID := namespaceEntry.GetInfo().Id
+
+	pauseRequest := request.GetRequest()
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: pauseRequest.GetExecution().GetWorkflowId(),
+		RunId:      pauseRequest.GetExecution().GetRunId(),
+	}
+
+	return e.updateWorkflowExecution(ctx, namespaceID, execution, false,
+		func(context workflowExecutionContext, mutableState mutableState) error {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return ErrWorkflowCompleted
+			}
+
+			if mutableState.GetExecutionInfo().PauseInfo != nil {
+				return ErrWorkflowAlreadyPaused
+			}
+			// workflow tasks scheduled while the workflow is paused are dropped by RecordWorkflowTaskStarted,
+			// the pending one is dispatched again on unpause
+			return mutableState.PauseWorkflowExecution(&workflowspb.WorkflowPauseInfo{
+				PauseTime:    timestamp.TimePtr(e.shard.GetTimeSource().Now()),
+				Identity:     pauseRequest.GetIdentity(),
+				Reason:       pauseRequest.GetReason(),
+				FreezeTimers: pauseRequest.GetFreezeTimers(),
+			})
+		},
+	)
+}
+
+func (e *historyEngineImpl) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.UnpauseWorkflowExecutionRequest,
+) error {
+
+	namespaceEntry, err := e.getActiveNamespaceEntry(request.GetNamespaceId())
+	if err != nil {
+		return err
+	}
+	namespaceID := namespaceEntry.GetInfo().Id
+
+	unpauseRequest := request.GetRequest()
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: unpauseRequest.GetExecution().GetWorkflowId(),
+		RunId:      unpauseRequest.GetExecution().GetRunId(),
+	}
+
+	return e.updateWorkflowExecution(ctx, namespaceID, execution, false,
+		func(context workflowExecutionContext, mutableState mutableState) error {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return ErrWorkflowCompleted
+			}
+
+			if mutableState.GetExecutionInfo().PauseInfo == nil {
+				return ErrWorkflowNotPaused
+			}
+			if err := mutableState.UnpauseWorkflowExecution(e.shard.GetTimeSource().Now()); err != nil {
+				return err
+			}
+
+			workflowTask, ok := mutableState.GetPendingWorkflowTask()
+			if !ok || workflowTask.StartedID != common.EmptyEventID {
+				return nil
+			}
+			// regenerate transfer task of the pending workflow task, since it was dropped if matching
+			// dispatched it while the workflow was paused
+			taskGenerator := newMutableStateTaskGenerator(e.shard.GetNamespaceCache(), e.logger, mutableState)
+			return taskGenerator.generateScheduleWorkflowTaskTasks(
+				e.shard.GetTimeSource().Now(),
+				workflowTask.ScheduleID,
+			)
+		},
+	)
+}
+
+func (e *historyEngineImpl) DescribeWorkflowLocks(
+	_ context.Context,
+	_ *historyservice.DescribeWorkflowLocksRequest,
+) (*historyservice.DescribeWorkflowLocksResponse, error) {
+
+	locks := e.historyCache.describeLocks()
+	lockInfos := make([]*adminservice.WorkflowLockInfo, 0, len(locks))
+	for _, lock := range locks {
+		lockInfo := &adminservice.WorkflowLockInfo{
+			NamespaceId: lock.namespaceID,
+			WorkflowId:  lock.workflowID,
+			RunId:       lock.runID,
+			Held:        lock.held,
+			Waiters:     lock.waiters,
+		}
+		if lock.held {
+			lockInfo.HoldDuration = timestamp.DurationPtr(lock.holdDuration)
+		}
+		lockInfos = append(lockInfos, lockInfo)
+	}
+	return &historyservice.DescribeWorkflowLocksResponse{
+		Locks: lockInfos,
+	}, nil
+}
+
 func (e *historyEngineImpl) loadWorkflowOnce(
 	ctx context.Context,
 	namespaceID string,
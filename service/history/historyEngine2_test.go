@@ -44,6 +44,7 @@ import (
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/api/workflowservice/v1"
 
+	"go.temporal.io/server/api/adminservice/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
@@ -54,6 +55,7 @@ import (
 	"go.temporal.io/server/service/history/shard"
 
 	tokenspb "go.temporal.io/server/api/token/v1"
+	workflowspb "go.temporal.io/server/api/workflow/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/clock"
@@ -64,6 +66,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/service/dynamicconfig"
 )
 
 type (
@@ -438,6 +441,182 @@ func (s *engine2Suite) TestRecordWorkflowTaskStartedIfTaskAlreadyCompleted() {
 	s.logger.Error("RecordWorkflowTaskStarted failed with", tag.Error(err))
 }
 
+func (s *engine2Suite) TestRecordWorkflowTaskStartedIfWorkflowPaused() {
+	namespaceID := testNamespaceID
+	workflowExecution := commonpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+
+	identity := "testIdentity"
+	tl := "testTaskQueue"
+
+	msBuilder := s.createExecutionStartedState(workflowExecution, tl, identity, false)
+	msBuilder.GetExecutionInfo().PauseInfo = &workflowspb.WorkflowPauseInfo{Reason: "incident"}
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any()).Return(gwmsResponse, nil).Times(1)
+
+	response, err := s.historyEngine.RecordWorkflowTaskStarted(context.Background(), &historyservice.RecordWorkflowTaskStartedRequest{
+		NamespaceId:       namespaceID,
+		WorkflowExecution: &workflowExecution,
+		ScheduleId:        2,
+		TaskId:            100,
+		RequestId:         "reqId",
+		PollRequest: &workflowservice.PollWorkflowTaskQueueRequest{
+			TaskQueue: &taskqueuepb.TaskQueue{
+				Name: tl,
+			},
+			Identity: identity,
+		},
+	})
+	s.Nil(response)
+	s.Equal(ErrWorkflowPaused, err)
+}
+
+func (s *engine2Suite) TestPauseWorkflowExecution() {
+	workflowExecution := commonpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+
+	msBuilder := s.createExecutionStartedState(workflowExecution, "testTaskQueue", "testIdentity", false)
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	var updateRequest *persistence.UpdateWorkflowExecutionRequest
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any()).Return(gwmsResponse, nil).Times(1)
+	s.mockExecutionMgr.EXPECT().UpdateWorkflowExecution(gomock.Any()).DoAndReturn(func(request *persistence.UpdateWorkflowExecutionRequest) (*persistence.UpdateWorkflowExecutionResponse, error) {
+		updateRequest = request
+		return &persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil
+	}).Times(1)
+
+	err := s.historyEngine.PauseWorkflowExecution(context.Background(), &historyservice.PauseWorkflowExecutionRequest{
+		NamespaceId: testNamespaceID,
+		Request: &adminservice.PauseWorkflowExecutionRequest{
+			Execution:    &workflowExecution,
+			Reason:       "incident",
+			Identity:     "operator",
+			FreezeTimers: true,
+		},
+	})
+	s.NoError(err)
+	// pause is kept in mutable state only, no history event or workflow task is added for it
+	s.Empty(updateRequest.UpdateWorkflowMutation.TransferTasks)
+	pauseInfo := updateRequest.UpdateWorkflowMutation.ExecutionInfo.GetPauseInfo()
+	s.NotNil(pauseInfo)
+	s.Equal("incident", pauseInfo.GetReason())
+	s.Equal("operator", pauseInfo.GetIdentity())
+	s.True(pauseInfo.GetFreezeTimers())
+
+	err = s.historyEngine.PauseWorkflowExecution(context.Background(), &historyservice.PauseWorkflowExecutionRequest{
+		NamespaceId: testNamespaceID,
+		Request: &adminservice.PauseWorkflowExecutionRequest{
+			Execution: &workflowExecution,
+		},
+	})
+	s.Equal(ErrWorkflowAlreadyPaused, err)
+}
+
+func (s *engine2Suite) TestUnpauseWorkflowExecution() {
+	workflowExecution := commonpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+
+	msBuilder := s.createExecutionStartedState(workflowExecution, "testTaskQueue", "testIdentity", false)
+	msBuilder.GetExecutionInfo().PauseInfo = &workflowspb.WorkflowPauseInfo{Reason: "incident"}
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	var updateRequest *persistence.UpdateWorkflowExecutionRequest
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any()).Return(gwmsResponse, nil).Times(1)
+	s.mockExecutionMgr.EXPECT().UpdateWorkflowExecution(gomock.Any()).DoAndReturn(func(request *persistence.UpdateWorkflowExecutionRequest) (*persistence.UpdateWorkflowExecutionResponse, error) {
+		updateRequest = request
+		return &persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil
+	}).Times(1)
+
+	err := s.historyEngine.UnpauseWorkflowExecution(context.Background(), &historyservice.UnpauseWorkflowExecutionRequest{
+		NamespaceId: testNamespaceID,
+		Request: &adminservice.UnpauseWorkflowExecutionRequest{
+			Execution: &workflowExecution,
+		},
+	})
+	s.NoError(err)
+	s.Nil(updateRequest.UpdateWorkflowMutation.ExecutionInfo.GetPauseInfo())
+	// pending workflow task is dispatched again
+	s.Len(updateRequest.UpdateWorkflowMutation.TransferTasks, 1)
+	workflowTask, ok := updateRequest.UpdateWorkflowMutation.TransferTasks[0].(*persistence.WorkflowTask)
+	s.True(ok)
+	s.Equal(int64(2), workflowTask.ScheduleID)
+
+	err = s.historyEngine.UnpauseWorkflowExecution(context.Background(), &historyservice.UnpauseWorkflowExecutionRequest{
+		NamespaceId: testNamespaceID,
+		Request: &adminservice.UnpauseWorkflowExecutionRequest{
+			Execution: &workflowExecution,
+		},
+	})
+	s.Equal(ErrWorkflowNotPaused, err)
+}
+
+func (s *engine2Suite) TestUpdateWorkflowExecutionTags() {
+	workflowExecution := commonpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+
+	msBuilder := s.createExecutionStartedState(workflowExecution, "testTaskQueue", "testIdentity", false)
+	msBuilder.GetExecutionInfo().Tags = map[string]string{"team": "payments", "env": "prod"}
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	var updateRequest *persistence.UpdateWorkflowExecutionRequest
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any()).Return(gwmsResponse, nil).Times(1)
+	s.mockExecutionMgr.EXPECT().UpdateWorkflowExecution(gomock.Any()).DoAndReturn(func(request *persistence.UpdateWorkflowExecutionRequest) (*persistence.UpdateWorkflowExecutionResponse, error) {
+		updateRequest = request
+		return &persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil
+	}).Times(1)
+
+	err := s.historyEngine.UpdateWorkflowExecutionTags(context.Background(), &historyservice.UpdateWorkflowExecutionTagsRequest{
+		NamespaceId: testNamespaceID,
+		Request: &adminservice.UpdateWorkflowExecutionTagsRequest{
+			Execution:     &workflowExecution,
+			UpsertTags:    map[string]string{"team": "billing"},
+			RemoveTagKeys: []string{"env"},
+		},
+	})
+	s.NoError(err)
+	expectedTags := map[string]string{"team": "billing"}
+	// tags are kept in mutable state only, no history event or workflow task is added for them
+	s.Empty(updateRequest.UpdateWorkflowMutation.TransferTasks)
+	s.Equal(expectedTags, updateRequest.UpdateWorkflowMutation.ExecutionInfo.Tags)
+}
+
+func (s *engine2Suite) TestUpdateWorkflowExecutionTags_LimitExceeded() {
+	workflowExecution := commonpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+	s.config.WorkflowTagsNumberOfKeysLimit = dynamicconfig.GetIntPropertyFilteredByNamespace(1)
+
+	msBuilder := s.createExecutionStartedState(workflowExecution, "testTaskQueue", "testIdentity", false)
+	msBuilder.GetExecutionInfo().Tags = map[string]string{"team": "payments"}
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any()).Return(gwmsResponse, nil).Times(1)
+
+	err := s.historyEngine.UpdateWorkflowExecutionTags(context.Background(), &historyservice.UpdateWorkflowExecutionTagsRequest{
+		NamespaceId: testNamespaceID,
+		Request: &adminservice.UpdateWorkflowExecutionTagsRequest{
+			Execution:  &workflowExecution,
+			UpsertTags: map[string]string{"env": "prod"},
+		},
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
 func (s *engine2Suite) TestRecordWorkflowTaskStartedConflictOnUpdate() {
 	namespaceID := testNamespaceID
 	workflowExecution := commonpb.WorkflowExecution{
@@ -952,6 +1131,49 @@ func (s *engine2Suite) TestStartWorkflowExecution_BrandNew() {
 	})
 	s.Nil(err)
 	s.NotNil(resp.RunId)
+	s.NotNil(resp.StartTime)
+	s.NotNil(resp.FirstWorkflowTaskScheduledTime)
+	s.Equal(20*time.Second, timestamp.DurationValue(resp.WorkflowExecutionTimeout))
+	s.Equal(1*time.Second, timestamp.DurationValue(resp.WorkflowRunTimeout))
+	s.Equal(1*time.Second, timestamp.DurationValue(resp.WorkflowTaskTimeout))
+}
+
+func (s *engine2Suite) TestStartWorkflowExecution_Tags() {
+	tags := map[string]string{"team": "payments"}
+
+	var appendRequest *persistence.AppendHistoryNodesRequest
+	var createRequest *persistence.CreateWorkflowExecutionRequest
+	s.mockHistoryMgr.EXPECT().AppendHistoryNodes(gomock.Any()).DoAndReturn(func(request *persistence.AppendHistoryNodesRequest) (*persistence.AppendHistoryNodesResponse, error) {
+		appendRequest = request
+		return &persistence.AppendHistoryNodesResponse{Size: 0}, nil
+	}).Times(1)
+	s.mockExecutionMgr.EXPECT().CreateWorkflowExecution(gomock.Any()).DoAndReturn(func(request *persistence.CreateWorkflowExecutionRequest) (*persistence.CreateWorkflowExecutionResponse, error) {
+		createRequest = request
+		return &persistence.CreateWorkflowExecutionResponse{}, nil
+	}).Times(1)
+
+	_, err := s.historyEngine.StartWorkflowExecution(context.Background(), &historyservice.StartWorkflowExecutionRequest{
+		Attempt:     1,
+		NamespaceId: testNamespaceID,
+		StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+			Namespace:                testNamespaceID,
+			WorkflowId:               "workflowID",
+			WorkflowType:             &commonpb.WorkflowType{Name: "workflowType"},
+			TaskQueue:                &taskqueuepb.TaskQueue{Name: "testTaskQueue"},
+			WorkflowExecutionTimeout: timestamp.DurationPtr(20 * time.Second),
+			WorkflowRunTimeout:       timestamp.DurationPtr(1 * time.Second),
+			WorkflowTaskTimeout:      timestamp.DurationPtr(2 * time.Second),
+			Identity:                 "testIdentity",
+			RequestId:                uuid.New(),
+		},
+		Tags: tags,
+	})
+	s.NoError(err)
+	// tags are kept in mutable state only
+	s.Len(appendRequest.Events, 2)
+	s.Equal(enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED, appendRequest.Events[0].GetEventType())
+	s.Equal(enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED, appendRequest.Events[1].GetEventType())
+	s.Equal(tags, createRequest.NewWorkflowSnapshot.ExecutionInfo.Tags)
 }
 
 func (s *engine2Suite) TestStartWorkflowExecution_StillRunning_Dedup() {
@@ -963,6 +1185,17 @@ func (s *engine2Suite) TestStartWorkflowExecution_StillRunning_Dedup() {
 	identity := "testIdentity"
 	requestID := "requestID"
 	lastWriteVersion := common.EmptyVersion
+	we := commonpb.WorkflowExecution{
+		WorkflowId: workflowID,
+		RunId:      runID,
+	}
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.historyEngine.shard, s.mockEventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), runID)
+	startedEvent := addWorkflowExecutionStartedEvent(msBuilder, we, workflowType, taskQueue, nil, 1*time.Second, 1*time.Second, 2*time.Second, identity)
+	workflowTask := addWorkflowTaskScheduledEvent(msBuilder)
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
 
 	s.mockHistoryMgr.EXPECT().AppendHistoryNodes(gomock.Any()).Return(&persistence.AppendHistoryNodesResponse{Size: 0}, nil).Times(1)
 	s.mockExecutionMgr.EXPECT().CreateWorkflowExecution(gomock.Any()).Return(nil, &persistence.WorkflowExecutionAlreadyStartedError{
@@ -973,6 +1206,10 @@ func (s *engine2Suite) TestStartWorkflowExecution_StillRunning_Dedup() {
 		Status:           enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
 		LastWriteVersion: lastWriteVersion,
 	}).Times(1)
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any()).Return(gwmsResponse, nil).Times(1)
+	s.mockEventsCache.EXPECT().GetEvent(
+		namespaceID, workflowID, runID, common.FirstEventID, common.FirstEventID, gomock.Any(),
+	).Return(startedEvent, nil).Times(1)
 
 	resp, err := s.historyEngine.StartWorkflowExecution(context.Background(), &historyservice.StartWorkflowExecutionRequest{
 		Attempt:     1,
@@ -990,6 +1227,10 @@ func (s *engine2Suite) TestStartWorkflowExecution_StillRunning_Dedup() {
 	})
 	s.Nil(err)
 	s.Equal(runID, resp.GetRunId())
+	s.Equal(startedEvent.GetEventTime(), resp.GetStartTime())
+	s.Equal(workflowTask.ScheduledTime, resp.GetFirstWorkflowTaskScheduledTime())
+	s.Equal(1*time.Second, timestamp.DurationValue(resp.GetWorkflowExecutionTimeout()))
+	s.Equal(2*time.Second, timestamp.DurationValue(resp.GetWorkflowTaskTimeout()))
 }
 
 func (s *engine2Suite) TestStartWorkflowExecution_StillRunning_NonDeDup() {
@@ -1204,8 +1445,14 @@ func (s *engine2Suite) TestSignalWithStartWorkflowExecution_JustSignal() {
 		},
 	}
 
+	we := commonpb.WorkflowExecution{
+		WorkflowId: workflowID,
+		RunId:      runID,
+	}
+
 	msBuilder := newMutableStateBuilderWithEventV2(s.historyEngine.shard, s.mockEventsCache,
 		loggerimpl.NewDevelopmentForTest(s.Suite), runID)
+	startedEvent := addWorkflowExecutionStartedEvent(msBuilder, we, "wType", "testTaskQueue", nil, 100*time.Second, 50*time.Second, 200*time.Second, identity)
 	ms := createMutableState(msBuilder)
 	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
 	gceResponse := &persistence.GetCurrentExecutionResponse{RunID: runID}
@@ -1216,10 +1463,15 @@ func (s *engine2Suite) TestSignalWithStartWorkflowExecution_JustSignal() {
 	s.mockExecutionMgr.EXPECT().UpdateWorkflowExecution(gomock.Any()).Return(&persistence.UpdateWorkflowExecutionResponse{
 		MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{},
 	}, nil).Times(1)
+	s.mockEventsCache.EXPECT().GetEvent(
+		namespaceID, workflowID, runID, common.FirstEventID, common.FirstEventID, gomock.Any(),
+	).Return(startedEvent, nil).Times(1)
 
 	resp, err := s.historyEngine.SignalWithStartWorkflowExecution(context.Background(), sRequest)
 	s.Nil(err)
 	s.Equal(runID, resp.GetRunId())
+	s.Equal(startedEvent.GetEventTime(), resp.GetStartTime())
+	s.Equal(50*time.Second, timestamp.DurationValue(resp.GetWorkflowRunTimeout()))
 }
 
 func (s *engine2Suite) TestSignalWithStartWorkflowExecution_WorkflowNotExist() {
@@ -1408,7 +1660,7 @@ func (s *engine2Suite) TestSignalWithStartWorkflowExecution_Start_DuplicateReque
 
 	msBuilder := newMutableStateBuilderWithEventV2(s.historyEngine.shard, s.mockEventsCache,
 		loggerimpl.NewDevelopmentForTest(s.Suite), runID)
-	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, payloads.EncodeString("input"), 100*time.Second, 50*time.Second, 200*time.Second, identity)
+	startedEvent := addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, payloads.EncodeString("input"), 100*time.Second, 50*time.Second, 200*time.Second, identity)
 	ms := createMutableState(msBuilder)
 	ms.ExecutionState.State = enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED
 	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
@@ -1426,11 +1678,16 @@ func (s *engine2Suite) TestSignalWithStartWorkflowExecution_Start_DuplicateReque
 	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any()).Return(gwmsResponse, nil).Times(1)
 	s.mockHistoryMgr.EXPECT().AppendHistoryNodes(gomock.Any()).Return(&persistence.AppendHistoryNodesResponse{Size: 0}, nil).Times(1)
 	s.mockExecutionMgr.EXPECT().CreateWorkflowExecution(gomock.Any()).Return(nil, workflowAlreadyStartedErr).Times(1)
+	s.mockEventsCache.EXPECT().GetEvent(
+		namespaceID, workflowID, runID, common.FirstEventID, common.FirstEventID, gomock.Any(),
+	).Return(startedEvent, nil).Times(1)
 
 	resp, err := s.historyEngine.SignalWithStartWorkflowExecution(context.Background(), sRequest)
 	s.Nil(err)
 	s.NotNil(resp.GetRunId())
 	s.Equal(runID, resp.GetRunId())
+	s.Equal(startedEvent.GetEventTime(), resp.GetStartTime())
+	s.Equal(100*time.Second, timestamp.DurationValue(resp.GetWorkflowExecutionTimeout()))
 }
 
 func (s *engine2Suite) TestSignalWithStartWorkflowExecution_Start_WorkflowAlreadyStarted() {
@@ -40,6 +40,8 @@ import (
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	replicationspb "go.temporal.io/server/api/replication/v1"
+	workflowspb "go.temporal.io/server/api/workflow/v1"
 	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/persistence"
@@ -60,6 +62,8 @@ type (
 		// Also used for recording latency metrics
 		ScheduledTime *time.Time
 		StartedTime   *time.Time
+		// StartedIdentity is the identity of the worker which currently holds the started workflow task.
+		StartedIdentity string
 		// OriginalScheduledTime is to record the first scheduled workflow task during workflow task heartbeat.
 		// Client may heartbeat workflow task by RespondWorkflowTaskComplete with ForceCreateNewWorkflowTask == true
 		// In this case, OriginalScheduledTime won't change. Then when current time - OriginalScheduledTime exceeds
@@ -113,6 +117,9 @@ type (
 		AddWorkflowExecutionStartedEvent(commonpb.WorkflowExecution, *historyservice.StartWorkflowExecutionRequest) (*historypb.HistoryEvent, error)
 		AddWorkflowExecutionTerminatedEvent(firstEventID int64, reason string, details *commonpb.Payloads, identity string) (*historypb.HistoryEvent, error)
 		ClearStickyness()
+		PauseWorkflowExecution(pauseInfo *workflowspb.WorkflowPauseInfo) error
+		UnpauseWorkflowExecution(unpauseTime time.Time) error
+		UpdateWorkflowExecutionTags(tags map[string]string) error
 		CheckResettable() error
 		ToProto() *persistencespb.WorkflowMutableState
 		RetryActivity(ai *persistencespb.ActivityInfo, failure *failurepb.Failure) (enumspb.RetryState, error)
@@ -173,6 +180,7 @@ type (
 		UpdateDuplicatedResource(resourceDedupKey definition.DeduplicationID)
 		Load(*persistencespb.WorkflowMutableState) error
 		ReplicateActivityInfo(*historyservice.SyncActivityRequest, bool) error
+		ReplicateWorkflowState(*replicationspb.SyncWorkflowStateTaskAttributes) error
 		ReplicateActivityTaskCancelRequestedEvent(*historypb.HistoryEvent) error
 		ReplicateActivityTaskCanceledEvent(*historypb.HistoryEvent) error
 		ReplicateActivityTaskCompletedEvent(*historypb.HistoryEvent) error
@@ -189,7 +197,7 @@ type (
 		ReplicateWorkflowTaskCompletedEvent(*historypb.HistoryEvent) error
 		ReplicateWorkflowTaskFailedEvent() error
 		ReplicateWorkflowTaskScheduledEvent(int64, int64, *taskqueuepb.TaskQueue, int32, int32, *time.Time, *time.Time) (*workflowTaskInfo, error)
-		ReplicateWorkflowTaskStartedEvent(*workflowTaskInfo, int64, int64, int64, string, time.Time) (*workflowTaskInfo, error)
+		ReplicateWorkflowTaskStartedEvent(*workflowTaskInfo, int64, int64, int64, string, string, time.Time) (*workflowTaskInfo, error)
 		ReplicateWorkflowTaskTimedOutEvent(enumspb.TimeoutType) error
 		ReplicateExternalWorkflowExecutionCancelRequested(*historypb.HistoryEvent) error
 		ReplicateExternalWorkflowExecutionSignaled(*historypb.HistoryEvent) error
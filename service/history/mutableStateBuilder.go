@@ -29,7 +29,6 @@ import (
 	"math/rand"
 	"time"
 
-	"github.com/pborman/uuid"
 	commandpb "go.temporal.io/api/command/v1"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
@@ -44,6 +43,7 @@ import (
 	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	replicationspb "go.temporal.io/server/api/replication/v1"
 	workflowspb "go.temporal.io/server/api/workflow/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
@@ -102,6 +102,7 @@ type (
 		updateActivityInfos            map[*persistencespb.ActivityInfo]struct{} // Modified activities from last update.
 		deleteActivityInfos            map[int64]struct{}                        // Deleted activities from last update.
 		syncActivityTasks              map[int64]struct{}                        // Activity to be sync to remote
+		syncWorkflowState              bool                                      // Workflow state not recorded in history to be sync to remote
 
 		pendingTimerInfoIDs     map[string]*persistencespb.TimerInfo   // User Timer ID -> Timer Info.
 		pendingTimerEventIDToID map[int64]string                       // User Timer Start Event ID -> User Timer ID.
@@ -1615,7 +1616,7 @@ func (e *mutableStateBuilder) addWorkflowExecutionStartedEventForContinueAsNew(
 	runTimeout := attributes.GetWorkflowRunTimeout()
 
 	createRequest := &workflowservice.StartWorkflowExecutionRequest{
-		RequestId:                uuid.New(),
+		RequestId:                e.shard.GetIDGenerator().NewID(),
 		Namespace:                e.namespaceEntry.GetInfo().Name,
 		WorkflowId:               execution.WorkflowId,
 		TaskQueue:                tq,
@@ -1692,6 +1693,10 @@ func (e *mutableStateBuilder) addWorkflowExecutionStartedEventForContinueAsNew(
 	); err != nil {
 		return nil, err
 	}
+	// tags are carried over to the new run
+	if len(previousExecutionInfo.GetTags()) != 0 {
+		e.setWorkflowExecutionTags(previousExecutionInfo.GetTags())
+	}
 
 	if err := e.AddFirstWorkflowTaskScheduled(
 		event,
@@ -1755,6 +1760,9 @@ func (e *mutableStateBuilder) AddWorkflowExecutionStartedEvent(
 	); err != nil {
 		return nil, err
 	}
+	if len(startRequest.GetTags()) != 0 {
+		e.setWorkflowExecutionTags(startRequest.GetTags())
+	}
 	return event, nil
 }
 
@@ -1920,10 +1928,11 @@ func (e *mutableStateBuilder) ReplicateWorkflowTaskStartedEvent(
 	scheduleID int64,
 	startedID int64,
 	requestID string,
+	identity string,
 	timestamp time.Time,
 ) (*workflowTaskInfo, error) {
 
-	return e.workflowTaskManager.ReplicateWorkflowTaskStartedEvent(workflowTask, version, scheduleID, startedID, requestID, timestamp)
+	return e.workflowTaskManager.ReplicateWorkflowTaskStartedEvent(workflowTask, version, scheduleID, startedID, requestID, identity, timestamp)
 }
 
 func (e *mutableStateBuilder) CreateTransientWorkflowTaskEvents(
@@ -3253,6 +3262,119 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionSignaled(
 	return nil
 }
 
+// PauseWorkflowExecution pauses the workflow execution, the pause state is not recorded in history
+// and is shipped to other clusters with a sync workflow state replication task
+func (e *mutableStateBuilder) PauseWorkflowExecution(
+	pauseInfo *workflowspb.WorkflowPauseInfo,
+) error {
+
+	opTag := tag.WorkflowActionWorkflowPaused
+	if err := e.checkMutability(opTag); err != nil {
+		return err
+	}
+
+	if err := e.updatePauseState(pauseInfo, timestamp.TimeValue(pauseInfo.GetPauseTime())); err != nil {
+		return err
+	}
+	e.executionInfo.PauseStateVersion = e.GetCurrentVersion()
+	e.syncWorkflowState = true
+	return nil
+}
+
+// UnpauseWorkflowExecution resumes the paused workflow execution
+func (e *mutableStateBuilder) UnpauseWorkflowExecution(
+	unpauseTime time.Time,
+) error {
+
+	opTag := tag.WorkflowActionWorkflowUnpaused
+	if err := e.checkMutability(opTag); err != nil {
+		return err
+	}
+
+	if err := e.updatePauseState(nil, unpauseTime); err != nil {
+		return err
+	}
+	e.executionInfo.PauseStateVersion = e.GetCurrentVersion()
+	e.syncWorkflowState = true
+	return nil
+}
+
+// UpdateWorkflowExecutionTags replaces the tags of the workflow execution, the tags are not recorded in history
+// and are shipped to other clusters with a sync workflow state replication task
+func (e *mutableStateBuilder) UpdateWorkflowExecutionTags(
+	tags map[string]string,
+) error {
+
+	opTag := tag.WorkflowActionWorkflowTagsUpdated
+	if err := e.checkMutability(opTag); err != nil {
+		return err
+	}
+
+	e.setWorkflowExecutionTags(tags)
+	return nil
+}
+
+func (e *mutableStateBuilder) setWorkflowExecutionTags(
+	tags map[string]string,
+) {
+
+	e.executionInfo.Tags = tags
+	e.executionInfo.TagsVersion = e.GetCurrentVersion()
+	e.syncWorkflowState = true
+}
+
+// ReplicateWorkflowState applies the workflow state shipped from the active cluster,
+// each part of the state is only applied if it was not changed locally at a higher version
+func (e *mutableStateBuilder) ReplicateWorkflowState(
+	attributes *replicationspb.SyncWorkflowStateTaskAttributes,
+) error {
+
+	if e.executionInfo.GetTagsVersion() <= attributes.GetTagsVersion() {
+		e.executionInfo.Tags = attributes.GetTags()
+		e.executionInfo.TagsVersion = attributes.GetTagsVersion()
+	}
+
+	if e.executionInfo.GetPauseStateVersion() <= attributes.GetVersion() {
+		if err := e.updatePauseState(
+			attributes.GetPauseInfo(),
+			timestamp.TimeValue(attributes.GetPauseStateUpdateTime()),
+		); err != nil {
+			return err
+		}
+		e.executionInfo.PauseStateVersion = attributes.GetVersion()
+	}
+	return nil
+}
+
+func (e *mutableStateBuilder) updatePauseState(
+	pauseInfo *workflowspb.WorkflowPauseInfo,
+	updateTime time.Time,
+) error {
+
+	previousPauseInfo := e.executionInfo.PauseInfo
+	e.executionInfo.PauseInfo = pauseInfo
+	e.executionInfo.PauseStateUpdateTime = &updateTime
+	if pauseInfo != nil || !previousPauseInfo.GetFreezeTimers() {
+		return nil
+	}
+
+	// user timers do not fire while the workflow is paused with timers frozen,
+	// push them out by the paused duration and let the timer tasks be regenerated
+	pauseTime := timestamp.TimeValue(previousPauseInfo.GetPauseTime())
+	for _, timerInfo := range e.pendingTimerInfoIDs {
+		expiryTime := updateTime
+		if remaining := timestamp.TimeValue(timerInfo.GetExpiryTime()).Sub(pauseTime); remaining > 0 {
+			expiryTime = expiryTime.Add(remaining)
+		}
+		timerInfo.ExpiryTime = &expiryTime
+		timerInfo.TaskStatus = timerTaskStatusNone
+		if err := e.UpdateUserTimer(timerInfo); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (e *mutableStateBuilder) AddContinueAsNewEvent(
 	firstEventID int64,
 	workflowTaskCompletedEventID int64,
@@ -3266,7 +3388,7 @@ func (e *mutableStateBuilder) AddContinueAsNewEvent(
 	}
 
 	var err error
-	newRunID := uuid.New()
+	newRunID := e.shard.GetIDGenerator().NewID()
 	newExecution := commonpb.WorkflowExecution{
 		WorkflowId: e.executionInfo.WorkflowId,
 		RunId:      newRunID,
@@ -3750,18 +3872,23 @@ func (e *mutableStateBuilder) RetryActivity(
 		return enumspb.RETRY_STATE_CANCEL_REQUESTED, nil
 	}
 
+	retryPolicy, err := e.getActivityRetryPolicy(ai)
+	if err != nil {
+		return enumspb.RETRY_STATE_INTERNAL_SERVER_ERROR, err
+	}
+
 	now := e.timeSource.Now()
 
 	backoffInterval, retryState := getBackoffInterval(
 		now,
 		timestamp.TimeValue(ai.RetryExpirationTime),
 		ai.Attempt,
-		ai.RetryMaximumAttempts,
-		ai.RetryInitialInterval,
-		ai.RetryMaximumInterval,
-		ai.RetryBackoffCoefficient,
+		retryPolicy.GetMaximumAttempts(),
+		retryPolicy.GetInitialInterval(),
+		retryPolicy.GetMaximumInterval(),
+		retryPolicy.GetBackoffCoefficient(),
 		failure,
-		ai.RetryNonRetryableErrorTypes,
+		retryPolicy.GetNonRetryableErrorTypes(),
 	)
 	if retryState != enumspb.RETRY_STATE_IN_PROGRESS {
 		return retryState, nil
@@ -3769,6 +3896,10 @@ func (e *mutableStateBuilder) RetryActivity(
 
 	// a retry is needed, update activity info for next retry
 	ai.Version = e.GetCurrentVersion()
+	ai.RetryInitialInterval = retryPolicy.GetInitialInterval()
+	ai.RetryMaximumInterval = retryPolicy.GetMaximumInterval()
+	ai.RetryMaximumAttempts = retryPolicy.GetMaximumAttempts()
+	ai.RetryNonRetryableErrorTypes = retryPolicy.GetNonRetryableErrorTypes()
 	ai.Attempt++
 	ai.ScheduledTime = timestamp.TimePtr(now.Add(backoffInterval)) // update to next schedule time
 	ai.StartedId = common.EmptyEventID
@@ -3789,6 +3920,42 @@ func (e *mutableStateBuilder) RetryActivity(
 	return enumspb.RETRY_STATE_IN_PROGRESS, nil
 }
 
+// getActivityRetryPolicy returns the retry policy of the activity with the operator
+// provided overrides applied, so that overrides changed after the activity was scheduled
+// still take effect on its next retry.
+func (e *mutableStateBuilder) getActivityRetryPolicy(
+	ai *persistencespb.ActivityInfo,
+) (*commonpb.RetryPolicy, error) {
+
+	retryPolicy := &commonpb.RetryPolicy{
+		InitialInterval:        ai.RetryInitialInterval,
+		BackoffCoefficient:     ai.RetryBackoffCoefficient,
+		MaximumInterval:        ai.RetryMaximumInterval,
+		MaximumAttempts:        ai.RetryMaximumAttempts,
+		NonRetryableErrorTypes: ai.RetryNonRetryableErrorTypes,
+	}
+
+	namespace := e.namespaceEntry.GetInfo().Name
+	if ai.NamespaceId != "" && ai.NamespaceId != e.executionInfo.NamespaceId {
+		var err error
+		if namespace, err = e.shard.GetNamespaceCache().GetNamespaceName(ai.NamespaceId); err != nil {
+			return nil, err
+		}
+	}
+
+	scheduledEvent := ai.ScheduledEvent
+	if scheduledEvent == nil {
+		var err error
+		if scheduledEvent, err = e.GetActivityScheduledEvent(ai.ScheduleId); err != nil {
+			return nil, err
+		}
+	}
+	activityType := scheduledEvent.GetActivityTaskScheduledEventAttributes().GetActivityType().GetName()
+
+	common.ApplyRetryPolicyOverrides(retryPolicy, e.config.ActivityRetryPolicyOverride(namespace, activityType))
+	return retryPolicy, nil
+}
+
 // TODO mutable state should generate corresponding transfer / timer tasks according to
 //  updates accumulated, while currently all transfer / timer tasks are managed manually
 
@@ -4091,6 +4258,7 @@ func (e *mutableStateBuilder) cleanupTransaction(
 	e.updateActivityInfos = make(map[*persistencespb.ActivityInfo]struct{})
 	e.deleteActivityInfos = make(map[int64]struct{})
 	e.syncActivityTasks = make(map[int64]struct{})
+	e.syncWorkflowState = false
 
 	e.updateTimerInfos = make(map[*persistencespb.TimerInfo]struct{})
 	e.deleteTimerInfos = make(map[string]struct{})
@@ -4175,6 +4343,10 @@ func (e *mutableStateBuilder) prepareEventsAndReplicationTasks(
 		e.insertReplicationTasks,
 		e.syncActivityToReplicationTask(transactionPolicy)...,
 	)
+	e.insertReplicationTasks = append(
+		e.insertReplicationTasks,
+		e.syncWorkflowStateToReplicationTask(transactionPolicy)...,
+	)
 
 	if transactionPolicy == transactionPolicyPassive && len(e.insertReplicationTasks) > 0 {
 		return nil, serviceerror.NewInternal("should not generate replication task when close transaction as passive")
@@ -4240,6 +4412,21 @@ func (e *mutableStateBuilder) syncActivityToReplicationTask(
 	)
 }
 
+func (e *mutableStateBuilder) syncWorkflowStateToReplicationTask(
+	transactionPolicy transactionPolicy,
+) []persistence.Task {
+
+	if transactionPolicy == transactionPolicyPassive ||
+		!e.canReplicateEvents() ||
+		!e.syncWorkflowState {
+		return emptyTasks
+	}
+
+	return []persistence.Task{&persistence.SyncWorkflowStateTask{
+		Version: e.GetCurrentVersion(),
+	}}
+}
+
 func (e *mutableStateBuilder) updateWithLastWriteEvent(
 	lastEvent *historypb.HistoryEvent,
 	transactionPolicy transactionPolicy,
@@ -42,6 +42,8 @@ import (
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	historyspb "go.temporal.io/server/api/history/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	replicationspb "go.temporal.io/server/api/replication/v1"
+	workflowspb "go.temporal.io/server/api/workflow/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/definition"
@@ -554,6 +556,108 @@ func (s *mutableStateSuite) TestEventReapplied() {
 	s.True(isReapplied)
 }
 
+func (s *mutableStateSuite) TestReplicateWorkflowState_FreezeTimers() {
+	startTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, err := s.msBuilder.ReplicateTimerStartedEvent(&historypb.HistoryEvent{
+		EventId:   5,
+		EventTime: timestamp.TimePtr(startTime),
+		EventType: enumspb.EVENT_TYPE_TIMER_STARTED,
+		Attributes: &historypb.HistoryEvent_TimerStartedEventAttributes{TimerStartedEventAttributes: &historypb.TimerStartedEventAttributes{
+			TimerId:            "timer",
+			StartToFireTimeout: timestamp.DurationPtr(time.Minute),
+		}},
+	})
+	s.NoError(err)
+
+	pauseTime := startTime.Add(10 * time.Second)
+	err = s.msBuilder.ReplicateWorkflowState(&replicationspb.SyncWorkflowStateTaskAttributes{
+		Version: 101,
+		PauseInfo: &workflowspb.WorkflowPauseInfo{
+			PauseTime:    timestamp.TimePtr(pauseTime),
+			Identity:     "operator",
+			Reason:       "incident",
+			FreezeTimers: true,
+		},
+		PauseStateUpdateTime: timestamp.TimePtr(pauseTime),
+	})
+	s.NoError(err)
+	pauseInfo := s.msBuilder.GetExecutionInfo().PauseInfo
+	s.NotNil(pauseInfo)
+	s.Equal("incident", pauseInfo.GetReason())
+	s.True(pauseInfo.GetFreezeTimers())
+	s.Equal(int64(101), s.msBuilder.GetExecutionInfo().PauseStateVersion)
+	s.Equal(pauseTime, timestamp.TimeValue(s.msBuilder.GetExecutionInfo().PauseStateUpdateTime))
+
+	unpauseTime := pauseTime.Add(time.Hour)
+	err = s.msBuilder.ReplicateWorkflowState(&replicationspb.SyncWorkflowStateTaskAttributes{
+		Version:              102,
+		PauseStateUpdateTime: timestamp.TimePtr(unpauseTime),
+	})
+	s.NoError(err)
+	s.Nil(s.msBuilder.GetExecutionInfo().PauseInfo)
+	s.Equal(int64(102), s.msBuilder.GetExecutionInfo().PauseStateVersion)
+	timerInfo, ok := s.msBuilder.GetUserTimerInfo("timer")
+	s.True(ok)
+	s.Equal(startTime.Add(time.Minute).Add(time.Hour), timestamp.TimeValue(timerInfo.GetExpiryTime()))
+	s.Equal(int64(timerTaskStatusNone), timerInfo.GetTaskStatus())
+}
+
+func (s *mutableStateSuite) TestReplicateWorkflowState_Tags() {
+	tags := map[string]string{"team": "payments"}
+	err := s.msBuilder.ReplicateWorkflowState(&replicationspb.SyncWorkflowStateTaskAttributes{
+		Tags:        tags,
+		TagsVersion: 101,
+	})
+	s.NoError(err)
+	s.Equal(tags, s.msBuilder.GetExecutionInfo().Tags)
+	s.Equal(int64(101), s.msBuilder.GetExecutionInfo().TagsVersion)
+
+	// tags updated locally at a higher version are kept
+	err = s.msBuilder.ReplicateWorkflowState(&replicationspb.SyncWorkflowStateTaskAttributes{
+		Tags:        map[string]string{"team": "billing"},
+		TagsVersion: 100,
+	})
+	s.NoError(err)
+	s.Equal(tags, s.msBuilder.GetExecutionInfo().Tags)
+	s.Equal(int64(101), s.msBuilder.GetExecutionInfo().TagsVersion)
+}
+
+func (s *mutableStateSuite) TestRetryActivity_RetryPolicyOverride() {
+	activityType := "some random activity type"
+	s.mockConfig.ActivityRetryPolicyOverride = func(namespace string, activityTypeName string) map[string]interface{} {
+		if namespace == testNamespace && activityTypeName == activityType {
+			return map[string]interface{}{"MaximumAttempts": 2}
+		}
+		return map[string]interface{}{}
+	}
+
+	ai := &persistencespb.ActivityInfo{
+		ScheduleId:              5,
+		ScheduledEventBatchId:   4,
+		Attempt:                 2,
+		HasRetryPolicy:          true,
+		RetryInitialInterval:    timestamp.DurationPtr(time.Second),
+		RetryBackoffCoefficient: 2,
+		RetryMaximumAttempts:    0,
+	}
+	s.msBuilder.pendingActivityInfoIDs[ai.ScheduleId] = ai
+	s.mockEventsCache.EXPECT().GetEvent(
+		gomock.Any(), gomock.Any(), gomock.Any(), ai.ScheduledEventBatchId, ai.ScheduleId, gomock.Any(),
+	).Return(&historypb.HistoryEvent{
+		EventId:   ai.ScheduleId,
+		EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED,
+		Attributes: &historypb.HistoryEvent_ActivityTaskScheduledEventAttributes{ActivityTaskScheduledEventAttributes: &historypb.ActivityTaskScheduledEventAttributes{
+			ActivityType: &commonpb.ActivityType{Name: activityType},
+		}},
+	}, nil)
+
+	// the user specified unlimited attempts, but the override set after scheduling caps them
+	retryState, err := s.msBuilder.RetryActivity(ai, failure.NewServerFailure("some random failure", false))
+	s.NoError(err)
+	s.Equal(enumspb.RETRY_STATE_MAXIMUM_ATTEMPTS_REACHED, retryState)
+	s.Equal(int32(2), ai.Attempt)
+}
+
 func (s *mutableStateSuite) TestTransientWorkflowTaskSchedule_CurrentVersionChanged() {
 	version := int64(2000)
 	runID := uuid.New()
@@ -682,6 +786,7 @@ func (s *mutableStateSuite) prepareTransientWorkflowTaskCompletionFirstBatchRepl
 		Attributes: &historypb.HistoryEvent_WorkflowTaskStartedEventAttributes{WorkflowTaskStartedEventAttributes: &historypb.WorkflowTaskStartedEventAttributes{
 			ScheduledEventId: workflowTaskScheduleEvent.GetEventId(),
 			RequestId:        uuid.New(),
+			Identity:         "some random worker identity",
 		}},
 	}
 	eventID++
@@ -728,10 +833,13 @@ func (s *mutableStateSuite) prepareTransientWorkflowTaskCompletionFirstBatchRepl
 		workflowTaskScheduleEvent.GetEventId(),
 		workflowTaskStartedEvent.GetEventId(),
 		workflowTaskStartedEvent.GetWorkflowTaskStartedEventAttributes().GetRequestId(),
+		workflowTaskStartedEvent.GetWorkflowTaskStartedEventAttributes().GetIdentity(),
 		timestamp.TimeValue(workflowTaskStartedEvent.GetEventTime()),
 	)
 	s.Nil(err)
 	s.NotNil(di)
+	s.Equal("some random worker identity", di.StartedIdentity)
+	s.Equal("some random worker identity", s.msBuilder.GetExecutionInfo().WorkflowTaskStartedIdentity)
 
 	err = s.msBuilder.ReplicateWorkflowTaskFailedEvent()
 	s.Nil(err)
@@ -779,6 +887,7 @@ func (s *mutableStateSuite) prepareTransientWorkflowTaskCompletionFirstBatchRepl
 		newWorkflowTaskScheduleEvent.GetEventId(),
 		newWorkflowTaskStartedEvent.GetEventId(),
 		newWorkflowTaskStartedEvent.GetWorkflowTaskStartedEventAttributes().GetRequestId(),
+		newWorkflowTaskStartedEvent.GetWorkflowTaskStartedEventAttributes().GetIdentity(),
 		timestamp.TimeValue(newWorkflowTaskStartedEvent.GetEventTime()),
 	)
 	s.Nil(err)
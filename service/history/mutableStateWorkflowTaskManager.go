@@ -54,6 +54,7 @@ type (
 			scheduleID int64,
 			startedID int64,
 			requestID string,
+			identity string,
 			timestamp time.Time,
 		) (*workflowTaskInfo, error)
 		ReplicateWorkflowTaskCompletedEvent(event *historypb.HistoryEvent) error
@@ -184,6 +185,7 @@ func (m *mutableStateWorkflowTaskManagerImpl) ReplicateWorkflowTaskStartedEvent(
 	scheduleID int64,
 	startedID int64,
 	requestID string,
+	identity string,
 	timestamp time.Time,
 ) (*workflowTaskInfo, error) {
 	// Replicator calls it with a nil workflow task info, and it is safe to always lookup the workflow task in this case as it
@@ -214,6 +216,7 @@ func (m *mutableStateWorkflowTaskManagerImpl) ReplicateWorkflowTaskStartedEvent(
 		WorkflowTaskTimeout:   workflowTask.WorkflowTaskTimeout,
 		Attempt:               workflowTask.Attempt,
 		StartedTime:           &timestamp,
+		StartedIdentity:       identity,
 		ScheduledTime:         workflowTask.ScheduledTime,
 		TaskQueue:             workflowTask.TaskQueue,
 		OriginalScheduledTime: workflowTask.OriginalScheduledTime,
@@ -440,7 +443,7 @@ func (m *mutableStateWorkflowTaskManagerImpl) AddWorkflowTaskStartedEvent(
 		startTime = timestamp.TimeValue(event.GetEventTime())
 	}
 
-	workflowTask, err := m.ReplicateWorkflowTaskStartedEvent(workflowTask, m.msb.GetCurrentVersion(), scheduleID, startedID, requestID, startTime)
+	workflowTask, err := m.ReplicateWorkflowTaskStartedEvent(workflowTask, m.msb.GetCurrentVersion(), scheduleID, startedID, requestID, request.GetIdentity(), startTime)
 	// TODO merge active & passive task generation
 	if err := m.msb.taskGenerator.generateStartWorkflowTaskTasks(
 		startTime, // start time is now
@@ -625,6 +628,7 @@ func (m *mutableStateWorkflowTaskManagerImpl) UpdateWorkflowTask(
 	m.msb.executionInfo.WorkflowTaskTimeout = workflowTask.WorkflowTaskTimeout
 	m.msb.executionInfo.WorkflowTaskAttempt = workflowTask.Attempt
 	m.msb.executionInfo.WorkflowTaskStartedTime = workflowTask.StartedTime
+	m.msb.executionInfo.WorkflowTaskStartedIdentity = workflowTask.StartedIdentity
 	m.msb.executionInfo.WorkflowTaskScheduledTime = workflowTask.ScheduledTime
 	m.msb.executionInfo.WorkflowTaskOriginalScheduledTime = workflowTask.OriginalScheduledTime
 
@@ -726,6 +730,7 @@ func (m *mutableStateWorkflowTaskManagerImpl) getWorkflowTaskInfo() *workflowTas
 		WorkflowTaskTimeout:   m.msb.executionInfo.WorkflowTaskTimeout,
 		Attempt:               m.msb.executionInfo.WorkflowTaskAttempt,
 		StartedTime:           m.msb.executionInfo.WorkflowTaskStartedTime,
+		StartedIdentity:       m.msb.executionInfo.WorkflowTaskStartedIdentity,
 		ScheduledTime:         m.msb.executionInfo.WorkflowTaskScheduledTime,
 		TaskQueue:             taskQueue,
 		OriginalScheduledTime: m.msb.executionInfo.WorkflowTaskOriginalScheduledTime,
@@ -28,7 +28,6 @@ import (
 	"context"
 	"time"
 
-	"github.com/pborman/uuid"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
@@ -311,7 +310,7 @@ func (r *nDCHistoryReplicatorImpl) applyStartEvents(
 	if err != nil {
 		return err
 	}
-	requestID := uuid.New() // requestID used for start workflow execution request.  This is not on the history event.
+	requestID := r.shard.GetIDGenerator().NewID() // requestID used for start workflow execution request.  This is not on the history event.
 	mutableState := r.newMutableState(namespaceEntry, timestamp.TimeValue(task.getFirstEvent().GetEventTime()), task.getLogger())
 	stateBuilder := r.newStateBuilder(mutableState, task.getLogger())
 
@@ -418,7 +417,7 @@ func (r *nDCHistoryReplicatorImpl) applyNonStartEventsToCurrentBranch(
 	task nDCReplicationTask,
 ) error {
 
-	requestID := uuid.New() // requestID used for start workflow execution request.  This is not on the history event.
+	requestID := r.shard.GetIDGenerator().NewID() // requestID used for start workflow execution request.  This is not on the history event.
 	stateBuilder := r.newStateBuilder(mutableState, task.getLogger())
 	newMutableState, err := stateBuilder.applyEvents(
 		task.getNamespaceID(),
@@ -672,7 +671,7 @@ func (r *nDCHistoryReplicatorImpl) applyNonStartEventsResetWorkflow(
 	task nDCReplicationTask,
 ) error {
 
-	requestID := uuid.New() // requestID used for start workflow execution request.  This is not on the history event.
+	requestID := r.shard.GetIDGenerator().NewID() // requestID used for start workflow execution request.  This is not on the history event.
 	stateBuilder := r.newStateBuilder(mutableState, task.getLogger())
 	_, err := stateBuilder.applyEvents(
 		task.getNamespaceID(),
@@ -30,7 +30,6 @@ import (
 	"context"
 	"time"
 
-	"github.com/pborman/uuid"
 	commonpb "go.temporal.io/api/common/v1"
 	"go.temporal.io/api/serviceerror"
 
@@ -316,7 +315,7 @@ func (r *nDCTransactionMgrImpl) backfillWorkflowEventsReapply(
 		namespaceID := baseMutableState.GetExecutionInfo().NamespaceId
 		workflowID := baseMutableState.GetExecutionInfo().WorkflowId
 		baseRunID := baseMutableState.GetExecutionState().GetRunId()
-		resetRunID := uuid.New()
+		resetRunID := r.shard.GetIDGenerator().NewID()
 		baseRebuildLastEventID := baseMutableState.GetPreviousStartedEventID()
 
 		// TODO when https://github.com/uber/cadence/issues/2420 is finished, remove this block,
@@ -352,7 +351,7 @@ func (r *nDCTransactionMgrImpl) backfillWorkflowEventsReapply(
 			baseRebuildLastEventVersion,
 			baseNextEventID,
 			resetRunID,
-			uuid.New(),
+			r.shard.GetIDGenerator().NewID(),
 			targetWorkflow,
 			eventsReapplicationResetWorkflowReason,
 			targetWorkflowEvents.Events,
@@ -30,8 +30,6 @@ import (
 	"context"
 	"time"
 
-	"github.com/pborman/uuid"
-
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/definition"
@@ -125,7 +123,7 @@ func (r *nDCWorkflowResetterImpl) resetWorkflow(
 
 	resetBranchToken, err := r.getResetBranchToken(ctx, baseBranchToken, baseLastEventID)
 
-	requestID := uuid.New()
+	requestID := r.shard.GetIDGenerator().NewID()
 	rebuildMutableState, rebuiltHistorySize, err := r.stateRebuilder.rebuild(
 		ctx,
 		now,
@@ -0,0 +1,219 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -copyright_file ../../LICENSE -package $GOPACKAGE -source $GOFILE -destination nDCWorkflowStateReplicator_mock.go
+
+package history
+
+import (
+	"context"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	replicationspb "go.temporal.io/server/api/replication/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/versionhistory"
+	serviceerrors "go.temporal.io/server/common/serviceerror"
+	"go.temporal.io/server/service/history/shard"
+)
+
+const (
+	resendWorkflowStateHigherVersionMessage = "Resend sync workflow state events due to a higher version received"
+)
+
+type (
+	nDCWorkflowStateReplicator interface {
+		SyncWorkflowState(
+			ctx context.Context,
+			attributes *replicationspb.SyncWorkflowStateTaskAttributes,
+		) error
+	}
+
+	nDCWorkflowStateReplicatorImpl struct {
+		shard        shard.Context
+		historyCache *historyCache
+		logger       log.Logger
+	}
+)
+
+func newNDCWorkflowStateReplicator(
+	shard shard.Context,
+	historyCache *historyCache,
+	logger log.Logger,
+) *nDCWorkflowStateReplicatorImpl {
+
+	return &nDCWorkflowStateReplicatorImpl{
+		shard:        shard,
+		historyCache: historyCache,
+		logger:       logger.WithTags(tag.ComponentHistoryReplicator),
+	}
+}
+
+func (r *nDCWorkflowStateReplicatorImpl) SyncWorkflowState(
+	ctx context.Context,
+	attributes *replicationspb.SyncWorkflowStateTaskAttributes,
+) (retError error) {
+
+	// sync workflow state is sent from active side for workflow state which is not recorded
+	// in history events, i.e. the pause state and tags, the task carries the state at the time the
+	// task is sent, not the time the state changed
+	namespaceID := attributes.GetNamespaceId()
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: attributes.GetWorkflowId(),
+		RunId:      attributes.GetRunId(),
+	}
+
+	context, release, err := r.historyCache.getOrCreateWorkflowExecution(ctx, namespaceID, execution)
+	if err != nil {
+		// for get workflow execution context, with valid run id
+		// err will not be of type EntityNotExistsError
+		return err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := context.loadWorkflowExecution()
+	if err != nil {
+		if _, ok := err.(*serviceerror.NotFound); !ok {
+			return err
+		}
+
+		// this can happen if the workflow start event and this sync workflow state task are out of order
+		// or the target workflow is long gone, the next sync workflow state task carries the latest state
+		return nil
+	}
+
+	shouldApply, err := r.testVersionHistory(
+		namespaceID,
+		execution.GetWorkflowId(),
+		execution.GetRunId(),
+		mutableState,
+		attributes.GetVersionHistory(),
+	)
+	if err != nil || !shouldApply {
+		return err
+	}
+	executionInfo := mutableState.GetExecutionInfo()
+	if executionInfo.GetPauseStateVersion() > attributes.GetVersion() &&
+		executionInfo.GetTagsVersion() > attributes.GetTagsVersion() {
+		// this should not retry, can be caused by failover
+		return nil
+	}
+
+	if err := mutableState.ReplicateWorkflowState(attributes); err != nil {
+		return err
+	}
+
+	// passive logic need to explicitly call create timer, user timers are
+	// pushed out when a workflow paused with timers frozen is unpaused
+	now := r.shard.GetTimeSource().Now()
+	if _, err := newTimerSequence(
+		clock.NewEventTimeSource().Update(now),
+		mutableState,
+	).createNextUserTimer(); err != nil {
+		return err
+	}
+
+	updateMode := persistence.UpdateWorkflowModeUpdateCurrent
+	if state, _ := mutableState.GetWorkflowStateStatus(); state == enumsspb.WORKFLOW_EXECUTION_STATE_ZOMBIE {
+		updateMode = persistence.UpdateWorkflowModeBypassCurrent
+	}
+
+	return context.updateWorkflowExecutionWithNew(
+		now,
+		updateMode,
+		nil, // no new workflow
+		nil, // no new workflow
+		transactionPolicyPassive,
+		nil,
+	)
+}
+
+func (r *nDCWorkflowStateReplicatorImpl) testVersionHistory(
+	namespaceID string,
+	workflowID string,
+	runID string,
+	mutableState mutableState,
+	incomingVersionHistory *historyspb.VersionHistory,
+) (bool, error) {
+
+	currentVersionHistory, err := versionhistory.GetCurrentVersionHistory(
+		mutableState.GetExecutionInfo().GetVersionHistories(),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	lastLocalItem, err := versionhistory.GetLastVersionHistoryItem(currentVersionHistory)
+	if err != nil {
+		return false, err
+	}
+
+	lastIncomingItem, err := versionhistory.GetLastVersionHistoryItem(incomingVersionHistory)
+	if err != nil {
+		return false, err
+	}
+
+	lcaItem, err := versionhistory.FindLCAVersionHistoryItem(currentVersionHistory, incomingVersionHistory)
+	if err != nil {
+		return false, err
+	}
+
+	// case 1: local version history is superset of incoming version history
+	//  or incoming version history is superset of local version history
+	//  the workflow state does not depend on events, apply the incoming state
+
+	// case 2: local version history and incoming version history diverged
+	//  case 2-1: local version history has the higher version and discard the incoming state
+	//  case 2-2: incoming version history has the higher version and resend the missing incoming events
+	if !versionhistory.IsLCAVersionHistoryItemAppendable(currentVersionHistory, lcaItem) &&
+		!versionhistory.IsLCAVersionHistoryItemAppendable(incomingVersionHistory, lcaItem) {
+		// case 2
+		if lastIncomingItem.GetVersion() < lastLocalItem.GetVersion() {
+			// case 2-1
+			return false, nil
+		} else if lastIncomingItem.GetVersion() > lastLocalItem.GetVersion() {
+			// case 2-2
+			return false, serviceerrors.NewRetryReplication(
+				resendWorkflowStateHigherVersionMessage,
+				namespaceID,
+				workflowID,
+				runID,
+				lcaItem.GetEventId(),
+				lcaItem.GetVersion(),
+				common.EmptyEventID,
+				common.EmptyVersion,
+			)
+		}
+	}
+
+	state, _ := mutableState.GetWorkflowStateStatus()
+	return state != enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED, nil
+}
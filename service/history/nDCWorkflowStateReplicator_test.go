@@ -0,0 +1,245 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	replicationspb "go.temporal.io/server/api/replication/v1"
+	workflowspb "go.temporal.io/server/api/workflow/v1"
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/service/history/shard"
+)
+
+type (
+	workflowStateReplicatorSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller       *gomock.Controller
+		mockShard        *shard.ContextTest
+		mockMutableState *MockmutableState
+		mockExecutionMgr *persistence.MockExecutionManager
+
+		historyCache *historyCache
+
+		nDCWorkflowStateReplicator *nDCWorkflowStateReplicatorImpl
+	}
+)
+
+func TestWorkflowStateReplicatorSuite(t *testing.T) {
+	s := new(workflowStateReplicatorSuite)
+	suite.Run(t, s)
+}
+
+func (s *workflowStateReplicatorSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.mockMutableState = NewMockmutableState(s.controller)
+
+	s.mockShard = shard.NewTestContext(
+		s.controller,
+		&persistence.ShardInfoWithFailover{
+			ShardInfo: &persistencespb.ShardInfo{
+				ShardId:          1,
+				RangeId:          1,
+				TransferAckLevel: 0,
+			}},
+		NewDynamicConfigForTest(),
+	)
+	s.mockExecutionMgr = s.mockShard.Resource.ExecutionMgr
+	s.mockShard.Resource.ClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+
+	s.historyCache = newHistoryCache(s.mockShard)
+	s.nDCWorkflowStateReplicator = newNDCWorkflowStateReplicator(
+		s.mockShard,
+		s.historyCache,
+		s.mockShard.GetLogger(),
+	)
+}
+
+func (s *workflowStateReplicatorSuite) TearDownTest() {
+	s.controller.Finish()
+	s.mockShard.Finish(s.T())
+}
+
+func (s *workflowStateReplicatorSuite) TestSyncWorkflowState_WorkflowNotFound() {
+	namespaceID := testNamespaceID
+	workflowID := testWorkflowID
+	runID := uuid.New()
+
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(&persistence.GetWorkflowExecutionRequest{
+		NamespaceID: namespaceID,
+		Execution: commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      runID,
+		},
+	}).Return(nil, serviceerror.NewNotFound(""))
+
+	err := s.nDCWorkflowStateReplicator.SyncWorkflowState(context.Background(), &replicationspb.SyncWorkflowStateTaskAttributes{
+		NamespaceId: namespaceID,
+		WorkflowId:  workflowID,
+		RunId:       runID,
+	})
+	s.Nil(err)
+}
+
+func (s *workflowStateReplicatorSuite) TestSyncWorkflowState_LocalVersionLarger() {
+	version := int64(100)
+	runID := uuid.New()
+	s.prepareWorkflowExecutionContext(runID)
+
+	s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistencespb.WorkflowExecutionInfo{
+		VersionHistories:  s.newVersionHistories(version),
+		PauseStateVersion: version + 1,
+		TagsVersion:       version + 1,
+	}).AnyTimes()
+	s.mockMutableState.EXPECT().GetWorkflowStateStatus().Return(
+		enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+	).AnyTimes()
+
+	err := s.nDCWorkflowStateReplicator.SyncWorkflowState(context.Background(), &replicationspb.SyncWorkflowStateTaskAttributes{
+		NamespaceId:    testNamespaceID,
+		WorkflowId:     testWorkflowID,
+		RunId:          runID,
+		Version:        version,
+		VersionHistory: s.newVersionHistories(version).Histories[0],
+		TagsVersion:    version,
+	})
+	s.Nil(err)
+}
+
+func (s *workflowStateReplicatorSuite) TestSyncWorkflowState_WorkflowClosed() {
+	version := int64(100)
+	runID := uuid.New()
+	s.prepareWorkflowExecutionContext(runID)
+
+	s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistencespb.WorkflowExecutionInfo{
+		VersionHistories: s.newVersionHistories(version),
+	}).AnyTimes()
+	s.mockMutableState.EXPECT().GetWorkflowStateStatus().Return(
+		enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED, enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+	).AnyTimes()
+
+	err := s.nDCWorkflowStateReplicator.SyncWorkflowState(context.Background(), &replicationspb.SyncWorkflowStateTaskAttributes{
+		NamespaceId:    testNamespaceID,
+		WorkflowId:     testWorkflowID,
+		RunId:          runID,
+		Version:        version,
+		VersionHistory: s.newVersionHistories(version).Histories[0],
+	})
+	s.Nil(err)
+}
+
+func (s *workflowStateReplicatorSuite) TestSyncWorkflowState_Apply() {
+	version := int64(100)
+	runID := uuid.New()
+	weContext := s.prepareWorkflowExecutionContext(runID)
+
+	now := time.Now().UTC()
+	request := &replicationspb.SyncWorkflowStateTaskAttributes{
+		NamespaceId:    testNamespaceID,
+		WorkflowId:     testWorkflowID,
+		RunId:          runID,
+		Version:        version,
+		VersionHistory: s.newVersionHistories(version).Histories[0],
+		PauseInfo: &workflowspb.WorkflowPauseInfo{
+			PauseTime: timestamp.TimePtr(now),
+			Reason:    "incident",
+		},
+		PauseStateUpdateTime: timestamp.TimePtr(now),
+		Tags:                 map[string]string{"team": "payments"},
+		TagsVersion:          version,
+	}
+	s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistencespb.WorkflowExecutionInfo{
+		VersionHistories:  s.newVersionHistories(version),
+		PauseStateVersion: version - 1,
+	}).AnyTimes()
+	s.mockMutableState.EXPECT().GetWorkflowStateStatus().Return(
+		enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+	).AnyTimes()
+	s.mockMutableState.EXPECT().ReplicateWorkflowState(request).Return(nil).Times(1)
+	s.mockMutableState.EXPECT().GetPendingTimerInfos().Return(map[string]*persistencespb.TimerInfo{}).Times(1)
+	weContext.EXPECT().updateWorkflowExecutionWithNew(
+		gomock.Any(),
+		persistence.UpdateWorkflowModeUpdateCurrent,
+		workflowExecutionContext(nil),
+		mutableState(nil),
+		transactionPolicyPassive,
+		(*transactionPolicy)(nil),
+	).Return(nil).Times(1)
+
+	err := s.nDCWorkflowStateReplicator.SyncWorkflowState(context.Background(), request)
+	s.Nil(err)
+}
+
+func (s *workflowStateReplicatorSuite) prepareWorkflowExecutionContext(
+	runID string,
+) *MockworkflowExecutionContext {
+
+	key := definition.NewWorkflowIdentifier(testNamespaceID, testWorkflowID, runID)
+	weContext := NewMockworkflowExecutionContext(s.controller)
+	weContext.EXPECT().loadWorkflowExecution().Return(s.mockMutableState, nil).Times(1)
+	weContext.EXPECT().lock(gomock.Any()).Return(nil)
+	weContext.EXPECT().unlock().Times(1)
+	_, err := s.historyCache.PutIfNotExist(key, weContext)
+	s.NoError(err)
+	return weContext
+}
+
+func (s *workflowStateReplicatorSuite) newVersionHistories(
+	version int64,
+) *historyspb.VersionHistories {
+
+	return &historyspb.VersionHistories{
+		CurrentVersionHistoryIndex: 0,
+		Histories: []*historyspb.VersionHistory{{
+			BranchToken: []byte{},
+			Items: []*historyspb.VersionHistoryItem{
+				{
+					EventId: 10,
+					Version: version,
+				},
+			},
+		}},
+	}
+}
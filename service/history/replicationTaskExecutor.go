@@ -98,6 +98,9 @@ func (e *replicationTaskExecutorImpl) execute(
 	case enumsspb.REPLICATION_TASK_TYPE_SYNC_ACTIVITY_TASK:
 		scope = metrics.SyncActivityTaskScope
 		err = e.handleActivityTask(replicationTask, forceApply)
+	case enumsspb.REPLICATION_TASK_TYPE_SYNC_WORKFLOW_STATE_TASK:
+		scope = metrics.SyncWorkflowStateTaskScope
+		err = e.handleWorkflowStateTask(replicationTask, forceApply)
 	case enumsspb.REPLICATION_TASK_TYPE_HISTORY_METADATA_TASK:
 		// Without kafka we should not have size limits so we don't necessary need this in the new replication scheme.
 		scope = metrics.HistoryMetadataReplicationTaskScope
@@ -176,6 +179,53 @@ func (e *replicationTaskExecutorImpl) handleActivityTask(
 	}
 }
 
+func (e *replicationTaskExecutorImpl) handleWorkflowStateTask(
+	task *replicationspb.ReplicationTask,
+	forceApply bool,
+) error {
+
+	attr := task.GetSyncWorkflowStateTaskAttributes()
+	doContinue, err := e.filterTask(attr.GetNamespaceId(), forceApply)
+	if err != nil || !doContinue {
+		return err
+	}
+
+	replicationStopWatch := e.metricsClient.StartTimer(metrics.SyncWorkflowStateTaskScope, metrics.ServiceLatency)
+	defer replicationStopWatch.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), replicationTimeout)
+	defer cancel()
+
+	err = e.historyEngine.SyncWorkflowState(ctx, attr)
+	switch retryErr := err.(type) {
+	case nil:
+		return nil
+
+	case *serviceerrors.RetryReplication:
+		e.metricsClient.IncCounter(metrics.HistoryRereplicationByWorkflowStateReplicationScope, metrics.ClientRequests)
+		stopwatch := e.metricsClient.StartTimer(metrics.HistoryRereplicationByWorkflowStateReplicationScope, metrics.ClientLatency)
+		defer stopwatch.Stop()
+
+		if resendErr := e.nDCHistoryResender.SendSingleWorkflowHistory(
+			retryErr.NamespaceId,
+			retryErr.WorkflowId,
+			retryErr.RunId,
+			retryErr.StartEventId,
+			retryErr.StartEventVersion,
+			retryErr.EndEventId,
+			retryErr.EndEventVersion,
+		); resendErr != nil {
+			e.logger.Error("error resend history for sync workflow state", tag.Error(resendErr))
+			// should return the replication error, not the resending error
+			return err
+		}
+		return e.historyEngine.SyncWorkflowState(ctx, attr)
+
+	default:
+		return err
+	}
+}
+
 func (e *replicationTaskExecutorImpl) handleHistoryReplicationTask(
 	task *replicationspb.ReplicationTask,
 	forceApply bool,
@@ -30,6 +30,9 @@ import (
 	"sync/atomic"
 	"time"
 
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding/gzip"
+
 	"go.temporal.io/server/api/adminservice/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
 	"go.temporal.io/server/client"
@@ -379,6 +382,35 @@ func (f *replicationTaskFetcherWorker) getMessages() error {
 	}
 	f.requestByShard = make(map[int32]*replicationTaskRequest, requestsMapSize)
 
+	maxShardsPerRequest := f.config.ReplicationTaskFetcherMaxShardsPerRequest()
+	if maxShardsPerRequest <= 0 {
+		maxShardsPerRequest = len(requestByShard)
+	}
+
+	var lastErr error
+	batch := make(map[int32]*replicationTaskRequest, maxShardsPerRequest)
+	for shardID, request := range requestByShard {
+		batch[shardID] = request
+		if len(batch) < maxShardsPerRequest {
+			continue
+		}
+		if err := f.getMessagesForShards(batch); err != nil {
+			lastErr = err
+		}
+		batch = make(map[int32]*replicationTaskRequest, maxShardsPerRequest)
+	}
+	if len(batch) > 0 {
+		if err := f.getMessagesForShards(batch); err != nil {
+			lastErr = err
+		}
+	}
+	return lastErr
+}
+
+func (f *replicationTaskFetcherWorker) getMessagesForShards(
+	requestByShard map[int32]*replicationTaskRequest,
+) error {
+
 	tokens := make([]*replicationspb.ReplicationToken, 0, len(requestByShard))
 	for _, request := range requestByShard {
 		tokens = append(tokens, request.token)
@@ -387,11 +419,17 @@ func (f *replicationTaskFetcherWorker) getMessages() error {
 	ctx, cancel := rpc.NewContextWithTimeoutAndHeaders(fetchTaskRequestTimeout)
 	defer cancel()
 
+	var opts []grpc.CallOption
+	if f.config.ReplicationTaskFetcherEnableCompression() {
+		// server side responds with the same compressor used by the request
+		opts = append(opts, grpc.UseCompressor(gzip.Name))
+	}
+
 	request := &adminservice.GetReplicationMessagesRequest{
 		Tokens:      tokens,
 		ClusterName: f.currentCluster,
 	}
-	response, err := f.remotePeer.GetReplicationMessages(ctx, request)
+	response, err := f.remotePeer.GetReplicationMessages(ctx, request, opts...)
 	if err != nil {
 		f.logger.Error("Failed to get replication tasks", tag.Error(err))
 		for _, req := range requestByShard {
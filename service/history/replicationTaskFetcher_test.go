@@ -295,6 +295,61 @@ func (s *replicationTaskFetcherSuite) TestGetMessages_Error() {
 	s.Equal((*replicationspb.ReplicationMessages)(nil), <-respChan2)
 }
 
+func (s *replicationTaskFetcherSuite) TestGetMessages_MaxShardsPerRequest() {
+	s.config.ReplicationTaskFetcherMaxShardsPerRequest = dynamicconfig.GetIntPropertyFn(1)
+
+	shardID1 := int32(1)
+	respChan1 := make(chan *replicationspb.ReplicationMessages, 1)
+	shardRequest1 := &replicationTaskRequest{
+		token: &replicationspb.ReplicationToken{
+			ShardId:                shardID1,
+			LastProcessedMessageId: 1,
+			LastRetrievedMessageId: 2,
+		},
+		respChan: respChan1,
+	}
+	shardID2 := int32(2)
+	respChan2 := make(chan *replicationspb.ReplicationMessages, 1)
+	shardRequest2 := &replicationTaskRequest{
+		token: &replicationspb.ReplicationToken{
+			ShardId:                shardID2,
+			LastProcessedMessageId: 1,
+			LastRetrievedMessageId: 2,
+		},
+		respChan: respChan2,
+	}
+	requestByShard := map[int32]*replicationTaskRequest{
+		shardID1: shardRequest1,
+		shardID2: shardRequest2,
+	}
+
+	responseByShard1 := map[int32]*replicationspb.ReplicationMessages{
+		shardID1: {LastRetrievedMessageId: 3},
+	}
+	responseByShard2 := map[int32]*replicationspb.ReplicationMessages{
+		shardID2: {LastRetrievedMessageId: 4},
+	}
+	s.frontendClient.EXPECT().GetReplicationMessages(
+		gomock.Any(),
+		newGetReplicationMessagesRequestMatcher(&adminservice.GetReplicationMessagesRequest{
+			Tokens:      []*replicationspb.ReplicationToken{shardRequest1.token},
+			ClusterName: cluster.TestCurrentClusterName,
+		}),
+	).Return(&adminservice.GetReplicationMessagesResponse{ShardMessages: responseByShard1}, nil)
+	s.frontendClient.EXPECT().GetReplicationMessages(
+		gomock.Any(),
+		newGetReplicationMessagesRequestMatcher(&adminservice.GetReplicationMessagesRequest{
+			Tokens:      []*replicationspb.ReplicationToken{shardRequest2.token},
+			ClusterName: cluster.TestCurrentClusterName,
+		}),
+	).Return(&adminservice.GetReplicationMessagesResponse{ShardMessages: responseByShard2}, nil)
+	s.replicationTaskFetcher.workers[0].requestByShard = requestByShard
+	err := s.replicationTaskFetcher.workers[0].getMessages()
+	s.NoError(err)
+	s.Equal(responseByShard1[shardID1], <-respChan1)
+	s.Equal(responseByShard2[shardID2], <-respChan2)
+}
+
 func (s *replicationTaskFetcherSuite) TestConcurrentFetchAndProcess_Success() {
 	numShards := 1024
 
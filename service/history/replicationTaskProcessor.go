@@ -372,6 +372,20 @@ func (p *ReplicationTaskProcessorImpl) convertTaskToDLQTask(
 			},
 		}, nil
 
+	case enumsspb.REPLICATION_TASK_TYPE_SYNC_WORKFLOW_STATE_TASK:
+		taskAttributes := replicationTask.GetSyncWorkflowStateTaskAttributes()
+		return &persistence.PutReplicationTaskToDLQRequest{
+			SourceClusterName: p.sourceCluster,
+			TaskInfo: &persistencespb.ReplicationTaskInfo{
+				NamespaceId: taskAttributes.GetNamespaceId(),
+				WorkflowId:  taskAttributes.GetWorkflowId(),
+				RunId:       taskAttributes.GetRunId(),
+				TaskId:      replicationTask.GetSourceTaskId(),
+				TaskType:    enumsspb.TASK_TYPE_REPLICATION_SYNC_WORKFLOW_STATE,
+				Version:     taskAttributes.GetVersion(),
+			},
+		}, nil
+
 	case enumsspb.REPLICATION_TASK_TYPE_HISTORY_V2_TASK:
 		taskAttributes := replicationTask.GetHistoryTaskV2Attributes()
 
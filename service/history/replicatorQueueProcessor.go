@@ -225,6 +225,9 @@ func (p *replicatorQueueProcessorImpl) toReplicationTask(
 	case enumsspb.TASK_TYPE_REPLICATION_SYNC_ACTIVITY:
 		return p.generateSyncActivityTask(ctx, task)
 
+	case enumsspb.TASK_TYPE_REPLICATION_SYNC_WORKFLOW_STATE:
+		return p.generateSyncWorkflowStateTask(ctx, task)
+
 	case enumsspb.TASK_TYPE_REPLICATION_HISTORY:
 		return p.generateHistoryReplicationTask(ctx, task)
 
@@ -298,6 +301,49 @@ func (p *replicatorQueueProcessorImpl) generateSyncActivityTask(
 	)
 }
 
+func (p *replicatorQueueProcessorImpl) generateSyncWorkflowStateTask(
+	ctx context.Context,
+	taskInfo *persistencespb.ReplicationTaskInfo,
+) (*replicationspb.ReplicationTask, error) {
+	namespaceID := taskInfo.GetNamespaceId()
+	workflowID := taskInfo.GetWorkflowId()
+	runID := taskInfo.GetRunId()
+	taskID := taskInfo.GetTaskId()
+	return p.processReplication(
+		ctx,
+		false, // not necessary to send out sync workflow state task if workflow closed
+		namespaceID,
+		workflowID,
+		runID,
+		func(mutableState mutableState) (*replicationspb.ReplicationTask, error) {
+			// the task carries the current workflow state, not the state at the time the task was created
+			executionInfo := mutableState.GetExecutionInfo()
+			versionHistory, err := versionhistory.GetCurrentVersionHistory(executionInfo.GetVersionHistories())
+			if err != nil {
+				return nil, err
+			}
+
+			return &replicationspb.ReplicationTask{
+				TaskType:     enumsspb.REPLICATION_TASK_TYPE_SYNC_WORKFLOW_STATE_TASK,
+				SourceTaskId: taskID,
+				Attributes: &replicationspb.ReplicationTask_SyncWorkflowStateTaskAttributes{
+					SyncWorkflowStateTaskAttributes: &replicationspb.SyncWorkflowStateTaskAttributes{
+						NamespaceId:          namespaceID,
+						WorkflowId:           workflowID,
+						RunId:                runID,
+						Version:              executionInfo.GetPauseStateVersion(),
+						VersionHistory:       versionHistory,
+						PauseInfo:            executionInfo.GetPauseInfo(),
+						PauseStateUpdateTime: executionInfo.GetPauseStateUpdateTime(),
+						Tags:                 executionInfo.GetTags(),
+						TagsVersion:          executionInfo.GetTagsVersion(),
+					},
+				},
+			}, nil
+		},
+	)
+}
+
 func (p *replicatorQueueProcessorImpl) generateHistoryReplicationTask(
 	ctx context.Context,
 	taskInfo *persistencespb.ReplicationTaskInfo,
@@ -40,6 +40,7 @@ import (
 	log "go.temporal.io/server/common/log"
 	metrics "go.temporal.io/server/common/metrics"
 	persistence "go.temporal.io/server/common/persistence"
+	primitives "go.temporal.io/server/common/primitives"
 	resource "go.temporal.io/server/common/resource"
 	configs "go.temporal.io/server/service/history/configs"
 	events "go.temporal.io/server/service/history/events"
@@ -324,6 +325,20 @@ func (mr *MockContextMockRecorder) GetHistoryManager() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistoryManager", reflect.TypeOf((*MockContext)(nil).GetHistoryManager))
 }
 
+// GetIDGenerator mocks base method.
+func (m *MockContext) GetIDGenerator() primitives.IDGenerator {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIDGenerator")
+	ret0, _ := ret[0].(primitives.IDGenerator)
+	return ret0
+}
+
+// GetIDGenerator indicates an expected call of GetIDGenerator.
+func (mr *MockContextMockRecorder) GetIDGenerator() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIDGenerator", reflect.TypeOf((*MockContext)(nil).GetIDGenerator))
+}
+
 // GetLastUpdatedTime mocks base method.
 func (m *MockContext) GetLastUpdatedTime() time.Time {
 	m.ctrl.T.Helper()
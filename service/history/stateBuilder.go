@@ -29,7 +29,6 @@ package history
 import (
 	"time"
 
-	"github.com/pborman/uuid"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
@@ -217,6 +216,7 @@ func (b *stateBuilderImpl) applyEvents(
 				attributes.GetScheduledEventId(),
 				event.GetEventId(),
 				attributes.GetRequestId(),
+				attributes.GetIdentity(),
 				timestamp.TimeValue(event.GetEventTime()),
 			)
 			if err != nil {
@@ -369,7 +369,7 @@ func (b *stateBuilderImpl) applyEvents(
 				event,
 				// create a new request ID which is used by transfer queue processor
 				// if namespace is failed over at this point
-				uuid.New(),
+				b.shard.GetIDGenerator().NewID(),
 			); err != nil {
 				return nil, err
 			}
@@ -436,7 +436,7 @@ func (b *stateBuilderImpl) applyEvents(
 				event,
 				// create a new request ID which is used by transfer queue processor
 				// if namespace is failed over at this point
-				uuid.New(),
+				b.shard.GetIDGenerator().NewID(),
 			); err != nil {
 				return nil, err
 			}
@@ -464,7 +464,7 @@ func (b *stateBuilderImpl) applyEvents(
 
 		case enumspb.EVENT_TYPE_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_INITIATED:
 			// Create a new request ID which is used by transfer queue processor if namespace is failed over at this point
-			signalRequestID := uuid.New()
+			signalRequestID := b.shard.GetIDGenerator().NewID()
 			if _, err := b.mutableState.ReplicateSignalExternalWorkflowExecutionInitiatedEvent(
 				firstEvent.GetEventId(),
 				event,
@@ -610,7 +610,7 @@ func (b *stateBuilderImpl) applyEvents(
 				}
 				_, err := newRunStateBuilder.applyEvents(
 					namespaceID,
-					uuid.New(),
+					b.shard.GetIDGenerator().NewID(),
 					newExecution,
 					newRunHistory,
 					nil,
@@ -128,6 +128,10 @@ func (t *timerQueueActiveTaskExecutor) executeUserTimerTimeoutTask(
 	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	// user timers of a workflow paused with timers frozen are pushed out and regenerated on unpause
+	if mutableState.GetExecutionInfo().PauseInfo.GetFreezeTimers() {
+		return nil
+	}
 
 	timerSequence := t.getTimerSequence(mutableState)
 	referenceTime := t.shard.GetTimeSource().Now()
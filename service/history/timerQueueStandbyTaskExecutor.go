@@ -123,6 +123,11 @@ func (t *timerQueueStandbyTaskExecutor) executeUserTimerTimeoutTask(
 
 	actionFn := func(context workflowExecutionContext, mutableState mutableState) (interface{}, error) {
 
+		// user timers of a workflow paused with timers frozen are pushed out and regenerated on unpause
+		if mutableState.GetExecutionInfo().PauseInfo.GetFreezeTimers() {
+			return nil, nil
+		}
+
 		timerSequence := t.getTimerSequence(mutableState)
 
 	Loop:
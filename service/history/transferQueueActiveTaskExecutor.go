@@ -29,7 +29,6 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/pborman/uuid"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
@@ -37,6 +36,7 @@ import (
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	"go.uber.org/multierr"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
@@ -45,6 +45,7 @@ import (
 	"go.temporal.io/server/client/history"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
@@ -310,9 +311,15 @@ func (t *transferQueueActiveTaskExecutor) processCloseExecution(
 
 	// Communicate the result to parent execution if this is Child Workflow execution
 	if replyToParentWorkflow {
+		var parentHistoryClient targetHistoryClient
+		parentHistoryClient, _, err = t.getTargetHistoryClient(namespace, parentNamespaceID)
+		if err != nil {
+			return err
+		}
+
 		ctx, cancel := context.WithTimeout(context.Background(), transferActiveTaskDefaultTimeout)
 		defer cancel()
-		_, err = t.historyClient.RecordChildExecutionCompleted(ctx, &historyservice.RecordChildExecutionCompletedRequest{
+		_, err = parentHistoryClient.RecordChildExecutionCompleted(ctx, &historyservice.RecordChildExecutionCompletedRequest{
 			NamespaceId: parentNamespaceID,
 			WorkflowExecution: &commonpb.WorkflowExecution{
 				WorkflowId: parentWorkflowID,
@@ -475,8 +482,17 @@ func (t *transferQueueActiveTaskExecutor) processSignalExecution(
 		)
 	}
 
+	targetClient, crossCluster, err := t.getTargetHistoryClient(
+		mutableState.GetNamespaceEntry().GetInfo().Name,
+		task.GetTargetNamespaceId(),
+	)
+	if err != nil {
+		return err
+	}
+
 	if err = t.signalExternalExecutionWithRetry(
 		task,
+		targetClient,
 		targetNamespace,
 		signalInfo,
 	); err != nil {
@@ -484,7 +500,12 @@ func (t *transferQueueActiveTaskExecutor) processSignalExecution(
 
 		// Check to see if the error is non-transient, in which case add SignalFailed
 		// event and complete transfer task by setting the err = nil
-		if !common.IsServiceNonRetryableError(err) || common.IsContextDeadlineExceededErr(err) {
+		if crossCluster {
+			if !isCrossClusterTaskPermanentError(err) {
+				// target namespace may be failing over, retry until it is active in either cluster
+				return err
+			}
+		} else if !common.IsServiceNonRetryableError(err) || common.IsContextDeadlineExceededErr(err) {
 			// for retryable error just return
 			return err
 		}
@@ -521,7 +542,7 @@ func (t *transferQueueActiveTaskExecutor) processSignalExecution(
 	// remove signalRequestedID from target workflow, after Signal detail is removed from source workflow
 	ctx, cancel := context.WithTimeout(context.Background(), transferActiveTaskDefaultTimeout)
 	defer cancel()
-	_, err = t.historyClient.RemoveSignalMutableState(ctx, &historyservice.RemoveSignalMutableStateRequest{
+	_, err = targetClient.RemoveSignalMutableState(ctx, &historyservice.RemoveSignalMutableStateRequest{
 		NamespaceId: task.GetTargetNamespaceId(),
 		WorkflowExecution: &commonpb.WorkflowExecution{
 			WorkflowId: task.GetTargetWorkflowId(),
@@ -564,6 +585,11 @@ func (t *transferQueueActiveTaskExecutor) processStartChildExecution(
 		namespace = namespaceEntry.GetInfo().Name
 	}
 
+	targetClient, crossCluster, err := t.getTargetHistoryClient(namespace, task.GetTargetNamespaceId())
+	if err != nil {
+		return err
+	}
+
 	// Get target namespace name
 	var targetNamespace string
 	if namespaceEntry, err := t.shard.GetNamespaceCache().GetNamespaceByID(task.GetTargetNamespaceId()); err != nil {
@@ -597,12 +623,13 @@ func (t *transferQueueActiveTaskExecutor) processStartChildExecution(
 			WorkflowId: childInfo.StartedWorkflowId,
 			RunId:      childInfo.StartedRunId,
 		}
-		return t.createFirstWorkflowTask(task.GetTargetNamespaceId(), childExecution)
+		return t.createFirstWorkflowTask(targetClient, task.GetTargetNamespaceId(), childExecution)
 	}
 
 	attributes := initiatedEvent.GetStartChildWorkflowExecutionInitiatedEventAttributes()
 	childRunID, err := t.startWorkflowWithRetry(
 		task,
+		targetClient,
 		namespace,
 		targetNamespace,
 		childInfo,
@@ -614,7 +641,9 @@ func (t *transferQueueActiveTaskExecutor) processStartChildExecution(
 		// Check to see if the error is non-transient, in which case add StartChildWorkflowExecutionFailed
 		// event and complete transfer task by setting the err = nil
 		if _, ok := err.(*serviceerror.WorkflowExecutionAlreadyStarted); ok {
-			err = t.recordStartChildExecutionFailed(task, context, attributes)
+			err = t.recordStartChildExecutionFailed(task, context, attributes, enumspb.START_CHILD_WORKFLOW_EXECUTION_FAILED_CAUSE_WORKFLOW_ALREADY_EXISTS)
+		} else if crossCluster && isCrossClusterTaskPermanentError(err) {
+			err = t.recordStartChildExecutionFailed(task, context, attributes, enumspb.START_CHILD_WORKFLOW_EXECUTION_FAILED_CAUSE_UNSPECIFIED)
 		}
 
 		return err
@@ -630,7 +659,7 @@ func (t *transferQueueActiveTaskExecutor) processStartChildExecution(
 		return err
 	}
 	// Finally create first workflow task for Child execution so it is really started
-	return t.createFirstWorkflowTask(task.GetTargetNamespaceId(), &commonpb.WorkflowExecution{
+	return t.createFirstWorkflowTask(targetClient, task.GetTargetNamespaceId(), &commonpb.WorkflowExecution{
 		WorkflowId: task.GetTargetWorkflowId(),
 		RunId:      childRunID,
 	})
@@ -889,6 +918,7 @@ func (t *transferQueueActiveTaskExecutor) recordStartChildExecutionFailed(
 	task *persistencespb.TransferTaskInfo,
 	context workflowExecutionContext,
 	initiatedAttributes *historypb.StartChildWorkflowExecutionInitiatedEventAttributes,
+	cause enumspb.StartChildWorkflowExecutionFailedCause,
 ) error {
 
 	return t.updateWorkflowExecution(context, true,
@@ -903,8 +933,7 @@ func (t *transferQueueActiveTaskExecutor) recordStartChildExecutionFailed(
 				return serviceerror.NewNotFound("Pending child execution not found.")
 			}
 
-			_, err := mutableState.AddStartChildWorkflowExecutionFailedEvent(initiatedEventID,
-				enumspb.START_CHILD_WORKFLOW_EXECUTION_FAILED_CAUSE_WORKFLOW_ALREADY_EXISTS, initiatedAttributes)
+			_, err := mutableState.AddStartChildWorkflowExecutionFailedEvent(initiatedEventID, cause, initiatedAttributes)
 
 			return err
 		})
@@ -913,13 +942,14 @@ func (t *transferQueueActiveTaskExecutor) recordStartChildExecutionFailed(
 // createFirstWorkflowTask is used by StartChildExecution transfer task to create the first workflow task for
 // child execution.
 func (t *transferQueueActiveTaskExecutor) createFirstWorkflowTask(
+	historyClient targetHistoryClient,
 	namespaceID string,
 	execution *commonpb.WorkflowExecution,
 ) error {
 
 	ctx, cancel := context.WithTimeout(context.Background(), transferActiveTaskDefaultTimeout)
 	defer cancel()
-	_, err := t.historyClient.ScheduleWorkflowTask(ctx, &historyservice.ScheduleWorkflowTaskRequest{
+	_, err := historyClient.ScheduleWorkflowTask(ctx, &historyservice.ScheduleWorkflowTaskRequest{
 		NamespaceId:         namespaceID,
 		WorkflowExecution:   execution,
 		IsFirstWorkflowTask: true,
@@ -1164,6 +1194,7 @@ func (t *transferQueueActiveTaskExecutor) requestCancelExternalExecutionWithRetr
 
 func (t *transferQueueActiveTaskExecutor) signalExternalExecutionWithRetry(
 	task *persistencespb.TransferTaskInfo,
+	historyClient targetHistoryClient,
 	targetNamespace string,
 	signalInfo *persistencespb.SignalInfo,
 ) error {
@@ -1193,7 +1224,7 @@ func (t *transferQueueActiveTaskExecutor) signalExternalExecutionWithRetry(
 	ctx, cancel := context.WithTimeout(context.Background(), transferActiveTaskDefaultTimeout)
 	defer cancel()
 	op := func() error {
-		_, err := t.historyClient.SignalWorkflowExecution(ctx, request)
+		_, err := historyClient.SignalWorkflowExecution(ctx, request)
 		return err
 	}
 
@@ -1202,6 +1233,7 @@ func (t *transferQueueActiveTaskExecutor) signalExternalExecutionWithRetry(
 
 func (t *transferQueueActiveTaskExecutor) startWorkflowWithRetry(
 	task *persistencespb.TransferTaskInfo,
+	historyClient targetHistoryClient,
 	namespace string,
 	targetNamespace string,
 	childInfo *persistencespb.ChildExecutionInfo,
@@ -1245,7 +1277,7 @@ func (t *transferQueueActiveTaskExecutor) startWorkflowWithRetry(
 	var response *historyservice.StartWorkflowExecutionResponse
 	var err error
 	op := func() error {
-		response, err = t.historyClient.StartWorkflowExecution(ctx, request)
+		response, err = historyClient.StartWorkflowExecution(ctx, request)
 		return err
 	}
 
@@ -1276,7 +1308,7 @@ func (t *transferQueueActiveTaskExecutor) resetWorkflow(
 	workflowID := task.GetWorkflowId()
 	baseRunID := baseMutableState.GetExecutionState().GetRunId()
 
-	resetRunID := uuid.New()
+	resetRunID := t.shard.GetIDGenerator().NewID()
 	baseRebuildLastEventID := resetPoint.GetFirstWorkflowTaskCompletedId() - 1
 	baseVersionHistories := baseMutableState.GetExecutionInfo().GetVersionHistories()
 	baseCurrentVersionHistory, err := versionhistory.GetCurrentVersionHistory(baseVersionHistories)
@@ -1300,7 +1332,7 @@ func (t *transferQueueActiveTaskExecutor) resetWorkflow(
 		baseRebuildLastEventVersion,
 		baseNextEventID,
 		resetRunID,
-		uuid.New(),
+		t.shard.GetIDGenerator().NewID(),
 		newNDCWorkflow(
 			ctx,
 			t.shard.GetNamespaceCache(),
@@ -1331,6 +1363,71 @@ func (t *transferQueueActiveTaskExecutor) resetWorkflow(
 	}
 }
 
+// getTargetHistoryClient returns the client for the calls of a transfer task to the workflows of the target namespace,
+// along with whether the calls are sent to the remote cluster in which the target namespace is active
+func (t *transferQueueActiveTaskExecutor) getTargetHistoryClient(
+	namespace string,
+	targetNamespaceID string,
+) (targetHistoryClient, bool, error) {
+
+	if !t.config.EnableCrossClusterOperations(namespace) {
+		return t.historyClient, false, nil
+	}
+
+	targetNamespaceEntry, err := t.shard.GetNamespaceCache().GetNamespaceByID(targetNamespaceID)
+	if err != nil {
+		if _, ok := err.(*serviceerror.NotFound); ok {
+			// let the history service fail the calls, same as without cross cluster operations
+			return t.historyClient, false, nil
+		}
+		return nil, false, err
+	}
+
+	activeCluster, ok := t.getRemoteActiveCluster(targetNamespaceEntry)
+	if !ok {
+		return t.historyClient, false, nil
+	}
+	return newCrossClusterHistoryClient(
+		t.shard.GetService().GetClusterMetadata().GetCurrentClusterName(),
+		t.shard.GetService().GetClientBean().GetRemoteAdminClient(activeCluster),
+	), true, nil
+}
+
+// getRemoteActiveCluster returns the cluster the target namespace is active in,
+// if it is a global namespace which is active in a remote cluster
+func (t *transferQueueActiveTaskExecutor) getRemoteActiveCluster(
+	targetNamespaceEntry *cache.NamespaceCacheEntry,
+) (string, bool) {
+
+	currentCluster := t.shard.GetService().GetClusterMetadata().GetCurrentClusterName()
+	activeCluster := targetNamespaceEntry.GetReplicationConfig().GetActiveClusterName()
+	if !targetNamespaceEntry.IsGlobalNamespace() || activeCluster == currentCluster {
+		return "", false
+	}
+	return activeCluster, true
+}
+
+// getRemoteChildActiveCluster returns the cluster a child is closed in by the parent close policy,
+// if cross cluster operations are enabled and the namespace of the child is active in a remote cluster
+func (t *transferQueueActiveTaskExecutor) getRemoteChildActiveCluster(
+	namespace string,
+	childInfo *persistencespb.ChildExecutionInfo,
+) (string, bool, error) {
+
+	if !t.config.EnableCrossClusterOperations(namespace) || childInfo.GetNamespace() == "" {
+		return "", false, nil
+	}
+	childNamespaceEntry, err := t.shard.GetNamespaceCache().GetNamespace(childInfo.GetNamespace())
+	if err != nil {
+		if _, ok := err.(*serviceerror.NotFound); ok {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+	activeCluster, ok := t.getRemoteActiveCluster(childNamespaceEntry)
+	return activeCluster, ok, nil
+}
+
 func (t *transferQueueActiveTaskExecutor) processParentClosePolicy(
 	namespaceID string,
 	namespace string,
@@ -1343,6 +1440,50 @@ func (t *transferQueueActiveTaskExecutor) processParentClosePolicy(
 
 	scope := t.metricsClient.Scope(metrics.TransferActiveTaskCloseExecutionScope)
 
+	// children active in a remote cluster can't be reached by the local history service
+	// or the parent close policy worker, so the policy is applied through the remote frontend
+	localChildInfos := make(map[int64]*persistencespb.ChildExecutionInfo, len(childInfos))
+	remoteChildClusters := make(map[int64]string)
+	for initiatedID, childInfo := range childInfos {
+		activeCluster, remote, err := t.getRemoteChildActiveCluster(namespace, childInfo)
+		if err != nil {
+			return err
+		}
+		if remote {
+			remoteChildClusters[initiatedID] = activeCluster
+		} else {
+			localChildInfos[initiatedID] = childInfo
+		}
+	}
+
+	// local children are handled first so that a failing remote cluster does not hold them back,
+	// and a failure of one remote child does not prevent the policy being applied to the others
+	err := t.processLocalParentClosePolicy(namespaceID, namespace, localChildInfos)
+	for initiatedID, activeCluster := range remoteChildClusters {
+		if remoteErr := t.applyRemoteParentClosePolicy(activeCluster, childInfos[initiatedID]); remoteErr != nil {
+			if _, ok := remoteErr.(*serviceerror.NotFound); !ok {
+				scope.IncCounter(metrics.ParentClosePolicyProcessorFailures)
+				err = multierr.Append(err, remoteErr)
+				continue
+			}
+		}
+		scope.IncCounter(metrics.ParentClosePolicyProcessorSuccess)
+	}
+	return err
+}
+
+func (t *transferQueueActiveTaskExecutor) processLocalParentClosePolicy(
+	namespaceID string,
+	namespace string,
+	childInfos map[int64]*persistencespb.ChildExecutionInfo,
+) error {
+
+	if len(childInfos) == 0 {
+		return nil
+	}
+
+	scope := t.metricsClient.Scope(metrics.TransferActiveTaskCloseExecutionScope)
+
 	if t.shard.GetConfig().EnableParentClosePolicyWorker() &&
 		len(childInfos) >= t.shard.GetConfig().ParentClosePolicyThreshold(namespace) {
 
@@ -1440,3 +1581,49 @@ func (t *transferQueueActiveTaskExecutor) applyParentClosePolicy(
 		}
 	}
 }
+
+// applyRemoteParentClosePolicy applies the parent close policy to a child in a namespace
+// which is active in a remote cluster, through the frontend of that cluster
+func (t *transferQueueActiveTaskExecutor) applyRemoteParentClosePolicy(
+	activeCluster string,
+	childInfo *persistencespb.ChildExecutionInfo,
+) error {
+
+	ctx, cancel := context.WithTimeout(context.Background(), transferActiveTaskDefaultTimeout)
+	defer cancel()
+
+	frontendClient := t.shard.GetService().GetClientBean().GetRemoteFrontendClient(activeCluster)
+	switch childInfo.ParentClosePolicy {
+	case enumspb.PARENT_CLOSE_POLICY_ABANDON:
+		// noop
+		return nil
+
+	case enumspb.PARENT_CLOSE_POLICY_TERMINATE:
+		_, err := frontendClient.TerminateWorkflowExecution(ctx, &workflowservice.TerminateWorkflowExecutionRequest{
+			Namespace: childInfo.GetNamespace(),
+			WorkflowExecution: &commonpb.WorkflowExecution{
+				WorkflowId: childInfo.StartedWorkflowId,
+			},
+			FirstExecutionRunId: childInfo.StartedRunId,
+			Reason:              "by parent close policy",
+			Identity:            identityHistoryService,
+		})
+		return err
+
+	case enumspb.PARENT_CLOSE_POLICY_REQUEST_CANCEL:
+		_, err := frontendClient.RequestCancelWorkflowExecution(ctx, &workflowservice.RequestCancelWorkflowExecutionRequest{
+			Namespace: childInfo.GetNamespace(),
+			WorkflowExecution: &commonpb.WorkflowExecution{
+				WorkflowId: childInfo.StartedWorkflowId,
+			},
+			FirstExecutionRunId: childInfo.StartedRunId,
+			Identity:            identityHistoryService,
+		})
+		return err
+
+	default:
+		return &serviceerror.Internal{
+			Message: fmt.Sprintf("unknown parent close policy: %v", childInfo.ParentClosePolicy),
+		}
+	}
+}
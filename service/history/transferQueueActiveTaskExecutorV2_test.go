@@ -48,6 +48,8 @@ import (
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/api/workflowservice/v1"
 
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/adminservicemock/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
@@ -89,6 +91,7 @@ type (
 		mockMatchingClient       *matchingservicemock.MockMatchingServiceClient
 		mockHistoryClient        *historyservicemock.MockHistoryServiceClient
 		mockClusterMetadata      *cluster.MockMetadata
+		mockAdminClient          *adminservicemock.MockAdminServiceClient
 
 		mockVisibilityMgr           *mocks.VisibilityManager
 		mockExecutionMgr            *persistence.MockExecutionManager
@@ -185,6 +188,7 @@ func (s *transferQueueActiveTaskExecutorSuiteV2) SetupTest() {
 	s.mockHistoryMgr = s.mockShard.Resource.HistoryMgr
 	s.mockVisibilityMgr = s.mockShard.Resource.VisibilityMgr
 	s.mockClusterMetadata = s.mockShard.Resource.ClusterMetadata
+	s.mockAdminClient = s.mockShard.Resource.RemoteAdminClient
 	s.mockArchivalMetadata = s.mockShard.Resource.ArchivalMetadata
 	s.mockArchiverProvider = s.mockShard.Resource.ArchiverProvider
 	s.mockNamespaceCache = s.mockShard.Resource.NamespaceCache
@@ -894,6 +898,180 @@ func (s *transferQueueActiveTaskExecutorSuiteV2) TestProcessCloseExecution_NoPar
 	s.Nil(err)
 }
 
+func (s *transferQueueActiveTaskExecutorSuiteV2) TestProcessCloseExecution_NoParent_HasRemoteChild() {
+
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: "some random workflow ID",
+		RunId:      uuid.New(),
+	}
+	workflowType := "some random workflow type"
+	taskQueueName := "some random task queue"
+
+	remoteChildNamespaceID := uuid.New()
+	remoteChildNamespace := "some random remote child namespace"
+	s.mockNamespaceCache.EXPECT().GetNamespace(remoteChildNamespace).Return(s.newRemoteNamespaceEntry(remoteChildNamespaceID, remoteChildNamespace), nil).AnyTimes()
+	s.transferQueueActiveTaskExecutor.config.EnableCrossClusterOperations = dc.GetBoolPropertyFnFilteredByNamespace(true)
+
+	mutableState := newMutableStateBuilderWithVersionHistoriesForTest(s.mockShard, s.mockShard.GetEventsCache(), s.logger, s.version, execution.GetRunId())
+	_, err := mutableState.AddWorkflowExecutionStartedEvent(
+		execution,
+		&historyservice.StartWorkflowExecutionRequest{
+			Attempt:     1,
+			NamespaceId: s.namespaceID,
+			StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+				WorkflowType:             &commonpb.WorkflowType{Name: workflowType},
+				TaskQueue:                &taskqueuepb.TaskQueue{Name: taskQueueName},
+				WorkflowExecutionTimeout: timestamp.DurationPtr(2 * time.Second),
+				WorkflowTaskTimeout:      timestamp.DurationPtr(1 * time.Second),
+			},
+		},
+	)
+	s.Nil(err)
+
+	di := addWorkflowTaskScheduledEvent(mutableState)
+	event := addWorkflowTaskStartedEvent(mutableState, di.ScheduleID, taskQueueName, uuid.New())
+	di.StartedID = event.GetEventId()
+	event = addWorkflowTaskCompletedEvent(mutableState, di.ScheduleID, di.StartedID, "some random identity")
+
+	_, _, err = mutableState.AddStartChildWorkflowExecutionInitiatedEvent(event.GetEventId(), uuid.New(), &commandpb.StartChildWorkflowExecutionCommandAttributes{
+		WorkflowId: "local child workflow",
+		WorkflowType: &commonpb.WorkflowType{
+			Name: "child workflow type",
+		},
+		TaskQueue:         &taskqueuepb.TaskQueue{Name: taskQueueName},
+		ParentClosePolicy: enumspb.PARENT_CLOSE_POLICY_TERMINATE,
+	})
+	s.Nil(err)
+	_, _, err = mutableState.AddStartChildWorkflowExecutionInitiatedEvent(event.GetEventId(), uuid.New(), &commandpb.StartChildWorkflowExecutionCommandAttributes{
+		Namespace:  remoteChildNamespace,
+		WorkflowId: "remote child workflow",
+		WorkflowType: &commonpb.WorkflowType{
+			Name: "child workflow type",
+		},
+		TaskQueue:         &taskqueuepb.TaskQueue{Name: taskQueueName},
+		ParentClosePolicy: enumspb.PARENT_CLOSE_POLICY_TERMINATE,
+	})
+	s.Nil(err)
+
+	taskID := int64(59)
+	event = addCompleteWorkflowEvent(mutableState, event.GetEventId(), nil)
+
+	transferTask := &persistencespb.TransferTaskInfo{
+		Version:     s.version,
+		NamespaceId: s.namespaceID,
+		WorkflowId:  execution.GetWorkflowId(),
+		RunId:       execution.GetRunId(),
+		TaskId:      taskID,
+		TaskQueue:   taskQueueName,
+		TaskType:    enumsspb.TASK_TYPE_TRANSFER_CLOSE_EXECUTION,
+		ScheduleId:  event.GetEventId(),
+	}
+
+	persistenceMutableState := s.createPersistenceMutableState(mutableState, event.GetEventId(), event.GetVersion())
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any()).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockArchivalMetadata.EXPECT().GetVisibilityConfig().Return(archiver.NewDisabledArchvialConfig())
+	s.mockHistoryClient.EXPECT().TerminateWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+	s.mockShard.Resource.RemoteFrontendClient.EXPECT().TerminateWorkflowExecution(gomock.Any(), &workflowservice.TerminateWorkflowExecutionRequest{
+		Namespace: remoteChildNamespace,
+		WorkflowExecution: &commonpb.WorkflowExecution{
+			WorkflowId: "remote child workflow",
+		},
+		Reason:   "by parent close policy",
+		Identity: identityHistoryService,
+	}).Return(&workflowservice.TerminateWorkflowExecutionResponse{}, nil).Times(1)
+
+	err = s.transferQueueActiveTaskExecutor.execute(transferTask, true)
+	s.Nil(err)
+}
+
+func (s *transferQueueActiveTaskExecutorSuiteV2) TestProcessCloseExecution_NoParent_HasRemoteChild_RemoteFailed() {
+
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: "some random workflow ID",
+		RunId:      uuid.New(),
+	}
+	workflowType := "some random workflow type"
+	taskQueueName := "some random task queue"
+
+	remoteChildNamespaceID := uuid.New()
+	remoteChildNamespace := "some random remote child namespace"
+	s.mockNamespaceCache.EXPECT().GetNamespace(remoteChildNamespace).Return(s.newRemoteNamespaceEntry(remoteChildNamespaceID, remoteChildNamespace), nil).AnyTimes()
+	s.transferQueueActiveTaskExecutor.config.EnableCrossClusterOperations = dc.GetBoolPropertyFnFilteredByNamespace(true)
+
+	mutableState := newMutableStateBuilderWithVersionHistoriesForTest(s.mockShard, s.mockShard.GetEventsCache(), s.logger, s.version, execution.GetRunId())
+	_, err := mutableState.AddWorkflowExecutionStartedEvent(
+		execution,
+		&historyservice.StartWorkflowExecutionRequest{
+			Attempt:     1,
+			NamespaceId: s.namespaceID,
+			StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+				WorkflowType:             &commonpb.WorkflowType{Name: workflowType},
+				TaskQueue:                &taskqueuepb.TaskQueue{Name: taskQueueName},
+				WorkflowExecutionTimeout: timestamp.DurationPtr(2 * time.Second),
+				WorkflowTaskTimeout:      timestamp.DurationPtr(1 * time.Second),
+			},
+		},
+	)
+	s.Nil(err)
+
+	di := addWorkflowTaskScheduledEvent(mutableState)
+	event := addWorkflowTaskStartedEvent(mutableState, di.ScheduleID, taskQueueName, uuid.New())
+	di.StartedID = event.GetEventId()
+	event = addWorkflowTaskCompletedEvent(mutableState, di.ScheduleID, di.StartedID, "some random identity")
+
+	_, _, err = mutableState.AddStartChildWorkflowExecutionInitiatedEvent(event.GetEventId(), uuid.New(), &commandpb.StartChildWorkflowExecutionCommandAttributes{
+		WorkflowId: "local child workflow",
+		WorkflowType: &commonpb.WorkflowType{
+			Name: "child workflow type",
+		},
+		TaskQueue:         &taskqueuepb.TaskQueue{Name: taskQueueName},
+		ParentClosePolicy: enumspb.PARENT_CLOSE_POLICY_TERMINATE,
+	})
+	s.Nil(err)
+	_, _, err = mutableState.AddStartChildWorkflowExecutionInitiatedEvent(event.GetEventId(), uuid.New(), &commandpb.StartChildWorkflowExecutionCommandAttributes{
+		Namespace:  remoteChildNamespace,
+		WorkflowId: "remote child workflow",
+		WorkflowType: &commonpb.WorkflowType{
+			Name: "child workflow type",
+		},
+		TaskQueue:         &taskqueuepb.TaskQueue{Name: taskQueueName},
+		ParentClosePolicy: enumspb.PARENT_CLOSE_POLICY_TERMINATE,
+	})
+	s.Nil(err)
+
+	taskID := int64(59)
+	event = addCompleteWorkflowEvent(mutableState, event.GetEventId(), nil)
+
+	transferTask := &persistencespb.TransferTaskInfo{
+		Version:     s.version,
+		NamespaceId: s.namespaceID,
+		WorkflowId:  execution.GetWorkflowId(),
+		RunId:       execution.GetRunId(),
+		TaskId:      taskID,
+		TaskQueue:   taskQueueName,
+		TaskType:    enumsspb.TASK_TYPE_TRANSFER_CLOSE_EXECUTION,
+		ScheduleId:  event.GetEventId(),
+	}
+
+	persistenceMutableState := s.createPersistenceMutableState(mutableState, event.GetEventId(), event.GetVersion())
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any()).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockArchivalMetadata.EXPECT().GetVisibilityConfig().Return(archiver.NewDisabledArchvialConfig())
+	// the local child is still terminated when the remote cluster is unavailable
+	remoteErr := serviceerror.NewUnavailable("remote cluster unavailable")
+	s.mockHistoryClient.EXPECT().TerminateWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+	s.mockShard.Resource.RemoteFrontendClient.EXPECT().TerminateWorkflowExecution(gomock.Any(), &workflowservice.TerminateWorkflowExecutionRequest{
+		Namespace: remoteChildNamespace,
+		WorkflowExecution: &commonpb.WorkflowExecution{
+			WorkflowId: "remote child workflow",
+		},
+		Reason:   "by parent close policy",
+		Identity: identityHistoryService,
+	}).Return(nil, remoteErr).Times(1)
+
+	err = s.transferQueueActiveTaskExecutor.execute(transferTask, true)
+	s.Equal(remoteErr, err)
+}
+
 func (s *transferQueueActiveTaskExecutorSuiteV2) TestProcessCloseExecution_NoParent_HasManyChildren() {
 
 	execution := commonpb.WorkflowExecution{
@@ -1405,6 +1583,174 @@ func (s *transferQueueActiveTaskExecutorSuiteV2) TestProcessSignalExecution_Fail
 	s.Nil(err)
 }
 
+func (s *transferQueueActiveTaskExecutorSuiteV2) TestProcessSignalExecution_CrossCluster() {
+
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: "some random workflow ID",
+		RunId:      uuid.New(),
+	}
+	workflowType := "some random workflow type"
+	taskQueueName := "some random task queue"
+
+	targetExecution := commonpb.WorkflowExecution{
+		WorkflowId: "some random target workflow ID",
+		RunId:      uuid.New(),
+	}
+	signalName := "some random signal name"
+	signalInput := payloads.EncodeString("some random signal input")
+	signalControl := "some random signal control"
+
+	remoteTargetNamespaceID := uuid.New()
+	remoteTargetNamespace := "some random remote target namespace"
+	s.mockNamespaceCache.EXPECT().GetNamespaceByID(remoteTargetNamespaceID).Return(s.newRemoteNamespaceEntry(remoteTargetNamespaceID, remoteTargetNamespace), nil).AnyTimes()
+	s.mockNamespaceCache.EXPECT().GetNamespace(remoteTargetNamespace).Return(s.newRemoteNamespaceEntry(remoteTargetNamespaceID, remoteTargetNamespace), nil).AnyTimes()
+	s.transferQueueActiveTaskExecutor.config.EnableCrossClusterOperations = dc.GetBoolPropertyFnFilteredByNamespace(true)
+
+	mutableState := newMutableStateBuilderWithVersionHistoriesForTest(s.mockShard, s.mockShard.GetEventsCache(), s.logger, s.version, execution.GetRunId())
+	_, err := mutableState.AddWorkflowExecutionStartedEvent(
+		execution,
+		&historyservice.StartWorkflowExecutionRequest{
+			Attempt:     1,
+			NamespaceId: s.namespaceID,
+			StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+				WorkflowType:             &commonpb.WorkflowType{Name: workflowType},
+				TaskQueue:                &taskqueuepb.TaskQueue{Name: taskQueueName},
+				WorkflowExecutionTimeout: timestamp.DurationPtr(2 * time.Second),
+				WorkflowTaskTimeout:      timestamp.DurationPtr(1 * time.Second),
+			},
+		},
+	)
+	s.Nil(err)
+
+	di := addWorkflowTaskScheduledEvent(mutableState)
+	event := addWorkflowTaskStartedEvent(mutableState, di.ScheduleID, taskQueueName, uuid.New())
+	di.StartedID = event.GetEventId()
+	event = addWorkflowTaskCompletedEvent(mutableState, di.ScheduleID, di.StartedID, "some random identity")
+
+	taskID := int64(59)
+	event, si := addRequestSignalInitiatedEvent(mutableState, event.GetEventId(), uuid.New(),
+		remoteTargetNamespace, targetExecution.GetWorkflowId(), targetExecution.GetRunId(), signalName, signalInput, signalControl)
+
+	transferTask := &persistencespb.TransferTaskInfo{
+		Version:           s.version,
+		NamespaceId:       s.namespaceID,
+		WorkflowId:        execution.GetWorkflowId(),
+		RunId:             execution.GetRunId(),
+		TargetNamespaceId: remoteTargetNamespaceID,
+		TargetWorkflowId:  targetExecution.GetWorkflowId(),
+		TargetRunId:       targetExecution.GetRunId(),
+		TaskId:            taskID,
+		TaskQueue:         taskQueueName,
+		TaskType:          enumsspb.TASK_TYPE_TRANSFER_SIGNAL_EXECUTION,
+		ScheduleId:        event.GetEventId(),
+	}
+
+	signalRequest := s.createSignalWorkflowExecutionRequest(remoteTargetNamespace, transferTask, si)
+	persistenceMutableState := s.createPersistenceMutableState(mutableState, event.GetEventId(), event.GetVersion())
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any()).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockAdminClient.EXPECT().ExecuteCrossClusterTask(gomock.Any(), &adminservice.ExecuteCrossClusterTaskRequest{
+		SourceCluster: cluster.TestCurrentClusterName,
+		Attributes: &adminservice.ExecuteCrossClusterTaskRequest_SignalWorkflowExecutionTaskAttributes{
+			SignalWorkflowExecutionTaskAttributes: &adminservice.SignalWorkflowExecutionTaskAttributes{
+				NamespaceId:               signalRequest.GetNamespaceId(),
+				SignalRequest:             signalRequest.GetSignalRequest(),
+				ExternalWorkflowExecution: signalRequest.GetExternalWorkflowExecution(),
+				ChildWorkflowOnly:         signalRequest.GetChildWorkflowOnly(),
+			},
+		},
+	}).Return(&adminservice.ExecuteCrossClusterTaskResponse{}, nil).Times(1)
+	s.mockHistoryMgr.EXPECT().AppendHistoryNodes(gomock.Any()).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Times(1)
+	s.mockExecutionMgr.EXPECT().UpdateWorkflowExecution(gomock.Any()).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Times(1)
+	s.mockClusterMetadata.EXPECT().ClusterNameForFailoverVersion(s.version).Return(cluster.TestCurrentClusterName).AnyTimes()
+
+	s.mockAdminClient.EXPECT().ExecuteCrossClusterTask(gomock.Any(), &adminservice.ExecuteCrossClusterTaskRequest{
+		SourceCluster: cluster.TestCurrentClusterName,
+		Attributes: &adminservice.ExecuteCrossClusterTaskRequest_RemoveSignalMutableStateTaskAttributes{
+			RemoveSignalMutableStateTaskAttributes: &adminservice.RemoveSignalMutableStateTaskAttributes{
+				NamespaceId:       remoteTargetNamespaceID,
+				WorkflowExecution: &targetExecution,
+				RequestId:         si.GetRequestId(),
+			},
+		},
+	}).Return(&adminservice.ExecuteCrossClusterTaskResponse{}, nil).Times(1)
+
+	err = s.transferQueueActiveTaskExecutor.execute(transferTask, true)
+	s.Nil(err)
+}
+
+func (s *transferQueueActiveTaskExecutorSuiteV2) TestProcessSignalExecution_CrossCluster_NamespaceNotActive() {
+
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: "some random workflow ID",
+		RunId:      uuid.New(),
+	}
+	workflowType := "some random workflow type"
+	taskQueueName := "some random task queue"
+
+	targetExecution := commonpb.WorkflowExecution{
+		WorkflowId: "some random target workflow ID",
+		RunId:      uuid.New(),
+	}
+	signalName := "some random signal name"
+	signalInput := payloads.EncodeString("some random signal input")
+	signalControl := "some random signal control"
+
+	remoteTargetNamespaceID := uuid.New()
+	remoteTargetNamespace := "some random remote target namespace"
+	s.mockNamespaceCache.EXPECT().GetNamespaceByID(remoteTargetNamespaceID).Return(s.newRemoteNamespaceEntry(remoteTargetNamespaceID, remoteTargetNamespace), nil).AnyTimes()
+	s.mockNamespaceCache.EXPECT().GetNamespace(remoteTargetNamespace).Return(s.newRemoteNamespaceEntry(remoteTargetNamespaceID, remoteTargetNamespace), nil).AnyTimes()
+	s.transferQueueActiveTaskExecutor.config.EnableCrossClusterOperations = dc.GetBoolPropertyFnFilteredByNamespace(true)
+
+	mutableState := newMutableStateBuilderWithVersionHistoriesForTest(s.mockShard, s.mockShard.GetEventsCache(), s.logger, s.version, execution.GetRunId())
+	_, err := mutableState.AddWorkflowExecutionStartedEvent(
+		execution,
+		&historyservice.StartWorkflowExecutionRequest{
+			Attempt:     1,
+			NamespaceId: s.namespaceID,
+			StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+				WorkflowType:             &commonpb.WorkflowType{Name: workflowType},
+				TaskQueue:                &taskqueuepb.TaskQueue{Name: taskQueueName},
+				WorkflowExecutionTimeout: timestamp.DurationPtr(2 * time.Second),
+				WorkflowTaskTimeout:      timestamp.DurationPtr(1 * time.Second),
+			},
+		},
+	)
+	s.Nil(err)
+
+	di := addWorkflowTaskScheduledEvent(mutableState)
+	event := addWorkflowTaskStartedEvent(mutableState, di.ScheduleID, taskQueueName, uuid.New())
+	di.StartedID = event.GetEventId()
+	event = addWorkflowTaskCompletedEvent(mutableState, di.ScheduleID, di.StartedID, "some random identity")
+
+	taskID := int64(59)
+	event, _ = addRequestSignalInitiatedEvent(mutableState, event.GetEventId(), uuid.New(),
+		remoteTargetNamespace, targetExecution.GetWorkflowId(), targetExecution.GetRunId(), signalName, signalInput, signalControl)
+
+	transferTask := &persistencespb.TransferTaskInfo{
+		Version:           s.version,
+		NamespaceId:       s.namespaceID,
+		WorkflowId:        execution.GetWorkflowId(),
+		RunId:             execution.GetRunId(),
+		TargetNamespaceId: remoteTargetNamespaceID,
+		TargetWorkflowId:  targetExecution.GetWorkflowId(),
+		TargetRunId:       targetExecution.GetRunId(),
+		TaskId:            taskID,
+		TaskQueue:         taskQueueName,
+		TaskType:          enumsspb.TASK_TYPE_TRANSFER_SIGNAL_EXECUTION,
+		ScheduleId:        event.GetEventId(),
+	}
+
+	persistenceMutableState := s.createPersistenceMutableState(mutableState, event.GetEventId(), event.GetVersion())
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any()).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	namespaceNotActiveErr := serviceerror.NewNamespaceNotActive(remoteTargetNamespace, cluster.TestAlternativeClusterName, cluster.TestCurrentClusterName)
+	s.mockAdminClient.EXPECT().ExecuteCrossClusterTask(gomock.Any(), gomock.Any()).Return(nil, namespaceNotActiveErr).Times(1)
+	s.mockClusterMetadata.EXPECT().ClusterNameForFailoverVersion(s.version).Return(cluster.TestCurrentClusterName).AnyTimes()
+
+	// the target namespace is failing over, the task is retried instead of failing the signal
+	err = s.transferQueueActiveTaskExecutor.execute(transferTask, true)
+	s.Equal(namespaceNotActiveErr, err)
+}
+
 func (s *transferQueueActiveTaskExecutorSuiteV2) TestProcessSignalExecution_Duplication() {
 
 	execution := commonpb.WorkflowExecution{
@@ -2024,6 +2370,26 @@ func (s *transferQueueActiveTaskExecutorSuiteV2) createRequestCancelWorkflowExec
 	}
 }
 
+func (s *transferQueueActiveTaskExecutorSuiteV2) newRemoteNamespaceEntry(
+	namespaceID string,
+	namespace string,
+) *cache.NamespaceCacheEntry {
+
+	return cache.NewGlobalNamespaceCacheEntryForTest(
+		&persistencespb.NamespaceInfo{Id: namespaceID, Name: namespace},
+		&persistencespb.NamespaceConfig{Retention: timestamp.DurationFromDays(1)},
+		&persistencespb.NamespaceReplicationConfig{
+			ActiveClusterName: cluster.TestAlternativeClusterName,
+			Clusters: []string{
+				cluster.TestCurrentClusterName,
+				cluster.TestAlternativeClusterName,
+			},
+		},
+		s.version,
+		nil,
+	)
+}
+
 func (s *transferQueueActiveTaskExecutorSuiteV2) createSignalWorkflowExecutionRequest(
 	targetNamespace string,
 	task *persistencespb.TransferTaskInfo,
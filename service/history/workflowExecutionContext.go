@@ -29,6 +29,7 @@ package history
 import (
 	"context"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	commonpb "go.temporal.io/api/common/v1"
@@ -70,6 +71,7 @@ type (
 
 		lock(ctx context.Context) error
 		unlock()
+		describeLock() workflowLockInfo
 
 		getHistorySize() int64
 		setHistorySize(size int64)
@@ -142,10 +144,21 @@ type (
 		timeSource        clock.TimeSource
 		config            *configs.Config
 
-		mutex           locks.Mutex
-		mutableState    mutableState
-		stats           *persistencespb.ExecutionStats
-		updateCondition int64
+		mutex            locks.Mutex
+		lockWaiters      int32 // number of requests waiting to acquire mutex
+		lockAcquiredTime int64 // unix nano time mutex was acquired by its current owner, 0 if not held
+		mutableState     mutableState
+		stats            *persistencespb.ExecutionStats
+		updateCondition  int64
+	}
+
+	workflowLockInfo struct {
+		namespaceID  string
+		workflowID   string
+		runID        string
+		held         bool
+		holdDuration time.Duration // zero if the lock is not held
+		waiters      int32
 	}
 )
 
@@ -180,11 +193,52 @@ func newWorkflowExecutionContext(
 }
 
 func (c *workflowExecutionContextImpl) lock(ctx context.Context) error {
-	return c.mutex.Lock(ctx)
+	waiters := atomic.AddInt32(&c.lockWaiters, 1)
+	if waiters > 1 || atomic.LoadInt64(&c.lockAcquiredTime) != 0 {
+		c.metricsClient.IncCounter(metrics.WorkflowContextScope, metrics.WorkflowContextLockContended)
+	}
+
+	sw := c.metricsClient.StartTimer(metrics.WorkflowContextScope, metrics.WorkflowContextLockWaitLatency)
+	err := c.mutex.Lock(ctx)
+	sw.Stop()
+	atomic.AddInt32(&c.lockWaiters, -1)
+	if err != nil {
+		return err
+	}
+
+	atomic.StoreInt64(&c.lockAcquiredTime, time.Now().UnixNano())
+	return nil
 }
 
 func (c *workflowExecutionContextImpl) unlock() {
+	holdDuration := time.Since(time.Unix(0, atomic.SwapInt64(&c.lockAcquiredTime, 0)))
+	waiters := atomic.LoadInt32(&c.lockWaiters)
 	c.mutex.Unlock()
+
+	c.metricsClient.RecordTimer(metrics.WorkflowContextScope, metrics.WorkflowContextLockHoldLatency, holdDuration)
+	if threshold := c.config.WorkflowLockHoldWarnThreshold(); threshold > 0 && holdDuration > threshold {
+		c.logger.Warn("Workflow execution lock held for too long.",
+			tag.WorkflowNamespaceID(c.namespaceID),
+			tag.WorkflowID(c.workflowExecution.GetWorkflowId()),
+			tag.WorkflowRunID(c.workflowExecution.GetRunId()),
+			tag.WorkflowLockHoldDuration(holdDuration),
+			tag.WorkflowLockWaiters(waiters),
+		)
+	}
+}
+
+func (c *workflowExecutionContextImpl) describeLock() workflowLockInfo {
+	lockInfo := workflowLockInfo{
+		namespaceID: c.namespaceID,
+		workflowID:  c.workflowExecution.GetWorkflowId(),
+		runID:       c.workflowExecution.GetRunId(),
+		waiters:     atomic.LoadInt32(&c.lockWaiters),
+	}
+	if acquiredTime := atomic.LoadInt64(&c.lockAcquiredTime); acquiredTime != 0 {
+		lockInfo.held = true
+		lockInfo.holdDuration = time.Since(time.Unix(0, acquiredTime))
+	}
+	return lockInfo
 }
 
 func (c *workflowExecutionContextImpl) clear() {
@@ -102,6 +102,20 @@ func (mr *MockworkflowExecutionContextMockRecorder) createWorkflowExecution(newW
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "createWorkflowExecution", reflect.TypeOf((*MockworkflowExecutionContext)(nil).createWorkflowExecution), newWorkflow, historySize, now, createMode, prevRunID, prevLastWriteVersion)
 }
 
+// describeLock mocks base method.
+func (m *MockworkflowExecutionContext) describeLock() workflowLockInfo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "describeLock")
+	ret0, _ := ret[0].(workflowLockInfo)
+	return ret0
+}
+
+// describeLock indicates an expected call of describeLock.
+func (mr *MockworkflowExecutionContextMockRecorder) describeLock() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "describeLock", reflect.TypeOf((*MockworkflowExecutionContext)(nil).describeLock))
+}
+
 // getExecution mocks base method.
 func (m *MockworkflowExecutionContext) getExecution() *common.WorkflowExecution {
 	m.ctrl.T.Helper()
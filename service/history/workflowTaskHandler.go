@@ -28,7 +28,6 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/pborman/uuid"
 	commandpb "go.temporal.io/api/command/v1"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
@@ -44,6 +43,7 @@ import (
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/payloads"
+	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/service/history/configs"
 )
@@ -72,6 +72,7 @@ type (
 		logger         log.Logger
 		namespaceCache cache.NamespaceCache
 		metricsClient  metrics.Client
+		idGenerator    primitives.IDGenerator
 		config         *configs.Config
 	}
 
@@ -93,6 +94,7 @@ func newWorkflowTaskHandler(
 	logger log.Logger,
 	namespaceCache cache.NamespaceCache,
 	metricsClient metrics.Client,
+	idGenerator primitives.IDGenerator,
 	config *configs.Config,
 ) *workflowTaskHandlerImpl {
 
@@ -117,6 +119,7 @@ func newWorkflowTaskHandler(
 		logger:         logger,
 		namespaceCache: namespaceCache,
 		metricsClient:  metricsClient,
+		idGenerator:    idGenerator,
 		config:         config,
 	}
 }
@@ -589,7 +592,7 @@ func (handler *workflowTaskHandlerImpl) handleCommandRequestCancelExternalWorkfl
 		return err
 	}
 
-	cancelRequestID := uuid.New()
+	cancelRequestID := handler.idGenerator.NewID()
 	_, _, err := handler.mutableState.AddRequestCancelExternalWorkflowExecutionInitiatedEvent(
 		handler.workflowTaskCompletedID, cancelRequestID, attr,
 	)
@@ -760,7 +763,7 @@ func (handler *workflowTaskHandlerImpl) handleCommandStartChildWorkflow(
 
 	enums.SetDefaultWorkflowIdReusePolicy(&attr.WorkflowIdReusePolicy)
 
-	requestID := uuid.New()
+	requestID := handler.idGenerator.NewID()
 	_, _, err = handler.mutableState.AddStartChildWorkflowExecutionInitiatedEvent(
 		handler.workflowTaskCompletedID, requestID, attr,
 	)
@@ -814,7 +817,7 @@ func (handler *workflowTaskHandlerImpl) handleCommandSignalExternalWorkflow(
 		return err
 	}
 
-	signalRequestID := uuid.New() // for deduplicate
+	signalRequestID := handler.idGenerator.NewID() // for deduplicate
 	_, _, err = handler.mutableState.AddSignalExternalWorkflowExecutionInitiatedEvent(
 		handler.workflowTaskCompletedID, signalRequestID, attr,
 	)
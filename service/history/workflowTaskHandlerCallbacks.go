@@ -193,6 +193,12 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleWorkflowTaskStarted(
 				return nil, serviceerror.NewNotFound("Workflow task not found.")
 			}
 
+			// Paused workflow doesn't dispatch workflow tasks, the task is dropped by matching
+			// and regenerated when the workflow is unpaused.
+			if mutableState.GetExecutionInfo().PauseInfo != nil && workflowTask.StartedID == common.EmptyEventID {
+				return nil, ErrWorkflowPaused
+			}
+
 			updateAction := &updateWorkflowAction{}
 
 			if workflowTask.StartedID != common.EmptyEventID {
@@ -414,6 +420,7 @@ Update_History_Loop:
 				handler.logger,
 				handler.namespaceCache,
 				handler.metricsClient,
+				handler.shard.GetIDGenerator(),
 				handler.config,
 			)
 
@@ -452,18 +459,20 @@ Update_History_Loop:
 		}
 
 		createNewWorkflowTask := msBuilder.IsWorkflowExecutionRunning() && (hasUnhandledEvents || request.GetForceCreateNewWorkflowTask() || activityNotStartedCancelled)
+		// new workflow task of a paused workflow is not returned to the worker, it is dispatched after unpause
+		returnNewWorkflowTask := request.GetReturnNewWorkflowTask() && msBuilder.GetExecutionInfo().PauseInfo == nil
 		var newWorkflowTaskScheduledID int64
 		if createNewWorkflowTask {
 			var newWorkflowTask *workflowTaskInfo
 			var err error
 			if workflowTaskHeartbeating && !workflowTaskHeartbeatTimeout {
 				newWorkflowTask, err = msBuilder.AddWorkflowTaskScheduledEventAsHeartbeat(
-					request.GetReturnNewWorkflowTask(),
+					returnNewWorkflowTask,
 					currentWorkflowTask.OriginalScheduledTime,
 				)
 			} else {
 				newWorkflowTask, err = msBuilder.AddWorkflowTaskScheduledEvent(
-					request.GetReturnNewWorkflowTask(),
+					returnNewWorkflowTask,
 				)
 			}
 			if err != nil {
@@ -472,7 +481,7 @@ Update_History_Loop:
 
 			newWorkflowTaskScheduledID = newWorkflowTask.ScheduleID
 			// skip transfer task for workflow task if request asking to return new workflow task
-			if request.GetReturnNewWorkflowTask() {
+			if returnNewWorkflowTask {
 				// start the new workflow task if request asked to do so
 				// TODO: replace the poll request
 				_, _, err := msBuilder.AddWorkflowTaskStartedEvent(newWorkflowTask.ScheduleID, "request-from-RespondWorkflowTaskCompleted", &workflowservice.PollWorkflowTaskQueueRequest{
@@ -557,7 +566,7 @@ Update_History_Loop:
 		}
 
 		resp = &historyservice.RespondWorkflowTaskCompletedResponse{}
-		if request.GetReturnNewWorkflowTask() && createNewWorkflowTask {
+		if returnNewWorkflowTask && createNewWorkflowTask {
 			workflowTask, _ := msBuilder.GetWorkflowTaskInfo(newWorkflowTaskScheduledID)
 			resp.StartedResponse, err = handler.createRecordWorkflowTaskStartedResponse(namespaceID, msBuilder, workflowTask, request.GetIdentity())
 			if err != nil {
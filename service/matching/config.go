@@ -38,6 +38,8 @@ type (
 		PersistenceMaxQPS       dynamicconfig.IntPropertyFn
 		PersistenceGlobalMaxQPS dynamicconfig.IntPropertyFn
 		EnableSyncMatch         dynamicconfig.BoolPropertyFnWithTaskQueueInfoFilters
+		EnableSyncMatchStats    dynamicconfig.BoolPropertyFnWithTaskQueueInfoFilters
+		SyncMatchStatsWindow    dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
 		RPS                     dynamicconfig.IntPropertyFn
 		ShutdownDrainDuration   dynamicconfig.DurationPropertyFn
 
@@ -56,6 +58,12 @@ type (
 
 		// Time to hold a poll request before returning an empty response if there are no tasks
 		LongPollExpirationInterval dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
+		// Time after the last poll after which a poller is removed from the poller history
+		PollerHistoryTTL dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
+		// Time after a workflow task was dispatched during which the worker holding it is reported
+		WorkflowTaskHolderTTL dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
+		// Max number of task holders kept by a task queue partition
+		MaxTaskHoldersPerPartition dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
 		MinTaskThrottlingBurstSize dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
 		MaxTaskDeleteBatchSize     dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
 
@@ -78,9 +86,14 @@ type (
 
 	taskQueueConfig struct {
 		forwarderConfig
-		EnableSyncMatch func() bool
+		EnableSyncMatch      func() bool
+		EnableSyncMatchStats func() bool
+		SyncMatchStatsWindow func() time.Duration
 		// Time to hold a poll request before returning an empty response if there are no tasks
 		LongPollExpirationInterval func() time.Duration
+		PollerHistoryTTL           func() time.Duration
+		WorkflowTaskHolderTTL      func() time.Duration
+		MaxTaskHoldersPerPartition func() int
 		RangeSize                  int64
 		GetTasksBatchSize          func() int
 		UpdateAckInterval          func() time.Duration
@@ -105,6 +118,8 @@ func NewConfig(dc *dynamicconfig.Collection) *Config {
 		PersistenceMaxQPS:               dc.GetIntProperty(dynamicconfig.MatchingPersistenceMaxQPS, 3000),
 		PersistenceGlobalMaxQPS:         dc.GetIntProperty(dynamicconfig.MatchingPersistenceGlobalMaxQPS, 0),
 		EnableSyncMatch:                 dc.GetBoolPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingEnableSyncMatch, true),
+		EnableSyncMatchStats:            dc.GetBoolPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingEnableSyncMatchStats, false),
+		SyncMatchStatsWindow:            dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingSyncMatchStatsWindow, 5*time.Minute),
 		RPS:                             dc.GetIntProperty(dynamicconfig.MatchingRPS, 1200),
 		RangeSize:                       100000,
 		GetTasksBatchSize:               dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingGetTasksBatchSize, 1000),
@@ -112,6 +127,9 @@ func NewConfig(dc *dynamicconfig.Collection) *Config {
 		IdleTaskqueueCheckInterval:      dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingIdleTaskqueueCheckInterval, 5*time.Minute),
 		MaxTaskqueueIdleTime:            dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MaxTaskqueueIdleTime, 5*time.Minute),
 		LongPollExpirationInterval:      dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingLongPollExpirationInterval, time.Minute),
+		PollerHistoryTTL:                dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingPollerHistoryTTL, 5*time.Minute),
+		WorkflowTaskHolderTTL:           dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingWorkflowTaskHolderTTL, time.Minute),
+		MaxTaskHoldersPerPartition:      dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingMaxTaskHoldersPerPartition, 1000),
 		MinTaskThrottlingBurstSize:      dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingMinTaskThrottlingBurstSize, 1),
 		MaxTaskDeleteBatchSize:          dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingMaxTaskDeleteBatchSize, 100),
 		OutstandingTaskAppendsThreshold: dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingOutstandingTaskAppendsThreshold, 250),
@@ -167,9 +185,24 @@ func newTaskQueueConfig(id *taskQueueID, config *Config, namespaceCache cache.Na
 		EnableSyncMatch: func() bool {
 			return config.EnableSyncMatch(namespace, taskQueueName, taskType)
 		},
+		EnableSyncMatchStats: func() bool {
+			return config.EnableSyncMatchStats(namespace, taskQueueName, taskType)
+		},
+		SyncMatchStatsWindow: func() time.Duration {
+			return config.SyncMatchStatsWindow(namespace, taskQueueName, taskType)
+		},
 		LongPollExpirationInterval: func() time.Duration {
 			return config.LongPollExpirationInterval(namespace, taskQueueName, taskType)
 		},
+		PollerHistoryTTL: func() time.Duration {
+			return config.PollerHistoryTTL(namespace, taskQueueName, taskType)
+		},
+		WorkflowTaskHolderTTL: func() time.Duration {
+			return config.WorkflowTaskHolderTTL(namespace, taskQueueName, taskType)
+		},
+		MaxTaskHoldersPerPartition: func() int {
+			return config.MaxTaskHoldersPerPartition(namespace, taskQueueName, taskType)
+		},
 		MaxTaskDeleteBatchSize: func() int {
 			return config.MaxTaskDeleteBatchSize(namespace, taskQueueName, taskType)
 		},
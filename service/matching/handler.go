@@ -87,6 +87,7 @@ func NewHandler(
 			resource.GetMetricsClient(),
 			resource.GetNamespaceCache(),
 			resource.GetMatchingServiceResolver(),
+			resource.GetIDGenerator(),
 		),
 	}
 
@@ -352,6 +353,27 @@ func (h *Handler) CancelOutstandingPoll(ctx context.Context,
 	return &matchingservice.CancelOutstandingPollResponse{}, hCtx.handleErr(err)
 }
 
+// RemovePoller is used to remove a poller of a gracefully shut down worker from poller history
+func (h *Handler) RemovePoller(ctx context.Context,
+	request *matchingservice.RemovePollerRequest) (_ *matchingservice.RemovePollerResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	hCtx := h.newHandlerContext(
+		ctx,
+		request.GetNamespaceId(),
+		request.GetTaskQueue(),
+		metrics.MatchingRemovePollerScope,
+	)
+
+	sw := hCtx.startProfiling(&h.startWG)
+	defer sw.Stop()
+
+	// Count the request in the RPS, but we still accept it even if RPS is exceeded
+	h.rateLimiter.Allow()
+
+	err := h.engine.RemovePoller(hCtx, request)
+	return &matchingservice.RemovePollerResponse{}, hCtx.handleErr(err)
+}
+
 // DescribeTaskQueue returns information about the target task queue, right now this API returns the
 // pollers which polled this task queue in last few minutes. If includeTaskQueueStatus field is true,
 // it will also return status of task queue's ackManager (readLevel, ackLevel, backlogCountHint and taskIDBlock).
@@ -33,7 +33,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/pborman/uuid"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
@@ -53,6 +52,7 @@ import (
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/primitives/timestamp"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
 )
@@ -86,6 +86,7 @@ type (
 		lockableQueryTaskMap lockableQueryTaskMap
 		namespaceCache       cache.NamespaceCache
 		keyResolver          membership.ServiceResolver
+		idGenerator          primitives.IDGenerator
 	}
 )
 
@@ -116,6 +117,7 @@ func NewEngine(taskManager persistence.TaskManager,
 	metricsClient metrics.Client,
 	namespaceCache cache.NamespaceCache,
 	resolver membership.ServiceResolver,
+	idGenerator primitives.IDGenerator,
 ) Engine {
 
 	return &matchingEngineImpl{
@@ -130,6 +132,7 @@ func NewEngine(taskManager persistence.TaskManager,
 		lockableQueryTaskMap: lockableQueryTaskMap{queryTaskMap: make(map[string]chan *queryResult)},
 		namespaceCache:       namespaceCache,
 		keyResolver:          resolver,
+		idGenerator:          idGenerator,
 	}
 }
 
@@ -408,6 +411,7 @@ pollLoop:
 			continue pollLoop
 		}
 		task.finish(nil)
+		e.recordTaskHolder(taskQueue, taskQueueKind, task, request.GetIdentity(), 0)
 		return e.createPollWorkflowTaskQueueResponse(task, resp, hCtx.scope), nil
 	}
 }
@@ -474,6 +478,8 @@ pollLoop:
 			continue pollLoop
 		}
 		task.finish(nil)
+		startToCloseTimeout := timestamp.DurationValue(resp.ScheduledEvent.GetActivityTaskScheduledEventAttributes().GetStartToCloseTimeout())
+		e.recordTaskHolder(taskQueue, taskQueueKind, task, request.GetIdentity(), startToCloseTimeout)
 		return e.createPollActivityTaskQueueResponse(task, resp, hCtx.scope), nil
 	}
 }
@@ -501,7 +507,7 @@ func (e *matchingEngineImpl) QueryWorkflow(
 	if err != nil {
 		return nil, err
 	}
-	taskID := uuid.New()
+	taskID := e.idGenerator.NewID()
 	resp, err := tlMgr.DispatchQueryTask(hCtx.Context, taskID, queryRequest)
 
 	// if get response or error it means that query task was handled by forwarding to another matching host
@@ -579,6 +585,54 @@ func (e *matchingEngineImpl) CancelOutstandingPoll(
 	return nil
 }
 
+func (e *matchingEngineImpl) RemovePoller(
+	hCtx *handlerContext,
+	request *matchingservice.RemovePollerRequest,
+) error {
+	namespaceID := request.GetNamespaceId()
+	taskQueueType := request.GetTaskQueueType()
+	taskQueueName := request.TaskQueue.GetName()
+
+	taskQueue, err := newTaskQueueID(namespaceID, taskQueueName, taskQueueType)
+	if err != nil {
+		return err
+	}
+
+	// poller can only be recorded by a task queue which is already loaded, no need to load it
+	e.taskQueuesLock.RLock()
+	tlMgr, ok := e.taskQueues[*taskQueue]
+	e.taskQueuesLock.RUnlock()
+	if ok {
+		tlMgr.RemovePoller(request.GetIdentity())
+	}
+
+	if !taskQueue.IsRoot() || request.TaskQueue.GetKind() == enumspb.TASK_QUEUE_KIND_STICKY {
+		return nil
+	}
+
+	// pollers are load balanced across read partitions, which can be owned by other hosts
+	namespace, err := e.namespaceCache.GetNamespaceName(namespaceID)
+	if err != nil {
+		return err
+	}
+	nPartitions := e.config.NumTaskqueueReadPartitions(namespace, taskQueueName, taskQueueType)
+	for partition := 1; partition < nPartitions; partition++ {
+		_, err := e.matchingClient.RemovePoller(hCtx.Context, &matchingservice.RemovePollerRequest{
+			NamespaceId:   namespaceID,
+			TaskQueueType: taskQueueType,
+			TaskQueue: &taskqueuepb.TaskQueue{
+				Name: taskQueue.mkName(partition),
+				Kind: request.TaskQueue.GetKind(),
+			},
+			Identity: request.GetIdentity(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (e *matchingEngineImpl) DescribeTaskQueue(
 	hCtx *handlerContext,
 	request *matchingservice.DescribeTaskQueueRequest,
@@ -695,6 +749,21 @@ func (e *matchingEngineImpl) getTask(
 	return tlMgr.GetTask(ctx, maxDispatchPerSecond)
 }
 
+// recordTaskHolder records the worker a task was dispatched to on the task queue which dispatched the task
+func (e *matchingEngineImpl) recordTaskHolder(
+	taskQueue *taskQueueID,
+	taskQueueKind enumspb.TaskQueueKind,
+	task *internalTask,
+	identity string,
+	startToCloseTimeout time.Duration,
+) {
+	tlMgr, err := e.getTaskQueueManager(taskQueue, taskQueueKind)
+	if err != nil {
+		return
+	}
+	tlMgr.RecordTaskHolder(task, identity, startToCloseTimeout)
+}
+
 func (e *matchingEngineImpl) unloadTaskQueue(id *taskQueueID) {
 	e.taskQueuesLock.Lock()
 	tlMgr, ok := e.taskQueues[*id]
@@ -848,7 +917,7 @@ func (e *matchingEngineImpl) recordWorkflowTaskStarted(
 		WorkflowExecution: task.workflowExecution(),
 		ScheduleId:        task.event.Data.GetScheduleId(),
 		TaskId:            task.event.GetTaskId(),
-		RequestId:         uuid.New(),
+		RequestId:         e.idGenerator.NewID(),
 		PollRequest:       pollReq,
 	}
 	var resp *historyservice.RecordWorkflowTaskStartedResponse
@@ -877,7 +946,7 @@ func (e *matchingEngineImpl) recordActivityTaskStarted(
 		WorkflowExecution: task.workflowExecution(),
 		ScheduleId:        task.event.Data.GetScheduleId(),
 		TaskId:            task.event.GetTaskId(),
-		RequestId:         uuid.New(),
+		RequestId:         e.idGenerator.NewID(),
 		PollRequest:       pollReq,
 	}
 	var resp *historyservice.RecordActivityTaskStartedResponse
@@ -63,6 +63,7 @@ import (
 	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/common/service/dynamicconfig"
@@ -146,6 +147,7 @@ func newMatchingEngine(
 		tokenSerializer: common.NewProtoTaskTokenSerializer(),
 		config:          config,
 		namespaceCache:  mockNamespaceCache,
+		idGenerator:     primitives.NewUUIDGenerator(),
 	}
 }
 
@@ -365,6 +367,7 @@ func (s *matchingEngineSuite) PollForTasksEmptyResultTest(callContext context.Co
 		s.Equal(1, len(descResp.Pollers))
 		s.Equal(identity, descResp.Pollers[0].GetIdentity())
 		s.NotEmpty(descResp.Pollers[0].GetLastAccessTime())
+		s.Empty(descResp.GetTaskHolders())
 		s.Nil(descResp.GetTaskQueueStatus())
 	}
 	s.EqualValues(1, s.taskManager.taskQueues[*tlID].rangeID)
@@ -777,6 +780,69 @@ func (s *matchingEngineSuite) TestSyncMatchActivities() {
 	s.True(descResp.GetTaskQueueStatus().GetRatePerSecond()*numPartitions >= (defaultTaskDispatchRPS - 1))
 }
 
+func (s *matchingEngineSuite) TestDescribeTaskQueue_TaskHolders() {
+	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskQueueInfo(10 * time.Millisecond)
+
+	namespaceID := uuid.NewRandom().String()
+	execution := &commonpb.WorkflowExecution{RunId: uuid.NewRandom().String(), WorkflowId: "workflow1"}
+	taskQueue := &taskqueuepb.TaskQueue{Name: "makeToast"}
+	identity := "worker@host"
+	scheduleID := int64(5)
+
+	s.mockHistoryClient.EXPECT().RecordActivityTaskStarted(gomock.Any(), gomock.Any()).Return(
+		&historyservice.RecordActivityTaskStartedResponse{
+			Attempt: 1,
+			ScheduledEvent: newActivityTaskScheduledEvent(scheduleID, 0,
+				&commandpb.ScheduleActivityTaskCommandAttributes{
+					ActivityId:          "activityId1",
+					TaskQueue:           taskQueue,
+					ActivityType:        &commonpb.ActivityType{Name: "activity1"},
+					StartToCloseTimeout: timestamp.DurationPtr(time.Minute),
+				}),
+		}, nil)
+
+	_, err := s.matchingEngine.AddActivityTask(s.handlerContext, &matchingservice.AddActivityTaskRequest{
+		SourceNamespaceId:      namespaceID,
+		NamespaceId:            namespaceID,
+		Execution:              execution,
+		ScheduleId:             scheduleID,
+		TaskQueue:              taskQueue,
+		ScheduleToStartTimeout: timestamp.DurationFromSeconds(10),
+	})
+	s.NoError(err)
+
+	// the task is read from the backlog, so the first polls may return empty
+	for {
+		pollResp, err := s.matchingEngine.PollActivityTaskQueue(s.handlerContext, &matchingservice.PollActivityTaskQueueRequest{
+			NamespaceId: namespaceID,
+			PollRequest: &workflowservice.PollActivityTaskQueueRequest{
+				TaskQueue: taskQueue,
+				Identity:  identity,
+			},
+		})
+		s.NoError(err)
+		if len(pollResp.TaskToken) > 0 {
+			break
+		}
+	}
+
+	descResp, err := s.matchingEngine.DescribeTaskQueue(s.handlerContext, &matchingservice.DescribeTaskQueueRequest{
+		NamespaceId: namespaceID,
+		DescRequest: &workflowservice.DescribeTaskQueueRequest{
+			TaskQueue:     taskQueue,
+			TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		},
+	})
+	s.NoError(err)
+	s.Len(descResp.GetTaskHolders(), 1)
+	holder := descResp.GetTaskHolders()[0]
+	s.Equal(execution.GetWorkflowId(), holder.GetWorkflowId())
+	s.Equal(execution.GetRunId(), holder.GetRunId())
+	s.Equal(scheduleID, holder.GetScheduleId())
+	s.Equal(identity, holder.GetIdentity())
+	s.True(validateTimeRange(*holder.GetStartedTime(), time.Minute))
+}
+
 func (s *matchingEngineSuite) TestConcurrentPublishConsumeActivities() {
 	dispatchLimitFn := func(int, int64) float64 {
 		return defaultTaskDispatchRPS
@@ -35,14 +35,14 @@ import (
 const (
 	pollerHistoryInitSize    = 0
 	pollerHistoryInitMaxSize = 1000
-	pollerHistoryTTL         = 5 * time.Minute
 )
 
 type (
 	pollerIdentity string
 
 	pollerInfo struct {
-		ratePerSecond float64
+		ratePerSecond  float64
+		lastAccessTime time.Time
 	}
 )
 
@@ -50,17 +50,19 @@ type pollerHistory struct {
 	// poller ID -> pollerInfo
 	// pollers map[pollerID]pollerInfo
 	history cache.Cache
+	// ttl is evaluated on every read, so that the expiry window can be changed dynamically
+	ttl func() time.Duration
 }
 
-func newPollerHistory() *pollerHistory {
+func newPollerHistory(ttl func() time.Duration) *pollerHistory {
 	opts := &cache.Options{
 		InitialCapacity: pollerHistoryInitSize,
-		TTL:             pollerHistoryTTL,
 		Pin:             false,
 	}
 
 	return &pollerHistory{
 		history: cache.New(pollerHistoryInitMaxSize, opts),
+		ttl:     ttl,
 	}
 }
 
@@ -69,26 +71,40 @@ func (pollers *pollerHistory) updatePollerInfo(id pollerIdentity, ratePerSecond
 	if ratePerSecond != nil {
 		rps = *ratePerSecond
 	}
-	pollers.history.Put(id, &pollerInfo{ratePerSecond: rps})
+	pollers.history.Put(id, &pollerInfo{ratePerSecond: rps, lastAccessTime: time.Now().UTC()})
+}
+
+func (pollers *pollerHistory) removePoller(id pollerIdentity) {
+	pollers.history.Delete(id)
 }
 
 func (pollers *pollerHistory) getAllPollerInfo() []*taskqueuepb.PollerInfo {
 	var result []*taskqueuepb.PollerInfo
+	var expired []pollerIdentity
 
+	expiryTime := time.Now().UTC().Add(-pollers.ttl())
 	ite := pollers.history.Iterator()
-	defer ite.Close()
 	for ite.HasNext() {
 		entry := ite.Next()
 		key := entry.Key().(pollerIdentity)
 		value := entry.Value().(*pollerInfo)
+		if value.lastAccessTime.Before(expiryTime) {
+			expired = append(expired, key)
+			continue
+		}
 		// TODO add IP, T1396795
-		lastAccessTime := entry.CreateTime()
+		lastAccessTime := value.lastAccessTime
 		result = append(result, &taskqueuepb.PollerInfo{
 			Identity:       string(key),
 			LastAccessTime: &lastAccessTime,
 			RatePerSecond:  value.ratePerSecond,
 		})
 	}
+	// iterator holds the cache lock, expired pollers can only be deleted after it is closed
+	ite.Close()
 
+	for _, key := range expired {
+		pollers.history.Delete(key)
+	}
 	return result
 }
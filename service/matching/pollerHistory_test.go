@@ -0,0 +1,73 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	pollerHistorySuite struct {
+		suite.Suite
+		*require.Assertions
+	}
+)
+
+func TestPollerHistorySuite(t *testing.T) {
+	suite.Run(t, new(pollerHistorySuite))
+}
+
+func (s *pollerHistorySuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *pollerHistorySuite) TestRemovePoller() {
+	history := newPollerHistory(func() time.Duration { return time.Minute })
+	history.updatePollerInfo("worker-1", nil)
+	history.updatePollerInfo("worker-2", nil)
+
+	history.removePoller("worker-1")
+
+	pollers := history.getAllPollerInfo()
+	s.Len(pollers, 1)
+	s.Equal("worker-2", pollers[0].GetIdentity())
+}
+
+func (s *pollerHistorySuite) TestExpiredPollersAreNotReported() {
+	ttl := time.Minute
+	history := newPollerHistory(func() time.Duration { return ttl })
+	history.updatePollerInfo("worker-1", nil)
+	s.Len(history.getAllPollerInfo(), 1)
+
+	ttl = -time.Second
+	s.Empty(history.getAllPollerInfo())
+
+	ttl = time.Minute
+	s.Empty(history.getAllPollerInfo())
+}
@@ -0,0 +1,153 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"sync"
+	"time"
+
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common/clock"
+)
+
+type (
+	// syncMatchStats tracks whether tasks added to a task queue were sync matched or spilled
+	// to the backlog, and how long spilled tasks waited before they were dispatched.
+	// Stats are kept for the current and the previous window, so that they only reflect
+	// recent behaviour of the task queue.
+	syncMatchStats struct {
+		sync.Mutex
+		window     func() time.Duration
+		timeSource clock.TimeSource
+		current    syncMatchWindow
+		previous   syncMatchWindow
+	}
+
+	syncMatchWindow struct {
+		startTime            time.Time
+		syncMatchCount       int64
+		spillCount           int64
+		backlogDispatchCount int64
+		totalSpillLatency    time.Duration
+		maxSpillLatency      time.Duration
+	}
+)
+
+func newSyncMatchStats(window func() time.Duration) *syncMatchStats {
+	timeSource := clock.NewRealTimeSource()
+	return &syncMatchStats{
+		window:     window,
+		timeSource: timeSource,
+		current:    syncMatchWindow{startTime: timeSource.Now()},
+	}
+}
+
+// recordAddedTask records the outcome of adding a task and returns the updated sync match ratio
+func (s *syncMatchStats) recordAddedTask(syncMatch bool) float64 {
+	s.Lock()
+	defer s.Unlock()
+	s.rotateLocked()
+	if syncMatch {
+		s.current.syncMatchCount++
+	} else {
+		s.current.spillCount++
+	}
+	return s.syncMatchRatioLocked()
+}
+
+// recordBacklogDispatch records the time a spilled task waited in the backlog and returns it.
+// The latency is recorded in the current window, no matter when the task was spilled.
+func (s *syncMatchStats) recordBacklogDispatch(createTime time.Time) time.Duration {
+	s.Lock()
+	defer s.Unlock()
+	s.rotateLocked()
+	latency := s.timeSource.Now().Sub(createTime)
+	s.current.backlogDispatchCount++
+	s.current.totalSpillLatency += latency
+	if latency > s.current.maxSpillLatency {
+		s.current.maxSpillLatency = latency
+	}
+	return latency
+}
+
+func (s *syncMatchStats) describe() *taskqueuespb.SyncMatchStats {
+	s.Lock()
+	defer s.Unlock()
+	s.rotateLocked()
+
+	startTime := s.startTimeLocked()
+	backlogDispatchCount := s.current.backlogDispatchCount + s.previous.backlogDispatchCount
+	averageSpillLatency := time.Duration(0)
+	if backlogDispatchCount > 0 {
+		averageSpillLatency = (s.current.totalSpillLatency + s.previous.totalSpillLatency) / time.Duration(backlogDispatchCount)
+	}
+	maxSpillLatency := s.current.maxSpillLatency
+	if s.previous.maxSpillLatency > maxSpillLatency {
+		maxSpillLatency = s.previous.maxSpillLatency
+	}
+	return &taskqueuespb.SyncMatchStats{
+		StartTime:            &startTime,
+		SyncMatchCount:       s.current.syncMatchCount + s.previous.syncMatchCount,
+		SpillCount:           s.current.spillCount + s.previous.spillCount,
+		SyncMatchRatio:       s.syncMatchRatioLocked(),
+		BacklogDispatchCount: backlogDispatchCount,
+		AverageSpillLatency:  &averageSpillLatency,
+		MaxSpillLatency:      &maxSpillLatency,
+	}
+}
+
+// rotateLocked starts a new window once the current one is older than the configured window.
+// The previous window is dropped when no task was recorded for a whole window.
+func (s *syncMatchStats) rotateLocked() {
+	now := s.timeSource.Now()
+	window := s.window()
+	elapsed := now.Sub(s.current.startTime)
+	if elapsed < window {
+		return
+	}
+	if elapsed < 2*window {
+		s.previous = s.current
+	} else {
+		s.previous = syncMatchWindow{}
+	}
+	s.current = syncMatchWindow{startTime: now}
+}
+
+// startTimeLocked returns the start of the oldest window
+func (s *syncMatchStats) startTimeLocked() time.Time {
+	if !s.previous.startTime.IsZero() {
+		return s.previous.startTime
+	}
+	return s.current.startTime
+}
+
+func (s *syncMatchStats) syncMatchRatioLocked() float64 {
+	syncMatchCount := s.current.syncMatchCount + s.previous.syncMatchCount
+	total := syncMatchCount + s.current.spillCount + s.previous.spillCount
+	if total == 0 {
+		return 0
+	}
+	return float64(syncMatchCount) / float64(total)
+}
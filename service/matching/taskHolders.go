@@ -0,0 +1,107 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"time"
+
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common/cache"
+)
+
+const (
+	taskHoldersInitSize = 0
+)
+
+type (
+	taskHolderKey struct {
+		workflowID string
+		runID      string
+		scheduleID int64
+	}
+
+	taskHolderInfo struct {
+		identity    string
+		startedTime time.Time
+		expiryTime  time.Time
+	}
+)
+
+// taskHolders tracks the workers which tasks of a task queue were dispatched to, so that operators can locate
+// the worker processing a stuck task. Matching is not notified when a task completes, so a holder is reported
+// until the task times out. At most maxSize holders are kept per task queue partition, the holders of the least
+// recently dispatched tasks are dropped first.
+type taskHolders struct {
+	// task key -> taskHolderInfo
+	holders cache.Cache
+}
+
+func newTaskHolders(maxSize int) *taskHolders {
+	opts := &cache.Options{
+		InitialCapacity: taskHoldersInitSize,
+		Pin:             false,
+	}
+
+	return &taskHolders{
+		holders: cache.New(maxSize, opts),
+	}
+}
+
+func (h *taskHolders) recordTaskHolder(key taskHolderKey, identity string, timeout time.Duration) {
+	now := time.Now().UTC()
+	h.holders.Put(key, &taskHolderInfo{identity: identity, startedTime: now, expiryTime: now.Add(timeout)})
+}
+
+func (h *taskHolders) getAllTaskHolders() []*taskqueuespb.TaskHolderInfo {
+	var result []*taskqueuespb.TaskHolderInfo
+	var expired []taskHolderKey
+
+	now := time.Now().UTC()
+	ite := h.holders.Iterator()
+	for ite.HasNext() {
+		entry := ite.Next()
+		key := entry.Key().(taskHolderKey)
+		value := entry.Value().(*taskHolderInfo)
+		if !value.expiryTime.After(now) {
+			expired = append(expired, key)
+			continue
+		}
+		startedTime := value.startedTime
+		result = append(result, &taskqueuespb.TaskHolderInfo{
+			WorkflowId:  key.workflowID,
+			RunId:       key.runID,
+			ScheduleId:  key.scheduleID,
+			Identity:    value.identity,
+			StartedTime: &startedTime,
+		})
+	}
+	// iterator holds the cache lock, expired holders can only be deleted after it is closed
+	ite.Close()
+
+	for _, key := range expired {
+		h.holders.Delete(key)
+	}
+	return result
+}
@@ -0,0 +1,92 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	taskHoldersSuite struct {
+		suite.Suite
+		*require.Assertions
+	}
+)
+
+func TestTaskHoldersSuite(t *testing.T) {
+	suite.Run(t, new(taskHoldersSuite))
+}
+
+func (s *taskHoldersSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *taskHoldersSuite) TestRecordTaskHolder() {
+	holders := newTaskHolders(10)
+	key := taskHolderKey{workflowID: "workflow1", runID: "run1", scheduleID: 5}
+	holders.recordTaskHolder(key, "worker-1", time.Minute)
+
+	result := holders.getAllTaskHolders()
+	s.Len(result, 1)
+	s.Equal("workflow1", result[0].GetWorkflowId())
+	s.Equal("run1", result[0].GetRunId())
+	s.Equal(int64(5), result[0].GetScheduleId())
+	s.Equal("worker-1", result[0].GetIdentity())
+	s.NotNil(result[0].GetStartedTime())
+
+	// a task dispatched again replaces its previous holder
+	holders.recordTaskHolder(key, "worker-2", time.Minute)
+	result = holders.getAllTaskHolders()
+	s.Len(result, 1)
+	s.Equal("worker-2", result[0].GetIdentity())
+}
+
+func (s *taskHoldersSuite) TestTimedOutTasksAreNotReported() {
+	holders := newTaskHolders(10)
+	holders.recordTaskHolder(taskHolderKey{workflowID: "workflow1", runID: "run1", scheduleID: 5}, "worker-1", -time.Second)
+	holders.recordTaskHolder(taskHolderKey{workflowID: "workflow1", runID: "run1", scheduleID: 6}, "worker-1", time.Minute)
+
+	result := holders.getAllTaskHolders()
+	s.Len(result, 1)
+	s.Equal(int64(6), result[0].GetScheduleId())
+	s.Equal(1, holders.holders.Size())
+}
+
+func (s *taskHoldersSuite) TestMaxSize() {
+	holders := newTaskHolders(2)
+	for scheduleID := int64(1); scheduleID <= 3; scheduleID++ {
+		holders.recordTaskHolder(taskHolderKey{workflowID: "workflow1", runID: "run1", scheduleID: scheduleID}, "worker-1", time.Minute)
+	}
+
+	result := holders.getAllTaskHolders()
+	s.Len(result, 2)
+	for _, holder := range result {
+		s.NotEqual(int64(1), holder.GetScheduleId())
+	}
+}
@@ -48,6 +48,7 @@ import (
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives/timestamp"
 )
 
 const (
@@ -85,7 +86,12 @@ type (
 		// if dispatched to local poller then nil and nil is returned.
 		DispatchQueryTask(ctx context.Context, taskID string, request *matchingservice.QueryWorkflowRequest) (*matchingservice.QueryWorkflowResponse, error)
 		CancelPoller(pollerID string)
+		// RemovePoller removes the poller with given identity from the poller history
+		RemovePoller(identity string)
 		GetAllPollerInfo() []*taskqueuepb.PollerInfo
+		// RecordTaskHolder records the identity of the worker a task was dispatched to. Activity tasks are reported
+		// by DescribeTaskQueue until their start to close timeout passes, workflow tasks until the holder TTL passes.
+		RecordTaskHolder(task *internalTask, identity string, startToCloseTimeout time.Duration)
 		// DescribeTaskQueue returns information about the target task queue
 		DescribeTaskQueue(includeTaskQueueStatus bool) *matchingservice.DescribeTaskQueueResponse
 		String() string
@@ -110,6 +116,10 @@ type (
 		metricScopeValue atomic.Value // namespace/taskqueue tagged metric scope
 		// pollerHistory stores poller which poll from this taskqueue in last few minutes
 		pollerHistory *pollerHistory
+		// taskHolders stores workers which may still hold tasks dispatched from this taskqueue
+		taskHolders *taskHolders
+		// syncMatchStats is only updated while sync match stats are enabled for this taskqueue
+		syncMatchStats *syncMatchStats
 		// outstandingPollsMap is needed to keep track of all outstanding pollers for a
 		// particular taskqueue.  PollerID generated by frontend is used as the key and
 		// CancelFunc is the value.  This is used to cancel the context to unblock any
@@ -160,7 +170,9 @@ func newTaskQueueManager(
 		taskAckManager:      newAckManager(e.logger),
 		taskGC:              newTaskGC(db, taskQueueConfig),
 		config:              taskQueueConfig,
-		pollerHistory:       newPollerHistory(),
+		pollerHistory:       newPollerHistory(taskQueueConfig.PollerHistoryTTL),
+		taskHolders:         newTaskHolders(taskQueueConfig.MaxTaskHoldersPerPartition()),
+		syncMatchStats:      newSyncMatchStats(taskQueueConfig.SyncMatchStatsWindow),
 		outstandingPollsMap: make(map[string]context.CancelFunc),
 	}
 
@@ -253,6 +265,10 @@ func (c *taskQueueManagerImpl) AddTask(ctx context.Context, params addTaskParams
 	})
 	if err == nil {
 		c.taskReader.Signal()
+		// tasks forwarded from a child partition are accounted for by the child partition
+		if params.forwardedFrom == "" && c.config.EnableSyncMatchStats() {
+			c.recordAddedTask(syncMatch)
+		}
 	}
 	return syncMatch, err
 }
@@ -344,6 +360,21 @@ func (c *taskQueueManagerImpl) GetAllPollerInfo() []*taskqueuepb.PollerInfo {
 	return c.pollerHistory.getAllPollerInfo()
 }
 
+func (c *taskQueueManagerImpl) RecordTaskHolder(task *internalTask, identity string, startToCloseTimeout time.Duration) {
+	if startToCloseTimeout <= 0 {
+		startToCloseTimeout = c.config.WorkflowTaskHolderTTL()
+	}
+	c.taskHolders.recordTaskHolder(taskHolderKey{
+		workflowID: task.event.Data.GetWorkflowId(),
+		runID:      task.event.Data.GetRunId(),
+		scheduleID: task.event.Data.GetScheduleId(),
+	}, identity, startToCloseTimeout)
+}
+
+func (c *taskQueueManagerImpl) RemovePoller(identity string) {
+	c.pollerHistory.removePoller(pollerIdentity(identity))
+}
+
 func (c *taskQueueManagerImpl) CancelPoller(pollerID string) {
 	c.outstandingPollsLock.Lock()
 	cancel, ok := c.outstandingPollsMap[pollerID]
@@ -355,10 +386,16 @@ func (c *taskQueueManagerImpl) CancelPoller(pollerID string) {
 }
 
 // DescribeTaskQueue returns information about the target taskqueue, right now this API returns the
-// pollers which polled this taskqueue in last few minutes and status of taskqueue's ackManager
-// (readLevel, ackLevel, backlogCountHint and taskIDBlock).
+// pollers which polled this taskqueue in last few minutes, the workers which may still hold tasks
+// dispatched to them and status of taskqueue's ackManager (readLevel, ackLevel, backlogCountHint and taskIDBlock).
 func (c *taskQueueManagerImpl) DescribeTaskQueue(includeTaskQueueStatus bool) *matchingservice.DescribeTaskQueueResponse {
-	response := &matchingservice.DescribeTaskQueueResponse{Pollers: c.GetAllPollerInfo()}
+	response := &matchingservice.DescribeTaskQueueResponse{
+		Pollers:     c.GetAllPollerInfo(),
+		TaskHolders: c.taskHolders.getAllTaskHolders(),
+	}
+	if c.config.EnableSyncMatchStats() {
+		response.SyncMatchStats = c.syncMatchStats.describe()
+	}
 	if !includeTaskQueueStatus {
 		return response
 	}
@@ -400,6 +437,12 @@ func (c *taskQueueManagerImpl) String() string {
 //   - task is deleted from the database when err is nil
 //   - new task is created and current task is deleted when err is not nil
 func (c *taskQueueManagerImpl) completeTask(task *persistencespb.AllocatedTaskInfo, err error) {
+	// only tasks read from the backlog were spilled, sync matched tasks are completed through their response channel
+	if err == nil && task.GetTaskId() != syncMatchTaskId && c.config.EnableSyncMatchStats() {
+		latency := c.syncMatchStats.recordBacklogDispatch(timestamp.TimeValue(task.Data.GetCreateTime()))
+		c.metricScope().RecordTimer(metrics.SpillLatencyPerTaskQueue, latency)
+	}
+
 	if err != nil {
 		// failed to start the task.
 		// We cannot just remove it from persistence because then it will be lost.
@@ -537,6 +580,16 @@ func (c *taskQueueManagerImpl) newChildContext(
 	return context.WithTimeout(parent, timeout)
 }
 
+func (c *taskQueueManagerImpl) recordAddedTask(syncMatch bool) {
+	scope := c.metricScope()
+	if syncMatch {
+		scope.IncCounter(metrics.SyncMatchedTasksPerTaskQueueCounter)
+	} else {
+		scope.IncCounter(metrics.SpilledTasksPerTaskQueueCounter)
+	}
+	scope.UpdateGauge(metrics.SyncMatchRatioPerTaskQueue, c.syncMatchStats.recordAddedTask(syncMatch))
+}
+
 func (c *taskQueueManagerImpl) isFowardingAllowed(taskQueue *taskQueueID, kind enumspb.TaskQueueKind) bool {
 	return !taskQueue.IsRoot() && kind != enumspb.TASK_QUEUE_KIND_STICKY
 }
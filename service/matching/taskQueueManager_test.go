@@ -38,6 +38,7 @@ import (
 
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/log/loggerimpl"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -240,6 +241,75 @@ func TestDescribeTaskQueue(t *testing.T) {
 	require.Zero(t, taskQueueStatus.GetBacklogCountHint())
 }
 
+func TestDescribeTaskQueue_SyncMatchStats(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	cfg := defaultTestConfig()
+	tlm := createTestTaskQueueManagerWithConfig(controller, cfg)
+	tlm.recordAddedTask(true)
+	require.Nil(t, tlm.DescribeTaskQueue(false).GetSyncMatchStats())
+
+	cfg = defaultTestConfig()
+	cfg.EnableSyncMatchStats = dynamicconfig.GetBoolPropertyFnFilteredByTaskQueueInfo(true)
+	tlm = createTestTaskQueueManagerWithConfig(controller, cfg)
+	tlm.db.rangeID = int64(1)
+	tlm.recordAddedTask(true)
+	tlm.recordAddedTask(true)
+	tlm.recordAddedTask(true)
+	tlm.recordAddedTask(false)
+
+	taskID := int64(1)
+	tlm.taskAckManager.addTask(taskID)
+	tlm.completeTask(&persistencespb.AllocatedTaskInfo{
+		Data: &persistencespb.TaskInfo{
+			CreateTime: timestamp.TimePtr(time.Now().UTC().Add(-time.Second)),
+		},
+		TaskId: taskID,
+	}, nil)
+
+	stats := tlm.DescribeTaskQueue(false).GetSyncMatchStats()
+	require.NotNil(t, stats)
+	require.Equal(t, int64(3), stats.GetSyncMatchCount())
+	require.Equal(t, int64(1), stats.GetSpillCount())
+	require.Equal(t, 0.75, stats.GetSyncMatchRatio())
+	require.Equal(t, int64(1), stats.GetBacklogDispatchCount())
+	require.True(t, timestamp.DurationValue(stats.GetAverageSpillLatency()) >= time.Second)
+	require.Equal(t, timestamp.DurationValue(stats.GetAverageSpillLatency()), timestamp.DurationValue(stats.GetMaxSpillLatency()))
+}
+
+func TestSyncMatchStats_Window(t *testing.T) {
+	timeSource := clock.NewEventTimeSource().Update(time.Now().UTC())
+	stats := newSyncMatchStats(func() time.Duration { return time.Minute })
+	stats.timeSource = timeSource
+	stats.current.startTime = timeSource.Now()
+
+	stats.recordAddedTask(false)
+	stats.recordAddedTask(false)
+	timeSource.Update(timeSource.Now().Add(time.Minute))
+	require.Equal(t, 1.0/3.0, stats.recordAddedTask(true))
+	require.Equal(t, 0.5, stats.recordAddedTask(true))
+
+	// spills from two windows ago are no longer reported
+	timeSource.Update(timeSource.Now().Add(time.Minute))
+	require.Equal(t, 1.0, stats.recordAddedTask(true))
+	described := stats.describe()
+	require.Equal(t, int64(3), described.GetSyncMatchCount())
+	require.Zero(t, described.GetSpillCount())
+
+	require.Equal(t, time.Second, stats.recordBacklogDispatch(timeSource.Now().Add(-time.Second)))
+	// tasks spilled before the oldest window are recorded as well
+	require.Equal(t, 3*time.Minute, stats.recordBacklogDispatch(timeSource.Now().Add(-3*time.Minute)))
+	require.Equal(t, int64(2), stats.describe().GetBacklogDispatchCount())
+
+	// stats are reset after a whole idle window
+	timeSource.Update(timeSource.Now().Add(3 * time.Minute))
+	described = stats.describe()
+	require.Zero(t, described.GetSyncMatchCount())
+	require.Zero(t, described.GetSyncMatchRatio())
+	require.Equal(t, timeSource.Now(), timestamp.TimeValue(described.GetStartTime()))
+}
+
 func tlMgrStartWithoutNotifyEvent(tlm *taskQueueManagerImpl) {
 	// mimic tlm.Start() but avoid calling notifyEvent
 	tlm.startWG.Done()
@@ -0,0 +1,133 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package canary
+
+import (
+	"context"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/activity"
+	sdkclient "go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+)
+
+const (
+	// canaryStartUpDelay is to let services warm up
+	canaryStartUpDelay = time.Second * 4
+)
+
+type (
+	// BootstrapParams contains the set of params needed to bootstrap
+	// the canary sub-system
+	BootstrapParams struct {
+		// ServiceClient is an instance of temporal service client
+		ServiceClient sdkclient.Client
+		// MetricsClient is an instance of metrics object for emitting stats
+		MetricsClient metrics.Client
+		Logger        log.Logger
+	}
+
+	// Canary is the background sub-system that continuously runs small representative
+	// workflows against the cluster and emits end-to-end latency and success metrics.
+	// It is also the context object that gets passed around within the canary activities
+	Canary struct {
+		svcClient     sdkclient.Client
+		metricsClient metrics.Client
+		logger        log.Logger
+	}
+)
+
+// New returns a new instance of canary daemon Canary
+func New(params *BootstrapParams) *Canary {
+	return &Canary{
+		svcClient:     params.ServiceClient,
+		metricsClient: params.MetricsClient,
+		logger:        params.Logger.WithTags(tag.ComponentCanary),
+	}
+}
+
+// Start starts the canary worker and the cron workflow driving the probes
+func (c *Canary) Start() error {
+	ctx := context.WithValue(context.Background(), canaryContextKey, c)
+	workerOpts := worker.Options{
+		BackgroundActivityContext: ctx,
+	}
+	canaryWorker := worker.New(c.svcClient, TaskQueueName, workerOpts)
+	canaryWorker.RegisterWorkflowWithOptions(CanaryWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	canaryWorker.RegisterWorkflowWithOptions(EchoWorkflow, workflow.RegisterOptions{Name: echoWFTypeName})
+	canaryWorker.RegisterWorkflowWithOptions(SignalWorkflow, workflow.RegisterOptions{Name: signalWFTypeName})
+	canaryWorker.RegisterWorkflowWithOptions(TimerWorkflow, workflow.RegisterOptions{Name: timerWFTypeName})
+	canaryWorker.RegisterWorkflowWithOptions(ActivityWorkflow, workflow.RegisterOptions{Name: activityWFTypeName})
+	canaryWorker.RegisterWorkflowWithOptions(ContinueAsNewWorkflow, workflow.RegisterOptions{Name: continueAsNewWFTypeName})
+	canaryWorker.RegisterActivityWithOptions(ProbeActivity, activity.RegisterOptions{Name: probeActivityName})
+	canaryWorker.RegisterActivityWithOptions(EchoActivity, activity.RegisterOptions{Name: echoActivityName})
+	canaryWorker.RegisterActivityWithOptions(ReportActivity, activity.RegisterOptions{Name: reportActivityName})
+
+	if err := canaryWorker.Start(); err != nil {
+		return err
+	}
+
+	go c.startWorkflowWithRetry()
+	return nil
+}
+
+func (c *Canary) startWorkflowWithRetry() {
+	// let history / matching service warm up
+	time.Sleep(canaryStartUpDelay)
+
+	policy := backoff.NewExponentialRetryPolicy(time.Second)
+	policy.SetMaximumInterval(time.Minute)
+	policy.SetExpirationInterval(backoff.NoInterval)
+	err := backoff.Retry(func() error {
+		return c.startWorkflow()
+	}, policy, func(err error) bool {
+		return true
+	})
+	if err != nil {
+		c.logger.Fatal("unable to start canary", tag.Error(err))
+	}
+}
+
+func (c *Canary) startWorkflow() error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	_, err := c.svcClient.ExecuteWorkflow(ctx, canaryWFStartOptions, WorkflowTypeName)
+	cancel()
+	if err != nil {
+		if _, ok := err.(*serviceerror.WorkflowExecutionAlreadyStarted); ok {
+			return nil
+		}
+		c.logger.Error("error starting canary workflow", tag.Error(err))
+		return err
+	}
+	c.logger.Info("canary workflow successfully started")
+	return nil
+}
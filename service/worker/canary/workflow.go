@@ -0,0 +1,279 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package canary
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/activity"
+	sdkclient "go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+)
+
+const (
+	canaryContextKey = "canaryContext"
+	// TaskQueueName is the taskqueue name
+	TaskQueueName = "temporal-sys-canary-taskqueue"
+	// WorkflowID is the workflow ID of the canary driver cron workflow
+	WorkflowID = "temporal-sys-canary-workflow"
+	// WorkflowTypeName is the workflow type of the canary driver cron workflow
+	WorkflowTypeName = "temporal-sys-canary-workflow"
+
+	echoWFTypeName          = "temporal-sys-canary-echo-workflow"
+	signalWFTypeName        = "temporal-sys-canary-signal-workflow"
+	timerWFTypeName         = "temporal-sys-canary-timer-workflow"
+	activityWFTypeName      = "temporal-sys-canary-activity-workflow"
+	continueAsNewWFTypeName = "temporal-sys-canary-continueasnew-workflow"
+	probeActivityName       = "temporal-sys-canary-probe-activity"
+	echoActivityName        = "temporal-sys-canary-echo-activity"
+	reportActivityName      = "temporal-sys-canary-report-activity"
+	canarySignalName        = "temporal-sys-canary-signal"
+	canaryQueryType         = "temporal-sys-canary-query"
+
+	// probeTimeout is the max time a single probe, including its workflow, is allowed to take
+	probeTimeout = 30 * time.Second
+	// probeTimerDuration is the timer duration used by ProbeTimer
+	probeTimerDuration = time.Second
+	// probeContinueAsNewCount is the number of runs continued as new by ProbeContinueAsNew
+	probeContinueAsNewCount = 3
+)
+
+const (
+	// ProbeStart starts a workflow which completes right away
+	ProbeStart = "start"
+	// ProbeSignal starts a workflow and signals it to complete
+	ProbeSignal = "signal"
+	// ProbeQuery starts a workflow, queries it and then signals it to complete
+	ProbeQuery = "query"
+	// ProbeTimer starts a workflow which completes after a short timer
+	ProbeTimer = "timer"
+	// ProbeActivityTask starts a workflow which completes after executing an activity task
+	ProbeActivityTask = "activity"
+	// ProbeContinueAsNew starts a workflow which continues as new a few times before completing
+	ProbeContinueAsNew = "continue-as-new"
+)
+
+// AllProbes is the probes executed by every run of the canary workflow
+var AllProbes = []string{ProbeStart, ProbeSignal, ProbeQuery, ProbeTimer, ProbeActivityTask, ProbeContinueAsNew}
+
+var (
+	canaryWFStartOptions = sdkclient.StartWorkflowOptions{
+		ID:                    WorkflowID,
+		TaskQueue:             TaskQueueName,
+		WorkflowRunTimeout:    5 * time.Minute,
+		WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+		CronSchedule:          "* * * * *",
+	}
+
+	probeActivityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    probeTimeout,
+		// probes are not retried so that every failure is reported
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 1,
+			MaximumAttempts:    1,
+		},
+	}
+
+	echoActivityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: probeTimeout,
+		StartToCloseTimeout:    probeTimeout,
+	}
+
+	reportActivityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    probeTimeout,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2,
+			MaximumAttempts:    3,
+		},
+	}
+)
+
+type (
+	// ProbeResult is the result of a probe as seen by the canary workflow
+	ProbeResult struct {
+		Probe  string
+		Failed bool
+	}
+)
+
+// CanaryWorkflow is the driver cron workflow of the canary. Every run executes all probes in parallel,
+// each probe reports its own latency to metrics. Failures are reported by the workflow, so that
+// probes which time out or never get to run because of the cluster are counted as well.
+func CanaryWorkflow(ctx workflow.Context) error {
+	opt := workflow.WithActivityOptions(ctx, probeActivityOptions)
+	futures := make([]workflow.Future, 0, len(AllProbes))
+	for _, probe := range AllProbes {
+		futures = append(futures, workflow.ExecuteActivity(opt, probeActivityName, probe))
+	}
+
+	logger := workflow.GetLogger(ctx)
+	results := make([]ProbeResult, 0, len(AllProbes))
+	for i, future := range futures {
+		err := future.Get(ctx, nil)
+		if err != nil {
+			logger.Warn("Canary probe failed.", "probe", AllProbes[i], "error", err)
+		}
+		results = append(results, ProbeResult{Probe: AllProbes[i], Failed: err != nil})
+	}
+
+	opt = workflow.WithActivityOptions(ctx, reportActivityOptions)
+	return workflow.ExecuteActivity(opt, reportActivityName, results).Get(ctx, nil)
+}
+
+// EchoWorkflow completes right away
+func EchoWorkflow(ctx workflow.Context) error {
+	return nil
+}
+
+// SignalWorkflow answers queries until it receives a signal
+func SignalWorkflow(ctx workflow.Context) error {
+	if err := workflow.SetQueryHandler(ctx, canaryQueryType, func() (string, error) {
+		return canaryQueryType, nil
+	}); err != nil {
+		return err
+	}
+	workflow.GetSignalChannel(ctx, canarySignalName).Receive(ctx, nil)
+	return nil
+}
+
+// TimerWorkflow sleeps for probeTimerDuration
+func TimerWorkflow(ctx workflow.Context) error {
+	return workflow.Sleep(ctx, probeTimerDuration)
+}
+
+// ActivityWorkflow executes an echo activity
+func ActivityWorkflow(ctx workflow.Context) error {
+	opt := workflow.WithActivityOptions(ctx, echoActivityOptions)
+	return workflow.ExecuteActivity(opt, echoActivityName, []byte(canaryQueryType)).Get(ctx, nil)
+}
+
+// ContinueAsNewWorkflow continues as new until remainingRuns reaches zero
+func ContinueAsNewWorkflow(ctx workflow.Context, remainingRuns int) error {
+	if remainingRuns <= 0 {
+		return nil
+	}
+	return workflow.NewContinueAsNewError(ctx, continueAsNewWFTypeName, remainingRuns-1)
+}
+
+// EchoActivity returns its input payload
+func EchoActivity(ctx context.Context, payload []byte) ([]byte, error) {
+	return payload, nil
+}
+
+// ProbeActivity runs a single probe against the cluster through the public client
+// and publishes its latency to metrics
+func ProbeActivity(ctx context.Context, probe string) error {
+	canary := ctx.Value(canaryContextKey).(*Canary)
+	workflowID := fmt.Sprintf("%v-%v-%v", WorkflowID, probe, activity.GetInfo(ctx).WorkflowExecution.RunID)
+	startTime := time.Now()
+	if err := canary.runProbe(ctx, probe, workflowID); err != nil {
+		canary.logger.Warn("Canary probe failed.", tag.Value(probe), tag.WorkflowID(workflowID), tag.Error(err))
+		return err
+	}
+	canary.metricsClient.Scope(metrics.CanaryScope, metrics.CanaryProbeTag(probe)).
+		RecordTimer(metrics.CanaryProbeLatency, time.Since(startTime))
+	return nil
+}
+
+// ReportActivity publishes the results of the probes of a canary run to metrics
+func ReportActivity(ctx context.Context, results []ProbeResult) error {
+	canary := ctx.Value(canaryContextKey).(*Canary)
+	for _, result := range results {
+		scope := canary.metricsClient.Scope(metrics.CanaryScope, metrics.CanaryProbeTag(result.Probe))
+		scope.IncCounter(metrics.CanaryProbeRequests)
+		if result.Failed {
+			scope.IncCounter(metrics.CanaryProbeFailures)
+		}
+	}
+	return nil
+}
+
+func (c *Canary) runProbe(ctx context.Context, probe string, workflowID string) error {
+	options := sdkclient.StartWorkflowOptions{
+		ID:                       workflowID,
+		TaskQueue:                TaskQueueName,
+		WorkflowExecutionTimeout: probeTimeout,
+	}
+
+	switch probe {
+	case ProbeStart:
+		return c.executeWorkflow(ctx, options, echoWFTypeName)
+	case ProbeTimer:
+		return c.executeWorkflow(ctx, options, timerWFTypeName)
+	case ProbeActivityTask:
+		return c.executeWorkflow(ctx, options, activityWFTypeName)
+	case ProbeContinueAsNew:
+		return c.executeWorkflow(ctx, options, continueAsNewWFTypeName, probeContinueAsNewCount)
+	case ProbeSignal, ProbeQuery:
+		run, err := c.svcClient.ExecuteWorkflow(ctx, options, signalWFTypeName)
+		if err != nil {
+			return err
+		}
+		if probe == ProbeQuery {
+			value, err := c.svcClient.QueryWorkflow(ctx, workflowID, run.GetRunID(), canaryQueryType)
+			if err != nil {
+				return err
+			}
+			var result string
+			if err := value.Get(&result); err != nil {
+				return err
+			}
+			if result != canaryQueryType {
+				return fmt.Errorf("unexpected query result: %v", result)
+			}
+		}
+		if err := c.svcClient.SignalWorkflow(ctx, workflowID, run.GetRunID(), canarySignalName, nil); err != nil {
+			return err
+		}
+		return run.Get(ctx, nil)
+	default:
+		return fmt.Errorf("not supported probe: %v, supported probes: %v", probe, AllProbes)
+	}
+}
+
+func (c *Canary) executeWorkflow(
+	ctx context.Context,
+	options sdkclient.StartWorkflowOptions,
+	workflowType string,
+	args ...interface{},
+) error {
+
+	run, err := c.svcClient.ExecuteWorkflow(ctx, options, workflowType, args...)
+	if err != nil {
+		return err
+	}
+	return run.Get(ctx, nil)
+}
@@ -0,0 +1,197 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package canary
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/mocks"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+
+	"go.temporal.io/server/common/log/loggerimpl"
+	"go.temporal.io/server/common/metrics"
+)
+
+type canaryWorkflowTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+}
+
+func TestCanaryWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(canaryWorkflowTestSuite))
+}
+
+func (s *canaryWorkflowTestSuite) registerWorkflows(env *testsuite.TestWorkflowEnvironment) {
+	env.RegisterWorkflowWithOptions(CanaryWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	env.RegisterWorkflowWithOptions(EchoWorkflow, workflow.RegisterOptions{Name: echoWFTypeName})
+	env.RegisterWorkflowWithOptions(SignalWorkflow, workflow.RegisterOptions{Name: signalWFTypeName})
+	env.RegisterWorkflowWithOptions(TimerWorkflow, workflow.RegisterOptions{Name: timerWFTypeName})
+	env.RegisterWorkflowWithOptions(ActivityWorkflow, workflow.RegisterOptions{Name: activityWFTypeName})
+	env.RegisterWorkflowWithOptions(ContinueAsNewWorkflow, workflow.RegisterOptions{Name: continueAsNewWFTypeName})
+	env.RegisterActivityWithOptions(ProbeActivity, activity.RegisterOptions{Name: probeActivityName})
+	env.RegisterActivityWithOptions(EchoActivity, activity.RegisterOptions{Name: echoActivityName})
+	env.RegisterActivityWithOptions(ReportActivity, activity.RegisterOptions{Name: reportActivityName})
+}
+
+func (s *canaryWorkflowTestSuite) newCanary(client *mocks.Client) *Canary {
+	return New(&BootstrapParams{
+		ServiceClient: client,
+		MetricsClient: metrics.NewClient(tally.NoopScope, metrics.Worker),
+		Logger:        loggerimpl.NewNopLogger(),
+	})
+}
+
+func (s *canaryWorkflowTestSuite) TestCanaryWorkflow() {
+	env := s.NewTestWorkflowEnvironment()
+	s.registerWorkflows(env)
+	var results []ProbeResult
+	for _, probe := range AllProbes {
+		env.OnActivity(probeActivityName, mock.Anything, probe).Return(nil).Once()
+		results = append(results, ProbeResult{Probe: probe})
+	}
+	env.OnActivity(reportActivityName, mock.Anything, results).Return(nil).Once()
+	env.ExecuteWorkflow(WorkflowTypeName)
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	env.AssertExpectations(s.T())
+}
+
+func (s *canaryWorkflowTestSuite) TestCanaryWorkflow_ProbeFailure() {
+	env := s.NewTestWorkflowEnvironment()
+	s.registerWorkflows(env)
+	env.OnActivity(probeActivityName, mock.Anything, ProbeTimer).Return(errors.New("probe failed"))
+	env.OnActivity(probeActivityName, mock.Anything, mock.Anything).Return(nil)
+	var results []ProbeResult
+	for _, probe := range AllProbes {
+		results = append(results, ProbeResult{Probe: probe, Failed: probe == ProbeTimer})
+	}
+	env.OnActivity(reportActivityName, mock.Anything, results).Return(nil).Once()
+	env.ExecuteWorkflow(WorkflowTypeName)
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	env.AssertExpectations(s.T())
+}
+
+func (s *canaryWorkflowTestSuite) TestSignalWorkflow() {
+	env := s.NewTestWorkflowEnvironment()
+	s.registerWorkflows(env)
+	env.RegisterDelayedCallback(func() {
+		value, err := env.QueryWorkflow(canaryQueryType)
+		s.NoError(err)
+		var result string
+		s.NoError(value.Get(&result))
+		s.Equal(canaryQueryType, result)
+		env.SignalWorkflow(canarySignalName, nil)
+	}, probeTimerDuration)
+	env.ExecuteWorkflow(signalWFTypeName)
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+}
+
+func (s *canaryWorkflowTestSuite) TestActivityWorkflow() {
+	env := s.NewTestWorkflowEnvironment()
+	s.registerWorkflows(env)
+	env.ExecuteWorkflow(activityWFTypeName)
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+}
+
+func (s *canaryWorkflowTestSuite) TestContinueAsNewWorkflow() {
+	env := s.NewTestWorkflowEnvironment()
+	s.registerWorkflows(env)
+	env.ExecuteWorkflow(continueAsNewWFTypeName, probeContinueAsNewCount)
+	s.True(env.IsWorkflowCompleted())
+	var continueAsNewErr *workflow.ContinueAsNewError
+	s.True(errors.As(env.GetWorkflowError(), &continueAsNewErr))
+
+	env = s.NewTestWorkflowEnvironment()
+	s.registerWorkflows(env)
+	env.ExecuteWorkflow(continueAsNewWFTypeName, 0)
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+}
+
+func (s *canaryWorkflowTestSuite) TestProbeActivity() {
+	client := &mocks.Client{}
+	run := &mocks.WorkflowRun{}
+	client.On("ExecuteWorkflow", mock.Anything, mock.Anything, echoWFTypeName).Return(run, nil).Once()
+	run.On("Get", mock.Anything, nil).Return(nil).Once()
+
+	env := s.NewTestActivityEnvironment()
+	env.RegisterActivityWithOptions(ProbeActivity, activity.RegisterOptions{Name: probeActivityName})
+	env.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), canaryContextKey, s.newCanary(client)),
+	})
+	_, err := env.ExecuteActivity(probeActivityName, ProbeStart)
+	s.NoError(err)
+	client.AssertExpectations(s.T())
+	run.AssertExpectations(s.T())
+}
+
+func (s *canaryWorkflowTestSuite) TestReportActivity() {
+	scope := tally.NewTestScope("test", nil)
+	canary := New(&BootstrapParams{
+		MetricsClient: metrics.NewClient(scope, metrics.Worker),
+		Logger:        loggerimpl.NewNopLogger(),
+	})
+
+	env := s.NewTestActivityEnvironment()
+	env.RegisterActivityWithOptions(ReportActivity, activity.RegisterOptions{Name: reportActivityName})
+	env.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), canaryContextKey, canary),
+	})
+	_, err := env.ExecuteActivity(reportActivityName, []ProbeResult{
+		{Probe: ProbeStart},
+		{Probe: ProbeTimer, Failed: true},
+	})
+	s.NoError(err)
+
+	counters := map[string]int64{}
+	for _, counter := range scope.Snapshot().Counters() {
+		counters[counter.Name()+"/"+counter.Tags()[metrics.CanaryProbeTagName]] += counter.Value()
+	}
+	s.Equal(int64(1), counters["test.canary_probe_requests/"+ProbeStart])
+	s.Equal(int64(0), counters["test.canary_probe_failures/"+ProbeStart])
+	s.Equal(int64(1), counters["test.canary_probe_requests/"+ProbeTimer])
+	s.Equal(int64(1), counters["test.canary_probe_failures/"+ProbeTimer])
+}
+
+func (s *canaryWorkflowTestSuite) TestProbeActivity_UnknownProbe() {
+	env := s.NewTestActivityEnvironment()
+	env.RegisterActivityWithOptions(ProbeActivity, activity.RegisterOptions{Name: probeActivityName})
+	env.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), canaryContextKey, s.newCanary(&mocks.Client{})),
+	})
+	_, err := env.ExecuteActivity(probeActivityName, "unknown")
+	s.Error(err)
+}
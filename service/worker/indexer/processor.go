@@ -31,6 +31,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
@@ -235,14 +236,55 @@ func (p *indexProcessor) generateESDoc(msg *indexerspb.Message, keyToKafkaMsg st
 	return doc
 }
 
-func (p *indexProcessor) decodeSearchAttrBinary(bytes []byte, key string) interface{} {
-	var val interface{}
-	err := json.Unmarshal(bytes, &val)
-	if err != nil {
-		p.logger.Error("Error when decode search attributes values.", tag.Error(err), tag.ESField(key))
-		p.metricsClient.IncCounter(metrics.IndexProcessorScope, metrics.IndexProcessorCorruptedData)
+// decodeSearchAttrBinary decodes the JSON value of a custom search attribute into the type the attribute is
+// registered with, so that ints keep their precision and a value of another type is caught before ES rejects
+// the whole document for it.
+func (p *indexProcessor) decodeSearchAttrBinary(bytes []byte, key string) (interface{}, error) {
+	valueType := common.ConvertIndexedValueTypeToProtoType(p.config.ValidSearchAttributes()[key], p.logger)
+	switch valueType {
+	case enumspb.INDEXED_VALUE_TYPE_STRING, enumspb.INDEXED_VALUE_TYPE_KEYWORD:
+		var val string
+		if err := json.Unmarshal(bytes, &val); err != nil {
+			var listVal []string
+			err = json.Unmarshal(bytes, &listVal)
+			return listVal, err
+		}
+		return val, nil
+	case enumspb.INDEXED_VALUE_TYPE_INT:
+		var val int64
+		if err := json.Unmarshal(bytes, &val); err != nil {
+			var listVal []int64
+			err = json.Unmarshal(bytes, &listVal)
+			return listVal, err
+		}
+		return val, nil
+	case enumspb.INDEXED_VALUE_TYPE_DOUBLE:
+		var val float64
+		if err := json.Unmarshal(bytes, &val); err != nil {
+			var listVal []float64
+			err = json.Unmarshal(bytes, &listVal)
+			return listVal, err
+		}
+		return val, nil
+	case enumspb.INDEXED_VALUE_TYPE_BOOL:
+		var val bool
+		if err := json.Unmarshal(bytes, &val); err != nil {
+			var listVal []bool
+			err = json.Unmarshal(bytes, &listVal)
+			return listVal, err
+		}
+		return val, nil
+	case enumspb.INDEXED_VALUE_TYPE_DATETIME:
+		var val time.Time
+		if err := json.Unmarshal(bytes, &val); err != nil {
+			var listVal []time.Time
+			err = json.Unmarshal(bytes, &listVal)
+			return listVal, err
+		}
+		return val, nil
+	default:
+		return nil, fmt.Errorf("unknown index value type %v", valueType)
 	}
-	return val
 }
 
 func (p *indexProcessor) dumpFieldsToMap(fields map[string]*indexerspb.Field) map[string]interface{} {
@@ -266,7 +308,14 @@ func (p *indexProcessor) dumpFieldsToMap(fields map[string]*indexerspb.Field) ma
 			if k == definition.Memo {
 				doc[k] = v.GetBinaryData()
 			} else { // custom search attributes
-				attr[k] = p.decodeSearchAttrBinary(v.GetBinaryData(), k)
+				val, err := p.decodeSearchAttrBinary(v.GetBinaryData(), k)
+				if err != nil {
+					// index the rest of the document instead of having ES reject it, the value is dropped
+					p.logger.Warn("Unable to decode search attribute value, dropping it.", tag.Error(err), tag.ESField(k))
+					p.metricsClient.IncCounter(metrics.IndexProcessorScope, metrics.IndexProcessorCorruptedData)
+					continue
+				}
+				attr[k] = val
 			}
 		default:
 			// must be bug in code and bad deployment, check data sent from producer
@@ -0,0 +1,99 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package indexer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	indexerspb "go.temporal.io/server/api/indexer/v1"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/log/loggerimpl"
+	"go.temporal.io/server/common/metrics"
+	mmocks "go.temporal.io/server/common/metrics/mocks"
+	"go.temporal.io/server/common/service/dynamicconfig"
+)
+
+type indexProcessorSuite struct {
+	suite.Suite
+	mockMetricClient *mmocks.Client
+	processor        *indexProcessor
+}
+
+func TestIndexProcessorSuite(t *testing.T) {
+	s := new(indexProcessorSuite)
+	suite.Run(t, s)
+}
+
+func (s *indexProcessorSuite) SetupTest() {
+	config := &Config{
+		ValidSearchAttributes: dynamicconfig.GetMapPropertyFn(map[string]interface{}{
+			"CustomIntField":     enumspb.INDEXED_VALUE_TYPE_INT,
+			"CustomBoolField":    enumspb.INDEXED_VALUE_TYPE_BOOL,
+			"CustomKeywordField": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		}),
+	}
+	s.mockMetricClient = &mmocks.Client{}
+	s.processor = &indexProcessor{
+		config:        config,
+		logger:        loggerimpl.NewNopLogger(),
+		metricsClient: s.mockMetricClient,
+	}
+}
+
+func (s *indexProcessorSuite) TearDownTest() {
+	s.mockMetricClient.AssertExpectations(s.T())
+}
+
+func (s *indexProcessorSuite) TestDumpFieldsToMap_CoercesSearchAttributes() {
+	doc := s.processor.dumpFieldsToMap(map[string]*indexerspb.Field{
+		"CustomIntField":     binaryField(`9007199254740993`),
+		"CustomKeywordField": binaryField(`["a","b"]`),
+	})
+
+	attr := doc[definition.Attr].(map[string]interface{})
+	s.Equal(int64(9007199254740993), attr["CustomIntField"])
+	s.Equal([]string{"a", "b"}, attr["CustomKeywordField"])
+}
+
+func (s *indexProcessorSuite) TestDumpFieldsToMap_DropsInvalidSearchAttributes() {
+	s.mockMetricClient.On("IncCounter", metrics.IndexProcessorScope, metrics.IndexProcessorCorruptedData).Once()
+
+	doc := s.processor.dumpFieldsToMap(map[string]*indexerspb.Field{
+		"CustomIntField":  binaryField(`5`),
+		"CustomBoolField": binaryField(`"not-a-bool"`),
+	})
+
+	attr := doc[definition.Attr].(map[string]interface{})
+	s.Equal(int64(5), attr["CustomIntField"])
+	s.NotContains(attr, "CustomBoolField")
+}
+
+func binaryField(data string) *indexerspb.Field {
+	return &indexerspb.Field{Type: enumsspb.FIELD_TYPE_BINARY, Data: &indexerspb.Field_BinaryData{BinaryData: []byte(data)}}
+}
@@ -0,0 +1,86 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package loadgen
+
+import (
+	"context"
+
+	"go.temporal.io/sdk/activity"
+	sdkclient "go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+)
+
+type (
+	// BootstrapParams contains the set of params needed to bootstrap
+	// the load generator sub-system
+	BootstrapParams struct {
+		// ServiceClient is an instance of temporal service client
+		ServiceClient sdkclient.Client
+		// MetricsClient is an instance of metrics object for emitting stats
+		MetricsClient metrics.Client
+		Logger        log.Logger
+	}
+
+	// LoadGenerator is the background sub-system that executes load generation workflows
+	// used to baseline the capacity of a cluster. It is also the context object that gets
+	// passed around within the load generation activities
+	LoadGenerator struct {
+		svcClient     sdkclient.Client
+		metricsClient metrics.Client
+		logger        log.Logger
+	}
+)
+
+// New returns a new instance of load generator daemon LoadGenerator
+func New(params *BootstrapParams) *LoadGenerator {
+	return &LoadGenerator{
+		svcClient:     params.ServiceClient,
+		metricsClient: params.MetricsClient,
+		logger:        params.Logger.WithTags(tag.ComponentLoadGenerator),
+	}
+}
+
+// Start starts the load generator worker
+func (l *LoadGenerator) Start() error {
+	ctx := context.WithValue(context.Background(), loadGenContextKey, l)
+	workerOpts := worker.Options{
+		BackgroundActivityContext: ctx,
+	}
+	loadGenWorker := worker.New(l.svcClient, TaskQueueName, workerOpts)
+	loadGenWorker.RegisterWorkflowWithOptions(LoadGenWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	loadGenWorker.RegisterWorkflowWithOptions(FanOutWorkflow, workflow.RegisterOptions{Name: fanOutWFTypeName})
+	loadGenWorker.RegisterWorkflowWithOptions(LongTimerWorkflow, workflow.RegisterOptions{Name: longTimerWFTypeName})
+	loadGenWorker.RegisterWorkflowWithOptions(SignalHeavyWorkflow, workflow.RegisterOptions{Name: signalHeavyWFTypeName})
+	loadGenWorker.RegisterWorkflowWithOptions(LargePayloadWorkflow, workflow.RegisterOptions{Name: largePayloadWFTypeName})
+	loadGenWorker.RegisterActivityWithOptions(EchoActivity, activity.RegisterOptions{Name: echoActivityName})
+	loadGenWorker.RegisterActivityWithOptions(ReportActivity, activity.RegisterOptions{Name: reportActivityName})
+
+	return loadGenWorker.Start()
+}
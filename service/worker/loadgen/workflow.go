@@ -0,0 +1,298 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package loadgen
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+)
+
+const (
+	loadGenContextKey = "loadGenContext"
+	// TaskQueueName is the taskqueue name
+	TaskQueueName = "temporal-sys-loadgen-taskqueue"
+	// WorkflowTypeName is the workflow type of the load generation driver workflow
+	WorkflowTypeName = "temporal-sys-loadgen-workflow"
+
+	fanOutWFTypeName       = "temporal-sys-loadgen-fanout-workflow"
+	longTimerWFTypeName    = "temporal-sys-loadgen-longtimer-workflow"
+	signalHeavyWFTypeName  = "temporal-sys-loadgen-signalheavy-workflow"
+	largePayloadWFTypeName = "temporal-sys-loadgen-largepayload-workflow"
+	echoActivityName       = "temporal-sys-loadgen-echo-activity"
+	reportActivityName     = "temporal-sys-loadgen-report-activity"
+	loadGenSignalName      = "temporal-sys-loadgen-signal"
+
+	// DefaultConcurrency is the default number of workflows started by a run
+	DefaultConcurrency = 10
+	// DefaultFanOut is the default number of activities per workflow for ShapeFanOut
+	DefaultFanOut = 10
+	// DefaultTimerDuration is the default timer duration for ShapeLongTimer
+	DefaultTimerDuration = 10 * time.Minute
+	// DefaultSignalCount is the default number of signals per workflow for ShapeSignalHeavy
+	DefaultSignalCount = 100
+	// DefaultPayloadSizeBytes is the default payload size for ShapeLargePayload
+	DefaultPayloadSizeBytes = 256 * 1024
+
+	// MaxConcurrency is the max number of workflows a single run can start
+	MaxConcurrency = 1000
+	// MaxFanOut is the max number of activities per workflow for ShapeFanOut
+	MaxFanOut = 1000
+	// MaxSignalCount is the max number of signals per workflow for ShapeSignalHeavy
+	MaxSignalCount = 1000
+	// MaxTotalSignalCount is the max number of signals sent by the driver workflow for ShapeSignalHeavy,
+	// each signal adds two events to the driver history so this keeps it below the history count warn limit
+	MaxTotalSignalCount = 4000
+	// MaxPayloadSizeBytes is the max payload size for ShapeLargePayload, kept at the default blob size
+	// warn limit so that the payload plus its encoding stays well below the blob size error limit
+	MaxPayloadSizeBytes = 512 * 1024
+)
+
+const (
+	// ShapeFanOut runs workflows that each execute FanOut activities in parallel
+	ShapeFanOut = "fan-out"
+	// ShapeLongTimer runs workflows that each sleep for TimerDuration
+	ShapeLongTimer = "long-timer"
+	// ShapeSignalHeavy runs workflows that each wait for SignalCount signals sent by the driver workflow
+	ShapeSignalHeavy = "signal-heavy"
+	// ShapeLargePayload runs workflows that each pass a PayloadSizeBytes payload through an activity
+	ShapeLargePayload = "large-payload"
+)
+
+// AllShapes is the workflow shapes we supported
+var AllShapes = []string{ShapeFanOut, ShapeLongTimer, ShapeSignalHeavy, ShapeLargePayload}
+
+var shapeWorkflowTypes = map[string]string{
+	ShapeFanOut:       fanOutWFTypeName,
+	ShapeLongTimer:    longTimerWFTypeName,
+	ShapeSignalHeavy:  signalHeavyWFTypeName,
+	ShapeLargePayload: largePayloadWFTypeName,
+}
+
+type (
+	// LoadParams is the parameters for load generation workflow
+	LoadParams struct {
+		// Supporting: fan-out,long-timer,signal-heavy,large-payload
+		Shape string
+
+		// Below are all optional
+		// Number of workflows started in parallel. Default to DefaultConcurrency
+		Concurrency int
+		// Number of activities per workflow, only for ShapeFanOut. Default to DefaultFanOut
+		FanOut int
+		// Timer duration, only for ShapeLongTimer. Default to DefaultTimerDuration
+		TimerDuration time.Duration
+		// Number of signals per workflow, only for ShapeSignalHeavy. Default to DefaultSignalCount
+		SignalCount int
+		// Payload size, only for ShapeLargePayload. Default to DefaultPayloadSizeBytes
+		PayloadSizeBytes int
+	}
+
+	// LoadResult is the result of a load generation run, also published to metrics
+	LoadResult struct {
+		Shape string
+		// Number of workflows started
+		Started int
+		// Number of workflows completed successfully
+		Completed int
+		// Number of workflows failed
+		Failed int
+		// Time between the start of the run and the completion of all its workflows
+		Duration time.Duration
+	}
+)
+
+var (
+	loadGenActivityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: 5 * time.Minute,
+		StartToCloseTimeout:    time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2,
+			MaximumInterval:    time.Minute,
+		},
+	}
+)
+
+// LoadGenWorkflow is the driver workflow of a load generation run. It starts Concurrency workflows
+// of the requested shape, waits for all of them to close and publishes the result to metrics.
+// Child workflow IDs are derived from the driver workflow ID so that runs are deterministic.
+func LoadGenWorkflow(ctx workflow.Context, params LoadParams) (LoadResult, error) {
+	params = setDefaultParams(params)
+	if err := validateParams(params); err != nil {
+		return LoadResult{}, err
+	}
+
+	startTime := workflow.Now(ctx)
+	workflowID := workflow.GetInfo(ctx).WorkflowExecution.ID
+	futures := make([]workflow.ChildWorkflowFuture, 0, params.Concurrency)
+	for i := 0; i < params.Concurrency; i++ {
+		childCtx := workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+			WorkflowID: fmt.Sprintf("%v-%v", workflowID, i),
+		})
+		futures = append(futures, workflow.ExecuteChildWorkflow(childCtx, shapeWorkflowTypes[params.Shape], params))
+	}
+
+	if params.Shape == ShapeSignalHeavy {
+		sendSignals(ctx, futures, params.SignalCount)
+	}
+
+	result := LoadResult{
+		Shape:   params.Shape,
+		Started: len(futures),
+	}
+	for _, future := range futures {
+		if err := future.Get(ctx, nil); err != nil {
+			result.Failed++
+		} else {
+			result.Completed++
+		}
+	}
+	result.Duration = workflow.Now(ctx).Sub(startTime)
+
+	opt := workflow.WithActivityOptions(ctx, loadGenActivityOptions)
+	err := workflow.ExecuteActivity(opt, reportActivityName, result).Get(ctx, nil)
+	return result, err
+}
+
+func sendSignals(ctx workflow.Context, futures []workflow.ChildWorkflowFuture, signalCount int) {
+	for _, future := range futures {
+		if err := future.GetChildWorkflowExecution().Get(ctx, nil); err != nil {
+			// child failed to start, it is accounted as failed when waiting for its result
+			continue
+		}
+		signalFutures := make([]workflow.Future, 0, signalCount)
+		for i := 0; i < signalCount; i++ {
+			signalFutures = append(signalFutures, future.SignalChildWorkflow(ctx, loadGenSignalName, i))
+		}
+		for _, signalFuture := range signalFutures {
+			_ = signalFuture.Get(ctx, nil)
+		}
+	}
+}
+
+// FanOutWorkflow executes FanOut activities in parallel and waits for all of them to complete
+func FanOutWorkflow(ctx workflow.Context, params LoadParams) error {
+	opt := workflow.WithActivityOptions(ctx, loadGenActivityOptions)
+	futures := make([]workflow.Future, 0, params.FanOut)
+	for i := 0; i < params.FanOut; i++ {
+		futures = append(futures, workflow.ExecuteActivity(opt, echoActivityName, []byte(nil)))
+	}
+	for _, future := range futures {
+		if err := future.Get(ctx, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LongTimerWorkflow sleeps for TimerDuration
+func LongTimerWorkflow(ctx workflow.Context, params LoadParams) error {
+	return workflow.Sleep(ctx, params.TimerDuration)
+}
+
+// SignalHeavyWorkflow waits for SignalCount signals
+func SignalHeavyWorkflow(ctx workflow.Context, params LoadParams) error {
+	signalCh := workflow.GetSignalChannel(ctx, loadGenSignalName)
+	for i := 0; i < params.SignalCount; i++ {
+		signalCh.Receive(ctx, nil)
+	}
+	return nil
+}
+
+// LargePayloadWorkflow passes a PayloadSizeBytes payload through an activity
+func LargePayloadWorkflow(ctx workflow.Context, params LoadParams) error {
+	opt := workflow.WithActivityOptions(ctx, loadGenActivityOptions)
+	payload := make([]byte, params.PayloadSizeBytes)
+	return workflow.ExecuteActivity(opt, echoActivityName, payload).Get(ctx, nil)
+}
+
+// EchoActivity returns its input payload
+func EchoActivity(ctx context.Context, payload []byte) ([]byte, error) {
+	return payload, nil
+}
+
+// ReportActivity publishes the result of a load generation run to metrics
+func ReportActivity(ctx context.Context, result LoadResult) error {
+	loadGen := ctx.Value(loadGenContextKey).(*LoadGenerator)
+	scope := loadGen.metricsClient.Scope(metrics.LoadGeneratorScope, metrics.WorkflowTypeTag(shapeWorkflowTypes[result.Shape]))
+	scope.AddCounter(metrics.LoadGeneratorWorkflowsStarted, int64(result.Started))
+	scope.AddCounter(metrics.LoadGeneratorWorkflowsCompleted, int64(result.Completed))
+	scope.AddCounter(metrics.LoadGeneratorWorkflowsFailed, int64(result.Failed))
+	scope.RecordTimer(metrics.LoadGeneratorRunLatency, result.Duration)
+
+	loadGen.logger.Info("Load generation run completed.",
+		tag.WorkflowType(shapeWorkflowTypes[result.Shape]),
+		tag.Value(result))
+	return nil
+}
+
+func setDefaultParams(params LoadParams) LoadParams {
+	if params.Concurrency <= 0 {
+		params.Concurrency = DefaultConcurrency
+	}
+	if params.FanOut <= 0 {
+		params.FanOut = DefaultFanOut
+	}
+	if params.TimerDuration <= 0 {
+		params.TimerDuration = DefaultTimerDuration
+	}
+	if params.SignalCount <= 0 {
+		params.SignalCount = DefaultSignalCount
+	}
+	if params.PayloadSizeBytes <= 0 {
+		params.PayloadSizeBytes = DefaultPayloadSizeBytes
+	}
+	return params
+}
+
+func validateParams(params LoadParams) error {
+	if _, ok := shapeWorkflowTypes[params.Shape]; !ok {
+		return fmt.Errorf("not supported shape: %v, supported shapes: %v", params.Shape, AllShapes)
+	}
+	if params.Concurrency > MaxConcurrency {
+		return fmt.Errorf("concurrency %v exceeds max %v", params.Concurrency, MaxConcurrency)
+	}
+	if params.FanOut > MaxFanOut {
+		return fmt.Errorf("fan out %v exceeds max %v", params.FanOut, MaxFanOut)
+	}
+	if params.SignalCount > MaxSignalCount {
+		return fmt.Errorf("signal count %v exceeds max %v", params.SignalCount, MaxSignalCount)
+	}
+	if params.Shape == ShapeSignalHeavy && params.Concurrency*params.SignalCount > MaxTotalSignalCount {
+		return fmt.Errorf("concurrency %v times signal count %v exceeds max total signal count %v",
+			params.Concurrency, params.SignalCount, MaxTotalSignalCount)
+	}
+	if params.PayloadSizeBytes > MaxPayloadSizeBytes {
+		return fmt.Errorf("payload size %v exceeds max %v", params.PayloadSizeBytes, MaxPayloadSizeBytes)
+	}
+	return nil
+}
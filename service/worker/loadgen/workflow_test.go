@@ -0,0 +1,136 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package loadgen
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+
+	"go.temporal.io/server/common/log/loggerimpl"
+	"go.temporal.io/server/common/metrics"
+)
+
+type loadGenWorkflowTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+}
+
+func TestLoadGenWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(loadGenWorkflowTestSuite))
+}
+
+func (s *loadGenWorkflowTestSuite) registerWorkflows(env *testsuite.TestWorkflowEnvironment) {
+	env.RegisterWorkflowWithOptions(LoadGenWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	env.RegisterWorkflowWithOptions(FanOutWorkflow, workflow.RegisterOptions{Name: fanOutWFTypeName})
+	env.RegisterWorkflowWithOptions(LongTimerWorkflow, workflow.RegisterOptions{Name: longTimerWFTypeName})
+	env.RegisterWorkflowWithOptions(SignalHeavyWorkflow, workflow.RegisterOptions{Name: signalHeavyWFTypeName})
+	env.RegisterWorkflowWithOptions(LargePayloadWorkflow, workflow.RegisterOptions{Name: largePayloadWFTypeName})
+	env.RegisterActivityWithOptions(EchoActivity, activity.RegisterOptions{Name: echoActivityName})
+	env.RegisterActivityWithOptions(ReportActivity, activity.RegisterOptions{Name: reportActivityName})
+}
+
+func (s *loadGenWorkflowTestSuite) executeWorkflow(params LoadParams) LoadResult {
+	env := s.NewTestWorkflowEnvironment()
+	s.registerWorkflows(env)
+	env.OnActivity(reportActivityName, mock.Anything, mock.Anything).Return(nil)
+	env.ExecuteWorkflow(WorkflowTypeName, params)
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+
+	var result LoadResult
+	s.NoError(env.GetWorkflowResult(&result))
+	return result
+}
+
+func (s *loadGenWorkflowTestSuite) TestFanOut() {
+	result := s.executeWorkflow(LoadParams{Shape: ShapeFanOut, Concurrency: 3, FanOut: 5})
+	s.Equal(ShapeFanOut, result.Shape)
+	s.Equal(3, result.Started)
+	s.Equal(3, result.Completed)
+	s.Equal(0, result.Failed)
+}
+
+func (s *loadGenWorkflowTestSuite) TestLongTimer() {
+	result := s.executeWorkflow(LoadParams{Shape: ShapeLongTimer, Concurrency: 2, TimerDuration: time.Hour})
+	s.Equal(2, result.Completed)
+	s.True(result.Duration >= time.Hour)
+}
+
+func (s *loadGenWorkflowTestSuite) TestSignalHeavy() {
+	result := s.executeWorkflow(LoadParams{Shape: ShapeSignalHeavy, Concurrency: 2, SignalCount: 10})
+	s.Equal(2, result.Completed)
+}
+
+func (s *loadGenWorkflowTestSuite) TestLargePayload() {
+	result := s.executeWorkflow(LoadParams{Shape: ShapeLargePayload, Concurrency: 2, PayloadSizeBytes: 1024})
+	s.Equal(2, result.Completed)
+}
+
+func (s *loadGenWorkflowTestSuite) TestInvalidShape() {
+	env := s.NewTestWorkflowEnvironment()
+	s.registerWorkflows(env)
+	env.ExecuteWorkflow(WorkflowTypeName, LoadParams{Shape: "unknown"})
+	s.True(env.IsWorkflowCompleted())
+	s.Error(env.GetWorkflowError())
+}
+
+func (s *loadGenWorkflowTestSuite) TestPayloadSizeExceedsMax() {
+	env := s.NewTestWorkflowEnvironment()
+	s.registerWorkflows(env)
+	env.ExecuteWorkflow(WorkflowTypeName, LoadParams{Shape: ShapeLargePayload, PayloadSizeBytes: MaxPayloadSizeBytes + 1})
+	s.True(env.IsWorkflowCompleted())
+	s.Error(env.GetWorkflowError())
+}
+
+func (s *loadGenWorkflowTestSuite) TestTotalSignalCountExceedsMax() {
+	env := s.NewTestWorkflowEnvironment()
+	s.registerWorkflows(env)
+	env.ExecuteWorkflow(WorkflowTypeName, LoadParams{Shape: ShapeSignalHeavy, Concurrency: MaxConcurrency, SignalCount: MaxSignalCount})
+	s.True(env.IsWorkflowCompleted())
+	s.Error(env.GetWorkflowError())
+}
+
+func (s *loadGenWorkflowTestSuite) TestReportActivity() {
+	env := s.NewTestActivityEnvironment()
+	env.RegisterActivityWithOptions(ReportActivity, activity.RegisterOptions{Name: reportActivityName})
+	loadGen := New(&BootstrapParams{
+		MetricsClient: metrics.NewClient(tally.NoopScope, metrics.Worker),
+		Logger:        loggerimpl.NewNopLogger(),
+	})
+	env.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), loadGenContextKey, loadGen),
+	})
+	_, err := env.ExecuteActivity(reportActivityName, LoadResult{Shape: ShapeFanOut, Started: 1, Completed: 1})
+	s.NoError(err)
+}
@@ -35,6 +35,7 @@ import (
 	"go.temporal.io/sdk/workflow"
 
 	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/claimcheck"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/resource"
@@ -62,6 +63,14 @@ type (
 		HistoryScannerEnabled dynamicconfig.BoolPropertyFn
 		// ExecutionsScannerEnabled indicates if executions scanner should be started as part of scanner
 		ExecutionsScannerEnabled dynamicconfig.BoolPropertyFn
+		// StorageUsageScannerEnabled indicates if storage usage scanner should be started as part of scanner
+		StorageUsageScannerEnabled dynamicconfig.BoolPropertyFn
+		// ClaimCheckScannerEnabled indicates if claim check scanner should be started as part of scanner
+		ClaimCheckScannerEnabled dynamicconfig.BoolPropertyFn
+		// ClaimCheckBlobLifetime is the minimum age of claim check blobs before they are deleted
+		ClaimCheckBlobLifetime dynamicconfig.DurationPropertyFnWithNamespaceFilter
+		// ClaimCheckStore is the store claim check blobs are offloaded to, nil if claim check is disabled
+		ClaimCheckStore claimcheck.Store
 	}
 
 	// BootstrapParams contains the set of params needed to bootstrap
@@ -120,6 +129,16 @@ func (s *Scanner) Start() error {
 		workerTaskQueueNames = append(workerTaskQueueNames, executionsScannerTaskQueueName)
 	}
 
+	if s.context.cfg.StorageUsageScannerEnabled() {
+		go s.startWorkflowWithRetry(storageUsageScannerWFStartOptions, storageUsageScannerWFTypeName)
+		workerTaskQueueNames = append(workerTaskQueueNames, storageUsageScannerTaskQueueName)
+	}
+
+	if s.context.cfg.ClaimCheckStore != nil && s.context.cfg.ClaimCheckScannerEnabled() {
+		go s.startWorkflowWithRetry(claimCheckScannerWFStartOptions, claimCheckScannerWFTypeName)
+		workerTaskQueueNames = append(workerTaskQueueNames, claimCheckScannerTaskQueueName)
+	}
+
 	if s.context.cfg.Persistence.DefaultStoreType() == config.StoreTypeSQL && s.context.cfg.TaskQueueScannerEnabled() {
 		go s.startWorkflowWithRetry(tlScannerWFStartOptions, tqScannerWFTypeName)
 		workerTaskQueueNames = append(workerTaskQueueNames, tqScannerTaskQueueName)
@@ -134,9 +153,13 @@ func (s *Scanner) Start() error {
 		work.RegisterWorkflowWithOptions(TaskQueueScannerWorkflow, workflow.RegisterOptions{Name: tqScannerWFTypeName})
 		work.RegisterWorkflowWithOptions(HistoryScannerWorkflow, workflow.RegisterOptions{Name: historyScannerWFTypeName})
 		work.RegisterWorkflowWithOptions(ExecutionsScannerWorkflow, workflow.RegisterOptions{Name: executionsScannerWFTypeName})
+		work.RegisterWorkflowWithOptions(StorageUsageScannerWorkflow, workflow.RegisterOptions{Name: storageUsageScannerWFTypeName})
+		work.RegisterWorkflowWithOptions(ClaimCheckScannerWorkflow, workflow.RegisterOptions{Name: claimCheckScannerWFTypeName})
 		work.RegisterActivityWithOptions(TaskQueueScavengerActivity, activity.RegisterOptions{Name: taskQueueScavengerActivityName})
 		work.RegisterActivityWithOptions(HistoryScavengerActivity, activity.RegisterOptions{Name: historyScavengerActivityName})
 		work.RegisterActivityWithOptions(ExecutionsScavengerActivity, activity.RegisterOptions{Name: executionsScavengerActivityName})
+		work.RegisterActivityWithOptions(StorageUsageAccountActivity, activity.RegisterOptions{Name: storageUsageAccountActivityName})
+		work.RegisterActivityWithOptions(ClaimCheckCleanupActivity, activity.RegisterOptions{Name: claimCheckCleanupActivityName})
 
 		if err := work.Start(); err != nil {
 			return err
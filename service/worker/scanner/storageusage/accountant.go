@@ -0,0 +1,255 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storageusage
+
+import (
+	"context"
+
+	"go.temporal.io/sdk/activity"
+	"golang.org/x/time/rate"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/collection"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+)
+
+type (
+	// Usage is the approximate storage footprint of a set of workflow executions
+	Usage struct {
+		ExecutionCount    int64
+		HistoryBytes      int64
+		MutableStateBytes int64
+		VisibilityBytes   int64
+	}
+
+	// Report is the result of one storage usage rollup
+	Report struct {
+		// Namespaces is the usage keyed by namespace ID
+		Namespaces map[string]*Usage
+	}
+
+	// HeartbeatDetails is the heartbeat detail for StorageUsageActivity
+	HeartbeatDetails struct {
+		// NextShardID is the first shard which is not yet accounted in Report
+		NextShardID int32
+		Report      Report
+	}
+
+	// Accountant is the type that holds the state for the storage usage rollup
+	Accountant struct {
+		execMgrFactory   persistence.ExecutionManagerFactory
+		namespaceCache   cache.NamespaceCache
+		numHistoryShards int32
+		limiter          *rate.Limiter
+		hbd              HeartbeatDetails
+		metrics          metrics.Client
+		logger           log.Logger
+		isInTest         bool
+	}
+)
+
+const (
+	pageSize = 1000
+	// visibilityRecordOverheadBytes approximates the size of the fixed columns of a visibility record
+	visibilityRecordOverheadBytes = 128
+)
+
+// NewAccountant returns an instance of storage usage accountant
+// The Accountant can be started by calling the Run() method on the
+// returned object. Calling the Run() method will result in one
+// complete iteration over all of the workflow executions of all shards,
+// summing up the approximate bytes they store in history, mutable state
+// and visibility, per shard and per namespace
+func NewAccountant(
+	execMgrFactory persistence.ExecutionManagerFactory,
+	namespaceCache cache.NamespaceCache,
+	numHistoryShards int32,
+	rps int,
+	hbd HeartbeatDetails,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) *Accountant {
+
+	if hbd.NextShardID == 0 {
+		hbd.NextShardID = 1
+	}
+	if hbd.Report.Namespaces == nil {
+		hbd.Report.Namespaces = make(map[string]*Usage)
+	}
+
+	return &Accountant{
+		execMgrFactory:   execMgrFactory,
+		namespaceCache:   namespaceCache,
+		numHistoryShards: numHistoryShards,
+		limiter:          rate.NewLimiter(rate.Limit(rps), rps),
+		hbd:              hbd,
+		metrics:          metricsClient,
+		logger:           logger,
+	}
+}
+
+// Run runs the accountant and returns the rollup of all shards
+func (a *Accountant) Run(ctx context.Context) (Report, error) {
+	for ; a.hbd.NextShardID <= a.numHistoryShards; a.hbd.NextShardID++ {
+		shardUsage, namespaceUsage, err := a.accountShard(ctx, a.hbd.NextShardID)
+		if err != nil {
+			a.logger.Error("Unable to account storage usage of shard.", tag.ShardID(a.hbd.NextShardID), tag.Error(err))
+			return a.hbd.Report, err
+		}
+
+		a.emitShardUsage(shardUsage)
+		for namespaceID, usage := range namespaceUsage {
+			a.hbd.Report.getOrCreate(namespaceID).add(usage)
+		}
+		if !a.isInTest {
+			activity.RecordHeartbeat(ctx, a.hbd)
+		}
+	}
+
+	for namespaceID, usage := range a.hbd.Report.Namespaces {
+		a.emitNamespaceUsage(namespaceID, usage)
+	}
+	return a.hbd.Report, nil
+}
+
+func (a *Accountant) accountShard(
+	ctx context.Context,
+	shardID int32,
+) (*Usage, map[string]*Usage, error) {
+
+	execMgr, err := a.execMgrFactory.NewExecutionManager(shardID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	shardUsage := &Usage{}
+	namespaceUsage := make(map[string]*Usage)
+	iter := collection.NewPagingIterator(a.getPaginationFn(ctx, execMgr))
+	for iter.HasNext() {
+		item, err := iter.Next()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		state := item.(*persistencespb.WorkflowMutableState)
+		usage := executionUsage(state)
+		shardUsage.add(usage)
+		if _, ok := namespaceUsage[state.ExecutionInfo.NamespaceId]; !ok {
+			namespaceUsage[state.ExecutionInfo.NamespaceId] = &Usage{}
+		}
+		namespaceUsage[state.ExecutionInfo.NamespaceId].add(usage)
+	}
+	return shardUsage, namespaceUsage, nil
+}
+
+func (a *Accountant) getPaginationFn(
+	ctx context.Context,
+	execMgr persistence.ExecutionManager,
+) collection.PaginationFn {
+
+	return func(paginationToken []byte) ([]interface{}, []byte, error) {
+		if err := a.limiter.Wait(ctx); err != nil {
+			return nil, nil, err
+		}
+		resp, err := execMgr.ListConcreteExecutions(&persistence.ListConcreteExecutionsRequest{
+			PageSize:  pageSize,
+			PageToken: paginationToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		var paginateItems []interface{}
+		for _, state := range resp.States {
+			paginateItems = append(paginateItems, state)
+		}
+		return paginateItems, resp.PageToken, nil
+	}
+}
+
+// emitShardUsage records the usage of a shard to distributions, a per shard tag would create a series per shard
+func (a *Accountant) emitShardUsage(usage *Usage) {
+	scope := a.metrics.Scope(metrics.StorageUsageScope)
+	scope.RecordDistribution(metrics.StorageUsageShardExecutionCount, int(usage.ExecutionCount))
+	scope.RecordDistribution(metrics.StorageUsageShardHistoryBytes, int(usage.HistoryBytes))
+	scope.RecordDistribution(metrics.StorageUsageShardMutableStateBytes, int(usage.MutableStateBytes))
+	scope.RecordDistribution(metrics.StorageUsageShardVisibilityBytes, int(usage.VisibilityBytes))
+}
+
+func (a *Accountant) emitNamespaceUsage(namespaceID string, usage *Usage) {
+	namespaceTag := metrics.NamespaceUnknownTag()
+	if namespace, err := a.namespaceCache.GetNamespaceName(namespaceID); err == nil {
+		namespaceTag = metrics.NamespaceTag(namespace)
+	}
+	usage.emit(a.metrics.Scope(metrics.StorageUsageScope, namespaceTag))
+}
+
+// executionUsage approximates the bytes stored by a single workflow execution
+func executionUsage(state *persistencespb.WorkflowMutableState) *Usage {
+	executionInfo := state.ExecutionInfo
+	visibilityBytes := visibilityRecordOverheadBytes +
+		len(executionInfo.WorkflowId) +
+		len(executionInfo.WorkflowTypeName) +
+		len(executionInfo.TaskQueue)
+	for key, payload := range executionInfo.Memo {
+		visibilityBytes += len(key) + payload.Size()
+	}
+	for key, payload := range executionInfo.SearchAttributes {
+		visibilityBytes += len(key) + payload.Size()
+	}
+
+	return &Usage{
+		ExecutionCount:    1,
+		HistoryBytes:      executionInfo.GetExecutionStats().GetHistorySize(),
+		MutableStateBytes: int64(state.Size()),
+		VisibilityBytes:   int64(visibilityBytes),
+	}
+}
+
+func (r *Report) getOrCreate(namespaceID string) *Usage {
+	usage, ok := r.Namespaces[namespaceID]
+	if !ok {
+		usage = &Usage{}
+		r.Namespaces[namespaceID] = usage
+	}
+	return usage
+}
+
+func (u *Usage) add(other *Usage) {
+	u.ExecutionCount += other.ExecutionCount
+	u.HistoryBytes += other.HistoryBytes
+	u.MutableStateBytes += other.MutableStateBytes
+	u.VisibilityBytes += other.VisibilityBytes
+}
+
+func (u *Usage) emit(scope metrics.Scope) {
+	scope.UpdateGauge(metrics.StorageUsageExecutionCount, float64(u.ExecutionCount))
+	scope.UpdateGauge(metrics.StorageUsageHistoryBytes, float64(u.HistoryBytes))
+	scope.UpdateGauge(metrics.StorageUsageMutableStateBytes, float64(u.MutableStateBytes))
+	scope.UpdateGauge(metrics.StorageUsageVisibilityBytes, float64(u.VisibilityBytes))
+}
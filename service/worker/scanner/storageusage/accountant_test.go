@@ -0,0 +1,169 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storageusage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	commonpb "go.temporal.io/api/common/v1"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/log/loggerimpl"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+)
+
+type (
+	accountantSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller         *gomock.Controller
+		mockExecMgrFactory *persistence.MockExecutionManagerFactory
+		mockNamespaceCache *cache.MockNamespaceCache
+	}
+)
+
+func TestAccountantSuite(t *testing.T) {
+	s := new(accountantSuite)
+	suite.Run(t, s)
+}
+
+func (s *accountantSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.mockExecMgrFactory = persistence.NewMockExecutionManagerFactory(s.controller)
+	s.mockNamespaceCache = cache.NewMockNamespaceCache(s.controller)
+	s.mockNamespaceCache.EXPECT().GetNamespaceName(gomock.Any()).Return("some random namespace name", nil).AnyTimes()
+}
+
+func (s *accountantSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *accountantSuite) newAccountant(numHistoryShards int32, hbd HeartbeatDetails) *Accountant {
+	accountant := NewAccountant(
+		s.mockExecMgrFactory,
+		s.mockNamespaceCache,
+		numHistoryShards,
+		100,
+		hbd,
+		metrics.NewClient(tally.NoopScope, metrics.Worker),
+		loggerimpl.NewNopLogger(),
+	)
+	accountant.isInTest = true
+	return accountant
+}
+
+func (s *accountantSuite) newState(namespaceID string, historySize int64) *persistencespb.WorkflowMutableState {
+	return &persistencespb.WorkflowMutableState{
+		ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+			NamespaceId: namespaceID,
+			WorkflowId:  "some random workflow ID",
+			Memo: map[string]*commonpb.Payload{
+				"some random memo key": {Data: []byte("some random memo value")},
+			},
+			ExecutionStats: &persistencespb.ExecutionStats{
+				HistorySize: historySize,
+			},
+		},
+		ExecutionState: &persistencespb.WorkflowExecutionState{},
+	}
+}
+
+func (s *accountantSuite) expectShard(shardID int32, states ...*persistencespb.WorkflowMutableState) {
+	execMgr := persistence.NewMockExecutionManager(s.controller)
+	execMgr.EXPECT().ListConcreteExecutions(gomock.Any()).Return(&persistence.ListConcreteExecutionsResponse{
+		States: states,
+	}, nil)
+	s.mockExecMgrFactory.EXPECT().NewExecutionManager(shardID).Return(execMgr, nil)
+}
+
+func (s *accountantSuite) TestRun() {
+	stateA1 := s.newState("namespace A", 100)
+	stateA2 := s.newState("namespace A", 200)
+	stateB := s.newState("namespace B", 300)
+	s.expectShard(1, stateA1, stateB)
+	s.expectShard(2, stateA2)
+
+	report, err := s.newAccountant(2, HeartbeatDetails{}).Run(context.Background())
+	s.NoError(err)
+	s.Len(report.Namespaces, 2)
+
+	usageA := report.Namespaces["namespace A"]
+	s.Equal(int64(2), usageA.ExecutionCount)
+	s.Equal(int64(300), usageA.HistoryBytes)
+	s.Equal(int64(stateA1.Size()+stateA2.Size()), usageA.MutableStateBytes)
+	s.Equal(executionUsage(stateA1).VisibilityBytes+executionUsage(stateA2).VisibilityBytes, usageA.VisibilityBytes)
+
+	usageB := report.Namespaces["namespace B"]
+	s.Equal(int64(1), usageB.ExecutionCount)
+	s.Equal(int64(300), usageB.HistoryBytes)
+}
+
+func (s *accountantSuite) TestRun_ShardUsageDistribution() {
+	s.expectShard(1, s.newState("namespace A", 100))
+	s.expectShard(2, s.newState("namespace A", 200))
+
+	scope := tally.NewTestScope("test", nil)
+	accountant := s.newAccountant(2, HeartbeatDetails{})
+	accountant.metrics = metrics.NewClient(scope, metrics.Worker)
+	_, err := accountant.Run(context.Background())
+	s.NoError(err)
+
+	// both shards are recorded to the same series
+	var historyBytes []time.Duration
+	for _, timer := range scope.Snapshot().Timers() {
+		s.NotContains(timer.Tags(), "shard_id")
+		if timer.Name() == "test.storage_usage_shard_history_bytes" {
+			historyBytes = append(historyBytes, timer.Values()...)
+		}
+	}
+	s.ElementsMatch([]time.Duration{100 * time.Millisecond, 200 * time.Millisecond}, historyBytes)
+}
+
+func (s *accountantSuite) TestRun_ResumeFromHeartbeat() {
+	s.expectShard(2, s.newState("namespace A", 200))
+
+	report, err := s.newAccountant(2, HeartbeatDetails{
+		NextShardID: 2,
+		Report: Report{
+			Namespaces: map[string]*Usage{
+				"namespace A": {ExecutionCount: 1, HistoryBytes: 100},
+			},
+		},
+	}).Run(context.Background())
+	s.NoError(err)
+	s.Equal(int64(2), report.Namespaces["namespace A"].ExecutionCount)
+	s.Equal(int64(300), report.Namespaces["namespace A"].HistoryBytes)
+}
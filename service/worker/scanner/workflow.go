@@ -26,18 +26,27 @@ package scanner
 
 import (
 	"context"
+	"strconv"
 	"time"
 
+	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/sdk/activity"
 	"go.temporal.io/sdk/client"
 	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/workflow"
 
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/claimcheck"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/service/worker/scanner/executions"
 	"go.temporal.io/server/service/worker/scanner/history"
+	"go.temporal.io/server/service/worker/scanner/storageusage"
 	"go.temporal.io/server/service/worker/scanner/taskqueue"
 )
 
@@ -76,6 +85,16 @@ const (
 	executionsScannerWFTypeName     = "temporal-sys-executions-scanner-workflow"
 	executionsScannerTaskQueueName  = "temporal-sys-executions-scanner-taskqueue-0"
 	executionsScavengerActivityName = "temporal-sys-executions-scanner-scvg-activity"
+
+	storageUsageScannerWFID          = "temporal-sys-storage-usage-scanner"
+	storageUsageScannerWFTypeName    = "temporal-sys-storage-usage-scanner-workflow"
+	storageUsageScannerTaskQueueName = "temporal-sys-storage-usage-scanner-taskqueue-0"
+	storageUsageAccountActivityName  = "temporal-sys-storage-usage-scanner-account-activity"
+
+	claimCheckScannerWFID          = "temporal-sys-claim-check-scanner"
+	claimCheckScannerWFTypeName    = "temporal-sys-claim-check-scanner-workflow"
+	claimCheckScannerTaskQueueName = "temporal-sys-claim-check-scanner-taskqueue-0"
+	claimCheckCleanupActivityName  = "temporal-sys-claim-check-scanner-cleanup-activity"
 )
 
 var (
@@ -112,6 +131,18 @@ var (
 		WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
 		CronSchedule:          "0 */12 * * *",
 	}
+	storageUsageScannerWFStartOptions = client.StartWorkflowOptions{
+		ID:                    storageUsageScannerWFID,
+		TaskQueue:             storageUsageScannerTaskQueueName,
+		WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+		CronSchedule:          "0 */12 * * *",
+	}
+	claimCheckScannerWFStartOptions = client.StartWorkflowOptions{
+		ID:                    claimCheckScannerWFID,
+		TaskQueue:             claimCheckScannerTaskQueueName,
+		WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+		CronSchedule:          "0 */12 * * *",
+	}
 )
 
 // TaskQueueScannerWorkflow is the workflow that runs the task queue scanner background daemon
@@ -143,6 +174,27 @@ func ExecutionsScannerWorkflow(
 	return future.Get(ctx, nil)
 }
 
+// StorageUsageScannerWorkflow is the workflow that runs the storage usage rollup background daemon.
+// The rollup is returned as the workflow result, so the latest one can be read from the workflow history.
+func StorageUsageScannerWorkflow(
+	ctx workflow.Context,
+) (storageusage.Report, error) {
+
+	var report storageusage.Report
+	future := workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, activityOptions), storageUsageAccountActivityName)
+	err := future.Get(ctx, &report)
+	return report, err
+}
+
+// ClaimCheckScannerWorkflow is the workflow that deletes claim check blobs past their namespace retention
+func ClaimCheckScannerWorkflow(
+	ctx workflow.Context,
+) error {
+
+	future := workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, activityOptions), claimCheckCleanupActivityName)
+	return future.Get(ctx, nil)
+}
+
 // HistoryScavengerActivity is the activity that runs history scavenger
 func HistoryScavengerActivity(
 	activityCtx context.Context,
@@ -216,3 +268,123 @@ func ExecutionsScavengerActivity(
 	}
 	return nil
 }
+
+// StorageUsageAccountActivity is the activity that runs storage usage accountant
+func StorageUsageAccountActivity(
+	activityCtx context.Context,
+) (storageusage.Report, error) {
+
+	ctx := activityCtx.Value(scannerContextKey).(scannerContext)
+
+	hbd := storageusage.HeartbeatDetails{}
+	if activity.HasHeartbeatDetails(activityCtx) {
+		if err := activity.GetHeartbeatDetails(activityCtx, &hbd); err != nil {
+			ctx.GetLogger().Error("Failed to recover from last heartbeat, start over from beginning", tag.Error(err))
+		}
+	}
+
+	accountant := storageusage.NewAccountant(
+		scannerCtxExecMgrFactory{ctx}, // as persistence.ExecutionManagerFactory
+		ctx.GetNamespaceCache(),
+		ctx.cfg.Persistence.NumHistoryShards,
+		ctx.cfg.PersistenceMaxQPS(),
+		hbd,
+		ctx.GetMetricsClient(),
+		ctx.GetLogger(),
+	)
+	return accountant.Run(activityCtx)
+}
+
+// ClaimCheckCleanupActivity is the activity that deletes expired claim check blobs of every namespace.
+// A blob is expired once every workflow owning it is closed and past its namespace retention, or doesn't
+// exist anymore. Blobs younger than ClaimCheckBlobLifetime are kept, so that requests still in flight
+// don't lose their payloads.
+func ClaimCheckCleanupActivity(
+	activityCtx context.Context,
+) error {
+
+	ctx := activityCtx.Value(scannerContextKey).(scannerContext)
+	processor := claimcheck.NewProcessor(ctx.cfg.ClaimCheckStore)
+	now := time.Now().UTC()
+	isExpired := func(owners []claimcheck.Owner) (bool, error) {
+		activity.RecordHeartbeat(activityCtx)
+		for _, owner := range owners {
+			expired, err := isClaimCheckOwnerExpired(activityCtx, ctx, owner, now)
+			if err != nil || !expired {
+				return false, err
+			}
+		}
+		return true, nil
+	}
+
+	for namespaceID, entry := range ctx.GetNamespaceCache().GetAllNamespace() {
+		namespaceName := entry.GetInfo().GetName()
+		lifetime := ctx.cfg.ClaimCheckBlobLifetime(namespaceName)
+		if lifetime <= 0 {
+			continue
+		}
+
+		deleted, err := processor.DeleteExpired(activityCtx, namespaceID, now.Add(-lifetime), isExpired)
+		if err != nil {
+			ctx.GetLogger().Error("Failed to delete expired claim check blobs", tag.WorkflowNamespace(namespaceName), tag.Error(err))
+			return err
+		}
+		if deleted > 0 {
+			ctx.GetLogger().Info("Deleted expired claim check blobs", tag.WorkflowNamespace(namespaceName), tag.Counter(deleted))
+		}
+		activity.RecordHeartbeat(activityCtx)
+	}
+	return nil
+}
+
+// isClaimCheckOwnerExpired returns true if the current run of the owner workflow is closed and past retention,
+// or if the workflow doesn't exist anymore. Runs started by continue as new or reset become the current run,
+// so they keep the blobs they share with earlier runs of the workflow.
+func isClaimCheckOwnerExpired(
+	activityCtx context.Context,
+	ctx scannerContext,
+	owner claimcheck.Owner,
+	now time.Time,
+) (bool, error) {
+
+	entry, err := ctx.GetNamespaceCache().GetNamespaceByID(owner.NamespaceID)
+	if err != nil {
+		if _, ok := err.(*serviceerror.NotFound); ok {
+			return false, nil
+		}
+		return false, err
+	}
+
+	resp, err := ctx.GetHistoryClient().DescribeWorkflowExecution(activityCtx, &historyservice.DescribeWorkflowExecutionRequest{
+		NamespaceId: owner.NamespaceID,
+		Request: &workflowservice.DescribeWorkflowExecutionRequest{
+			Namespace: entry.GetInfo().GetName(),
+			Execution: &commonpb.WorkflowExecution{WorkflowId: owner.WorkflowID},
+		},
+	})
+	if err != nil {
+		if _, ok := err.(*serviceerror.NotFound); ok {
+			return true, nil
+		}
+		return false, err
+	}
+
+	info := resp.GetWorkflowExecutionInfo()
+	if info.GetStatus() == enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+		return false, nil
+	}
+	return timestamp.TimeValue(info.GetCloseTime()).Add(claimCheckRetention(entry)).Before(now), nil
+}
+
+// claimCheckRetention returns the longest retention any workflow of the namespace can have
+func claimCheckRetention(entry *cache.NamespaceCacheEntry) time.Duration {
+	retention := timestamp.DurationValue(entry.GetConfig().GetRetention())
+	if sampledRetentionValue, ok := entry.GetInfo().GetData()[cache.SampleRetentionKey]; ok {
+		if sampledRetentionDays, err := strconv.Atoi(sampledRetentionValue); err == nil {
+			if sampledRetention := time.Duration(sampledRetentionDays) * 24 * time.Hour; sampledRetention > retention {
+				retention = sampledRetention
+			}
+		}
+	}
+	return retention
+}
@@ -26,21 +26,37 @@ package scanner
 
 import (
 	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/sdk/activity"
 	"go.temporal.io/sdk/workflow"
 
 	"go.temporal.io/sdk/testsuite"
 	"go.temporal.io/sdk/worker"
 
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/claimcheck"
+	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/metrics"
 	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/resource"
+	"go.temporal.io/server/common/service/config"
 )
 
 type scannerWorkflowTestSuite struct {
@@ -62,6 +78,7 @@ func (s *scannerWorkflowTestSuite) registerWorkflows(env *testsuite.TestWorkflow
 func (s *scannerWorkflowTestSuite) registerActivities(env *testsuite.TestActivityEnvironment) {
 	env.RegisterActivityWithOptions(TaskQueueScavengerActivity, activity.RegisterOptions{Name: taskQueueScavengerActivityName})
 	env.RegisterActivityWithOptions(HistoryScavengerActivity, activity.RegisterOptions{Name: historyScavengerActivityName})
+	env.RegisterActivityWithOptions(ClaimCheckCleanupActivity, activity.RegisterOptions{Name: claimCheckCleanupActivityName})
 }
 
 func (s *scannerWorkflowTestSuite) TestWorkflow() {
@@ -92,3 +109,92 @@ func (s *scannerWorkflowTestSuite) TestScavengerActivity() {
 	_, err := env.ExecuteActivity(taskQueueScavengerActivityName)
 	s.NoError(err)
 }
+
+func (s *scannerWorkflowTestSuite) TestClaimCheckCleanupActivity() {
+	env := s.NewTestActivityEnvironment()
+	s.registerActivities(env)
+	controller := gomock.NewController(s.T())
+	defer controller.Finish()
+	mockResource := resource.NewTest(controller, metrics.Worker)
+	defer mockResource.Finish(s.T())
+
+	directory, err := ioutil.TempDir("", "claimCheckScanner")
+	s.NoError(err)
+	defer os.RemoveAll(directory)
+	store, err := claimcheck.NewStore(config.ClaimCheck{
+		Filestore: &config.FilestoreClaimCheck{Directory: directory},
+	})
+	s.NoError(err)
+	processor := claimcheck.NewProcessor(store)
+
+	namespaceID := uuid.New()
+	offload := func(age time.Duration, workflowID string) string {
+		payloads := &commonpb.Payloads{Payloads: []*commonpb.Payload{{Data: make([]byte, 1024)}}}
+		owners := []claimcheck.Owner{{NamespaceID: namespaceID, WorkflowID: workflowID}}
+		s.NoError(processor.OffloadPayloads(context.Background(), namespaceID, owners, 100, payloads))
+		path := filepath.Join(directory, string(payloads.Payloads[0].GetMetadata()[claimcheck.MetadataKeyClaimCheckKey]))
+		s.NoError(os.Chtimes(path, time.Now().Add(-age), time.Now().Add(-age)))
+		return path
+	}
+	expired := offload(48*time.Hour, "closed-workflow")
+	deletedWorkflow := offload(48*time.Hour, "deleted-workflow")
+	retained := offload(48*time.Hour, "retained-workflow")
+	running := offload(48*time.Hour, "open-workflow")
+	recent := offload(0, "closed-workflow")
+
+	entry := cache.NewLocalNamespaceCacheEntryForTest(
+		&persistencespb.NamespaceInfo{Id: namespaceID, Name: "test-namespace"},
+		&persistencespb.NamespaceConfig{Retention: timestamp.DurationFromDays(1)},
+		cluster.TestCurrentClusterName,
+		nil,
+	)
+	mockResource.NamespaceCache.EXPECT().GetAllNamespace().Return(map[string]*cache.NamespaceCacheEntry{namespaceID: entry})
+	mockResource.NamespaceCache.EXPECT().GetNamespaceByID(namespaceID).Return(entry, nil).AnyTimes()
+	expectDescribe := func(workflowID string, info *workflowpb.WorkflowExecutionInfo, err error) {
+		var resp *historyservice.DescribeWorkflowExecutionResponse
+		if err == nil {
+			resp = &historyservice.DescribeWorkflowExecutionResponse{WorkflowExecutionInfo: info}
+		}
+		mockResource.HistoryClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), &historyservice.DescribeWorkflowExecutionRequest{
+			NamespaceId: namespaceID,
+			Request: &workflowservice.DescribeWorkflowExecutionRequest{
+				Namespace: "test-namespace",
+				Execution: &commonpb.WorkflowExecution{WorkflowId: workflowID},
+			},
+		}).Return(resp, err)
+	}
+	expectDescribe("closed-workflow", &workflowpb.WorkflowExecutionInfo{
+		Status:    enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		CloseTime: timestamp.TimePtr(time.Now().Add(-36 * time.Hour)),
+	}, nil)
+	expectDescribe("deleted-workflow", nil, serviceerror.NewNotFound("workflow not found"))
+	expectDescribe("retained-workflow", &workflowpb.WorkflowExecutionInfo{
+		Status:    enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		CloseTime: timestamp.TimePtr(time.Now().Add(-12 * time.Hour)),
+	}, nil)
+	expectDescribe("open-workflow", &workflowpb.WorkflowExecutionInfo{
+		Status: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+	}, nil)
+
+	ctx := scannerContext{
+		Resource: mockResource,
+		cfg: Config{
+			ClaimCheckStore:        store,
+			ClaimCheckBlobLifetime: func(string) time.Duration { return time.Hour },
+		},
+	}
+	env.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), scannerContextKey, ctx),
+	})
+	_, err = env.ExecuteActivity(claimCheckCleanupActivityName)
+	s.NoError(err)
+
+	for _, path := range []string{expired, deletedWorkflow} {
+		_, err = os.Stat(path)
+		s.True(os.IsNotExist(err))
+	}
+	for _, path := range []string{retained, running, recent} {
+		_, err = os.Stat(path)
+		s.NoError(err)
+	}
+}
@@ -41,7 +41,9 @@ import (
 	"go.temporal.io/server/common/service/dynamicconfig"
 	"go.temporal.io/server/service/worker/archiver"
 	"go.temporal.io/server/service/worker/batcher"
+	"go.temporal.io/server/service/worker/canary"
 	"go.temporal.io/server/service/worker/indexer"
+	"go.temporal.io/server/service/worker/loadgen"
 	"go.temporal.io/server/service/worker/parentclosepolicy"
 	"go.temporal.io/server/service/worker/replicator"
 	"go.temporal.io/server/service/worker/scanner"
@@ -71,6 +73,8 @@ type (
 		ThrottledLogRPS               dynamicconfig.IntPropertyFn
 		PersistenceGlobalMaxQPS       dynamicconfig.IntPropertyFn
 		EnableBatcher                 dynamicconfig.BoolPropertyFn
+		EnableLoadGenerator           dynamicconfig.BoolPropertyFn
+		EnableCanary                  dynamicconfig.BoolPropertyFn
 		VisibilityQueue               dynamicconfig.StringPropertyFn
 		VisibilityProcessorEnabled    dynamicconfig.BoolPropertyFn
 		EnableParentClosePolicyWorker dynamicconfig.BoolPropertyFn
@@ -132,17 +136,23 @@ func NewConfig(params *resource.BootstrapParams) *Config {
 			TimeLimitPerArchivalIteration: dc.GetDurationProperty(dynamicconfig.WorkerTimeLimitPerArchivalIteration, archiver.MaxArchivalIterationTimeout()),
 		},
 		ScannerCfg: &scanner.Config{
-			PersistenceMaxQPS:        dc.GetIntProperty(dynamicconfig.ScannerPersistenceMaxQPS, 100),
-			Persistence:              &params.PersistenceConfig,
-			ClusterMetadata:          params.ClusterMetadata,
-			TaskQueueScannerEnabled:  dc.GetBoolProperty(dynamicconfig.TaskQueueScannerEnabled, true),
-			HistoryScannerEnabled:    dc.GetBoolProperty(dynamicconfig.HistoryScannerEnabled, true),
-			ExecutionsScannerEnabled: dc.GetBoolProperty(dynamicconfig.ExecutionsScannerEnabled, false),
+			PersistenceMaxQPS:          dc.GetIntProperty(dynamicconfig.ScannerPersistenceMaxQPS, 100),
+			Persistence:                &params.PersistenceConfig,
+			ClusterMetadata:            params.ClusterMetadata,
+			TaskQueueScannerEnabled:    dc.GetBoolProperty(dynamicconfig.TaskQueueScannerEnabled, true),
+			HistoryScannerEnabled:      dc.GetBoolProperty(dynamicconfig.HistoryScannerEnabled, true),
+			ExecutionsScannerEnabled:   dc.GetBoolProperty(dynamicconfig.ExecutionsScannerEnabled, false),
+			StorageUsageScannerEnabled: dc.GetBoolProperty(dynamicconfig.StorageUsageScannerEnabled, false),
+			ClaimCheckScannerEnabled:   dc.GetBoolProperty(dynamicconfig.ClaimCheckScannerEnabled, false),
+			ClaimCheckBlobLifetime:     dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.ClaimCheckBlobLifetime, 0),
+			ClaimCheckStore:            params.ClaimCheckStore,
 		},
 		BatcherCfg: &batcher.Config{
 			ClusterMetadata: params.ClusterMetadata,
 		},
 		EnableBatcher:                 dc.GetBoolProperty(dynamicconfig.EnableBatcher, true),
+		EnableLoadGenerator:           dc.GetBoolProperty(dynamicconfig.EnableLoadGenerator, false),
+		EnableCanary:                  dc.GetBoolProperty(dynamicconfig.EnableCanary, false),
 		VisibilityQueue:               dc.GetStringProperty(dynamicconfig.VisibilityQueue, common.VisibilityQueueInternal),
 		VisibilityProcessorEnabled:    dc.GetBoolProperty(dynamicconfig.VisibilityProcessorEnabled, true),
 		EnableParentClosePolicyWorker: dc.GetBoolProperty(dynamicconfig.EnableParentClosePolicyWorker, true),
@@ -197,6 +207,12 @@ func (s *Service) Start() {
 	if s.config.EnableParentClosePolicyWorker() {
 		s.startParentClosePolicyProcessor()
 	}
+	if s.config.EnableLoadGenerator() {
+		s.startLoadGenerator()
+	}
+	if s.config.EnableCanary() {
+		s.startCanary()
+	}
 
 	logger.Info("worker started", tag.ComponentWorker)
 	<-s.stopC
@@ -241,6 +257,28 @@ func (s *Service) startBatcher() {
 	}
 }
 
+func (s *Service) startLoadGenerator() {
+	params := &loadgen.BootstrapParams{
+		ServiceClient: s.params.PublicClient,
+		MetricsClient: s.GetMetricsClient(),
+		Logger:        s.GetLogger(),
+	}
+	if err := loadgen.New(params).Start(); err != nil {
+		s.GetLogger().Fatal("error starting load generator", tag.Error(err))
+	}
+}
+
+func (s *Service) startCanary() {
+	params := &canary.BootstrapParams{
+		ServiceClient: s.params.PublicClient,
+		MetricsClient: s.GetMetricsClient(),
+		Logger:        s.GetLogger(),
+	}
+	if err := canary.New(params).Start(); err != nil {
+		s.GetLogger().Fatal("error starting canary", tag.Error(err))
+	}
+}
+
 func (s *Service) startScanner() {
 	params := &scanner.BootstrapParams{
 		Config: *s.config.ScannerCfg,
@@ -25,21 +25,30 @@
 package temporal
 
 import (
+	"context"
+	"database/sql/driver"
 	"errors"
 	"fmt"
+	"net"
 	"sync"
 	"time"
 
 	"github.com/pborman/uuid"
 	"github.com/uber-go/tally"
+	"go.temporal.io/api/serviceerror"
 	sdkclient "go.temporal.io/sdk/client"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/provider"
 	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/claimcheck"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/elasticsearch"
 	l "go.temporal.io/server/common/log"
@@ -56,6 +65,7 @@ import (
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/rpc"
 	"go.temporal.io/server/common/rpc/encryption"
+	"go.temporal.io/server/common/service/config"
 	"go.temporal.io/server/common/service/config/ringpop"
 	"go.temporal.io/server/common/service/dynamicconfig"
 	"go.temporal.io/server/service/frontend"
@@ -72,9 +82,21 @@ type (
 		serviceStoppedChs map[string]chan struct{}
 		stoppedCh         chan struct{}
 		logger            l.Logger
+		// startupHealthServer serves the frontend health check while startup waits on dependencies
+		startupHealthServer *grpc.Server
 	}
 )
 
+const (
+	defaultStartupRetryInitialInterval = time.Second
+	defaultStartupRetryMaximumInterval = 30 * time.Second
+
+	esIndexCheckTimeout = 10 * time.Second
+
+	// frontendHealthCheckService is the service name checked by the frontend health check
+	frontendHealthCheckService = "temporal.api.workflowservice.v1.WorkflowService"
+)
+
 // Services is the list of all valid temporal services
 var (
 	Services = []string{
@@ -125,6 +147,17 @@ func (s *Server) Start() error {
 		}
 	}
 
+	// report the frontend as not serving instead of refusing connections until its dependencies are reachable
+	if err := s.startStartupHealthServer(tlsFactory); err != nil {
+		return err
+	}
+	defer s.stopStartupHealthServer()
+
+	err = s.verifyPersistenceCompatibleVersion()
+	if err != nil {
+		return err
+	}
+
 	dynamicConfig, err := dynamicconfig.NewFileBasedClient(&s.so.config.DynamicConfigClient, s.logger, s.stoppedCh)
 	if err != nil {
 		s.logger.Info("Error creating file based dynamic config client, use no-op config client instead.", tag.Error(err))
@@ -135,7 +168,9 @@ func (s *Server) Start() error {
 	// This call performs a config check against the configured persistence store for immutable cluster metadata.
 	// If there is a mismatch, the persisted values take precedence and will be written over in the config objects.
 	// This is to keep this check hidden from independent downstream daemons and keep this in a single place.
-	err = s.immutableClusterMetadataInitialization(dc)
+	err = s.retryStartupDependency("cluster metadata", func() error {
+		return s.immutableClusterMetadataInitialization(dc)
+	})
 	if err != nil {
 		return fmt.Errorf("unable to initialize cluster metadata: %w", err)
 	}
@@ -156,12 +191,19 @@ func (s *Server) Start() error {
 		globalMetricsScope = s.so.config.Global.Metrics.NewScope(s.logger)
 	}
 
+	// all dependencies are checked before any service binds its ports
+	serviceParams := make(map[string]*resource.BootstrapParams, len(s.so.serviceNames))
 	for _, svcName := range s.so.serviceNames {
 		params, err := s.getServiceParams(svcName, dynamicConfig, tlsFactory, clusterMetadata, dc, zapLogger, globalMetricsScope)
 		if err != nil {
 			return err
 		}
+		serviceParams[svcName] = params
+	}
+	s.stopStartupHealthServer()
 
+	for _, svcName := range s.so.serviceNames {
+		params := serviceParams[svcName]
 		var svc common.Daemon
 		switch svcName {
 		case primitives.FrontendService:
@@ -330,6 +372,21 @@ func (s *Server) getServiceParams(
 		if !ok || len(indexName) == 0 {
 			return nil, errors.New("visibility index in missing in Elasticsearch config")
 		}
+		err = s.retryStartupDependency("elasticsearch", func() error {
+			ctx, cancel := context.WithTimeout(context.Background(), esIndexCheckTimeout)
+			defer cancel()
+			exists, err := esClient.IndexExists(ctx, indexName)
+			if err != nil {
+				return err
+			}
+			if !exists {
+				return fmt.Errorf("visibility index %q does not exist", indexName)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to reach Elasticsearch: %w", err)
+		}
 	}
 
 	params.ArchivalMetadata = archiver.NewArchivalMetadata(
@@ -342,13 +399,34 @@ func (s *Server) getServiceParams(
 	)
 
 	params.ArchiverProvider = provider.NewArchiverProvider(s.so.config.Archival.History.Provider, s.so.config.Archival.Visibility.Provider)
+	params.ClaimCheckStore, err = claimcheck.NewStore(s.so.config.ClaimCheck)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create claim check store: %w", err)
+	}
+	params.IDGenerator, err = primitives.NewIDGenerator(s.so.config.Global.IDGenerator)
+	if err != nil {
+		return nil, fmt.Errorf("id generator config error: %w", err)
+	}
 	params.PersistenceConfig.TransactionSizeLimit = dc.GetIntProperty(dynamicconfig.TransactionSizeLimit, common.DefaultTransactionSizeLimit)
+	if params.PersistenceConfig.EnableFaultInjection {
+		params.PersistenceConfig.FaultInjection = &config.FaultInjectionConfig{
+			Enabled:    dc.GetBoolProperty(dynamicconfig.PersistenceFaultInjectionEnabled, false),
+			ErrorRate:  dc.GetFloat64Property(dynamicconfig.PersistenceFaultInjectionErrorRate, 0),
+			Latency:    dc.GetDurationProperty(dynamicconfig.PersistenceFaultInjectionLatency, 0),
+			TargetAPIs: dc.GetStringProperty(dynamicconfig.PersistenceFaultInjectionTargetAPIs, ""),
+		}
+	}
 
 	if s.so.authorizer != nil {
 		params.Authorizer = s.so.authorizer
 	} else {
 		params.Authorizer = authorization.NewNoopAuthorizer()
 	}
+	if s.so.operatorAuthorizer != nil {
+		params.OperatorAuthorizer = s.so.operatorAuthorizer
+	} else {
+		params.OperatorAuthorizer = params.Authorizer
+	}
 	if s.so.claimMapper != nil {
 		params.ClaimMapper = s.so.claimMapper
 	} else {
@@ -356,6 +434,7 @@ func (s *Server) getServiceParams(
 	}
 
 	params.PersistenceServiceResolver = s.so.persistenceServiceResolver
+	params.NamespaceBootstrap = s.so.config.Namespaces
 
 	return &params, nil
 }
@@ -366,26 +445,130 @@ func (s *Server) validate() error {
 		s.so.persistenceServiceResolver = resolver.NewNoopResolver()
 	}
 
+	if err := s.so.config.Global.PProf.NewInitializer(s.logger).Start(); err != nil {
+		return fmt.Errorf("unable to start PProf: %w", err)
+	}
+
+	err := ringpop.ValidateRingpopConfig(&s.so.config.Global.Membership)
+	if err != nil {
+		return fmt.Errorf("ringpop config validation error: %w", err)
+	}
+	return nil
+}
+
+// Verifies that the schema versions of the datastores are compatible
+func (s *Server) verifyPersistenceCompatibleVersion() error {
 	// cassandra schema version validation
-	if err := cassandra.VerifyCompatibleVersion(s.so.config.Persistence, s.so.persistenceServiceResolver); err != nil {
+	if err := s.retryStartupDependency("cassandra", func() error {
+		return cassandra.VerifyCompatibleVersion(s.so.config.Persistence, s.so.persistenceServiceResolver)
+	}); err != nil {
 		return fmt.Errorf("cassandra schema version compatibility check failed: %w", err)
 	}
 	// sql schema version validation
-	if err := sql.VerifyCompatibleVersion(s.so.config.Persistence, s.so.persistenceServiceResolver); err != nil {
+	if err := s.retryStartupDependency("sql", func() error {
+		return sql.VerifyCompatibleVersion(s.so.config.Persistence, s.so.persistenceServiceResolver)
+	}); err != nil {
 		return fmt.Errorf("sql schema version compatibility check failed: %w", err)
 	}
+	return nil
+}
 
-	if err := s.so.config.Global.PProf.NewInitializer(s.logger).Start(); err != nil {
-		return fmt.Errorf("unable to start PProf: %w", err)
+// startStartupHealthServer serves the frontend health check on the frontend gRPC port with status NOT_SERVING
+// while startup retries unavailable dependencies, so that readiness probes and load balancers see an unhealthy
+// frontend. It is only started if startup retries are enabled and this process runs the frontend service.
+func (s *Server) startStartupHealthServer(tlsFactory encryption.TLSConfigProvider) error {
+	if s.so.config.Persistence.StartupRetry == nil || !s.hasService(primitives.FrontendService) {
+		return nil
 	}
 
-	err := ringpop.ValidateRingpopConfig(&s.so.config.Global.Membership)
+	svcCfg := s.so.config.Services[primitives.FrontendService]
+	rpcFactory := rpc.NewFactory(&svcCfg.RPC, primitives.FrontendService, s.logger, tlsFactory)
+	opts, err := rpcFactory.GetFrontendGRPCServerOptions()
 	if err != nil {
-		return fmt.Errorf("ringpop config validation error: %w", err)
+		return fmt.Errorf("unable to load frontend TLS configuration: %w", err)
 	}
+
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus(frontendHealthCheckService, healthpb.HealthCheckResponse_NOT_SERVING)
+	s.startupHealthServer = grpc.NewServer(opts...)
+	healthpb.RegisterHealthServer(s.startupHealthServer, healthServer)
+
+	listener := rpcFactory.GetGRPCListener()
+	go func(server *grpc.Server) {
+		if err := server.Serve(listener); err != nil {
+			s.logger.Warn("Startup health check server stopped.", tag.Error(err))
+		}
+	}(s.startupHealthServer)
+	s.logger.Info("Serving frontend health check as not serving until startup dependencies are reachable.")
 	return nil
 }
 
+// stopStartupHealthServer stops the startup health check server and releases the frontend gRPC port
+func (s *Server) stopStartupHealthServer() {
+	if s.startupHealthServer == nil {
+		return
+	}
+	s.startupHealthServer.Stop()
+	s.startupHealthServer = nil
+}
+
+func (s *Server) hasService(svcName string) bool {
+	for _, name := range s.so.serviceNames {
+		if name == svcName {
+			return true
+		}
+	}
+	return false
+}
+
+// retryStartupDependency retries op with the persistence startup retry policy while it fails because the
+// dependency is unavailable, until it succeeds or the policy expires. Other errors, such as an incompatible
+// schema version, fail startup right away. The frontend health check reports NOT_SERVING while waiting.
+func (s *Server) retryStartupDependency(name string, op func() error) error {
+	startupRetry := s.so.config.Persistence.StartupRetry
+	if startupRetry == nil {
+		return op()
+	}
+
+	initialInterval := startupRetry.InitialInterval
+	if initialInterval <= 0 {
+		initialInterval = defaultStartupRetryInitialInterval
+	}
+	maximumInterval := startupRetry.MaximumInterval
+	if maximumInterval <= 0 {
+		maximumInterval = defaultStartupRetryMaximumInterval
+	}
+	policy := backoff.NewExponentialRetryPolicy(initialInterval)
+	policy.SetMaximumInterval(maximumInterval)
+	policy.SetExpirationInterval(startupRetry.MaximumWait)
+
+	attempt := int32(0)
+	return backoff.Retry(func() error {
+		attempt++
+		err := op()
+		if err != nil && isStartupDependencyUnavailable(err) {
+			s.logger.Warn("Startup dependency is unavailable, retrying.",
+				tag.Name(name),
+				tag.Attempt(attempt),
+				tag.Error(err))
+		}
+		return err
+	}, policy, isStartupDependencyUnavailable)
+}
+
+// isStartupDependencyUnavailable returns true if err indicates that a startup dependency is not reachable yet
+func isStartupDependencyUnavailable(err error) bool {
+	var unavailable *serviceerror.Unavailable
+	var timeout *persistence.TimeoutError
+	var netErr net.Error
+	return errors.As(err, &unavailable) ||
+		errors.As(err, &timeout) ||
+		errors.As(err, &netErr) ||
+		errors.Is(err, context.DeadlineExceeded) ||
+		errors.Is(err, driver.ErrBadConn) ||
+		elasticsearch.IsUnavailableError(err)
+}
+
 func (s *Server) immutableClusterMetadataInitialization(dc *dynamicconfig.Collection) error {
 	logger := s.logger.WithTags(tag.ComponentMetadataInitializer)
 
@@ -74,6 +74,13 @@ func WithAuthorizer(authorizer authorization.Authorizer) ServerOption {
 	})
 }
 
+// Sets low level authorizer to allow/deny operator (admin service) API calls
+func WithOperatorAuthorizer(authorizer authorization.Authorizer) ServerOption {
+	return newApplyFuncContainer(func(s *serverOptions) {
+		s.operatorAuthorizer = authorizer
+	})
+}
+
 // Overrides default provider of TLS configuration
 func WithTLSConfigFactory(tlsConfigProvider encryption.TLSConfigProvider) ServerOption {
 	return newApplyFuncContainer(func(s *serverOptions) {
@@ -49,6 +49,7 @@ type (
 		blockingStart bool
 
 		authorizer                 authorization.Authorizer
+		operatorAuthorizer         authorization.Authorizer
 		tlsConfigProvider          encryption.TLSConfigProvider
 		claimMapper                authorization.ClaimMapper
 		metricsReporter            tally.BaseStatsReporter
@@ -90,13 +91,9 @@ func (so *serverOptions) loadAndValidate() error {
 }
 
 func (so *serverOptions) loadConfig() error {
-	so.config = &config.Config{}
-	err := config.Load(so.env, so.configDir, so.zone, so.config)
-	if err != nil {
-		return fmt.Errorf("config file corrupted: %w", err)
-	}
-
-	return nil
+	var err error
+	so.config, err = config.LoadConfig(so.env, so.configDir, so.zone)
+	return err
 }
 
 func (so *serverOptions) validateConfig() error {
@@ -91,6 +91,116 @@ func newAdminWorkflowCommands() []cli.Command {
 				AdminRefreshWorkflowTasks(c)
 			},
 		},
+		{
+			Name:    "update_tags",
+			Aliases: []string{"ut"},
+			Usage:   "Upserts and removes non-indexed tags of a running workflow",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowId",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunId",
+				},
+				cli.StringFlag{
+					Name:  FlagWorkflowTags,
+					Usage: "Tags to upsert, in format key1=value1,key2=value2",
+				},
+				cli.StringFlag{
+					Name:  FlagRemoveWorkflowTags,
+					Usage: "Tag keys to remove, in format key1,key2",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminUpdateWorkflowTags(c)
+			},
+		},
+		{
+			Name:  "pause",
+			Usage: "Pause a running workflow, its workflow tasks are not dispatched to workers until it is unpaused",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowId",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunId",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Reason for pausing the workflow",
+				},
+				cli.BoolFlag{
+					Name:  FlagFreezeTimers,
+					Usage: "Do not fire user timers while the workflow is paused and push them out by the paused duration on unpause",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminPauseWorkflow(c)
+			},
+		},
+		{
+			Name:  "unpause",
+			Usage: "Unpause a paused workflow",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowId",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunId",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminUnpauseWorkflow(c)
+			},
+		},
+		{
+			Name:  "chain",
+			Usage: "List the runs of the continue-as-new, retry and cron chain of a workflow, latest run first",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowId",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunId to start from, the current run if not set",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminListWorkflowExecutionChain(c)
+			},
+		},
+		{
+			Name:  "events",
+			Usage: "Show a single event or a contiguous event ID range of a workflow run",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowId",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunId, the current run if not set",
+				},
+				cli.Int64Flag{
+					Name:  FlagMinEventID,
+					Usage: "First event ID of the range, inclusive",
+				},
+				cli.Int64Flag{
+					Name:  FlagMaxEventID,
+					Usage: "Last event ID of the range, inclusive. Only the first event is shown if not set",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminGetWorkflowExecutionEvents(c)
+			},
+		},
 		{
 			Name:    "delete",
 			Aliases: []string{"del"},
@@ -223,6 +333,20 @@ func newAdminShardManagementCommands() []cli.Command {
 				AdminShardManagement(c)
 			},
 		},
+		{
+			Name:    "describe_locks",
+			Aliases: []string{"dl"},
+			Usage:   "describe the contended workflow execution locks of a shard, most contended first",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagShardID,
+					Usage: "ShardId for the temporal cluster to manage",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDescribeWorkflowLocks(c)
+			},
+		},
 		{
 			Name:    "remove_task",
 			Aliases: []string{"rmtk"},
@@ -401,6 +525,14 @@ func newAdminNamespaceCommands() []cli.Command {
 				AdminGetNamespaceIDOrName(c)
 			},
 		},
+		{
+			Name:    "describe_config",
+			Aliases: []string{"descc"},
+			Usage:   "Describe effective configuration of a namespace, including dynamic config overrides",
+			Action: func(c *cli.Context) {
+				AdminDescribeNamespaceConfig(c)
+			},
+		},
 	}
 }
 
@@ -685,7 +817,7 @@ func newAdminTaskQueueCommands() []cli.Command {
 		{
 			Name:    "describe",
 			Aliases: []string{"desc"},
-			Usage:   "Describe pollers and status information of task queue",
+			Usage:   "Describe pollers, status and sync match information of task queue",
 			Flags: []cli.Flag{
 				cli.StringFlag{
 					Name:  FlagTaskQueueWithAlias,
@@ -775,6 +907,24 @@ func newAdminClusterCommands() []cli.Command {
 				AdminClusterMetadata(c)
 			},
 		},
+		{
+			Name:    "list_dynamic_config_keys",
+			Aliases: []string{"ldck"},
+			Usage:   "List dynamic config keys with their types, defaults, filters and descriptions",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagPrefix,
+					Usage: "Only list keys whose name starts with the prefix, e.g. history.",
+				},
+				cli.BoolFlag{
+					Name:  FlagPrintJSONWithAlias,
+					Usage: "Print in raw json format",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminListDynamicConfigKeys(c)
+			},
+		},
 	}
 }
 
@@ -26,8 +26,11 @@ package cli
 
 import (
 	"fmt"
+	"os"
+	"strings"
 
 	"github.com/fatih/color"
+	"github.com/olekukonko/tablewriter"
 	"github.com/urfave/cli"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/workflowservice/v1"
@@ -96,3 +99,58 @@ func AdminClusterMetadata(c *cli.Context) {
 	}
 	prettyPrintJSONObject(info)
 }
+
+// AdminListDynamicConfigKeys lists the dynamic config keys known to the cluster
+func AdminListDynamicConfigKeys(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	response, err := adminClient.ListDynamicConfigKeys(ctx, &adminservice.ListDynamicConfigKeysRequest{
+		Prefix: c.String(FlagPrefix),
+	})
+	if err != nil {
+		ErrorAndExit("Operation ListDynamicConfigKeys failed.", err)
+	}
+
+	if c.Bool(FlagPrintJSON) {
+		prettyPrintJSONObject(response)
+		return
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Name", "Type", "Default", "Constraints", "Filters", "Description"})
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+	for _, key := range response.GetKeys() {
+		defaultValues := key.GetDefaultValues()
+		if key.GetComputedDefault() {
+			defaultValues = append(defaultValues, "(computed)")
+		}
+		table.Append([]string{
+			key.GetName(),
+			key.GetType(),
+			strings.Join(defaultValues, ", "),
+			dynamicConfigKeyConstraints(key),
+			strings.Join(key.GetFilters(), ", "),
+			key.GetDescription(),
+		})
+	}
+	table.Render()
+}
+
+func dynamicConfigKeyConstraints(key *adminservice.DynamicConfigKeyInfo) string {
+	var constraints []string
+	if key.GetMinValue() != "" {
+		constraints = append(constraints, ">= "+key.GetMinValue())
+	}
+	if key.GetMaxValue() != "" {
+		constraints = append(constraints, "<= "+key.GetMaxValue())
+	}
+	if len(key.GetAllowedValues()) > 0 {
+		constraints = append(constraints, "one of "+strings.Join(key.GetAllowedValues(), ", "))
+	}
+	return strings.Join(constraints, ", ")
+}
@@ -28,6 +28,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gocql/gocql"
@@ -597,6 +598,82 @@ func AdminShardManagement(c *cli.Context) {
 	}
 }
 
+// AdminDescribeWorkflowLocks describes the contended workflow execution locks of a shard
+func AdminDescribeWorkflowLocks(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+	sid := getRequiredIntOption(c, FlagShardID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.DescribeWorkflowLocks(ctx, &adminservice.DescribeWorkflowLocksRequest{
+		ShardId: int32(sid),
+	})
+	if err != nil {
+		ErrorAndExit("Describe workflow locks has failed", err)
+	}
+	prettyPrintJSONObject(resp.GetLocks())
+}
+
+// AdminListWorkflowExecutionChain lists the runs of the continue-as-new, retry and cron chain of a workflow
+func AdminListWorkflowExecutionChain(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+
+	var runs []*adminservice.WorkflowExecutionChainRun
+	var nextPageToken []byte
+	for {
+		ctx, cancel := newContext(c)
+		resp, err := adminClient.ListWorkflowExecutionChain(ctx, &adminservice.ListWorkflowExecutionChainRequest{
+			Namespace: namespace,
+			Execution: &commonpb.WorkflowExecution{
+				WorkflowId: wid,
+				RunId:      rid,
+			},
+			NextPageToken: nextPageToken,
+		})
+		cancel()
+		if err != nil {
+			ErrorAndExit("List workflow execution chain failed", err)
+		}
+		runs = append(runs, resp.GetRuns()...)
+		nextPageToken = resp.GetNextPageToken()
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+	prettyPrintJSONObject(runs)
+}
+
+// AdminGetWorkflowExecutionEvents shows a single event or a contiguous event ID range of a workflow run
+func AdminGetWorkflowExecutionEvents(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	firstEventID := getRequiredInt64Option(c, FlagMinEventID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.GetWorkflowExecutionEvents(ctx, &adminservice.GetWorkflowExecutionEventsRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+		FirstEventId: firstEventID,
+		LastEventId:  c.Int64(FlagMaxEventID),
+	})
+	if err != nil {
+		ErrorAndExit("Get workflow execution events failed", err)
+	}
+	prettyPrintJSONObject(resp.GetEvents())
+}
+
 // AdminListGossipMembers outputs a list of gossip members
 func AdminListGossipMembers(c *cli.Context) {
 	roleFlag := c.String(FlagClusterMembershipRole)
@@ -702,6 +779,24 @@ func AdminDescribeHistoryHost(c *cli.Context) {
 	prettyPrintJSONObject(resp)
 }
 
+// AdminDescribeNamespaceConfig describes the effective configuration of a namespace
+func AdminDescribeNamespaceConfig(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.DescribeNamespaceConfig(ctx, &adminservice.DescribeNamespaceConfigRequest{
+		Namespace: namespace,
+	})
+	if err != nil {
+		ErrorAndExit("Describe namespace config failed", err)
+	}
+	prettyPrintJSONObject(resp)
+}
+
 // AdminRefreshWorkflowTasks refreshes all the tasks of a workflow
 func AdminRefreshWorkflowTasks(c *cli.Context) {
 	adminClient := cFactory.AdminClient(c)
@@ -726,3 +821,101 @@ func AdminRefreshWorkflowTasks(c *cli.Context) {
 		fmt.Println("Refresh workflow task succeeded.")
 	}
 }
+
+// AdminUpdateWorkflowTags upserts and removes non-indexed tags of a running workflow
+func AdminUpdateWorkflowTags(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+
+	upsertTags := make(map[string]string)
+	if c.IsSet(FlagWorkflowTags) {
+		for _, tag := range strings.Split(c.String(FlagWorkflowTags), ",") {
+			kv := strings.SplitN(strings.TrimSpace(tag), "=", 2)
+			if len(kv) != 2 || kv[0] == "" {
+				ErrorAndExit(fmt.Sprintf("Invalid tag %q, expected key=value.", tag), nil)
+			}
+			upsertTags[kv[0]] = kv[1]
+		}
+	}
+	var removeTagKeys []string
+	if c.IsSet(FlagRemoveWorkflowTags) {
+		for _, key := range strings.Split(c.String(FlagRemoveWorkflowTags), ",") {
+			removeTagKeys = append(removeTagKeys, strings.TrimSpace(key))
+		}
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err := adminClient.UpdateWorkflowExecutionTags(ctx, &adminservice.UpdateWorkflowExecutionTagsRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+		UpsertTags:    upsertTags,
+		RemoveTagKeys: removeTagKeys,
+	})
+	if err != nil {
+		ErrorAndExit("Update workflow tags failed", err)
+	} else {
+		fmt.Println("Update workflow tags succeeded.")
+	}
+}
+
+// AdminPauseWorkflow pauses dispatching workflow tasks of a running workflow
+func AdminPauseWorkflow(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err := adminClient.PauseWorkflowExecution(ctx, &adminservice.PauseWorkflowExecutionRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+		Reason:       c.String(FlagReason),
+		Identity:     getCliIdentity(),
+		FreezeTimers: c.Bool(FlagFreezeTimers),
+	})
+	if err != nil {
+		ErrorAndExit("Pause workflow failed", err)
+	} else {
+		fmt.Println("Pause workflow succeeded.")
+	}
+}
+
+// AdminUnpauseWorkflow resumes dispatching workflow tasks of a paused workflow
+func AdminUnpauseWorkflow(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err := adminClient.UnpauseWorkflowExecution(ctx, &adminservice.UnpauseWorkflowExecutionRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+		Identity: getCliIdentity(),
+	})
+	if err != nil {
+		ErrorAndExit("Unpause workflow failed", err)
+	} else {
+		fmt.Println("Unpause workflow succeeded.")
+	}
+}
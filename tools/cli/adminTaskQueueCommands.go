@@ -32,16 +32,17 @@ import (
 	"github.com/urfave/cli"
 	enumspb "go.temporal.io/api/enums/v1"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
-	"go.temporal.io/api/workflowservice/v1"
-	"go.temporal.io/server/common/convert"
 
+	"go.temporal.io/server/api/adminservice/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives/timestamp"
 )
 
-// AdminDescribeTaskQueue displays poller and status information of task queue.
+// AdminDescribeTaskQueue displays poller, task holder, status and sync match information of task queue.
 func AdminDescribeTaskQueue(c *cli.Context) {
-	frontendClient := cFactory.FrontendClient(c)
+	adminClient := cFactory.AdminClient(c)
 	namespace := getRequiredGlobalOption(c, FlagNamespace)
 	taskQueue := getRequiredOption(c, FlagTaskQueue)
 	tlTypeInt, err := stringToEnum(c.String(FlagTaskQueueType), enumspb.TaskQueueType_value)
@@ -54,17 +55,16 @@ func AdminDescribeTaskQueue(c *cli.Context) {
 	}
 	ctx, cancel := newContext(c)
 	defer cancel()
-	request := &workflowservice.DescribeTaskQueueRequest{
+	request := &adminservice.DescribeTaskQueueRequest{
 		Namespace: namespace,
 		TaskQueue: &taskqueuepb.TaskQueue{
 			Name: taskQueue,
 			Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
 		},
-		TaskQueueType:          tlType,
-		IncludeTaskQueueStatus: true,
+		TaskQueueType: tlType,
 	}
 
-	response, err := frontendClient.DescribeTaskQueue(ctx, request)
+	response, err := adminClient.DescribeTaskQueue(ctx, request)
 	if err != nil {
 		ErrorAndExit("Operation DescribeTaskQueue failed.", err)
 	}
@@ -76,6 +76,16 @@ func AdminDescribeTaskQueue(c *cli.Context) {
 	printTaskQueueStatus(taskQueueStatus)
 	fmt.Printf("\n")
 
+	if syncMatchStats := response.GetSyncMatchStats(); syncMatchStats != nil {
+		printSyncMatchStats(syncMatchStats)
+		fmt.Printf("\n")
+	}
+
+	if taskHolders := response.GetTaskHolders(); len(taskHolders) > 0 {
+		printTaskHolders(taskHolders)
+		fmt.Printf("\n")
+	}
+
 	pollers := response.Pollers
 	if len(pollers) == 0 {
 		ErrorAndExit(colorMagenta("No poller for taskqueue: "+taskQueue), nil)
@@ -100,6 +110,39 @@ func printTaskQueueStatus(taskQueueStatus *taskqueuepb.TaskQueueStatus) {
 	table.Render()
 }
 
+func printSyncMatchStats(syncMatchStats *taskqueuespb.SyncMatchStats) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Since", "Sync Matched", "Spilled", "Sync Match Ratio", "Avg Spill Latency", "Max Spill Latency"})
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+	table.Append([]string{formatTime(timestamp.TimeValue(syncMatchStats.GetStartTime()), false),
+		convert.Int64ToString(syncMatchStats.GetSyncMatchCount()),
+		convert.Int64ToString(syncMatchStats.GetSpillCount()),
+		fmt.Sprintf("%.3f", syncMatchStats.GetSyncMatchRatio()),
+		timestamp.DurationValue(syncMatchStats.GetAverageSpillLatency()).String(),
+		timestamp.DurationValue(syncMatchStats.GetMaxSpillLatency()).String()})
+	table.Render()
+}
+
+func printTaskHolders(taskHolders []*taskqueuespb.TaskHolderInfo) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Workflow ID", "Run ID", "Schedule ID", "Holder Identity", "Started Time"})
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+	for _, holder := range taskHolders {
+		table.Append([]string{holder.GetWorkflowId(),
+			holder.GetRunId(),
+			convert.Int64ToString(holder.GetScheduleId()),
+			holder.GetIdentity(),
+			formatTime(timestamp.TimeValue(holder.GetStartedTime()), false)})
+	}
+	table.Render()
+}
+
 func printPollerInfo(pollers []*taskqueuepb.PollerInfo, taskQueueType enumspb.TaskQueueType) {
 	table := tablewriter.NewWriter(os.Stdout)
 	table.SetBorder(false)
@@ -95,6 +95,7 @@ const (
 	FlagDetail                           = "detail"
 	FlagReason                           = "reason"
 	FlagReasonWithAlias                  = FlagReason + ", re"
+	FlagFreezeTimers                     = "freeze_timers"
 	FlagOpen                             = "open"
 	FlagOpenWithAlias                    = FlagOpen + ", op"
 	FlagMore                             = "more"
@@ -158,6 +159,8 @@ const (
 	FlagIsGlobalNamespaceWithAlias       = FlagIsGlobalNamespace + ", gd"
 	FlagNamespaceData                    = "namespace_data"
 	FlagNamespaceDataWithAlias           = FlagNamespaceData + ", dmd"
+	FlagCodecEndpoint                    = "codec_endpoint"
+	FlagCodecKeyID                       = "codec_key_id"
 	FlagEventID                          = "event_id"
 	FlagEventIDWithAlias                 = FlagEventID + ", eid"
 	FlagActivityID                       = "activity_id"
@@ -182,6 +185,8 @@ const (
 	FlagSearchAttributesKey              = "search_attr_key"
 	FlagSearchAttributesVal              = "search_attr_value"
 	FlagSearchAttributesType             = "search_attr_type"
+	FlagWorkflowTags                     = "tags"
+	FlagRemoveWorkflowTags               = "remove_tags"
 	FlagAddBadBinary                     = "add_bad_binary"
 	FlagRemoveBadBinary                  = "remove_bad_binary"
 	FlagResetType                        = "reset_type"
@@ -230,6 +235,7 @@ const (
 	FlagInputDirectory                   = "input_directory"
 	FlagAutoConfirm                      = "auto_confirm"
 	FlagVersion                          = "version"
+	FlagPrefix                           = "prefix"
 
 	FlagProtoType  = "type"
 	FlagHexData    = "hex_data"
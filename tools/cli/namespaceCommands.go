@@ -103,6 +103,7 @@ func (d *namespaceCLIImpl) RegisterNamespace(c *cli.Context) {
 			ErrorAndExit(fmt.Sprintf("Option %s format is invalid.", FlagNamespaceData), err)
 		}
 	}
+	setCodecNamespaceData(c, namespaceData)
 	if len(requiredNamespaceDataKeys) > 0 {
 		err = checkRequiredNamespaceDataKVs(namespaceData)
 		if err != nil {
@@ -208,6 +209,7 @@ func (d *namespaceCLIImpl) UpdateNamespace(c *cli.Context) {
 				ErrorAndExit("Namespace data format is invalid.", err)
 			}
 		}
+		setCodecNamespaceData(c, namespaceData)
 		if c.IsSet(FlagRetentionDays) {
 			retention = timestamp.DurationPtr(time.Duration(c.Int(FlagRetentionDays)) * time.Hour * 24)
 		}
@@ -309,6 +311,15 @@ func (d *namespaceCLIImpl) DescribeNamespace(c *cli.Context) {
 	printNamespace(resp)
 }
 
+func setCodecNamespaceData(c *cli.Context, namespaceData map[string]string) {
+	if c.IsSet(FlagCodecEndpoint) {
+		namespaceData[namespace.CodecEndpointDataKey] = c.String(FlagCodecEndpoint)
+	}
+	if c.IsSet(FlagCodecKeyID) {
+		namespaceData[namespace.CodecKeyIDDataKey] = c.String(FlagCodecKeyID)
+	}
+}
+
 func printNamespace(resp *workflowservice.DescribeNamespaceResponse) {
 	var formatStr = "Name: %v\nId: %v\nDescription: %v\nOwnerEmail: %v\nNamespaceData: %#v\nState: %v\nRetentionInDays: %v\n" +
 		"ActiveClusterName: %v\nClusters: %v\nHistoryArchivalState: %v\n"
@@ -334,6 +345,14 @@ func printNamespace(resp *workflowservice.DescribeNamespaceResponse) {
 		formatStr = formatStr + "VisibilityArchivalURI: %v\n"
 		descValues = append(descValues, resp.Config.GetVisibilityArchivalUri())
 	}
+	if endpoint := resp.NamespaceInfo.Data[namespace.CodecEndpointDataKey]; endpoint != "" {
+		formatStr = formatStr + "CodecEndpoint: %v\n"
+		descValues = append(descValues, endpoint)
+	}
+	if keyID := resp.NamespaceInfo.Data[namespace.CodecKeyIDDataKey]; keyID != "" {
+		formatStr = formatStr + "CodecKeyID: %v\n"
+		descValues = append(descValues, keyID)
+	}
 	fmt.Printf(formatStr, descValues...)
 	if resp.Config.BadBinaries != nil {
 		fmt.Println("Bad binaries to reset:")
@@ -86,6 +86,14 @@ var (
 			Name:  FlagNamespaceDataWithAlias,
 			Usage: "Namespace data of key value pairs, in format of k1:v1,k2:v2,k3:v3",
 		},
+		cli.StringFlag{
+			Name:  FlagCodecEndpoint,
+			Usage: "Optional URL of the remote codec server used to decode payloads of this namespace",
+		},
+		cli.StringFlag{
+			Name:  FlagCodecKeyID,
+			Usage: "Optional ID of the encryption key used by the namespace data converter",
+		},
 		cli.StringFlag{
 			Name:  FlagSecurityTokenWithAlias,
 			Usage: "Optional token for security check",
@@ -136,6 +144,14 @@ var (
 			Name:  FlagNamespaceDataWithAlias,
 			Usage: "Namespace data of key value pairs, in format of k1:v1,k2:v2,k3:v3 ",
 		},
+		cli.StringFlag{
+			Name:  FlagCodecEndpoint,
+			Usage: "Optional URL of the remote codec server used to decode payloads of this namespace",
+		},
+		cli.StringFlag{
+			Name:  FlagCodecKeyID,
+			Usage: "Optional ID of the encryption key used by the namespace data converter",
+		},
 		cli.StringFlag{
 			Name:  FlagSecurityTokenWithAlias,
 			Usage: "Optional token for security check",